@@ -18,6 +18,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/providers"
@@ -44,7 +45,16 @@ func main() {
 		return
 	}
 
-	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+	logOptions, err := cfg.Log.Options(os.Stdout)
+	if err != nil {
+		log.Error(ctx, "invalid log configuration", "err", err)
+		return
+	}
+	if err := log.Setup(logOptions); err != nil {
+		log.Error(ctx, "cannot set up the logger", "err", err)
+		return
+	}
+	defer func() { _ = log.Close() }()
 
 	if err := cfg.Sanitize(ctx); err != nil {
 		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
@@ -114,9 +124,15 @@ func main() {
 		return
 	}
 
+	dataCipher, err := encryption.NewDataCipher(ctx, cfg.DataEncryption, vaultCli)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the data cipher", "err", err)
+		return
+	}
+
 	// repositories initialization
 	identityRepository := repositories.NewIdentity()
-	claimsRepository := repositories.NewClaims()
+	claimsRepository := repositories.NewClaimsWithCipher(dataCipher)
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 
@@ -147,7 +163,7 @@ func main() {
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), ethConn, common.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	cfg.CredentialStatus.SingleIssuer = true
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, nil, claimsRepository, nil, nil, storage, nil, nil, nil, cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, nil, claimsRepository, nil, nil, storage, nil, nil, nil, cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	didCreationOptions := &ports.DIDCreationOptions{
 		Method:                  core.DIDMethod(cfg.APIUI.IdentityMethod),
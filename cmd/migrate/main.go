@@ -8,8 +8,6 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/db/schema"
 	"github.com/polygonid/sh-id-platform/internal/log"
-
-	_ "github.com/lib/pq"
 )
 
 // IssuerDatabaseUrl is the environment variable for the issuer database URL
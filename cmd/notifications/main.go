@@ -10,7 +10,6 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	vault "github.com/hashicorp/vault/api"
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -21,14 +20,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/credentialid"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/secrets"
 	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
@@ -51,20 +54,38 @@ func main() {
 		return
 	}
 
-	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+	logOptions, err := cfg.Log.Options(os.Stdout)
+	if err != nil {
+		log.Error(ctx, "invalid log configuration", "err", err)
+		return
+	}
+	if err := log.Setup(logOptions); err != nil {
+		log.Error(ctx, "cannot set up the logger", "err", err)
+		return
+	}
+	defer func() { _ = log.Close() }()
 
 	if err := cfg.SanitizeAPIUI(ctx); err != nil {
 		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
 		return
 	}
 
-	rdb, err := redis.Open(cfg.Cache.RedisUrl)
+	var secretStore *secrets.Store
+	if cfg.Secrets.Dir != "" {
+		if secretStore, err = secrets.NewStore(ctx, cfg.Secrets.Dir); err != nil {
+			log.Error(ctx, "cannot load the secrets", "err", err, "dir", cfg.Secrets.Dir)
+			return
+		}
+		go secretStore.Watch(ctx, cfg.Secrets.ReloadInterval)
+	}
+
+	rdb, err := redis.OpenWithPassword(cfg.Cache.RedisUrl, secretStore.Secret(secrets.CachePassword))
 	if err != nil {
 		log.Error(ctx, "cannot connect to redis", "err", err, "host", cfg.Cache.RedisUrl)
 		return
 	}
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorageWithPassword(cfg.Database.URL, secretStore.Secret(secrets.DatabasePassword))
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		return
@@ -75,7 +96,6 @@ func main() {
 	cachex := cache.NewRedisCache(rdb)
 
 	connectionsRepository := repositories.NewConnections()
-	claimsRepository := repositories.NewClaims()
 
 	var vaultCli *vault.Client
 	var vaultErr error
@@ -85,12 +105,18 @@ func main() {
 		Token:               cfg.KeyStore.Token,
 		Pass:                cfg.VaultUserPassAuthPassword,
 	}
+	if token := secretStore.Secret(secrets.VaultToken)(); token != "" {
+		vaultCfg.Token = token
+	}
 
 	vaultCli, vaultErr = providers.VaultClient(ctx, vaultCfg)
 	if vaultErr != nil {
 		log.Error(ctx, "cannot initialize vault client", "err", err)
 		return
 	}
+	if secretStore != nil && !vaultCfg.UserPassAuthEnabled {
+		secretStore.OnChange(secrets.VaultToken, vaultCli.SetToken)
+	}
 
 	if vaultCfg.UserPassAuthEnabled {
 		go providers.RenewToken(ctx, vaultCli, vaultCfg)
@@ -102,8 +128,15 @@ func main() {
 		return
 	}
 
+	dataCipher, err := encryption.NewDataCipher(ctx, cfg.DataEncryption, vaultCli)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the data cipher", "err", err)
+		return
+	}
+
+	claimsRepository := repositories.NewClaimsWithCipher(dataCipher)
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
-	credentialsService, err := newCredentialsService(ctx, cfg, storage, cachex, ps, vaultCli)
+	credentialsService, err := newCredentialsService(ctx, cfg, storage, cachex, ps, vaultCli, claimsRepository)
 	if err != nil {
 		log.Error(ctx, "cannot initialize the credential service", "err", err)
 		return
@@ -123,6 +156,9 @@ func main() {
 	ps.Subscribe(ctxCancel, event.CreateCredentialEvent, notificationService.SendCreateCredentialNotification)
 	ps.Subscribe(ctxCancel, event.CreateConnectionEvent, notificationService.SendCreateConnectionNotification)
 	ps.Subscribe(ctxCancel, event.CreateStateEvent, notificationService.SendRevokeCredentialNotification)
+	ps.Subscribe(ctxCancel, event.CreateCredentialEvent, services.NewCampaign(repositories.NewCampaign(), gateways.NewCampaignWebhook(httpPkg.DefaultHTTPClientWithRetry), storage).NotifyMilestones)
+	ps.Subscribe(ctxCancel, event.RevokeIneligibleCredentialEvent, services.NewStatusOracle(credentialsService).RevokeIneligibleCredential)
+	ps.Subscribe(ctxCancel, event.RevokeDependentsEvent, services.NewRevocationCascade(repositories.NewCredentialDependency(), claimsRepository, credentialsService, ps, storage).RevokeDependents)
 
 	gracefulShutdown := make(chan os.Signal, 1)
 	signal.Notify(gracefulShutdown, syscall.SIGINT, syscall.SIGTERM)
@@ -144,9 +180,8 @@ func main() {
 	<-gracefulShutdown
 }
 
-func newCredentialsService(ctx context.Context, cfg *config.Configuration, storage *db.Storage, cachex cache.Cache, ps pubsub.Client, vaultCli *vault.Client) (ports.ClaimsService, error) {
+func newCredentialsService(ctx context.Context, cfg *config.Configuration, storage *db.Storage, cachex cache.Cache, ps pubsub.Client, vaultCli *vault.Client, claimsRepository ports.ClaimsRepository) (ports.ClaimsService, error) {
 	identityRepository := repositories.NewIdentity()
-	claimsRepository := repositories.NewClaims()
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
@@ -155,7 +190,13 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 		return nil, fmt.Errorf("cannot initialize kms: err %s", err.Error())
 	}
 
-	commonClient, err := ethclient.Dial(cfg.Ethereum.URL)
+	if cfg.KeyStore.CustodyEnabled() {
+		if err = keyStore.RegisterRemoteCustody(cfg.KeyStore.CustodyURL, cfg.KeyStore.CustodyToken, cfg.KeyStore.CustodyTimeout); err != nil {
+			return nil, fmt.Errorf("cannot initialize the key custody: %w", err)
+		}
+	}
+
+	commonClient, err := blockchain.Dial(ctx, cfg.Ethereum)
 	if err != nil {
 		log.Error(ctx, "error dialing with ethclient", "err", err, "eth-url", cfg.Ethereum.URL)
 		return nil, err
@@ -190,8 +231,24 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.APIUI.ServerURL, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepository,
+		IdentityService:          identityService,
+		QrService:                qrService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepository,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.APIUI.ServerURL,
+		Publisher:                ps,
+		IPFSGatewayURL:           cfg.IPFS.GatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		IssuancePolicy:           cfg.IssuancePolicy,
+		CredentialIDTemplate:     credentialid.Template(cfg.CredentialID.URITemplate),
+		FetchThreads:             services.NewFetchThreads(cachex, cfg.FetchBinding),
+	})
 
 	return claimsService, nil
 }
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	vault "github.com/hashicorp/vault/api"
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -20,14 +19,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/credentialid"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/secrets"
 	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
@@ -50,14 +53,32 @@ func main() {
 		panic(err)
 	}
 
-	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+	logOptions, err := cfg.Log.Options(os.Stdout)
+	if err != nil {
+		log.Error(ctx, "invalid log configuration", "err", err)
+		panic(err)
+	}
+	if err := log.Setup(logOptions); err != nil {
+		log.Error(ctx, "cannot set up the logger", "err", err)
+		panic(err)
+	}
+	defer func() { _ = log.Close() }()
 
 	if err := cfg.Sanitize(ctx); err != nil {
 		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
 		return
 	}
 
-	rdb, err := redis.Open(cfg.Cache.RedisUrl)
+	var secretStore *secrets.Store
+	if cfg.Secrets.Dir != "" {
+		if secretStore, err = secrets.NewStore(ctx, cfg.Secrets.Dir); err != nil {
+			log.Error(ctx, "cannot load the secrets", "err", err, "dir", cfg.Secrets.Dir)
+			return
+		}
+		go secretStore.Watch(ctx, cfg.Secrets.ReloadInterval)
+	}
+
+	rdb, err := redis.OpenWithPassword(cfg.Cache.RedisUrl, secretStore.Secret(secrets.CachePassword))
 	if err != nil {
 		log.Error(ctx, "cannot connect to redis", "err", err, "host", cfg.Cache.RedisUrl)
 		return
@@ -66,7 +87,7 @@ func main() {
 	ps.WithLogger(log.Error)
 	cachex := cache.NewRedisCache(rdb)
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorageWithPassword(cfg.Database.URL, secretStore.Secret(secrets.DatabasePassword))
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		panic(err)
@@ -90,12 +111,18 @@ func main() {
 		Token:               cfg.KeyStore.Token,
 		Pass:                cfg.VaultUserPassAuthPassword,
 	}
+	if token := secretStore.Secret(secrets.VaultToken)(); token != "" {
+		vaultCfg.Token = token
+	}
 
 	vaultCli, vaultErr = providers.VaultClient(ctx, vaultCfg)
 	if vaultErr != nil {
 		log.Error(ctx, "cannot initialize vault client", "err", err)
 		return
 	}
+	if secretStore != nil && !vaultCfg.UserPassAuthEnabled {
+		secretStore.OnChange(secrets.VaultToken, vaultCli.SetToken)
+	}
 
 	if vaultCfg.UserPassAuthEnabled {
 		go providers.RenewToken(ctx, vaultCli, vaultCfg)
@@ -126,8 +153,31 @@ func main() {
 		panic(err)
 	}
 
+	if cfg.KeyStore.CustodyEnabled() {
+		err = keyStore.RegisterRemoteCustody(cfg.KeyStore.CustodyURL, cfg.KeyStore.CustodyToken, cfg.KeyStore.CustodyTimeout)
+		if err != nil {
+			log.Error(ctx, "cannot register the custody key provider", "err", err)
+			panic(err)
+		}
+	}
+
+	if cfg.KeyStore.Policies != "" {
+		policies, err := kms.ParseKeyPolicies(cfg.KeyStore.Policies)
+		if err != nil {
+			log.Error(ctx, "cannot parse the key policies", "err", err)
+			panic(err)
+		}
+		keyStore.SetKeyPolicies(policies)
+	}
+
+	dataCipher, err := encryption.NewDataCipher(ctx, cfg.DataEncryption, vaultCli)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the data cipher", "err", err)
+		return
+	}
+
 	identityRepo := repositories.NewIdentity()
-	claimsRepo := repositories.NewClaims()
+	claimsRepo := repositories.NewClaimsWithCipher(dataCipher)
 	mtRepo := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepo := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
@@ -136,7 +186,7 @@ func main() {
 
 	connectionsRepository := repositories.NewConnections()
 
-	commonClient, err := ethclient.Dial(cfg.Ethereum.URL)
+	commonClient, err := blockchain.Dial(ctx, cfg.Ethereum)
 	if err != nil {
 		panic("Error dialing with ethclient: " + err.Error())
 	}
@@ -165,8 +215,23 @@ func main() {
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.APIUI.ServerURL, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		QrService:                qrService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.APIUI.ServerURL,
+		Publisher:                ps,
+		IPFSGatewayURL:           cfg.IPFS.GatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		IssuancePolicy:           cfg.IssuancePolicy,
+		CredentialIDTemplate:     credentialid.Template(cfg.CredentialID.URITemplate),
+	})
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(ctx, cfg, circuitsLoaderService)
@@ -176,12 +241,12 @@ func main() {
 		log.Error(ctx, "error creating transaction service", "err", err)
 		panic("error creating transaction service")
 	}
-	publisherGateway, err := gateways.NewPublisherEthGateway(cl, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
+	publisherGateway, err := gateways.NewStatePublisherGateway(cfg, cl, keyStore)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		panic("error creating publish gateway")
 	}
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps, gateways.NewStateConfirmationTracker(cfg, cl), gateways.NewPriceFeed(cfg.PriceFeed))
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
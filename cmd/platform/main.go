@@ -8,37 +8,30 @@ import (
 	"os/signal"
 	"syscall"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
-	redis2 "github.com/go-redis/redis/v8"
-	vault "github.com/hashicorp/vault/api"
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
-	iden3commProtocol "github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/api"
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
 	"github.com/polygonid/sh-id-platform/internal/buildinfo"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
-	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/httpcache"
+	"github.com/polygonid/sh-id-platform/internal/httplimits"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+	"github.com/polygonid/sh-id-platform/internal/httpsignature"
+	"github.com/polygonid/sh-id-platform/internal/issuernode"
 	"github.com/polygonid/sh-id-platform/internal/kms"
-	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
-	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
-	"github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/mtls"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
-	"github.com/polygonid/sh-id-platform/pkg/cache"
-	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
-	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
-	"github.com/polygonid/sh-id-platform/pkg/protocol"
-	"github.com/polygonid/sh-id-platform/pkg/pubsub"
-	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+	"github.com/polygonid/sh-id-platform/pkg/plugins"
 )
 
 var build = buildinfo.Revision()
@@ -54,168 +47,97 @@ func main() {
 		log.Error(ctx, "cannot load config", "err", err)
 		return
 	}
-	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
-
-	if err := cfg.Sanitize(ctx); err != nil {
-		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
-		return
-	}
-
-	if err := services.RegisterCustomDIDMethods(ctx, cfg.CustomDIDMethods); err != nil {
-		log.Error(ctx, "cannot register custom DID methods. Server cannot start", "err", err)
-		return
-	}
-
-	storage, err := db.NewStorage(cfg.Database.URL)
+	logOptions, err := cfg.Log.Options(os.Stdout)
 	if err != nil {
-		log.Error(ctx, "cannot connect to database", "err", err)
+		log.Error(ctx, "invalid log configuration", "err", err)
 		return
 	}
-
-	// Redis cache
-	rdb, err := redis.Open(cfg.Cache.RedisUrl)
-	if err != nil {
-		log.Error(ctx, "cannot connect to redis", "err", err, "host", cfg.Cache.RedisUrl)
+	if err := log.Setup(logOptions); err != nil {
+		log.Error(ctx, "cannot set up the logger", "err", err)
 		return
 	}
-	ps := pubsub.NewRedis(rdb)
-	ps.WithLogger(log.Error)
-	cachex := cache.NewRedisCache(rdb)
-
-	// TODO: Cache only if cfg.APIUI.SchemaCache == true
-	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL)
-
-	var vaultCli *vault.Client
-	var vaultErr error
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.VaultUserPassAuthEnabled,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		Pass:                cfg.VaultUserPassAuthPassword,
-	}
+	defer func() { _ = log.Close() }()
 
-	vaultCli, vaultErr = providers.VaultClient(ctx, vaultCfg)
-	if vaultErr != nil {
-		log.Error(ctx, "cannot initialize vault client", "err", err)
-		return
-	}
-
-	if vaultCfg.UserPassAuthEnabled {
-		go providers.RenewToken(ctx, vaultCli, vaultCfg)
-	}
-
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
-	if err != nil {
-		log.Error(ctx, "cannot initialize kms", "err", err)
-		return
-	}
-
-	ethereumClient, err := blockchain.Open(cfg, keyStore)
-	if err != nil {
-		log.Error(ctx, "error dialing with ethereum client", "err", err)
-		return
-	}
-
-	stateContract, err := blockchain.InitEthClient(cfg.Ethereum.URL, cfg.Ethereum.ContractAddress)
-	if err != nil {
-		log.Error(ctx, "failed init ethereum client", "err", err)
+	if err := cfg.Sanitize(ctx); err != nil {
+		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
 		return
 	}
 
-	ethConn, err := blockchain.InitEthConnect(cfg.Ethereum, keyStore)
+	node, err := issuernode.New(ctx, cfg, issuernode.Options{ServerURL: cfg.ServerUrl})
 	if err != nil {
-		log.Error(ctx, "failed init ethereum connect", "err", err)
+		log.Error(ctx, "cannot initialize the issuer node. Server cannot start", "err", err)
 		return
 	}
+	defer func() { _ = node.Close() }()
 
-	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
-
-	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, ethConn, common.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-
-	// repositories initialization
-	identityRepository := repositories.NewIdentity()
-	claimsRepository := repositories.NewClaims()
-	mtRepository := repositories.NewIdentityMerkleTreeRepository()
-	identityStateRepository := repositories.NewIdentityState()
-	revocationRepository := repositories.NewRevocation()
-
-	// services initialization
-	mtService := services.NewIdentityMerkleTrees(mtRepository)
-	qrService := services.NewQrStoreService(cachex)
-
-	cfg.CredentialStatus.SingleIssuer = false
-
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		*cfg.MediaTypeManager.Enabled,
-	)
-
-	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager)
-	proofService := gateways.NewProver(ctx, cfg, circuitsLoaderService)
+	tenantService := services.NewTenant(repositories.NewTenant(), node.Storage)
+	qrBrandingService := services.NewQRBranding(repositories.NewQRBranding(), node.QrStore, node.Storage)
+	usageService := services.NewUsage(repositories.NewUsage(), node.Storage)
+	accountService := services.NewAccountService(cfg.Ethereum, node.KeyStore)
+	healthMonitors := node.HealthMonitors()
 
-	transactionService, err := gateways.NewTransaction(ethereumClient, cfg.Ethereum.ConfirmationBlockCount)
-	if err != nil {
-		log.Error(ctx, "error creating transaction service", "err", err)
-		return
-	}
-
-	publisherGateway, err := gateways.NewPublisherEthGateway(ethereumClient, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
-	if err != nil {
-		log.Error(ctx, "error creating publish gateway", "err", err)
-		return
+	var balanceMonitor *gateways.BalanceMonitor
+	if cfg.BalanceMonitor.Enabled {
+		balanceMonitor, err = newBalanceMonitor(cfg.BalanceMonitor, node.EthereumClient, cfg.PublishingKeyPath)
+		if err != nil {
+			log.Error(ctx, "cannot create the publishing account balance monitor", "err", err)
+			return
+		}
+		balanceMonitor.Run(ctx)
+		healthMonitors["publishing-account-balance"] = balanceMonitor.Ping
 	}
 
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
+	serverHealth := health.New(healthMonitors)
+	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
-	packageManager, err := protocol.InitPackageManager(stateContract, cfg.Circuit.Path)
+	responseSigner, err := httpsignature.NewSigner(cfg.ResponseSigning.KeyPath)
 	if err != nil {
-		log.Error(ctx, "failed init package protocol", "err", err)
+		log.Error(ctx, "cannot load the response signing key", "err", err)
 		return
 	}
 
-	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	serverHealth := health.New(health.Monitors{
-		"postgres": storage.Ping,
-		"redis": func(rdb *redis2.Client) health.Pinger {
-			return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
-		}(rdb),
-	})
-	serverHealth.Run(ctx, health.DefaultPingPeriod)
-
 	mux := chi.NewRouter()
 	mux.Use(
 		chiMiddleware.RequestID,
-		log.ChiMiddleware(ctx),
+		log.ChiMiddleware(ctx, "/status", "/v1/qr-store"),
 		chiMiddleware.Recoverer,
-		cors.Handler(cors.Options{AllowedOrigins: []string{"*"}}),
-		chiMiddleware.NoCache,
+		forwarded.Middleware(cfg.ForwardedHeaders),
+		api.ServerURLMiddleware(node.Identities, cfg.ServerUrl),
+		responseSigner.Middleware(api.SignedRoutes),
+		apiversion.Middleware,
+		httpsecurity.Middleware(cfg.HTTPSecurity, api.PublicRoutes),
+		httplimits.Middleware(ctx, cfg.HTTPLimits, api.MessageRoutes, nil),
+		httpcache.Middleware(cfg.HTTPCache, api.DocumentRoutes, api.StatusRoutes),
+		plugins.Middleware(plugins.ServerAPI),
 	)
 	api.HandlerFromMux(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, accountService, claimsService, qrService, publisher, packageManager, serverHealth),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, node.Identities, accountService, node.Credentials, node.QrStore, node.Publisher, node.PackageManager, serverHealth, tenantService, qrBrandingService, usageService),
+			middlewares(ctx, cfg.HTTPBasicAuth, cfg.MultiTenancy, cfg.TLS, tenantService),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
 			}),
 		mux)
 	api.RegisterStatic(mux)
+	if responseSigner != nil {
+		mux.Get(httpsignature.JWKSPath, responseSigner.JWKSHandler)
+	}
+	if balanceMonitor != nil {
+		mux.Get("/metrics", balanceMonitor.MetricsHandler)
+	}
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.ServerPort),
-		Handler: mux,
+		Addr:              fmt.Sprintf(":%d", cfg.ServerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.HTTPLimits.ReadHeaderTimeout,
 	}
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		log.Info(ctx, "server started", "port", cfg.ServerPort)
-		if err := server.ListenAndServe(); err != nil {
+		log.Info(ctx, "server started", "port", cfg.ServerPort, "tls", cfg.TLS.Enabled(), "mtls", cfg.TLS.MutualTLSEnabled())
+		if err := mtls.ListenAndServe(server, cfg.TLS); err != nil {
 			log.Error(ctx, "starting http server", "err", err)
 		}
 	}()
@@ -224,9 +146,25 @@ func main() {
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
+func middlewares(ctx context.Context, auth config.HTTPBasicAuth, multiTenancy config.MultiTenancy, tlsCfg config.TLS, tenantService ports.TenantService) []api.StrictMiddlewareFunc {
+	if !multiTenancy.Enabled {
+		tenantService = nil
+	}
 	return []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
-		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api.BasicAuthMiddleware(ctx, auth.User, auth.Password, tenantService),
+		api.ClientCertMiddleware(tlsCfg),
+	}
+}
+
+func newBalanceMonitor(cfg config.BalanceMonitor, ethereumClient *eth.Client, publishingKeyPath string) (*gateways.BalanceMonitor, error) {
+	threshold, err := cfg.ThresholdWei()
+	if err != nil {
+		return nil, err
+	}
+	address, err := ethereumClient.Address(kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath})
+	if err != nil {
+		return nil, err
 	}
+	return gateways.NewBalanceMonitor(ethereumClient, address, threshold, cfg.CheckInterval, gateways.NewBalanceAlerters(cfg)...), nil
 }
@@ -8,44 +8,35 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
-	redis2 "github.com/go-redis/redis/v8"
-	vault "github.com/hashicorp/vault/api"
-	auth "github.com/iden3/go-iden3-auth/v2"
-	authLoaders "github.com/iden3/go-iden3-auth/v2/loaders"
-	"github.com/iden3/go-iden3-auth/v2/pubsignals"
-	"github.com/iden3/go-iden3-auth/v2/state"
+	core "github.com/iden3/go-iden3-core/v2"
 	"github.com/iden3/go-iden3-core/v2/w3c"
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
-	iden3commProtocol "github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/api_ui"
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
 	"github.com/polygonid/sh-id-platform/internal/buildinfo"
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
-	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/httpcache"
+	"github.com/polygonid/sh-id-platform/internal/httplimits"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+	"github.com/polygonid/sh-id-platform/internal/httpsignature"
+	"github.com/polygonid/sh-id-platform/internal/issuernode"
 	"github.com/polygonid/sh-id-platform/internal/kms"
-	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/mtls"
 	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
-	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
-	"github.com/polygonid/sh-id-platform/pkg/cache"
-	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
-	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
-	"github.com/polygonid/sh-id-platform/pkg/protocol"
-	"github.com/polygonid/sh-id-platform/pkg/pubsub"
-	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	httpPkg "github.com/polygonid/sh-id-platform/pkg/http"
+	"github.com/polygonid/sh-id-platform/pkg/plugins"
 )
 
 var build = buildinfo.Revision()
@@ -62,162 +53,60 @@ func main() {
 		return
 	}
 
-	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
-
-	if err := cfg.SanitizeAPIUI(ctx); err != nil {
-		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
-		return
-	}
-
-	if err := services.RegisterCustomDIDMethods(ctx, cfg.CustomDIDMethods); err != nil {
-		log.Error(ctx, "cannot register custom DID methods. Server cannot start", "err", err)
-		return
-	}
-
-	storage, err := db.NewStorage(cfg.Database.URL)
-	if err != nil {
-		log.Error(ctx, "cannot connect to database", "err", err)
-		return
-	}
-
-	// Redis cache
-	rdb, err := redis.Open(cfg.Cache.RedisUrl)
-	if err != nil {
-		log.Error(ctx, "cannot connect to redis", "err", err, "host", cfg.Cache.RedisUrl)
-		return
-	}
-	ps := pubsub.NewRedis(rdb)
-	ps.WithLogger(log.Error)
-	cachex := cache.NewRedisCache(rdb)
-
-	// TODO: Cache only if cfg.APIUI.SchemaCache == true
-	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL)
-
-	var vaultCli *vault.Client
-	var vaultErr error
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.VaultUserPassAuthEnabled,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		Pass:                cfg.VaultUserPassAuthPassword,
-	}
-
-	vaultCli, vaultErr = providers.VaultClient(ctx, vaultCfg)
-	if vaultErr != nil {
-		log.Error(ctx, "cannot initialize vault client", "err", err)
-		return
-	}
-
-	if vaultCfg.UserPassAuthEnabled {
-		go providers.RenewToken(ctx, vaultCli, vaultCfg)
-	}
-
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
-	if err != nil {
-		log.Error(ctx, "cannot initialize kms", "err", err)
-		return
-	}
-
-	err = config.CheckDID(ctx, cfg, vaultCli)
-	if err != nil {
-		log.Error(ctx, "cannot initialize did", "err", err)
-		return
-	}
-
-	ethereumClient, err := blockchain.Open(cfg, keyStore)
+	logOptions, err := cfg.Log.Options(os.Stdout)
 	if err != nil {
-		log.Error(ctx, "error dialing with ethereum client", "err", err)
+		log.Error(ctx, "invalid log configuration", "err", err)
 		return
 	}
-
-	stateContract, err := blockchain.InitEthClient(cfg.Ethereum.URL, cfg.Ethereum.ContractAddress)
-	if err != nil {
-		log.Error(ctx, "failed init ethereum client", "err", err)
+	if err := log.Setup(logOptions); err != nil {
+		log.Error(ctx, "cannot set up the logger", "err", err)
 		return
 	}
+	defer func() { _ = log.Close() }()
 
-	ethConn, err := blockchain.InitEthConnect(cfg.Ethereum, keyStore)
-	if err != nil {
-		log.Error(ctx, "failed init ethereum connect", "err", err)
-		return
-	}
-
-	verificationKeyLoader := &authLoaders.FSKeyLoader{Dir: cfg.Circuit.Path + "/authV2"}
-	resolvers := map[string]pubsignals.StateResolver{
-		cfg.Ethereum.ResolverPrefix: state.ETHResolver{
-			RPCUrl:          cfg.Ethereum.URL,
-			ContractAddress: common.HexToAddress(cfg.Ethereum.ContractAddress),
-		},
-	}
-
-	verifier, err := auth.NewVerifier(verificationKeyLoader, resolvers)
-	if err != nil {
-		log.Error(ctx, "failed init verifier", "err", err)
+	if err := cfg.SanitizeAPIUI(ctx); err != nil {
+		log.Error(ctx, "there are errors in the configuration that prevent server to start", "err", err)
 		return
 	}
 
-	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
-	proofService := gateways.NewProver(ctx, cfg, circuitsLoaderService)
-	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), ethConn, common.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-
-	// repositories initialization
-	identityRepository := repositories.NewIdentity()
-	claimsRepository := repositories.NewClaims()
-	mtRepository := repositories.NewIdentityMerkleTreeRepository()
-	identityStateRepository := repositories.NewIdentityState()
-	revocationRepository := repositories.NewRevocation()
-	connectionsRepository := repositories.NewConnections()
-	sessionRepository := repositories.NewSessionCached(cachex)
-	linkRepository := repositories.NewLink(*storage)
-	schemaRepository := repositories.NewSchema(*storage)
-
-	// services initialization
-	mtService := services.NewIdentityMerkleTrees(mtRepository)
-	qrService := services.NewQrStoreService(cachex)
-
-	cfg.CredentialStatus.SingleIssuer = true
-
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		*cfg.MediaTypeManager.Enabled,
-	)
-
-	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, ps, cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
-	schemaService := services.NewSchema(schemaRepository, schemaLoader)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.APIUI.ServerURL, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager)
-	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, cfg.IPFS.GatewayURL)
-
-	transactionService, err := gateways.NewTransaction(ethereumClient, cfg.Ethereum.ConfirmationBlockCount)
+	node, err := issuernode.New(ctx, cfg, issuernode.Options{ServerURL: cfg.APIUI.ServerURL, SingleIssuer: true, Authentication: true})
 	if err != nil {
-		log.Error(ctx, "error creating transaction service", "err", err)
+		log.Error(ctx, "cannot initialize the issuer node. Server cannot start", "err", err)
 		return
 	}
+	defer func() { _ = node.Close() }()
 
-	publisherGateway, err := gateways.NewPublisherEthGateway(ethereumClient, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
-	if err != nil {
-		log.Error(ctx, "error creating publish gateway", "err", err)
-		return
+	if cfg.SyntheticIssuance.Enabled {
+		err = createSyntheticIssuer(ctx, cfg, node.Identities)
+	} else {
+		err = config.CheckDID(ctx, cfg, node.Vault)
 	}
-
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
-
-	packageManager, err := protocol.InitPackageManager(stateContract, cfg.Circuit.Path)
 	if err != nil {
-		log.Error(ctx, "failed init package protocol", "err", err)
+		log.Error(ctx, "cannot initialize did", "err", err)
 		return
 	}
 
-	serverHealth := health.New(health.Monitors{
-		"postgres": storage.Ping,
-		"redis": func(rdb *redis2.Client) health.Pinger {
-			return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
-		}(rdb),
-	})
+	storage := node.Storage
+	identityService := node.Identities
+	claimsService := node.Credentials
+	connectionsService := node.Connections
+	linkService := node.Links
+	qrService := node.QrStore
+	translationService := node.Translations
+	sessionRepository := node.Repositories.Sessions
+
+	schemaService := services.NewSchemaWithCache(node.Repositories.Schemas, node.SchemaLoader, node.Storage, node.DocumentCache)
+	qrBrandingService := services.NewQRBranding(repositories.NewQRBranding(), qrService, storage)
+	holderPortalService := services.NewHolderPortal(sessionRepository, connectionsService, claimsService, gateways.NewDisplayMethodResolver(), cfg.APIUI.HolderPortal.SessionTTL)
+	sessionStatusService := services.NewSessionStatus(sessionRepository, connectionsService, linkService, claimsService)
+	apiKeyService := services.NewAPIKey(repositories.NewAPIKey(), storage)
+	authAttemptsService := services.NewAuthAttempts(node.Cache, sessionRepository, cfg.APIUI.AuthProtection)
+	presentationTemplateService := services.NewPresentationTemplate(node.Repositories.PresentationTemplates, storage)
+	campaignService := services.NewCampaign(repositories.NewCampaign(), gateways.NewCampaignWebhook(httpPkg.DefaultHTTPClientWithRetry), storage)
+	revocationRuleService := services.NewRevocationRule(repositories.NewRevocationRule(), claimsService, gateways.NewRevocationRuleWebhook(httpPkg.DefaultHTTPClientWithRetry), storage)
+	credentialPDFService := services.NewCredentialPDF(repositories.NewCredentialPDFTemplate(), node.Repositories.Schemas, claimsService, qrBrandingService, storage, cfg.ServerUrl)
+
+	serverHealth := health.New(node.HealthMonitors())
 	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
 	if !identifierExists(ctx, &cfg.APIUI.IssuerDID, identityService) {
@@ -226,18 +115,83 @@ func main() {
 		return
 	}
 
+	responseSigner, err := httpsignature.NewSigner(cfg.ResponseSigning.KeyPath)
+	if err != nil {
+		log.Error(ctx, "cannot load the response signing key", "err", err)
+		return
+	}
+
 	mux := chi.NewRouter()
 	mux.Use(
 		chiMiddleware.RequestID,
-		log.ChiMiddleware(ctx),
+		log.ChiMiddleware(ctx, "/status", "/v1/authentication/sessions/", "/v1/sessions/", "/v1/holder/sessions/", "/v1/qr-store"),
+		responseSigner.Middleware(api_ui.SignedRoutes),
+		apiversion.Middleware,
 		chiMiddleware.Recoverer,
-		cors.AllowAll().Handler,
-		chiMiddleware.NoCache,
+		forwarded.Middleware(cfg.ForwardedHeaders),
+		httpsecurity.Middleware(cfg.HTTPSecurity, api_ui.PublicRoutes),
+		httplimits.Middleware(ctx, cfg.HTTPLimits, api_ui.MessageRoutes, api_ui.StreamingRoutes),
+		httpcache.Middleware(cfg.HTTPCache, api_ui.DocumentRoutes, api_ui.StatusRoutes),
+		plugins.Middleware(plugins.ServerUI),
 	)
+	revocationRequestService := services.NewRevocationRequest(repositories.NewRevocationRequest(), claimsService, node.Repositories.Schemas, storage)
+	revocationCascadeService := services.NewRevocationCascade(repositories.NewCredentialDependency(), node.Repositories.Claims, claimsService, node.PubSub, storage)
+	connectionMergeService := services.NewConnectionMerge(repositories.NewConnectionMerge(), repositories.NewConnections(), node.Repositories.Claims, claimsService, node.PubSub, storage)
+	connectionPruningService := services.NewConnectionPruning(repositories.NewConnections(), storage)
+	claimArchivalService := services.NewClaimArchival(repositories.NewClaimArchive(), claimsService, storage)
+	claimImportService := services.NewClaimImport(repositories.NewClaimImport(), claimsService, storage, cfg.CredentialStatus.CredentialStatusType)
+	connectionRebindingService := services.NewConnectionRebinding(repositories.NewConnectionRebinding(), repositories.NewConnections(), node.Repositories.Claims, claimsService, identityService, node.PubSub, storage)
+	stateAnchor, err := newStateAnchor(ctx, cfg, node.KeyStore)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the state checkpoints anchor", "err", err)
+		return
+	}
+	stateCheckpointService := services.NewStateCheckpoint(repositories.NewStateCheckpoint(), identityService, node.Repositories.IdentityState, stateAnchor, storage)
+	keyMigrationService := services.NewKeyMigration(repositories.NewKeyMigration(), identityService, claimsService, node.Publisher, node.KeyStore, storage, cfg.ServerUrl)
+	uiServer := api_ui.NewServer(cfg, api_ui.Dependencies{
+		IdentityService:             identityService,
+		ClaimsService:               claimsService,
+		SchemaService:               schemaService,
+		ConnectionsService:          connectionsService,
+		LinkService:                 linkService,
+		QrService:                   qrService,
+		PublisherGateway:            node.Publisher,
+		PackageManager:              node.PackageManager,
+		Health:                      serverHealth,
+		HolderPortal:                holderPortalService,
+		QRBrandingService:           qrBrandingService,
+		SessionStatusService:        sessionStatusService,
+		TransactionService:          node.Transactions,
+		APIKeyService:               apiKeyService,
+		AuthAttemptsService:         authAttemptsService,
+		PresentationTemplateService: presentationTemplateService,
+		StatsService:                services.NewStats(repositories.NewStats(), storage, cfg.PriceFeed),
+		VerificationBundleService:   services.NewVerificationBundle(claimsService, node.Repositories.IdentityState, node.SchemaLoader, storage, cfg.Ethereum.ContractAddress),
+		TranslationService:          translationService,
+		InvitationService:           services.NewInvitation(qrService),
+		IssuerProfileService:        services.NewIssuerProfile(repositories.NewIssuerProfile(), storage),
+		MigrationsService:           services.NewMigrations(cfg.Database.URL),
+		CredentialPDFService:        credentialPDFService,
+		CampaignService:             campaignService,
+		WalletService:               services.NewWallet(repositories.NewWalletProfile(), storage),
+		OrganizationCredentials:     services.NewOrganizationCredential(repositories.NewOrganizationCredential(), storage),
+		TrustRegistryService:        node.TrustRegistry,
+		RevocationRuleService:       revocationRuleService,
+		KeyMigrationService:         keyMigrationService,
+		RevocationRequestService:    revocationRequestService,
+		ConnectionRebindingService:  connectionRebindingService,
+		StateCheckpointService:      stateCheckpointService,
+		ConnectionMergeService:      connectionMergeService,
+		ConnectionPruningService:    connectionPruningService,
+		RevocationCascadeService:    revocationCascadeService,
+		ClaimArchivalService:        claimArchivalService,
+		RevocationNonceService:      node.RevocationNonces,
+		ClaimImportService:          claimImportService,
+	})
 	api_ui.HandlerWithOptions(
 		api_ui.NewStrictHandlerWithOptions(
-			api_ui.NewServer(cfg, identityService, claimsService, schemaService, connectionsService, linkService, qrService, publisher, packageManager, serverHealth),
-			middlewares(ctx, cfg.APIUI.APIUIAuth),
+			uiServer,
+			middlewares(ctx, cfg.APIUI, cfg.TLS, holderPortalService, apiKeyService),
 			api_ui.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -248,17 +202,61 @@ func main() {
 		},
 	)
 	api_ui.RegisterStatic(mux)
+	if responseSigner != nil {
+		mux.Get(httpsignature.JWKSPath, responseSigner.JWKSHandler)
+	}
+	if cfg.APIUI.LandingPage.Enabled {
+		uiServer.RegisterLandingPage(mux)
+	}
+	mux.Get(api_ui.DIDConfigurationPath, api_ui.DIDConfigurationHandler(services.NewDomainLinkage(identityService, node.KeyStore), cfg.APIUI.IssuerDID, cfg.APIUI.ServerURL))
+	authAttemptsMetrics, fetchThreadsMetrics := api_ui.AuthAttemptsMetricsHandler(authAttemptsService), api_ui.FetchThreadsMetricsHandler(node.FetchThreads)
+	keyPoliciesMetrics := api_ui.KeyPoliciesMetricsHandler(node.KeyStore)
+	mux.Get("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		authAttemptsMetrics(w, r)
+		fetchThreadsMetrics(w, r)
+		keyPoliciesMetrics(w, r)
+	})
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.APIUI.ServerPort),
-		Handler: mux,
+		Addr:              fmt.Sprintf(":%d", cfg.APIUI.ServerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.HTTPLimits.ReadHeaderTimeout,
 	}
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
+	if cfg.RevocationRules.Enabled {
+		go runRevocationRules(ctx, revocationRuleService, cfg.RevocationRules.CheckInterval)
+	}
+
+	if cfg.StateCheckpoints.Enabled {
+		go runStateCheckpoints(ctx, stateCheckpointService, cfg.StateCheckpoints.Interval)
+	}
+
+	if cfg.ConnectionDuplicates.Enabled {
+		go runConnectionDuplicatesReport(ctx, connectionMergeService, cfg.APIUI.IssuerDID, cfg.ConnectionDuplicates.Interval)
+	}
+
+	if cfg.ConnectionPruning.Enabled {
+		go runConnectionPruning(ctx, connectionPruningService, cfg.APIUI.IssuerDID, cfg.ConnectionPruning)
+	}
+
+	if cfg.ClaimArchival.Enabled {
+		go runClaimArchival(ctx, claimArchivalService, cfg.APIUI.IssuerDID, cfg.ClaimArchival)
+	}
+
+	if cfg.IssuanceQueue.Enabled {
+		issuanceQueue, err := gateways.NewRedisIssuanceQueue(ctx, node.Redis, cfg.IssuanceQueue)
+		if err != nil {
+			log.Error(ctx, "cannot initialize the issuance queue", "err", err)
+			return
+		}
+		go runIssuanceQueue(ctx, services.NewIssuanceConsumer(issuanceQueue, claimsService, cfg.APIUI.IssuerDID, cfg.CredentialStatus.CredentialStatusType), cfg.IssuanceQueue)
+	}
+
 	go func() {
-		log.Info(ctx, "UI API server started", "port", cfg.APIUI.ServerPort)
-		if err := server.ListenAndServe(); err != nil {
+		log.Info(ctx, "UI API server started", "port", cfg.APIUI.ServerPort, "tls", cfg.TLS.Enabled(), "mtls", cfg.TLS.MutualTLSEnabled())
+		if err := mtls.ListenAndServe(server, cfg.TLS); err != nil {
 			log.Error(ctx, "starting HTTP UI API server", "err", err)
 		}
 	}()
@@ -267,6 +265,113 @@ func main() {
 	log.Info(ctx, "Shutting down")
 }
 
+// runRevocationRules runs the due revocation rules every interval
+func runRevocationRules(ctx context.Context, revocationRuleService ports.RevocationRuleService, interval time.Duration) {
+	log.Info(ctx, "revocation rules scheduler started", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			revocationRuleService.RunDue(ctx)
+		case <-ctx.Done():
+			log.Info(ctx, "finishing revocation rules scheduler")
+			return
+		}
+	}
+}
+
+// runStateCheckpoints anchors the latest published states every interval
+func runStateCheckpoints(ctx context.Context, stateCheckpointService ports.StateCheckpointService, interval time.Duration) {
+	log.Info(ctx, "state checkpoints scheduler started", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			stateCheckpointService.CheckpointAll(ctx)
+		case <-ctx.Done():
+			log.Info(ctx, "finishing state checkpoints scheduler")
+			return
+		}
+	}
+}
+
+// runConnectionDuplicatesReport reports the duplicate connections of the issuer every interval
+func runConnectionDuplicatesReport(ctx context.Context, connectionMergeService ports.ConnectionMergeService, issuerDID w3c.DID, interval time.Duration) {
+	log.Info(ctx, "duplicate connections report scheduler started", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			connectionMergeService.ReportDuplicates(ctx, issuerDID)
+		case <-ctx.Done():
+			log.Info(ctx, "finishing duplicate connections report scheduler")
+			return
+		}
+	}
+}
+
+// runConnectionPruning prunes the dead connections of the issuer every interval
+func runConnectionPruning(ctx context.Context, connectionPruningService ports.ConnectionPruningService, issuerDID w3c.DID, cfg config.ConnectionPruning) {
+	log.Info(ctx, "dead connections pruning scheduler started", "interval", cfg.Interval, "inactiveDays", cfg.InactiveDays, "action", cfg.Action)
+	req := ports.ConnectionPruneRequest{InactiveDays: cfg.InactiveDays, Action: cfg.Action, BatchSize: cfg.BatchSize}
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			connectionPruningService.PruneAll(ctx, issuerDID, req)
+		case <-ctx.Done():
+			log.Info(ctx, "finishing dead connections pruning scheduler")
+			return
+		}
+	}
+}
+
+// runClaimArchival archives the old claims of the issuer every interval
+func runClaimArchival(ctx context.Context, claimArchivalService ports.ClaimArchivalService, issuerDID w3c.DID, cfg config.ClaimArchival) {
+	log.Info(ctx, "claims archival scheduler started", "interval", cfg.Interval, "minAgeDays", cfg.MinAgeDays)
+	req := ports.ClaimArchiveRequest{MinAgeDays: cfg.MinAgeDays, BatchSize: cfg.BatchSize}
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			claimArchivalService.ArchiveAll(ctx, issuerDID, req)
+		case <-ctx.Done():
+			log.Info(ctx, "finishing claims archival scheduler")
+			return
+		}
+	}
+}
+
+// runIssuanceQueue issues the credentials of the commands of the issuance queue until the server stops
+func runIssuanceQueue(ctx context.Context, issuanceConsumer ports.IssuanceConsumerService, cfg config.IssuanceQueue) {
+	log.Info(ctx, "issuance queue consumer started", "type", cfg.Type, "topic", cfg.Topic, "replyTopic", cfg.ReplyTopic, "consumer", cfg.Consumer)
+	issuanceConsumer.Run(ctx)
+	log.Info(ctx, "finishing issuance queue consumer")
+}
+
+// newStateAnchor returns the anchor of the state checkpoints, or nil when they are disabled
+func newStateAnchor(ctx context.Context, cfg *config.Configuration, keyStore *kms.KMS) (ports.StateAnchor, error) {
+	if !cfg.StateCheckpoints.Enabled {
+		return nil, nil
+	}
+	if cfg.StateCheckpoints.Type != config.StateCheckpointsEthereum {
+		return gateways.NewOpenTimestampsAnchor(cfg.StateCheckpoints.CalendarURL, cfg.StateCheckpoints.Timeout), nil
+	}
+	ethCfg := cfg.Ethereum
+	ethCfg.URL = cfg.StateCheckpoints.EthereumURL
+	ethCfg.FallbackURLs = nil
+	client, err := blockchain.InitEthConnect(ctx, ethCfg, keyStore)
+	if err != nil {
+		return nil, err
+	}
+	return gateways.NewEthereumAnchor(client, kms.KeyID{Type: kms.KeyTypeEthereum, ID: cfg.StateCheckpoints.KeyPath}), nil
+}
+
 func identifierExists(ctx context.Context, did *w3c.DID, service ports.IdentityService) bool {
 	_, err := service.GetByDID(ctx, *did)
 	if err != nil {
@@ -276,10 +381,17 @@ func identifierExists(ctx context.Context, did *w3c.DID, service ports.IdentityS
 	return true
 }
 
-func middlewares(ctx context.Context, auth config.APIUIAuth) []api_ui.StrictMiddlewareFunc {
+func middlewares(ctx context.Context, cfg config.APIUI, tlsCfg config.TLS, holderPortalService ports.HolderPortalService, apiKeyService ports.APIKeyService) []api_ui.StrictMiddlewareFunc {
+	if !cfg.HolderPortal.Enabled {
+		holderPortalService = nil
+	}
+	auth := cfg.APIUIAuth
 	return []api_ui.StrictMiddlewareFunc{
+		api_ui.WalletMiddleware(),
+		api_ui.HolderAuthMiddleware(holderPortalService),
 		api_ui.LogMiddleware(ctx),
-		api_ui.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api_ui.BasicAuthMiddleware(ctx, auth.User, auth.Password, auth.AdminUser, auth.AdminPassword, apiKeyService),
+		api_ui.ClientCertMiddleware(tlsCfg),
 	}
 }
 
@@ -293,3 +405,26 @@ func errorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 	}
 }
+
+// createSyntheticIssuer creates a new issuer identity for the synthetic issuance mode. Its keys are kept in memory, so
+// the identity of the previous start cannot be used anymore.
+func createSyntheticIssuer(ctx context.Context, cfg *config.Configuration, identityService ports.IdentityService) error {
+	identity, err := identityService.Create(ctx, cfg.APIUI.ServerURL, &ports.DIDCreationOptions{
+		Method:                  core.DIDMethod(cfg.APIUI.IdentityMethod),
+		Network:                 core.NetworkID(cfg.APIUI.IdentityNetwork),
+		Blockchain:              core.Blockchain(cfg.APIUI.IdentityBlockchain),
+		KeyType:                 kms.KeyType(cfg.APIUI.KeyType),
+		AuthBJJCredentialStatus: cfg.CredentialStatus.CredentialStatusType,
+	})
+	if err != nil {
+		return err
+	}
+	issuerDID, err := w3c.ParseDID(identity.Identifier)
+	if err != nil {
+		return err
+	}
+	cfg.APIUI.Issuer = identity.Identifier
+	cfg.APIUI.IssuerDID = *issuerDID
+	log.Warn(ctx, "synthetic issuance: created a new issuer did", "did", cfg.APIUI.Issuer)
+	return nil
+}
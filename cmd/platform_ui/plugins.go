@@ -0,0 +1,7 @@
+package main
+
+// Import here the plugins compiled in the server, i.e:
+//
+//	import _ "example.com/issuer-plugins/sso"
+//
+// See the plugins package for the extension points.
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/tsclient"
+)
+
+const (
+	permFile   os.FileMode = 0o644
+	permFolder os.FileMode = 0o755
+)
+
+var (
+	fSpec   = flag.String("spec", "api_ui/api.yaml", "openapi spec to generate the client from")
+	fOutput = flag.String("output", "clients/api_ui/client.ts", "output file")
+	fV2     = flag.Bool("v2", false, "generate the client of the v2 version of the api")
+)
+
+func main() {
+	ctx := context.Background()
+	log.Config(log.LevelDebug, log.OutputText, os.Stdout)
+	flag.Parse()
+
+	spec, err := os.ReadFile(*fSpec)
+	if err != nil {
+		log.Error(ctx, "cannot read the spec", "err", err, "spec", *fSpec)
+		os.Exit(1)
+	}
+	if *fV2 {
+		if spec, err = apiversion.V2Spec(spec); err != nil {
+			log.Error(ctx, "cannot build the v2 spec", "err", err)
+			os.Exit(1)
+		}
+	}
+	client, err := tsclient.Generate(spec)
+	if err != nil {
+		log.Error(ctx, "cannot generate the client", "err", err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(filepath.Dir(*fOutput), permFolder); err != nil {
+		log.Error(ctx, "cannot create the output folder", "err", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*fOutput, client.Source, permFile); err != nil {
+		log.Error(ctx, "cannot write the client", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "typescript client generated", "output", *fOutput, "version", client.Version, "digest", client.Digest)
+}
@@ -14,6 +14,7 @@ import (
 	uuid "github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	timeapi "github.com/polygonid/sh-id-platform/internal/timeapi"
 )
 
@@ -38,11 +39,25 @@ const (
 	Iden3BasicDisplayMethodV1 DisplayMethodType = "Iden3BasicDisplayMethodV1"
 )
 
+// Defines values for QRBrandingErrorCorrection.
+const (
+	H QRBrandingErrorCorrection = "H"
+	L QRBrandingErrorCorrection = "L"
+	M QRBrandingErrorCorrection = "M"
+	Q QRBrandingErrorCorrection = "Q"
+)
+
 // Defines values for RefreshServiceType.
 const (
 	Iden3RefreshService2023 RefreshServiceType = "Iden3RefreshService2023"
 )
 
+// Defines values for GetUsageParamsFormat.
+const (
+	Csv  GetUsageParamsFormat = "csv"
+	Json GetUsageParamsFormat = "json"
+)
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse struct {
 	Body     interface{} `json:"body"`
@@ -59,10 +74,16 @@ type Config = []KeyValue
 
 // CreateClaimRequest defines model for CreateClaimRequest.
 type CreateClaimRequest struct {
-	CredentialSchema      string                      `json:"credentialSchema"`
-	CredentialSubject     map[string]interface{}      `json:"credentialSubject"`
-	DisplayMethod         *DisplayMethod              `json:"displayMethod,omitempty"`
-	Expiration            *int64                      `json:"expiration,omitempty"`
+	CredentialSchema  string                 `json:"credentialSchema"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	DisplayMethod     *DisplayMethod         `json:"displayMethod,omitempty"`
+	Expiration        *int64                 `json:"expiration,omitempty"`
+
+	// ExternalId Integrator reference to correlate the credential with a record in their own system
+	ExternalId *string `json:"externalId,omitempty"`
+
+	// Force Issue the credential even if an identical non revoked credential has already been issued to the user
+	Force                 *bool                       `json:"force,omitempty"`
 	MerklizedRootPosition *string                     `json:"merklizedRootPosition,omitempty"`
 	Proofs                *[]CreateClaimRequestProofs `json:"proofs,omitempty"`
 	RefreshService        *RefreshService             `json:"refreshService,omitempty"`
@@ -88,6 +109,9 @@ type CreateIdentityRequest struct {
 		Network    string                               `json:"network"`
 		Type       CreateIdentityRequestDidMetadataType `json:"type"`
 	} `json:"didMetadata"`
+
+	// ServerUrl Public url the agent of the identity is reached at, if it is not the one of the node
+	ServerUrl *string `json:"serverUrl,omitempty"`
 }
 
 // CreateIdentityRequestDidMetadataType defines model for CreateIdentityRequest.DidMetadata.Type.
@@ -100,6 +124,31 @@ type CreateIdentityResponse struct {
 	State      *IdentityState `json:"state,omitempty"`
 }
 
+// CreateTenantRequest defines model for CreateTenantRequest.
+type CreateTenantRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateTenantResponse defines model for CreateTenantResponse.
+type CreateTenantResponse struct {
+	ApiKey    string    `json:"apiKey"`
+	CreatedAt TimeUTC   `json:"createdAt"`
+	Id        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// CredentialMetadata defines model for CredentialMetadata.
+type CredentialMetadata struct {
+	CredentialSchema CredentialSchema `json:"credentialSchema"`
+	CredentialStatus interface{}      `json:"credentialStatus"`
+	ExpirationDate   *TimeUTC         `json:"expirationDate"`
+	Id               string           `json:"id"`
+	IssuanceDate     *TimeUTC         `json:"issuanceDate"`
+	Issuer           string           `json:"issuer"`
+	Revoked          bool             `json:"revoked"`
+	Type             []string         `json:"type"`
+}
+
 // CredentialSchema defines model for CredentialSchema.
 type CredentialSchema struct {
 	Id   string `json:"id"`
@@ -158,15 +207,25 @@ type GetClaimsResponse = []GetClaimResponse
 
 // GetIdentityDetailsResponse defines model for GetIdentityDetailsResponse.
 type GetIdentityDetailsResponse struct {
-	Address    *string        `json:"address,omitempty"`
-	Balance    *string        `json:"balance,omitempty"`
-	Identifier *string        `json:"identifier,omitempty"`
-	State      *IdentityState `json:"state,omitempty"`
+	Address    *string `json:"address,omitempty"`
+	Balance    *string `json:"balance,omitempty"`
+	Identifier *string `json:"identifier,omitempty"`
+
+	// ServerUrl Public url the agent of the identity is reached at, if it is not the one of the node
+	ServerUrl *string        `json:"serverUrl,omitempty"`
+	State     *IdentityState `json:"state,omitempty"`
 }
 
 // Health defines model for Health.
 type Health map[string]bool
 
+// IdentityServerURL defines model for IdentityServerURL.
+type IdentityServerURL struct {
+	// ServerUrl Public url the agent of the identity is reached at, i.e. https://acme.issuer.example.com or
+	// https://issuer.example.com/acme. Leave it empty to use the one of the node.
+	ServerUrl *string `json:"serverUrl,omitempty"`
+}
+
 // IdentityState defines model for IdentityState.
 type IdentityState struct {
 	BlockNumber        *int    `json:"blockNumber,omitempty"`
@@ -184,6 +243,24 @@ type IdentityState struct {
 	TxID               *string `json:"txID,omitempty"`
 }
 
+// IssuerUsage defines model for IssuerUsage.
+type IssuerUsage struct {
+	CredentialsIssued int    `json:"credentialsIssued"`
+	GasUsed           int64  `json:"gasUsed"`
+	Issuer            string `json:"issuer"`
+
+	// StateTransitions State transitions confirmed on chain
+	StateTransitions int        `json:"stateTransitions"`
+	TenantID         *uuid.UUID `json:"tenantID,omitempty"`
+	TenantName       *string    `json:"tenantName,omitempty"`
+
+	// TxFee Fees paid by the state transitions, in wei
+	TxFee string `json:"txFee"`
+
+	// Verifications Holder authentications with the issuer
+	Verifications int `json:"verifications"`
+}
+
 // KeyValue defines model for KeyValue.
 type KeyValue struct {
 	Key   string `json:"key"`
@@ -199,6 +276,21 @@ type PublishIdentityStateResponse struct {
 	TxID               *string `json:"txID,omitempty"`
 }
 
+// QRBranding defines model for QRBranding.
+type QRBranding struct {
+	BackgroundColor string `json:"backgroundColor"`
+
+	// ErrorCorrection QR error correction level. Use Q or H with big logos.
+	ErrorCorrection QRBrandingErrorCorrection `json:"errorCorrection"`
+	ForegroundColor string                    `json:"foregroundColor"`
+
+	// Logo Base64 encoded png or jpeg image embedded in the center of the QR code. Up to 256KB.
+	Logo *[]byte `json:"logo,omitempty"`
+}
+
+// QRBrandingErrorCorrection QR error correction level. Use Q or H with big logos.
+type QRBrandingErrorCorrection string
+
 // RefreshService defines model for RefreshService.
 type RefreshService struct {
 	Id   string             `json:"id"`
@@ -210,7 +302,10 @@ type RefreshServiceType string
 
 // RevocationStatusResponse defines model for RevocationStatusResponse.
 type RevocationStatusResponse struct {
-	Issuer struct {
+	// ExpirationStatus valid, expired_grace or expired. expired_grace means the credential is expired but within the grace
+	// period of the issuer, so verifiers can still accept it while it is renewed. Missing for unknown nonces.
+	ExpirationStatus *string `json:"expirationStatus,omitempty"`
+	Issuer           struct {
 		ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
 		RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
 		RootOfRoots        *string `json:"rootOfRoots,omitempty"`
@@ -231,9 +326,23 @@ type RevokeClaimResponse struct {
 	Message string `json:"message"`
 }
 
+// Tenant defines model for Tenant.
+type Tenant struct {
+	CreatedAt TimeUTC   `json:"createdAt"`
+	Id        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+}
+
 // TimeUTC defines model for TimeUTC.
 type TimeUTC = timeapi.Time
 
+// UsageReport defines model for UsageReport.
+type UsageReport struct {
+	From    openapi_types.Date `json:"from"`
+	Issuers []IssuerUsage      `json:"issuers"`
+	To      openapi_types.Date `json:"to"`
+}
+
 // PathClaim defines model for pathClaim.
 type PathClaim = string
 
@@ -243,6 +352,9 @@ type PathIdentifier = string
 // PathNonce defines model for pathNonce.
 type PathNonce = int64
 
+// PathTenant defines model for pathTenant.
+type PathTenant = uuid.UUID
+
 // N400 defines model for 400.
 type N400 = GenericErrorMessage
 
@@ -279,6 +391,35 @@ type GetQrFromStoreParams struct {
 	Id *uuid.UUID `form:"id,omitempty" json:"id,omitempty"`
 }
 
+// GetQrImageFromStoreParams defines parameters for GetQrImageFromStore.
+type GetQrImageFromStoreParams struct {
+	Id uuid.UUID `form:"id" json:"id"`
+
+	// Size Image size in pixels. Between 128 and 2048, 512 by default.
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetUsageParams defines parameters for GetUsage.
+type GetUsageParams struct {
+	// From First day of the report (UTC). Defaults to the first day of the current month
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the report (UTC). Defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// TenantID Only the issuers of this tenant. Ignored in tenant requests
+	TenantID *uuid.UUID `form:"tenantID,omitempty" json:"tenantID,omitempty"`
+
+	// Issuer Only this issuer
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty"`
+
+	// Format Report format. Defaults to json
+	Format *GetUsageParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsageParamsFormat defines parameters for GetUsage.
+type GetUsageParamsFormat string
+
 // GetClaimsParams defines parameters for GetClaims.
 type GetClaimsParams struct {
 	// SchemaType Filter per schema type. Example - KYCAgeCredential
@@ -296,11 +437,15 @@ type GetClaimsParams struct {
 	// Self Filter per retrieve claims of the provided identifier. Example - true
 	Self *bool `form:"self,omitempty" json:"self,omitempty"`
 
-	// QueryField Filter this field inside the data of the claim
+	// QueryField Filter this field inside the data of the claim. When the credentials data is encrypted the field is matched
+	// in memory, and the other filters must narrow the claims to 1000 at most, otherwise it answers 400.
 	QueryField *string `form:"query_field,omitempty" json:"query_field,omitempty"`
 
 	// QueryValue Filter this value inside the data of the claim for the specified field in query_field
 	QueryValue *string `form:"query_value,omitempty" json:"query_value,omitempty"`
+
+	// ExternalId Return only the claims issued with this external id
+	ExternalId *string `form:"externalId,omitempty" json:"externalId,omitempty"`
 }
 
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
@@ -309,9 +454,18 @@ type AgentTextRequestBody = AgentTextBody
 // CreateIdentityJSONRequestBody defines body for CreateIdentity for application/json ContentType.
 type CreateIdentityJSONRequestBody = CreateIdentityRequest
 
+// CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
+type CreateTenantJSONRequestBody = CreateTenantRequest
+
 // CreateClaimJSONRequestBody defines body for CreateClaim for application/json ContentType.
 type CreateClaimJSONRequestBody = CreateClaimRequest
 
+// UpdateQRBrandingJSONRequestBody defines body for UpdateQRBranding for application/json ContentType.
+type UpdateQRBrandingJSONRequestBody = QRBranding
+
+// UpdateIdentityServerURLJSONRequestBody defines body for UpdateIdentityServerURL for application/json ContentType.
+type UpdateIdentityServerURLJSONRequestBody = IdentityServerURL
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the documentation
@@ -332,6 +486,9 @@ type ServerInterface interface {
 	// Agent
 	// (POST /v1/agent)
 	Agent(w http.ResponseWriter, r *http.Request)
+	// Credential Metadata
+	// (GET /v1/credentials/{id})
+	GetCredentialMetadata(w http.ResponseWriter, r *http.Request, id PathClaim)
 	// Get Identities
 	// (GET /v1/identities)
 	GetIdentities(w http.ResponseWriter, r *http.Request)
@@ -344,6 +501,21 @@ type ServerInterface interface {
 	// QrCode body
 	// (GET /v1/qr-store)
 	GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams)
+	// QrCode image
+	// (GET /v1/qr-store/image)
+	GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams)
+	// Get Tenants
+	// (GET /v1/tenants)
+	GetTenants(w http.ResponseWriter, r *http.Request)
+	// Create Tenant
+	// (POST /v1/tenants)
+	CreateTenant(w http.ResponseWriter, r *http.Request)
+	// Delete Tenant
+	// (DELETE /v1/tenants/{id})
+	DeleteTenant(w http.ResponseWriter, r *http.Request, id PathTenant)
+	// Get Usage Report
+	// (GET /v1/usage)
+	GetUsage(w http.ResponseWriter, r *http.Request, params GetUsageParams)
 	// Get Claims
 	// (GET /v1/{identifier}/claims)
 	GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams)
@@ -362,6 +534,15 @@ type ServerInterface interface {
 	// Get Claim QR code
 	// (GET /v1/{identifier}/claims/{id}/qrcode)
 	GetClaimQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	// Get QR branding
+	// (GET /v1/{identifier}/qr-branding)
+	GetQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Update QR branding
+	// (PUT /v1/{identifier}/qr-branding)
+	UpdateQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Update the server url of the identity
+	// (PUT /v1/{identifier}/server-url)
+	UpdateIdentityServerURL(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 	// Publish Identity State
 	// (POST /v1/{identifier}/state/publish)
 	PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
@@ -410,6 +591,12 @@ func (_ Unimplemented) Agent(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Credential Metadata
+// (GET /v1/credentials/{id})
+func (_ Unimplemented) GetCredentialMetadata(w http.ResponseWriter, r *http.Request, id PathClaim) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Identities
 // (GET /v1/identities)
 func (_ Unimplemented) GetIdentities(w http.ResponseWriter, r *http.Request) {
@@ -434,6 +621,36 @@ func (_ Unimplemented) GetQrFromStore(w http.ResponseWriter, r *http.Request, pa
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// QrCode image
+// (GET /v1/qr-store/image)
+func (_ Unimplemented) GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Tenants
+// (GET /v1/tenants)
+func (_ Unimplemented) GetTenants(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Tenant
+// (POST /v1/tenants)
+func (_ Unimplemented) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete Tenant
+// (DELETE /v1/tenants/{id})
+func (_ Unimplemented) DeleteTenant(w http.ResponseWriter, r *http.Request, id PathTenant) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Usage Report
+// (GET /v1/usage)
+func (_ Unimplemented) GetUsage(w http.ResponseWriter, r *http.Request, params GetUsageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Claims
 // (GET /v1/{identifier}/claims)
 func (_ Unimplemented) GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams) {
@@ -470,6 +687,24 @@ func (_ Unimplemented) GetClaimQrCode(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get QR branding
+// (GET /v1/{identifier}/qr-branding)
+func (_ Unimplemented) GetQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update QR branding
+// (PUT /v1/{identifier}/qr-branding)
+func (_ Unimplemented) UpdateQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the server url of the identity
+// (PUT /v1/{identifier}/server-url)
+func (_ Unimplemented) UpdateIdentityServerURL(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Publish Identity State
 // (POST /v1/{identifier}/state/publish)
 func (_ Unimplemented) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
@@ -583,6 +818,32 @@ func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetCredentialMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialMetadata(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetIdentities operation middleware
 func (siw *ServerInterfaceWrapper) GetIdentities(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -673,6 +934,173 @@ func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetQrImageFromStore operation middleware
+func (siw *ServerInterfaceWrapper) GetQrImageFromStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQrImageFromStoreParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQrImageFromStore(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTenants operation middleware
+func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenants(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateTenant operation middleware
+func (siw *ServerInterfaceWrapper) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTenant(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTenant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathTenant
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTenant(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsageParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tenantID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenantID", r.URL.Query(), &params.TenantID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "issuer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "issuer", r.URL.Query(), &params.Issuer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetClaims operation middleware
 func (siw *ServerInterfaceWrapper) GetClaims(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -749,6 +1177,14 @@ func (siw *ServerInterfaceWrapper) GetClaims(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "externalId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "externalId", r.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "externalId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetClaims(w, r, identifier, params)
 	}))
@@ -934,8 +1370,8 @@ func (siw *ServerInterfaceWrapper) GetClaimQrCode(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PublishIdentityState operation middleware
-func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+// GetQRBranding operation middleware
+func (siw *ServerInterfaceWrapper) GetQRBranding(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -952,7 +1388,7 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishIdentityState(w, r, identifier)
+		siw.Handler.GetQRBranding(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -962,8 +1398,8 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RetryPublishState operation middleware
-func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+// UpdateQRBranding operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQRBranding(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -980,7 +1416,7 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryPublishState(w, r, identifier)
+		siw.Handler.UpdateQRBranding(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -990,23 +1426,107 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UpdateIdentityServerURL operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIdentityServerURL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateIdentityServerURL(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PublishIdentityState operation middleware
+func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishIdentityState(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RetryPublishState operation middleware
+func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPublishState(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
 
 func (e *UnmarshalingParamError) Error() string {
 	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
@@ -1121,6 +1641,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}", wrapper.GetCredentialMetadata)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/identities", wrapper.GetIdentities)
 	})
@@ -1133,6 +1656,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/qr-store", wrapper.GetQrFromStore)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/qr-store/image", wrapper.GetQrImageFromStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tenants", wrapper.GetTenants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tenants", wrapper.CreateTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/tenants/{id}", wrapper.DeleteTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/usage", wrapper.GetUsage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/{identifier}/claims", wrapper.GetClaims)
 	})
@@ -1151,6 +1689,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}/qrcode", wrapper.GetClaimQrCode)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/qr-branding", wrapper.GetQRBranding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/{identifier}/qr-branding", wrapper.UpdateQRBranding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/{identifier}/server-url", wrapper.UpdateIdentityServerURL)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/{identifier}/state/publish", wrapper.PublishIdentityState)
 	})
@@ -1311,6 +1858,50 @@ func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) e
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCredentialMetadataRequestObject struct {
+	Id PathClaim `json:"id"`
+}
+
+type GetCredentialMetadataResponseObject interface {
+	VisitGetCredentialMetadataResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialMetadata200JSONResponse CredentialMetadata
+
+func (response GetCredentialMetadata200JSONResponse) VisitGetCredentialMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialMetadata400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialMetadata400JSONResponse) VisitGetCredentialMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialMetadata404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialMetadata404JSONResponse) VisitGetCredentialMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialMetadata500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialMetadata500JSONResponse) VisitGetCredentialMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetIdentitiesRequestObject struct {
 }
 
@@ -1486,6 +2077,263 @@ func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetQrImageFromStoreRequestObject struct {
+	Params GetQrImageFromStoreParams
+}
+
+type GetQrImageFromStoreResponseObject interface {
+	VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error
+}
+
+type GetQrImageFromStore200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetQrImageFromStore200ImagepngResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQrImageFromStore400JSONResponse struct{ N400JSONResponse }
+
+func (response GetQrImageFromStore400JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrImageFromStore404JSONResponse struct{ N404JSONResponse }
+
+func (response GetQrImageFromStore404JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrImageFromStore500JSONResponse struct{ N500JSONResponse }
+
+func (response GetQrImageFromStore500JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantsRequestObject struct {
+}
+
+type GetTenantsResponseObject interface {
+	VisitGetTenantsResponse(w http.ResponseWriter) error
+}
+
+type GetTenants200JSONResponse []Tenant
+
+func (response GetTenants200JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenants401JSONResponse struct{ N401JSONResponse }
+
+func (response GetTenants401JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenants500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTenants500JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenantRequestObject struct {
+	Body *CreateTenantJSONRequestBody
+}
+
+type CreateTenantResponseObject interface {
+	VisitCreateTenantResponse(w http.ResponseWriter) error
+}
+
+type CreateTenant201JSONResponse CreateTenantResponse
+
+func (response CreateTenant201JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateTenant400JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateTenant401JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateTenant409JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateTenant500JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenantRequestObject struct {
+	Id PathTenant `json:"id"`
+}
+
+type DeleteTenantResponseObject interface {
+	VisitDeleteTenantResponse(w http.ResponseWriter) error
+}
+
+type DeleteTenant200JSONResponse GenericErrorMessage
+
+func (response DeleteTenant200JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteTenant400JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteTenant401JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteTenant404JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteTenant500JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageRequestObject struct {
+	Params GetUsageParams
+}
+
+type GetUsageResponseObject interface {
+	VisitGetUsageResponse(w http.ResponseWriter) error
+}
+
+type GetUsage200JSONResponse UsageReport
+
+func (response GetUsage200JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsage200TextcsvResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsage400JSONResponse struct{ N400JSONResponse }
+
+func (response GetUsage400JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage401JSONResponse struct{ N401JSONResponse }
+
+func (response GetUsage401JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage500JSONResponse struct{ N500JSONResponse }
+
+func (response GetUsage500JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetClaimsRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
 	Params     GetClaimsParams
@@ -1607,176 +2455,328 @@ type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
 
 func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaimRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type RevokeClaimResponseObject interface {
+	VisitRevokeClaimResponse(w http.ResponseWriter) error
+}
+
+type RevokeClaim202JSONResponse RevokeClaimResponse
+
+func (response RevokeClaim202JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeClaim400JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeClaim401JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeClaim404JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeClaim500JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type GetClaimResponseObject interface {
+	VisitGetClaimResponse(w http.ResponseWriter) error
+}
+
+type GetClaim200JSONResponse GetClaimResponse
+
+func (response GetClaim200JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaim400JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim401JSONResponse struct{ N401JSONResponse }
+
+func (response GetClaim401JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaim404JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaim500JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCodeRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type GetClaimQrCodeResponseObject interface {
+	VisitGetClaimQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetClaimQrCode200JSONResponse GetClaimQrCodeResponse
+
+func (response GetClaimQrCode200JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaimQrCode400JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaimQrCode404JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode409JSONResponse struct{ N409JSONResponse }
+
+func (response GetClaimQrCode409JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+type GetClaimQrCode500JSONResponse struct{ N500JSONResponse }
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+func (response GetClaimQrCode500JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeClaimRequestObject struct {
+type GetQRBrandingRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
 }
 
-type RevokeClaimResponseObject interface {
-	VisitRevokeClaimResponse(w http.ResponseWriter) error
+type GetQRBrandingResponseObject interface {
+	VisitGetQRBrandingResponse(w http.ResponseWriter) error
 }
 
-type RevokeClaim202JSONResponse RevokeClaimResponse
+type GetQRBranding200JSONResponse QRBranding
 
-func (response RevokeClaim202JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+func (response GetQRBranding200JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeClaim400JSONResponse struct{ N400JSONResponse }
+type GetQRBranding400JSONResponse struct{ N400JSONResponse }
 
-func (response RevokeClaim400JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+func (response GetQRBranding400JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeClaim401JSONResponse struct{ N401JSONResponse }
+type GetQRBranding401JSONResponse struct{ N401JSONResponse }
 
-func (response RevokeClaim401JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+func (response GetQRBranding401JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeClaim404JSONResponse struct{ N404JSONResponse }
-
-func (response RevokeClaim404JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type RevokeClaim500JSONResponse struct{ N500JSONResponse }
+type GetQRBranding500JSONResponse struct{ N500JSONResponse }
 
-func (response RevokeClaim500JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+func (response GetQRBranding500JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimRequestObject struct {
+type UpdateQRBrandingRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
+	Body       *UpdateQRBrandingJSONRequestBody
 }
 
-type GetClaimResponseObject interface {
-	VisitGetClaimResponse(w http.ResponseWriter) error
+type UpdateQRBrandingResponseObject interface {
+	VisitUpdateQRBrandingResponse(w http.ResponseWriter) error
 }
 
-type GetClaim200JSONResponse GetClaimResponse
+type UpdateQRBranding200JSONResponse QRBranding
 
-func (response GetClaim200JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+func (response UpdateQRBranding200JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaim400JSONResponse struct{ N400JSONResponse }
+type UpdateQRBranding400JSONResponse struct{ N400JSONResponse }
 
-func (response GetClaim400JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+func (response UpdateQRBranding400JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaim401JSONResponse struct{ N401JSONResponse }
+type UpdateQRBranding401JSONResponse struct{ N401JSONResponse }
 
-func (response GetClaim401JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+func (response UpdateQRBranding401JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaim404JSONResponse struct{ N404JSONResponse }
-
-func (response GetClaim404JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetClaim500JSONResponse struct{ N500JSONResponse }
+type UpdateQRBranding500JSONResponse struct{ N500JSONResponse }
 
-func (response GetClaim500JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+func (response UpdateQRBranding500JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimQrCodeRequestObject struct {
+type UpdateIdentityServerURLRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
+	Body       *UpdateIdentityServerURLJSONRequestBody
 }
 
-type GetClaimQrCodeResponseObject interface {
-	VisitGetClaimQrCodeResponse(w http.ResponseWriter) error
+type UpdateIdentityServerURLResponseObject interface {
+	VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error
 }
 
-type GetClaimQrCode200JSONResponse GetClaimQrCodeResponse
+type UpdateIdentityServerURL200JSONResponse IdentityServerURL
 
-func (response GetClaimQrCode200JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+func (response UpdateIdentityServerURL200JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimQrCode400JSONResponse struct{ N400JSONResponse }
+type UpdateIdentityServerURL400JSONResponse struct{ N400JSONResponse }
 
-func (response GetClaimQrCode400JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+func (response UpdateIdentityServerURL400JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimQrCode404JSONResponse struct{ N404JSONResponse }
+type UpdateIdentityServerURL401JSONResponse struct{ N401JSONResponse }
 
-func (response GetClaimQrCode404JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+func (response UpdateIdentityServerURL401JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentityServerURL404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateIdentityServerURL404JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimQrCode409JSONResponse struct{ N409JSONResponse }
+type UpdateIdentityServerURL409JSONResponse struct{ N409JSONResponse }
 
-func (response GetClaimQrCode409JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+func (response UpdateIdentityServerURL409JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClaimQrCode500JSONResponse struct{ N500JSONResponse }
+type UpdateIdentityServerURL500JSONResponse struct{ N500JSONResponse }
 
-func (response GetClaimQrCode500JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+func (response UpdateIdentityServerURL500JSONResponse) VisitUpdateIdentityServerURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -1891,6 +2891,9 @@ type StrictServerInterface interface {
 	// Agent
 	// (POST /v1/agent)
 	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
+	// Credential Metadata
+	// (GET /v1/credentials/{id})
+	GetCredentialMetadata(ctx context.Context, request GetCredentialMetadataRequestObject) (GetCredentialMetadataResponseObject, error)
 	// Get Identities
 	// (GET /v1/identities)
 	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
@@ -1903,6 +2906,21 @@ type StrictServerInterface interface {
 	// QrCode body
 	// (GET /v1/qr-store)
 	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
+	// QrCode image
+	// (GET /v1/qr-store/image)
+	GetQrImageFromStore(ctx context.Context, request GetQrImageFromStoreRequestObject) (GetQrImageFromStoreResponseObject, error)
+	// Get Tenants
+	// (GET /v1/tenants)
+	GetTenants(ctx context.Context, request GetTenantsRequestObject) (GetTenantsResponseObject, error)
+	// Create Tenant
+	// (POST /v1/tenants)
+	CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error)
+	// Delete Tenant
+	// (DELETE /v1/tenants/{id})
+	DeleteTenant(ctx context.Context, request DeleteTenantRequestObject) (DeleteTenantResponseObject, error)
+	// Get Usage Report
+	// (GET /v1/usage)
+	GetUsage(ctx context.Context, request GetUsageRequestObject) (GetUsageResponseObject, error)
 	// Get Claims
 	// (GET /v1/{identifier}/claims)
 	GetClaims(ctx context.Context, request GetClaimsRequestObject) (GetClaimsResponseObject, error)
@@ -1921,6 +2939,15 @@ type StrictServerInterface interface {
 	// Get Claim QR code
 	// (GET /v1/{identifier}/claims/{id}/qrcode)
 	GetClaimQrCode(ctx context.Context, request GetClaimQrCodeRequestObject) (GetClaimQrCodeResponseObject, error)
+	// Get QR branding
+	// (GET /v1/{identifier}/qr-branding)
+	GetQRBranding(ctx context.Context, request GetQRBrandingRequestObject) (GetQRBrandingResponseObject, error)
+	// Update QR branding
+	// (PUT /v1/{identifier}/qr-branding)
+	UpdateQRBranding(ctx context.Context, request UpdateQRBrandingRequestObject) (UpdateQRBrandingResponseObject, error)
+	// Update the server url of the identity
+	// (PUT /v1/{identifier}/server-url)
+	UpdateIdentityServerURL(ctx context.Context, request UpdateIdentityServerURLRequestObject) (UpdateIdentityServerURLResponseObject, error)
 	// Publish Identity State
 	// (POST /v1/{identifier}/state/publish)
 	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
@@ -2110,6 +3137,32 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetCredentialMetadata operation middleware
+func (sh *strictHandler) GetCredentialMetadata(w http.ResponseWriter, r *http.Request, id PathClaim) {
+	var request GetCredentialMetadataRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialMetadata(ctx, request.(GetCredentialMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialMetadata")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialMetadataResponseObject); ok {
+		if err := validResponse.VisitGetCredentialMetadataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetIdentities operation middleware
 func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
 	var request GetIdentitiesRequestObject
@@ -2217,6 +3270,139 @@ func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetQrImageFromStore operation middleware
+func (sh *strictHandler) GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams) {
+	var request GetQrImageFromStoreRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQrImageFromStore(ctx, request.(GetQrImageFromStoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQrImageFromStore")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQrImageFromStoreResponseObject); ok {
+		if err := validResponse.VisitGetQrImageFromStoreResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTenants operation middleware
+func (sh *strictHandler) GetTenants(w http.ResponseWriter, r *http.Request) {
+	var request GetTenantsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTenants(ctx, request.(GetTenantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTenants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTenantsResponseObject); ok {
+		if err := validResponse.VisitGetTenantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTenant operation middleware
+func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	var request CreateTenantRequestObject
+
+	var body CreateTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTenant(ctx, request.(CreateTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTenantResponseObject); ok {
+		if err := validResponse.VisitCreateTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTenant operation middleware
+func (sh *strictHandler) DeleteTenant(w http.ResponseWriter, r *http.Request, id PathTenant) {
+	var request DeleteTenantRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTenant(ctx, request.(DeleteTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTenantResponseObject); ok {
+		if err := validResponse.VisitDeleteTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsage operation middleware
+func (sh *strictHandler) GetUsage(w http.ResponseWriter, r *http.Request, params GetUsageParams) {
+	var request GetUsageRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsage(ctx, request.(GetUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsageResponseObject); ok {
+		if err := validResponse.VisitGetUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetClaims operation middleware
 func (sh *strictHandler) GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams) {
 	var request GetClaimsRequestObject
@@ -2385,6 +3571,98 @@ func (sh *strictHandler) GetClaimQrCode(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetQRBranding operation middleware
+func (sh *strictHandler) GetQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetQRBrandingRequestObject
+
+	request.Identifier = identifier
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQRBranding(ctx, request.(GetQRBrandingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQRBranding")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQRBrandingResponseObject); ok {
+		if err := validResponse.VisitGetQRBrandingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateQRBranding operation middleware
+func (sh *strictHandler) UpdateQRBranding(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request UpdateQRBrandingRequestObject
+
+	request.Identifier = identifier
+
+	var body UpdateQRBrandingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateQRBranding(ctx, request.(UpdateQRBrandingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateQRBranding")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateQRBrandingResponseObject); ok {
+		if err := validResponse.VisitUpdateQRBrandingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateIdentityServerURL operation middleware
+func (sh *strictHandler) UpdateIdentityServerURL(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request UpdateIdentityServerURLRequestObject
+
+	request.Identifier = identifier
+
+	var body UpdateIdentityServerURLJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateIdentityServerURL(ctx, request.(UpdateIdentityServerURLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateIdentityServerURL")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateIdentityServerURLResponseObject); ok {
+		if err := validResponse.VisitUpdateIdentityServerURLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PublishIdentityState operation middleware
 func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
 	var request PublishIdentityStateRequestObject
@@ -127,7 +127,7 @@ func middlewares(ctx context.Context) []StrictMiddlewareFunc {
 	usr, pass := authOk()
 	return []StrictMiddlewareFunc{
 		LogMiddleware(ctx),
-		BasicAuthMiddleware(ctx, usr, pass),
+		BasicAuthMiddleware(ctx, usr, pass, nil),
 	}
 }
 
@@ -178,6 +178,19 @@ func (kpm *KMSMock) LinkToIdentity(ctx context.Context, keyID kms.KeyID, identit
 	return key, nil
 }
 
+func (kpm *KMSMock) HasCustody(kt kms.KeyType) bool {
+	return false
+}
+
+func (kpm *KMSMock) CreateCustodyKey(kt kms.KeyType, identity *w3c.DID) (kms.KeyID, error) {
+	var key kms.KeyID
+	return key, nil
+}
+
+func (kpm *KMSMock) RetireKey(ctx context.Context, keyID kms.KeyID) error {
+	return nil
+}
+
 // TODO: add package manager mocks
 func NewPackageManagerMock() *iden3comm.PackageManager {
 	return &iden3comm.PackageManager{}
@@ -6,10 +6,17 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/mtls"
 )
 
 // LogMiddleware returns a middleware that adds general log configuration to each context request
@@ -19,7 +26,14 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 			if reqID := middleware.GetReqID(ctxReq); reqID != "" {
 				log.With("req-id", reqID)
 			}
-			return f(ctx, w, r, args)
+			ctxF := forwarded.WithRequestBaseURL(ctx, r)
+			if tenantID := tenantFromContext(ctxReq); tenantID != nil {
+				ctxF = withTenant(ctxF, *tenantID)
+			}
+			if issuerDID := issuerFromContext(r.Context()); issuerDID != nil {
+				ctxF = withIssuer(ctxF, *issuerDID)
+			}
+			return f(ctxF, w, r, args)
 		}
 	}
 }
@@ -28,7 +42,9 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 // basic auth in the api spec.
 // In uses the BasicAuthScopes value in context to figure if and endpoint needs authorization or not, because this
 // value is injected automatically by openapi when basic auth is selected
-func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
+// If tenantService is not nil, tenants can also authenticate using their id as user and their API key as password.
+// Tenant requests are restricted to the identities owned by the tenant.
+func BasicAuthMiddleware(ctx context.Context, user, pass string, tenantService ports.TenantService) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
 			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
@@ -37,10 +53,64 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
 				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
-					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+					if tenantService == nil {
+						return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+					}
+					tenantID, err := authenticateTenant(ctxReq, r, tenantService, operationID, userReq, passReq)
+					if err != nil {
+						return nil, err
+					}
+					return f(withTenant(ctx, tenantID), w, r, args)
 				}
 			}
 			return f(ctx, w, r, args)
 		}
 	}
 }
+
+func authenticateTenant(ctx context.Context, r *http.Request, tenantService ports.TenantService, operationID string, userReq, passReq string) (uuid.UUID, error) {
+	tenantID, err := uuid.Parse(userReq)
+	if err != nil {
+		return uuid.Nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+	}
+	tenant, err := tenantService.Authenticate(ctx, tenantID, passReq)
+	if err != nil {
+		log.Warn(ctx, "tenant authentication failed", "tenant", tenantID, "err", err)
+		return uuid.Nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+	}
+	if _, ok := adminOnlyOperations[operationID]; ok {
+		return uuid.Nil, apiErrors.ForbiddenError{Err: errors.New("forbidden")}
+	}
+	if identifier := chi.URLParam(r, "identifier"); identifier != "" {
+		did, err := w3c.ParseDID(identifier)
+		if err != nil {
+			return uuid.Nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+		}
+		owns, err := tenantService.OwnsIdentity(ctx, tenant.ID, *did)
+		if err != nil {
+			log.Error(ctx, "checking tenant identity ownership", "err", err)
+			return uuid.Nil, err
+		}
+		if !owns {
+			return uuid.Nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+		}
+	}
+	return tenant.ID, nil
+}
+
+// ClientCertMiddleware returns a middleware that requires a client certificate, validated against the configured CA
+// bundle and subject allowlist, in the endpoints configured with basic auth in the api spec. Public endpoints are
+// served on standard TLS. It does nothing if mutual TLS is not enabled.
+func ClientCertMiddleware(cfg config.TLS) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if ctxReq.Value(BasicAuthScopes) != nil && cfg.MutualTLSEnabled() {
+				if err := mtls.Verify(r, cfg.ClientAllowedSubjects); err != nil {
+					log.Warn(ctxReq, "client certificate rejected", "operation", operationID, "err", err)
+					return nil, apiErrors.AuthError{Err: err}
+				}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+// PublicRoutes are the endpoints that are not protected with basic auth, used by holders, wallets and verifiers.
+// They get the public CORS and security headers configuration.
+var PublicRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/"},
+	{Method: http.MethodGet, Pattern: "/favicon.ico"},
+	{Method: http.MethodGet, Pattern: "/static/*"},
+	{Method: http.MethodGet, Pattern: "/status"},
+	{Method: http.MethodGet, Pattern: "/v1/{identifier}/claims/revocation/status/{nonce}"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/{id}"},
+	{Method: http.MethodPost, Pattern: "/v1/agent"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store/image"},
+	{Method: http.MethodGet, Pattern: "/.well-known/jwks.json"},
+}
+
+// DocumentRoutes are the public endpoints that serve immutable documents, like the QR bodies. They get the documents
+// cache headers.
+var DocumentRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/favicon.ico"},
+	{Method: http.MethodGet, Pattern: "/static/*"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store/image"},
+}
+
+// StatusRoutes are the public endpoints that serve the revocation statuses. They get the statuses cache headers.
+var StatusRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/v1/{identifier}/claims/revocation/status/{nonce}"},
+}
+
+// SignedRoutes are the public endpoints whose responses are signed when the response signing is configured, the
+// revocation statuses and the credentials
+var SignedRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/v1/{identifier}/claims/revocation/status/{nonce}"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/{id}"},
+}
+
+// MessageRoutes are the endpoints that accept packed messages from wallets. They get the messages limits.
+var MessageRoutes = []httpsecurity.Route{
+	{Method: http.MethodPost, Pattern: "/v1/agent"},
+}
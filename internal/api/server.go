@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -18,43 +19,52 @@ import (
 	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/tsclient"
 	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
 
 // Server implements StrictServerInterface and holds the implementation of all API controllers
 // This is the glue to the API autogenerated code
 type Server struct {
-	cfg              *config.Configuration
-	identityService  ports.IdentityService
-	claimService     ports.ClaimsService
-	qrService        ports.QrStoreService
-	publisherGateway ports.Publisher
-	packageManager   *iden3comm.PackageManager
-	health           *health.Status
-	accountService   ports.AccountService
+	cfg               *config.Configuration
+	identityService   ports.IdentityService
+	claimService      ports.ClaimsService
+	qrService         ports.QrStoreService
+	publisherGateway  ports.Publisher
+	packageManager    *iden3comm.PackageManager
+	health            *health.Status
+	accountService    ports.AccountService
+	tenantService     ports.TenantService
+	qrBrandingService ports.QRBrandingService
+	usageService      ports.UsageService
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, claimsService ports.ClaimsService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, claimsService ports.ClaimsService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status, tenantService ports.TenantService, qrBrandingService ports.QRBrandingService, usageService ports.UsageService) *Server {
 	return &Server{
-		cfg:              cfg,
-		identityService:  identityService,
-		claimService:     claimsService,
-		qrService:        qrService,
-		publisherGateway: publisherGateway,
-		packageManager:   packageManager,
-		health:           health,
-		accountService:   accountService,
+		cfg:               cfg,
+		identityService:   identityService,
+		claimService:      claimsService,
+		qrService:         qrService,
+		publisherGateway:  publisherGateway,
+		packageManager:    packageManager,
+		health:            health,
+		accountService:    accountService,
+		tenantService:     tenantService,
+		qrBrandingService: qrBrandingService,
+		usageService:      usageService,
 	}
 }
 
@@ -101,9 +111,11 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		Blockchain:              core.Blockchain(blockchain),
 		KeyType:                 kms.KeyType(keyType),
 		AuthBJJCredentialStatus: s.cfg.CredentialStatus.CredentialStatusType,
+		TenantID:                tenantFromContext(ctx),
+		ServerURL:               request.Body.ServerUrl,
 	})
 	if err != nil {
-		if errors.Is(err, services.ErrWrongDIDMetada) {
+		if errors.Is(err, services.ErrWrongDIDMetada) || errors.Is(err, services.ErrInvalidServerURL) || errors.Is(err, services.ErrServerURLInUse) {
 			return CreateIdentity400JSONResponse{
 				N400JSONResponse{
 					Message: err.Error(),
@@ -180,6 +192,10 @@ func (s *Server) CreateClaim(ctx context.Context, request CreateClaimRequestObje
 
 	req := ports.NewCreateClaimRequest(did, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, claimRequestProofs, nil, false, s.cfg.CredentialStatus.CredentialStatusType, toVerifiableRefreshService(request.Body.RefreshService), request.Body.RevNonce,
 		toVerifiableDisplayMethod(request.Body.DisplayMethod))
+	if request.Body.Force != nil {
+		req.Force = *request.Body.Force
+	}
+	req.ExternalID = request.Body.ExternalId
 
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
@@ -207,6 +223,9 @@ func (s *Server) CreateClaim(ctx context.Context, request CreateClaimRequestObje
 		if errors.Is(err, services.ErrAssigningMTPProof) {
 			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrExternalIDTooLong) {
+			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrUnsupportedRefreshServiceType) {
 			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
@@ -222,6 +241,16 @@ func (s *Server) CreateClaim(ctx context.Context, request CreateClaimRequestObje
 		if errors.Is(err, services.ErrUnsupportedDisplayMethodType) {
 			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrIssuanceLimitExceeded) || errors.Is(err, services.ErrIssuanceCooldown) || errors.Is(err, services.ErrPolicyDenied) ||
+			errors.Is(err, services.ErrCredentialExpirationExceeded) || errors.Is(err, services.ErrCredentialUniqueKeyTaken) {
+			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrDuplicateCredential) {
+			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationNonceCollision) || errors.Is(err, services.ErrRevocationNoncesExhausted) {
+			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		return CreateClaim500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 	return CreateClaim201JSONResponse{Id: resp.ID.String()}, nil
@@ -293,7 +322,16 @@ func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationS
 	}
 	response.Mtp.Siblings = &siblings
 
-	return response, err
+	expirationStatuses, err := s.claimService.GetExpirationStatuses(ctx, *issuerDID, []uint64{uint64(request.Nonce)})
+	if err != nil {
+		log.Error(ctx, "get expiration status", "err", err, "nonce", request.Nonce)
+		return response, nil
+	}
+	if status, ok := expirationStatuses[uint64(request.Nonce)]; ok {
+		response.ExpirationStatus = &status
+	}
+
+	return response, nil
 }
 
 // GetClaim is the controller to get a client.
@@ -332,6 +370,30 @@ func (s *Server) GetClaim(ctx context.Context, request GetClaimRequestObject) (G
 	return GetClaim200JSONResponse(toGetClaim200Response(w3c)), nil
 }
 
+// GetCredentialMetadata returns the public metadata of a credential, without the subject and the proofs
+func (s *Server) GetCredentialMetadata(ctx context.Context, request GetCredentialMetadataRequestObject) (GetCredentialMetadataResponseObject, error) {
+	clID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return GetCredentialMetadata400JSONResponse{N400JSONResponse{"invalid credential id"}}, nil
+	}
+
+	claim, err := s.claimService.GetPublicByID(ctx, clID)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialMetadata404JSONResponse{N404JSONResponse{"credential not found"}}, nil
+		}
+		log.Error(ctx, "loading credential metadata", "err", err, "id", request.Id)
+		return GetCredentialMetadata500JSONResponse{N500JSONResponse{"there was an error loading the credential"}}, nil
+	}
+
+	w3c, err := schema.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return GetCredentialMetadata500JSONResponse{N500JSONResponse{"invalid claim format"}}, nil
+	}
+
+	return GetCredentialMetadata200JSONResponse(toCredentialMetadataResponse(w3c, claim.Revoked)), nil
+}
+
 // GetClaims is the controller to get multiple claims of a determined identity
 func (s *Server) GetClaims(ctx context.Context, request GetClaimsRequestObject) (GetClaimsResponseObject, error) {
 	if request.Identifier == "" {
@@ -354,8 +416,14 @@ func (s *Server) GetClaims(ctx context.Context, request GetClaimsRequestObject)
 	if err != nil {
 		return GetClaims400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
+	if request.Params.ExternalId != nil {
+		filter.ExternalID = *request.Params.ExternalId
+	}
 
 	claims, _, err := s.claimService.GetAll(ctx, *did, filter)
+	if errors.Is(err, services.ErrQueryFieldNotSupported) {
+		return GetClaims400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
 	if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
 		return GetClaims500JSONResponse{N500JSONResponse{"there was an internal error trying to retrieve claims for the requested identifier"}}, nil
 	}
@@ -402,14 +470,20 @@ func (s *Server) GetClaimQrCode(ctx context.Context, request GetClaimQrCodeReque
 		return GetClaimQrCode409JSONResponse{N409JSONResponse{"State must be published before fetching MTP type credential"}}, nil
 	}
 
-	return toGetClaimQrCode200JSONResponse(claim, s.cfg.ServerUrl), nil
+	id := uuid.New()
+	s.claimService.RegisterOffer(ctx, id.String(), claim.ID)
+	return toGetClaimQrCode200JSONResponse(claim, s.identityService.ServerURL(ctx, *did, s.serverURL(ctx)), id), nil
 }
 
 // GetIdentities is the controller to get identities
 func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error) {
 	var response GetIdentities200JSONResponse
 	var err error
-	response, err = s.identityService.Get(ctx)
+	if tenantID := tenantFromContext(ctx); tenantID != nil {
+		response, err = s.identityService.GetByTenantID(ctx, *tenantID)
+	} else {
+		response, err = s.identityService.Get(ctx)
+	}
 	if err != nil {
 		return GetIdentities500JSONResponse{N500JSONResponse{
 			Message: err.Error(),
@@ -419,6 +493,64 @@ func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequest
 	return response, nil
 }
 
+// CreateTenant creates a new tenant and returns its API key. The API key cannot be retrieved later.
+func (s *Server) CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error) {
+	tenant, apiKey, err := s.tenantService.Create(ctx, request.Body.Name)
+	if err != nil {
+		if errors.Is(err, services.ErrTenantNameEmpty) {
+			return CreateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrTenantDuplicated) {
+			return CreateTenant409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating tenant", "err", err)
+		return CreateTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return CreateTenant201JSONResponse{
+		Id:        tenant.ID,
+		Name:      tenant.Name,
+		CreatedAt: TimeUTC(tenant.CreatedAt),
+		ApiKey:    apiKey,
+	}, nil
+}
+
+// GetTenants returns all the tenants
+func (s *Server) GetTenants(ctx context.Context, _ GetTenantsRequestObject) (GetTenantsResponseObject, error) {
+	tenants, err := s.tenantService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting tenants", "err", err)
+		return GetTenants500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := make(GetTenants200JSONResponse, len(tenants))
+	for i, tenant := range tenants {
+		response[i] = Tenant{
+			Id:        tenant.ID,
+			Name:      tenant.Name,
+			CreatedAt: TimeUTC(tenant.CreatedAt),
+		}
+	}
+
+	return response, nil
+}
+
+// DeleteTenant removes a tenant. Tenants that still own identities cannot be deleted.
+func (s *Server) DeleteTenant(ctx context.Context, request DeleteTenantRequestObject) (DeleteTenantResponseObject, error) {
+	if err := s.tenantService.Delete(ctx, request.Id); err != nil {
+		if errors.Is(err, services.ErrTenantNotFound) {
+			return DeleteTenant404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrTenantHasIdentities) {
+			return DeleteTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting tenant", "err", err)
+		return DeleteTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return DeleteTenant200JSONResponse{Message: "tenant deleted"}, nil
+}
+
 // Agent is the controller to fetch credentials from mobile
 func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
@@ -438,6 +570,11 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
 
+	if issuerDID := issuerFromContext(ctx); issuerDID != nil && (req.IssuerDID == nil || req.IssuerDID.String() != issuerDID.String()) {
+		log.Debug(ctx, "agent message not addressed to the identity of the server url", "to", req.IssuerDID, "issuer", issuerDID.String())
+		return Agent400JSONResponse{N400JSONResponse{"the message is not addressed to the issuer of this server url"}}, nil
+	}
+
 	agent, err := s.claimService.Agent(ctx, req, mediatype)
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
@@ -516,6 +653,91 @@ func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreReque
 	return NewQrContentResponse(body), nil
 }
 
+// GetQRBranding returns the options used to render the qr code images of the identity
+func (s *Server) GetQRBranding(ctx context.Context, request GetQRBrandingRequestObject) (GetQRBrandingResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetQRBranding400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	branding, err := s.qrBrandingService.Get(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting qr branding", "err", err)
+		return GetQRBranding500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetQRBranding200JSONResponse(qrBrandingResponse(branding)), nil
+}
+
+// UpdateQRBranding updates the options used to render the qr code images of the identity
+func (s *Server) UpdateQRBranding(ctx context.Context, request UpdateQRBrandingRequestObject) (UpdateQRBrandingResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return UpdateQRBranding400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	branding := &domain.QRBranding{
+		IssuerDID:       *did,
+		ForegroundColor: request.Body.ForegroundColor,
+		BackgroundColor: request.Body.BackgroundColor,
+		ErrorCorrection: domain.QRErrorCorrection(request.Body.ErrorCorrection),
+	}
+	if request.Body.Logo != nil {
+		branding.Logo = *request.Body.Logo
+	}
+	if err := s.qrBrandingService.Save(ctx, branding); err != nil {
+		if errors.Is(err, services.ErrQRBrandingInvalidColor) || errors.Is(err, services.ErrQRBrandingInvalidErrorCorrection) || errors.Is(err, services.ErrQRBrandingInvalidLogo) {
+			return UpdateQRBranding400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "saving qr branding", "err", err)
+		return UpdateQRBranding500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return UpdateQRBranding200JSONResponse(qrBrandingResponse(branding)), nil
+}
+
+// UpdateIdentityServerURL sets the public url the agent of the identity is reached at
+func (s *Server) UpdateIdentityServerURL(ctx context.Context, request UpdateIdentityServerURLRequestObject) (UpdateIdentityServerURLResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return UpdateIdentityServerURL400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	serverURL := request.Body.ServerUrl
+	if serverURL != nil && *serverURL == "" {
+		serverURL = nil
+	}
+	identity, err := s.identityService.UpdateServerURL(ctx, *did, serverURL)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidServerURL):
+			return UpdateIdentityServerURL400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityNotFound):
+			return UpdateIdentityServerURL404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrServerURLInUse):
+			return UpdateIdentityServerURL409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "updating identity server url", "err", err, "did", did.String())
+		return UpdateIdentityServerURL500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return UpdateIdentityServerURL200JSONResponse{ServerUrl: identity.ServerURL}, nil
+}
+
+// GetQrImageFromStore returns the png image of the qr code that links to a stored qr code body
+func (s *Server) GetQrImageFromStore(ctx context.Context, request GetQrImageFromStoreRequestObject) (GetQrImageFromStoreResponseObject, error) {
+	size := services.DefaultQRImageSize
+	if request.Params.Size != nil {
+		size = *request.Params.Size
+	}
+	img, err := s.qrBrandingService.RenderStoredQR(ctx, s.serverURL(ctx), request.Params.Id, size)
+	if err != nil {
+		if errors.Is(err, services.ErrQRImageInvalidSize) {
+			return GetQrImageFromStore400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrQRCodeLinkNotFound) {
+			return GetQrImageFromStore404JSONResponse{N404JSONResponse{"qr code not found"}}, nil
+		}
+		log.Error(ctx, "qr store. Rendering qr image", "err", err, "id", request.Params.Id)
+		return GetQrImageFromStore500JSONResponse{N500JSONResponse{"error rendering qr image"}}, nil
+	}
+	return GetQrImageFromStore200ImagepngResponse{Body: bytes.NewReader(img), ContentLength: int64(len(img))}, nil
+}
+
 // GetIdentityDetails is the controller to get identity details
 func (s *Server) GetIdentityDetails(ctx context.Context, request GetIdentityDetailsRequestObject) (GetIdentityDetailsResponseObject, error) {
 	userDID, err := w3c.ParseDID(request.Identifier)
@@ -577,16 +799,39 @@ func (s *Server) GetIdentityDetails(ctx context.Context, request GetIdentityDeta
 		response.Balance = common.ToPointer(identity.Balance.String())
 	}
 
+	response.ServerUrl = identity.ServerURL
+
 	return response, nil
 }
 
+// serverURL returns the base url of the links built for the request of the context. It is the url the request was
+// sent to when it comes from a trusted proxy or an allowed host, and the server url otherwise.
+func (s *Server) serverURL(ctx context.Context) string {
+	return forwarded.BaseURL(ctx, s.cfg.ServerUrl)
+}
+
 // RegisterStatic add method to the mux that are not documented in the API.
 func RegisterStatic(mux *chi.Mux) {
 	mux.Get("/", documentation)
 	mux.Get("/static/docs/api/api.yaml", swagger)
+	mux.Get("/static/docs/api/api.v2.yaml", apiversion.SpecHandler("api/api.yaml"))
+	mux.Get("/static/clients/api/client.ts", tsclient.Handler("api/api.yaml"))
+	mux.Get("/static/clients/api/client.v2.ts", tsclient.V2Handler("api/api.yaml"))
 	mux.Get("/favicon.ico", favicon)
 }
 
+func qrBrandingResponse(branding *domain.QRBranding) QRBranding {
+	resp := QRBranding{
+		ForegroundColor: branding.ForegroundColor,
+		BackgroundColor: branding.BackgroundColor,
+		ErrorCorrection: QRBrandingErrorCorrection(branding.ErrorCorrection),
+	}
+	if len(branding.Logo) > 0 {
+		resp.Logo = &branding.Logo
+	}
+	return resp
+}
+
 func toVerifiableRefreshService(s *RefreshService) *verifiable.RefreshService {
 	if s == nil {
 		return nil
@@ -660,8 +905,31 @@ func toGetClaim200Response(claim *verifiable.W3CCredential) GetClaimResponse {
 	}
 }
 
-func toGetClaimQrCode200JSONResponse(claim *domain.Claim, hostURL string) *GetClaimQrCode200JSONResponse {
-	id := uuid.New()
+func toCredentialMetadataResponse(credential *verifiable.W3CCredential, revoked bool) CredentialMetadata {
+	var expiration, issuanceDate *TimeUTC
+	if credential.Expiration != nil {
+		expiration = common.ToPointer(TimeUTC(*credential.Expiration))
+	}
+	if credential.IssuanceDate != nil {
+		issuanceDate = common.ToPointer(TimeUTC(*credential.IssuanceDate))
+	}
+
+	return CredentialMetadata{
+		Id:   credential.ID,
+		Type: credential.Type,
+		CredentialSchema: CredentialSchema{
+			Id:   credential.CredentialSchema.ID,
+			Type: credential.CredentialSchema.Type,
+		},
+		CredentialStatus: credential.CredentialStatus,
+		ExpirationDate:   expiration,
+		IssuanceDate:     issuanceDate,
+		Issuer:           credential.Issuer,
+		Revoked:          revoked,
+	}
+}
+
+func toGetClaimQrCode200JSONResponse(claim *domain.Claim, hostURL string, id uuid.UUID) *GetClaimQrCode200JSONResponse {
 	return &GetClaimQrCode200JSONResponse{
 		Body: struct {
 			Credentials []struct {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core/v2"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -29,6 +30,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
@@ -53,7 +55,7 @@ func TestServer_CreateIdentity(t *testing.T) {
 
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -62,9 +64,21 @@ func TestServer_CreateIdentity(t *testing.T) {
 		},
 		true,
 	)
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -257,7 +271,7 @@ func TestServer_RevokeClaim(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -266,9 +280,21 @@ func TestServer_RevokeClaim(t *testing.T) {
 		},
 		true,
 	)
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 
 	idStr := "did:polygonid:polygon:mumbai:2qM77fA6NGGWL9QEeb1dv2VA6wz5svcohgv61LZ7wB"
 	identity := &domain.Identity{
@@ -423,11 +449,23 @@ func TestServer_CreateClaim(t *testing.T) {
 
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	pubSub := pubsub.NewMock()
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubSub,
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 	handler := getHandler(ctx, server)
 
 	iden, err := identityService.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -691,7 +729,7 @@ func TestServer_GetIdentities(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -701,9 +739,21 @@ func TestServer_GetIdentities(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	idStr1 := "did:polygonid:polygon:mumbai:2qE1ZT16aqEWhh9mX9aqM2pe2ZwV995dTkReeKwCaQ"
@@ -771,7 +821,7 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	idStr := "did:polygonid:polygon:mumbai:2qPrv5Yx8s1qAmEnPym68LfT7gTbASGampiGU7TseL"
 	idNoClaims := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
@@ -785,7 +835,19 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 
 	identity := &domain.Identity{
 		Identifier: idStr,
@@ -797,7 +859,7 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 	claim := fixture.NewClaim(t, identity.Identifier)
 	fixture.CreateClaim(t, claim)
 
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -924,7 +986,7 @@ func TestServer_GetClaim(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -934,10 +996,22 @@ func TestServer_GetClaim(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 
 	idStr := "did:polygonid:polygon:mumbai:2qLduMv2z7hnuhzkcTWesCUuJKpRVDEThztM4tsJUj"
 	idStrWithoutClaims := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
@@ -1091,6 +1165,92 @@ func TestServer_GetClaim(t *testing.T) {
 	}
 }
 
+func TestServer_GetCredentialMetadata(t *testing.T) {
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+	})
+	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
+
+	identity := &domain.Identity{
+		Identifier: "did:polygonid:polygon:mumbai:2qMJ1ABV8Hrw6mNbAhkgg3jkDmqfwRJ6UbXEdPRfh3",
+	}
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, identity)
+	claim := fixture.NewClaim(t, identity.Identifier)
+	fixture.CreateClaim(t, claim)
+
+	handler := getHandler(context.Background(), server)
+
+	type expected struct {
+		httpCode int
+		message  string
+	}
+
+	for _, tc := range []struct {
+		name     string
+		id       string
+		expected expected
+	}{
+		{
+			name:     "invalid id",
+			id:       "1234",
+			expected: expected{httpCode: http.StatusBadRequest, message: "invalid credential id"},
+		},
+		{
+			name:     "non existing credential",
+			id:       uuid.NewString(),
+			expected: expected{httpCode: http.StatusNotFound, message: "credential not found"},
+		},
+		{
+			name:     "should get the metadata without basic auth",
+			id:       claim.ID.String(),
+			expected: expected{httpCode: http.StatusOK},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/%s", tc.id), nil)
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode != http.StatusOK {
+				var response N400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+				return
+			}
+			var response map[string]any
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, identity.Identifier, response["issuer"])
+			assert.Equal(t, false, response["revoked"])
+			assert.NotContains(t, response, "credentialSubject")
+			assert.NotContains(t, response, "proof")
+		})
+	}
+}
+
 func TestServer_GetClaims(t *testing.T) {
 	const (
 		method     = "polygonid"
@@ -1107,7 +1267,7 @@ func TestServer_GetClaims(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -1117,12 +1277,24 @@ func TestServer_GetClaims(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 
 	fixture := tests.NewFixture(storage)
 
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 
 	ctx := context.Background()
 	identityMultipleClaims, err := server.identityService.Create(ctx, "https://localhost.com", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -1462,7 +1634,7 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	identity, err := identityService.Create(ctx, "http://localhost:3001", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	assert.NoError(t, err)
@@ -1475,9 +1647,21 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	accountService := services.NewAccountService(cfg.Ethereum, keyStore)
-	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, accountService, claimsService, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil, nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
@@ -1630,3 +1814,69 @@ func createGetClaimsURL(did string, schemaHash *string, schemaType *string, subj
 	tURL.RawQuery = q.Encode()
 	return tURL.String()
 }
+
+type tenantServiceMock struct {
+	ports.TenantService
+	tenant *domain.Tenant
+	apiKey string
+}
+
+func (m *tenantServiceMock) Authenticate(_ context.Context, id uuid.UUID, apiKey string) (*domain.Tenant, error) {
+	if id != m.tenant.ID || apiKey != m.apiKey {
+		return nil, fmt.Errorf("invalid api key")
+	}
+	return m.tenant, nil
+}
+
+func TestServer_GetConfigTenant(t *testing.T) {
+	ctx := context.Background()
+	tenants := &tenantServiceMock{tenant: &domain.Tenant{ID: uuid.New(), Name: "tenant"}, apiKey: "tenant-api-key"}
+	server := NewServer(&cfg, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, tenants, nil, nil)
+	mux := chi.NewRouter()
+	usr, pass := authOk()
+	handler := HandlerFromMux(NewStrictHandlerWithOptions(
+		server,
+		[]StrictMiddlewareFunc{LogMiddleware(ctx), BasicAuthMiddleware(ctx, usr, pass, tenants)},
+		StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
+			ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
+		},
+	), mux)
+
+	type expected struct {
+		httpCode int
+	}
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		expected expected
+	}{
+		{
+			name:     "admin credentials",
+			auth:     authOk,
+			expected: expected{httpCode: http.StatusOK},
+		},
+		{
+			name:     "tenant credentials",
+			auth:     func() (string, string) { return tenants.tenant.ID.String(), tenants.apiKey },
+			expected: expected{httpCode: http.StatusForbidden},
+		},
+		{
+			name:     "wrong tenant api key",
+			auth:     func() (string, string) { return tenants.tenant.ID.String(), "wrong" },
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "/config", nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode != http.StatusOK && cfg.Database.URL != "" {
+				assert.NotContains(t, rr.Body.String(), cfg.Database.URL)
+			}
+		})
+	}
+}
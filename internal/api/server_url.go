@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// apiPathPrefixes are the prefixes of the api paths. The server url of an identity is the part of the url before them.
+var apiPathPrefixes = []string{"/v1/", "/v2/"}
+
+// serverURLCacheTTL is how long the identity of a server url is reused, so the changes of the server urls are
+// applied after at most this time
+const serverURLCacheTTL = time.Minute
+
+type issuerCtxKey struct{}
+
+func withIssuer(ctx context.Context, issuerDID w3c.DID) context.Context {
+	return context.WithValue(ctx, issuerCtxKey{}, issuerDID)
+}
+
+// issuerFromContext returns the identity the request was routed to by its server url, if any.
+// A nil value means that the request was sent to the server url of the node.
+func issuerFromContext(ctx context.Context) *w3c.DID {
+	issuerDID, ok := ctx.Value(issuerCtxKey{}).(w3c.DID)
+	if !ok {
+		return nil
+	}
+	return &issuerDID
+}
+
+// ServerURLMiddleware returns a middleware that routes the requests sent to the server url of an identity, i.e.
+// https://acme.issuer.example.com/v1/agent or https://issuer.example.com/acme/v1/agent, to that identity.
+// The path prefix of the server url is removed before routing, and the identity is kept in the request context, so
+// the agent only accepts messages addressed to it. The identities of the server urls, or their absence, are cached
+// during serverURLCacheTTL. It must run after the forwarded headers have been resolved and before the api version
+// is resolved.
+func ServerURLMiddleware(identityService ports.IdentityService, serverURL string) func(http.Handler) http.Handler {
+	nodeURL := strings.TrimSuffix(serverURL, "/")
+	// a nil identity means that the server url does not belong to any identity
+	identities := invalidation.NewLocal[*w3c.DID](serverURLCacheTTL)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idx := apiPathIndex(r.URL.Path)
+			if idx < 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			requestURL := strings.TrimSuffix(forwarded.BaseURL(r.Context(), forwarded.Origin(r)), "/") + r.URL.Path[:idx]
+			if requestURL == nodeURL {
+				next.ServeHTTP(w, r)
+				return
+			}
+			issuerDID, err := identities.Get(requestURL)
+			if err != nil {
+				issuerDID, err = identityService.GetByServerURL(r.Context(), requestURL)
+				if err != nil {
+					if !errors.Is(err, services.ErrIdentityNotFound) {
+						log.Error(r.Context(), "resolving the identity of the server url", "err", err, "url", requestURL)
+						next.ServeHTTP(w, r)
+						return
+					}
+					issuerDID = nil
+				}
+				identities.Set(requestURL, issuerDID)
+			}
+			if issuerDID == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.URL.Path = r.URL.Path[idx:]
+			if r.URL.RawPath != "" {
+				if rawIdx := apiPathIndex(r.URL.RawPath); rawIdx >= 0 {
+					r.URL.RawPath = r.URL.RawPath[rawIdx:]
+				} else {
+					r.URL.RawPath = ""
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(withIssuer(r.Context(), *issuerDID)))
+		})
+	}
+}
+
+// apiPathIndex returns the index of the first api path prefix in the path, or -1 if it is not an api path
+func apiPathIndex(path string) int {
+	idx := -1
+	for _, prefix := range apiPathPrefixes {
+		if i := strings.Index(path, prefix); i >= 0 && (idx < 0 || i < idx) {
+			idx = i
+		}
+	}
+	return idx
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+type serverURLIdentities struct {
+	ports.IdentityService
+	urls    map[string]w3c.DID
+	lookups int
+}
+
+func (s *serverURLIdentities) GetByServerURL(_ context.Context, serverURL string) (*w3c.DID, error) {
+	s.lookups++
+	did, ok := s.urls[serverURL]
+	if !ok {
+		return nil, services.ErrIdentityNotFound
+	}
+	return &did, nil
+}
+
+func TestServerURLMiddleware(t *testing.T) {
+	acme, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	identities := &serverURLIdentities{urls: map[string]w3c.DID{"http://issuer.example.com/acme": *acme}}
+
+	var issuer *w3c.DID
+	var rawPath string
+	mux := chi.NewRouter()
+	mux.Use(ServerURLMiddleware(identities, "http://issuer.example.com"), apiversion.Middleware)
+	mux.Get("/v1/credentials/{id}", func(w http.ResponseWriter, r *http.Request) {
+		issuer = issuerFromContext(r.Context())
+		rawPath = r.URL.EscapedPath()
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		url     string
+		code    int
+		issuer  *w3c.DID
+		escaped string
+		lookups int
+	}{
+		{name: "node server url", url: "http://issuer.example.com/v1/credentials/1", code: http.StatusOK, escaped: "/v1/credentials/1"},
+		{name: "identity server url", url: "http://issuer.example.com/acme/v1/credentials/1", code: http.StatusOK, issuer: acme, escaped: "/v1/credentials/1", lookups: 1},
+		{name: "cached identity server url with v2", url: "http://issuer.example.com/acme/v2/credentials/1", code: http.StatusOK, issuer: acme, escaped: "/v1/credentials/1", lookups: 1},
+		{name: "percent encoded path", url: "http://issuer.example.com/acme/v1/credentials/did%3Apolygonid%2Fa", code: http.StatusOK, issuer: acme, escaped: "/v1/credentials/did%3Apolygonid%2Fa", lookups: 1},
+		{name: "unknown server url", url: "http://issuer.example.com/other/v1/credentials/1", code: http.StatusNotFound, lookups: 2},
+		{name: "cached unknown server url", url: "http://issuer.example.com/other/v1/credentials/1", code: http.StatusNotFound, lookups: 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			issuer, rawPath = nil, ""
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.url, nil))
+			require.Equal(t, tc.code, rr.Code)
+			assert.Equal(t, tc.lookups, identities.lookups)
+			if tc.code != http.StatusOK {
+				return
+			}
+			assert.Equal(t, tc.issuer, issuer)
+			assert.Equal(t, tc.escaped, rawPath)
+		})
+	}
+}
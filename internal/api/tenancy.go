@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
+)
+
+// adminOnlyOperations are the operations that cannot be called with tenant credentials, the ones about the whole node
+var adminOnlyOperations = map[string]struct{}{
+	"GetConfig":    {},
+	"CreateTenant": {},
+	"GetTenants":   {},
+	"DeleteTenant": {},
+}
+
+func withTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return tenancy.WithTenant(ctx, tenantID)
+}
+
+// tenantFromContext returns the tenant that is performing the request, if any.
+// A nil value means that the request was authenticated with the admin credentials.
+func tenantFromContext(ctx context.Context) *uuid.UUID {
+	return tenancy.FromContext(ctx)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// usageCSVHeader is the header of the usage reports in csv format
+var usageCSVHeader = []string{"from", "to", "tenantID", "tenantName", "issuer", "credentialsIssued", "verifications", "stateTransitions", "gasUsed", "txFee"}
+
+// GetUsage returns the usage report of the issuers between two days, both included.
+// Tenant requests are restricted to the issuers of the tenant.
+func (s *Server) GetUsage(ctx context.Context, request GetUsageRequestObject) (GetUsageResponseObject, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if request.Params.From != nil {
+		from = request.Params.From.Time
+	}
+	to := today
+	if request.Params.To != nil {
+		to = request.Params.To.Time
+	}
+
+	filter := domain.UsageFilter{TenantID: request.Params.TenantID, IssuerDID: request.Params.Issuer}
+	if tenantID := tenantFromContext(ctx); tenantID != nil {
+		filter.TenantID = tenantID
+	}
+
+	report, err := s.usageService.Get(ctx, from, to.AddDate(0, 0, 1), filter)
+	if err != nil {
+		if errors.Is(err, services.ErrUsageInvalidPeriod) {
+			return GetUsage400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting usage report", "err", err)
+		return GetUsage500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	if request.Params.Format != nil && *request.Params.Format == Csv {
+		body, err := usageReportCSV(report)
+		if err != nil {
+			log.Error(ctx, "writing usage report csv", "err", err)
+			return GetUsage500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		return GetUsage200TextcsvResponse{Body: bytes.NewReader(body), ContentLength: int64(len(body))}, nil
+	}
+	return GetUsage200JSONResponse(usageReportResponse(report)), nil
+}
+
+func usageReportResponse(report *domain.UsageReport) UsageReport {
+	issuers := make([]IssuerUsage, len(report.Issuers))
+	for i, usage := range report.Issuers {
+		issuers[i] = IssuerUsage{
+			Issuer:            usage.IssuerDID,
+			TenantID:          usage.TenantID,
+			TenantName:        usage.TenantName,
+			CredentialsIssued: usage.CredentialsIssued,
+			Verifications:     usage.Verifications,
+			StateTransitions:  usage.StateTransitions,
+			GasUsed:           usage.GasUsed,
+			TxFee:             usage.TxFee,
+		}
+	}
+	return UsageReport{
+		From:    openapi_types.Date{Time: report.From},
+		To:      openapi_types.Date{Time: usageReportLastDay(report)},
+		Issuers: issuers,
+	}
+}
+
+func usageReportCSV(report *domain.UsageReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(usageCSVHeader); err != nil {
+		return nil, err
+	}
+	from, to := report.From.Format(time.DateOnly), usageReportLastDay(report).Format(time.DateOnly)
+	for _, usage := range report.Issuers {
+		var tenantID, tenantName string
+		if usage.TenantID != nil {
+			tenantID = usage.TenantID.String()
+		}
+		if usage.TenantName != nil {
+			tenantName = *usage.TenantName
+		}
+		if err := w.Write([]string{
+			from,
+			to,
+			tenantID,
+			tenantName,
+			usage.IssuerDID,
+			strconv.Itoa(usage.CredentialsIssued),
+			strconv.Itoa(usage.Verifications),
+			strconv.Itoa(usage.StateTransitions),
+			strconv.FormatInt(usage.GasUsed, 10),
+			usage.TxFee,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// usageReportLastDay returns the last day included in the report, as its end is excluded
+func usageReportLastDay(report *domain.UsageReport) time.Time {
+	return report.To.AddDate(0, 0, -1)
+}
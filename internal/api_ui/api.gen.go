@@ -13,13 +13,61 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	uuid "github.com/google/uuid"
+	verifiable "github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	timeapi "github.com/polygonid/sh-id-platform/internal/timeapi"
 )
 
 const (
-	BasicAuthScopes = "basicAuth.Scopes"
+	BasicAuthScopes  = "basicAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	ConnectionsRead  APIKeyScope = "connections:read"
+	ConnectionsWrite APIKeyScope = "connections:write"
+	CredentialsRead  APIKeyScope = "credentials:read"
+	CredentialsWrite APIKeyScope = "credentials:write"
+	LinksRead        APIKeyScope = "links:read"
+	LinksWrite       APIKeyScope = "links:write"
+	SchemasRead      APIKeyScope = "schemas:read"
+	SchemasWrite     APIKeyScope = "schemas:write"
+	StatePublish     APIKeyScope = "state:publish"
+	StateRead        APIKeyScope = "state:read"
+)
+
+// Defines values for CreatePresentationTemplateRequestProofType.
+const (
+	CreatePresentationTemplateRequestProofTypeBJJSignature2021           CreatePresentationTemplateRequestProofType = "BJJSignature2021"
+	CreatePresentationTemplateRequestProofTypeIden3SparseMerkleTreeProof CreatePresentationTemplateRequestProofType = "Iden3SparseMerkleTreeProof"
+)
+
+// Defines values for CredentialFetchPolicyType.
+const (
+	FreshAuth CredentialFetchPolicyType = "freshAuth"
+	Profiles  CredentialFetchPolicyType = "profiles"
+	Subject   CredentialFetchPolicyType = "subject"
+)
+
+// Defines values for CredentialLayoutSlotContents.
+const (
+	Data                         CredentialLayoutSlotContents = "data"
+	Empty                        CredentialLayoutSlotContents = "empty"
+	MerklizedRoot                CredentialLayoutSlotContents = "merklized root"
+	RevocationNonceAndExpiration CredentialLayoutSlotContents = "revocation nonce and expiration"
+	SchemaHashFlagsAndVersion    CredentialLayoutSlotContents = "schema hash, flags and version"
+	SubjectId                    CredentialLayoutSlotContents = "subject id"
+)
+
+// Defines values for CredentialPreviewStatus.
+const (
+	CredentialPreviewStatusActive  CredentialPreviewStatus = "active"
+	CredentialPreviewStatusExpired CredentialPreviewStatus = "expired"
+	CredentialPreviewStatusRevoked CredentialPreviewStatus = "revoked"
 )
 
 // Defines values for DisplayMethodType.
@@ -27,6 +75,24 @@ const (
 	Iden3BasicDisplayMethodV1 DisplayMethodType = "Iden3BasicDisplayMethodV1"
 )
 
+// Defines values for KeyMigrationStatus.
+const (
+	KeyMigrationStatusCompleted KeyMigrationStatus = "completed"
+	KeyMigrationStatusFailed    KeyMigrationStatus = "failed"
+	KeyMigrationStatusPending   KeyMigrationStatus = "pending"
+	KeyMigrationStatusRunning   KeyMigrationStatus = "running"
+	KeyMigrationStatusWaiting   KeyMigrationStatus = "waiting"
+)
+
+// Defines values for KeyMigrationStepStep.
+const (
+	AddAuthClaim   KeyMigrationStepStep = "add_auth_claim"
+	GenerateKey    KeyMigrationStepStep = "generate_key"
+	PublishState   KeyMigrationStepStep = "publish_state"
+	RetireOldKey   KeyMigrationStepStep = "retire_old_key"
+	VerifyIssuance KeyMigrationStepStep = "verify_issuance"
+)
+
 // Defines values for LinkStatus.
 const (
 	LinkStatusActive   LinkStatus = "active"
@@ -34,11 +100,77 @@ const (
 	LinkStatusInactive LinkStatus = "inactive"
 )
 
+// Defines values for LinkPrerequisiteProofType.
+const (
+	LinkPrerequisiteProofTypeBJJSignature2021           LinkPrerequisiteProofType = "BJJSignature2021"
+	LinkPrerequisiteProofTypeIden3SparseMerkleTreeProof LinkPrerequisiteProofType = "Iden3SparseMerkleTreeProof"
+)
+
+// Defines values for PresentationOperator.
+const (
+	Eq  PresentationOperator = "$eq"
+	Gt  PresentationOperator = "$gt"
+	In  PresentationOperator = "$in"
+	Lt  PresentationOperator = "$lt"
+	Ne  PresentationOperator = "$ne"
+	Nin PresentationOperator = "$nin"
+)
+
+// Defines values for QRBrandingErrorCorrection.
+const (
+	H QRBrandingErrorCorrection = "H"
+	L QRBrandingErrorCorrection = "L"
+	M QRBrandingErrorCorrection = "M"
+	Q QRBrandingErrorCorrection = "Q"
+)
+
 // Defines values for RefreshServiceType.
 const (
 	Iden3RefreshService2023 RefreshServiceType = "Iden3RefreshService2023"
 )
 
+// Defines values for RevocationRequestStatus.
+const (
+	RevocationRequestStatusApproved RevocationRequestStatus = "approved"
+	RevocationRequestStatusPending  RevocationRequestStatus = "pending"
+	RevocationRequestStatusRejected RevocationRequestStatus = "rejected"
+)
+
+// Defines values for SchemaSelfRevocation.
+const (
+	SchemaSelfRevocationApproval SchemaSelfRevocation = "approval"
+	SchemaSelfRevocationAuto     SchemaSelfRevocation = "auto"
+	SchemaSelfRevocationDisabled SchemaSelfRevocation = "disabled"
+)
+
+// Defines values for SchemaUniqueStrategy.
+const (
+	Reject SchemaUniqueStrategy = "reject"
+	Revoke SchemaUniqueStrategy = "revoke"
+)
+
+// Defines values for SessionStatusStatus.
+const (
+	SessionStatusStatusDone           SessionStatusStatus = "done"
+	SessionStatusStatusError          SessionStatusStatus = "error"
+	SessionStatusStatusPending        SessionStatusStatus = "pending"
+	SessionStatusStatusPendingPayment SessionStatusStatus = "pendingPayment"
+	SessionStatusStatusPendingPublish SessionStatusStatus = "pendingPublish"
+)
+
+// Defines values for SessionStatusType.
+const (
+	SessionStatusTypeAuthentication SessionStatusType = "authentication"
+	SessionStatusTypeCredential     SessionStatusType = "credential"
+	SessionStatusTypeLink           SessionStatusType = "link"
+)
+
+// Defines values for StateCheckpointAnchorType.
+const (
+	Ethereum       StateCheckpointAnchorType = "ethereum"
+	Opentimestamps StateCheckpointAnchorType = "opentimestamps"
+)
+
 // Defines values for StateTransactionStatus.
 const (
 	Created   StateTransactionStatus = "created"
@@ -47,6 +179,15 @@ const (
 	Published StateTransactionStatus = "published"
 )
 
+// Defines values for TrustStatusSource.
+const (
+	AllowList   TrustStatusSource = "allowList"
+	DenyList    TrustStatusSource = "denyList"
+	Disabled    TrustStatusSource = "disabled"
+	Registry    TrustStatusSource = "registry"
+	Unavailable TrustStatusSource = "unavailable"
+)
+
 // Defines values for AuthQRCodeParamsType.
 const (
 	AuthQRCodeParamsTypeLink AuthQRCodeParamsType = "link"
@@ -61,6 +202,12 @@ const (
 	GetConnectionsParamsSortUserID         GetConnectionsParamsSort = "userID"
 )
 
+// Defines values for GetConnectionReAuthQRCodeParamsType.
+const (
+	GetConnectionReAuthQRCodeParamsTypeLink GetConnectionReAuthQRCodeParamsType = "link"
+	GetConnectionReAuthQRCodeParamsTypeRaw  GetConnectionReAuthQRCodeParamsType = "raw"
+)
+
 // Defines values for GetCredentialsParamsStatus.
 const (
 	All     GetCredentialsParamsStatus = "all"
@@ -88,12 +235,55 @@ const (
 	GetLinksParamsStatusInactive GetLinksParamsStatus = "inactive"
 )
 
+// Defines values for CreateLinkQrCodeParamsType.
+const (
+	CreateLinkQrCodeParamsTypeAuto     CreateLinkQrCodeParamsType = "auto"
+	CreateLinkQrCodeParamsTypeEmbedded CreateLinkQrCodeParamsType = "embedded"
+	CreateLinkQrCodeParamsTypeLink     CreateLinkQrCodeParamsType = "link"
+	CreateLinkQrCodeParamsTypeOob      CreateLinkQrCodeParamsType = "oob"
+)
+
 // Defines values for GetCredentialQrCodeParamsType.
 const (
-	GetCredentialQrCodeParamsTypeLink GetCredentialQrCodeParamsType = "link"
-	GetCredentialQrCodeParamsTypeRaw  GetCredentialQrCodeParamsType = "raw"
+	GetCredentialQrCodeParamsTypeAuto      GetCredentialQrCodeParamsType = "auto"
+	GetCredentialQrCodeParamsTypeEmbedded  GetCredentialQrCodeParamsType = "embedded"
+	GetCredentialQrCodeParamsTypeLink      GetCredentialQrCodeParamsType = "link"
+	GetCredentialQrCodeParamsTypeNegotiate GetCredentialQrCodeParamsType = "negotiate"
+	GetCredentialQrCodeParamsTypeOob       GetCredentialQrCodeParamsType = "oob"
+	GetCredentialQrCodeParamsTypeRaw       GetCredentialQrCodeParamsType = "raw"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	// Active False if the key is revoked or expired
+	Active     bool       `json:"active"`
+	AllowedIPs []string   `json:"allowedIPs"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         uuid.UUID  `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// ReadOnly True for the read-only keys of the support engineers, which never grant a write scope
+	ReadOnly  bool          `json:"readOnly"`
+	RevokedAt *time.Time    `json:"revokedAt,omitempty"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	TenantId  *uuid.UUID    `json:"tenantId,omitempty"`
+}
+
+// APIKeyScope Write scopes also grant the read scope of the same resource
+type APIKeyScope string
+
+// AddCampaignCredentialsRequest defines model for AddCampaignCredentialsRequest.
+type AddCampaignCredentialsRequest struct {
+	CredentialIds []uuid.UUID `json:"credentialIds"`
+}
+
+// AddCampaignLinksRequest defines model for AddCampaignLinksRequest.
+type AddCampaignLinksRequest struct {
+	LinkIds []uuid.UUID `json:"linkIds"`
+}
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse struct {
 	Body     interface{} `json:"body"`
@@ -105,34 +295,265 @@ type AgentResponse struct {
 	Type     string      `json:"type"`
 }
 
+// ArchiveCredentialsRequest defines model for ArchiveCredentialsRequest.
+type ArchiveCredentialsRequest struct {
+	// BatchSize Credentials archived in the request. Defaults to ISSUER_CLAIM_ARCHIVAL_BATCH_SIZE.
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// OlderThanDays Age in days of the credentials to archive. Defaults to ISSUER_CLAIM_ARCHIVAL_MIN_AGE_DAYS.
+	OlderThanDays *int `json:"olderThanDays,omitempty"`
+}
+
+// ArchiveCredentialsResponse defines model for ArchiveCredentialsResponse.
+type ArchiveCredentialsResponse struct {
+	Credentials []uuid.UUID `json:"credentials"`
+}
+
+// AttributeProvenance defines model for AttributeProvenance.
+type AttributeProvenance struct {
+	// Source System the value of the attribute comes from
+	Source string `json:"source"`
+
+	// VerificationMethod How the issuer verified the value
+	VerificationMethod string     `json:"verificationMethod"`
+	VerifiedAt         *time.Time `json:"verifiedAt,omitempty"`
+}
+
 // AuthenticationConnection defines model for AuthenticationConnection.
 type AuthenticationConnection struct {
-	CreatedAt  TimeUTC    `json:"createdAt"`
-	Id         UUIDString `json:"id"`
-	IssuerID   UUIDString `json:"issuerID"`
-	ModifiedAt TimeUTC    `json:"modifiedAt"`
-	UserID     UUIDString `json:"userID"`
+	CreatedAt      TimeUTC    `json:"createdAt"`
+	Id             UUIDString `json:"id"`
+	IssuerID       UUIDString `json:"issuerID"`
+	LastVerifiedAt *TimeUTC   `json:"lastVerifiedAt"`
+	ModifiedAt     TimeUTC    `json:"modifiedAt"`
+	UserID         UUIDString `json:"userID"`
+}
+
+// Campaign defines model for Campaign.
+type Campaign struct {
+	CreatedAt          TimeUTC          `json:"createdAt"`
+	Description        *string          `json:"description,omitempty"`
+	EndAt              *TimeUTC         `json:"endAt"`
+	Id                 uuid.UUID        `json:"id"`
+	LinkIds            []uuid.UUID      `json:"linkIds"`
+	Milestones         []int            `json:"milestones"`
+	Name               string           `json:"name"`
+	NotifiedMilestones []int            `json:"notifiedMilestones"`
+	Progress           CampaignProgress `json:"progress"`
+	StartAt            *TimeUTC         `json:"startAt"`
+
+	// Status scheduled, active or ended, according to the dates of the campaign
+	Status     string  `json:"status"`
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
+}
+
+// CampaignProgress defines model for CampaignProgress.
+type CampaignProgress struct {
+	Claimed           int `json:"claimed"`
+	ClaimedPercentage int `json:"claimedPercentage"`
+
+	// Invited Credentials the campaign expects to issue: the recipients of the links that have them, the maximum
+	// issuance of the other links and the credentials added to the campaign.
+	Invited int `json:"invited"`
+	Links   int `json:"links"`
+	Revoked int `json:"revoked"`
+}
+
+// CatalogCredential defines model for CatalogCredential.
+type CatalogCredential struct {
+	CredentialExpiration *TimeUTC `json:"credentialExpiration"`
+	Description          *string  `json:"description,omitempty"`
+	Expiration           *TimeUTC `json:"expiration"`
+
+	// LandingPageUrl Hosted landing page of the link. Only present if the landing pages are enabled
+	LandingPageUrl *string   `json:"landingPageUrl,omitempty"`
+	LinkId         uuid.UUID `json:"linkId"`
+	ProofTypes     []string  `json:"proofTypes"`
+
+	// QrCodeUrl Endpoint that creates the link QR code session
+	QrCodeUrl string `json:"qrCodeUrl"`
+
+	// RemainingIssuance Credentials that can still be issued through the link. Not present for unlimited links
+	RemainingIssuance *int                `json:"remainingIssuance,omitempty"`
+	Requirements      CatalogRequirements `json:"requirements"`
+	SchemaType        string              `json:"schemaType"`
+	SchemaUrl         string              `json:"schemaUrl"`
+	SchemaVersion     *string             `json:"schemaVersion,omitempty"`
+	Title             *string             `json:"title,omitempty"`
+}
+
+// CatalogIssuer defines model for CatalogIssuer.
+type CatalogIssuer struct {
+	Did  string  `json:"did"`
+	Logo *string `json:"logo,omitempty"`
+	Name string  `json:"name"`
+}
+
+// CatalogPresentationRequirement Proof the holder must present before the credential is issued
+type CatalogPresentationRequirement struct {
+	AllowedIssuers  []string                `json:"allowedIssuers"`
+	Conditions      []PresentationCondition `json:"conditions"`
+	CredentialType  string                  `json:"credentialType"`
+	Description     *string                 `json:"description,omitempty"`
+	DisclosedFields []string                `json:"disclosedFields"`
+	Name            string                  `json:"name"`
+	SchemaUrl       string                  `json:"schemaUrl"`
+}
+
+// CatalogRequirements defines model for CatalogRequirements.
+type CatalogRequirements struct {
+	// Payment On-chain payment the holder has to make before the credential is issued
+	Payment *LinkPayment `json:"payment,omitempty"`
+
+	// Presentation Proof the holder must present before the credential is issued
+	Presentation *CatalogPresentationRequirement `json:"presentation,omitempty"`
 }
 
 // Config defines model for Config.
 type Config = []KeyValue
 
+// ConnectionDuplicates defines model for ConnectionDuplicates.
+type ConnectionDuplicates struct {
+	Connections []struct {
+		Id     uuid.UUID `json:"id"`
+		UserID string    `json:"userID"`
+	} `json:"connections"`
+
+	// Reason Profile field shared by the connections, externalId or email
+	Reason string `json:"reason"`
+	Value  string `json:"value"`
+}
+
+// ConnectionMerge defines model for ConnectionMerge.
+type ConnectionMerge struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// Credentials Number of credentials issued again to the DID of the target connection
+	Credentials        int       `json:"credentials"`
+	Id                 uuid.UUID `json:"id"`
+	SourceConnectionID uuid.UUID `json:"sourceConnectionID"`
+	SourceUserID       string    `json:"sourceUserID"`
+	TargetConnectionID uuid.UUID `json:"targetConnectionID"`
+}
+
+// ConnectionProfile defines model for ConnectionProfile.
+type ConnectionProfile struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	ExternalId  *string `json:"externalId,omitempty"`
+}
+
+// ConnectionRebindingCode defines model for ConnectionRebindingCode.
+type ConnectionRebindingCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt TimeUTC   `json:"expiresAt"`
+	Id        uuid.UUID `json:"id"`
+}
+
 // ConnectionsPaginated defines model for ConnectionsPaginated.
 type ConnectionsPaginated struct {
 	Items GetConnectionsResponse `json:"items"`
 	Meta  PaginatedMetadata      `json:"meta"`
 }
 
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	// AllowedIPs IPs or CIDR ranges the key can be used from. Any IP if empty.
+	AllowedIPs *[]string     `json:"allowedIPs,omitempty"`
+	ExpiresAt  *time.Time    `json:"expiresAt,omitempty"`
+	Name       string        `json:"name"`
+	Scopes     []APIKeyScope `json:"scopes"`
+
+	// TenantId Tenant the key is scoped to. Requests made with the key only see the schemas, links and connections of the tenant.
+	TenantId *uuid.UUID `json:"tenantId,omitempty"`
+}
+
+// CreateAPIKeyResponse defines model for CreateAPIKeyResponse.
+type CreateAPIKeyResponse struct {
+	ApiKey APIKey `json:"apiKey"`
+
+	// Key Secret of the API key. It is not stored, so it can not be retrieved again.
+	Key string `json:"key"`
+}
+
+// CreateCampaignRequest defines model for CreateCampaignRequest.
+type CreateCampaignRequest struct {
+	Description *string    `json:"description,omitempty"`
+	EndAt       *time.Time `json:"endAt,omitempty"`
+
+	// Milestones Percentages of the invited holders that claimed their credential. Defaults to 25, 50, 75 and 100
+	Milestones *[]int     `json:"milestones,omitempty"`
+	Name       string     `json:"name"`
+	StartAt    *time.Time `json:"startAt,omitempty"`
+
+	// WebhookUrl Url that receives a POST request when a milestone is reached
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
+}
+
 // CreateCredentialRequest defines model for CreateCredentialRequest.
 type CreateCredentialRequest struct {
 	CredentialSchema  string                 `json:"credentialSchema"`
 	CredentialSubject map[string]interface{} `json:"credentialSubject"`
 	DisplayMethod     *DisplayMethod         `json:"displayMethod,omitempty"`
-	Expiration        *time.Time             `json:"expiration,omitempty"`
-	MtProof           *bool                  `json:"mtProof,omitempty"`
-	RefreshService    *RefreshService        `json:"refreshService"`
-	SignatureProof    *bool                  `json:"signatureProof,omitempty"`
-	Type              string                 `json:"type"`
+
+	// Evidence Entries of the evidence section of the credential, after the provenance ones. Each entry needs a type and
+	// the credential is rejected when its schema does not allow the section.
+	Evidence   *[]Evidence `json:"evidence,omitempty"`
+	Expiration *time.Time  `json:"expiration,omitempty"`
+
+	// ExternalId Integrator reference to correlate the credential with a record in their own system
+	ExternalId *string `json:"externalId,omitempty"`
+
+	// FetchPolicy Who can fetch the credential from the agent:
+	//   * `subject` - (default value) Only the subject DID of the credential.
+	//   * `profiles` - The subject DID and the profiles derived from it. Holders fetching the credential from a profile
+	//     send its nonce in the `profileNonce` field of the fetch request body.
+	//   * `freshAuth` - Only the subject DID, if it authenticated with the issuer in the last `freshAuthMinutes` minutes.
+	FetchPolicy *CredentialFetchPolicy `json:"fetchPolicy,omitempty"`
+
+	// Force Issue the credential even if an identical non revoked credential has already been issued to the user
+	Force   *bool `json:"force,omitempty"`
+	MtProof *bool `json:"mtProof,omitempty"`
+
+	// ProfileID Issue the credential on behalf of this issuer profile
+	ProfileID *uuid.UUID `json:"profileID,omitempty"`
+
+	// Provenance Provenance of attributes of the credential subject, by attribute name. It is embedded in the evidence
+	// section of the credential, so the verifiers can weigh the assurance of each attribute.
+	Provenance     *map[string]AttributeProvenance `json:"provenance,omitempty"`
+	RefreshService *RefreshService                 `json:"refreshService"`
+
+	// RevNonceRangeID Take the revocation nonce of the credential from this reserved range
+	RevNonceRangeID *uuid.UUID `json:"revNonceRangeID,omitempty"`
+	SignatureProof  *bool      `json:"signatureProof,omitempty"`
+
+	// TermsOfUse Entries of the termsOfUse section of the credential. Each entry needs a type and the credential is
+	// rejected when its schema does not allow the section.
+	TermsOfUse *[]TermsOfUse `json:"termsOfUse,omitempty"`
+	Type       string        `json:"type"`
+}
+
+// CreateIssuerProfileRequest defines model for CreateIssuerProfileRequest.
+type CreateIssuerProfileRequest struct {
+	// AllowedProofs Proofs the credentials of the profile can be issued with, BJJSignature2021 and/or
+	// Iden3SparseMerkleTreeProof. All of them if omitted.
+	AllowedProofs *[]string `json:"allowedProofs,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	LogoUrl       *string   `json:"logoUrl,omitempty"`
+	Name          string    `json:"name"`
+}
+
+// CreateLinkBatchRequest defines model for CreateLinkBatchRequest.
+type CreateLinkBatchRequest struct {
+	Count int `json:"count"`
+
+	// ExternalIds One external id per link, e.g. the id of the recipient in the mailing system.
+	ExternalIds *[]string `json:"externalIds,omitempty"`
+}
+
+// CreateLinkBatchResponse defines model for CreateLinkBatchResponse.
+type CreateLinkBatchResponse struct {
+	Links []LinkBatchItem `json:"links"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
@@ -140,12 +561,97 @@ type CreateLinkRequest struct {
 	CredentialExpiration *time.Time        `json:"credentialExpiration,omitempty"`
 	CredentialSubject    CredentialSubject `json:"credentialSubject"`
 	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
-	Expiration           *time.Time        `json:"expiration,omitempty"`
-	LimitedClaims        *int              `json:"limitedClaims"`
-	MtProof              bool              `json:"mtProof"`
-	RefreshService       *RefreshService   `json:"refreshService"`
-	SchemaID             uuid.UUID         `json:"schemaID"`
-	SignatureProof       bool              `json:"signatureProof"`
+
+	// Evidence Entries of the evidence section of every credential issued through the link
+	Evidence   *[]Evidence `json:"evidence,omitempty"`
+	Expiration *time.Time  `json:"expiration,omitempty"`
+
+	// ExternalId Integrator reference copied to every credential issued through the link
+	ExternalId    *string `json:"externalId,omitempty"`
+	LimitedClaims *int    `json:"limitedClaims"`
+
+	// Locale Locale of the strings shown to the holders of the link, e.g. es or pt-BR, used when the holder does not request one.
+	// The credential offer description uses it.
+	Locale  *string `json:"locale,omitempty"`
+	MtProof bool    `json:"mtProof"`
+
+	// Payment On-chain payment the holder has to make before the credential is issued
+	Payment *LinkPayment `json:"payment,omitempty"`
+
+	// Prerequisites Credentials the holder must hold, and not revoked, before the credential is issued
+	Prerequisites *[]LinkPrerequisite `json:"prerequisites,omitempty"`
+
+	// PresentationTemplate Name of the presentation template the holder must satisfy before the credential is issued
+	PresentationTemplate *string         `json:"presentationTemplate,omitempty"`
+	RefreshService       *RefreshService `json:"refreshService"`
+
+	// Restrictions Networks and countries a link can be claimed from, so region limited offers, like event badges, can not be
+	// claimed remotely. They are checked against the ip of the client when the QR code is created and when the wallet
+	// sends the callback. The countries require a geoip provider (ISSUER_GEOIP_TYPE). Empty lists do not restrict anything.
+	Restrictions *LinkRestrictions `json:"restrictions,omitempty"`
+
+	// ResultWebhook Url the node posts the result of every credential issued through the link to, with the link, the holder DID, the
+	// credential id and its attributes. The body is signed with the secret: the X-Issuer-Signature header is
+	// sha256=<hex encoded HMAC-SHA256 of the body>. The secret is never returned.
+	ResultWebhook  *LinkResultWebhook `json:"resultWebhook,omitempty"`
+	SchemaID       uuid.UUID          `json:"schemaID"`
+	SignatureProof bool               `json:"signatureProof"`
+
+	// TermsOfUse Entries of the termsOfUse section of every credential issued through the link
+	TermsOfUse *[]TermsOfUse `json:"termsOfUse,omitempty"`
+
+	// Throttle Limits how fast and when a link can be claimed, to protect the downstream systems and the state publishing from
+	// spikes. The link answers with a 429 error and a Retry-After header when it reached its claims per hour or it is
+	// out of its time windows, both when the QR code is created and when the wallet sends the callback.
+	Throttle *LinkThrottle `json:"throttle,omitempty"`
+}
+
+// CreatePresentationTemplateRequest defines model for CreatePresentationTemplateRequest.
+type CreatePresentationTemplateRequest struct {
+	// AllowedIssuers DIDs of the issuers whose credentials are accepted. Defaults to any issuer (*)
+	AllowedIssuers *[]string                `json:"allowedIssuers,omitempty"`
+	Conditions     *[]PresentationCondition `json:"conditions,omitempty"`
+	CredentialType string                   `json:"credentialType"`
+	Description    *string                  `json:"description,omitempty"`
+
+	// DisclosedFields Fields the holder reveals to the issuer
+	DisclosedFields *[]string `json:"disclosedFields,omitempty"`
+	Name            string    `json:"name"`
+
+	// ProofType Proof of the presented credential. Defaults to BJJSignature2021
+	ProofType *CreatePresentationTemplateRequestProofType `json:"proofType,omitempty"`
+
+	// SchemaUrl JSON-LD context of the credential the holder has to present
+	SchemaUrl string `json:"schemaUrl"`
+}
+
+// CreatePresentationTemplateRequestProofType Proof of the presented credential. Defaults to BJJSignature2021
+type CreatePresentationTemplateRequestProofType string
+
+// CreateReadOnlyAPIKeyRequest defines model for CreateReadOnlyAPIKeyRequest.
+type CreateReadOnlyAPIKeyRequest struct {
+	// AllowedIPs IPs or CIDR ranges the key can be used from. Any IP if empty.
+	AllowedIPs *[]string `json:"allowedIPs,omitempty"`
+	Name       string    `json:"name"`
+
+	// TenantId Tenant the key is scoped to.
+	TenantId *uuid.UUID `json:"tenantId,omitempty"`
+
+	// ValidForMinutes Minutes the key can be used for, at most 1440
+	ValidForMinutes int `json:"validForMinutes"`
+}
+
+// CreateRevocationRuleRequest defines model for CreateRevocationRuleRequest.
+type CreateRevocationRuleRequest struct {
+	CronExpression string `json:"cronExpression"`
+
+	// Enabled Defaults to true
+	Enabled    *bool  `json:"enabled,omitempty"`
+	Name       string `json:"name"`
+	SchemaType string `json:"schemaType"`
+
+	// WebhookUrl Url that receives a POST request with the result of every run
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
 }
 
 // Credential defines model for Credential.
@@ -153,19 +659,140 @@ type Credential struct {
 	CreatedAt         TimeUTC                `json:"createdAt"`
 	CredentialSubject map[string]interface{} `json:"credentialSubject"`
 	DisplayMethod     *DisplayMethod         `json:"displayMethod,omitempty"`
+	Evidence          *[]Evidence            `json:"evidence,omitempty"`
 	Expired           bool                   `json:"expired"`
 	ExpiresAt         *TimeUTC               `json:"expiresAt"`
+	ExternalId        *string                `json:"externalId,omitempty"`
 	Id                uuid.UUID              `json:"id"`
-	ProofTypes        []string               `json:"proofTypes"`
-	RefreshService    *RefreshService        `json:"refreshService"`
-	RevNonce          uint64                 `json:"revNonce"`
-	Revoked           bool                   `json:"revoked"`
-	SchemaHash        string                 `json:"schemaHash"`
-	SchemaType        string                 `json:"schemaType"`
-	SchemaUrl         string                 `json:"schemaUrl"`
-	UserID            string                 `json:"userID"`
+
+	// ProfileId Issuer profile the credential was issued on behalf of
+	ProfileId      *uuid.UUID      `json:"profileId,omitempty"`
+	ProofTypes     []string        `json:"proofTypes"`
+	RefreshService *RefreshService `json:"refreshService"`
+	RevNonce       uint64          `json:"revNonce"`
+	Revoked        bool            `json:"revoked"`
+	SchemaHash     string          `json:"schemaHash"`
+	SchemaType     string          `json:"schemaType"`
+	SchemaUrl      string          `json:"schemaUrl"`
+	TermsOfUse     *[]TermsOfUse   `json:"termsOfUse,omitempty"`
+	UserID         string          `json:"userID"`
+}
+
+// CredentialDependent defines model for CredentialDependent.
+type CredentialDependent struct {
+	// Depth 1 for the direct dependents of the credential, 2 for their dependents, and so on
+	Depth int       `json:"depth"`
+	Id    uuid.UUID `json:"id"`
+
+	// PrerequisiteId Credential this one was issued after, because the holder met a link prerequisite with it
+	PrerequisiteId uuid.UUID `json:"prerequisiteId"`
+	RevNonce       uint64    `json:"revNonce"`
+	SchemaType     string    `json:"schemaType"`
+}
+
+// CredentialDisplay Document of the Iden3BasicDisplayMethodV1 display method of the credential
+type CredentialDisplay struct {
+	BackgroundImageUrl   *string `json:"backgroundImageUrl,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	DescriptionTextColor *string `json:"descriptionTextColor,omitempty"`
+	IssuerName           *string `json:"issuerName,omitempty"`
+	IssuerTextColor      *string `json:"issuerTextColor,omitempty"`
+	Logo                 *struct {
+		Alt *string `json:"alt,omitempty"`
+		Uri *string `json:"uri,omitempty"`
+	} `json:"logo,omitempty"`
+	Title          *string `json:"title,omitempty"`
+	TitleTextColor *string `json:"titleTextColor,omitempty"`
+}
+
+// CredentialFetchPolicy Who can fetch the credential from the agent:
+//   - `subject` - (default value) Only the subject DID of the credential.
+//   - `profiles` - The subject DID and the profiles derived from it. Holders fetching the credential from a profile
+//     send its nonce in the `profileNonce` field of the fetch request body.
+//   - `freshAuth` - Only the subject DID, if it authenticated with the issuer in the last `freshAuthMinutes` minutes.
+type CredentialFetchPolicy struct {
+	// FreshAuthMinutes Only for the `freshAuth` policy
+	FreshAuthMinutes *int                      `json:"freshAuthMinutes,omitempty"`
+	Type             CredentialFetchPolicyType `json:"type"`
+}
+
+// CredentialFetchPolicyType defines model for CredentialFetchPolicy.Type.
+type CredentialFetchPolicyType string
+
+// CredentialImport defines model for CredentialImport.
+type CredentialImport struct {
+	// CredentialId Credential issued from the imported document
+	CredentialId uuid.UUID `json:"credentialId"`
+
+	// DocumentHash sha256 of the imported document, with its keys sorted
+	DocumentHash string    `json:"documentHash"`
+	Id           uuid.UUID `json:"id"`
+	ImportedAt   TimeUTC   `json:"importedAt"`
+
+	// OriginalId Id of the imported document
+	OriginalId *string `json:"originalId,omitempty"`
+
+	// OriginalIssuer Issuer of the imported document
+	OriginalIssuer  *string `json:"originalIssuer,omitempty"`
+	Source          string  `json:"source"`
+	SourceReference *string `json:"sourceReference,omitempty"`
+}
+
+// CredentialLayout defines model for CredentialLayout.
+type CredentialLayout struct {
+	// Entries Merklized tree entries of the credential subject fields. Empty for non merklized schemas.
+	Entries []CredentialLayoutEntry `json:"entries"`
+
+	// HIndex Hash of the index slots
+	HIndex string `json:"hIndex"`
+
+	// HValue Hash of the value slots
+	HValue string `json:"hValue"`
+
+	// Hash Hash of hIndex and hValue. The one signed by the BJJSignature2021 proofs
+	Hash       string                 `json:"hash"`
+	Merklized  bool                   `json:"merklized"`
+	SchemaHash string                 `json:"schemaHash"`
+	Slots      []CredentialLayoutSlot `json:"slots"`
+}
+
+// CredentialLayoutEntry defines model for CredentialLayoutEntry.
+type CredentialLayoutEntry struct {
+	Field string `json:"field"`
+
+	// Key Merklized tree key of the field path as a decimal integer
+	Key string `json:"key"`
+
+	// Value Merklized tree value of the field as a decimal integer
+	Value string `json:"value"`
+}
+
+// CredentialLayoutRequest defines model for CredentialLayoutRequest.
+type CredentialLayoutRequest struct {
+	CredentialSchema  string                 `json:"credentialSchema"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Expiration        *time.Time             `json:"expiration,omitempty"`
+
+	// RevNonce Revocation nonce of the claim. Zero if omitted.
+	RevNonce *uint64 `json:"revNonce,omitempty"`
+	Type     string  `json:"type"`
+}
+
+// CredentialLayoutSlot defines model for CredentialLayoutSlot.
+type CredentialLayoutSlot struct {
+	Contents CredentialLayoutSlotContents `json:"contents"`
+
+	// Field Credential subject field serialized in the slot
+	Field *string `json:"field,omitempty"`
+	Name  string  `json:"name"`
+
+	// Value Slot value as a decimal integer
+	Value string `json:"value"`
 }
 
+// CredentialLayoutSlotContents defines model for CredentialLayoutSlot.Contents.
+type CredentialLayoutSlotContents string
+
 // CredentialLinkQrCodeResponse defines model for CredentialLinkQrCodeResponse.
 type CredentialLinkQrCodeResponse struct {
 	Issuer     IssuerDescription `json:"issuer"`
@@ -175,15 +802,94 @@ type CredentialLinkQrCodeResponse struct {
 	SessionID  string            `json:"sessionID"`
 }
 
+// CredentialPDFField defines model for CredentialPDFField.
+type CredentialPDFField struct {
+	Attribute string `json:"attribute"`
+	Label     string `json:"label"`
+}
+
+// CredentialPDFTemplate defines model for CredentialPDFTemplate.
+type CredentialPDFTemplate struct {
+	AccentColor string `json:"accentColor"`
+
+	// Fields Credential subject attributes rendered, in order, with their labels.
+	Fields []CredentialPDFField `json:"fields"`
+	Footer string               `json:"footer"`
+	Header string               `json:"header"`
+
+	// Title Document title. The credential type is used when empty.
+	Title string `json:"title"`
+}
+
+// CredentialPreview defines model for CredentialPreview.
+type CredentialPreview struct {
+	Attributes   []CredentialPreviewAttribute `json:"attributes"`
+	CredentialId string                       `json:"credentialId"`
+
+	// Display Document of the Iden3BasicDisplayMethodV1 display method of the credential
+	Display        *CredentialDisplay      `json:"display,omitempty"`
+	ExpirationDate *TimeUTC                `json:"expirationDate,omitempty"`
+	Id             uuid.UUID               `json:"id"`
+	IssuanceDate   *TimeUTC                `json:"issuanceDate,omitempty"`
+	Issuer         string                  `json:"issuer"`
+	SchemaType     string                  `json:"schemaType"`
+	SchemaUrl      string                  `json:"schemaUrl"`
+	Status         CredentialPreviewStatus `json:"status"`
+}
+
+// CredentialPreviewStatus defines model for CredentialPreview.Status.
+type CredentialPreviewStatus string
+
+// CredentialPreviewAttribute defines model for CredentialPreviewAttribute.
+type CredentialPreviewAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // CredentialSubject defines model for CredentialSubject.
 type CredentialSubject = map[string]interface{}
 
+// CredentialSubjectChange defines model for CredentialSubjectChange.
+type CredentialSubjectChange struct {
+	// After Value in this version, null if the attribute was removed
+	After     interface{} `json:"after"`
+	Attribute string      `json:"attribute"`
+
+	// Before Value in the previous version, null if the attribute was added
+	Before interface{} `json:"before"`
+}
+
+// CredentialVersion defines model for CredentialVersion.
+type CredentialVersion struct {
+	Changes              []CredentialSubjectChange `json:"changes"`
+	CreatedAt            TimeUTC                   `json:"createdAt"`
+	CredentialId         uuid.UUID                 `json:"credentialId"`
+	Id                   uuid.UUID                 `json:"id"`
+	PreviousCredentialId uuid.UUID                 `json:"previousCredentialId"`
+	Version              int                       `json:"version"`
+}
+
 // CredentialsPaginated defines model for CredentialsPaginated.
 type CredentialsPaginated struct {
 	Items []Credential      `json:"items"`
 	Meta  PaginatedMetadata `json:"meta"`
 }
 
+// DailyCount defines model for DailyCount.
+type DailyCount struct {
+	Count int                `json:"count"`
+	Day   openapi_types.Date `json:"day"`
+}
+
+// DeadConnection defines model for DeadConnection.
+type DeadConnection struct {
+	// Credentials Number of credentials of the connection, all revoked or expired
+	Credentials    int       `json:"credentials"`
+	Id             uuid.UUID `json:"id"`
+	LastActivityAt TimeUTC   `json:"lastActivityAt"`
+	UserID         string    `json:"userID"`
+}
+
 // DisplayMethod defines model for DisplayMethod.
 type DisplayMethod struct {
 	Id   string            `json:"id"`
@@ -193,6 +899,9 @@ type DisplayMethod struct {
 // DisplayMethodType defines model for DisplayMethod.Type.
 type DisplayMethodType string
 
+// Evidence Entry of the evidence section of the credential
+type Evidence map[string]interface{}
+
 // GenericErrorMessage defines model for GenericErrorMessage.
 type GenericErrorMessage struct {
 	Message string `json:"message"`
@@ -210,11 +919,20 @@ type GetAuthenticationConnectionResponse struct {
 
 // GetConnectionResponse defines model for GetConnectionResponse.
 type GetConnectionResponse struct {
-	CreatedAt   TimeUTC      `json:"createdAt"`
-	Credentials []Credential `json:"credentials"`
-	Id          string       `json:"id"`
-	IssuerID    string       `json:"issuerID"`
-	UserID      string       `json:"userID"`
+	CreatedAt      TimeUTC      `json:"createdAt"`
+	Credentials    []Credential `json:"credentials"`
+	DisplayName    *string      `json:"displayName,omitempty"`
+	Email          *string      `json:"email,omitempty"`
+	ExternalId     *string      `json:"externalId,omitempty"`
+	Id             string       `json:"id"`
+	IssuerID       string       `json:"issuerID"`
+	LastVerifiedAt *TimeUTC     `json:"lastVerifiedAt"`
+
+	// Trust Whether the DID is trusted. The source tells where the answer comes from: the trust registry, the allow
+	// or deny lists of the configuration, unavailable if the registry could not be consulted or disabled if
+	// there is no trust registry configured.
+	Trust  *TrustStatus `json:"trust,omitempty"`
+	UserID string       `json:"userID"`
 }
 
 // GetConnectionsResponse defines model for GetConnectionsResponse.
@@ -230,21 +948,110 @@ type GetLinkQrCodeResponse struct {
 // Health defines model for Health.
 type Health map[string]bool
 
+// HistoricalRevocationStatusResponse defines model for HistoricalRevocationStatusResponse.
+type HistoricalRevocationStatusResponse struct {
+	PublishedState   PublishedState           `json:"publishedState"`
+	RevocationStatus RevocationStatusResponse `json:"revocationStatus"`
+
+	// Revoked Whether the nonce was in the revocation tree of the state
+	Revoked bool `json:"revoked"`
+}
+
+// HolderRevocationRequest defines model for HolderRevocationRequest.
+type HolderRevocationRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// HolderSession defines model for HolderSession.
+type HolderSession struct {
+	ExpiresAt TimeUTC `json:"expiresAt"`
+	Token     string  `json:"token"`
+	UserID    string  `json:"userID"`
+}
+
+// ImportCredentialRequest defines model for ImportCredentialRequest.
+type ImportCredentialRequest struct {
+	// Credential W3C credential built by another system. Its proofs are ignored.
+	Credential     map[string]interface{} `json:"credential"`
+	MtProof        *bool                  `json:"mtProof,omitempty"`
+	SignatureProof *bool                  `json:"signatureProof,omitempty"`
+
+	// Source System the credential comes from
+	Source string `json:"source"`
+
+	// SourceReference Reference of the credential in the source system, stored as the external id of the credential
+	SourceReference *string `json:"sourceReference,omitempty"`
+}
+
 // ImportSchemaRequest defines model for ImportSchemaRequest.
 type ImportSchemaRequest struct {
-	Description *string `json:"description,omitempty"`
-	SchemaType  string  `json:"schemaType"`
-	Title       *string `json:"title,omitempty"`
-	Url         string  `json:"url"`
-	Version     string  `json:"version"`
+	// DefaultExpirationDays Days the credentials issued without expiration are valid
+	DefaultExpirationDays *int    `json:"defaultExpirationDays,omitempty"`
+	Description           *string `json:"description,omitempty"`
+
+	// MaxExpirationDays Maximum days the credentials are valid
+	MaxExpirationDays *int    `json:"maxExpirationDays,omitempty"`
+	SchemaType        string  `json:"schemaType"`
+	Title             *string `json:"title,omitempty"`
+
+	// UniqueAttributes credentialSubject attributes that identify a single active credential of the schema
+	UniqueAttributes *[]string `json:"uniqueAttributes,omitempty"`
+
+	// UniqueStrategy What happens to a new credential with the unique key of an active one. Reject by default.
+	UniqueStrategy *SchemaUniqueStrategy `json:"uniqueStrategy,omitempty"`
+	Url            string                `json:"url"`
+	Version        string                `json:"version"`
 }
 
 // IssuerDescription defines model for IssuerDescription.
 type IssuerDescription struct {
-	DisplayName string `json:"displayName"`
-	Logo        string `json:"logo"`
+	// Description Issuer description in the requested locale, if translated
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"displayName"`
+	Logo        string  `json:"logo"`
+}
+
+// IssuerProfile defines model for IssuerProfile.
+type IssuerProfile struct {
+	AllowedProofs []string  `json:"allowedProofs"`
+	CreatedAt     TimeUTC   `json:"createdAt"`
+	Description   *string   `json:"description,omitempty"`
+	Did           string    `json:"did"`
+	Id            uuid.UUID `json:"id"`
+	LogoUrl       *string   `json:"logoUrl,omitempty"`
+	Name          string    `json:"name"`
+}
+
+// KeyMigration defines model for KeyMigration.
+type KeyMigration struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// Error Error of the failed step
+	Error              *string            `json:"error,omitempty"`
+	Id                 uuid.UUID          `json:"id"`
+	ModifiedAt         TimeUTC            `json:"modifiedAt"`
+	NewAuthClaimId     *uuid.UUID         `json:"newAuthClaimId,omitempty"`
+	OldAuthClaimId     uuid.UUID          `json:"oldAuthClaimId"`
+	RevokeOldAuthClaim bool               `json:"revokeOldAuthClaim"`
+	Status             KeyMigrationStatus `json:"status"`
+
+	// Step Current step, done when the migration is completed
+	Step  string             `json:"step"`
+	Steps []KeyMigrationStep `json:"steps"`
+}
+
+// KeyMigrationStatus defines model for KeyMigrationStatus.
+type KeyMigrationStatus string
+
+// KeyMigrationStep defines model for KeyMigrationStep.
+type KeyMigrationStep struct {
+	Status KeyMigrationStatus   `json:"status"`
+	Step   KeyMigrationStepStep `json:"step"`
 }
 
+// KeyMigrationStepStep defines model for KeyMigrationStep.Step.
+type KeyMigrationStepStep string
+
 // KeyValue defines model for KeyValue.
 type KeyValue struct {
 	Key   string `json:"key"`
@@ -258,21 +1065,128 @@ type Link struct {
 	CredentialExpiration *TimeUTC          `json:"credentialExpiration"`
 	CredentialSubject    CredentialSubject `json:"credentialSubject"`
 	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
+	Evidence             *[]Evidence       `json:"evidence,omitempty"`
 	Expiration           *TimeUTC          `json:"expiration"`
+	ExternalId           *string           `json:"externalId,omitempty"`
 	Id                   uuid.UUID         `json:"id"`
 	IssuedClaims         int               `json:"issuedClaims"`
+	Locale               *string           `json:"locale,omitempty"`
 	MaxIssuance          *int              `json:"maxIssuance"`
-	ProofTypes           []string          `json:"proofTypes"`
-	RefreshService       *RefreshService   `json:"refreshService"`
-	SchemaHash           string            `json:"schemaHash"`
-	SchemaType           string            `json:"schemaType"`
-	SchemaUrl            string            `json:"schemaUrl"`
-	Status               LinkStatus        `json:"status"`
+
+	// Payment On-chain payment the holder has to make before the credential is issued
+	Payment              *LinkPayment        `json:"payment,omitempty"`
+	Prerequisites        *[]LinkPrerequisite `json:"prerequisites,omitempty"`
+	PresentationTemplate *string             `json:"presentationTemplate,omitempty"`
+	ProofTypes           []string            `json:"proofTypes"`
+	RefreshService       *RefreshService     `json:"refreshService"`
+
+	// Restrictions Networks and countries a link can be claimed from, so region limited offers, like event badges, can not be
+	// claimed remotely. They are checked against the ip of the client when the QR code is created and when the wallet
+	// sends the callback. The countries require a geoip provider (ISSUER_GEOIP_TYPE). Empty lists do not restrict anything.
+	Restrictions *LinkRestrictions `json:"restrictions,omitempty"`
+
+	// ResultWebhookURL Url the result of every issuance of the link is posted to
+	ResultWebhookURL *string       `json:"resultWebhookURL,omitempty"`
+	SchemaHash       string        `json:"schemaHash"`
+	SchemaType       string        `json:"schemaType"`
+	SchemaUrl        string        `json:"schemaUrl"`
+	Status           LinkStatus    `json:"status"`
+	TermsOfUse       *[]TermsOfUse `json:"termsOfUse,omitempty"`
+
+	// Throttle Limits how fast and when a link can be claimed, to protect the downstream systems and the state publishing from
+	// spikes. The link answers with a 429 error and a Retry-After header when it reached its claims per hour or it is
+	// out of its time windows, both when the QR code is created and when the wallet sends the callback.
+	Throttle *LinkThrottle `json:"throttle,omitempty"`
 }
 
 // LinkStatus defines model for Link.Status.
 type LinkStatus string
 
+// LinkBatchItem defines model for LinkBatchItem.
+type LinkBatchItem struct {
+	ExternalId *string   `json:"externalId,omitempty"`
+	Id         uuid.UUID `json:"id"`
+
+	// QrCodeUrl Endpoint to create the QR code of the link.
+	QrCodeUrl string `json:"qrCodeUrl"`
+}
+
+// LinkPayment On-chain payment the holder has to make before the credential is issued
+type LinkPayment struct {
+	// Amount Price in the smallest unit of the token, e.g. wei
+	Amount      string  `json:"amount"`
+	ChainId     int     `json:"chainId"`
+	Description *string `json:"description,omitempty"`
+
+	// Recipient Address that receives the payment
+	Recipient string `json:"recipient"`
+
+	// Tokens Tokens the holder can pay with
+	Tokens []PaymentToken `json:"tokens"`
+}
+
+// LinkPrerequisite Credential the holder has to hold before a link issues its credential. The holder proves it, and that it is
+// not revoked, with a zero knowledge proof that discloses nothing else about the credential.
+type LinkPrerequisite struct {
+	// AllowedIssuers DIDs of the issuers whose credentials are accepted. Defaults to any issuer (*)
+	AllowedIssuers *[]string `json:"allowedIssuers,omitempty"`
+	CredentialType string    `json:"credentialType"`
+
+	// ProofType Proof of the prerequisite credential. Defaults to BJJSignature2021
+	ProofType *LinkPrerequisiteProofType `json:"proofType,omitempty"`
+
+	// SchemaUrl JSON-LD context of the prerequisite credential
+	SchemaUrl string `json:"schemaUrl"`
+}
+
+// LinkPrerequisiteProofType Proof of the prerequisite credential. Defaults to BJJSignature2021
+type LinkPrerequisiteProofType string
+
+// LinkPrerequisiteProof defines model for LinkPrerequisiteProof.
+type LinkPrerequisiteProof struct {
+	CreatedAt      TimeUTC   `json:"createdAt"`
+	CredentialType string    `json:"credentialType"`
+	Id             uuid.UUID `json:"id"`
+
+	// Proof Zero knowledge proof response the holder sent for the prerequisite
+	Proof     map[string]interface{} `json:"proof"`
+	SchemaUrl string                 `json:"schemaUrl"`
+	SessionID uuid.UUID              `json:"sessionID"`
+	UserDID   string                 `json:"userDID"`
+}
+
+// LinkRecipient defines model for LinkRecipient.
+type LinkRecipient struct {
+	// ClaimUrl Landing page of the link with the code of the recipient. Only when the landing page is enabled.
+	ClaimUrl   *string  `json:"claimUrl,omitempty"`
+	Code       string   `json:"code"`
+	ConsumedAt *TimeUTC `json:"consumedAt"`
+
+	// CredentialId Credential issued to the recipient, once the code is used.
+	CredentialId      *uuid.UUID             `json:"credentialId,omitempty"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Id                uuid.UUID              `json:"id"`
+}
+
+// LinkRestrictions Networks and countries a link can be claimed from, so region limited offers, like event badges, can not be
+// claimed remotely. They are checked against the ip of the client when the QR code is created and when the wallet
+// sends the callback. The countries require a geoip provider (ISSUER_GEOIP_TYPE). Empty lists do not restrict anything.
+type LinkRestrictions struct {
+	// Countries ISO 3166-1 alpha-2 codes of the allowed countries
+	Countries *[]string `json:"countries,omitempty"`
+
+	// IpRanges CIDR ranges or single ips of the allowed clients
+	IpRanges *[]string `json:"ipRanges,omitempty"`
+}
+
+// LinkResultWebhook Url the node posts the result of every credential issued through the link to, with the link, the holder DID, the
+// credential id and its attributes. The body is signed with the secret: the X-Issuer-Signature header is
+// sha256=<hex encoded HMAC-SHA256 of the body>. The secret is never returned.
+type LinkResultWebhook struct {
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
 // LinkSimple defines model for LinkSimple.
 type LinkSimple struct {
 	Id         uuid.UUID `json:"id"`
@@ -282,46 +1196,377 @@ type LinkSimple struct {
 	SchemaUrl  string    `json:"schemaUrl"`
 }
 
-// PaginatedMetadata defines model for PaginatedMetadata.
-type PaginatedMetadata struct {
-	MaxResults uint `json:"max_results"`
-	Page       uint `json:"page"`
-	Total      uint `json:"total"`
-}
+// LinkThrottle Limits how fast and when a link can be claimed, to protect the downstream systems and the state publishing from
+// spikes. The link answers with a 429 error and a Retry-After header when it reached its claims per hour or it is
+// out of its time windows, both when the QR code is created and when the wallet sends the callback.
+type LinkThrottle struct {
+	// MaxClaimsPerHour Max number of credentials issued by the link in the last hour
+	MaxClaimsPerHour *int `json:"maxClaimsPerHour,omitempty"`
 
-// PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
-type PublishIdentityStateResponse struct {
-	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
-	RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
-	RootOfRoots        *string `json:"rootOfRoots,omitempty"`
-	State              *string `json:"state,omitempty"`
-	TxID               *string `json:"txID,omitempty"`
-}
+	// TimeZone IANA time zone of the windows, UTC by default
+	TimeZone *string `json:"timeZone,omitempty"`
 
-// QrCodeLinkShortResponse defines model for QrCodeLinkShortResponse.
-type QrCodeLinkShortResponse struct {
-	QrCodeLink string     `json:"qrCodeLink"`
-	SessionID  UUIDString `json:"sessionID"`
+	// Windows Periods the link can be claimed in. Any time if empty
+	Windows *[]LinkTimeWindow `json:"windows,omitempty"`
 }
 
-// QrCodeLinkWithSchemaTypeShortResponse defines model for QrCodeLinkWithSchemaTypeShortResponse.
-type QrCodeLinkWithSchemaTypeShortResponse struct {
-	QrCodeLink string `json:"qrCodeLink"`
-	SchemaType string `json:"schemaType"`
+// LinkTimeWindow Daily period, the window ends the next day if end is not after start
+type LinkTimeWindow struct {
+	// Days Week days the window starts on, 0 is Sunday. Every day if empty
+	Days *[]int `json:"days,omitempty"`
+
+	// End HH:MM
+	End string `json:"end"`
+
+	// Start HH:MM
+	Start string `json:"start"`
 }
 
-// RefreshService defines model for RefreshService.
-type RefreshService struct {
-	Id   string             `json:"id"`
-	Type RefreshServiceType `json:"type"`
+// MergeConnectionRequest defines model for MergeConnectionRequest.
+type MergeConnectionRequest struct {
+	// ConnectionID Id of the duplicate connection, that is removed
+	ConnectionID uuid.UUID `json:"connectionID"`
+}
+
+// Migration defines model for Migration.
+type Migration struct {
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"appliedAt,omitempty"`
+
+	// DurationMs Only for the migrations applied by the request
+	DurationMs *int64 `json:"durationMs,omitempty"`
+	Name       string `json:"name"`
+	Version    int64  `json:"version"`
+}
+
+// MigrationsStatus defines model for MigrationsStatus.
+type MigrationsStatus struct {
+	// CurrentVersion Highest version applied
+	CurrentVersion int64       `json:"currentVersion"`
+	Migrations     []Migration `json:"migrations"`
+	Pending        int         `json:"pending"`
+}
+
+// MonthlyGasSpend defines model for MonthlyGasSpend.
+type MonthlyGasSpend struct {
+	GasUsed int64 `json:"gasUsed"`
+
+	// Month First day of the month
+	Month            openapi_types.Date `json:"month"`
+	StateTransitions int                `json:"stateTransitions"`
+
+	// TxFee Transaction fees in wei
+	TxFee string `json:"txFee"`
+
+	// TxFeeFiat Transaction fees in the fiat currency, converted with the price of the native token when each transaction was confirmed
+	TxFeeFiat string `json:"txFeeFiat"`
+}
+
+// OrganizationCredential defines model for OrganizationCredential.
+type OrganizationCredential struct {
+	CreatedAt  TimeUTC         `json:"createdAt"`
+	Credential json.RawMessage `json:"credential"`
+	Expired    bool            `json:"expired"`
+	ExpiresAt  *TimeUTC        `json:"expiresAt"`
+	Id         uuid.UUID       `json:"id"`
+	Issuer     string          `json:"issuer"`
+	Public     bool            `json:"public"`
+	Type       string          `json:"type"`
+}
+
+// OrganizationPresentation defines model for OrganizationPresentation.
+type OrganizationPresentation struct {
+	Context              []string          `json:"@context"`
+	Holder               string            `json:"holder"`
+	Type                 []string          `json:"type"`
+	VerifiableCredential []json.RawMessage `json:"verifiableCredential"`
+}
+
+// PaginatedMetadata defines model for PaginatedMetadata.
+type PaginatedMetadata struct {
+	MaxResults uint `json:"max_results"`
+	Page       uint `json:"page"`
+	Total      uint `json:"total"`
+}
+
+// PaymentToken defines model for PaymentToken.
+type PaymentToken struct {
+	// Address Address of the ERC-20 contract. Empty for the native currency of the chain
+	Address  *string `json:"address,omitempty"`
+	Currency string  `json:"currency"`
+}
+
+// PendingStateCredential defines model for PendingStateCredential.
+type PendingStateCredential struct {
+	Id         uuid.UUID `json:"id"`
+	SchemaType string    `json:"schemaType"`
+	UserID     string    `json:"userID"`
+}
+
+// PendingStateResponse defines model for PendingStateResponse.
+type PendingStateResponse struct {
+	Credentials []PendingStateCredential `json:"credentials"`
+	Revocations []PendingStateRevocation `json:"revocations"`
+	Summary     PendingStateSummary      `json:"summary"`
+}
+
+// PendingStateRevocation defines model for PendingStateRevocation.
+type PendingStateRevocation struct {
+	// CredentialID Credential revoked. Empty when the nonce does not belong to any credential of the issuer
+	CredentialID *uuid.UUID `json:"credentialID,omitempty"`
+	Nonce        uint64     `json:"nonce"`
+	SchemaType   *string    `json:"schemaType,omitempty"`
+}
+
+// PendingStateSummary defines model for PendingStateSummary.
+type PendingStateSummary struct {
+	ByType      []PendingStateTypeCount `json:"byType"`
+	Credentials int                     `json:"credentials"`
+	Revocations int                     `json:"revocations"`
+}
+
+// PendingStateTypeCount defines model for PendingStateTypeCount.
+type PendingStateTypeCount struct {
+	Credentials int    `json:"credentials"`
+	Revocations int    `json:"revocations"`
+	SchemaType  string `json:"schemaType"`
+}
+
+// PresentationCondition defines model for PresentationCondition.
+type PresentationCondition struct {
+	Field    string               `json:"field"`
+	Operator PresentationOperator `json:"operator"`
+
+	// Value Value to compare the field with. A list of values for the $in and $nin operators.
+	Value interface{} `json:"value"`
+}
+
+// PresentationOperator defines model for PresentationOperator.
+type PresentationOperator string
+
+// PresentationTemplate defines model for PresentationTemplate.
+type PresentationTemplate struct {
+	AllowedIssuers  []string                `json:"allowedIssuers"`
+	Conditions      []PresentationCondition `json:"conditions"`
+	CreatedAt       TimeUTC                 `json:"createdAt"`
+	CredentialType  string                  `json:"credentialType"`
+	Description     *string                 `json:"description,omitempty"`
+	DisclosedFields []string                `json:"disclosedFields"`
+	Id              uuid.UUID               `json:"id"`
+	Name            string                  `json:"name"`
+
+	// ProofRequests Zero knowledge proof requests sent to the holders
+	ProofRequests []map[string]interface{} `json:"proofRequests"`
+	ProofType     string                   `json:"proofType"`
+	SchemaUrl     string                   `json:"schemaUrl"`
+}
+
+// ProblemReportMessage iden3comm problem report message
+type ProblemReportMessage = protocol.ProblemReportMessage
+
+// PruneConnectionsRequest defines model for PruneConnectionsRequest.
+type PruneConnectionsRequest struct {
+	// Action archive or delete
+	Action string `json:"action"`
+
+	// BatchSize Connections pruned in the request. Defaults to ISSUER_CONNECTION_PRUNING_BATCH_SIZE.
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// InactiveDays Days without activity. Defaults to ISSUER_CONNECTION_PRUNING_INACTIVE_DAYS.
+	InactiveDays *int `json:"inactiveDays,omitempty"`
+}
+
+// PruneConnectionsResponse defines model for PruneConnectionsResponse.
+type PruneConnectionsResponse struct {
+	Action      string      `json:"action"`
+	Connections []uuid.UUID `json:"connections"`
+}
+
+// PublicCatalog defines model for PublicCatalog.
+type PublicCatalog struct {
+	Credentials []CatalogCredential `json:"credentials"`
+	Issuer      CatalogIssuer       `json:"issuer"`
+}
+
+// PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
+type PublishIdentityStateResponse struct {
+	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
+	RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
+	RootOfRoots        *string `json:"rootOfRoots,omitempty"`
+	State              *string `json:"state,omitempty"`
+	TxID               *string `json:"txID,omitempty"`
+}
+
+// PublishedState defines model for PublishedState.
+type PublishedState struct {
+	BlockNumber *int64  `json:"blockNumber,omitempty"`
+	PublishDate TimeUTC `json:"publishDate"`
+	State       string  `json:"state"`
+	TxID        *string `json:"txID,omitempty"`
+}
+
+// QRBranding defines model for QRBranding.
+type QRBranding struct {
+	BackgroundColor string `json:"backgroundColor"`
+
+	// ErrorCorrection QR error correction level. Use Q or H with big logos.
+	ErrorCorrection QRBrandingErrorCorrection `json:"errorCorrection"`
+	ForegroundColor string                    `json:"foregroundColor"`
+
+	// Logo Base64 encoded png or jpeg image embedded in the center of the QR code. Up to 256KB.
+	Logo *[]byte `json:"logo,omitempty"`
+}
+
+// QRBrandingErrorCorrection QR error correction level. Use Q or H with big logos.
+type QRBrandingErrorCorrection string
+
+// QrCodeLinkShortResponse defines model for QrCodeLinkShortResponse.
+type QrCodeLinkShortResponse struct {
+	QrCodeLink string     `json:"qrCodeLink"`
+	SessionID  UUIDString `json:"sessionID"`
+}
+
+// QrCodeLinkWithSchemaTypeShortResponse defines model for QrCodeLinkWithSchemaTypeShortResponse.
+type QrCodeLinkWithSchemaTypeShortResponse struct {
+	QrCodeLink string `json:"qrCodeLink"`
+	SchemaType string `json:"schemaType"`
+}
+
+// ReceiveOrganizationCredentialRequest defines model for ReceiveOrganizationCredentialRequest.
+type ReceiveOrganizationCredentialRequest struct {
+	// Credential W3C credential issued to the issuer DID
+	Credential json.RawMessage `json:"credential"`
+
+	// Public Whether the credential is presented to the verifiers. False by default.
+	Public *bool `json:"public,omitempty"`
+}
+
+// RedeemConnectionRebindingCodeRequest defines model for RedeemConnectionRebindingCodeRequest.
+type RedeemConnectionRebindingCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// RefreshService defines model for RefreshService.
+type RefreshService struct {
+	Id   string             `json:"id"`
+	Type RefreshServiceType `json:"type"`
 }
 
 // RefreshServiceType defines model for RefreshService.Type.
 type RefreshServiceType string
 
+// ReserveRevocationNonceRangeRequest defines model for ReserveRevocationNonceRangeRequest.
+type ReserveRevocationNonceRangeRequest struct {
+	// Integration Name of the integration the nonces are reserved for
+	Integration string `json:"integration"`
+
+	// Size Number of nonces of the range, up to ISSUER_REVOCATION_NONCES_MAX_RANGE_SIZE
+	Size uint64 `json:"size"`
+}
+
+// ResolveRevocationRequest defines model for ResolveRevocationRequest.
+type ResolveRevocationRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// RevocationNonceRange defines model for RevocationNonceRange.
+type RevocationNonceRange struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// End Last nonce of the range, included
+	End         uint64    `json:"end"`
+	Id          uuid.UUID `json:"id"`
+	Integration string    `json:"integration"`
+
+	// Next Next nonce handed out to the credentials created with revNonceRangeID, end + 1 when none is left
+	Next uint64 `json:"next"`
+
+	// Start First nonce of the range
+	Start uint64 `json:"start"`
+}
+
+// RevocationRequest defines model for RevocationRequest.
+type RevocationRequest struct {
+	// AutoApproved The credential was revoked at once following the self revocation policy of the schema
+	AutoApproved bool                    `json:"autoApproved"`
+	CreatedAt    TimeUTC                 `json:"createdAt"`
+	CredentialId uuid.UUID               `json:"credentialId"`
+	Id           uuid.UUID               `json:"id"`
+	Note         *string                 `json:"note,omitempty"`
+	Reason       string                  `json:"reason"`
+	ResolvedAt   *TimeUTC                `json:"resolvedAt,omitempty"`
+	Status       RevocationRequestStatus `json:"status"`
+	UserDID      string                  `json:"userDID"`
+}
+
+// RevocationRequestStatus defines model for RevocationRequestStatus.
+type RevocationRequestStatus string
+
+// RevocationRule defines model for RevocationRule.
+type RevocationRule struct {
+	CreatedAt      TimeUTC   `json:"createdAt"`
+	CronExpression string    `json:"cronExpression"`
+	Enabled        bool      `json:"enabled"`
+	Id             uuid.UUID `json:"id"`
+
+	// LastRevoked Number of credentials revoked by the last run
+	LastRevoked int      `json:"lastRevoked"`
+	LastRunAt   *TimeUTC `json:"lastRunAt"`
+	Name        string   `json:"name"`
+	NextRunAt   *TimeUTC `json:"nextRunAt"`
+	SchemaType  string   `json:"schemaType"`
+	WebhookUrl  *string  `json:"webhookUrl,omitempty"`
+}
+
+// RevocationRulePreview defines model for RevocationRulePreview.
+type RevocationRulePreview struct {
+	// CredentialIds Credentials the rule would revoke if it ran now
+	CredentialIds []uuid.UUID `json:"credentialIds"`
+
+	// NextRuns Next runs of the rule, none if it is disabled
+	NextRuns []TimeUTC      `json:"nextRuns"`
+	Rule     RevocationRule `json:"rule"`
+}
+
+// RevocationStatusBatchItem defines model for RevocationStatusBatchItem.
+type RevocationStatusBatchItem struct {
+	// ExpirationStatus valid, expired_grace or expired. expired_grace means the credential is expired but within the grace
+	// period of the issuer, so verifiers can still accept it while it is renewed. Missing for unknown nonces.
+	ExpirationStatus *string `json:"expirationStatus,omitempty"`
+	Mtp              struct {
+		Existence bool `json:"existence"`
+		NodeAux   *struct {
+			Key   *string `json:"key,omitempty"`
+			Value *string `json:"value,omitempty"`
+		} `json:"node_aux,omitempty"`
+		Siblings *[]string `json:"siblings"`
+	} `json:"mtp"`
+	Nonce   uint64 `json:"nonce"`
+	Revoked bool   `json:"revoked"`
+}
+
+// RevocationStatusBatchRequest defines model for RevocationStatusBatchRequest.
+type RevocationStatusBatchRequest struct {
+	Nonces []uint64 `json:"nonces"`
+}
+
+// RevocationStatusBatchResponse defines model for RevocationStatusBatchResponse.
+type RevocationStatusBatchResponse struct {
+	Issuer struct {
+		ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
+		RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
+		RootOfRoots        *string `json:"rootOfRoots,omitempty"`
+		State              *string `json:"state,omitempty"`
+	} `json:"issuer"`
+	Statuses []RevocationStatusBatchItem `json:"statuses"`
+}
+
 // RevocationStatusResponse defines model for RevocationStatusResponse.
 type RevocationStatusResponse struct {
-	Issuer struct {
+	// ExpirationStatus valid, expired_grace or expired. expired_grace means the credential is expired but within the grace
+	// period of the issuer, so verifiers can still accept it while it is renewed. Missing for unknown nonces.
+	ExpirationStatus *string `json:"expirationStatus,omitempty"`
+	Issuer           struct {
 		ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
 		RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
 		RootOfRoots        *string `json:"rootOfRoots,omitempty"`
@@ -342,17 +1587,99 @@ type RevokeCredentialResponse struct {
 	Message string `json:"message"`
 }
 
+// RunMigrationsResponse defines model for RunMigrationsResponse.
+type RunMigrationsResponse struct {
+	Applied []Migration `json:"applied"`
+
+	// CurrentVersion Highest version applied
+	CurrentVersion int64 `json:"currentVersion"`
+}
+
 // Schema defines model for Schema.
 type Schema struct {
-	BigInt      string  `json:"bigInt"`
-	CreatedAt   TimeUTC `json:"createdAt"`
-	Description *string `json:"description"`
-	Hash        string  `json:"hash"`
-	Id          string  `json:"id"`
-	Title       *string `json:"title"`
-	Type        string  `json:"type"`
-	Url         string  `json:"url"`
-	Version     string  `json:"version"`
+	BigInt                string  `json:"bigInt"`
+	CreatedAt             TimeUTC `json:"createdAt"`
+	DefaultExpirationDays *int    `json:"defaultExpirationDays,omitempty"`
+	Description           *string `json:"description"`
+	Hash                  string  `json:"hash"`
+	Id                    string  `json:"id"`
+	MaxExpirationDays     *int    `json:"maxExpirationDays,omitempty"`
+
+	// SelfRevocation How the revocation requests of the holders are handled. Disabled by default.
+	SelfRevocation   *SchemaSelfRevocation `json:"selfRevocation,omitempty"`
+	Title            *string               `json:"title"`
+	Type             string                `json:"type"`
+	UniqueAttributes *[]string             `json:"uniqueAttributes,omitempty"`
+
+	// UniqueStrategy What happens to a new credential with the unique key of an active one. Reject by default.
+	UniqueStrategy *SchemaUniqueStrategy `json:"uniqueStrategy,omitempty"`
+	Url            string                `json:"url"`
+	Version        string                `json:"version"`
+
+	// WebhookTemplate Shape of the bodies posted to the webhooks about the credentials of a schema. The string values can reference
+	// the fields of the original body with {{path}}, e.g. {{attributes.firstName}}. The other values are copied as
+	// they are.
+	WebhookTemplate *WebhookTemplate `json:"webhookTemplate,omitempty"`
+}
+
+// SchemaExpiration defines model for SchemaExpiration.
+type SchemaExpiration struct {
+	// DefaultExpirationDays Days the credentials issued without expiration are valid
+	DefaultExpirationDays *int `json:"defaultExpirationDays,omitempty"`
+
+	// MaxExpirationDays Maximum days the credentials are valid
+	MaxExpirationDays *int `json:"maxExpirationDays,omitempty"`
+}
+
+// SchemaSelfRevocation How the revocation requests of the holders are handled. Disabled by default.
+type SchemaSelfRevocation string
+
+// SchemaSelfRevocationRequest defines model for SchemaSelfRevocationRequest.
+type SchemaSelfRevocationRequest struct {
+	// Policy How the revocation requests of the holders are handled. Disabled by default.
+	Policy SchemaSelfRevocation `json:"policy"`
+}
+
+// SchemaUniqueStrategy What happens to a new credential with the unique key of an active one. Reject by default.
+type SchemaUniqueStrategy string
+
+// SchemaUniqueness defines model for SchemaUniqueness.
+type SchemaUniqueness struct {
+	// Attributes credentialSubject attributes that identify a single active credential of the schema
+	Attributes []string `json:"attributes"`
+
+	// Strategy What happens to a new credential with the unique key of an active one. Reject by default.
+	Strategy *SchemaUniqueStrategy `json:"strategy,omitempty"`
+}
+
+// SchemaWebhookTemplateRequest defines model for SchemaWebhookTemplateRequest.
+type SchemaWebhookTemplateRequest struct {
+	// Template Shape of the bodies posted to the webhooks about the credentials of a schema. The string values can reference
+	// the fields of the original body with {{path}}, e.g. {{attributes.firstName}}. The other values are copied as
+	// they are.
+	Template *WebhookTemplate `json:"template,omitempty"`
+}
+
+// SessionStatus defines model for SessionStatus.
+type SessionStatus struct {
+	Message *string `json:"message,omitempty"`
+
+	// QrCode Deep link with the credential offer. Only for link sessions in done status
+	QrCode *string             `json:"qrCode,omitempty"`
+	Status SessionStatusStatus `json:"status"`
+	Type   SessionStatusType   `json:"type"`
+}
+
+// SessionStatusStatus defines model for SessionStatus.Status.
+type SessionStatusStatus string
+
+// SessionStatusType defines model for SessionStatus.Type.
+type SessionStatusType string
+
+// StartKeyMigrationRequest defines model for StartKeyMigrationRequest.
+type StartKeyMigrationRequest struct {
+	// RevokeOldAuthClaim Revoke the old auth claim once the new one is published. The credentials with signature proofs issued with the old key become invalid.
+	RevokeOldAuthClaim *bool `json:"revokeOldAuthClaim,omitempty"`
 }
 
 // StateStatusResponse defines model for StateStatusResponse.
@@ -360,13 +1687,55 @@ type StateStatusResponse struct {
 	PendingActions bool `json:"pendingActions"`
 }
 
+// StateCheckpoint defines model for StateCheckpoint.
+type StateCheckpoint struct {
+	AnchorType StateCheckpointAnchorType `json:"anchorType"`
+	CreatedAt  TimeUTC                   `json:"createdAt"`
+	Id         uuid.UUID                 `json:"id"`
+
+	// Receipt Base64 OpenTimestamps timestamp returned by the calendar, to be upgraded to the bitcoin attestation by the verifiers
+	Receipt *[]byte `json:"receipt,omitempty"`
+
+	// Reference Transaction hash of the ethereum anchors or calendar url of the OpenTimestamps ones
+	Reference string `json:"reference"`
+	State     string `json:"state"`
+}
+
+// StateCheckpointAnchorType defines model for StateCheckpoint.AnchorType.
+type StateCheckpointAnchorType string
+
 // StateTransaction defines model for StateTransaction.
 type StateTransaction struct {
-	Id          int64                  `json:"id"`
-	PublishDate TimeUTC                `json:"publishDate"`
-	State       string                 `json:"state"`
-	Status      StateTransactionStatus `json:"status"`
-	TxID        string                 `json:"txID"`
+	BlockNumber *int64 `json:"blockNumber,omitempty"`
+
+	// Confirmations Number of blocks mined on top of the transaction block
+	Confirmations *int64 `json:"confirmations,omitempty"`
+
+	// CredentialIDs Credentials added to the claims tree in this state transition
+	CredentialIDs []uuid.UUID `json:"credentialIDs"`
+
+	// DroppedTxIDs Previous transactions of this state transition dropped by chain reorganizations. The state was published again.
+	DroppedTxIDs *[]string `json:"droppedTxIDs,omitempty"`
+	ExplorerURL  *string   `json:"explorerURL,omitempty"`
+	GasUsed      *int64    `json:"gasUsed,omitempty"`
+	Id           int64     `json:"id"`
+	PublishDate  TimeUTC   `json:"publishDate"`
+
+	// RequiredConfirmations Number of confirmations needed to consider the state published
+	RequiredConfirmations *int64 `json:"requiredConfirmations,omitempty"`
+
+	// RevokedCredentialIDs Credentials revoked in this state transition
+	RevokedCredentialIDs []uuid.UUID            `json:"revokedCredentialIDs"`
+	State                string                 `json:"state"`
+	Status               StateTransactionStatus `json:"status"`
+
+	// TxFee Transaction fee in wei
+	TxFee         *string `json:"txFee,omitempty"`
+	TxFeeCurrency *string `json:"txFeeCurrency,omitempty"`
+
+	// TxFeeFiat Transaction fee in fiat, converted with the price of the native token when the transaction was confirmed
+	TxFeeFiat *string `json:"txFeeFiat,omitempty"`
+	TxID      string  `json:"txID"`
 }
 
 // StateTransactionStatus defines model for StateTransaction.Status.
@@ -375,9 +1744,62 @@ type StateTransactionStatus string
 // StateTransactionsResponse defines model for StateTransactionsResponse.
 type StateTransactionsResponse = []StateTransaction
 
+// Stats defines model for Stats.
+type Stats struct {
+	ActiveLinks             int          `json:"activeLinks"`
+	Connections             int          `json:"connections"`
+	ConnectionsPerDay       []DailyCount `json:"connectionsPerDay"`
+	CredentialsIssued       int          `json:"credentialsIssued"`
+	CredentialsIssuedPerDay []DailyCount `json:"credentialsIssuedPerDay"`
+	CredentialsRevoked      int          `json:"credentialsRevoked"`
+
+	// FiatCurrency Currency of the fiat fees. Missing when no price feed is configured
+	FiatCurrency *string `json:"fiatCurrency,omitempty"`
+
+	// GasSpendPerMonth Spend of the state transitions confirmed in each of the last 12 months, the current one included
+	GasSpendPerMonth []MonthlyGasSpend `json:"gasSpendPerMonth"`
+
+	// PendingCredentials Credentials with MTP proof waiting to be published
+	PendingCredentials int `json:"pendingCredentials"`
+
+	// PendingRevocations Revocations waiting to be published
+	PendingRevocations int `json:"pendingRevocations"`
+
+	// PendingStates Published states not confirmed yet
+	PendingStates int `json:"pendingStates"`
+}
+
+// TermsOfUse Entry of the termsOfUse section of the credential
+type TermsOfUse map[string]interface{}
+
 // TimeUTC defines model for TimeUTC.
 type TimeUTC = timeapi.Time
 
+// Translation defines model for Translation.
+type Translation struct {
+	Locale     string            `json:"locale"`
+	Messages   map[string]string `json:"messages"`
+	ModifiedAt TimeUTC           `json:"modifiedAt"`
+}
+
+// TranslationRequest defines model for TranslationRequest.
+type TranslationRequest struct {
+	Messages map[string]string `json:"messages"`
+}
+
+// TrustStatus Whether the DID is trusted. The source tells where the answer comes from: the trust registry, the allow
+// or deny lists of the configuration, unavailable if the registry could not be consulted or disabled if
+// there is no trust registry configured.
+type TrustStatus struct {
+	CheckedAt TimeUTC           `json:"checkedAt"`
+	Name      *string           `json:"name,omitempty"`
+	Source    TrustStatusSource `json:"source"`
+	Trusted   bool              `json:"trusted"`
+}
+
+// TrustStatusSource defines model for TrustStatus.Source.
+type TrustStatusSource string
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Id string `json:"id"`
@@ -386,15 +1808,117 @@ type UUIDResponse struct {
 // UUIDString defines model for UUIDString.
 type UUIDString = string
 
+// UpdateCampaignRequest defines model for UpdateCampaignRequest.
+type UpdateCampaignRequest struct {
+	Description *string    `json:"description,omitempty"`
+	EndAt       *time.Time `json:"endAt,omitempty"`
+	Milestones  *[]int     `json:"milestones,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	StartAt     *time.Time `json:"startAt,omitempty"`
+	WebhookUrl  *string    `json:"webhookUrl,omitempty"`
+}
+
+// UpdateOrganizationCredentialRequest defines model for UpdateOrganizationCredentialRequest.
+type UpdateOrganizationCredentialRequest struct {
+	Public bool `json:"public"`
+}
+
+// UpdateRevocationRuleRequest defines model for UpdateRevocationRuleRequest.
+type UpdateRevocationRuleRequest struct {
+	CronExpression *string `json:"cronExpression,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	SchemaType     *string `json:"schemaType,omitempty"`
+	WebhookUrl     *string `json:"webhookUrl,omitempty"`
+}
+
+// VerificationBundle defines model for VerificationBundle.
+type VerificationBundle struct {
+	ChainID int32 `json:"chainID"`
+
+	// Credential W3C verifiable credential with its proofs
+	Credential verifiable.W3CCredential `json:"credential"`
+
+	// Documents JSON-LD contexts and JSON schema of the credential indexed by url
+	Documents        map[string]interface{}   `json:"documents"`
+	ExportedAt       TimeUTC                  `json:"exportedAt"`
+	RevocationState  PublishedState           `json:"revocationState"`
+	RevocationStatus RevocationStatusResponse `json:"revocationStatus"`
+	State            PublishedState           `json:"state"`
+
+	// StateContract Address of the state contract the states are published to
+	StateContract string `json:"stateContract"`
+}
+
+// WalletCompatibility defines model for WalletCompatibility.
+type WalletCompatibility struct {
+	// Accept Media types in the last Accept header sent by the wallet
+	Accept      []string `json:"accept"`
+	FirstSeenAt TimeUTC  `json:"firstSeenAt"`
+	Issues      []string `json:"issues"`
+	LastSeenAt  TimeUTC  `json:"lastSeenAt"`
+
+	// MediaTypes Media types of the messages of the wallet that the issuer accepted
+	MediaTypes         []string `json:"mediaTypes"`
+	MessageTypes       []string `json:"messageTypes"`
+	PreferredMediaType string   `json:"preferredMediaType"`
+
+	// QrFormat Format of the credential offers for the wallet, link, auto or oob
+	QrFormat string `json:"qrFormat"`
+
+	// RejectedMediaTypes Media types of the messages of the wallet that the media type policy of the issuer rejected
+	RejectedMediaTypes []string `json:"rejectedMediaTypes"`
+	SupportsDIDComm    bool     `json:"supportsDIDComm"`
+	UserAgent          *string  `json:"userAgent,omitempty"`
+	UserID             string   `json:"userID"`
+}
+
+// WalletUsage defines model for WalletUsage.
+type WalletUsage struct {
+	Accept             []string `json:"accept"`
+	Connections        int      `json:"connections"`
+	Issues             []string `json:"issues"`
+	LastSeenAt         TimeUTC  `json:"lastSeenAt"`
+	MediaTypes         []string `json:"mediaTypes"`
+	PreferredMediaType string   `json:"preferredMediaType"`
+	QrFormat           string   `json:"qrFormat"`
+	RejectedMediaTypes []string `json:"rejectedMediaTypes"`
+	SupportsDIDComm    bool     `json:"supportsDIDComm"`
+	UserAgent          *string  `json:"userAgent,omitempty"`
+}
+
+// WebhookTemplate Shape of the bodies posted to the webhooks about the credentials of a schema. The string values can reference
+// the fields of the original body with {{path}}, e.g. {{attributes.firstName}}. The other values are copied as
+// they are.
+type WebhookTemplate map[string]interface{}
+
+// CampaignLinkID defines model for campaignLinkID.
+type CampaignLinkID = uuid.UUID
+
 // Id defines model for id.
 type Id = uuid.UUID
 
 // LinkID defines model for linkID.
 type LinkID = uuid.UUID
 
+// LinkRecipientCode defines model for linkRecipientCode.
+type LinkRecipientCode = string
+
+// Locale defines model for locale.
+type Locale = string
+
+// OrganizationCredentialType defines model for organizationCredentialType.
+type OrganizationCredentialType = string
+
+// PathLocale defines model for pathLocale.
+type PathLocale = string
+
 // PathNonce defines model for pathNonce.
 type PathNonce = int64
 
+// PresentationTemplateName defines model for presentationTemplateName.
+type PresentationTemplateName = string
+
 // SessionID defines model for sessionID.
 type SessionID = uuid.UUID
 
@@ -404,6 +1928,9 @@ type N400 = GenericErrorMessage
 // N401 defines model for 401.
 type N401 = GenericErrorMessage
 
+// N403 defines model for 403.
+type N403 = GenericErrorMessage
+
 // N404 defines model for 404.
 type N404 = GenericErrorMessage
 
@@ -413,6 +1940,9 @@ type N409 = GenericErrorMessage
 // N422 defines model for 422.
 type N422 = GenericErrorMessage
 
+// N429 defines model for 429.
+type N429 = GenericErrorMessage
+
 // N500 defines model for 500.
 type N500 = GenericErrorMessage
 
@@ -441,7 +1971,7 @@ type AuthQRCodeParamsType string
 
 // GetConnectionsParams defines parameters for GetConnections.
 type GetConnectionsParams struct {
-	// Query Query string to do full text search in connections.
+	// Query Query string to do full text search in connections. It matches the user DID, display name, external id and email.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
 	// Credentials credentials=true to include the connection credentials.
@@ -467,6 +1997,26 @@ type DeleteConnectionParams struct {
 	DeleteCredentials *bool `form:"deleteCredentials,omitempty" json:"deleteCredentials,omitempty"`
 }
 
+// GetConnectionReAuthQRCodeParams defines parameters for GetConnectionReAuthQRCode.
+type GetConnectionReAuthQRCodeParams struct {
+	// Type Type:
+	//   * `link` - (default value) Return a QR code with a link redirection to the raw content. Easier to scan.
+	//   * `raw` - Return the raw QR code.
+	Type *GetConnectionReAuthQRCodeParamsType `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetConnectionReAuthQRCodeParamsType defines parameters for GetConnectionReAuthQRCode.
+type GetConnectionReAuthQRCodeParamsType string
+
+// GetDeadConnectionsParams defines parameters for GetDeadConnections.
+type GetDeadConnectionsParams struct {
+	// InactiveDays Days without activity. Defaults to ISSUER_CONNECTION_PRUNING_INACTIVE_DAYS.
+	InactiveDays *int `form:"inactiveDays,omitempty" json:"inactiveDays,omitempty"`
+
+	// MaxResults Number of connections to return. Default is 50.
+	MaxResults *uint `form:"max_results,omitempty" json:"max_results,omitempty"`
+}
+
 // GetCredentialsParams defines parameters for GetCredentials.
 type GetCredentialsParams struct {
 	Did *string `form:"did,omitempty" json:"did,omitempty"`
@@ -480,6 +2030,12 @@ type GetCredentialsParams struct {
 	// Query Query string to do full text search
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
+	// ExternalId Return only the credentials issued with this external id
+	ExternalId *string `form:"externalId,omitempty" json:"externalId,omitempty"`
+
+	// ProfileID Return only the credentials issued on behalf of this issuer profile
+	ProfileID *uuid.UUID `form:"profileID,omitempty" json:"profileID,omitempty"`
+
 	// Page Page to fetch. First is one. If omitted, all results will be returned.
 	Page *uint `form:"page,omitempty" json:"page,omitempty"`
 
@@ -533,37 +2089,194 @@ type GetLinkQRCodeParams struct {
 	SessionID SessionID `form:"sessionID" json:"sessionID"`
 }
 
+// CreateLinkQrCodeParams defines parameters for CreateLinkQrCode.
+type CreateLinkQrCodeParams struct {
+	// Locale Locale of the strings shown to the holder, e.g. es or pt-BR. The issuer translations to it are used when they exist.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Code Claim code of a recipient of the link. Required for links with recipients, and not allowed for the others.
+	Code *LinkRecipientCode `form:"code,omitempty" json:"code,omitempty"`
+
+	// Type Type:
+	//   * `link` - (default value) Return a QR code with a link redirection to the authentication request.
+	//   * `oob` - Return a DIDComm out-of-band invitation url with the authentication request attached. The raw
+	//     QR code is the invitation.
+	//   * `embedded` - Return an iden3comm link with the authentication request embedded in the `i_m`
+	//     parameter, base64url encoded.
+	//   * `auto` - Return the `embedded` link when it is not longer than ISSUER_QR_MAX_EMBEDDED_LENGTH, the
+	//     compressed one if ISSUER_QR_COMPRESSION is enabled and it fits, and the `link` one otherwise.
+	Type *CreateLinkQrCodeParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Compress Only for `embedded` links. Deflates the message before encoding it, and adds the `c=deflate` parameter to the link. The `c` parameter is not part of the iden3comm specification: standard wallets can't read these links, only the wallets that support compressed messages.
+	Compress *bool `form:"compress,omitempty" json:"compress,omitempty"`
+}
+
+// CreateLinkQrCodeParamsType defines parameters for CreateLinkQrCode.
+type CreateLinkQrCodeParamsType string
+
+// CreateLinkQrCodeHTMLParams defines parameters for CreateLinkQrCodeHTML.
+type CreateLinkQrCodeHTMLParams struct {
+	// Locale Locale of the strings shown to the holder, e.g. es or pt-BR. The issuer translations to it are used when they exist.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Code Claim code of a recipient of the link. Required for links with recipients, and not allowed for the others.
+	Code *LinkRecipientCode `form:"code,omitempty" json:"code,omitempty"`
+
+	// Size Resolution of the QR code image in pixels. Between 128 and 2048, 256 by default.
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// RevokeCredentialParams defines parameters for RevokeCredential.
+type RevokeCredentialParams struct {
+	// Cascade Set cascade to true to revoke the credentials that depend on this one as well
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+}
+
+// GetHistoricalRevocationStatusParams defines parameters for GetHistoricalRevocationStatus.
+type GetHistoricalRevocationStatusParams struct {
+	// State Hash of a published state of the issuer. It takes precedence over timestamp.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Timestamp Date to get the state published at, e.g. 2024-03-01T00:00:00Z
+	Timestamp *time.Time `form:"timestamp,omitempty" json:"timestamp,omitempty"`
+}
+
+// GetCredentialParams defines parameters for GetCredential.
+type GetCredentialParams struct {
+	// Unmasked Set unmasked to true to get the masked credentialSubject attributes in clear. Only for admin users,
+	// the access is audited.
+	Unmasked *bool `form:"unmasked,omitempty" json:"unmasked,omitempty"`
+}
+
+// GetCredentialVerificationBundleParams defines parameters for GetCredentialVerificationBundle.
+type GetCredentialVerificationBundleParams struct {
+	// Unmasked Show the masked attributes. Only for admin users, the access is audited.
+	Unmasked *bool `form:"unmasked,omitempty" json:"unmasked,omitempty"`
+}
+
+// GetCredentialPDFParams defines parameters for GetCredentialPDF.
+type GetCredentialPDFParams struct {
+	// Unmasked Show the masked attributes. Only for admin users, the access is audited.
+	Unmasked *bool `form:"unmasked,omitempty" json:"unmasked,omitempty"`
+}
+
 // GetCredentialQrCodeParams defines parameters for GetCredentialQrCode.
 type GetCredentialQrCodeParams struct {
 	// Type Type:
 	//   * `link` - (default value) Return a QR code with a link redirection to the raw content. Easier to scan.
 	//   * `raw` - Return the raw QR code.
+	//   * `oob` - Return a DIDComm out-of-band invitation url with the credential offer attached.
+	//   * `embedded` - Return an iden3comm link with the credential offer embedded in the `i_m` parameter,
+	//     base64url encoded. No request to the issuer is needed to read it.
+	//   * `auto` - Return the `embedded` link when it is not longer than ISSUER_QR_MAX_EMBEDDED_LENGTH, the
+	//     compressed one if ISSUER_QR_COMPRESSION is enabled and it fits, and the `link` one otherwise.
+	//   * `negotiate` - Return the format supported by the wallet of the holder, learned from the messages it
+	//     sent to the issuer: `oob` for DIDComm wallets, `auto` for iden3comm wallets and `link` for the rest.
 	Type *GetCredentialQrCodeParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Compress Only for `embedded` links. Deflates the message before encoding it, and adds the `c=deflate` parameter to the link. The `c` parameter is not part of the iden3comm specification: standard wallets can't read these links, only the wallets that support compressed messages.
+	Compress *bool `form:"compress,omitempty" json:"compress,omitempty"`
+
+	// Connect Only for `oob` invitations. Attaches an authentication request before the credential offer, so the same scan also creates a connection with the holder.
+	Connect *bool `form:"connect,omitempty" json:"connect,omitempty"`
+
+	// Locale Locale of the strings shown to the holder, e.g. es or pt-BR. The issuer translations to it are used when they exist.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
 }
 
 // GetCredentialQrCodeParamsType defines parameters for GetCredentialQrCode.
 type GetCredentialQrCodeParamsType string
 
+// GetCredentialQrCodeHTMLParams defines parameters for GetCredentialQrCodeHTML.
+type GetCredentialQrCodeHTMLParams struct {
+	// Locale Locale of the strings shown to the holder, e.g. es or pt-BR. The issuer translations to it are used when they exist.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Size Resolution of the QR code image in pixels. Between 128 and 2048, 256 by default.
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// HolderGetCredentialQrCodeParams defines parameters for HolderGetCredentialQrCode.
+type HolderGetCredentialQrCodeParams struct {
+	// Locale Locale of the strings shown to the holder, e.g. es or pt-BR. The issuer translations to it are used when they exist.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// GetOrganizationCredentialsParams defines parameters for GetOrganizationCredentials.
+type GetOrganizationCredentialsParams struct {
+	// Type Type of the organization credentials, e.g: AccreditedIssuer
+	Type *OrganizationCredentialType `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetOrganizationPresentationParams defines parameters for GetOrganizationPresentation.
+type GetOrganizationPresentationParams struct {
+	// Type Type of the organization credentials, e.g: AccreditedIssuer
+	Type *OrganizationCredentialType `form:"type,omitempty" json:"type,omitempty"`
+}
+
 // GetQrFromStoreParams defines parameters for GetQrFromStore.
 type GetQrFromStoreParams struct {
 	Id *uuid.UUID `form:"id,omitempty" json:"id,omitempty"`
 }
 
+// GetQrImageFromStoreParams defines parameters for GetQrImageFromStore.
+type GetQrImageFromStoreParams struct {
+	Id uuid.UUID `form:"id" json:"id"`
+
+	// Size Image size in pixels. Between 128 and 2048, 512 by default.
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetRevocationRequestsParams defines parameters for GetRevocationRequests.
+type GetRevocationRequestsParams struct {
+	Status *RevocationRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // GetSchemasParams defines parameters for GetSchemas.
 type GetSchemasParams struct {
 	// Query Query string to do full text search in schema types and attributes.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
+// StartKeyMigrationJSONRequestBody defines body for StartKeyMigration for application/json ContentType.
+type StartKeyMigrationJSONRequestBody = StartKeyMigrationRequest
+
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
 type AgentTextRequestBody = AgentTextBody
 
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = CreateAPIKeyRequest
+
 // AuthCallbackTextRequestBody defines body for AuthCallback for text/plain ContentType.
 type AuthCallbackTextRequestBody = AuthCallbackTextBody
 
+// CreateCampaignJSONRequestBody defines body for CreateCampaign for application/json ContentType.
+type CreateCampaignJSONRequestBody = CreateCampaignRequest
+
+// UpdateCampaignJSONRequestBody defines body for UpdateCampaign for application/json ContentType.
+type UpdateCampaignJSONRequestBody = UpdateCampaignRequest
+
+// AddCampaignCredentialsJSONRequestBody defines body for AddCampaignCredentials for application/json ContentType.
+type AddCampaignCredentialsJSONRequestBody = AddCampaignCredentialsRequest
+
+// AddCampaignLinksJSONRequestBody defines body for AddCampaignLinks for application/json ContentType.
+type AddCampaignLinksJSONRequestBody = AddCampaignLinksRequest
+
+// UpdateConnectionJSONRequestBody defines body for UpdateConnection for application/json ContentType.
+type UpdateConnectionJSONRequestBody = ConnectionProfile
+
 // CreateCredentialJSONRequestBody defines body for CreateCredential for application/json ContentType.
 type CreateCredentialJSONRequestBody = CreateCredentialRequest
 
+// ArchiveCredentialsJSONRequestBody defines body for ArchiveCredentials for application/json ContentType.
+type ArchiveCredentialsJSONRequestBody = ArchiveCredentialsRequest
+
+// ImportCredentialJSONRequestBody defines body for ImportCredential for application/json ContentType.
+type ImportCredentialJSONRequestBody = ImportCredentialRequest
+
+// InspectCredentialLayoutJSONRequestBody defines body for InspectCredentialLayout for application/json ContentType.
+type InspectCredentialLayoutJSONRequestBody = CredentialLayoutRequest
+
 // CreateLinkJSONRequestBody defines body for CreateLink for application/json ContentType.
 type CreateLinkJSONRequestBody = CreateLinkRequest
 
@@ -573,14 +2286,83 @@ type CreateLinkQrCodeCallbackTextRequestBody = CreateLinkQrCodeCallbackTextBody
 // AcivateLinkJSONRequestBody defines body for AcivateLink for application/json ContentType.
 type AcivateLinkJSONRequestBody AcivateLinkJSONBody
 
-// ImportSchemaJSONRequestBody defines body for ImportSchema for application/json ContentType.
-type ImportSchemaJSONRequestBody = ImportSchemaRequest
+// CreateLinkBatchJSONRequestBody defines body for CreateLinkBatch for application/json ContentType.
+type CreateLinkBatchJSONRequestBody = CreateLinkBatchRequest
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get the documentation
-	// (GET /)
-	GetDocumentation(w http.ResponseWriter, r *http.Request)
+// HolderRequestRevocationJSONRequestBody defines body for HolderRequestRevocation for application/json ContentType.
+type HolderRequestRevocationJSONRequestBody = HolderRevocationRequest
+
+// CreateIssuerProfileJSONRequestBody defines body for CreateIssuerProfile for application/json ContentType.
+type CreateIssuerProfileJSONRequestBody = CreateIssuerProfileRequest
+
+// ReceiveOrganizationCredentialJSONRequestBody defines body for ReceiveOrganizationCredential for application/json ContentType.
+type ReceiveOrganizationCredentialJSONRequestBody = ReceiveOrganizationCredentialRequest
+
+// UpdateOrganizationCredentialJSONRequestBody defines body for UpdateOrganizationCredential for application/json ContentType.
+type UpdateOrganizationCredentialJSONRequestBody = UpdateOrganizationCredentialRequest
+
+// CreatePresentationTemplateJSONRequestBody defines body for CreatePresentationTemplate for application/json ContentType.
+type CreatePresentationTemplateJSONRequestBody = CreatePresentationTemplateRequest
+
+// UpdateQRBrandingJSONRequestBody defines body for UpdateQRBranding for application/json ContentType.
+type UpdateQRBrandingJSONRequestBody = QRBranding
+
+// ReserveRevocationNonceRangeJSONRequestBody defines body for ReserveRevocationNonceRange for application/json ContentType.
+type ReserveRevocationNonceRangeJSONRequestBody = ReserveRevocationNonceRangeRequest
+
+// ApproveRevocationRequestJSONRequestBody defines body for ApproveRevocationRequest for application/json ContentType.
+type ApproveRevocationRequestJSONRequestBody = ResolveRevocationRequest
+
+// RejectRevocationRequestJSONRequestBody defines body for RejectRevocationRequest for application/json ContentType.
+type RejectRevocationRequestJSONRequestBody = ResolveRevocationRequest
+
+// CreateRevocationRuleJSONRequestBody defines body for CreateRevocationRule for application/json ContentType.
+type CreateRevocationRuleJSONRequestBody = CreateRevocationRuleRequest
+
+// UpdateRevocationRuleJSONRequestBody defines body for UpdateRevocationRule for application/json ContentType.
+type UpdateRevocationRuleJSONRequestBody = UpdateRevocationRuleRequest
+
+// ImportSchemaJSONRequestBody defines body for ImportSchema for application/json ContentType.
+type ImportSchemaJSONRequestBody = ImportSchemaRequest
+
+// UpdateSchemaJSONRequestBody defines body for UpdateSchema for application/json ContentType.
+type UpdateSchemaJSONRequestBody = SchemaExpiration
+
+// UpdateCredentialPDFTemplateJSONRequestBody defines body for UpdateCredentialPDFTemplate for application/json ContentType.
+type UpdateCredentialPDFTemplateJSONRequestBody = CredentialPDFTemplate
+
+// UpdateSchemaSelfRevocationJSONRequestBody defines body for UpdateSchemaSelfRevocation for application/json ContentType.
+type UpdateSchemaSelfRevocationJSONRequestBody = SchemaSelfRevocationRequest
+
+// UpdateSchemaUniquenessJSONRequestBody defines body for UpdateSchemaUniqueness for application/json ContentType.
+type UpdateSchemaUniquenessJSONRequestBody = SchemaUniqueness
+
+// UpdateSchemaWebhookTemplateJSONRequestBody defines body for UpdateSchemaWebhookTemplate for application/json ContentType.
+type UpdateSchemaWebhookTemplateJSONRequestBody = SchemaWebhookTemplateRequest
+
+// SaveTranslationJSONRequestBody defines body for SaveTranslation for application/json ContentType.
+type SaveTranslationJSONRequestBody = TranslationRequest
+
+// RedeemConnectionRebindingCodeJSONRequestBody defines body for RedeemConnectionRebindingCode for application/json ContentType.
+type RedeemConnectionRebindingCodeJSONRequestBody = RedeemConnectionRebindingCodeRequest
+
+// GetRevocationStatusBatchJSONRequestBody defines body for GetRevocationStatusBatch for application/json ContentType.
+type GetRevocationStatusBatchJSONRequestBody = RevocationStatusBatchRequest
+
+// MergeConnectionJSONRequestBody defines body for MergeConnection for application/json ContentType.
+type MergeConnectionJSONRequestBody = MergeConnectionRequest
+
+// PruneConnectionsJSONRequestBody defines body for PruneConnections for application/json ContentType.
+type PruneConnectionsJSONRequestBody = PruneConnectionsRequest
+
+// CreateReadOnlyAPIKeyJSONRequestBody defines body for CreateReadOnlyAPIKey for application/json ContentType.
+type CreateReadOnlyAPIKeyJSONRequestBody = CreateReadOnlyAPIKeyRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the documentation
+	// (GET /)
+	GetDocumentation(w http.ResponseWriter, r *http.Request)
 	// Get Config
 	// (GET /config)
 	GetConfig(w http.ResponseWriter, r *http.Request)
@@ -593,9 +2375,42 @@ type ServerInterface interface {
 	// Healthcheck
 	// (GET /status)
 	Health(w http.ResponseWriter, r *http.Request)
+	// Get key migrations
+	// (GET /v1/admin/key-migrations)
+	GetKeyMigrations(w http.ResponseWriter, r *http.Request)
+	// Start key migration
+	// (POST /v1/admin/key-migrations)
+	StartKeyMigration(w http.ResponseWriter, r *http.Request)
+	// Get key migration
+	// (GET /v1/admin/key-migrations/{id})
+	GetKeyMigration(w http.ResponseWriter, r *http.Request, id Id)
+	// Resume key migration
+	// (POST /v1/admin/key-migrations/{id}/resume)
+	ResumeKeyMigration(w http.ResponseWriter, r *http.Request, id Id)
+	// Get database migrations
+	// (GET /v1/admin/migrations)
+	GetMigrations(w http.ResponseWriter, r *http.Request)
+	// Run database migrations
+	// (POST /v1/admin/migrations)
+	RunMigrations(w http.ResponseWriter, r *http.Request)
 	// Agent
 	// (POST /v1/agent)
 	Agent(w http.ResponseWriter, r *http.Request)
+	// Get API keys
+	// (GET /v1/api-keys)
+	GetAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Create API key
+	// (POST /v1/api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Create read-only API key
+	// (POST /v1/api-keys/read-only)
+	CreateReadOnlyAPIKey(w http.ResponseWriter, r *http.Request)
+	// Revoke API key
+	// (DELETE /v1/api-keys/{id})
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id)
+	// Rotate API key
+	// (POST /v1/api-keys/{id}/rotate)
+	RotateAPIKey(w http.ResponseWriter, r *http.Request, id Id)
 	// Authentication Callback
 	// (POST /v1/authentication/callback)
 	AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams)
@@ -605,6 +2420,33 @@ type ServerInterface interface {
 	// Get Authentication Connection
 	// (GET /v1/authentication/sessions/{id})
 	GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id)
+	// Get campaigns
+	// (GET /v1/campaigns)
+	GetCampaigns(w http.ResponseWriter, r *http.Request)
+	// Create campaign
+	// (POST /v1/campaigns)
+	CreateCampaign(w http.ResponseWriter, r *http.Request)
+	// Delete campaign
+	// (DELETE /v1/campaigns/{id})
+	DeleteCampaign(w http.ResponseWriter, r *http.Request, id Id)
+	// Get campaign
+	// (GET /v1/campaigns/{id})
+	GetCampaign(w http.ResponseWriter, r *http.Request, id Id)
+	// Update campaign
+	// (PATCH /v1/campaigns/{id})
+	UpdateCampaign(w http.ResponseWriter, r *http.Request, id Id)
+	// Add credentials to campaign
+	// (POST /v1/campaigns/{id}/credentials)
+	AddCampaignCredentials(w http.ResponseWriter, r *http.Request, id Id)
+	// Add links to campaign
+	// (POST /v1/campaigns/{id}/links)
+	AddCampaignLinks(w http.ResponseWriter, r *http.Request, id Id)
+	// Remove link from campaign
+	// (DELETE /v1/campaigns/{id}/links/{linkID})
+	DeleteCampaignLink(w http.ResponseWriter, r *http.Request, id Id, linkID CampaignLinkID)
+	// Get Public Catalog
+	// (GET /v1/catalog)
+	GetPublicCatalog(w http.ResponseWriter, r *http.Request)
 	// Get Connections
 	// (GET /v1/connections)
 	GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams)
@@ -614,18 +2456,57 @@ type ServerInterface interface {
 	// Get Connection
 	// (GET /v1/connections/{id})
 	GetConnection(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Connection Profile
+	// (PATCH /v1/connections/{id})
+	UpdateConnection(w http.ResponseWriter, r *http.Request, id Id)
 	// Delete Connection Credentials
 	// (DELETE /v1/connections/{id}/credentials)
 	DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id)
 	// Revoke Connection Credentials
 	// (POST /v1/connections/{id}/credentials/revoke)
 	RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id)
+	// Create Connection Rebinding Code
+	// (POST /v1/connections/{id}/rebinding-code)
+	CreateConnectionRebindingCode(w http.ResponseWriter, r *http.Request, id Id)
+	// Redeem Connection Rebinding Code
+	// (POST /v1/connections/rebinding)
+	RedeemConnectionRebindingCode(w http.ResponseWriter, r *http.Request)
+	// Get Connection Re-Authentication QRCode
+	// (GET /v1/connections/{id}/reauthentication/qrcode)
+	GetConnectionReAuthQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetConnectionReAuthQRCodeParams)
+	// Get Duplicate Connections
+	// (GET /v1/connections/duplicates)
+	GetConnectionDuplicates(w http.ResponseWriter, r *http.Request)
+	// Get Dead Connections
+	// (GET /v1/connections/dead)
+	GetDeadConnections(w http.ResponseWriter, r *http.Request, params GetDeadConnectionsParams)
+	// Prune Dead Connections
+	// (POST /v1/connections/prune)
+	PruneConnections(w http.ResponseWriter, r *http.Request)
+	// Merge Connection
+	// (POST /v1/connections/{id}/merge)
+	MergeConnection(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Connection Merges
+	// (GET /v1/connections/{id}/merges)
+	GetConnectionMerges(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Connection Wallet
+	// (GET /v1/connections/{id}/wallet)
+	GetConnectionWallet(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credentials
 	// (GET /v1/credentials)
 	GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams)
 	// Create Credential
 	// (POST /v1/credentials)
 	CreateCredential(w http.ResponseWriter, r *http.Request)
+	// Archive Credentials
+	// (POST /v1/credentials/archive)
+	ArchiveCredentials(w http.ResponseWriter, r *http.Request)
+	// Import Credential
+	// (POST /v1/credentials/import)
+	ImportCredential(w http.ResponseWriter, r *http.Request)
+	// Inspect credential layout
+	// (POST /v1/credentials/layout)
+	InspectCredentialLayout(w http.ResponseWriter, r *http.Request)
 	// Get Links
 	// (GET /v1/credentials/links)
 	GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams)
@@ -644,30 +2525,180 @@ type ServerInterface interface {
 	// Activate | Deactivate Link
 	// (PATCH /v1/credentials/links/{id})
 	AcivateLink(w http.ResponseWriter, r *http.Request, id Id)
+	// Create Link Batch
+	// (POST /v1/credentials/links/{id}/batch)
+	CreateLinkBatch(w http.ResponseWriter, r *http.Request, id Id)
+	// Clone Link
+	// (POST /v1/credentials/links/{id}/clone)
+	CloneLink(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Link Prerequisite Proofs
+	// (GET /v1/credentials/links/{id}/prerequisite-proofs)
+	GetLinkPrerequisiteProofs(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential Link QRCode
 	// (GET /v1/credentials/links/{id}/qrcode)
 	GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams)
 	// Create Authentication Link QRCode
 	// (POST /v1/credentials/links/{id}/qrcode)
-	CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id)
+	CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeParams)
+	// Create Link QR code HTML snippet
+	// (POST /v1/credentials/links/{id}/qrcode/html)
+	CreateLinkQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeHTMLParams)
+	// Get Link Recipients
+	// (GET /v1/credentials/links/{id}/recipients)
+	GetLinkRecipients(w http.ResponseWriter, r *http.Request, id Id)
+	// Import Link Recipients
+	// (POST /v1/credentials/links/{id}/recipients)
+	ImportLinkRecipients(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Revocation Statuses
+	// (POST /v1/credentials/revocation/status/batch)
+	GetRevocationStatusBatch(w http.ResponseWriter, r *http.Request)
 	// Get Revocation Status
 	// (GET /v1/credentials/revocation/status/{nonce})
 	GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce)
+	// Get Historical Revocation Status
+	// (GET /v1/credentials/revocation/status/{nonce}/historical)
+	GetHistoricalRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce, params GetHistoricalRevocationStatusParams)
 	// Revoke Credential
 	// (POST /v1/credentials/revoke/{nonce})
-	RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce)
+	RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce, params RevokeCredentialParams)
 	// Delete Credential
 	// (DELETE /v1/credentials/{id})
 	DeleteCredential(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential
 	// (GET /v1/credentials/{id})
-	GetCredential(w http.ResponseWriter, r *http.Request, id Id)
+	GetCredential(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialParams)
+	// Export Credential Verification Bundle
+	// (GET /v1/credentials/{id}/bundle)
+	GetCredentialVerificationBundle(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialVerificationBundleParams)
+	// Get Credential Versions
+	// (GET /v1/credentials/{id}/versions)
+	GetCredentialVersions(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Dependents
+	// (GET /v1/credentials/{id}/dependents)
+	GetCredentialDependents(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Import
+	// (GET /v1/credentials/{id}/import)
+	GetCredentialImport(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential PDF
+	// (GET /v1/credentials/{id}/pdf)
+	GetCredentialPDF(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialPDFParams)
+	// Rehydrate Credential
+	// (POST /v1/credentials/{id}/rehydrate)
+	RehydrateCredential(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential QR code
 	// (GET /v1/credentials/{id}/qrcode)
 	GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeParams)
+	// Get Credential QR code HTML snippet
+	// (GET /v1/credentials/{id}/qrcode/html)
+	GetCredentialQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeHTMLParams)
+	// Get Holder Credentials
+	// (GET /v1/holder/credentials)
+	HolderGetCredentials(w http.ResponseWriter, r *http.Request)
+	// Get Holder Credential Preview
+	// (GET /v1/holder/credentials/{id}/preview)
+	HolderGetCredentialPreview(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Holder Credential Preview HTML page
+	// (GET /v1/holder/credentials/{id}/preview/html)
+	HolderGetCredentialPreviewHTML(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Holder Credential QR code
+	// (GET /v1/holder/credentials/{id}/qrcode)
+	HolderGetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params HolderGetCredentialQrCodeParams)
+	// Reissue Holder Credential
+	// (POST /v1/holder/credentials/{id}/reissue)
+	HolderReissueCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Request Holder Credential Revocation
+	// (POST /v1/holder/credentials/{id}/revocation-request)
+	HolderRequestRevocation(w http.ResponseWriter, r *http.Request, id Id)
+	// Create Holder Session
+	// (POST /v1/holder/sessions/{id})
+	HolderCreateSession(w http.ResponseWriter, r *http.Request, id Id)
+	// Get issuer profiles
+	// (GET /v1/issuer-profiles)
+	GetIssuerProfiles(w http.ResponseWriter, r *http.Request)
+	// Create issuer profile
+	// (POST /v1/issuer-profiles)
+	CreateIssuerProfile(w http.ResponseWriter, r *http.Request)
+	// Delete issuer profile
+	// (DELETE /v1/issuer-profiles/{id})
+	DeleteIssuerProfile(w http.ResponseWriter, r *http.Request, id Id)
+	// Get issuer profile
+	// (GET /v1/issuer-profiles/{id})
+	GetIssuerProfile(w http.ResponseWriter, r *http.Request, id Id)
+	// Get organization credentials
+	// (GET /v1/organization-credentials)
+	GetOrganizationCredentials(w http.ResponseWriter, r *http.Request, params GetOrganizationCredentialsParams)
+	// Receive organization credential
+	// (POST /v1/organization-credentials)
+	ReceiveOrganizationCredential(w http.ResponseWriter, r *http.Request)
+	// Delete organization credential
+	// (DELETE /v1/organization-credentials/{id})
+	DeleteOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Get organization credential
+	// (GET /v1/organization-credentials/{id})
+	GetOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Update organization credential
+	// (PATCH /v1/organization-credentials/{id})
+	UpdateOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Get organization presentation
+	// (GET /v1/organization-presentation)
+	GetOrganizationPresentation(w http.ResponseWriter, r *http.Request, params GetOrganizationPresentationParams)
+	// Get presentation templates
+	// (GET /v1/presentation-templates)
+	GetPresentationTemplates(w http.ResponseWriter, r *http.Request)
+	// Create presentation template
+	// (POST /v1/presentation-templates)
+	CreatePresentationTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete presentation template
+	// (DELETE /v1/presentation-templates/{name})
+	DeletePresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName)
+	// Get presentation template
+	// (GET /v1/presentation-templates/{name})
+	GetPresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName)
+	// Get QR branding
+	// (GET /v1/qr-branding)
+	GetQRBranding(w http.ResponseWriter, r *http.Request)
+	// Update QR branding
+	// (PUT /v1/qr-branding)
+	UpdateQRBranding(w http.ResponseWriter, r *http.Request)
 	// QrCode body
 	// (GET /v1/qr-store)
 	GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams)
+	// QrCode image
+	// (GET /v1/qr-store/image)
+	GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams)
+	// Get revocation nonce ranges
+	// (GET /v1/revocation-nonces/ranges)
+	GetRevocationNonceRanges(w http.ResponseWriter, r *http.Request)
+	// Reserve revocation nonce range
+	// (POST /v1/revocation-nonces/ranges)
+	ReserveRevocationNonceRange(w http.ResponseWriter, r *http.Request)
+	// Get revocation requests
+	// (GET /v1/revocation-requests)
+	GetRevocationRequests(w http.ResponseWriter, r *http.Request, params GetRevocationRequestsParams)
+	// Approve revocation request
+	// (POST /v1/revocation-requests/{id}/approve)
+	ApproveRevocationRequest(w http.ResponseWriter, r *http.Request, id Id)
+	// Reject revocation request
+	// (POST /v1/revocation-requests/{id}/reject)
+	RejectRevocationRequest(w http.ResponseWriter, r *http.Request, id Id)
+	// Get revocation rules
+	// (GET /v1/revocation-rules)
+	GetRevocationRules(w http.ResponseWriter, r *http.Request)
+	// Create revocation rule
+	// (POST /v1/revocation-rules)
+	CreateRevocationRule(w http.ResponseWriter, r *http.Request)
+	// Delete revocation rule
+	// (DELETE /v1/revocation-rules/{id})
+	DeleteRevocationRule(w http.ResponseWriter, r *http.Request, id Id)
+	// Get revocation rule
+	// (GET /v1/revocation-rules/{id})
+	GetRevocationRule(w http.ResponseWriter, r *http.Request, id Id)
+	// Update revocation rule
+	// (PATCH /v1/revocation-rules/{id})
+	UpdateRevocationRule(w http.ResponseWriter, r *http.Request, id Id)
+	// Preview revocation rule
+	// (GET /v1/revocation-rules/{id}/preview)
+	PreviewRevocationRule(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Schemas
 	// (GET /v1/schemas)
 	GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams)
@@ -677,6 +2708,33 @@ type ServerInterface interface {
 	// Get Schema
 	// (GET /v1/schemas/{id})
 	GetSchema(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Schema Expiration
+	// (PATCH /v1/schemas/{id})
+	UpdateSchema(w http.ResponseWriter, r *http.Request, id Id)
+	// Delete Credential PDF Template
+	// (DELETE /v1/schemas/{id}/pdf-template)
+	DeleteCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential PDF Template
+	// (GET /v1/schemas/{id}/pdf-template)
+	GetCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Credential PDF Template
+	// (PUT /v1/schemas/{id}/pdf-template)
+	UpdateCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Schema Self Revocation
+	// (PUT /v1/schemas/{id}/self-revocation)
+	UpdateSchemaSelfRevocation(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Schema Uniqueness
+	// (PUT /v1/schemas/{id}/uniqueness)
+	UpdateSchemaUniqueness(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Schema Webhook Template
+	// (PUT /v1/schemas/{id}/webhook-template)
+	UpdateSchemaWebhookTemplate(w http.ResponseWriter, r *http.Request, id Id)
+	// Session Status Events
+	// (GET /v1/sessions/{id}/events)
+	GetSessionEvents(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Pending State Changes
+	// (GET /v1/state/pending)
+	GetStatePending(w http.ResponseWriter, r *http.Request)
 	// Publish Identity State
 	// (POST /v1/state/publish)
 	PublishState(w http.ResponseWriter, r *http.Request)
@@ -686,9 +2744,30 @@ type ServerInterface interface {
 	// Get Identity State Status
 	// (GET /v1/state/status)
 	GetStateStatus(w http.ResponseWriter, r *http.Request)
+	// Get State Checkpoints
+	// (GET /v1/state/checkpoints)
+	GetStateCheckpoints(w http.ResponseWriter, r *http.Request)
 	// Get Identity State Transactions
 	// (GET /v1/state/transactions)
 	GetStateTransactions(w http.ResponseWriter, r *http.Request)
+	// Get Stats
+	// (GET /v1/stats)
+	GetStats(w http.ResponseWriter, r *http.Request)
+	// Get translations
+	// (GET /v1/translations)
+	GetTranslations(w http.ResponseWriter, r *http.Request)
+	// Delete translation
+	// (DELETE /v1/translations/{locale})
+	DeleteTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale)
+	// Get translation
+	// (GET /v1/translations/{locale})
+	GetTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale)
+	// Save translation
+	// (PUT /v1/translations/{locale})
+	SaveTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale)
+	// Get Wallet Compatibility Report
+	// (GET /v1/wallets)
+	GetWalletCompatibilityReport(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -725,12 +2804,78 @@ func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get key migrations
+// (GET /v1/admin/key-migrations)
+func (_ Unimplemented) GetKeyMigrations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start key migration
+// (POST /v1/admin/key-migrations)
+func (_ Unimplemented) StartKeyMigration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get key migration
+// (GET /v1/admin/key-migrations/{id})
+func (_ Unimplemented) GetKeyMigration(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume key migration
+// (POST /v1/admin/key-migrations/{id}/resume)
+func (_ Unimplemented) ResumeKeyMigration(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get database migrations
+// (GET /v1/admin/migrations)
+func (_ Unimplemented) GetMigrations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run database migrations
+// (POST /v1/admin/migrations)
+func (_ Unimplemented) RunMigrations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Agent
 // (POST /v1/agent)
 func (_ Unimplemented) Agent(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get API keys
+// (GET /v1/api-keys)
+func (_ Unimplemented) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create API key
+// (POST /v1/api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create read-only API key
+// (POST /v1/api-keys/read-only)
+func (_ Unimplemented) CreateReadOnlyAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke API key
+// (DELETE /v1/api-keys/{id})
+func (_ Unimplemented) RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rotate API key
+// (POST /v1/api-keys/{id}/rotate)
+func (_ Unimplemented) RotateAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authentication Callback
 // (POST /v1/authentication/callback)
 func (_ Unimplemented) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
@@ -749,6 +2894,60 @@ func (_ Unimplemented) GetAuthenticationConnection(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get campaigns
+// (GET /v1/campaigns)
+func (_ Unimplemented) GetCampaigns(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create campaign
+// (POST /v1/campaigns)
+func (_ Unimplemented) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete campaign
+// (DELETE /v1/campaigns/{id})
+func (_ Unimplemented) DeleteCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get campaign
+// (GET /v1/campaigns/{id})
+func (_ Unimplemented) GetCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update campaign
+// (PATCH /v1/campaigns/{id})
+func (_ Unimplemented) UpdateCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add credentials to campaign
+// (POST /v1/campaigns/{id}/credentials)
+func (_ Unimplemented) AddCampaignCredentials(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add links to campaign
+// (POST /v1/campaigns/{id}/links)
+func (_ Unimplemented) AddCampaignLinks(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove link from campaign
+// (DELETE /v1/campaigns/{id}/links/{linkID})
+func (_ Unimplemented) DeleteCampaignLink(w http.ResponseWriter, r *http.Request, id Id, linkID CampaignLinkID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Public Catalog
+// (GET /v1/catalog)
+func (_ Unimplemented) GetPublicCatalog(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Connections
 // (GET /v1/connections)
 func (_ Unimplemented) GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams) {
@@ -767,6 +2966,12 @@ func (_ Unimplemented) GetConnection(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update Connection Profile
+// (PATCH /v1/connections/{id})
+func (_ Unimplemented) UpdateConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete Connection Credentials
 // (DELETE /v1/connections/{id}/credentials)
 func (_ Unimplemented) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
@@ -779,6 +2984,60 @@ func (_ Unimplemented) RevokeConnectionCredentials(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create Connection Rebinding Code
+// (POST /v1/connections/{id}/rebinding-code)
+func (_ Unimplemented) CreateConnectionRebindingCode(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Redeem Connection Rebinding Code
+// (POST /v1/connections/rebinding)
+func (_ Unimplemented) RedeemConnectionRebindingCode(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Connection Re-Authentication QRCode
+// (GET /v1/connections/{id}/reauthentication/qrcode)
+func (_ Unimplemented) GetConnectionReAuthQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetConnectionReAuthQRCodeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Duplicate Connections
+// (GET /v1/connections/duplicates)
+func (_ Unimplemented) GetConnectionDuplicates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Dead Connections
+// (GET /v1/connections/dead)
+func (_ Unimplemented) GetDeadConnections(w http.ResponseWriter, r *http.Request, params GetDeadConnectionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Prune Dead Connections
+// (POST /v1/connections/prune)
+func (_ Unimplemented) PruneConnections(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Merge Connection
+// (POST /v1/connections/{id}/merge)
+func (_ Unimplemented) MergeConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Connection Merges
+// (GET /v1/connections/{id}/merges)
+func (_ Unimplemented) GetConnectionMerges(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Connection Wallet
+// (GET /v1/connections/{id}/wallet)
+func (_ Unimplemented) GetConnectionWallet(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Credentials
 // (GET /v1/credentials)
 func (_ Unimplemented) GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams) {
@@ -791,6 +3050,24 @@ func (_ Unimplemented) CreateCredential(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Archive Credentials
+// (POST /v1/credentials/archive)
+func (_ Unimplemented) ArchiveCredentials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import Credential
+// (POST /v1/credentials/import)
+func (_ Unimplemented) ImportCredential(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Inspect credential layout
+// (POST /v1/credentials/layout)
+func (_ Unimplemented) InspectCredentialLayout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Links
 // (GET /v1/credentials/links)
 func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
@@ -827,6 +3104,24 @@ func (_ Unimplemented) AcivateLink(w http.ResponseWriter, r *http.Request, id Id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create Link Batch
+// (POST /v1/credentials/links/{id}/batch)
+func (_ Unimplemented) CreateLinkBatch(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clone Link
+// (POST /v1/credentials/links/{id}/clone)
+func (_ Unimplemented) CloneLink(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Link Prerequisite Proofs
+// (GET /v1/credentials/links/{id}/prerequisite-proofs)
+func (_ Unimplemented) GetLinkPrerequisiteProofs(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Credential Link QRCode
 // (GET /v1/credentials/links/{id}/qrcode)
 func (_ Unimplemented) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams) {
@@ -835,7 +3130,31 @@ func (_ Unimplemented) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id
 
 // Create Authentication Link QRCode
 // (POST /v1/credentials/links/{id}/qrcode)
-func (_ Unimplemented) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id) {
+func (_ Unimplemented) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Link QR code HTML snippet
+// (POST /v1/credentials/links/{id}/qrcode/html)
+func (_ Unimplemented) CreateLinkQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeHTMLParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Link Recipients
+// (GET /v1/credentials/links/{id}/recipients)
+func (_ Unimplemented) GetLinkRecipients(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import Link Recipients
+// (POST /v1/credentials/links/{id}/recipients)
+func (_ Unimplemented) ImportLinkRecipients(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Revocation Statuses
+// (POST /v1/credentials/revocation/status/batch)
+func (_ Unimplemented) GetRevocationStatusBatch(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -845,9 +3164,15 @@ func (_ Unimplemented) GetRevocationStatus(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Historical Revocation Status
+// (GET /v1/credentials/revocation/status/{nonce}/historical)
+func (_ Unimplemented) GetHistoricalRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce, params GetHistoricalRevocationStatusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Revoke Credential
 // (POST /v1/credentials/revoke/{nonce})
-func (_ Unimplemented) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
+func (_ Unimplemented) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce, params RevokeCredentialParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -859,127 +3184,428 @@ func (_ Unimplemented) DeleteCredential(w http.ResponseWriter, r *http.Request,
 
 // Get Credential
 // (GET /v1/credentials/{id})
-func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, id Id) {
+func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Credential QR code
-// (GET /v1/credentials/{id}/qrcode)
-func (_ Unimplemented) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeParams) {
+// Export Credential Verification Bundle
+// (GET /v1/credentials/{id}/bundle)
+func (_ Unimplemented) GetCredentialVerificationBundle(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialVerificationBundleParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// QrCode body
-// (GET /v1/qr-store)
-func (_ Unimplemented) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
+// Get Credential Versions
+// (GET /v1/credentials/{id}/versions)
+func (_ Unimplemented) GetCredentialVersions(w http.ResponseWriter, r *http.Request, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Schemas
-// (GET /v1/schemas)
-func (_ Unimplemented) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
+// Get Credential Dependents
+// (GET /v1/credentials/{id}/dependents)
+func (_ Unimplemented) GetCredentialDependents(w http.ResponseWriter, r *http.Request, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Import JSON schema
-// (POST /v1/schemas)
-func (_ Unimplemented) ImportSchema(w http.ResponseWriter, r *http.Request) {
+// Get Credential Import
+// (GET /v1/credentials/{id}/import)
+func (_ Unimplemented) GetCredentialImport(w http.ResponseWriter, r *http.Request, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Schema
-// (GET /v1/schemas/{id})
-func (_ Unimplemented) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
+// Get Credential PDF
+// (GET /v1/credentials/{id}/pdf)
+func (_ Unimplemented) GetCredentialPDF(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialPDFParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Publish Identity State
-// (POST /v1/state/publish)
-func (_ Unimplemented) PublishState(w http.ResponseWriter, r *http.Request) {
+// Rehydrate Credential
+// (POST /v1/credentials/{id}/rehydrate)
+func (_ Unimplemented) RehydrateCredential(w http.ResponseWriter, r *http.Request, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Retry Publish Identity State
-// (POST /v1/state/retry)
-func (_ Unimplemented) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+// Get Credential QR code
+// (GET /v1/credentials/{id}/qrcode)
+func (_ Unimplemented) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Identity State Status
-// (GET /v1/state/status)
-func (_ Unimplemented) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+// Get Credential QR code HTML snippet
+// (GET /v1/credentials/{id}/qrcode/html)
+func (_ Unimplemented) GetCredentialQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeHTMLParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Identity State Transactions
-// (GET /v1/state/transactions)
-func (_ Unimplemented) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+// Get Holder Credentials
+// (GET /v1/holder/credentials)
+func (_ Unimplemented) HolderGetCredentials(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Get Holder Credential Preview
+// (GET /v1/holder/credentials/{id}/preview)
+func (_ Unimplemented) HolderGetCredentialPreview(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Get Holder Credential Preview HTML page
+// (GET /v1/holder/credentials/{id}/preview/html)
+func (_ Unimplemented) HolderGetCredentialPreviewHTML(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// GetDocumentation operation middleware
-func (siw *ServerInterfaceWrapper) GetDocumentation(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Get Holder Credential QR code
+// (GET /v1/holder/credentials/{id}/qrcode)
+func (_ Unimplemented) HolderGetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params HolderGetCredentialQrCodeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDocumentation(w, r)
-	}))
+// Reissue Holder Credential
+// (POST /v1/holder/credentials/{id}/reissue)
+func (_ Unimplemented) HolderReissueCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Request Holder Credential Revocation
+// (POST /v1/holder/credentials/{id}/revocation-request)
+func (_ Unimplemented) HolderRequestRevocation(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// Create Holder Session
+// (POST /v1/holder/sessions/{id})
+func (_ Unimplemented) HolderCreateSession(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Get issuer profiles
+// (GET /v1/issuer-profiles)
+func (_ Unimplemented) GetIssuerProfiles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// Create issuer profile
+// (POST /v1/issuer-profiles)
+func (_ Unimplemented) CreateIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConfig(w, r)
-	}))
+// Delete issuer profile
+// (DELETE /v1/issuer-profiles/{id})
+func (_ Unimplemented) DeleteIssuerProfile(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Get issuer profile
+// (GET /v1/issuer-profiles/{id})
+func (_ Unimplemented) GetIssuerProfile(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// Get organization credentials
+// (GET /v1/organization-credentials)
+func (_ Unimplemented) GetOrganizationCredentials(w http.ResponseWriter, r *http.Request, params GetOrganizationCredentialsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetFavicon operation middleware
-func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Receive organization credential
+// (POST /v1/organization-credentials)
+func (_ Unimplemented) ReceiveOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFavicon(w, r)
-	}))
+// Delete organization credential
+// (DELETE /v1/organization-credentials/{id})
+func (_ Unimplemented) DeleteOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Get organization credential
+// (GET /v1/organization-credentials/{id})
+func (_ Unimplemented) GetOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// Update organization credential
+// (PATCH /v1/organization-credentials/{id})
+func (_ Unimplemented) UpdateOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetYaml operation middleware
-func (siw *ServerInterfaceWrapper) GetYaml(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Get organization presentation
+// (GET /v1/organization-presentation)
+func (_ Unimplemented) GetOrganizationPresentation(w http.ResponseWriter, r *http.Request, params GetOrganizationPresentationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetYaml(w, r)
-	}))
+// Get presentation templates
+// (GET /v1/presentation-templates)
+func (_ Unimplemented) GetPresentationTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create presentation template
+// (POST /v1/presentation-templates)
+func (_ Unimplemented) CreatePresentationTemplate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete presentation template
+// (DELETE /v1/presentation-templates/{name})
+func (_ Unimplemented) DeletePresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get presentation template
+// (GET /v1/presentation-templates/{name})
+func (_ Unimplemented) GetPresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get QR branding
+// (GET /v1/qr-branding)
+func (_ Unimplemented) GetQRBranding(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update QR branding
+// (PUT /v1/qr-branding)
+func (_ Unimplemented) UpdateQRBranding(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// QrCode body
+// (GET /v1/qr-store)
+func (_ Unimplemented) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// QrCode image
+// (GET /v1/qr-store/image)
+func (_ Unimplemented) GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get revocation nonce ranges
+// (GET /v1/revocation-nonces/ranges)
+func (_ Unimplemented) GetRevocationNonceRanges(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reserve revocation nonce range
+// (POST /v1/revocation-nonces/ranges)
+func (_ Unimplemented) ReserveRevocationNonceRange(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get revocation requests
+// (GET /v1/revocation-requests)
+func (_ Unimplemented) GetRevocationRequests(w http.ResponseWriter, r *http.Request, params GetRevocationRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve revocation request
+// (POST /v1/revocation-requests/{id}/approve)
+func (_ Unimplemented) ApproveRevocationRequest(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject revocation request
+// (POST /v1/revocation-requests/{id}/reject)
+func (_ Unimplemented) RejectRevocationRequest(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get revocation rules
+// (GET /v1/revocation-rules)
+func (_ Unimplemented) GetRevocationRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create revocation rule
+// (POST /v1/revocation-rules)
+func (_ Unimplemented) CreateRevocationRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete revocation rule
+// (DELETE /v1/revocation-rules/{id})
+func (_ Unimplemented) DeleteRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get revocation rule
+// (GET /v1/revocation-rules/{id})
+func (_ Unimplemented) GetRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update revocation rule
+// (PATCH /v1/revocation-rules/{id})
+func (_ Unimplemented) UpdateRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview revocation rule
+// (GET /v1/revocation-rules/{id}/preview)
+func (_ Unimplemented) PreviewRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Schemas
+// (GET /v1/schemas)
+func (_ Unimplemented) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import JSON schema
+// (POST /v1/schemas)
+func (_ Unimplemented) ImportSchema(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Schema
+// (GET /v1/schemas/{id})
+func (_ Unimplemented) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Schema Expiration
+// (PATCH /v1/schemas/{id})
+func (_ Unimplemented) UpdateSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete Credential PDF Template
+// (DELETE /v1/schemas/{id}/pdf-template)
+func (_ Unimplemented) DeleteCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Credential PDF Template
+// (GET /v1/schemas/{id}/pdf-template)
+func (_ Unimplemented) GetCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Credential PDF Template
+// (PUT /v1/schemas/{id}/pdf-template)
+func (_ Unimplemented) UpdateCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Schema Self Revocation
+// (PUT /v1/schemas/{id}/self-revocation)
+func (_ Unimplemented) UpdateSchemaSelfRevocation(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Schema Uniqueness
+// (PUT /v1/schemas/{id}/uniqueness)
+func (_ Unimplemented) UpdateSchemaUniqueness(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Schema Webhook Template
+// (PUT /v1/schemas/{id}/webhook-template)
+func (_ Unimplemented) UpdateSchemaWebhookTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Session Status Events
+// (GET /v1/sessions/{id}/events)
+func (_ Unimplemented) GetSessionEvents(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Pending State Changes
+// (GET /v1/state/pending)
+func (_ Unimplemented) GetStatePending(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish Identity State
+// (POST /v1/state/publish)
+func (_ Unimplemented) PublishState(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry Publish Identity State
+// (POST /v1/state/retry)
+func (_ Unimplemented) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Identity State Status
+// (GET /v1/state/status)
+func (_ Unimplemented) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get State Checkpoints
+// (GET /v1/state/checkpoints)
+func (_ Unimplemented) GetStateCheckpoints(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Identity State Transactions
+// (GET /v1/state/transactions)
+func (_ Unimplemented) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Stats
+// (GET /v1/stats)
+func (_ Unimplemented) GetStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get translations
+// (GET /v1/translations)
+func (_ Unimplemented) GetTranslations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete translation
+// (DELETE /v1/translations/{locale})
+func (_ Unimplemented) DeleteTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get translation
+// (GET /v1/translations/{locale})
+func (_ Unimplemented) GetTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save translation
+// (PUT /v1/translations/{locale})
+func (_ Unimplemented) SaveTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Wallet Compatibility Report
+// (GET /v1/wallets)
+func (_ Unimplemented) GetWalletCompatibilityReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetDocumentation operation middleware
+func (siw *ServerInterfaceWrapper) GetDocumentation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocumentation(w, r)
+	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -988,12 +3614,14 @@ func (siw *ServerInterfaceWrapper) GetYaml(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.GetConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1003,12 +3631,12 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Agent operation middleware
-func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request) {
+// GetFavicon operation middleware
+func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Agent(w, r)
+		siw.Handler.GetFavicon(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1018,32 +3646,61 @@ func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AuthCallback operation middleware
-func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.Request) {
+// GetYaml operation middleware
+func (siw *ServerInterfaceWrapper) GetYaml(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetYaml(w, r)
+	}))
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthCallbackParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ------------- Required query parameter "sessionID" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
-		return
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetKeyMigrations operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyMigrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetKeyMigrations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// StartKeyMigration operation middleware
+func (siw *ServerInterfaceWrapper) StartKeyMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthCallback(w, r, params)
+		siw.Handler.StartKeyMigration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1053,25 +3710,25 @@ func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AuthQRCode operation middleware
-func (siw *ServerInterfaceWrapper) AuthQRCode(w http.ResponseWriter, r *http.Request) {
+// GetKeyMigration operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyMigration(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthQRCodeParams
-
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthQRCode(w, r, params)
+		siw.Handler.GetKeyMigration(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1081,8 +3738,8 @@ func (siw *ServerInterfaceWrapper) AuthQRCode(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetAuthenticationConnection operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request) {
+// ResumeKeyMigration operation middleware
+func (siw *ServerInterfaceWrapper) ResumeKeyMigration(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1099,7 +3756,7 @@ func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWr
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthenticationConnection(w, r, id)
+		siw.Handler.ResumeKeyMigration(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1109,59 +3766,46 @@ func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetConnections operation middleware
-func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
+// GetMigrations operation middleware
+func (siw *ServerInterfaceWrapper) GetMigrations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetConnectionsParams
-
-	// ------------- Optional query parameter "query" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMigrations(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "credentials" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "credentials", r.URL.Query(), &params.Credentials)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentials", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// ------------- Optional query parameter "page" -------------
+// RunMigrations operation middleware
+func (siw *ServerInterfaceWrapper) RunMigrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// ------------- Optional query parameter "max_results" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunMigrations(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
-	}
+// Agent operation middleware
+func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnections(w, r, params)
+		siw.Handler.Agent(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1171,44 +3815,31 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteConnection operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *http.Request) {
+// GetAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteConnectionParams
-
-	// ------------- Optional query parameter "revokeCredentials" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKeys(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "revokeCredentials", r.URL.Query(), &params.RevokeCredentials)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revokeCredentials", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "deleteCredentials" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "deleteCredentials", r.URL.Query(), &params.DeleteCredentials)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleteCredentials", Err: err})
-		return
-	}
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConnection(w, r, id, params)
+		siw.Handler.CreateAPIKey(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1218,25 +3849,14 @@ func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetConnection operation middleware
-func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.Request) {
+// CreateReadOnlyAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateReadOnlyAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnection(w, r, id)
+		siw.Handler.CreateReadOnlyAPIKey(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1246,8 +3866,8 @@ func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteConnectionCredentials operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request) {
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1264,7 +3884,7 @@ func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWr
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConnectionCredentials(w, r, id)
+		siw.Handler.RevokeAPIKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1274,8 +3894,8 @@ func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RevokeConnectionCredentials operation middleware
-func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request) {
+// RotateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1292,7 +3912,7 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeConnectionCredentials(w, r, id)
+		siw.Handler.RotateAPIKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1302,67 +3922,60 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCredentials operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
+// AuthCallback operation middleware
+func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCredentialsParams
+	var params AuthCallbackParams
 
-	// ------------- Optional query parameter "did" -------------
+	// ------------- Required query parameter "sessionID" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "did", r.URL.Query(), &params.Did)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "did", Err: err})
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthCallback(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+// AuthQRCode operation middleware
+func (siw *ServerInterfaceWrapper) AuthQRCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "max_results" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthQRCodeParams
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Optional query parameter "type" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentials(w, r, params)
+		siw.Handler.AuthQRCode(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1372,14 +3985,25 @@ func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateCredential operation middleware
-func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
+// GetAuthenticationConnection operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCredential(w, r)
+		siw.Handler.GetAuthenticationConnection(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1389,35 +4013,14 @@ func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+// GetCampaigns operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaigns(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLinksParams
-
-	// ------------- Optional query parameter "query" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinks(w, r, params)
+		siw.Handler.GetCampaigns(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1427,14 +4030,14 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLink operation middleware
-func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
+// CreateCampaign operation middleware
+func (siw *ServerInterfaceWrapper) CreateCampaign(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLink(w, r)
+		siw.Handler.CreateCampaign(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1444,47 +4047,25 @@ func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
+// DeleteCampaign operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCampaign(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateLinkQrCodeCallbackParams
-
-	// ------------- Required query parameter "sessionID" -------------
-
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
-		return
-	}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
-		return
-	}
-
-	// ------------- Required query parameter "linkID" -------------
-
-	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkQrCodeCallback(w, r, params)
+		siw.Handler.DeleteCampaign(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1494,8 +4075,8 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
+// GetCampaign operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaign(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1512,7 +4093,7 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLink(w, r, id)
+		siw.Handler.GetCampaign(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1522,8 +4103,8 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLink operation middleware
-func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
+// UpdateCampaign operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1540,7 +4121,7 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLink(w, r, id)
+		siw.Handler.UpdateCampaign(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1550,8 +4131,8 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AcivateLink operation middleware
-func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Request) {
+// AddCampaignCredentials operation middleware
+func (siw *ServerInterfaceWrapper) AddCampaignCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1568,7 +4149,7 @@ func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Re
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AcivateLink(w, r, id)
+		siw.Handler.AddCampaignCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1578,8 +4159,8 @@ func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinkQRCode operation middleware
-func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
+// AddCampaignLinks operation middleware
+func (siw *ServerInterfaceWrapper) AddCampaignLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1593,26 +4174,10 @@ func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLinkQRCodeParams
-
-	// ------------- Required query parameter "sessionID" -------------
-
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinkQRCode(w, r, id, params)
+		siw.Handler.AddCampaignLinks(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1622,8 +4187,8 @@ func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLinkQrCode operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *http.Request) {
+// DeleteCampaignLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCampaignLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1637,8 +4202,19 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Path parameter "linkID" -------------
+	var linkID CampaignLinkID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "linkID", chi.URLParam(r, "linkID"), &linkID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkQrCode(w, r, id)
+		siw.Handler.DeleteCampaignLink(w, r, id, linkID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1648,23 +4224,74 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRevocationStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r *http.Request) {
+// GetPublicCatalog operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicCatalog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublicCatalog(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetConnections operation middleware
+func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConnectionsParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "credentials" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentials", r.URL.Query(), &params.Credentials)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentials", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRevocationStatus(w, r, nonce)
+		siw.Handler.GetConnections(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1674,25 +4301,44 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RevokeCredential operation middleware
-func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+// DeleteConnection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteConnectionParams
+
+	// ------------- Optional query parameter "revokeCredentials" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "revokeCredentials", r.URL.Query(), &params.RevokeCredentials)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revokeCredentials", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deleteCredentials" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleteCredentials", r.URL.Query(), &params.DeleteCredentials)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleteCredentials", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeCredential(w, r, nonce)
+		siw.Handler.DeleteConnection(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1702,8 +4348,8 @@ func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteCredential operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
+// GetConnection operation middleware
+func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1720,7 +4366,7 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCredential(w, r, id)
+		siw.Handler.GetConnection(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1730,8 +4376,8 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCredential operation middleware
-func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
+// UpdateConnection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1748,7 +4394,7 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredential(w, r, id)
+		siw.Handler.UpdateConnection(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1758,8 +4404,8 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCredentialQrCode operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r *http.Request) {
+// DeleteConnectionCredentials operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1773,19 +4419,10 @@ func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCredentialQrCodeParams
-
-	// ------------- Optional query parameter "type" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentialQrCode(w, r, id, params)
+		siw.Handler.DeleteConnectionCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1795,25 +4432,25 @@ func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetQrFromStore operation middleware
-func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
+// RevokeConnectionCredentials operation middleware
+func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetQrFromStoreParams
-
-	// ------------- Optional query parameter "id" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetQrFromStore(w, r, params)
+		siw.Handler.RevokeConnectionCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1823,27 +4460,25 @@ func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSchemas operation middleware
-func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+// CreateConnectionRebindingCode operation middleware
+func (siw *ServerInterfaceWrapper) CreateConnectionRebindingCode(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSchemasParams
-
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchemas(w, r, params)
+		siw.Handler.CreateConnectionRebindingCode(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1853,14 +4488,12 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ImportSchema operation middleware
-func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+// RedeemConnectionRebindingCode operation middleware
+func (siw *ServerInterfaceWrapper) RedeemConnectionRebindingCode(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportSchema(w, r)
+		siw.Handler.RedeemConnectionRebindingCode(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1870,8 +4503,8 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSchema operation middleware
-func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+// GetConnectionReAuthQRCode operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionReAuthQRCode(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1887,8 +4520,19 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConnectionReAuthQRCodeParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchema(w, r, id)
+		siw.Handler.GetConnectionReAuthQRCode(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1898,14 +4542,14 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PublishState operation middleware
-func (siw *ServerInterfaceWrapper) PublishState(w http.ResponseWriter, r *http.Request) {
+// GetConnectionDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionDuplicates(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishState(w, r)
+		siw.Handler.GetConnectionDuplicates(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1915,14 +4559,35 @@ func (siw *ServerInterfaceWrapper) PublishState(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RetryPublishState operation middleware
-func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+// GetDeadConnections operation middleware
+func (siw *ServerInterfaceWrapper) GetDeadConnections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeadConnectionsParams
+
+	// ------------- Optional query parameter "inactiveDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inactiveDays", r.URL.Query(), &params.InactiveDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inactiveDays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryPublishState(w, r)
+		siw.Handler.GetDeadConnections(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1932,14 +4597,14 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStateStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+// PruneConnections operation middleware
+func (siw *ServerInterfaceWrapper) PruneConnections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateStatus(w, r)
+		siw.Handler.PruneConnections(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1949,14 +4614,25 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStateTransactions operation middleware
-func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+// MergeConnection operation middleware
+func (siw *ServerInterfaceWrapper) MergeConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateTransactions(w, r)
+		siw.Handler.MergeConnection(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1966,209 +4642,3085 @@ func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetConnectionMerges operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionMerges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnectionMerges(w, r, id)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// GetConnectionWallet operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionWallet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	var err error
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnectionWallet(w, r, id)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// GetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialsParams
+
+	// ------------- Optional query parameter "did" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "did", r.URL.Query(), &params.Did)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "did", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "externalId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "externalId", r.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "externalId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "profileID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profileID", r.URL.Query(), &params.ProfileID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "profileID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentials(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// CreateCredential operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCredential(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// ArchiveCredentials operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveCredentials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// ImportCredential operation middleware
+func (siw *ServerInterfaceWrapper) ImportCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCredential(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// InspectCredentialLayout operation middleware
+func (siw *ServerInterfaceWrapper) InspectCredentialLayout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InspectCredentialLayout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if r == nil {
-		r = chi.NewRouter()
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLinkQrCodeCallback operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeCallbackParams
+
+	// ------------- Required query parameter "sessionID" -------------
+
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "linkID" -------------
+
+	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkQrCodeCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLink(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLink operation middleware
+func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLink(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AcivateLink operation middleware
+func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcivateLink(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLinkBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkBatch(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CloneLink operation middleware
+func (siw *ServerInterfaceWrapper) CloneLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneLink(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinkPrerequisiteProofs operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkPrerequisiteProofs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkPrerequisiteProofs(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinkQRCode operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinkQRCodeParams
+
+	// ------------- Required query parameter "sessionID" -------------
+
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkQRCode(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLinkQrCode operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "compress" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "compress", r.URL.Query(), &params.Compress)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compress", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkQrCode(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLinkQrCodeHTML operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCodeHTML(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeHTMLParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkQrCodeHTML(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinkRecipients operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkRecipients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkRecipients(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportLinkRecipients operation middleware
+func (siw *ServerInterfaceWrapper) ImportLinkRecipients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportLinkRecipients(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationStatusBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationStatusBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationStatusBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationStatus(w, r, nonce)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHistoricalRevocationStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetHistoricalRevocationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHistoricalRevocationStatusParams
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamp", r.URL.Query(), &params.Timestamp)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timestamp", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHistoricalRevocationStatus(w, r, nonce, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevokeCredential operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeCredentialParams
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", r.URL.Query(), &params.Cascade)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cascade", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCredential(w, r, nonce, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCredential operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialParams
+
+	// ------------- Optional query parameter "unmasked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unmasked", r.URL.Query(), &params.Unmasked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unmasked", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredential(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialVerificationBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialVerificationBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialVerificationBundleParams
+
+	// ------------- Optional query parameter "unmasked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unmasked", r.URL.Query(), &params.Unmasked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unmasked", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialVerificationBundle(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialVersions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialDependents operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialDependents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialDependents(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialImport operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialImport(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialPDF operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialPDF(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialPDFParams
+
+	// ------------- Optional query parameter "unmasked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unmasked", r.URL.Query(), &params.Unmasked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unmasked", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialPDF(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RehydrateCredential operation middleware
+func (siw *ServerInterfaceWrapper) RehydrateCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RehydrateCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialQrCode operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialQrCodeParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "compress" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "compress", r.URL.Query(), &params.Compress)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compress", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "connect" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connect", r.URL.Query(), &params.Connect)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connect", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialQrCode(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialQrCodeHTML operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialQrCodeHTML(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialQrCodeHTMLParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialQrCodeHTML(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderGetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) HolderGetCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderGetCredentials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderGetCredentialPreview operation middleware
+func (siw *ServerInterfaceWrapper) HolderGetCredentialPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderGetCredentialPreview(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderGetCredentialPreviewHTML operation middleware
+func (siw *ServerInterfaceWrapper) HolderGetCredentialPreviewHTML(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderGetCredentialPreviewHTML(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderGetCredentialQrCode operation middleware
+func (siw *ServerInterfaceWrapper) HolderGetCredentialQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HolderGetCredentialQrCodeParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderGetCredentialQrCode(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderReissueCredential operation middleware
+func (siw *ServerInterfaceWrapper) HolderReissueCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderReissueCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderRequestRevocation operation middleware
+func (siw *ServerInterfaceWrapper) HolderRequestRevocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderRequestRevocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HolderCreateSession operation middleware
+func (siw *ServerInterfaceWrapper) HolderCreateSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HolderCreateSession(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetIssuerProfiles operation middleware
+func (siw *ServerInterfaceWrapper) GetIssuerProfiles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIssuerProfiles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateIssuerProfile operation middleware
+func (siw *ServerInterfaceWrapper) CreateIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIssuerProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteIssuerProfile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteIssuerProfile(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetIssuerProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIssuerProfile(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOrganizationCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationCredentialsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationCredentials(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReceiveOrganizationCredential operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReceiveOrganizationCredential(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteOrganizationCredential operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOrganizationCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateOrganizationCredential operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationCredential(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOrganizationPresentation operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationPresentation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationPresentationParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationPresentation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPresentationTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetPresentationTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPresentationTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePresentationTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreatePresentationTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePresentationTemplate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeletePresentationTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeletePresentationTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name PresentationTemplateName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePresentationTemplate(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPresentationTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetPresentationTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name PresentationTemplateName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPresentationTemplate(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetQRBranding operation middleware
+func (siw *ServerInterfaceWrapper) GetQRBranding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQRBranding(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateQRBranding operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQRBranding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateQRBranding(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetQrFromStore operation middleware
+func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQrFromStoreParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQrFromStore(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetQrImageFromStore operation middleware
+func (siw *ServerInterfaceWrapper) GetQrImageFromStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQrImageFromStoreParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQrImageFromStore(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationNonceRanges operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationNonceRanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationNonceRanges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReserveRevocationNonceRange operation middleware
+func (siw *ServerInterfaceWrapper) ReserveRevocationNonceRange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReserveRevocationNonceRange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRevocationRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ApproveRevocationRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveRevocationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveRevocationRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RejectRevocationRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectRevocationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectRevocationRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationRules operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateRevocationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateRevocationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRevocationRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRevocationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRevocationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRevocationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateRevocationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRevocationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRevocationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PreviewRevocationRule operation middleware
+func (siw *ServerInterfaceWrapper) PreviewRevocationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewRevocationRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSchemas operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSchemasParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemas(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportSchema operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportSchema(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchema(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSchema operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchema(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCredentialPDFTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentialPDFTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCredentialPDFTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCredentialPDFTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialPDFTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialPDFTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateCredentialPDFTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCredentialPDFTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCredentialPDFTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSchemaSelfRevocation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchemaSelfRevocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchemaSelfRevocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSchemaUniqueness operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchemaUniqueness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchemaUniqueness(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSchemaWebhookTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchemaWebhookTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchemaWebhookTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSessionEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessionEvents(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatePending operation middleware
+func (siw *ServerInterfaceWrapper) GetStatePending(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatePending(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PublishState operation middleware
+func (siw *ServerInterfaceWrapper) PublishState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishState(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RetryPublishState operation middleware
+func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPublishState(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStateStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStateCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) GetStateCheckpoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateCheckpoints(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateTransactions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTranslations operation middleware
+func (siw *ServerInterfaceWrapper) GetTranslations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranslations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTranslation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "locale" -------------
+	var locale PathLocale
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", chi.URLParam(r, "locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTranslation(w, r, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTranslation operation middleware
+func (siw *ServerInterfaceWrapper) GetTranslation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "locale" -------------
+	var locale PathLocale
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", chi.URLParam(r, "locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranslation(w, r, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SaveTranslation operation middleware
+func (siw *ServerInterfaceWrapper) SaveTranslation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "locale" -------------
+	var locale PathLocale
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", chi.URLParam(r, "locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SaveTranslation(w, r, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWalletCompatibilityReport operation middleware
+func (siw *ServerInterfaceWrapper) GetWalletCompatibilityReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWalletCompatibilityReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/static/docs/api_ui/api.yaml", wrapper.GetYaml)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/key-migrations", wrapper.GetKeyMigrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/key-migrations", wrapper.StartKeyMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/key-migrations/{id}", wrapper.GetKeyMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/key-migrations/{id}/resume", wrapper.ResumeKeyMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/migrations", wrapper.GetMigrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/migrations", wrapper.RunMigrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/api-keys", wrapper.GetAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/api-keys/read-only", wrapper.CreateReadOnlyAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/api-keys/{id}", wrapper.RevokeAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/api-keys/{id}/rotate", wrapper.RotateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/authentication/callback", wrapper.AuthCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/authentication/qrcode", wrapper.AuthQRCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/campaigns", wrapper.GetCampaigns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/campaigns", wrapper.CreateCampaign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/campaigns/{id}", wrapper.DeleteCampaign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/campaigns/{id}", wrapper.GetCampaign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/campaigns/{id}", wrapper.UpdateCampaign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/campaigns/{id}/credentials", wrapper.AddCampaignCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/campaigns/{id}/links", wrapper.AddCampaignLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/campaigns/{id}/links/{linkID}", wrapper.DeleteCampaignLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/catalog", wrapper.GetPublicCatalog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections", wrapper.GetConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/connections/{id}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}", wrapper.GetConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/connections/{id}", wrapper.UpdateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/{id}/rebinding-code", wrapper.CreateConnectionRebindingCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/rebinding", wrapper.RedeemConnectionRebindingCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}/reauthentication/qrcode", wrapper.GetConnectionReAuthQRCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/duplicates", wrapper.GetConnectionDuplicates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/dead", wrapper.GetDeadConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/prune", wrapper.PruneConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/{id}/merge", wrapper.MergeConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}/merges", wrapper.GetConnectionMerges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}/wallet", wrapper.GetConnectionWallet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials", wrapper.GetCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials", wrapper.CreateCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/archive", wrapper.ArchiveCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/import", wrapper.ImportCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/layout", wrapper.InspectCredentialLayout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links", wrapper.GetLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links", wrapper.CreateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/credentials/links/{id}", wrapper.DeleteLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}", wrapper.GetLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/credentials/links/{id}", wrapper.AcivateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/batch", wrapper.CreateLinkBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/clone", wrapper.CloneLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/prerequisite-proofs", wrapper.GetLinkPrerequisiteProofs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.GetLinkQRCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.CreateLinkQrCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/qrcode/html", wrapper.CreateLinkQrCodeHTML)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/recipients", wrapper.GetLinkRecipients)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/recipients", wrapper.ImportLinkRecipients)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/revocation/status/batch", wrapper.GetRevocationStatusBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/revocation/status/{nonce}/historical", wrapper.GetHistoricalRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/revoke/{nonce}", wrapper.RevokeCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/credentials/{id}", wrapper.DeleteCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}", wrapper.GetCredential)
+	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/bundle", wrapper.GetCredentialVerificationBundle)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/versions", wrapper.GetCredentialVersions)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/dependents", wrapper.GetCredentialDependents)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/static/docs/api_ui/api.yaml", wrapper.GetYaml)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/import", wrapper.GetCredentialImport)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status", wrapper.Health)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/pdf", wrapper.GetCredentialPDF)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
+		r.Post(options.BaseURL+"/v1/credentials/{id}/rehydrate", wrapper.RehydrateCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/authentication/callback", wrapper.AuthCallback)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/qrcode", wrapper.GetCredentialQrCode)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/authentication/qrcode", wrapper.AuthQRCode)
+		r.Get(options.BaseURL+"/v1/credentials/{id}/qrcode/html", wrapper.GetCredentialQrCodeHTML)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
+		r.Get(options.BaseURL+"/v1/holder/credentials", wrapper.HolderGetCredentials)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/connections", wrapper.GetConnections)
+		r.Get(options.BaseURL+"/v1/holder/credentials/{id}/preview", wrapper.HolderGetCredentialPreview)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/connections/{id}", wrapper.DeleteConnection)
+		r.Get(options.BaseURL+"/v1/holder/credentials/{id}/preview/html", wrapper.HolderGetCredentialPreviewHTML)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/connections/{id}", wrapper.GetConnection)
+		r.Get(options.BaseURL+"/v1/holder/credentials/{id}/qrcode", wrapper.HolderGetCredentialQrCode)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
+		r.Post(options.BaseURL+"/v1/holder/credentials/{id}/reissue", wrapper.HolderReissueCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
+		r.Post(options.BaseURL+"/v1/holder/credentials/{id}/revocation-request", wrapper.HolderRequestRevocation)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials", wrapper.GetCredentials)
+		r.Post(options.BaseURL+"/v1/holder/sessions/{id}", wrapper.HolderCreateSession)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials", wrapper.CreateCredential)
+		r.Get(options.BaseURL+"/v1/issuer-profiles", wrapper.GetIssuerProfiles)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links", wrapper.GetLinks)
+		r.Post(options.BaseURL+"/v1/issuer-profiles", wrapper.CreateIssuerProfile)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links", wrapper.CreateLink)
+		r.Delete(options.BaseURL+"/v1/issuer-profiles/{id}", wrapper.DeleteIssuerProfile)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
+		r.Get(options.BaseURL+"/v1/issuer-profiles/{id}", wrapper.GetIssuerProfile)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/credentials/links/{id}", wrapper.DeleteLink)
+		r.Get(options.BaseURL+"/v1/organization-credentials", wrapper.GetOrganizationCredentials)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links/{id}", wrapper.GetLink)
+		r.Post(options.BaseURL+"/v1/organization-credentials", wrapper.ReceiveOrganizationCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v1/credentials/links/{id}", wrapper.AcivateLink)
+		r.Delete(options.BaseURL+"/v1/organization-credentials/{id}", wrapper.DeleteOrganizationCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.GetLinkQRCode)
+		r.Get(options.BaseURL+"/v1/organization-credentials/{id}", wrapper.GetOrganizationCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.CreateLinkQrCode)
+		r.Patch(options.BaseURL+"/v1/organization-credentials/{id}", wrapper.UpdateOrganizationCredential)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+		r.Get(options.BaseURL+"/v1/organization-presentation", wrapper.GetOrganizationPresentation)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/revoke/{nonce}", wrapper.RevokeCredential)
+		r.Get(options.BaseURL+"/v1/presentation-templates", wrapper.GetPresentationTemplates)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/credentials/{id}", wrapper.DeleteCredential)
+		r.Post(options.BaseURL+"/v1/presentation-templates", wrapper.CreatePresentationTemplate)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/{id}", wrapper.GetCredential)
+		r.Delete(options.BaseURL+"/v1/presentation-templates/{name}", wrapper.DeletePresentationTemplate)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/{id}/qrcode", wrapper.GetCredentialQrCode)
+		r.Get(options.BaseURL+"/v1/presentation-templates/{name}", wrapper.GetPresentationTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/qr-branding", wrapper.GetQRBranding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/qr-branding", wrapper.UpdateQRBranding)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/qr-store", wrapper.GetQrFromStore)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/qr-store/image", wrapper.GetQrImageFromStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/revocation-nonces/ranges", wrapper.GetRevocationNonceRanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/revocation-nonces/ranges", wrapper.ReserveRevocationNonceRange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/revocation-requests", wrapper.GetRevocationRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/revocation-requests/{id}/approve", wrapper.ApproveRevocationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/revocation-requests/{id}/reject", wrapper.RejectRevocationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/revocation-rules", wrapper.GetRevocationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/revocation-rules", wrapper.CreateRevocationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/revocation-rules/{id}", wrapper.DeleteRevocationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/revocation-rules/{id}", wrapper.GetRevocationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/revocation-rules/{id}", wrapper.UpdateRevocationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/revocation-rules/{id}/preview", wrapper.PreviewRevocationRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/schemas", wrapper.GetSchemas)
 	})
@@ -2178,6 +7730,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/schemas/{id}", wrapper.GetSchema)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/schemas/{id}", wrapper.UpdateSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/schemas/{id}/pdf-template", wrapper.DeleteCredentialPDFTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/schemas/{id}/pdf-template", wrapper.GetCredentialPDFTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/schemas/{id}/pdf-template", wrapper.UpdateCredentialPDFTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/schemas/{id}/self-revocation", wrapper.UpdateSchemaSelfRevocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/schemas/{id}/uniqueness", wrapper.UpdateSchemaUniqueness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/schemas/{id}/webhook-template", wrapper.UpdateSchemaWebhookTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/sessions/{id}/events", wrapper.GetSessionEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/state/pending", wrapper.GetStatePending)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/state/publish", wrapper.PublishState)
 	})
@@ -2187,1491 +7766,8972 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/state/status", wrapper.GetStateStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/state/checkpoints", wrapper.GetStateCheckpoints)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/state/transactions", wrapper.GetStateTransactions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/stats", wrapper.GetStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/translations", wrapper.GetTranslations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/translations/{locale}", wrapper.DeleteTranslation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/translations/{locale}", wrapper.GetTranslation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/translations/{locale}", wrapper.SaveTranslation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets", wrapper.GetWalletCompatibilityReport)
+	})
+
+	return r
+}
+
+type N400JSONResponse GenericErrorMessage
+
+type N401JSONResponse GenericErrorMessage
+
+type N403JSONResponse GenericErrorMessage
+
+type N404JSONResponse GenericErrorMessage
+
+type N409JSONResponse GenericErrorMessage
+
+type N422JSONResponse GenericErrorMessage
+
+type N429ResponseHeaders struct {
+	RetryAfter int
+}
+type N429JSONResponse struct {
+	Body GenericErrorMessage
+
+	Headers N429ResponseHeaders
+}
+
+type N500JSONResponse GenericErrorMessage
+
+type GetDocumentationRequestObject struct {
+}
+
+type GetDocumentationResponseObject interface {
+	VisitGetDocumentationResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentation200Response struct {
+}
+
+func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetConfigRequestObject struct {
+}
+
+type GetConfigResponseObject interface {
+	VisitGetConfigResponse(w http.ResponseWriter) error
+}
+
+type GetConfig200JSONResponse Config
+
+func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfig500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConfig500JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFaviconRequestObject struct {
+}
+
+type GetFaviconResponseObject interface {
+	VisitGetFaviconResponse(w http.ResponseWriter) error
+}
+
+type GetFavicon200Response struct {
+}
+
+func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetYamlRequestObject struct {
+}
+
+type GetYamlResponseObject interface {
+	VisitGetYamlResponse(w http.ResponseWriter) error
+}
+
+type GetYaml200Response struct {
+}
+
+func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse Health
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health500JSONResponse struct{ N500JSONResponse }
+
+func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigrationsRequestObject struct {
+}
+
+type GetKeyMigrationsResponseObject interface {
+	VisitGetKeyMigrationsResponse(w http.ResponseWriter) error
+}
+
+type GetKeyMigrations200JSONResponse []KeyMigration
+
+func (response GetKeyMigrations200JSONResponse) VisitGetKeyMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigrations401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKeyMigrations401JSONResponse) VisitGetKeyMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigrations403JSONResponse struct{ N403JSONResponse }
+
+func (response GetKeyMigrations403JSONResponse) VisitGetKeyMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigrations500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyMigrations500JSONResponse) VisitGetKeyMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigrationRequestObject struct {
+	Body *StartKeyMigrationJSONRequestBody
+}
+
+type StartKeyMigrationResponseObject interface {
+	VisitStartKeyMigrationResponse(w http.ResponseWriter) error
+}
+
+type StartKeyMigration201JSONResponse KeyMigration
+
+func (response StartKeyMigration201JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response StartKeyMigration400JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response StartKeyMigration401JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigration403JSONResponse struct{ N403JSONResponse }
+
+func (response StartKeyMigration403JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigration409JSONResponse struct{ N409JSONResponse }
+
+func (response StartKeyMigration409JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartKeyMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response StartKeyMigration500JSONResponse) VisitStartKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigrationRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetKeyMigrationResponseObject interface {
+	VisitGetKeyMigrationResponse(w http.ResponseWriter) error
+}
+
+type GetKeyMigration200JSONResponse KeyMigration
+
+func (response GetKeyMigration200JSONResponse) VisitGetKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKeyMigration401JSONResponse) VisitGetKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigration403JSONResponse struct{ N403JSONResponse }
+
+func (response GetKeyMigration403JSONResponse) VisitGetKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeyMigration404JSONResponse) VisitGetKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyMigration500JSONResponse) VisitGetKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigrationRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type ResumeKeyMigrationResponseObject interface {
+	VisitResumeKeyMigrationResponse(w http.ResponseWriter) error
+}
+
+type ResumeKeyMigration200JSONResponse KeyMigration
+
+func (response ResumeKeyMigration200JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response ResumeKeyMigration401JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigration403JSONResponse struct{ N403JSONResponse }
+
+func (response ResumeKeyMigration403JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response ResumeKeyMigration404JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigration409JSONResponse struct{ N409JSONResponse }
+
+func (response ResumeKeyMigration409JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response ResumeKeyMigration500JSONResponse) VisitResumeKeyMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMigrationsRequestObject struct {
+}
+
+type GetMigrationsResponseObject interface {
+	VisitGetMigrationsResponse(w http.ResponseWriter) error
+}
+
+type GetMigrations200JSONResponse MigrationsStatus
+
+func (response GetMigrations200JSONResponse) VisitGetMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMigrations401JSONResponse struct{ N401JSONResponse }
+
+func (response GetMigrations401JSONResponse) VisitGetMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMigrations403JSONResponse struct{ N403JSONResponse }
+
+func (response GetMigrations403JSONResponse) VisitGetMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMigrations500JSONResponse struct{ N500JSONResponse }
+
+func (response GetMigrations500JSONResponse) VisitGetMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMigrationsRequestObject struct {
+}
+
+type RunMigrationsResponseObject interface {
+	VisitRunMigrationsResponse(w http.ResponseWriter) error
+}
+
+type RunMigrations200JSONResponse RunMigrationsResponse
+
+func (response RunMigrations200JSONResponse) VisitRunMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMigrations401JSONResponse struct{ N401JSONResponse }
+
+func (response RunMigrations401JSONResponse) VisitRunMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMigrations403JSONResponse struct{ N403JSONResponse }
+
+func (response RunMigrations403JSONResponse) VisitRunMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMigrations409JSONResponse struct{ N409JSONResponse }
+
+func (response RunMigrations409JSONResponse) VisitRunMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMigrations500JSONResponse struct{ N500JSONResponse }
+
+func (response RunMigrations500JSONResponse) VisitRunMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentRequestObject struct {
+	Body *AgentTextRequestBody
+}
+
+type AgentResponseObject interface {
+	VisitAgentResponse(w http.ResponseWriter) error
+}
+
+type Agent200JSONResponse AgentResponse
+
+func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent400JSONResponse struct{ N400JSONResponse }
+
+func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent500JSONResponse struct{ N500JSONResponse }
+
+func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeysRequestObject struct {
+}
+
+type GetAPIKeysResponseObject interface {
+	VisitGetAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKeys200JSONResponse []APIKey
+
+func (response GetAPIKeys200JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAPIKeys401JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAPIKeys500JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
+}
+
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIKey201JSONResponse CreateAPIKeyResponse
+
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAPIKey400JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAPIKey500JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReadOnlyAPIKeyRequestObject struct {
+	Body *CreateReadOnlyAPIKeyJSONRequestBody
+}
+
+type CreateReadOnlyAPIKeyResponseObject interface {
+	VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateReadOnlyAPIKey201JSONResponse CreateAPIKeyResponse
+
+func (response CreateReadOnlyAPIKey201JSONResponse) VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReadOnlyAPIKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateReadOnlyAPIKey400JSONResponse) VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReadOnlyAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateReadOnlyAPIKey401JSONResponse) VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReadOnlyAPIKey403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateReadOnlyAPIKey403JSONResponse) VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReadOnlyAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateReadOnlyAPIKey500JSONResponse) VisitCreateReadOnlyAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKeyRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RevokeAPIKeyResponseObject interface {
+	VisitRevokeAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeAPIKey200JSONResponse GenericMessage
+
+func (response RevokeAPIKey200JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeAPIKey401JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeAPIKey404JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeAPIKey500JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAPIKeyRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RotateAPIKeyResponseObject interface {
+	VisitRotateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type RotateAPIKey200JSONResponse CreateAPIKeyResponse
+
+func (response RotateAPIKey200JSONResponse) VisitRotateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response RotateAPIKey401JSONResponse) VisitRotateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response RotateAPIKey404JSONResponse) VisitRotateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response RotateAPIKey500JSONResponse) VisitRotateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallbackRequestObject struct {
+	Params AuthCallbackParams
+	Body   *AuthCallbackTextRequestBody
+}
+
+type AuthCallbackResponseObject interface {
+	VisitAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthCallback200Response struct {
+}
+
+func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AuthCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthQRCodeRequestObject struct {
+	Params AuthQRCodeParams
+}
+
+type AuthQRCodeResponseObject interface {
+	VisitAuthQRCodeResponse(w http.ResponseWriter) error
+}
+
+type AuthQRCode200JSONResponse QrCodeLinkShortResponse
+
+func (response AuthQRCode200JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthQRCode500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthQRCode500JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetAuthenticationConnectionResponseObject interface {
+	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+
+func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsRequestObject struct {
+}
+
+type GetCampaignsResponseObject interface {
+	VisitGetCampaignsResponse(w http.ResponseWriter) error
+}
+
+type GetCampaigns200JSONResponse []Campaign
+
+func (response GetCampaigns200JSONResponse) VisitGetCampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaigns401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCampaigns401JSONResponse) VisitGetCampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaigns500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCampaigns500JSONResponse) VisitGetCampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCampaignRequestObject struct {
+	Body *CreateCampaignJSONRequestBody
+}
+
+type CreateCampaignResponseObject interface {
+	VisitCreateCampaignResponse(w http.ResponseWriter) error
+}
+
+type CreateCampaign201JSONResponse Campaign
+
+func (response CreateCampaign201JSONResponse) VisitCreateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCampaign400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCampaign400JSONResponse) VisitCreateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCampaign401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCampaign401JSONResponse) VisitCreateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCampaign500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCampaign500JSONResponse) VisitCreateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaignRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteCampaignResponseObject interface {
+	VisitDeleteCampaignResponse(w http.ResponseWriter) error
+}
+
+type DeleteCampaign200JSONResponse GenericMessage
+
+func (response DeleteCampaign200JSONResponse) VisitDeleteCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaign401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCampaign401JSONResponse) VisitDeleteCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaign404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCampaign404JSONResponse) VisitDeleteCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaign500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCampaign500JSONResponse) VisitDeleteCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCampaignResponseObject interface {
+	VisitGetCampaignResponse(w http.ResponseWriter) error
+}
+
+type GetCampaign200JSONResponse Campaign
+
+func (response GetCampaign200JSONResponse) VisitGetCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaign401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCampaign401JSONResponse) VisitGetCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaign404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCampaign404JSONResponse) VisitGetCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaign500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCampaign500JSONResponse) VisitGetCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCampaignRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateCampaignJSONRequestBody
+}
+
+type UpdateCampaignResponseObject interface {
+	VisitUpdateCampaignResponse(w http.ResponseWriter) error
+}
+
+type UpdateCampaign200JSONResponse Campaign
+
+func (response UpdateCampaign200JSONResponse) VisitUpdateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCampaign400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCampaign400JSONResponse) VisitUpdateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCampaign401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateCampaign401JSONResponse) VisitUpdateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCampaign404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCampaign404JSONResponse) VisitUpdateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCampaign500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCampaign500JSONResponse) VisitUpdateCampaignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignCredentialsRequestObject struct {
+	Id   Id `json:"id"`
+	Body *AddCampaignCredentialsJSONRequestBody
+}
+
+type AddCampaignCredentialsResponseObject interface {
+	VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error
+}
+
+type AddCampaignCredentials200JSONResponse GenericMessage
+
+func (response AddCampaignCredentials200JSONResponse) VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response AddCampaignCredentials400JSONResponse) VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response AddCampaignCredentials401JSONResponse) VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response AddCampaignCredentials404JSONResponse) VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response AddCampaignCredentials500JSONResponse) VisitAddCampaignCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignLinksRequestObject struct {
+	Id   Id `json:"id"`
+	Body *AddCampaignLinksJSONRequestBody
+}
+
+type AddCampaignLinksResponseObject interface {
+	VisitAddCampaignLinksResponse(w http.ResponseWriter) error
+}
+
+type AddCampaignLinks200JSONResponse GenericMessage
+
+func (response AddCampaignLinks200JSONResponse) VisitAddCampaignLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response AddCampaignLinks400JSONResponse) VisitAddCampaignLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignLinks401JSONResponse struct{ N401JSONResponse }
+
+func (response AddCampaignLinks401JSONResponse) VisitAddCampaignLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response AddCampaignLinks404JSONResponse) VisitAddCampaignLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCampaignLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response AddCampaignLinks500JSONResponse) VisitAddCampaignLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaignLinkRequestObject struct {
+	Id     Id             `json:"id"`
+	LinkID CampaignLinkID `json:"linkID"`
+}
+
+type DeleteCampaignLinkResponseObject interface {
+	VisitDeleteCampaignLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteCampaignLink200JSONResponse GenericMessage
+
+func (response DeleteCampaignLink200JSONResponse) VisitDeleteCampaignLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaignLink401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCampaignLink401JSONResponse) VisitDeleteCampaignLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaignLink404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCampaignLink404JSONResponse) VisitDeleteCampaignLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCampaignLink500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCampaignLink500JSONResponse) VisitDeleteCampaignLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublicCatalogRequestObject struct {
+}
+
+type GetPublicCatalogResponseObject interface {
+	VisitGetPublicCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetPublicCatalog200JSONResponse PublicCatalog
+
+func (response GetPublicCatalog200JSONResponse) VisitGetPublicCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublicCatalog404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPublicCatalog404JSONResponse) VisitGetPublicCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublicCatalog500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPublicCatalog500JSONResponse) VisitGetPublicCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionsRequestObject struct {
+	Params GetConnectionsParams
+}
+
+type GetConnectionsResponseObject interface {
+	VisitGetConnectionsResponse(w http.ResponseWriter) error
+}
+
+type GetConnections200JSONResponse ConnectionsPaginated
+
+func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionRequestObject struct {
+	Id     Id `json:"id"`
+	Params DeleteConnectionParams
+}
+
+type DeleteConnectionResponseObject interface {
+	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnection200JSONResponse GenericMessage
+
+func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteConnection404JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetConnectionResponseObject interface {
+	VisitGetConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetConnection200JSONResponse GetConnectionResponse
+
+func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnection404JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnectionRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateConnectionJSONRequestBody
+}
+
+type UpdateConnectionResponseObject interface {
+	VisitUpdateConnectionResponse(w http.ResponseWriter) error
+}
+
+type UpdateConnection200JSONResponse GenericMessage
+
+func (response UpdateConnection200JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateConnection400JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateConnection404JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateConnection500JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentialsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteConnectionCredentialsResponseObject interface {
+	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnectionCredentials200JSONResponse GenericMessage
+
+func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentialsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RevokeConnectionCredentialsResponseObject interface {
+	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type RevokeConnectionCredentials202JSONResponse GenericMessage
+
+func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnectionRebindingCodeRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type CreateConnectionRebindingCodeResponseObject interface {
+	VisitCreateConnectionRebindingCodeResponse(w http.ResponseWriter) error
+}
+
+type CreateConnectionRebindingCode201JSONResponse ConnectionRebindingCode
+
+func (response CreateConnectionRebindingCode201JSONResponse) VisitCreateConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnectionRebindingCode404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateConnectionRebindingCode404JSONResponse) VisitCreateConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnectionRebindingCode500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateConnectionRebindingCode500JSONResponse) VisitCreateConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemConnectionRebindingCodeRequestObject struct {
+	Body *RedeemConnectionRebindingCodeJSONRequestBody
+}
+
+type RedeemConnectionRebindingCodeResponseObject interface {
+	VisitRedeemConnectionRebindingCodeResponse(w http.ResponseWriter) error
+}
+
+type RedeemConnectionRebindingCode200JSONResponse QrCodeLinkShortResponse
+
+func (response RedeemConnectionRebindingCode200JSONResponse) VisitRedeemConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemConnectionRebindingCode400JSONResponse struct{ N400JSONResponse }
+
+func (response RedeemConnectionRebindingCode400JSONResponse) VisitRedeemConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemConnectionRebindingCode500JSONResponse struct{ N500JSONResponse }
+
+func (response RedeemConnectionRebindingCode500JSONResponse) VisitRedeemConnectionRebindingCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionReAuthQRCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetConnectionReAuthQRCodeParams
+}
+
+type GetConnectionReAuthQRCodeResponseObject interface {
+	VisitGetConnectionReAuthQRCodeResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionReAuthQRCode200JSONResponse QrCodeLinkShortResponse
+
+func (response GetConnectionReAuthQRCode200JSONResponse) VisitGetConnectionReAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionReAuthQRCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionReAuthQRCode404JSONResponse) VisitGetConnectionReAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionReAuthQRCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionReAuthQRCode500JSONResponse) VisitGetConnectionReAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionDuplicatesRequestObject struct {
+}
+
+type GetConnectionDuplicatesResponseObject interface {
+	VisitGetConnectionDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionDuplicates200JSONResponse []ConnectionDuplicates
+
+func (response GetConnectionDuplicates200JSONResponse) VisitGetConnectionDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionDuplicates500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionDuplicates500JSONResponse) VisitGetConnectionDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeadConnectionsRequestObject struct {
+	Params GetDeadConnectionsParams
+}
+
+type GetDeadConnectionsResponseObject interface {
+	VisitGetDeadConnectionsResponse(w http.ResponseWriter) error
+}
+
+type GetDeadConnections200JSONResponse []DeadConnection
+
+func (response GetDeadConnections200JSONResponse) VisitGetDeadConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeadConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDeadConnections400JSONResponse) VisitGetDeadConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeadConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDeadConnections500JSONResponse) VisitGetDeadConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PruneConnectionsRequestObject struct {
+	Body *PruneConnectionsJSONRequestBody
+}
+
+type PruneConnectionsResponseObject interface {
+	VisitPruneConnectionsResponse(w http.ResponseWriter) error
+}
+
+type PruneConnections200JSONResponse PruneConnectionsResponse
+
+func (response PruneConnections200JSONResponse) VisitPruneConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PruneConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response PruneConnections400JSONResponse) VisitPruneConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PruneConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response PruneConnections500JSONResponse) VisitPruneConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeConnectionRequestObject struct {
+	Id   Id `json:"id"`
+	Body *MergeConnectionJSONRequestBody
+}
+
+type MergeConnectionResponseObject interface {
+	VisitMergeConnectionResponse(w http.ResponseWriter) error
+}
+
+type MergeConnection200JSONResponse ConnectionMerge
+
+func (response MergeConnection200JSONResponse) VisitMergeConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response MergeConnection400JSONResponse) VisitMergeConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response MergeConnection404JSONResponse) VisitMergeConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response MergeConnection500JSONResponse) VisitMergeConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionMergesRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetConnectionMergesResponseObject interface {
+	VisitGetConnectionMergesResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionMerges200JSONResponse []ConnectionMerge
+
+func (response GetConnectionMerges200JSONResponse) VisitGetConnectionMergesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionMerges404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionMerges404JSONResponse) VisitGetConnectionMergesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionMerges500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionMerges500JSONResponse) VisitGetConnectionMergesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionWalletRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetConnectionWalletResponseObject interface {
+	VisitGetConnectionWalletResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionWallet200JSONResponse WalletCompatibility
+
+func (response GetConnectionWallet200JSONResponse) VisitGetConnectionWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionWallet404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionWallet404JSONResponse) VisitGetConnectionWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionWallet500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionWallet500JSONResponse) VisitGetConnectionWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialsRequestObject struct {
+	Params GetCredentialsParams
+}
+
+type GetCredentialsResponseObject interface {
+	VisitGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentials200JSONResponse CredentialsPaginated
+
+func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialRequestObject struct {
+	Body *CreateCredentialJSONRequestBody
+}
+
+type CreateCredentialResponseObject interface {
+	VisitCreateCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateCredential201JSONResponse UUIDResponse
+
+func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveCredentialsRequestObject struct {
+	Body *ArchiveCredentialsJSONRequestBody
+}
+
+type ArchiveCredentialsResponseObject interface {
+	VisitArchiveCredentialsResponse(w http.ResponseWriter) error
+}
+
+type ArchiveCredentials200JSONResponse ArchiveCredentialsResponse
+
+func (response ArchiveCredentials200JSONResponse) VisitArchiveCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response ArchiveCredentials400JSONResponse) VisitArchiveCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response ArchiveCredentials500JSONResponse) VisitArchiveCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentialRequestObject struct {
+	Body *ImportCredentialJSONRequestBody
+}
+
+type ImportCredentialResponseObject interface {
+	VisitImportCredentialResponse(w http.ResponseWriter) error
+}
+
+type ImportCredential201JSONResponse CredentialImport
+
+func (response ImportCredential201JSONResponse) VisitImportCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportCredential400JSONResponse) VisitImportCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response ImportCredential409JSONResponse) VisitImportCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response ImportCredential422JSONResponse) VisitImportCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportCredential500JSONResponse) VisitImportCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InspectCredentialLayoutRequestObject struct {
+	Body *InspectCredentialLayoutJSONRequestBody
+}
+
+type InspectCredentialLayoutResponseObject interface {
+	VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error
+}
+
+type InspectCredentialLayout200JSONResponse CredentialLayout
+
+func (response InspectCredentialLayout200JSONResponse) VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InspectCredentialLayout400JSONResponse struct{ N400JSONResponse }
+
+func (response InspectCredentialLayout400JSONResponse) VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InspectCredentialLayout401JSONResponse struct{ N401JSONResponse }
+
+func (response InspectCredentialLayout401JSONResponse) VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InspectCredentialLayout422JSONResponse struct{ N422JSONResponse }
+
+func (response InspectCredentialLayout422JSONResponse) VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InspectCredentialLayout500JSONResponse struct{ N500JSONResponse }
+
+func (response InspectCredentialLayout500JSONResponse) VisitInspectCredentialLayoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinksRequestObject struct {
+	Params GetLinksParams
+}
+
+type GetLinksResponseObject interface {
+	VisitGetLinksResponse(w http.ResponseWriter) error
+}
+
+type GetLinks200JSONResponse []Link
+
+func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkRequestObject struct {
+	Body *CreateLinkJSONRequestBody
+}
+
+type CreateLinkResponseObject interface {
+	VisitCreateLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateLink201JSONResponse UUIDResponse
+
+func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallbackRequestObject struct {
+	Params CreateLinkQrCodeCallbackParams
+	Body   *CreateLinkQrCodeCallbackTextRequestBody
+}
+
+type CreateLinkQrCodeCallbackResponseObject interface {
+	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCodeCallback200Response struct {
+}
+
+func (response CreateLinkQrCodeCallback200Response) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateLinkQrCodeCallback403JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type CreateLinkQrCodeCallback429JSONResponse struct {
+	Body    ProblemReportMessage
+	Headers CreateLinkQrCodeCallback429ResponseHeaders
+}
+
+func (response CreateLinkQrCodeCallback429JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLinkRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteLinkResponseObject interface {
+	VisitDeleteLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteLink200JSONResponse GenericMessage
+
+func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteLink404JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkResponseObject interface {
+	VisitGetLinkResponse(w http.ResponseWriter) error
+}
+
+type GetLink200JSONResponse Link
+
+func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLinkRequestObject struct {
+	Id   Id `json:"id"`
+	Body *AcivateLinkJSONRequestBody
+}
+
+type AcivateLinkResponseObject interface {
+	VisitAcivateLinkResponse(w http.ResponseWriter) error
+}
+
+type AcivateLink200JSONResponse GenericMessage
+
+func (response AcivateLink200JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response AcivateLink400JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLink404JSONResponse struct{ N404JSONResponse }
+
+func (response AcivateLink404JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response AcivateLink500JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkBatchRequestObject struct {
+	Id   Id `json:"id"`
+	Body *CreateLinkBatchJSONRequestBody
+}
+
+type CreateLinkBatchResponseObject interface {
+	VisitCreateLinkBatchResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkBatch201JSONResponse CreateLinkBatchResponse
+
+func (response CreateLinkBatch201JSONResponse) VisitCreateLinkBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkBatch400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkBatch400JSONResponse) VisitCreateLinkBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkBatch404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkBatch404JSONResponse) VisitCreateLinkBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkBatch500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkBatch500JSONResponse) VisitCreateLinkBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneLinkRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type CloneLinkResponseObject interface {
+	VisitCloneLinkResponse(w http.ResponseWriter) error
+}
+
+type CloneLink201JSONResponse UUIDResponse
+
+func (response CloneLink201JSONResponse) VisitCloneLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneLink404JSONResponse struct{ N404JSONResponse }
+
+func (response CloneLink404JSONResponse) VisitCloneLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneLink500JSONResponse struct{ N500JSONResponse }
+
+func (response CloneLink500JSONResponse) VisitCloneLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkPrerequisiteProofsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkPrerequisiteProofsResponseObject interface {
+	VisitGetLinkPrerequisiteProofsResponse(w http.ResponseWriter) error
+}
+
+type GetLinkPrerequisiteProofs200JSONResponse []LinkPrerequisiteProof
+
+func (response GetLinkPrerequisiteProofs200JSONResponse) VisitGetLinkPrerequisiteProofsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkPrerequisiteProofs404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinkPrerequisiteProofs404JSONResponse) VisitGetLinkPrerequisiteProofsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkPrerequisiteProofs500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkPrerequisiteProofs500JSONResponse) VisitGetLinkPrerequisiteProofsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetLinkQRCodeParams
+}
+
+type GetLinkQRCodeResponseObject interface {
+	VisitGetLinkQRCodeResponse(w http.ResponseWriter) error
+}
+
+type GetLinkQRCode200JSONResponse GetLinkQrCodeResponse
+
+func (response GetLinkQRCode200JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinkQRCode400JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode404JSONResponse GenericErrorMessage
+
+func (response GetLinkQRCode404JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkQRCode500JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params CreateLinkQrCodeParams
+}
+
+type CreateLinkQrCodeResponseObject interface {
+	VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCode200JSONResponse CredentialLinkQrCodeResponse
+
+func (response CreateLinkQrCode200JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCode400JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateLinkQrCode403JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkQrCode404JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkQrCode429JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCode500JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeHTMLRequestObject struct {
+	Id     Id `json:"id"`
+	Params CreateLinkQrCodeHTMLParams
+}
+
+type CreateLinkQrCodeHTMLResponseObject interface {
+	VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCodeHTML200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response CreateLinkQrCodeHTML200TexthtmlResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type CreateLinkQrCodeHTML400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCodeHTML400JSONResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeHTML403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateLinkQrCodeHTML403JSONResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeHTML404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkQrCodeHTML404JSONResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeHTML429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkQrCodeHTML429JSONResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkQrCodeHTML500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCodeHTML500JSONResponse) VisitCreateLinkQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRecipientsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkRecipientsResponseObject interface {
+	VisitGetLinkRecipientsResponse(w http.ResponseWriter) error
+}
+
+type GetLinkRecipients200JSONResponse []LinkRecipient
+
+func (response GetLinkRecipients200JSONResponse) VisitGetLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRecipients404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinkRecipients404JSONResponse) VisitGetLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRecipients500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkRecipients500JSONResponse) VisitGetLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportLinkRecipientsRequestObject struct {
+	Id   Id `json:"id"`
+	Body io.Reader
+}
+
+type ImportLinkRecipientsResponseObject interface {
+	VisitImportLinkRecipientsResponse(w http.ResponseWriter) error
+}
+
+type ImportLinkRecipients201JSONResponse []LinkRecipient
+
+func (response ImportLinkRecipients201JSONResponse) VisitImportLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportLinkRecipients400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportLinkRecipients400JSONResponse) VisitImportLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportLinkRecipients404JSONResponse struct{ N404JSONResponse }
+
+func (response ImportLinkRecipients404JSONResponse) VisitImportLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportLinkRecipients500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportLinkRecipients500JSONResponse) VisitImportLinkRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusBatchRequestObject struct {
+	Body *GetRevocationStatusBatchJSONRequestBody
+}
+
+type GetRevocationStatusBatchResponseObject interface {
+	VisitGetRevocationStatusBatchResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatusBatch200JSONResponse RevocationStatusBatchResponse
+
+func (response GetRevocationStatusBatch200JSONResponse) VisitGetRevocationStatusBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusBatch400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatusBatch400JSONResponse) VisitGetRevocationStatusBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusBatch500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatusBatch500JSONResponse) VisitGetRevocationStatusBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusRequestObject struct {
+	Nonce PathNonce `json:"nonce"`
+}
+
+type GetRevocationStatusResponseObject interface {
+	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatus200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHistoricalRevocationStatusRequestObject struct {
+	Nonce  PathNonce `json:"nonce"`
+	Params GetHistoricalRevocationStatusParams
+}
+
+type GetHistoricalRevocationStatusResponseObject interface {
+	VisitGetHistoricalRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetHistoricalRevocationStatus200JSONResponse HistoricalRevocationStatusResponse
+
+func (response GetHistoricalRevocationStatus200JSONResponse) VisitGetHistoricalRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHistoricalRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetHistoricalRevocationStatus400JSONResponse) VisitGetHistoricalRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHistoricalRevocationStatus404JSONResponse struct{ N404JSONResponse }
+
+func (response GetHistoricalRevocationStatus404JSONResponse) VisitGetHistoricalRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHistoricalRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetHistoricalRevocationStatus500JSONResponse) VisitGetHistoricalRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredentialRequestObject struct {
+	Nonce  PathNonce `json:"nonce"`
+	Params RevokeCredentialParams
+}
+
+type RevokeCredentialResponseObject interface {
+	VisitRevokeCredentialResponse(w http.ResponseWriter) error
+}
+
+type RevokeCredential202JSONResponse RevokeCredentialResponse
+
+func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteCredentialResponseObject interface {
+	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredential200JSONResponse GenericMessage
+
+func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCredential404JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetCredentialParams
+}
+
+type GetCredentialResponseObject interface {
+	VisitGetCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetCredential200JSONResponse Credential
+
+func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredential404JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential403JSONResponse struct{ N403JSONResponse }
+
+func (response GetCredential403JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVerificationBundleRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetCredentialVerificationBundleParams
+}
+
+type GetCredentialVerificationBundleResponseObject interface {
+	VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialVerificationBundle200JSONResponse VerificationBundle
+
+func (response GetCredentialVerificationBundle200JSONResponse) VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVerificationBundle400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialVerificationBundle400JSONResponse) VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVerificationBundle403JSONResponse struct{ N403JSONResponse }
+
+func (response GetCredentialVerificationBundle403JSONResponse) VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVerificationBundle404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialVerificationBundle404JSONResponse) VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVerificationBundle500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialVerificationBundle500JSONResponse) VisitGetCredentialVerificationBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVersionsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialVersionsResponseObject interface {
+	VisitGetCredentialVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialVersions200JSONResponse []CredentialVersion
+
+func (response GetCredentialVersions200JSONResponse) VisitGetCredentialVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVersions404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialVersions404JSONResponse) VisitGetCredentialVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialVersions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialVersions500JSONResponse) VisitGetCredentialVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialDependentsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialDependentsResponseObject interface {
+	VisitGetCredentialDependentsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialDependents200JSONResponse []CredentialDependent
+
+func (response GetCredentialDependents200JSONResponse) VisitGetCredentialDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialDependents404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialDependents404JSONResponse) VisitGetCredentialDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialDependents500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialDependents500JSONResponse) VisitGetCredentialDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialImportRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialImportResponseObject interface {
+	VisitGetCredentialImportResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialImport200JSONResponse CredentialImport
+
+func (response GetCredentialImport200JSONResponse) VisitGetCredentialImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialImport404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialImport404JSONResponse) VisitGetCredentialImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialImport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialImport500JSONResponse) VisitGetCredentialImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDFRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetCredentialPDFParams
+}
+
+type GetCredentialPDFResponseObject interface {
+	VisitGetCredentialPDFResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialPDF200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCredentialPDF200ApplicationpdfResponse) VisitGetCredentialPDFResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCredentialPDF400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialPDF400JSONResponse) VisitGetCredentialPDFResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDF403JSONResponse struct{ N403JSONResponse }
+
+func (response GetCredentialPDF403JSONResponse) VisitGetCredentialPDFResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDF404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialPDF404JSONResponse) VisitGetCredentialPDFResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDF500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialPDF500JSONResponse) VisitGetCredentialPDFResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RehydrateCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RehydrateCredentialResponseObject interface {
+	VisitRehydrateCredentialResponse(w http.ResponseWriter) error
+}
+
+type RehydrateCredential200JSONResponse Credential
+
+func (response RehydrateCredential200JSONResponse) VisitRehydrateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RehydrateCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RehydrateCredential404JSONResponse) VisitRehydrateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RehydrateCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response RehydrateCredential409JSONResponse) VisitRehydrateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RehydrateCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RehydrateCredential500JSONResponse) VisitRehydrateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetCredentialQrCodeParams
+}
+
+type GetCredentialQrCodeResponseObject interface {
+	VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialQrCode200JSONResponse QrCodeLinkWithSchemaTypeShortResponse
+
+func (response GetCredentialQrCode200JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialQrCode400JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialQrCode404JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode409JSONResponse struct{ N409JSONResponse }
+
+func (response GetCredentialQrCode409JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialQrCode500JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCodeHTMLRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetCredentialQrCodeHTMLParams
+}
+
+type GetCredentialQrCodeHTMLResponseObject interface {
+	VisitGetCredentialQrCodeHTMLResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialQrCodeHTML200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCredentialQrCodeHTML200TexthtmlResponse) VisitGetCredentialQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCredentialQrCodeHTML400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialQrCodeHTML400JSONResponse) VisitGetCredentialQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCodeHTML404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialQrCodeHTML404JSONResponse) VisitGetCredentialQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCodeHTML500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialQrCodeHTML500JSONResponse) VisitGetCredentialQrCodeHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialsRequestObject struct {
+}
+
+type HolderGetCredentialsResponseObject interface {
+	VisitHolderGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type HolderGetCredentials200JSONResponse []Credential
+
+func (response HolderGetCredentials200JSONResponse) VisitHolderGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderGetCredentials401JSONResponse) VisitHolderGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderGetCredentials500JSONResponse) VisitHolderGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreviewRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type HolderGetCredentialPreviewResponseObject interface {
+	VisitHolderGetCredentialPreviewResponse(w http.ResponseWriter) error
+}
+
+type HolderGetCredentialPreview200JSONResponse CredentialPreview
+
+func (response HolderGetCredentialPreview200JSONResponse) VisitHolderGetCredentialPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreview401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderGetCredentialPreview401JSONResponse) VisitHolderGetCredentialPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreview404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderGetCredentialPreview404JSONResponse) VisitHolderGetCredentialPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreview500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderGetCredentialPreview500JSONResponse) VisitHolderGetCredentialPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreviewHTMLRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type HolderGetCredentialPreviewHTMLResponseObject interface {
+	VisitHolderGetCredentialPreviewHTMLResponse(w http.ResponseWriter) error
+}
+
+type HolderGetCredentialPreviewHTML200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response HolderGetCredentialPreviewHTML200TexthtmlResponse) VisitHolderGetCredentialPreviewHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type HolderGetCredentialPreviewHTML401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderGetCredentialPreviewHTML401JSONResponse) VisitHolderGetCredentialPreviewHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreviewHTML404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderGetCredentialPreviewHTML404JSONResponse) VisitHolderGetCredentialPreviewHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialPreviewHTML500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderGetCredentialPreviewHTML500JSONResponse) VisitHolderGetCredentialPreviewHTMLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialQrCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params HolderGetCredentialQrCodeParams
+}
+
+type HolderGetCredentialQrCodeResponseObject interface {
+	VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error
+}
+
+type HolderGetCredentialQrCode200JSONResponse QrCodeLinkWithSchemaTypeShortResponse
+
+func (response HolderGetCredentialQrCode200JSONResponse) VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialQrCode401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderGetCredentialQrCode401JSONResponse) VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderGetCredentialQrCode404JSONResponse) VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialQrCode409JSONResponse struct{ N409JSONResponse }
+
+func (response HolderGetCredentialQrCode409JSONResponse) VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderGetCredentialQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderGetCredentialQrCode500JSONResponse) VisitHolderGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderReissueCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type HolderReissueCredentialResponseObject interface {
+	VisitHolderReissueCredentialResponse(w http.ResponseWriter) error
+}
+
+type HolderReissueCredential201JSONResponse UUIDResponse
+
+func (response HolderReissueCredential201JSONResponse) VisitHolderReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderReissueCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response HolderReissueCredential400JSONResponse) VisitHolderReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderReissueCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderReissueCredential401JSONResponse) VisitHolderReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderReissueCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderReissueCredential404JSONResponse) VisitHolderReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderReissueCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderReissueCredential500JSONResponse) VisitHolderReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocationRequestObject struct {
+	Id   Id `json:"id"`
+	Body *HolderRequestRevocationJSONRequestBody
+}
+
+type HolderRequestRevocationResponseObject interface {
+	VisitHolderRequestRevocationResponse(w http.ResponseWriter) error
+}
+
+type HolderRequestRevocation201JSONResponse RevocationRequest
+
+func (response HolderRequestRevocation201JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocation400JSONResponse struct{ N400JSONResponse }
+
+func (response HolderRequestRevocation400JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocation401JSONResponse struct{ N401JSONResponse }
+
+func (response HolderRequestRevocation401JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocation404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderRequestRevocation404JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocation409JSONResponse struct{ N409JSONResponse }
+
+func (response HolderRequestRevocation409JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderRequestRevocation500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderRequestRevocation500JSONResponse) VisitHolderRequestRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderCreateSessionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type HolderCreateSessionResponseObject interface {
+	VisitHolderCreateSessionResponse(w http.ResponseWriter) error
+}
+
+type HolderCreateSession201JSONResponse HolderSession
+
+func (response HolderCreateSession201JSONResponse) VisitHolderCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderCreateSession404JSONResponse struct{ N404JSONResponse }
+
+func (response HolderCreateSession404JSONResponse) VisitHolderCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HolderCreateSession500JSONResponse struct{ N500JSONResponse }
+
+func (response HolderCreateSession500JSONResponse) VisitHolderCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfilesRequestObject struct {
+}
+
+type GetIssuerProfilesResponseObject interface {
+	VisitGetIssuerProfilesResponse(w http.ResponseWriter) error
+}
+
+type GetIssuerProfiles200JSONResponse []IssuerProfile
+
+func (response GetIssuerProfiles200JSONResponse) VisitGetIssuerProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfiles401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIssuerProfiles401JSONResponse) VisitGetIssuerProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfiles500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIssuerProfiles500JSONResponse) VisitGetIssuerProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIssuerProfileRequestObject struct {
+	Body *CreateIssuerProfileJSONRequestBody
+}
+
+type CreateIssuerProfileResponseObject interface {
+	VisitCreateIssuerProfileResponse(w http.ResponseWriter) error
+}
+
+type CreateIssuerProfile201JSONResponse IssuerProfile
+
+func (response CreateIssuerProfile201JSONResponse) VisitCreateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIssuerProfile400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIssuerProfile400JSONResponse) VisitCreateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIssuerProfile401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateIssuerProfile401JSONResponse) VisitCreateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIssuerProfile409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateIssuerProfile409JSONResponse) VisitCreateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIssuerProfile500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateIssuerProfile500JSONResponse) VisitCreateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIssuerProfileRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteIssuerProfileResponseObject interface {
+	VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error
+}
+
+type DeleteIssuerProfile200JSONResponse GenericMessage
+
+func (response DeleteIssuerProfile200JSONResponse) VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIssuerProfile400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteIssuerProfile400JSONResponse) VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIssuerProfile401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteIssuerProfile401JSONResponse) VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIssuerProfile404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteIssuerProfile404JSONResponse) VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIssuerProfile500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteIssuerProfile500JSONResponse) VisitDeleteIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfileRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetIssuerProfileResponseObject interface {
+	VisitGetIssuerProfileResponse(w http.ResponseWriter) error
+}
+
+type GetIssuerProfile200JSONResponse IssuerProfile
+
+func (response GetIssuerProfile200JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfile401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIssuerProfile401JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfile404JSONResponse struct{ N404JSONResponse }
+
+func (response GetIssuerProfile404JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfile500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIssuerProfile500JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredentialsRequestObject struct {
+	Params GetOrganizationCredentialsParams
+}
+
+type GetOrganizationCredentialsResponseObject interface {
+	VisitGetOrganizationCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetOrganizationCredentials200JSONResponse []OrganizationCredential
+
+func (response GetOrganizationCredentials200JSONResponse) VisitGetOrganizationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response GetOrganizationCredentials401JSONResponse) VisitGetOrganizationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOrganizationCredentials500JSONResponse) VisitGetOrganizationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveOrganizationCredentialRequestObject struct {
+	Body *ReceiveOrganizationCredentialJSONRequestBody
+}
+
+type ReceiveOrganizationCredentialResponseObject interface {
+	VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error
+}
+
+type ReceiveOrganizationCredential201JSONResponse OrganizationCredential
+
+func (response ReceiveOrganizationCredential201JSONResponse) VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveOrganizationCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response ReceiveOrganizationCredential400JSONResponse) VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveOrganizationCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response ReceiveOrganizationCredential401JSONResponse) VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveOrganizationCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response ReceiveOrganizationCredential409JSONResponse) VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveOrganizationCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response ReceiveOrganizationCredential500JSONResponse) VisitReceiveOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteOrganizationCredentialResponseObject interface {
+	VisitDeleteOrganizationCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganizationCredential200JSONResponse GenericMessage
+
+func (response DeleteOrganizationCredential200JSONResponse) VisitDeleteOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteOrganizationCredential401JSONResponse) VisitDeleteOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteOrganizationCredential404JSONResponse) VisitDeleteOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteOrganizationCredential500JSONResponse) VisitDeleteOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetOrganizationCredentialResponseObject interface {
+	VisitGetOrganizationCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetOrganizationCredential200JSONResponse OrganizationCredential
+
+func (response GetOrganizationCredential200JSONResponse) VisitGetOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response GetOrganizationCredential401JSONResponse) VisitGetOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetOrganizationCredential404JSONResponse) VisitGetOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOrganizationCredential500JSONResponse) VisitGetOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationCredentialRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateOrganizationCredentialJSONRequestBody
+}
+
+type UpdateOrganizationCredentialResponseObject interface {
+	VisitUpdateOrganizationCredentialResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationCredential200JSONResponse OrganizationCredential
+
+func (response UpdateOrganizationCredential200JSONResponse) VisitUpdateOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateOrganizationCredential401JSONResponse) VisitUpdateOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateOrganizationCredential404JSONResponse) VisitUpdateOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateOrganizationCredential500JSONResponse) VisitUpdateOrganizationCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPresentationRequestObject struct {
+	Params GetOrganizationPresentationParams
+}
+
+type GetOrganizationPresentationResponseObject interface {
+	VisitGetOrganizationPresentationResponse(w http.ResponseWriter) error
+}
+
+type GetOrganizationPresentation200JSONResponse OrganizationPresentation
+
+func (response GetOrganizationPresentation200JSONResponse) VisitGetOrganizationPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPresentation500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOrganizationPresentation500JSONResponse) VisitGetOrganizationPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplatesRequestObject struct {
+}
+
+type GetPresentationTemplatesResponseObject interface {
+	VisitGetPresentationTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetPresentationTemplates200JSONResponse []PresentationTemplate
+
+func (response GetPresentationTemplates200JSONResponse) VisitGetPresentationTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplates401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPresentationTemplates401JSONResponse) VisitGetPresentationTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplates500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPresentationTemplates500JSONResponse) VisitGetPresentationTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePresentationTemplateRequestObject struct {
+	Body *CreatePresentationTemplateJSONRequestBody
+}
+
+type CreatePresentationTemplateResponseObject interface {
+	VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreatePresentationTemplate201JSONResponse PresentationTemplate
+
+func (response CreatePresentationTemplate201JSONResponse) VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePresentationTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePresentationTemplate400JSONResponse) VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePresentationTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePresentationTemplate401JSONResponse) VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePresentationTemplate409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePresentationTemplate409JSONResponse) VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePresentationTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePresentationTemplate500JSONResponse) VisitCreatePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePresentationTemplateRequestObject struct {
+	Name PresentationTemplateName `json:"name"`
+}
+
+type DeletePresentationTemplateResponseObject interface {
+	VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeletePresentationTemplate200JSONResponse GenericMessage
+
+func (response DeletePresentationTemplate200JSONResponse) VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePresentationTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePresentationTemplate400JSONResponse) VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePresentationTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response DeletePresentationTemplate401JSONResponse) VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePresentationTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response DeletePresentationTemplate404JSONResponse) VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePresentationTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePresentationTemplate500JSONResponse) VisitDeletePresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplateRequestObject struct {
+	Name PresentationTemplateName `json:"name"`
+}
+
+type GetPresentationTemplateResponseObject interface {
+	VisitGetPresentationTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetPresentationTemplate200JSONResponse PresentationTemplate
+
+func (response GetPresentationTemplate200JSONResponse) VisitGetPresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPresentationTemplate401JSONResponse) VisitGetPresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPresentationTemplate404JSONResponse) VisitGetPresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPresentationTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPresentationTemplate500JSONResponse) VisitGetPresentationTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQRBrandingRequestObject struct {
+}
+
+type GetQRBrandingResponseObject interface {
+	VisitGetQRBrandingResponse(w http.ResponseWriter) error
+}
+
+type GetQRBranding200JSONResponse QRBranding
+
+func (response GetQRBranding200JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQRBranding400JSONResponse struct{ N400JSONResponse }
+
+func (response GetQRBranding400JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQRBranding401JSONResponse struct{ N401JSONResponse }
+
+func (response GetQRBranding401JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQRBranding500JSONResponse struct{ N500JSONResponse }
+
+func (response GetQRBranding500JSONResponse) VisitGetQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQRBrandingRequestObject struct {
+	Body *UpdateQRBrandingJSONRequestBody
+}
+
+type UpdateQRBrandingResponseObject interface {
+	VisitUpdateQRBrandingResponse(w http.ResponseWriter) error
+}
+
+type UpdateQRBranding200JSONResponse QRBranding
+
+func (response UpdateQRBranding200JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQRBranding400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateQRBranding400JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQRBranding401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateQRBranding401JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQRBranding500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateQRBranding500JSONResponse) VisitUpdateQRBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStoreRequestObject struct {
+	Params GetQrFromStoreParams
+}
+
+type GetQrFromStoreResponseObject interface {
+	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
+}
+
+type GetQrFromStore200JSONResponse map[string]interface{}
+
+func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
+
+func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
+
+func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
+
+func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrImageFromStoreRequestObject struct {
+	Params GetQrImageFromStoreParams
+}
+
+type GetQrImageFromStoreResponseObject interface {
+	VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error
+}
+
+type GetQrImageFromStore200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetQrImageFromStore200ImagepngResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQrImageFromStore400JSONResponse struct{ N400JSONResponse }
+
+func (response GetQrImageFromStore400JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrImageFromStore404JSONResponse struct{ N404JSONResponse }
+
+func (response GetQrImageFromStore404JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrImageFromStore500JSONResponse struct{ N500JSONResponse }
+
+func (response GetQrImageFromStore500JSONResponse) VisitGetQrImageFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationNonceRangesRequestObject struct {
+}
+
+type GetRevocationNonceRangesResponseObject interface {
+	VisitGetRevocationNonceRangesResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationNonceRanges200JSONResponse []RevocationNonceRange
+
+func (response GetRevocationNonceRanges200JSONResponse) VisitGetRevocationNonceRangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationNonceRanges401JSONResponse struct{ N401JSONResponse }
+
+func (response GetRevocationNonceRanges401JSONResponse) VisitGetRevocationNonceRangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationNonceRanges500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationNonceRanges500JSONResponse) VisitGetRevocationNonceRangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReserveRevocationNonceRangeRequestObject struct {
+	Body *ReserveRevocationNonceRangeJSONRequestBody
+}
+
+type ReserveRevocationNonceRangeResponseObject interface {
+	VisitReserveRevocationNonceRangeResponse(w http.ResponseWriter) error
+}
+
+type ReserveRevocationNonceRange201JSONResponse RevocationNonceRange
+
+func (response ReserveRevocationNonceRange201JSONResponse) VisitReserveRevocationNonceRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReserveRevocationNonceRange400JSONResponse struct{ N400JSONResponse }
+
+func (response ReserveRevocationNonceRange400JSONResponse) VisitReserveRevocationNonceRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReserveRevocationNonceRange401JSONResponse struct{ N401JSONResponse }
+
+func (response ReserveRevocationNonceRange401JSONResponse) VisitReserveRevocationNonceRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReserveRevocationNonceRange500JSONResponse struct{ N500JSONResponse }
+
+func (response ReserveRevocationNonceRange500JSONResponse) VisitReserveRevocationNonceRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRequestsRequestObject struct {
+	Params GetRevocationRequestsParams
+}
+
+type GetRevocationRequestsResponseObject interface {
+	VisitGetRevocationRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationRequests200JSONResponse []RevocationRequest
+
+func (response GetRevocationRequests200JSONResponse) VisitGetRevocationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRequests400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationRequests400JSONResponse) VisitGetRevocationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRequests401JSONResponse struct{ N401JSONResponse }
+
+func (response GetRevocationRequests401JSONResponse) VisitGetRevocationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRequests500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationRequests500JSONResponse) VisitGetRevocationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveRevocationRequestRequestObject struct {
+	Id   Id `json:"id"`
+	Body *ApproveRevocationRequestJSONRequestBody
+}
+
+type ApproveRevocationRequestResponseObject interface {
+	VisitApproveRevocationRequestResponse(w http.ResponseWriter) error
+}
+
+type ApproveRevocationRequest200JSONResponse RevocationRequest
+
+func (response ApproveRevocationRequest200JSONResponse) VisitApproveRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveRevocationRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response ApproveRevocationRequest401JSONResponse) VisitApproveRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveRevocationRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response ApproveRevocationRequest404JSONResponse) VisitApproveRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveRevocationRequest409JSONResponse struct{ N409JSONResponse }
+
+func (response ApproveRevocationRequest409JSONResponse) VisitApproveRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveRevocationRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response ApproveRevocationRequest500JSONResponse) VisitApproveRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectRevocationRequestRequestObject struct {
+	Id   Id `json:"id"`
+	Body *RejectRevocationRequestJSONRequestBody
+}
+
+type RejectRevocationRequestResponseObject interface {
+	VisitRejectRevocationRequestResponse(w http.ResponseWriter) error
+}
+
+type RejectRevocationRequest200JSONResponse RevocationRequest
+
+func (response RejectRevocationRequest200JSONResponse) VisitRejectRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectRevocationRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response RejectRevocationRequest401JSONResponse) VisitRejectRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectRevocationRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response RejectRevocationRequest404JSONResponse) VisitRejectRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectRevocationRequest409JSONResponse struct{ N409JSONResponse }
+
+func (response RejectRevocationRequest409JSONResponse) VisitRejectRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectRevocationRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response RejectRevocationRequest500JSONResponse) VisitRejectRevocationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRulesRequestObject struct {
+}
+
+type GetRevocationRulesResponseObject interface {
+	VisitGetRevocationRulesResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationRules200JSONResponse []RevocationRule
+
+func (response GetRevocationRules200JSONResponse) VisitGetRevocationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRules401JSONResponse struct{ N401JSONResponse }
+
+func (response GetRevocationRules401JSONResponse) VisitGetRevocationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRules500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationRules500JSONResponse) VisitGetRevocationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRevocationRuleRequestObject struct {
+	Body *CreateRevocationRuleJSONRequestBody
+}
+
+type CreateRevocationRuleResponseObject interface {
+	VisitCreateRevocationRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateRevocationRule201JSONResponse RevocationRule
+
+func (response CreateRevocationRule201JSONResponse) VisitCreateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRevocationRule400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateRevocationRule400JSONResponse) VisitCreateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRevocationRule401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateRevocationRule401JSONResponse) VisitCreateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRevocationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateRevocationRule500JSONResponse) VisitCreateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRevocationRuleRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteRevocationRuleResponseObject interface {
+	VisitDeleteRevocationRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteRevocationRule200JSONResponse GenericMessage
+
+func (response DeleteRevocationRule200JSONResponse) VisitDeleteRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRevocationRule401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteRevocationRule401JSONResponse) VisitDeleteRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRevocationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteRevocationRule404JSONResponse) VisitDeleteRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRevocationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteRevocationRule500JSONResponse) VisitDeleteRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRuleRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetRevocationRuleResponseObject interface {
+	VisitGetRevocationRuleResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationRule200JSONResponse RevocationRule
+
+func (response GetRevocationRule200JSONResponse) VisitGetRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRule401JSONResponse struct{ N401JSONResponse }
+
+func (response GetRevocationRule401JSONResponse) VisitGetRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response GetRevocationRule404JSONResponse) VisitGetRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationRule500JSONResponse) VisitGetRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRevocationRuleRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateRevocationRuleJSONRequestBody
+}
+
+type UpdateRevocationRuleResponseObject interface {
+	VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRevocationRule200JSONResponse RevocationRule
+
+func (response UpdateRevocationRule200JSONResponse) VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRevocationRule400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateRevocationRule400JSONResponse) VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRevocationRule401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateRevocationRule401JSONResponse) VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRevocationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateRevocationRule404JSONResponse) VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRevocationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateRevocationRule500JSONResponse) VisitUpdateRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRevocationRuleRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type PreviewRevocationRuleResponseObject interface {
+	VisitPreviewRevocationRuleResponse(w http.ResponseWriter) error
+}
+
+type PreviewRevocationRule200JSONResponse RevocationRulePreview
+
+func (response PreviewRevocationRule200JSONResponse) VisitPreviewRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRevocationRule401JSONResponse struct{ N401JSONResponse }
+
+func (response PreviewRevocationRule401JSONResponse) VisitPreviewRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRevocationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response PreviewRevocationRule404JSONResponse) VisitPreviewRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRevocationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response PreviewRevocationRule500JSONResponse) VisitPreviewRevocationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemasRequestObject struct {
+	Params GetSchemasParams
+}
+
+type GetSchemasResponseObject interface {
+	VisitGetSchemasResponse(w http.ResponseWriter) error
+}
+
+type GetSchemas200JSONResponse []Schema
+
+func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchemaRequestObject struct {
+	Body *ImportSchemaJSONRequestBody
+}
+
+type ImportSchemaResponseObject interface {
+	VisitImportSchemaResponse(w http.ResponseWriter) error
+}
+
+type ImportSchema201JSONResponse UUIDResponse
+
+func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetSchemaResponseObject interface {
+	VisitGetSchemaResponse(w http.ResponseWriter) error
+}
+
+type GetSchema200JSONResponse Schema
+
+func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateSchemaJSONRequestBody
+}
+
+type UpdateSchemaResponseObject interface {
+	VisitUpdateSchemaResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchema200JSONResponse Schema
+
+func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialPDFTemplateRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteCredentialPDFTemplateResponseObject interface {
+	VisitDeleteCredentialPDFTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredentialPDFTemplate200JSONResponse GenericMessage
+
+func (response DeleteCredentialPDFTemplate200JSONResponse) VisitDeleteCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialPDFTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredentialPDFTemplate400JSONResponse) VisitDeleteCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialPDFTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCredentialPDFTemplate404JSONResponse) VisitDeleteCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialPDFTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredentialPDFTemplate500JSONResponse) VisitDeleteCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDFTemplateRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialPDFTemplateResponseObject interface {
+	VisitGetCredentialPDFTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialPDFTemplate200JSONResponse CredentialPDFTemplate
+
+func (response GetCredentialPDFTemplate200JSONResponse) VisitGetCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDFTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialPDFTemplate400JSONResponse) VisitGetCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDFTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialPDFTemplate404JSONResponse) VisitGetCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialPDFTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialPDFTemplate500JSONResponse) VisitGetCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialPDFTemplateRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateCredentialPDFTemplateJSONRequestBody
+}
+
+type UpdateCredentialPDFTemplateResponseObject interface {
+	VisitUpdateCredentialPDFTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateCredentialPDFTemplate200JSONResponse CredentialPDFTemplate
+
+func (response UpdateCredentialPDFTemplate200JSONResponse) VisitUpdateCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialPDFTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCredentialPDFTemplate400JSONResponse) VisitUpdateCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialPDFTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCredentialPDFTemplate404JSONResponse) VisitUpdateCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialPDFTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCredentialPDFTemplate500JSONResponse) VisitUpdateCredentialPDFTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaSelfRevocationRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateSchemaSelfRevocationJSONRequestBody
+}
+
+type UpdateSchemaSelfRevocationResponseObject interface {
+	VisitUpdateSchemaSelfRevocationResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchemaSelfRevocation200JSONResponse Schema
+
+func (response UpdateSchemaSelfRevocation200JSONResponse) VisitUpdateSchemaSelfRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaSelfRevocation400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchemaSelfRevocation400JSONResponse) VisitUpdateSchemaSelfRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaSelfRevocation404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSchemaSelfRevocation404JSONResponse) VisitUpdateSchemaSelfRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaSelfRevocation500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchemaSelfRevocation500JSONResponse) VisitUpdateSchemaSelfRevocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaUniquenessRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateSchemaUniquenessJSONRequestBody
+}
+
+type UpdateSchemaUniquenessResponseObject interface {
+	VisitUpdateSchemaUniquenessResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchemaUniqueness200JSONResponse Schema
+
+func (response UpdateSchemaUniqueness200JSONResponse) VisitUpdateSchemaUniquenessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaUniqueness400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchemaUniqueness400JSONResponse) VisitUpdateSchemaUniquenessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaUniqueness404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSchemaUniqueness404JSONResponse) VisitUpdateSchemaUniquenessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaUniqueness500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchemaUniqueness500JSONResponse) VisitUpdateSchemaUniquenessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaWebhookTemplateRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateSchemaWebhookTemplateJSONRequestBody
+}
+
+type UpdateSchemaWebhookTemplateResponseObject interface {
+	VisitUpdateSchemaWebhookTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchemaWebhookTemplate200JSONResponse Schema
+
+func (response UpdateSchemaWebhookTemplate200JSONResponse) VisitUpdateSchemaWebhookTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaWebhookTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchemaWebhookTemplate400JSONResponse) VisitUpdateSchemaWebhookTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaWebhookTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSchemaWebhookTemplate404JSONResponse) VisitUpdateSchemaWebhookTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaWebhookTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchemaWebhookTemplate500JSONResponse) VisitUpdateSchemaWebhookTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEventsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetSessionEventsResponseObject interface {
+	VisitGetSessionEventsResponse(w http.ResponseWriter) error
+}
+
+type GetSessionEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSessionEvents200TexteventStreamResponse) VisitGetSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSessionEvents400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSessionEvents400JSONResponse) VisitGetSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEvents404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSessionEvents404JSONResponse) VisitGetSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEvents500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSessionEvents500JSONResponse) VisitGetSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatePendingRequestObject struct {
+}
+
+type GetStatePendingResponseObject interface {
+	VisitGetStatePendingResponse(w http.ResponseWriter) error
+}
+
+type GetStatePending200JSONResponse PendingStateResponse
+
+func (response GetStatePending200JSONResponse) VisitGetStatePendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatePending401JSONResponse struct{ N401JSONResponse }
+
+func (response GetStatePending401JSONResponse) VisitGetStatePendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatePending500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStatePending500JSONResponse) VisitGetStatePendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishStateRequestObject struct {
+}
+
+type PublishStateResponseObject interface {
+	VisitPublishStateResponse(w http.ResponseWriter) error
+}
+
+type PublishState202JSONResponse PublishIdentityStateResponse
+
+func (response PublishState202JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishState400JSONResponse struct{ N400JSONResponse }
+
+func (response PublishState400JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishState500JSONResponse struct{ N500JSONResponse }
+
+func (response PublishState500JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishStateRequestObject struct {
+}
+
+type RetryPublishStateResponseObject interface {
+	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+}
+
+type RetryPublishState202JSONResponse PublishIdentityStateResponse
+
+func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+
+func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+
+func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateStatusRequestObject struct {
+}
+
+type GetStateStatusResponseObject interface {
+	VisitGetStateStatusResponse(w http.ResponseWriter) error
+}
+
+type GetStateStatus200JSONResponse StateStatusResponse
+
+func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateCheckpointsRequestObject struct {
+}
+
+type GetStateCheckpointsResponseObject interface {
+	VisitGetStateCheckpointsResponse(w http.ResponseWriter) error
+}
+
+type GetStateCheckpoints200JSONResponse []StateCheckpoint
+
+func (response GetStateCheckpoints200JSONResponse) VisitGetStateCheckpointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateCheckpoints500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateCheckpoints500JSONResponse) VisitGetStateCheckpointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactionsRequestObject struct {
+}
+
+type GetStateTransactionsResponseObject interface {
+	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetStateTransactions200JSONResponse StateTransactionsResponse
+
+func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats401JSONResponse struct{ N401JSONResponse }
+
+func (response GetStats401JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStats500JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslationsRequestObject struct {
+}
+
+type GetTranslationsResponseObject interface {
+	VisitGetTranslationsResponse(w http.ResponseWriter) error
+}
+
+type GetTranslations200JSONResponse []Translation
+
+func (response GetTranslations200JSONResponse) VisitGetTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslations401JSONResponse struct{ N401JSONResponse }
+
+func (response GetTranslations401JSONResponse) VisitGetTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslations500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTranslations500JSONResponse) VisitGetTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTranslationRequestObject struct {
+	Locale PathLocale `json:"locale"`
+}
+
+type DeleteTranslationResponseObject interface {
+	VisitDeleteTranslationResponse(w http.ResponseWriter) error
+}
+
+type DeleteTranslation200JSONResponse GenericMessage
+
+func (response DeleteTranslation200JSONResponse) VisitDeleteTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTranslation400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteTranslation400JSONResponse) VisitDeleteTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTranslation401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteTranslation401JSONResponse) VisitDeleteTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTranslation404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteTranslation404JSONResponse) VisitDeleteTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTranslation500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteTranslation500JSONResponse) VisitDeleteTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslationRequestObject struct {
+	Locale PathLocale `json:"locale"`
+}
+
+type GetTranslationResponseObject interface {
+	VisitGetTranslationResponse(w http.ResponseWriter) error
+}
+
+type GetTranslation200JSONResponse Translation
+
+func (response GetTranslation200JSONResponse) VisitGetTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslation400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTranslation400JSONResponse) VisitGetTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslation401JSONResponse struct{ N401JSONResponse }
+
+func (response GetTranslation401JSONResponse) VisitGetTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslation404JSONResponse struct{ N404JSONResponse }
+
+func (response GetTranslation404JSONResponse) VisitGetTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranslation500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTranslation500JSONResponse) VisitGetTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveTranslationRequestObject struct {
+	Locale PathLocale `json:"locale"`
+	Body   *SaveTranslationJSONRequestBody
+}
+
+type SaveTranslationResponseObject interface {
+	VisitSaveTranslationResponse(w http.ResponseWriter) error
+}
+
+type SaveTranslation200JSONResponse Translation
+
+func (response SaveTranslation200JSONResponse) VisitSaveTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveTranslation400JSONResponse struct{ N400JSONResponse }
+
+func (response SaveTranslation400JSONResponse) VisitSaveTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveTranslation401JSONResponse struct{ N401JSONResponse }
+
+func (response SaveTranslation401JSONResponse) VisitSaveTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveTranslation500JSONResponse struct{ N500JSONResponse }
+
+func (response SaveTranslation500JSONResponse) VisitSaveTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWalletCompatibilityReportRequestObject struct {
+}
+
+type GetWalletCompatibilityReportResponseObject interface {
+	VisitGetWalletCompatibilityReportResponse(w http.ResponseWriter) error
+}
+
+type GetWalletCompatibilityReport200JSONResponse []WalletUsage
+
+func (response GetWalletCompatibilityReport200JSONResponse) VisitGetWalletCompatibilityReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWalletCompatibilityReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWalletCompatibilityReport500JSONResponse) VisitGetWalletCompatibilityReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the documentation
+	// (GET /)
+	GetDocumentation(ctx context.Context, request GetDocumentationRequestObject) (GetDocumentationResponseObject, error)
+	// Get Config
+	// (GET /config)
+	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
+	// Gets the favicon
+	// (GET /favicon.ico)
+	GetFavicon(ctx context.Context, request GetFaviconRequestObject) (GetFaviconResponseObject, error)
+	// Get the documentation yaml file
+	// (GET /static/docs/api_ui/api.yaml)
+	GetYaml(ctx context.Context, request GetYamlRequestObject) (GetYamlResponseObject, error)
+	// Healthcheck
+	// (GET /status)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	// Get key migrations
+	// (GET /v1/admin/key-migrations)
+	GetKeyMigrations(ctx context.Context, request GetKeyMigrationsRequestObject) (GetKeyMigrationsResponseObject, error)
+	// Start key migration
+	// (POST /v1/admin/key-migrations)
+	StartKeyMigration(ctx context.Context, request StartKeyMigrationRequestObject) (StartKeyMigrationResponseObject, error)
+	// Get key migration
+	// (GET /v1/admin/key-migrations/{id})
+	GetKeyMigration(ctx context.Context, request GetKeyMigrationRequestObject) (GetKeyMigrationResponseObject, error)
+	// Resume key migration
+	// (POST /v1/admin/key-migrations/{id}/resume)
+	ResumeKeyMigration(ctx context.Context, request ResumeKeyMigrationRequestObject) (ResumeKeyMigrationResponseObject, error)
+	// Get database migrations
+	// (GET /v1/admin/migrations)
+	GetMigrations(ctx context.Context, request GetMigrationsRequestObject) (GetMigrationsResponseObject, error)
+	// Run database migrations
+	// (POST /v1/admin/migrations)
+	RunMigrations(ctx context.Context, request RunMigrationsRequestObject) (RunMigrationsResponseObject, error)
+	// Agent
+	// (POST /v1/agent)
+	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
+	// Get API keys
+	// (GET /v1/api-keys)
+	GetAPIKeys(ctx context.Context, request GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error)
+	// Create API key
+	// (POST /v1/api-keys)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Create read-only API key
+	// (POST /v1/api-keys/read-only)
+	CreateReadOnlyAPIKey(ctx context.Context, request CreateReadOnlyAPIKeyRequestObject) (CreateReadOnlyAPIKeyResponseObject, error)
+	// Revoke API key
+	// (DELETE /v1/api-keys/{id})
+	RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error)
+	// Rotate API key
+	// (POST /v1/api-keys/{id}/rotate)
+	RotateAPIKey(ctx context.Context, request RotateAPIKeyRequestObject) (RotateAPIKeyResponseObject, error)
+	// Authentication Callback
+	// (POST /v1/authentication/callback)
+	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
+	// Get Connection QRCode
+	// (GET /v1/authentication/qrcode)
+	AuthQRCode(ctx context.Context, request AuthQRCodeRequestObject) (AuthQRCodeResponseObject, error)
+	// Get Authentication Connection
+	// (GET /v1/authentication/sessions/{id})
+	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
+	// Get campaigns
+	// (GET /v1/campaigns)
+	GetCampaigns(ctx context.Context, request GetCampaignsRequestObject) (GetCampaignsResponseObject, error)
+	// Create campaign
+	// (POST /v1/campaigns)
+	CreateCampaign(ctx context.Context, request CreateCampaignRequestObject) (CreateCampaignResponseObject, error)
+	// Delete campaign
+	// (DELETE /v1/campaigns/{id})
+	DeleteCampaign(ctx context.Context, request DeleteCampaignRequestObject) (DeleteCampaignResponseObject, error)
+	// Get campaign
+	// (GET /v1/campaigns/{id})
+	GetCampaign(ctx context.Context, request GetCampaignRequestObject) (GetCampaignResponseObject, error)
+	// Update campaign
+	// (PATCH /v1/campaigns/{id})
+	UpdateCampaign(ctx context.Context, request UpdateCampaignRequestObject) (UpdateCampaignResponseObject, error)
+	// Add credentials to campaign
+	// (POST /v1/campaigns/{id}/credentials)
+	AddCampaignCredentials(ctx context.Context, request AddCampaignCredentialsRequestObject) (AddCampaignCredentialsResponseObject, error)
+	// Add links to campaign
+	// (POST /v1/campaigns/{id}/links)
+	AddCampaignLinks(ctx context.Context, request AddCampaignLinksRequestObject) (AddCampaignLinksResponseObject, error)
+	// Remove link from campaign
+	// (DELETE /v1/campaigns/{id}/links/{linkID})
+	DeleteCampaignLink(ctx context.Context, request DeleteCampaignLinkRequestObject) (DeleteCampaignLinkResponseObject, error)
+	// Get Public Catalog
+	// (GET /v1/catalog)
+	GetPublicCatalog(ctx context.Context, request GetPublicCatalogRequestObject) (GetPublicCatalogResponseObject, error)
+	// Get Connections
+	// (GET /v1/connections)
+	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
+	// Delete Connection
+	// (DELETE /v1/connections/{id})
+	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
+	// Get Connection
+	// (GET /v1/connections/{id})
+	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
+	// Update Connection Profile
+	// (PATCH /v1/connections/{id})
+	UpdateConnection(ctx context.Context, request UpdateConnectionRequestObject) (UpdateConnectionResponseObject, error)
+	// Delete Connection Credentials
+	// (DELETE /v1/connections/{id}/credentials)
+	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
+	// Revoke Connection Credentials
+	// (POST /v1/connections/{id}/credentials/revoke)
+	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
+	// Create Connection Rebinding Code
+	// (POST /v1/connections/{id}/rebinding-code)
+	CreateConnectionRebindingCode(ctx context.Context, request CreateConnectionRebindingCodeRequestObject) (CreateConnectionRebindingCodeResponseObject, error)
+	// Redeem Connection Rebinding Code
+	// (POST /v1/connections/rebinding)
+	RedeemConnectionRebindingCode(ctx context.Context, request RedeemConnectionRebindingCodeRequestObject) (RedeemConnectionRebindingCodeResponseObject, error)
+	// Get Connection Re-Authentication QRCode
+	// (GET /v1/connections/{id}/reauthentication/qrcode)
+	GetConnectionReAuthQRCode(ctx context.Context, request GetConnectionReAuthQRCodeRequestObject) (GetConnectionReAuthQRCodeResponseObject, error)
+	// Get Duplicate Connections
+	// (GET /v1/connections/duplicates)
+	GetConnectionDuplicates(ctx context.Context, request GetConnectionDuplicatesRequestObject) (GetConnectionDuplicatesResponseObject, error)
+	// Get Dead Connections
+	// (GET /v1/connections/dead)
+	GetDeadConnections(ctx context.Context, request GetDeadConnectionsRequestObject) (GetDeadConnectionsResponseObject, error)
+	// Prune Dead Connections
+	// (POST /v1/connections/prune)
+	PruneConnections(ctx context.Context, request PruneConnectionsRequestObject) (PruneConnectionsResponseObject, error)
+	// Merge Connection
+	// (POST /v1/connections/{id}/merge)
+	MergeConnection(ctx context.Context, request MergeConnectionRequestObject) (MergeConnectionResponseObject, error)
+	// Get Connection Merges
+	// (GET /v1/connections/{id}/merges)
+	GetConnectionMerges(ctx context.Context, request GetConnectionMergesRequestObject) (GetConnectionMergesResponseObject, error)
+	// Get Connection Wallet
+	// (GET /v1/connections/{id}/wallet)
+	GetConnectionWallet(ctx context.Context, request GetConnectionWalletRequestObject) (GetConnectionWalletResponseObject, error)
+	// Get Credentials
+	// (GET /v1/credentials)
+	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
+	// Create Credential
+	// (POST /v1/credentials)
+	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
+	// Archive Credentials
+	// (POST /v1/credentials/archive)
+	ArchiveCredentials(ctx context.Context, request ArchiveCredentialsRequestObject) (ArchiveCredentialsResponseObject, error)
+	// Import Credential
+	// (POST /v1/credentials/import)
+	ImportCredential(ctx context.Context, request ImportCredentialRequestObject) (ImportCredentialResponseObject, error)
+	// Inspect credential layout
+	// (POST /v1/credentials/layout)
+	InspectCredentialLayout(ctx context.Context, request InspectCredentialLayoutRequestObject) (InspectCredentialLayoutResponseObject, error)
+	// Get Links
+	// (GET /v1/credentials/links)
+	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
+	// Create Link
+	// (POST /v1/credentials/links)
+	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
+	// Create Link QR Code Callback
+	// (POST /v1/credentials/links/callback)
+	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
+	// Delete Link
+	// (DELETE /v1/credentials/links/{id})
+	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
+	// Get Link
+	// (GET /v1/credentials/links/{id})
+	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
+	// Activate | Deactivate Link
+	// (PATCH /v1/credentials/links/{id})
+	AcivateLink(ctx context.Context, request AcivateLinkRequestObject) (AcivateLinkResponseObject, error)
+	// Create Link Batch
+	// (POST /v1/credentials/links/{id}/batch)
+	CreateLinkBatch(ctx context.Context, request CreateLinkBatchRequestObject) (CreateLinkBatchResponseObject, error)
+	// Clone Link
+	// (POST /v1/credentials/links/{id}/clone)
+	CloneLink(ctx context.Context, request CloneLinkRequestObject) (CloneLinkResponseObject, error)
+	// Get Link Prerequisite Proofs
+	// (GET /v1/credentials/links/{id}/prerequisite-proofs)
+	GetLinkPrerequisiteProofs(ctx context.Context, request GetLinkPrerequisiteProofsRequestObject) (GetLinkPrerequisiteProofsResponseObject, error)
+	// Get Credential Link QRCode
+	// (GET /v1/credentials/links/{id}/qrcode)
+	GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequestObject) (GetLinkQRCodeResponseObject, error)
+	// Create Authentication Link QRCode
+	// (POST /v1/credentials/links/{id}/qrcode)
+	CreateLinkQrCode(ctx context.Context, request CreateLinkQrCodeRequestObject) (CreateLinkQrCodeResponseObject, error)
+	// Create Link QR code HTML snippet
+	// (POST /v1/credentials/links/{id}/qrcode/html)
+	CreateLinkQrCodeHTML(ctx context.Context, request CreateLinkQrCodeHTMLRequestObject) (CreateLinkQrCodeHTMLResponseObject, error)
+	// Get Link Recipients
+	// (GET /v1/credentials/links/{id}/recipients)
+	GetLinkRecipients(ctx context.Context, request GetLinkRecipientsRequestObject) (GetLinkRecipientsResponseObject, error)
+	// Import Link Recipients
+	// (POST /v1/credentials/links/{id}/recipients)
+	ImportLinkRecipients(ctx context.Context, request ImportLinkRecipientsRequestObject) (ImportLinkRecipientsResponseObject, error)
+	// Get Revocation Statuses
+	// (POST /v1/credentials/revocation/status/batch)
+	GetRevocationStatusBatch(ctx context.Context, request GetRevocationStatusBatchRequestObject) (GetRevocationStatusBatchResponseObject, error)
+	// Get Revocation Status
+	// (GET /v1/credentials/revocation/status/{nonce})
+	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
+	// Get Historical Revocation Status
+	// (GET /v1/credentials/revocation/status/{nonce}/historical)
+	GetHistoricalRevocationStatus(ctx context.Context, request GetHistoricalRevocationStatusRequestObject) (GetHistoricalRevocationStatusResponseObject, error)
+	// Revoke Credential
+	// (POST /v1/credentials/revoke/{nonce})
+	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
+	// Delete Credential
+	// (DELETE /v1/credentials/{id})
+	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
+	// Get Credential
+	// (GET /v1/credentials/{id})
+	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
+	// Export Credential Verification Bundle
+	// (GET /v1/credentials/{id}/bundle)
+	GetCredentialVerificationBundle(ctx context.Context, request GetCredentialVerificationBundleRequestObject) (GetCredentialVerificationBundleResponseObject, error)
+	// Get Credential Versions
+	// (GET /v1/credentials/{id}/versions)
+	GetCredentialVersions(ctx context.Context, request GetCredentialVersionsRequestObject) (GetCredentialVersionsResponseObject, error)
+	// Get Credential Dependents
+	// (GET /v1/credentials/{id}/dependents)
+	GetCredentialDependents(ctx context.Context, request GetCredentialDependentsRequestObject) (GetCredentialDependentsResponseObject, error)
+	// Get Credential Import
+	// (GET /v1/credentials/{id}/import)
+	GetCredentialImport(ctx context.Context, request GetCredentialImportRequestObject) (GetCredentialImportResponseObject, error)
+	// Get Credential PDF
+	// (GET /v1/credentials/{id}/pdf)
+	GetCredentialPDF(ctx context.Context, request GetCredentialPDFRequestObject) (GetCredentialPDFResponseObject, error)
+	// Rehydrate Credential
+	// (POST /v1/credentials/{id}/rehydrate)
+	RehydrateCredential(ctx context.Context, request RehydrateCredentialRequestObject) (RehydrateCredentialResponseObject, error)
+	// Get Credential QR code
+	// (GET /v1/credentials/{id}/qrcode)
+	GetCredentialQrCode(ctx context.Context, request GetCredentialQrCodeRequestObject) (GetCredentialQrCodeResponseObject, error)
+	// Get Credential QR code HTML snippet
+	// (GET /v1/credentials/{id}/qrcode/html)
+	GetCredentialQrCodeHTML(ctx context.Context, request GetCredentialQrCodeHTMLRequestObject) (GetCredentialQrCodeHTMLResponseObject, error)
+	// Get Holder Credentials
+	// (GET /v1/holder/credentials)
+	HolderGetCredentials(ctx context.Context, request HolderGetCredentialsRequestObject) (HolderGetCredentialsResponseObject, error)
+	// Get Holder Credential Preview
+	// (GET /v1/holder/credentials/{id}/preview)
+	HolderGetCredentialPreview(ctx context.Context, request HolderGetCredentialPreviewRequestObject) (HolderGetCredentialPreviewResponseObject, error)
+	// Get Holder Credential Preview HTML page
+	// (GET /v1/holder/credentials/{id}/preview/html)
+	HolderGetCredentialPreviewHTML(ctx context.Context, request HolderGetCredentialPreviewHTMLRequestObject) (HolderGetCredentialPreviewHTMLResponseObject, error)
+	// Get Holder Credential QR code
+	// (GET /v1/holder/credentials/{id}/qrcode)
+	HolderGetCredentialQrCode(ctx context.Context, request HolderGetCredentialQrCodeRequestObject) (HolderGetCredentialQrCodeResponseObject, error)
+	// Reissue Holder Credential
+	// (POST /v1/holder/credentials/{id}/reissue)
+	HolderReissueCredential(ctx context.Context, request HolderReissueCredentialRequestObject) (HolderReissueCredentialResponseObject, error)
+	// Request Holder Credential Revocation
+	// (POST /v1/holder/credentials/{id}/revocation-request)
+	HolderRequestRevocation(ctx context.Context, request HolderRequestRevocationRequestObject) (HolderRequestRevocationResponseObject, error)
+	// Create Holder Session
+	// (POST /v1/holder/sessions/{id})
+	HolderCreateSession(ctx context.Context, request HolderCreateSessionRequestObject) (HolderCreateSessionResponseObject, error)
+	// Get issuer profiles
+	// (GET /v1/issuer-profiles)
+	GetIssuerProfiles(ctx context.Context, request GetIssuerProfilesRequestObject) (GetIssuerProfilesResponseObject, error)
+	// Create issuer profile
+	// (POST /v1/issuer-profiles)
+	CreateIssuerProfile(ctx context.Context, request CreateIssuerProfileRequestObject) (CreateIssuerProfileResponseObject, error)
+	// Delete issuer profile
+	// (DELETE /v1/issuer-profiles/{id})
+	DeleteIssuerProfile(ctx context.Context, request DeleteIssuerProfileRequestObject) (DeleteIssuerProfileResponseObject, error)
+	// Get issuer profile
+	// (GET /v1/issuer-profiles/{id})
+	GetIssuerProfile(ctx context.Context, request GetIssuerProfileRequestObject) (GetIssuerProfileResponseObject, error)
+	// Get organization credentials
+	// (GET /v1/organization-credentials)
+	GetOrganizationCredentials(ctx context.Context, request GetOrganizationCredentialsRequestObject) (GetOrganizationCredentialsResponseObject, error)
+	// Receive organization credential
+	// (POST /v1/organization-credentials)
+	ReceiveOrganizationCredential(ctx context.Context, request ReceiveOrganizationCredentialRequestObject) (ReceiveOrganizationCredentialResponseObject, error)
+	// Delete organization credential
+	// (DELETE /v1/organization-credentials/{id})
+	DeleteOrganizationCredential(ctx context.Context, request DeleteOrganizationCredentialRequestObject) (DeleteOrganizationCredentialResponseObject, error)
+	// Get organization credential
+	// (GET /v1/organization-credentials/{id})
+	GetOrganizationCredential(ctx context.Context, request GetOrganizationCredentialRequestObject) (GetOrganizationCredentialResponseObject, error)
+	// Update organization credential
+	// (PATCH /v1/organization-credentials/{id})
+	UpdateOrganizationCredential(ctx context.Context, request UpdateOrganizationCredentialRequestObject) (UpdateOrganizationCredentialResponseObject, error)
+	// Get organization presentation
+	// (GET /v1/organization-presentation)
+	GetOrganizationPresentation(ctx context.Context, request GetOrganizationPresentationRequestObject) (GetOrganizationPresentationResponseObject, error)
+	// Get presentation templates
+	// (GET /v1/presentation-templates)
+	GetPresentationTemplates(ctx context.Context, request GetPresentationTemplatesRequestObject) (GetPresentationTemplatesResponseObject, error)
+	// Create presentation template
+	// (POST /v1/presentation-templates)
+	CreatePresentationTemplate(ctx context.Context, request CreatePresentationTemplateRequestObject) (CreatePresentationTemplateResponseObject, error)
+	// Delete presentation template
+	// (DELETE /v1/presentation-templates/{name})
+	DeletePresentationTemplate(ctx context.Context, request DeletePresentationTemplateRequestObject) (DeletePresentationTemplateResponseObject, error)
+	// Get presentation template
+	// (GET /v1/presentation-templates/{name})
+	GetPresentationTemplate(ctx context.Context, request GetPresentationTemplateRequestObject) (GetPresentationTemplateResponseObject, error)
+	// Get QR branding
+	// (GET /v1/qr-branding)
+	GetQRBranding(ctx context.Context, request GetQRBrandingRequestObject) (GetQRBrandingResponseObject, error)
+	// Update QR branding
+	// (PUT /v1/qr-branding)
+	UpdateQRBranding(ctx context.Context, request UpdateQRBrandingRequestObject) (UpdateQRBrandingResponseObject, error)
+	// QrCode body
+	// (GET /v1/qr-store)
+	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
+	// QrCode image
+	// (GET /v1/qr-store/image)
+	GetQrImageFromStore(ctx context.Context, request GetQrImageFromStoreRequestObject) (GetQrImageFromStoreResponseObject, error)
+	// Get revocation nonce ranges
+	// (GET /v1/revocation-nonces/ranges)
+	GetRevocationNonceRanges(ctx context.Context, request GetRevocationNonceRangesRequestObject) (GetRevocationNonceRangesResponseObject, error)
+	// Reserve revocation nonce range
+	// (POST /v1/revocation-nonces/ranges)
+	ReserveRevocationNonceRange(ctx context.Context, request ReserveRevocationNonceRangeRequestObject) (ReserveRevocationNonceRangeResponseObject, error)
+	// Get revocation requests
+	// (GET /v1/revocation-requests)
+	GetRevocationRequests(ctx context.Context, request GetRevocationRequestsRequestObject) (GetRevocationRequestsResponseObject, error)
+	// Approve revocation request
+	// (POST /v1/revocation-requests/{id}/approve)
+	ApproveRevocationRequest(ctx context.Context, request ApproveRevocationRequestRequestObject) (ApproveRevocationRequestResponseObject, error)
+	// Reject revocation request
+	// (POST /v1/revocation-requests/{id}/reject)
+	RejectRevocationRequest(ctx context.Context, request RejectRevocationRequestRequestObject) (RejectRevocationRequestResponseObject, error)
+	// Get revocation rules
+	// (GET /v1/revocation-rules)
+	GetRevocationRules(ctx context.Context, request GetRevocationRulesRequestObject) (GetRevocationRulesResponseObject, error)
+	// Create revocation rule
+	// (POST /v1/revocation-rules)
+	CreateRevocationRule(ctx context.Context, request CreateRevocationRuleRequestObject) (CreateRevocationRuleResponseObject, error)
+	// Delete revocation rule
+	// (DELETE /v1/revocation-rules/{id})
+	DeleteRevocationRule(ctx context.Context, request DeleteRevocationRuleRequestObject) (DeleteRevocationRuleResponseObject, error)
+	// Get revocation rule
+	// (GET /v1/revocation-rules/{id})
+	GetRevocationRule(ctx context.Context, request GetRevocationRuleRequestObject) (GetRevocationRuleResponseObject, error)
+	// Update revocation rule
+	// (PATCH /v1/revocation-rules/{id})
+	UpdateRevocationRule(ctx context.Context, request UpdateRevocationRuleRequestObject) (UpdateRevocationRuleResponseObject, error)
+	// Preview revocation rule
+	// (GET /v1/revocation-rules/{id}/preview)
+	PreviewRevocationRule(ctx context.Context, request PreviewRevocationRuleRequestObject) (PreviewRevocationRuleResponseObject, error)
+	// Get Schemas
+	// (GET /v1/schemas)
+	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
+	// Import JSON schema
+	// (POST /v1/schemas)
+	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
+	// Get Schema
+	// (GET /v1/schemas/{id})
+	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
+	// Update Schema Expiration
+	// (PATCH /v1/schemas/{id})
+	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
+	// Delete Credential PDF Template
+	// (DELETE /v1/schemas/{id}/pdf-template)
+	DeleteCredentialPDFTemplate(ctx context.Context, request DeleteCredentialPDFTemplateRequestObject) (DeleteCredentialPDFTemplateResponseObject, error)
+	// Get Credential PDF Template
+	// (GET /v1/schemas/{id}/pdf-template)
+	GetCredentialPDFTemplate(ctx context.Context, request GetCredentialPDFTemplateRequestObject) (GetCredentialPDFTemplateResponseObject, error)
+	// Update Credential PDF Template
+	// (PUT /v1/schemas/{id}/pdf-template)
+	UpdateCredentialPDFTemplate(ctx context.Context, request UpdateCredentialPDFTemplateRequestObject) (UpdateCredentialPDFTemplateResponseObject, error)
+	// Update Schema Self Revocation
+	// (PUT /v1/schemas/{id}/self-revocation)
+	UpdateSchemaSelfRevocation(ctx context.Context, request UpdateSchemaSelfRevocationRequestObject) (UpdateSchemaSelfRevocationResponseObject, error)
+	// Update Schema Uniqueness
+	// (PUT /v1/schemas/{id}/uniqueness)
+	UpdateSchemaUniqueness(ctx context.Context, request UpdateSchemaUniquenessRequestObject) (UpdateSchemaUniquenessResponseObject, error)
+	// Update Schema Webhook Template
+	// (PUT /v1/schemas/{id}/webhook-template)
+	UpdateSchemaWebhookTemplate(ctx context.Context, request UpdateSchemaWebhookTemplateRequestObject) (UpdateSchemaWebhookTemplateResponseObject, error)
+	// Session Status Events
+	// (GET /v1/sessions/{id}/events)
+	GetSessionEvents(ctx context.Context, request GetSessionEventsRequestObject) (GetSessionEventsResponseObject, error)
+	// Get Pending State Changes
+	// (GET /v1/state/pending)
+	GetStatePending(ctx context.Context, request GetStatePendingRequestObject) (GetStatePendingResponseObject, error)
+	// Publish Identity State
+	// (POST /v1/state/publish)
+	PublishState(ctx context.Context, request PublishStateRequestObject) (PublishStateResponseObject, error)
+	// Retry Publish Identity State
+	// (POST /v1/state/retry)
+	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
+	// Get Identity State Status
+	// (GET /v1/state/status)
+	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
+	// Get State Checkpoints
+	// (GET /v1/state/checkpoints)
+	GetStateCheckpoints(ctx context.Context, request GetStateCheckpointsRequestObject) (GetStateCheckpointsResponseObject, error)
+	// Get Identity State Transactions
+	// (GET /v1/state/transactions)
+	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	// Get Stats
+	// (GET /v1/stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Get translations
+	// (GET /v1/translations)
+	GetTranslations(ctx context.Context, request GetTranslationsRequestObject) (GetTranslationsResponseObject, error)
+	// Delete translation
+	// (DELETE /v1/translations/{locale})
+	DeleteTranslation(ctx context.Context, request DeleteTranslationRequestObject) (DeleteTranslationResponseObject, error)
+	// Get translation
+	// (GET /v1/translations/{locale})
+	GetTranslation(ctx context.Context, request GetTranslationRequestObject) (GetTranslationResponseObject, error)
+	// Save translation
+	// (PUT /v1/translations/{locale})
+	SaveTranslation(ctx context.Context, request SaveTranslationRequestObject) (SaveTranslationResponseObject, error)
+	// Get Wallet Compatibility Report
+	// (GET /v1/wallets)
+	GetWalletCompatibilityReport(ctx context.Context, request GetWalletCompatibilityReportRequestObject) (GetWalletCompatibilityReportResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDocumentation operation middleware
+func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
+	var request GetDocumentationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
+		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFavicon operation middleware
+func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
+	var request GetFaviconRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFavicon")
+	}
 
-	return r
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
+		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type N400JSONResponse GenericErrorMessage
+// GetYaml operation middleware
+func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
+	var request GetYamlRequestObject
 
-type N401JSONResponse GenericErrorMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetYaml")
+	}
 
-type N404JSONResponse GenericErrorMessage
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
+		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetKeyMigrations operation middleware
+func (sh *strictHandler) GetKeyMigrations(w http.ResponseWriter, r *http.Request) {
+	var request GetKeyMigrationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeyMigrations(ctx, request.(GetKeyMigrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeyMigrations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeyMigrationsResponseObject); ok {
+		if err := validResponse.VisitGetKeyMigrationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartKeyMigration operation middleware
+func (sh *strictHandler) StartKeyMigration(w http.ResponseWriter, r *http.Request) {
+	var request StartKeyMigrationRequestObject
+
+	var body StartKeyMigrationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartKeyMigration(ctx, request.(StartKeyMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartKeyMigration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartKeyMigrationResponseObject); ok {
+		if err := validResponse.VisitStartKeyMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetKeyMigration operation middleware
+func (sh *strictHandler) GetKeyMigration(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetKeyMigrationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeyMigration(ctx, request.(GetKeyMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeyMigration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeyMigrationResponseObject); ok {
+		if err := validResponse.VisitGetKeyMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeKeyMigration operation middleware
+func (sh *strictHandler) ResumeKeyMigration(w http.ResponseWriter, r *http.Request, id Id) {
+	var request ResumeKeyMigrationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeKeyMigration(ctx, request.(ResumeKeyMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeKeyMigration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeKeyMigrationResponseObject); ok {
+		if err := validResponse.VisitResumeKeyMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMigrations operation middleware
+func (sh *strictHandler) GetMigrations(w http.ResponseWriter, r *http.Request) {
+	var request GetMigrationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMigrations(ctx, request.(GetMigrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMigrations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMigrationsResponseObject); ok {
+		if err := validResponse.VisitGetMigrationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RunMigrations operation middleware
+func (sh *strictHandler) RunMigrations(w http.ResponseWriter, r *http.Request) {
+	var request RunMigrationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunMigrations(ctx, request.(RunMigrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunMigrations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunMigrationsResponseObject); ok {
+		if err := validResponse.VisitRunMigrationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Agent operation middleware
+func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
+	var request AgentRequestObject
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Agent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentResponseObject); ok {
+		if err := validResponse.VisitAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIKeys operation middleware
+func (sh *strictHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKeys(ctx, request.(GetAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKeysResponseObject); ok {
+		if err := validResponse.VisitGetAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
+
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateReadOnlyAPIKey operation middleware
+func (sh *strictHandler) CreateReadOnlyAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateReadOnlyAPIKeyRequestObject
+
+	var body CreateReadOnlyAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateReadOnlyAPIKey(ctx, request.(CreateReadOnlyAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateReadOnlyAPIKey")
+	}
 
-type N409JSONResponse GenericErrorMessage
+	response, err := handler(r.Context(), w, r, request)
 
-type N422JSONResponse GenericErrorMessage
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateReadOnlyAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateReadOnlyAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-type N500JSONResponse GenericErrorMessage
+// RevokeAPIKey operation middleware
+func (sh *strictHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RevokeAPIKeyRequestObject
 
-type GetDocumentationRequestObject struct {
-}
+	request.Id = id
 
-type GetDocumentationResponseObject interface {
-	VisitGetDocumentationResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIKey(ctx, request.(RevokeAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIKey")
+	}
 
-type GetDocumentation200Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPIKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConfigRequestObject struct {
-}
+// RotateAPIKey operation middleware
+func (sh *strictHandler) RotateAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RotateAPIKeyRequestObject
 
-type GetConfigResponseObject interface {
-	VisitGetConfigResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type GetConfig200JSONResponse Config
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateAPIKey(ctx, request.(RotateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateAPIKey")
+	}
 
-func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitRotateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConfig500JSONResponse struct{ N500JSONResponse }
+// AuthCallback operation middleware
+func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
+	var request AuthCallbackRequestObject
 
-func (response GetConfig500JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AuthCallbackTextRequestBody(data)
+	request.Body = &body
 
-type GetFaviconRequestObject struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthCallback")
+	}
 
-type GetFaviconResponseObject interface {
-	VisitGetFaviconResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetFavicon200Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
+		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// AuthQRCode operation middleware
+func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request, params AuthQRCodeParams) {
+	var request AuthQRCodeRequestObject
 
-type GetYamlRequestObject struct {
-}
+	request.Params = params
 
-type GetYamlResponseObject interface {
-	VisitGetYamlResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthQRCode(ctx, request.(AuthQRCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthQRCode")
+	}
 
-type GetYaml200Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthQRCodeResponseObject); ok {
+		if err := validResponse.VisitAuthQRCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HealthRequestObject struct {
-}
+// GetAuthenticationConnection operation middleware
+func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetAuthenticationConnectionRequestObject
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type Health200JSONResponse Health
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthenticationConnection")
+	}
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
+		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Health500JSONResponse struct{ N500JSONResponse }
+// GetCampaigns operation middleware
+func (sh *strictHandler) GetCampaigns(w http.ResponseWriter, r *http.Request) {
+	var request GetCampaignsRequestObject
 
-func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCampaigns(ctx, request.(GetCampaignsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCampaigns")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AgentRequestObject struct {
-	Body *AgentTextRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCampaignsResponseObject); ok {
+		if err := validResponse.VisitGetCampaignsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AgentResponseObject interface {
-	VisitAgentResponse(w http.ResponseWriter) error
-}
+// CreateCampaign operation middleware
+func (sh *strictHandler) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	var request CreateCampaignRequestObject
 
-type Agent200JSONResponse AgentResponse
+	var body CreateCampaignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCampaign(ctx, request.(CreateCampaignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCampaign")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type Agent400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCampaignResponseObject); ok {
+		if err := validResponse.VisitCreateCampaignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteCampaign operation middleware
+func (sh *strictHandler) DeleteCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteCampaignRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type Agent500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCampaign(ctx, request.(DeleteCampaignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCampaign")
+	}
 
-func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCampaignResponseObject); ok {
+		if err := validResponse.VisitDeleteCampaignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AuthCallbackRequestObject struct {
-	Params AuthCallbackParams
-	Body   *AuthCallbackTextRequestBody
-}
+// GetCampaign operation middleware
+func (sh *strictHandler) GetCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCampaignRequestObject
 
-type AuthCallbackResponseObject interface {
-	VisitAuthCallbackResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type AuthCallback200Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCampaign(ctx, request.(GetCampaignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCampaign")
+	}
 
-func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCampaignResponseObject); ok {
+		if err := validResponse.VisitGetCampaignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AuthCallback400JSONResponse struct{ N400JSONResponse }
+// UpdateCampaign operation middleware
+func (sh *strictHandler) UpdateCampaign(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateCampaignRequestObject
 
-func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateCampaignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type AuthCallback500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCampaign(ctx, request.(UpdateCampaignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCampaign")
+	}
 
-func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCampaignResponseObject); ok {
+		if err := validResponse.VisitUpdateCampaignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AuthQRCodeRequestObject struct {
-	Params AuthQRCodeParams
-}
+// AddCampaignCredentials operation middleware
+func (sh *strictHandler) AddCampaignCredentials(w http.ResponseWriter, r *http.Request, id Id) {
+	var request AddCampaignCredentialsRequestObject
 
-type AuthQRCodeResponseObject interface {
-	VisitAuthQRCodeResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type AuthQRCode200JSONResponse QrCodeLinkShortResponse
+	var body AddCampaignCredentialsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response AuthQRCode200JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddCampaignCredentials(ctx, request.(AddCampaignCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddCampaignCredentials")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddCampaignCredentialsResponseObject); ok {
+		if err := validResponse.VisitAddCampaignCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AuthQRCode500JSONResponse struct{ N500JSONResponse }
+// AddCampaignLinks operation middleware
+func (sh *strictHandler) AddCampaignLinks(w http.ResponseWriter, r *http.Request, id Id) {
+	var request AddCampaignLinksRequestObject
 
-func (response AuthQRCode500JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body AddCampaignLinksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetAuthenticationConnectionRequestObject struct {
-	Id Id `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddCampaignLinks(ctx, request.(AddCampaignLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddCampaignLinks")
+	}
 
-type GetAuthenticationConnectionResponseObject interface {
-	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddCampaignLinksResponseObject); ok {
+		if err := validResponse.VisitAddCampaignLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteCampaignLink operation middleware
+func (sh *strictHandler) DeleteCampaignLink(w http.ResponseWriter, r *http.Request, id Id, linkID CampaignLinkID) {
+	var request DeleteCampaignLinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.LinkID = linkID
 
-type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCampaignLink(ctx, request.(DeleteCampaignLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCampaignLink")
+	}
 
-func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCampaignLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteCampaignLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+// GetPublicCatalog operation middleware
+func (sh *strictHandler) GetPublicCatalog(w http.ResponseWriter, r *http.Request) {
+	var request GetPublicCatalogRequestObject
 
-func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPublicCatalog(ctx, request.(GetPublicCatalogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPublicCatalog")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPublicCatalogResponseObject); ok {
+		if err := validResponse.VisitGetPublicCatalogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetConnections operation middleware
+func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams) {
+	var request GetConnectionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnections")
+	}
 
-func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConnectionsRequestObject struct {
-	Params GetConnectionsParams
-}
+// DeleteConnection operation middleware
+func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id Id, params DeleteConnectionParams) {
+	var request DeleteConnectionRequestObject
 
-type GetConnectionsResponseObject interface {
-	VisitGetConnectionsResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type GetConnections200JSONResponse ConnectionsPaginated
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnection")
+	}
 
-func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConnections400JSONResponse struct{ N400JSONResponse }
-
-func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetConnection operation middleware
+func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetConnectionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetConnections500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnection")
+	}
 
-func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
+		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteConnectionRequestObject struct {
-	Id     Id `json:"id"`
-	Params DeleteConnectionParams
-}
+// UpdateConnection operation middleware
+func (sh *strictHandler) UpdateConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateConnectionRequestObject
 
-type DeleteConnectionResponseObject interface {
-	VisitDeleteConnectionResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type DeleteConnection200JSONResponse GenericMessage
+	var body UpdateConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConnection(ctx, request.(UpdateConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConnection")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateConnectionResponseObject); ok {
+		if err := validResponse.VisitUpdateConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteConnection400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteConnectionCredentials operation middleware
+func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteConnectionCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnectionCredentials")
+	}
 
-func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConnectionRequestObject struct {
-	Id Id `json:"id"`
-}
+// RevokeConnectionCredentials operation middleware
+func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RevokeConnectionCredentialsRequestObject
 
-type GetConnectionResponseObject interface {
-	VisitGetConnectionResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type GetConnection200JSONResponse GetConnectionResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeConnectionCredentials")
+	}
 
-func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetConnection400JSONResponse struct{ N400JSONResponse }
-
-func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateConnectionRebindingCode operation middleware
+func (sh *strictHandler) CreateConnectionRebindingCode(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CreateConnectionRebindingCodeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetConnection500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConnectionRebindingCode(ctx, request.(CreateConnectionRebindingCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConnectionRebindingCode")
+	}
 
-func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConnectionRebindingCodeResponseObject); ok {
+		if err := validResponse.VisitCreateConnectionRebindingCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteConnectionCredentialsRequestObject struct {
-	Id Id `json:"id"`
-}
+// RedeemConnectionRebindingCode operation middleware
+func (sh *strictHandler) RedeemConnectionRebindingCode(w http.ResponseWriter, r *http.Request) {
+	var request RedeemConnectionRebindingCodeRequestObject
 
-type DeleteConnectionCredentialsResponseObject interface {
-	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
-}
+	var body RedeemConnectionRebindingCodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteConnectionCredentials200JSONResponse GenericMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeemConnectionRebindingCode(ctx, request.(RedeemConnectionRebindingCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeemConnectionRebindingCode")
+	}
 
-func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeemConnectionRebindingCodeResponseObject); ok {
+		if err := validResponse.VisitRedeemConnectionRebindingCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+// GetConnectionReAuthQRCode operation middleware
+func (sh *strictHandler) GetConnectionReAuthQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetConnectionReAuthQRCodeParams) {
+	var request GetConnectionReAuthQRCodeRequestObject
 
-func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionReAuthQRCode(ctx, request.(GetConnectionReAuthQRCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionReAuthQRCode")
+	}
 
-type RevokeConnectionCredentialsRequestObject struct {
-	Id Id `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RevokeConnectionCredentialsResponseObject interface {
-	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionReAuthQRCodeResponseObject); ok {
+		if err := validResponse.VisitGetConnectionReAuthQRCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RevokeConnectionCredentials202JSONResponse GenericMessage
+// GetConnectionDuplicates operation middleware
+func (sh *strictHandler) GetConnectionDuplicates(w http.ResponseWriter, r *http.Request) {
+	var request GetConnectionDuplicatesRequestObject
 
-func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionDuplicates(ctx, request.(GetConnectionDuplicatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionDuplicates")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionDuplicatesResponseObject); ok {
+		if err := validResponse.VisitGetConnectionDuplicatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetDeadConnections operation middleware
+func (sh *strictHandler) GetDeadConnections(w http.ResponseWriter, r *http.Request, params GetDeadConnectionsParams) {
+	var request GetDeadConnectionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeadConnections(ctx, request.(GetDeadConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeadConnections")
+	}
 
-func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeadConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetDeadConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentialsRequestObject struct {
-	Params GetCredentialsParams
-}
+// PruneConnections operation middleware
+func (sh *strictHandler) PruneConnections(w http.ResponseWriter, r *http.Request) {
+	var request PruneConnectionsRequestObject
 
-type GetCredentialsResponseObject interface {
-	VisitGetCredentialsResponse(w http.ResponseWriter) error
-}
+	var body PruneConnectionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetCredentials200JSONResponse CredentialsPaginated
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PruneConnections(ctx, request.(PruneConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PruneConnections")
+	}
 
-func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PruneConnectionsResponseObject); ok {
+		if err := validResponse.VisitPruneConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentials400JSONResponse struct{ N400JSONResponse }
+// MergeConnection operation middleware
+func (sh *strictHandler) MergeConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request MergeConnectionRequestObject
 
-func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body MergeConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetCredentials404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergeConnection(ctx, request.(MergeConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergeConnection")
+	}
 
-func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergeConnectionResponseObject); ok {
+		if err := validResponse.VisitMergeConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentials500JSONResponse struct{ N500JSONResponse }
+// GetConnectionMerges operation middleware
+func (sh *strictHandler) GetConnectionMerges(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetConnectionMergesRequestObject
 
-func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionMerges(ctx, request.(GetConnectionMergesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionMerges")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateCredentialRequestObject struct {
-	Body *CreateCredentialJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionMergesResponseObject); ok {
+		if err := validResponse.VisitGetConnectionMergesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateCredentialResponseObject interface {
-	VisitCreateCredentialResponse(w http.ResponseWriter) error
-}
+// GetConnectionWallet operation middleware
+func (sh *strictHandler) GetConnectionWallet(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetConnectionWalletRequestObject
 
-type CreateCredential201JSONResponse UUIDResponse
+	request.Id = id
 
-func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionWallet(ctx, request.(GetConnectionWalletRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionWallet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateCredential400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionWalletResponseObject); ok {
+		if err := validResponse.VisitGetConnectionWalletResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetCredentials operation middleware
+func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams) {
+	var request GetCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type CreateCredential401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentials")
+	}
 
-func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateCredential422JSONResponse struct{ N422JSONResponse }
-
-func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// CreateCredential operation middleware
+func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request) {
+	var request CreateCredentialRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateCredential500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredential")
+	}
 
-func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLinksRequestObject struct {
-	Params GetLinksParams
-}
+// ArchiveCredentials operation middleware
+func (sh *strictHandler) ArchiveCredentials(w http.ResponseWriter, r *http.Request) {
+	var request ArchiveCredentialsRequestObject
 
-type GetLinksResponseObject interface {
-	VisitGetLinksResponse(w http.ResponseWriter) error
-}
+	var body ArchiveCredentialsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetLinks200JSONResponse []Link
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveCredentials(ctx, request.(ArchiveCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveCredentials")
+	}
 
-func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveCredentialsResponseObject); ok {
+		if err := validResponse.VisitArchiveCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLinks400JSONResponse struct{ N400JSONResponse }
-
-func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ImportCredential operation middleware
+func (sh *strictHandler) ImportCredential(w http.ResponseWriter, r *http.Request) {
+	var request ImportCredentialRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ImportCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetLinks404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportCredential(ctx, request.(ImportCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportCredential")
+	}
 
-func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportCredentialResponseObject); ok {
+		if err := validResponse.VisitImportCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLinks500JSONResponse struct{ N500JSONResponse }
+// InspectCredentialLayout operation middleware
+func (sh *strictHandler) InspectCredentialLayout(w http.ResponseWriter, r *http.Request) {
+	var request InspectCredentialLayoutRequestObject
 
-func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body InspectCredentialLayoutJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InspectCredentialLayout(ctx, request.(InspectCredentialLayoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InspectCredentialLayout")
+	}
 
-type CreateLinkRequestObject struct {
-	Body *CreateLinkJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateLinkResponseObject interface {
-	VisitCreateLinkResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InspectCredentialLayoutResponseObject); ok {
+		if err := validResponse.VisitInspectCredentialLayoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLink201JSONResponse UUIDResponse
-
-func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetLinks operation middleware
+func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
+	var request GetLinksRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type CreateLink400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinks")
+	}
 
-func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
+		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLink500JSONResponse struct{ N500JSONResponse }
+// CreateLink operation middleware
+func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
+	var request CreateLinkRequestObject
 
-func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body CreateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLink")
+	}
 
-type CreateLinkQrCodeCallbackRequestObject struct {
-	Params CreateLinkQrCodeCallbackParams
-	Body   *CreateLinkQrCodeCallbackTextRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateLinkQrCodeCallbackResponseObject interface {
-	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
+		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLinkQrCodeCallback200Response struct {
-}
+// CreateLinkQrCodeCallback operation middleware
+func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, params CreateLinkQrCodeCallbackParams) {
+	var request CreateLinkQrCodeCallbackRequestObject
 
-func (response CreateLinkQrCodeCallback200Response) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Params = params
 
-type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := CreateLinkQrCodeCallbackTextRequestBody(data)
+	request.Body = &body
 
-func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCodeCallback")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+// DeleteLink operation middleware
+func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteLinkRequestObject
 
-func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLink")
+	}
 
-type DeleteLinkRequestObject struct {
-	Id Id `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteLinkResponseObject interface {
-	VisitDeleteLinkResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteLink200JSONResponse GenericMessage
-
-func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetLink operation middleware
+func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type DeleteLink400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLink")
+	}
 
-func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
+		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteLink500JSONResponse struct{ N500JSONResponse }
+// AcivateLink operation middleware
+func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request AcivateLinkRequestObject
 
-func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body AcivateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetLinkRequestObject struct {
-	Id Id `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcivateLink(ctx, request.(AcivateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcivateLink")
+	}
 
-type GetLinkResponseObject interface {
-	VisitGetLinkResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcivateLinkResponseObject); ok {
+		if err := validResponse.VisitAcivateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLink200JSONResponse Link
+// CreateLinkBatch operation middleware
+func (sh *strictHandler) CreateLinkBatch(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CreateLinkBatchRequestObject
 
-func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateLinkBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetLink404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkBatch(ctx, request.(CreateLinkBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkBatch")
+	}
 
-func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkBatchResponseObject); ok {
+		if err := validResponse.VisitCreateLinkBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLink500JSONResponse struct{ N500JSONResponse }
+// CloneLink operation middleware
+func (sh *strictHandler) CloneLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CloneLinkRequestObject
 
-func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneLink(ctx, request.(CloneLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneLink")
+	}
 
-type AcivateLinkRequestObject struct {
-	Id   Id `json:"id"`
-	Body *AcivateLinkJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AcivateLinkResponseObject interface {
-	VisitAcivateLinkResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloneLinkResponseObject); ok {
+		if err := validResponse.VisitCloneLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AcivateLink200JSONResponse GenericMessage
-
-func (response AcivateLink200JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetLinkPrerequisiteProofs operation middleware
+func (sh *strictHandler) GetLinkPrerequisiteProofs(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkPrerequisiteProofsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type AcivateLink400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkPrerequisiteProofs(ctx, request.(GetLinkPrerequisiteProofsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkPrerequisiteProofs")
+	}
 
-func (response AcivateLink400JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkPrerequisiteProofsResponseObject); ok {
+		if err := validResponse.VisitGetLinkPrerequisiteProofsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AcivateLink500JSONResponse struct{ N500JSONResponse }
+// GetLinkQRCode operation middleware
+func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams) {
+	var request GetLinkQRCodeRequestObject
 
-func (response AcivateLink500JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkQRCode(ctx, request.(GetLinkQRCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkQRCode")
+	}
 
-type GetLinkQRCodeRequestObject struct {
-	Id     Id `json:"id"`
-	Params GetLinkQRCodeParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetLinkQRCodeResponseObject interface {
-	VisitGetLinkQRCodeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkQRCodeResponseObject); ok {
+		if err := validResponse.VisitGetLinkQRCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLinkQRCode200JSONResponse GetLinkQrCodeResponse
+// CreateLinkQrCode operation middleware
+func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeParams) {
+	var request CreateLinkQrCodeRequestObject
+
+	request.Id = id
+	request.Params = params
 
-func (response GetLinkQRCode200JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCode(ctx, request.(CreateLinkQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCode")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetLinkQRCode400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetLinkQRCode400JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateLinkQrCodeHTML operation middleware
+func (sh *strictHandler) CreateLinkQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeHTMLParams) {
+	var request CreateLinkQrCodeHTMLRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type GetLinkQRCode404JSONResponse GenericErrorMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCodeHTML(ctx, request.(CreateLinkQrCodeHTMLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCodeHTML")
+	}
 
-func (response GetLinkQRCode404JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeHTMLResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeHTMLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLinkQRCode500JSONResponse struct{ N500JSONResponse }
+// GetLinkRecipients operation middleware
+func (sh *strictHandler) GetLinkRecipients(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkRecipientsRequestObject
 
-func (response GetLinkQRCode500JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkRecipients(ctx, request.(GetLinkRecipientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkRecipients")
+	}
 
-type CreateLinkQrCodeRequestObject struct {
-	Id Id `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateLinkQrCodeResponseObject interface {
-	VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkRecipientsResponseObject); ok {
+		if err := validResponse.VisitGetLinkRecipientsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLinkQrCode200JSONResponse CredentialLinkQrCodeResponse
+// ImportLinkRecipients operation middleware
+func (sh *strictHandler) ImportLinkRecipients(w http.ResponseWriter, r *http.Request, id Id) {
+	var request ImportLinkRecipientsRequestObject
 
-func (response CreateLinkQrCode200JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type CreateLinkQrCode400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportLinkRecipients(ctx, request.(ImportLinkRecipientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportLinkRecipients")
+	}
 
-func (response CreateLinkQrCode400JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportLinkRecipientsResponseObject); ok {
+		if err := validResponse.VisitImportLinkRecipientsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateLinkQrCode404JSONResponse struct{ N404JSONResponse }
-
-func (response CreateLinkQrCode404JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetRevocationStatusBatch operation middleware
+func (sh *strictHandler) GetRevocationStatusBatch(w http.ResponseWriter, r *http.Request) {
+	var request GetRevocationStatusBatchRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body GetRevocationStatusBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateLinkQrCode500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatusBatch(ctx, request.(GetRevocationStatusBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatusBatch")
+	}
 
-func (response CreateLinkQrCode500JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusBatchResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRevocationStatusRequestObject struct {
-	Nonce PathNonce `json:"nonce"`
-}
+// GetRevocationStatus operation middleware
+func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
+	var request GetRevocationStatusRequestObject
 
-type GetRevocationStatusResponseObject interface {
-	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
-}
+	request.Nonce = nonce
 
-type GetRevocationStatus200JSONResponse RevocationStatusResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatus")
+	}
 
-func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
-
-func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetHistoricalRevocationStatus operation middleware
+func (sh *strictHandler) GetHistoricalRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce, params GetHistoricalRevocationStatusParams) {
+	var request GetHistoricalRevocationStatusRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Nonce = nonce
+	request.Params = params
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHistoricalRevocationStatus(ctx, request.(GetHistoricalRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHistoricalRevocationStatus")
+	}
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHistoricalRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetHistoricalRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RevokeCredentialRequestObject struct {
-	Nonce PathNonce `json:"nonce"`
-}
+// RevokeCredential operation middleware
+func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce, params RevokeCredentialParams) {
+	var request RevokeCredentialRequestObject
 
-type RevokeCredentialResponseObject interface {
-	VisitRevokeCredentialResponse(w http.ResponseWriter) error
-}
+	request.Nonce = nonce
+	request.Params = params
 
-type RevokeCredential202JSONResponse RevokeCredentialResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeCredential")
+	}
 
-func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
+		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RevokeCredential401JSONResponse struct{ N401JSONResponse }
-
-func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// DeleteCredential operation middleware
+func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteCredentialRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCredential")
+	}
 
-func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+// GetCredential operation middleware
+func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialParams) {
+	var request GetCredentialRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredential")
+	}
 
-func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
+		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteCredentialRequestObject struct {
-	Id Id `json:"id"`
-}
+// GetCredentialVerificationBundle operation middleware
+func (sh *strictHandler) GetCredentialVerificationBundle(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialVerificationBundleParams) {
+	var request GetCredentialVerificationBundleRequestObject
 
-type DeleteCredentialResponseObject interface {
-	VisitDeleteCredentialResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type DeleteCredential200JSONResponse GenericMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialVerificationBundle(ctx, request.(GetCredentialVerificationBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialVerificationBundle")
+	}
 
-func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialVerificationBundleResponseObject); ok {
+		if err := validResponse.VisitGetCredentialVerificationBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteCredential400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetCredentialVersions operation middleware
+func (sh *strictHandler) GetCredentialVersions(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialVersionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialVersions(ctx, request.(GetCredentialVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialVersions")
+	}
 
-func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialVersionsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+// GetCredentialDependents operation middleware
+func (sh *strictHandler) GetCredentialDependents(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialDependentsRequestObject
 
-func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialDependents(ctx, request.(GetCredentialDependentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialDependents")
+	}
 
-type GetCredentialRequestObject struct {
-	Id Id `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetCredentialResponseObject interface {
-	VisitGetCredentialResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialDependentsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialDependentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredential200JSONResponse Credential
-
-func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetCredentialImport operation middleware
+func (sh *strictHandler) GetCredentialImport(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialImportRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetCredential400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialImport(ctx, request.(GetCredentialImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialImport")
+	}
 
-func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialImportResponseObject); ok {
+		if err := validResponse.VisitGetCredentialImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredential500JSONResponse struct{ N500JSONResponse }
+// GetCredentialPDF operation middleware
+func (sh *strictHandler) GetCredentialPDF(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialPDFParams) {
+	var request GetCredentialPDFRequestObject
 
-func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialPDF(ctx, request.(GetCredentialPDFRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialPDF")
+	}
 
-type GetCredentialQrCodeRequestObject struct {
-	Id     Id `json:"id"`
-	Params GetCredentialQrCodeParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetCredentialQrCodeResponseObject interface {
-	VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialPDFResponseObject); ok {
+		if err := validResponse.VisitGetCredentialPDFResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentialQrCode200JSONResponse QrCodeLinkWithSchemaTypeShortResponse
-
-func (response GetCredentialQrCode200JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RehydrateCredential operation middleware
+func (sh *strictHandler) RehydrateCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RehydrateCredentialRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetCredentialQrCode400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RehydrateCredential(ctx, request.(RehydrateCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RehydrateCredential")
+	}
 
-func (response GetCredentialQrCode400JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RehydrateCredentialResponseObject); ok {
+		if err := validResponse.VisitRehydrateCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentialQrCode404JSONResponse struct{ N404JSONResponse }
-
-func (response GetCredentialQrCode404JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetCredentialQrCode operation middleware
+func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeParams) {
+	var request GetCredentialQrCodeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type GetCredentialQrCode409JSONResponse struct{ N409JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialQrCode(ctx, request.(GetCredentialQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialQrCode")
+	}
 
-func (response GetCredentialQrCode409JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialQrCodeResponseObject); ok {
+		if err := validResponse.VisitGetCredentialQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetCredentialQrCode500JSONResponse struct{ N500JSONResponse }
+// GetCredentialQrCodeHTML operation middleware
+func (sh *strictHandler) GetCredentialQrCodeHTML(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeHTMLParams) {
+	var request GetCredentialQrCodeHTMLRequestObject
 
-func (response GetCredentialQrCode500JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialQrCodeHTML(ctx, request.(GetCredentialQrCodeHTMLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialQrCodeHTML")
+	}
 
-type GetQrFromStoreRequestObject struct {
-	Params GetQrFromStoreParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetQrFromStoreResponseObject interface {
-	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialQrCodeHTMLResponseObject); ok {
+		if err := validResponse.VisitGetCredentialQrCodeHTMLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore200JSONResponse map[string]interface{}
+// HolderGetCredentials operation middleware
+func (sh *strictHandler) HolderGetCredentials(w http.ResponseWriter, r *http.Request) {
+	var request HolderGetCredentialsRequestObject
 
-func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderGetCredentials(ctx, request.(HolderGetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderGetCredentials")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderGetCredentialsResponseObject); ok {
+		if err := validResponse.VisitHolderGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// HolderGetCredentialPreview operation middleware
+func (sh *strictHandler) HolderGetCredentialPreview(w http.ResponseWriter, r *http.Request, id Id) {
+	var request HolderGetCredentialPreviewRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderGetCredentialPreview(ctx, request.(HolderGetCredentialPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderGetCredentialPreview")
+	}
 
-func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderGetCredentialPreviewResponseObject); ok {
+		if err := validResponse.VisitHolderGetCredentialPreviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
+// HolderGetCredentialPreviewHTML operation middleware
+func (sh *strictHandler) HolderGetCredentialPreviewHTML(w http.ResponseWriter, r *http.Request, id Id) {
+	var request HolderGetCredentialPreviewHTMLRequestObject
 
-func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderGetCredentialPreviewHTML(ctx, request.(HolderGetCredentialPreviewHTMLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderGetCredentialPreviewHTML")
+	}
 
-type GetSchemasRequestObject struct {
-	Params GetSchemasParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemasResponseObject interface {
-	VisitGetSchemasResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderGetCredentialPreviewHTMLResponseObject); ok {
+		if err := validResponse.VisitHolderGetCredentialPreviewHTMLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchemas200JSONResponse []Schema
-
-func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// HolderGetCredentialQrCode operation middleware
+func (sh *strictHandler) HolderGetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params HolderGetCredentialQrCodeParams) {
+	var request HolderGetCredentialQrCodeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type GetSchemas400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderGetCredentialQrCode(ctx, request.(HolderGetCredentialQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderGetCredentialQrCode")
+	}
 
-func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderGetCredentialQrCodeResponseObject); ok {
+		if err := validResponse.VisitHolderGetCredentialQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchemas500JSONResponse struct{ N500JSONResponse }
+// HolderReissueCredential operation middleware
+func (sh *strictHandler) HolderReissueCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request HolderReissueCredentialRequestObject
 
-func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderReissueCredential(ctx, request.(HolderReissueCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderReissueCredential")
+	}
 
-type ImportSchemaRequestObject struct {
-	Body *ImportSchemaJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ImportSchemaResponseObject interface {
-	VisitImportSchemaResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderReissueCredentialResponseObject); ok {
+		if err := validResponse.VisitHolderReissueCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchema201JSONResponse UUIDResponse
+// HolderRequestRevocation operation middleware
+func (sh *strictHandler) HolderRequestRevocation(w http.ResponseWriter, r *http.Request, id Id) {
+	var request HolderRequestRevocationRequestObject
 
-func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body HolderRequestRevocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ImportSchema400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderRequestRevocation(ctx, request.(HolderRequestRevocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderRequestRevocation")
+	}
 
-func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderRequestRevocationResponseObject); ok {
+		if err := validResponse.VisitHolderRequestRevocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchema500JSONResponse struct{ N500JSONResponse }
+// HolderCreateSession operation middleware
+func (sh *strictHandler) HolderCreateSession(w http.ResponseWriter, r *http.Request, id Id) {
+	var request HolderCreateSessionRequestObject
 
-func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HolderCreateSession(ctx, request.(HolderCreateSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HolderCreateSession")
+	}
 
-type GetSchemaRequestObject struct {
-	Id Id `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemaResponseObject interface {
-	VisitGetSchemaResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HolderCreateSessionResponseObject); ok {
+		if err := validResponse.VisitHolderCreateSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema200JSONResponse Schema
+// GetIssuerProfiles operation middleware
+func (sh *strictHandler) GetIssuerProfiles(w http.ResponseWriter, r *http.Request) {
+	var request GetIssuerProfilesRequestObject
 
-func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIssuerProfiles(ctx, request.(GetIssuerProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIssuerProfiles")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchema400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIssuerProfilesResponseObject); ok {
+		if err := validResponse.VisitGetIssuerProfilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateIssuerProfile operation middleware
+func (sh *strictHandler) CreateIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	var request CreateIssuerProfileRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateIssuerProfileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSchema404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIssuerProfile(ctx, request.(CreateIssuerProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIssuerProfile")
+	}
 
-func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIssuerProfileResponseObject); ok {
+		if err := validResponse.VisitCreateIssuerProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema500JSONResponse struct{ N500JSONResponse }
+// DeleteIssuerProfile operation middleware
+func (sh *strictHandler) DeleteIssuerProfile(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteIssuerProfileRequestObject
 
-func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteIssuerProfile(ctx, request.(DeleteIssuerProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteIssuerProfile")
+	}
 
-type PublishStateRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PublishStateResponseObject interface {
-	VisitPublishStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteIssuerProfileResponseObject); ok {
+		if err := validResponse.VisitDeleteIssuerProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishState202JSONResponse PublishIdentityStateResponse
-
-func (response PublishState202JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+// GetIssuerProfile operation middleware
+func (sh *strictHandler) GetIssuerProfile(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetIssuerProfileRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type PublishState400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIssuerProfile(ctx, request.(GetIssuerProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIssuerProfile")
+	}
 
-func (response PublishState400JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIssuerProfileResponseObject); ok {
+		if err := validResponse.VisitGetIssuerProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishState500JSONResponse struct{ N500JSONResponse }
+// GetOrganizationCredentials operation middleware
+func (sh *strictHandler) GetOrganizationCredentials(w http.ResponseWriter, r *http.Request, params GetOrganizationCredentialsParams) {
+	var request GetOrganizationCredentialsRequestObject
 
-func (response PublishState500JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationCredentials(ctx, request.(GetOrganizationCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationCredentials")
+	}
 
-type RetryPublishStateRequestObject struct {
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishStateResponseObject interface {
-	VisitRetryPublishStateResponse(w http.ResponseWriter) error
-}
+// ReceiveOrganizationCredential operation middleware
+func (sh *strictHandler) ReceiveOrganizationCredential(w http.ResponseWriter, r *http.Request) {
+	var request ReceiveOrganizationCredentialRequestObject
 
-type RetryPublishState202JSONResponse PublishIdentityStateResponse
+	var body ReceiveOrganizationCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReceiveOrganizationCredential(ctx, request.(ReceiveOrganizationCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReceiveOrganizationCredential")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReceiveOrganizationCredentialResponseObject); ok {
+		if err := validResponse.VisitReceiveOrganizationCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+// DeleteOrganizationCredential operation middleware
+func (sh *strictHandler) DeleteOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteOrganizationCredentialRequestObject
 
-func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganizationCredential(ctx, request.(DeleteOrganizationCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganizationCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrganizationCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+// GetOrganizationCredential operation middleware
+func (sh *strictHandler) GetOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetOrganizationCredentialRequestObject
 
-func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationCredential(ctx, request.(GetOrganizationCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationCredential")
+	}
 
-type GetStateStatusRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStateStatusResponseObject interface {
-	VisitGetStateStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationCredentialResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateStatus200JSONResponse StateStatusResponse
+// UpdateOrganizationCredential operation middleware
+func (sh *strictHandler) UpdateOrganizationCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateOrganizationCredentialRequestObject
 
-func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateOrganizationCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationCredential(ctx, request.(UpdateOrganizationCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationCredential")
+	}
 
-func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationCredentialResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateTransactionsRequestObject struct {
-}
+// GetOrganizationPresentation operation middleware
+func (sh *strictHandler) GetOrganizationPresentation(w http.ResponseWriter, r *http.Request, params GetOrganizationPresentationParams) {
+	var request GetOrganizationPresentationRequestObject
 
-type GetStateTransactionsResponseObject interface {
-	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetStateTransactions200JSONResponse StateTransactionsResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationPresentation(ctx, request.(GetOrganizationPresentationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationPresentation")
+	}
 
-func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationPresentationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationPresentationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+// GetPresentationTemplates operation middleware
+func (sh *strictHandler) GetPresentationTemplates(w http.ResponseWriter, r *http.Request) {
+	var request GetPresentationTemplatesRequestObject
 
-func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPresentationTemplates(ctx, request.(GetPresentationTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPresentationTemplates")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get the documentation
-	// (GET /)
-	GetDocumentation(ctx context.Context, request GetDocumentationRequestObject) (GetDocumentationResponseObject, error)
-	// Get Config
-	// (GET /config)
-	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
-	// Gets the favicon
-	// (GET /favicon.ico)
-	GetFavicon(ctx context.Context, request GetFaviconRequestObject) (GetFaviconResponseObject, error)
-	// Get the documentation yaml file
-	// (GET /static/docs/api_ui/api.yaml)
-	GetYaml(ctx context.Context, request GetYamlRequestObject) (GetYamlResponseObject, error)
-	// Healthcheck
-	// (GET /status)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-	// Agent
-	// (POST /v1/agent)
-	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
-	// Authentication Callback
-	// (POST /v1/authentication/callback)
-	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
-	// Get Connection QRCode
-	// (GET /v1/authentication/qrcode)
-	AuthQRCode(ctx context.Context, request AuthQRCodeRequestObject) (AuthQRCodeResponseObject, error)
-	// Get Authentication Connection
-	// (GET /v1/authentication/sessions/{id})
-	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
-	// Get Connections
-	// (GET /v1/connections)
-	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
-	// Delete Connection
-	// (DELETE /v1/connections/{id})
-	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
-	// Get Connection
-	// (GET /v1/connections/{id})
-	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
-	// Delete Connection Credentials
-	// (DELETE /v1/connections/{id}/credentials)
-	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
-	// Revoke Connection Credentials
-	// (POST /v1/connections/{id}/credentials/revoke)
-	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
-	// Get Credentials
-	// (GET /v1/credentials)
-	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
-	// Create Credential
-	// (POST /v1/credentials)
-	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
-	// Get Links
-	// (GET /v1/credentials/links)
-	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
-	// Create Link
-	// (POST /v1/credentials/links)
-	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
-	// Create Link QR Code Callback
-	// (POST /v1/credentials/links/callback)
-	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
-	// Delete Link
-	// (DELETE /v1/credentials/links/{id})
-	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
-	// Get Link
-	// (GET /v1/credentials/links/{id})
-	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
-	// Activate | Deactivate Link
-	// (PATCH /v1/credentials/links/{id})
-	AcivateLink(ctx context.Context, request AcivateLinkRequestObject) (AcivateLinkResponseObject, error)
-	// Get Credential Link QRCode
-	// (GET /v1/credentials/links/{id}/qrcode)
-	GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequestObject) (GetLinkQRCodeResponseObject, error)
-	// Create Authentication Link QRCode
-	// (POST /v1/credentials/links/{id}/qrcode)
-	CreateLinkQrCode(ctx context.Context, request CreateLinkQrCodeRequestObject) (CreateLinkQrCodeResponseObject, error)
-	// Get Revocation Status
-	// (GET /v1/credentials/revocation/status/{nonce})
-	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
-	// Revoke Credential
-	// (POST /v1/credentials/revoke/{nonce})
-	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
-	// Delete Credential
-	// (DELETE /v1/credentials/{id})
-	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
-	// Get Credential
-	// (GET /v1/credentials/{id})
-	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
-	// Get Credential QR code
-	// (GET /v1/credentials/{id}/qrcode)
-	GetCredentialQrCode(ctx context.Context, request GetCredentialQrCodeRequestObject) (GetCredentialQrCodeResponseObject, error)
-	// QrCode body
-	// (GET /v1/qr-store)
-	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
-	// Get Schemas
-	// (GET /v1/schemas)
-	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
-	// Import JSON schema
-	// (POST /v1/schemas)
-	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
-	// Get Schema
-	// (GET /v1/schemas/{id})
-	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
-	// Publish Identity State
-	// (POST /v1/state/publish)
-	PublishState(ctx context.Context, request PublishStateRequestObject) (PublishStateResponseObject, error)
-	// Retry Publish Identity State
-	// (POST /v1/state/retry)
-	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
-	// Get Identity State Status
-	// (GET /v1/state/status)
-	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
-	// Get Identity State Transactions
-	// (GET /v1/state/transactions)
-	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPresentationTemplatesResponseObject); ok {
+		if err := validResponse.VisitGetPresentationTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreatePresentationTemplate operation middleware
+func (sh *strictHandler) CreatePresentationTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreatePresentationTemplateRequestObject
+
+	var body CreatePresentationTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePresentationTemplate(ctx, request.(CreatePresentationTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePresentationTemplate")
+	}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePresentationTemplateResponseObject); ok {
+		if err := validResponse.VisitCreatePresentationTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+// DeletePresentationTemplate operation middleware
+func (sh *strictHandler) DeletePresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName) {
+	var request DeletePresentationTemplateRequestObject
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePresentationTemplate(ctx, request.(DeletePresentationTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePresentationTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePresentationTemplateResponseObject); ok {
+		if err := validResponse.VisitDeletePresentationTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetDocumentation operation middleware
-func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
-	var request GetDocumentationRequestObject
+// GetPresentationTemplate operation middleware
+func (sh *strictHandler) GetPresentationTemplate(w http.ResponseWriter, r *http.Request, name PresentationTemplateName) {
+	var request GetPresentationTemplateRequestObject
+
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+		return sh.ssi.GetPresentationTemplate(ctx, request.(GetPresentationTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDocumentation")
+		handler = middleware(handler, "GetPresentationTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
-		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPresentationTemplateResponseObject); ok {
+		if err := validResponse.VisitGetPresentationTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3679,23 +16739,23 @@ func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConfig operation middleware
-func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetConfigRequestObject
+// GetQRBranding operation middleware
+func (sh *strictHandler) GetQRBranding(w http.ResponseWriter, r *http.Request) {
+	var request GetQRBrandingRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+		return sh.ssi.GetQRBranding(ctx, request.(GetQRBrandingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConfig")
+		handler = middleware(handler, "GetQRBranding")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
-		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetQRBrandingResponseObject); ok {
+		if err := validResponse.VisitGetQRBrandingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3703,23 +16763,30 @@ func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetFavicon operation middleware
-func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
-	var request GetFaviconRequestObject
+// UpdateQRBranding operation middleware
+func (sh *strictHandler) UpdateQRBranding(w http.ResponseWriter, r *http.Request) {
+	var request UpdateQRBrandingRequestObject
+
+	var body UpdateQRBrandingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+		return sh.ssi.UpdateQRBranding(ctx, request.(UpdateQRBrandingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFavicon")
+		handler = middleware(handler, "UpdateQRBranding")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
-		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateQRBrandingResponseObject); ok {
+		if err := validResponse.VisitUpdateQRBrandingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3727,23 +16794,25 @@ func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetYaml operation middleware
-func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
-	var request GetYamlRequestObject
+// GetQrFromStore operation middleware
+func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
+	var request GetQrFromStoreRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+		return sh.ssi.GetQrFromStore(ctx, request.(GetQrFromStoreRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetYaml")
+		handler = middleware(handler, "GetQrFromStore")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
-		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetQrFromStoreResponseObject); ok {
+		if err := validResponse.VisitGetQrFromStoreResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3751,23 +16820,25 @@ func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Health operation middleware
-func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
-	var request HealthRequestObject
+// GetQrImageFromStore operation middleware
+func (sh *strictHandler) GetQrImageFromStore(w http.ResponseWriter, r *http.Request, params GetQrImageFromStoreParams) {
+	var request GetQrImageFromStoreRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+		return sh.ssi.GetQrImageFromStore(ctx, request.(GetQrImageFromStoreRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Health")
+		handler = middleware(handler, "GetQrImageFromStore")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HealthResponseObject); ok {
-		if err := validResponse.VisitHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetQrImageFromStoreResponseObject); ok {
+		if err := validResponse.VisitGetQrImageFromStoreResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3775,31 +16846,54 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Agent operation middleware
-func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
-	var request AgentRequestObject
+// GetRevocationNonceRanges operation middleware
+func (sh *strictHandler) GetRevocationNonceRanges(w http.ResponseWriter, r *http.Request) {
+	var request GetRevocationNonceRangesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationNonceRanges(ctx, request.(GetRevocationNonceRangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationNonceRanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationNonceRangesResponseObject); ok {
+		if err := validResponse.VisitGetRevocationNonceRangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReserveRevocationNonceRange operation middleware
+func (sh *strictHandler) ReserveRevocationNonceRange(w http.ResponseWriter, r *http.Request) {
+	var request ReserveRevocationNonceRangeRequestObject
+
+	var body ReserveRevocationNonceRangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	body := AgentTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+		return sh.ssi.ReserveRevocationNonceRange(ctx, request.(ReserveRevocationNonceRangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Agent")
+		handler = middleware(handler, "ReserveRevocationNonceRange")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentResponseObject); ok {
-		if err := validResponse.VisitAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReserveRevocationNonceRangeResponseObject); ok {
+		if err := validResponse.VisitReserveRevocationNonceRangeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3807,33 +16901,58 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthCallback operation middleware
-func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
-	var request AuthCallbackRequestObject
+// GetRevocationRequests operation middleware
+func (sh *strictHandler) GetRevocationRequests(w http.ResponseWriter, r *http.Request, params GetRevocationRequestsParams) {
+	var request GetRevocationRequestsRequestObject
 
 	request.Params = params
 
-	data, err := io.ReadAll(r.Body)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationRequests(ctx, request.(GetRevocationRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationRequestsResponseObject); ok {
+		if err := validResponse.VisitGetRevocationRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveRevocationRequest operation middleware
+func (sh *strictHandler) ApproveRevocationRequest(w http.ResponseWriter, r *http.Request, id Id) {
+	var request ApproveRevocationRequestRequestObject
+
+	request.Id = id
+
+	var body ApproveRevocationRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	body := AuthCallbackTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+		return sh.ssi.ApproveRevocationRequest(ctx, request.(ApproveRevocationRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthCallback")
+		handler = middleware(handler, "ApproveRevocationRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
-		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(ApproveRevocationRequestResponseObject); ok {
+		if err := validResponse.VisitApproveRevocationRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3841,25 +16960,32 @@ func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// AuthQRCode operation middleware
-func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request, params AuthQRCodeParams) {
-	var request AuthQRCodeRequestObject
+// RejectRevocationRequest operation middleware
+func (sh *strictHandler) RejectRevocationRequest(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RejectRevocationRequestRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body RejectRevocationRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthQRCode(ctx, request.(AuthQRCodeRequestObject))
+		return sh.ssi.RejectRevocationRequest(ctx, request.(RejectRevocationRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthQRCode")
+		handler = middleware(handler, "RejectRevocationRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthQRCodeResponseObject); ok {
-		if err := validResponse.VisitAuthQRCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(RejectRevocationRequestResponseObject); ok {
+		if err := validResponse.VisitRejectRevocationRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3867,25 +16993,23 @@ func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetAuthenticationConnection operation middleware
-func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetAuthenticationConnectionRequestObject
-
-	request.Id = id
+// GetRevocationRules operation middleware
+func (sh *strictHandler) GetRevocationRules(w http.ResponseWriter, r *http.Request) {
+	var request GetRevocationRulesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+		return sh.ssi.GetRevocationRules(ctx, request.(GetRevocationRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthenticationConnection")
+		handler = middleware(handler, "GetRevocationRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
-		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRevocationRulesResponseObject); ok {
+		if err := validResponse.VisitGetRevocationRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3893,25 +17017,30 @@ func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *h
 	}
 }
 
-// GetConnections operation middleware
-func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams) {
-	var request GetConnectionsRequestObject
+// CreateRevocationRule operation middleware
+func (sh *strictHandler) CreateRevocationRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateRevocationRuleRequestObject
 
-	request.Params = params
+	var body CreateRevocationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+		return sh.ssi.CreateRevocationRule(ctx, request.(CreateRevocationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnections")
+		handler = middleware(handler, "CreateRevocationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
-		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRevocationRuleResponseObject); ok {
+		if err := validResponse.VisitCreateRevocationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3919,26 +17048,25 @@ func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteConnection operation middleware
-func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id Id, params DeleteConnectionParams) {
-	var request DeleteConnectionRequestObject
+// DeleteRevocationRule operation middleware
+func (sh *strictHandler) DeleteRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteRevocationRuleRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+		return sh.ssi.DeleteRevocationRule(ctx, request.(DeleteRevocationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnection")
+		handler = middleware(handler, "DeleteRevocationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRevocationRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteRevocationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3946,25 +17074,25 @@ func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConnection operation middleware
-func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetConnectionRequestObject
+// GetRevocationRule operation middleware
+func (sh *strictHandler) GetRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetRevocationRuleRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+		return sh.ssi.GetRevocationRule(ctx, request.(GetRevocationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnection")
+		handler = middleware(handler, "GetRevocationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
-		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRevocationRuleResponseObject); ok {
+		if err := validResponse.VisitGetRevocationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3972,25 +17100,32 @@ func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// DeleteConnectionCredentials operation middleware
-func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteConnectionCredentialsRequestObject
+// UpdateRevocationRule operation middleware
+func (sh *strictHandler) UpdateRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateRevocationRuleRequestObject
 
 	request.Id = id
 
+	var body UpdateRevocationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+		return sh.ssi.UpdateRevocationRule(ctx, request.(UpdateRevocationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnectionCredentials")
+		handler = middleware(handler, "UpdateRevocationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRevocationRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateRevocationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3998,25 +17133,25 @@ func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// RevokeConnectionCredentials operation middleware
-func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
-	var request RevokeConnectionCredentialsRequestObject
+// PreviewRevocationRule operation middleware
+func (sh *strictHandler) PreviewRevocationRule(w http.ResponseWriter, r *http.Request, id Id) {
+	var request PreviewRevocationRuleRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+		return sh.ssi.PreviewRevocationRule(ctx, request.(PreviewRevocationRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeConnectionCredentials")
+		handler = middleware(handler, "PreviewRevocationRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewRevocationRuleResponseObject); ok {
+		if err := validResponse.VisitPreviewRevocationRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4024,25 +17159,25 @@ func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// GetCredentials operation middleware
-func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams) {
-	var request GetCredentialsRequestObject
+// GetSchemas operation middleware
+func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
+	var request GetSchemasRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentials")
+		handler = middleware(handler, "GetSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
-		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
+		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4050,11 +17185,11 @@ func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateCredential operation middleware
-func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request) {
-	var request CreateCredentialRequestObject
+// ImportSchema operation middleware
+func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
+	var request ImportSchemaRequestObject
 
-	var body CreateCredentialJSONRequestBody
+	var body ImportSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4062,18 +17197,18 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCredential")
+		handler = middleware(handler, "ImportSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
+		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4081,25 +17216,25 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLinks operation middleware
-func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
-	var request GetLinksRequestObject
+// GetSchema operation middleware
+func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetSchemaRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinks")
+		handler = middleware(handler, "GetSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
-		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
+		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4107,11 +17242,13 @@ func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// CreateLink operation middleware
-func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
-	var request CreateLinkRequestObject
+// UpdateSchema operation middleware
+func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateSchemaRequestObject
 
-	var body CreateLinkJSONRequestBody
+	request.Id = id
+
+	var body UpdateSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4119,18 +17256,18 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLink")
+		handler = middleware(handler, "UpdateSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
-		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4138,33 +17275,25 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, params CreateLinkQrCodeCallbackParams) {
-	var request CreateLinkQrCodeCallbackRequestObject
-
-	request.Params = params
+// DeleteCredentialPDFTemplate operation middleware
+func (sh *strictHandler) DeleteCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteCredentialPDFTemplateRequestObject
 
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
-	}
-	body := CreateLinkQrCodeCallbackTextRequestBody(data)
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+		return sh.ssi.DeleteCredentialPDFTemplate(ctx, request.(DeleteCredentialPDFTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCodeCallback")
+		handler = middleware(handler, "DeleteCredentialPDFTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCredentialPDFTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialPDFTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4172,25 +17301,25 @@ func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http
 	}
 }
 
-// DeleteLink operation middleware
-func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteLinkRequestObject
+// GetCredentialPDFTemplate operation middleware
+func (sh *strictHandler) GetCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialPDFTemplateRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+		return sh.ssi.GetCredentialPDFTemplate(ctx, request.(GetCredentialPDFTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLink")
+		handler = middleware(handler, "GetCredentialPDFTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
-		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialPDFTemplateResponseObject); ok {
+		if err := validResponse.VisitGetCredentialPDFTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4198,25 +17327,32 @@ func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id I
 	}
 }
 
-// GetLink operation middleware
-func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetLinkRequestObject
+// UpdateCredentialPDFTemplate operation middleware
+func (sh *strictHandler) UpdateCredentialPDFTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateCredentialPDFTemplateRequestObject
 
 	request.Id = id
 
+	var body UpdateCredentialPDFTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+		return sh.ssi.UpdateCredentialPDFTemplate(ctx, request.(UpdateCredentialPDFTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLink")
+		handler = middleware(handler, "UpdateCredentialPDFTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
-		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateCredentialPDFTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateCredentialPDFTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4224,13 +17360,13 @@ func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id)
 	}
 }
 
-// AcivateLink operation middleware
-func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request AcivateLinkRequestObject
+// UpdateSchemaSelfRevocation operation middleware
+func (sh *strictHandler) UpdateSchemaSelfRevocation(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateSchemaSelfRevocationRequestObject
 
 	request.Id = id
 
-	var body AcivateLinkJSONRequestBody
+	var body UpdateSchemaSelfRevocationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4238,18 +17374,18 @@ func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AcivateLink(ctx, request.(AcivateLinkRequestObject))
+		return sh.ssi.UpdateSchemaSelfRevocation(ctx, request.(UpdateSchemaSelfRevocationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AcivateLink")
+		handler = middleware(handler, "UpdateSchemaSelfRevocation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AcivateLinkResponseObject); ok {
-		if err := validResponse.VisitAcivateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaSelfRevocationResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaSelfRevocationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4257,26 +17393,32 @@ func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetLinkQRCode operation middleware
-func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams) {
-	var request GetLinkQRCodeRequestObject
+// UpdateSchemaUniqueness operation middleware
+func (sh *strictHandler) UpdateSchemaUniqueness(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateSchemaUniquenessRequestObject
 
 	request.Id = id
-	request.Params = params
+
+	var body UpdateSchemaUniquenessJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinkQRCode(ctx, request.(GetLinkQRCodeRequestObject))
+		return sh.ssi.UpdateSchemaUniqueness(ctx, request.(UpdateSchemaUniquenessRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinkQRCode")
+		handler = middleware(handler, "UpdateSchemaUniqueness")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkQRCodeResponseObject); ok {
-		if err := validResponse.VisitGetLinkQRCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaUniquenessResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaUniquenessResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4284,25 +17426,32 @@ func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// CreateLinkQrCode operation middleware
-func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id) {
-	var request CreateLinkQrCodeRequestObject
+// UpdateSchemaWebhookTemplate operation middleware
+func (sh *strictHandler) UpdateSchemaWebhookTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateSchemaWebhookTemplateRequestObject
 
 	request.Id = id
 
+	var body UpdateSchemaWebhookTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCode(ctx, request.(CreateLinkQrCodeRequestObject))
+		return sh.ssi.UpdateSchemaWebhookTemplate(ctx, request.(UpdateSchemaWebhookTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCode")
+		handler = middleware(handler, "UpdateSchemaWebhookTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaWebhookTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaWebhookTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4310,25 +17459,25 @@ func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetRevocationStatus operation middleware
-func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
-	var request GetRevocationStatusRequestObject
+// GetSessionEvents operation middleware
+func (sh *strictHandler) GetSessionEvents(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetSessionEventsRequestObject
 
-	request.Nonce = nonce
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+		return sh.ssi.GetSessionEvents(ctx, request.(GetSessionEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatus")
+		handler = middleware(handler, "GetSessionEvents")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSessionEventsResponseObject); ok {
+		if err := validResponse.VisitGetSessionEventsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4336,25 +17485,23 @@ func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// RevokeCredential operation middleware
-func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
-	var request RevokeCredentialRequestObject
-
-	request.Nonce = nonce
+// GetStatePending operation middleware
+func (sh *strictHandler) GetStatePending(w http.ResponseWriter, r *http.Request) {
+	var request GetStatePendingRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+		return sh.ssi.GetStatePending(ctx, request.(GetStatePendingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeCredential")
+		handler = middleware(handler, "GetStatePending")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
-		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatePendingResponseObject); ok {
+		if err := validResponse.VisitGetStatePendingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4362,25 +17509,23 @@ func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteCredential operation middleware
-func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteCredentialRequestObject
-
-	request.Id = id
+// PublishState operation middleware
+func (sh *strictHandler) PublishState(w http.ResponseWriter, r *http.Request) {
+	var request PublishStateRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
+		return sh.ssi.PublishState(ctx, request.(PublishStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCredential")
+		handler = middleware(handler, "PublishState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
-		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(PublishStateResponseObject); ok {
+		if err := validResponse.VisitPublishStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4388,25 +17533,23 @@ func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetCredential operation middleware
-func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetCredentialRequestObject
-
-	request.Id = id
+// RetryPublishState operation middleware
+func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+	var request RetryPublishStateRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredential")
+		handler = middleware(handler, "RetryPublishState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
-		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
+		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4414,26 +17557,23 @@ func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetCredentialQrCode operation middleware
-func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id, params GetCredentialQrCodeParams) {
-	var request GetCredentialQrCodeRequestObject
-
-	request.Id = id
-	request.Params = params
+// GetStateStatus operation middleware
+func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetStateStatusRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentialQrCode(ctx, request.(GetCredentialQrCodeRequestObject))
+		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentialQrCode")
+		handler = middleware(handler, "GetStateStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialQrCodeResponseObject); ok {
-		if err := validResponse.VisitGetCredentialQrCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
+		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4441,25 +17581,23 @@ func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetQrFromStore operation middleware
-func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
-	var request GetQrFromStoreRequestObject
-
-	request.Params = params
+// GetStateCheckpoints operation middleware
+func (sh *strictHandler) GetStateCheckpoints(w http.ResponseWriter, r *http.Request) {
+	var request GetStateCheckpointsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetQrFromStore(ctx, request.(GetQrFromStoreRequestObject))
+		return sh.ssi.GetStateCheckpoints(ctx, request.(GetStateCheckpointsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetQrFromStore")
+		handler = middleware(handler, "GetStateCheckpoints")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetQrFromStoreResponseObject); ok {
-		if err := validResponse.VisitGetQrFromStoreResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateCheckpointsResponseObject); ok {
+		if err := validResponse.VisitGetStateCheckpointsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4467,25 +17605,23 @@ func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetSchemas operation middleware
-func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
-	var request GetSchemasRequestObject
-
-	request.Params = params
+// GetStateTransactions operation middleware
+func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+	var request GetStateTransactionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
+		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchemas")
+		handler = middleware(handler, "GetStateTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
-		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
+		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4493,30 +17629,23 @@ func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// ImportSchema operation middleware
-func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
-	var request ImportSchemaRequestObject
-
-	var body ImportSchemaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	var request GetStatsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportSchema")
+		handler = middleware(handler, "GetStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
-		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4524,25 +17653,23 @@ func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetSchema operation middleware
-func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetSchemaRequestObject
-
-	request.Id = id
+// GetTranslations operation middleware
+func (sh *strictHandler) GetTranslations(w http.ResponseWriter, r *http.Request) {
+	var request GetTranslationsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
+		return sh.ssi.GetTranslations(ctx, request.(GetTranslationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchema")
+		handler = middleware(handler, "GetTranslations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
-		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTranslationsResponseObject); ok {
+		if err := validResponse.VisitGetTranslationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4550,23 +17677,25 @@ func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, id Id
 	}
 }
 
-// PublishState operation middleware
-func (sh *strictHandler) PublishState(w http.ResponseWriter, r *http.Request) {
-	var request PublishStateRequestObject
+// DeleteTranslation operation middleware
+func (sh *strictHandler) DeleteTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	var request DeleteTranslationRequestObject
+
+	request.Locale = locale
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PublishState(ctx, request.(PublishStateRequestObject))
+		return sh.ssi.DeleteTranslation(ctx, request.(DeleteTranslationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PublishState")
+		handler = middleware(handler, "DeleteTranslation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PublishStateResponseObject); ok {
-		if err := validResponse.VisitPublishStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTranslationResponseObject); ok {
+		if err := validResponse.VisitDeleteTranslationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4574,23 +17703,25 @@ func (sh *strictHandler) PublishState(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RetryPublishState operation middleware
-func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request) {
-	var request RetryPublishStateRequestObject
+// GetTranslation operation middleware
+func (sh *strictHandler) GetTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	var request GetTranslationRequestObject
+
+	request.Locale = locale
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
+		return sh.ssi.GetTranslation(ctx, request.(GetTranslationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RetryPublishState")
+		handler = middleware(handler, "GetTranslation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
-		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTranslationResponseObject); ok {
+		if err := validResponse.VisitGetTranslationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4598,23 +17729,32 @@ func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetStateStatus operation middleware
-func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request) {
-	var request GetStateStatusRequestObject
+// SaveTranslation operation middleware
+func (sh *strictHandler) SaveTranslation(w http.ResponseWriter, r *http.Request, locale PathLocale) {
+	var request SaveTranslationRequestObject
+
+	request.Locale = locale
+
+	var body SaveTranslationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
+		return sh.ssi.SaveTranslation(ctx, request.(SaveTranslationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateStatus")
+		handler = middleware(handler, "SaveTranslation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
-		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(SaveTranslationResponseObject); ok {
+		if err := validResponse.VisitSaveTranslationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4622,23 +17762,23 @@ func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetStateTransactions operation middleware
-func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
-	var request GetStateTransactionsRequestObject
+// GetWalletCompatibilityReport operation middleware
+func (sh *strictHandler) GetWalletCompatibilityReport(w http.ResponseWriter, r *http.Request) {
+	var request GetWalletCompatibilityReportRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
+		return sh.ssi.GetWalletCompatibilityReport(ctx, request.(GetWalletCompatibilityReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateTransactions")
+		handler = middleware(handler, "GetWalletCompatibilityReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
-		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWalletCompatibilityReportResponseObject); ok {
+		if err := validResponse.VisitGetWalletCompatibilityReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,147 @@
+package api_ui
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
+)
+
+// apiKeyHeader is the header used to authenticate with an api key instead of the basic auth credentials
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyOperations are the operations that can be called with an api key and the scope they need.
+// Any other operation protected by basic auth, like the api key management, is rejected for api keys.
+var apiKeyOperations = map[string]domain.APIKeyScope{
+	"GetCredentials":                  domain.APIKeyScopeCredentialsRead,
+	"GetCredential":                   domain.APIKeyScopeCredentialsRead,
+	"GetCredentialVersions":           domain.APIKeyScopeCredentialsRead,
+	"GetCredentialDependents":         domain.APIKeyScopeCredentialsRead,
+	"GetCredentialQrCode":             domain.APIKeyScopeCredentialsRead,
+	"GetCredentialQrCodeHTML":         domain.APIKeyScopeCredentialsRead,
+	"GetCredentialVerificationBundle": domain.APIKeyScopeCredentialsRead,
+	"GetCredentialPDF":                domain.APIKeyScopeCredentialsRead,
+	"InspectCredentialLayout":         domain.APIKeyScopeCredentialsRead,
+	"CreateCredential":                domain.APIKeyScopeCredentialsWrite,
+	"DeleteCredential":                domain.APIKeyScopeCredentialsWrite,
+	"RevokeCredential":                domain.APIKeyScopeCredentialsWrite,
+	"ArchiveCredentials":              domain.APIKeyScopeCredentialsWrite,
+	"ImportCredential":                domain.APIKeyScopeCredentialsWrite,
+	"GetCredentialImport":             domain.APIKeyScopeCredentialsRead,
+	"RehydrateCredential":             domain.APIKeyScopeCredentialsWrite,
+	"GetIssuerProfiles":               domain.APIKeyScopeCredentialsRead,
+	"GetIssuerProfile":                domain.APIKeyScopeCredentialsRead,
+	"GetOrganizationCredentials":      domain.APIKeyScopeCredentialsRead,
+	"GetOrganizationCredential":       domain.APIKeyScopeCredentialsRead,
+	"ReceiveOrganizationCredential":   domain.APIKeyScopeCredentialsWrite,
+	"UpdateOrganizationCredential":    domain.APIKeyScopeCredentialsWrite,
+	"DeleteOrganizationCredential":    domain.APIKeyScopeCredentialsWrite,
+	"GetConnections":                  domain.APIKeyScopeConnectionsRead,
+	"GetConnection":                   domain.APIKeyScopeConnectionsRead,
+	"GetConnectionReAuthQRCode":       domain.APIKeyScopeConnectionsRead,
+	"GetConnectionWallet":             domain.APIKeyScopeConnectionsRead,
+	"GetWalletCompatibilityReport":    domain.APIKeyScopeConnectionsRead,
+	"GetConnectionDuplicates":         domain.APIKeyScopeConnectionsRead,
+	"GetConnectionMerges":             domain.APIKeyScopeConnectionsRead,
+	"GetDeadConnections":              domain.APIKeyScopeConnectionsRead,
+	"UpdateConnection":                domain.APIKeyScopeConnectionsWrite,
+	"DeleteConnection":                domain.APIKeyScopeConnectionsWrite,
+	"DeleteConnectionCredentials":     domain.APIKeyScopeConnectionsWrite,
+	"RevokeConnectionCredentials":     domain.APIKeyScopeConnectionsWrite,
+	"CreateConnectionRebindingCode":   domain.APIKeyScopeConnectionsWrite,
+	"MergeConnection":                 domain.APIKeyScopeConnectionsWrite,
+	"PruneConnections":                domain.APIKeyScopeConnectionsWrite,
+	"GetLinks":                        domain.APIKeyScopeLinksRead,
+	"GetLink":                         domain.APIKeyScopeLinksRead,
+	"GetLinkPrerequisiteProofs":       domain.APIKeyScopeLinksRead,
+	"CreateLink":                      domain.APIKeyScopeLinksWrite,
+	"CloneLink":                       domain.APIKeyScopeLinksWrite,
+	"CreateLinkBatch":                 domain.APIKeyScopeLinksWrite,
+	"ImportLinkRecipients":            domain.APIKeyScopeLinksWrite,
+	"GetLinkRecipients":               domain.APIKeyScopeLinksRead,
+	"GetCampaigns":                    domain.APIKeyScopeLinksRead,
+	"GetCampaign":                     domain.APIKeyScopeLinksRead,
+	"CreateCampaign":                  domain.APIKeyScopeLinksWrite,
+	"UpdateCampaign":                  domain.APIKeyScopeLinksWrite,
+	"DeleteCampaign":                  domain.APIKeyScopeLinksWrite,
+	"AddCampaignLinks":                domain.APIKeyScopeLinksWrite,
+	"DeleteCampaignLink":              domain.APIKeyScopeLinksWrite,
+	"AddCampaignCredentials":          domain.APIKeyScopeCredentialsWrite,
+	"GetRevocationRules":              domain.APIKeyScopeCredentialsRead,
+	"GetRevocationRule":               domain.APIKeyScopeCredentialsRead,
+	"PreviewRevocationRule":           domain.APIKeyScopeCredentialsRead,
+	"CreateRevocationRule":            domain.APIKeyScopeCredentialsWrite,
+	"UpdateRevocationRule":            domain.APIKeyScopeCredentialsWrite,
+	"DeleteRevocationRule":            domain.APIKeyScopeCredentialsWrite,
+	"GetRevocationRequests":           domain.APIKeyScopeCredentialsRead,
+	"GetRevocationNonceRanges":        domain.APIKeyScopeCredentialsRead,
+	"ReserveRevocationNonceRange":     domain.APIKeyScopeCredentialsWrite,
+	"ApproveRevocationRequest":        domain.APIKeyScopeCredentialsWrite,
+	"RejectRevocationRequest":         domain.APIKeyScopeCredentialsWrite,
+	"AcivateLink":                     domain.APIKeyScopeLinksWrite,
+	"DeleteLink":                      domain.APIKeyScopeLinksWrite,
+	"CreateLinkQrCode":                domain.APIKeyScopeLinksWrite,
+	"CreateLinkQrCodeHTML":            domain.APIKeyScopeLinksWrite,
+	"GetPresentationTemplates":        domain.APIKeyScopeLinksRead,
+	"GetPresentationTemplate":         domain.APIKeyScopeLinksRead,
+	"CreatePresentationTemplate":      domain.APIKeyScopeLinksWrite,
+	"DeletePresentationTemplate":      domain.APIKeyScopeLinksWrite,
+	"GetSchemas":                      domain.APIKeyScopeSchemasRead,
+	"GetSchema":                       domain.APIKeyScopeSchemasRead,
+	"ImportSchema":                    domain.APIKeyScopeSchemasWrite,
+	"UpdateSchema":                    domain.APIKeyScopeSchemasWrite,
+	"UpdateSchemaUniqueness":          domain.APIKeyScopeSchemasWrite,
+	"UpdateSchemaSelfRevocation":      domain.APIKeyScopeSchemasWrite,
+	"UpdateSchemaWebhookTemplate":     domain.APIKeyScopeSchemasWrite,
+	"GetCredentialPDFTemplate":        domain.APIKeyScopeSchemasRead,
+	"UpdateCredentialPDFTemplate":     domain.APIKeyScopeSchemasWrite,
+	"DeleteCredentialPDFTemplate":     domain.APIKeyScopeSchemasWrite,
+	"GetStateStatus":                  domain.APIKeyScopeStateRead,
+	"GetStatePending":                 domain.APIKeyScopeStateRead,
+	"GetStateTransactions":            domain.APIKeyScopeStateRead,
+	"GetStateCheckpoints":             domain.APIKeyScopeStateRead,
+	"PublishState":                    domain.APIKeyScopeStatePublish,
+	"RetryPublishState":               domain.APIKeyScopeStatePublish,
+}
+
+type apiKeyCtxKey struct{}
+
+// withAPIKey stores the api key in the context. Requests made with a tenant key are scoped to the tenant, so the
+// handlers only see its schemas, links and connections.
+func withAPIKey(ctx context.Context, key *domain.APIKey) context.Context {
+	if key.TenantID != nil {
+		ctx = tenancy.WithTenant(ctx, *key.TenantID)
+	}
+	return context.WithValue(ctx, apiKeyCtxKey{}, key)
+}
+
+// apiKeyFromContext returns the api key used to authenticate the request, if any
+func apiKeyFromContext(ctx context.Context) *domain.APIKey {
+	key, _ := ctx.Value(apiKeyCtxKey{}).(*domain.APIKey)
+	return key
+}
+
+func apiKeyResponse(key *domain.APIKey) APIKey {
+	scopes := make([]APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = APIKeyScope(scope)
+	}
+	allowedIPs := key.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+	return APIKey{
+		Id:         key.ID,
+		Name:       key.Name,
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+		TenantId:   key.TenantID,
+		Active:     key.IsActive(time.Now()),
+		ReadOnly:   key.ReadOnly,
+	}
+}
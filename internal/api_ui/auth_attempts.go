@@ -0,0 +1,102 @@
+package api_ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type (
+	clientIPCtxKey struct{}
+	requestCtxKey  struct{}
+)
+
+func withClientIP(ctx context.Context, ip net.IP) context.Context {
+	return context.WithValue(ctx, clientIPCtxKey{}, ip)
+}
+
+// clientIPFromContext returns the ip of the client that sent the request
+func clientIPFromContext(ctx context.Context) net.IP {
+	ip, _ := ctx.Value(clientIPCtxKey{}).(net.IP)
+	return ip
+}
+
+// withRequestContext keeps the context of the http request, as the handlers receive the server context
+func withRequestContext(ctx context.Context, reqCtx context.Context) context.Context {
+	return context.WithValue(ctx, requestCtxKey{}, reqCtx)
+}
+
+// requestContext returns a copy of ctx that is also cancelled when the http request is cancelled
+func requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	reqCtx, ok := ctx.Value(requestCtxKey{}).(context.Context)
+	if !ok {
+		return ctx, cancel
+	}
+	stop := context.AfterFunc(reqCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// checkAuthAttempt applies the brute force protection to an authentication callback. It returns the message of
+// the error response if the attempt is rejected.
+func (s *Server) checkAuthAttempt(ctx context.Context, sessionID uuid.UUID) (string, bool) {
+	if s.authAttemptsService == nil {
+		return "", true
+	}
+	// the backoff delay is cut short when the client goes away
+	ctx, cancel := requestContext(ctx)
+	defer cancel()
+	if err := s.authAttemptsService.Check(ctx, sessionID, clientIPFromContext(ctx)); err != nil {
+		if errors.Is(err, services.ErrAuthSessionLocked) {
+			return err.Error(), false
+		}
+		log.Warn(ctx, "authentication attempt cancelled", "err", err, "session", sessionID)
+		return "authentication attempt cancelled", false
+	}
+	return "", true
+}
+
+// recordAuthAttempt updates the failed attempts of the session and the client
+func (s *Server) recordAuthAttempt(ctx context.Context, sessionID uuid.UUID, err error) {
+	if s.authAttemptsService == nil {
+		return
+	}
+	if err != nil {
+		s.authAttemptsService.Failed(ctx, sessionID, clientIPFromContext(ctx))
+		return
+	}
+	s.authAttemptsService.Succeeded(ctx, sessionID, clientIPFromContext(ctx))
+}
+
+// AuthAttemptsMetricsHandler exposes the brute force protection counters in the prometheus text format
+func AuthAttemptsMetricsHandler(service ports.AuthAttemptsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		metrics := service.Metrics()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		for _, counter := range []struct {
+			name  string
+			help  string
+			value uint64
+		}{
+			{"issuer_auth_callback_failures_total", "Failed authentication attempts in the authentication and link callbacks.", metrics.Failures},
+			{"issuer_auth_callback_delayed_total", "Authentication attempts delayed because of previous failures.", metrics.Delayed},
+			{"issuer_auth_callback_rejected_total", "Authentication attempts rejected because the session was invalidated.", metrics.Rejected},
+			{"issuer_auth_callback_sessions_invalidated_total", "Authentication sessions invalidated after too many failed attempts.", metrics.SessionsInvalidated},
+		} {
+			_, _ = fmt.Fprintf(w, "# HELP %s %s\n", counter.name, counter.help)
+			_, _ = fmt.Fprintf(w, "# TYPE %s counter\n", counter.name)
+			_, _ = fmt.Fprintf(w, "%s %d\n", counter.name, counter.value)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package api_ui
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+func TestServer_CheckAuthAttempt(t *testing.T) {
+	c := cache.NewMemoryCache()
+	cfg := config.AuthProtection{MaxFailures: 10, BaseDelay: time.Minute, MaxDelay: time.Minute, Window: time.Minute}
+	s := &Server{authAttemptsService: services.NewAuthAttempts(c, repositories.NewSessionCached(c), cfg)}
+	ip := net.ParseIP("192.0.2.1")
+	sessionID := uuid.New()
+	s.authAttemptsService.Failed(context.Background(), sessionID, ip)
+
+	// the handlers receive the server context, that outlives the request
+	reqCtx, cancel := context.WithCancel(context.Background())
+	ctx := withRequestContext(withClientIP(context.Background(), ip), reqCtx)
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	msg, ok := s.checkAuthAttempt(ctx, sessionID)
+	assert.False(t, ok)
+	assert.Equal(t, "authentication attempt cancelled", msg)
+	assert.Less(t, time.Since(start), cfg.BaseDelay)
+}
@@ -0,0 +1,39 @@
+package api_ui
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// DIDConfigurationPath is the path of the DIF well known DID configuration of the origin
+const DIDConfigurationPath = "/.well-known/did-configuration.json"
+
+// DIDConfigurationHandler serves the DID configuration that links the issuer DID to the origin of the server url, so
+// the wallets can show the verified domain of the issuer. The domain linkage credential is signed on every request
+// with the current ethereum key of the issuer. The issuers with only babyjubjub keys answer not found.
+func DIDConfigurationHandler(service ports.DomainLinkageService, issuerDID w3c.DID, serverURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		configuration, err := service.DIDConfiguration(r.Context(), issuerDID, serverURL)
+		if errors.Is(err, services.ErrDomainLinkageKeyType) {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(GenericErrorMessage{Message: err.Error()})
+			return
+		}
+		if err != nil {
+			log.Error(r.Context(), "getting the did configuration", "err", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(GenericErrorMessage{Message: "internal error"})
+			return
+		}
+		w.Header().Set("Cache-Control", "no-cache")
+		_ = json.NewEncoder(w).Encode(configuration)
+	}
+}
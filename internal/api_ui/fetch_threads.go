@@ -0,0 +1,31 @@
+package api_ui
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// FetchThreadsMetricsHandler exposes the counters of the credential fetch requests bound to the thread of their offer
+// in the prometheus text format
+func FetchThreadsMetricsHandler(service ports.FetchThreadsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		metrics := service.Metrics()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		for _, counter := range []struct {
+			name  string
+			help  string
+			value uint64
+		}{
+			{"issuer_agent_fetch_accepted_total", "Credential fetch requests accepted in the thread of their offer.", metrics.Accepted},
+			{"issuer_agent_fetch_replayed_total", "Credential fetch requests rejected because the credential was already fetched in the thread.", metrics.Replayed},
+			{"issuer_agent_fetch_mismatched_total", "Credential fetch requests rejected because the credential was not offered in the thread.", metrics.Mismatched},
+			{"issuer_agent_fetch_unbound_total", "Credential fetch requests without thread or in a thread of an unknown offer.", metrics.Unbound},
+		} {
+			_, _ = fmt.Fprintf(w, "# HELP %s %s\n", counter.name, counter.help)
+			_, _ = fmt.Fprintf(w, "# TYPE %s counter\n", counter.name)
+			_, _ = fmt.Fprintf(w, "%s %d\n", counter.name, counter.value)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package api_ui
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type holderSessionCtxKey struct{}
+
+// holderOperations are the holder portal operations. The value tells if the operation needs a holder session.
+var holderOperations = map[string]bool{
+	"HolderCreateSession":            false,
+	"HolderGetCredentials":           true,
+	"HolderReissueCredential":        true,
+	"HolderGetCredentialQrCode":      true,
+	"HolderGetCredentialPreview":     true,
+	"HolderGetCredentialPreviewHTML": true,
+	"HolderRequestRevocation":        true,
+}
+
+func withHolderSession(ctx context.Context, session *domain.HolderSession) context.Context {
+	return context.WithValue(ctx, holderSessionCtxKey{}, session)
+}
+
+func holderSessionFromContext(ctx context.Context) *domain.HolderSession {
+	session, _ := ctx.Value(holderSessionCtxKey{}).(*domain.HolderSession)
+	return session
+}
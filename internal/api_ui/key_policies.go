@@ -0,0 +1,34 @@
+package api_ui
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// KeyPoliciesMetricsHandler exposes the counters of the signatures checked against the key policies in the prometheus
+// text format
+func KeyPoliciesMetricsHandler(keyStore *kms.KMS) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		metrics := keyStore.KeyPolicyMetrics()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		for _, metric := range []struct {
+			name  string
+			help  string
+			kind  string
+			value uint64
+		}{
+			{"issuer_kms_signatures_total", "Signatures allowed by the key policies.", "counter", metrics.Signed},
+			{"issuer_kms_signatures_operation_denied_total", "Signatures denied because the key policy doesn't allow the operation.", "counter", metrics.OperationDenied},
+			{"issuer_kms_signatures_schema_denied_total", "Credential signatures denied because the key policy doesn't allow the schema.", "counter", metrics.SchemaDenied},
+			{"issuer_kms_signatures_quota_exceeded_total", "Signatures denied because the key exceeded its daily quota.", "counter", metrics.QuotaExceeded},
+			{"issuer_kms_keys_over_quota", "Keys that exhausted their daily signing quota today.", "gauge", metrics.KeysOverQuota},
+			{"issuer_kms_key_policies", "Key policies configured.", "gauge", metrics.Policies},
+		} {
+			_, _ = fmt.Fprintf(w, "# HELP %s %s\n", metric.name, metric.help)
+			_, _ = fmt.Fprintf(w, "# TYPE %s %s\n", metric.name, metric.kind)
+			_, _ = fmt.Fprintf(w, "%s %d\n", metric.name, metric.value)
+		}
+	}
+}
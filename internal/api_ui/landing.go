@@ -0,0 +1,236 @@
+package api_ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+const landingPageTemplate = "api_ui/landing.html"
+
+type landingPage struct {
+	Lang              string
+	IssuerName        string
+	IssuerDescription string
+	IssuerLogo        string
+	Title             string
+	Description       string
+	SchemaType        string
+	QRImageURL        string
+	DeepLink          template.URL
+	EventsURL         string
+	Error             string
+	Text              landingPageText
+}
+
+// landingPageText are the holder facing strings of the landing page, translated to the locale of the page
+type landingPageText struct {
+	Instructions   string
+	OpenWallet     string
+	Waiting        string
+	PendingPublish string
+	PendingPayment string
+	Ready          string
+	SessionFailed  string
+}
+
+type landingPageStatus struct {
+	Status     string `json:"status"`
+	Message    string `json:"message,omitempty"`
+	DeepLink   string `json:"deepLink,omitempty"`
+	QRImageURL string `json:"qrImageURL,omitempty"`
+}
+
+// RegisterLandingPage registers the hosted link landing page (/l/{linkID}) and the server sent events endpoint
+// used by the page to follow the status of the session.
+func (s *Server) RegisterLandingPage(mux *chi.Mux) {
+	tmpl := template.Must(template.ParseFiles(landingPageTemplate))
+	mux.Get("/l/{linkID}", s.linkLandingPage(tmpl))
+	mux.Get("/l/{linkID}/events", s.linkLandingPageEvents)
+}
+
+func (s *Server) linkLandingPage(tmpl *template.Template) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		locales := requestLocales(r)
+		localizer := s.localizer(ctx, locales...)
+		page := s.newLandingPage(localizer)
+
+		status := http.StatusOK
+		linkID, err := uuid.Parse(chi.URLParam(r, "linkID"))
+		if err != nil {
+			status, page.Error = http.StatusNotFound, localizer.T(domain.TranslationKeyErrorLinkNotFound, "This link does not exist")
+			renderLandingPage(w, tmpl, status, page)
+			return
+		}
+
+		resp, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, linkID, s.serverURL(ctx), r.URL.Query().Get("code"), forwarded.ClientIP(r))
+		if err != nil {
+			switch {
+			case errors.Is(err, services.ErrLinkNotFound):
+				status, page.Error = http.StatusNotFound, localizer.T(domain.TranslationKeyErrorLinkNotFound, "This link does not exist")
+			case errors.Is(err, services.ErrLinkAlreadyExpired), errors.Is(err, services.ErrLinkMaxExceeded), errors.Is(err, services.ErrLinkInactive),
+				errors.Is(err, services.ErrLinkRecipientCodeInvalid):
+				status, page.Error = http.StatusNotFound, localizer.T(domain.TranslationKeyErrorLinkNotAvailable, "This link is no longer available")
+			case errors.Is(err, services.ErrLinkRestricted):
+				status, page.Error = http.StatusForbidden, localizer.T(domain.TranslationKeyErrorLinkRestricted, "This link can not be claimed from your location")
+			case errors.Is(err, services.ErrLinkThrottled):
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(err)))
+				status, page.Error = http.StatusTooManyRequests, localizer.T(domain.TranslationKeyErrorLinkThrottled, "This link is busy right now. Please, try again later")
+			default:
+				log.Error(ctx, "landing page. Creating link qr code", "err", err, "link", linkID)
+				status, page.Error = http.StatusInternalServerError, localizer.T(domain.TranslationKeyErrorUnexpected, "Unexpected error. Please, try again later")
+			}
+			renderLandingPage(w, tmpl, status, page)
+			return
+		}
+
+		// the locale of the link is the fallback when the holder does not ask for a translated one
+		if localizer.Locale() == "" && resp.Link.Locale != nil {
+			localizer = s.localizer(ctx, resp.Link.Locale)
+			page = s.newLandingPage(localizer)
+		}
+
+		schemaType := resp.Link.Schema.Type
+		page.SchemaType = schemaType
+		title := schemaType
+		if resp.Link.Schema.Title != nil && *resp.Link.Schema.Title != "" {
+			title = *resp.Link.Schema.Title
+		}
+		page.Title = localizer.T(domain.CredentialTitleKey(schemaType), title)
+		description := ""
+		if resp.Link.Schema.Description != nil {
+			description = *resp.Link.Schema.Description
+		}
+		page.Description = localizer.T(domain.CredentialDescriptionKey(schemaType), description)
+		page.Text.Instructions = strings.ReplaceAll(page.Text.Instructions, "{credential}", schemaType)
+		page.QRImageURL = s.qrImageURL(ctx, resp.QrID)
+		page.DeepLink = template.URL(resp.QrCode) // iden3comm scheme links are rejected by html/template otherwise
+		page.EventsURL = fmt.Sprintf("/l/%s/events?sessionID=%s", linkID, url.QueryEscape(resp.SessionID))
+
+		renderLandingPage(w, tmpl, status, page)
+	}
+}
+
+// linkLandingPageEvents streams the status of a link session until the credential offer is ready,
+// the session fails or the client goes away.
+func (s *Server) linkLandingPageEvents(w http.ResponseWriter, r *http.Request) {
+	linkID, err := uuid.Parse(chi.URLParam(r, "linkID"))
+	if err != nil {
+		http.Error(w, "invalid link id", http.StatusBadRequest)
+		return
+	}
+	sessionID, err := uuid.Parse(r.URL.Query().Get("sessionID"))
+	if err != nil {
+		http.Error(w, "invalid session id", http.StatusBadRequest)
+		return
+	}
+
+	localizer := s.localizer(r.Context(), requestLocales(r)...)
+	err = streamStatusEvents(r.Context(), w, func(ctx context.Context) (any, string) {
+		status := s.landingPageStatus(ctx, sessionID, linkID, localizer)
+		return status, status.Status
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) landingPageStatus(ctx context.Context, sessionID, linkID uuid.UUID, localizer *domain.Localizer) landingPageStatus {
+	resp, err := s.linkService.GetQRCode(ctx, sessionID, s.cfg.APIUI.IssuerDID, linkID)
+	if err != nil {
+		return landingPageStatus{Status: link_state.StatusError, Message: localizer.T(domain.TranslationKeyErrorSessionFailed, "session not found or expired")}
+	}
+
+	status := landingPageStatus{Status: resp.State.Status, Message: resp.State.Message}
+	if resp.State.Status == link_state.StatusDone && resp.State.QRCode != nil {
+		status.DeepLink = *resp.State.QRCode
+		if id, err := qrIDFromDeepLink(*resp.State.QRCode); err == nil {
+			status.QRImageURL = s.qrImageURL(ctx, id)
+		}
+	}
+	return status
+}
+
+// newLandingPage returns a landing page with the issuer details and the texts translated with the localizer
+func (s *Server) newLandingPage(localizer *domain.Localizer) landingPage {
+	issuer := s.issuerDescription(localizer)
+	page := landingPage{
+		Lang:       "en",
+		IssuerName: issuer.DisplayName,
+		IssuerLogo: issuer.Logo,
+		Text: landingPageText{
+			Instructions:   localizer.T(domain.TranslationKeyLandingInstructions, "Scan the QR code with your wallet to receive your {credential} credential."),
+			OpenWallet:     localizer.T(domain.TranslationKeyLandingOpenWallet, "Open in wallet"),
+			Waiting:        localizer.T(domain.TranslationKeyLandingWaiting, "Waiting for your wallet..."),
+			PendingPublish: localizer.T(domain.TranslationKeyLandingPendingPublish, "Your credential is being published. This may take a few minutes..."),
+			PendingPayment: localizer.T(domain.TranslationKeyLandingPendingPayment, "Waiting for your payment..."),
+			Ready:          localizer.T(domain.TranslationKeyLandingReady, "Your credential is ready. Scan this QR code to add it to your wallet."),
+			SessionFailed:  localizer.T(domain.TranslationKeyErrorSessionFailed, "Something went wrong. Please, reload the page."),
+		},
+	}
+	if locale := localizer.Locale(); locale != "" {
+		page.Lang = locale
+	}
+	if issuer.Description != nil {
+		page.IssuerDescription = *issuer.Description
+	}
+	return page
+}
+
+// requestLocales returns the locales requested by the holder, the locale query parameter first and then the
+// Accept-Language header in order of preference
+func requestLocales(r *http.Request) []*string {
+	var locales []*string
+	if locale := r.URL.Query().Get("locale"); locale != "" {
+		locales = append(locales, &locale)
+	}
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err == nil {
+		for _, tag := range tags {
+			locale := tag.String()
+			locales = append(locales, &locale)
+		}
+	}
+	return locales
+}
+
+func (s *Server) qrImageURL(ctx context.Context, id uuid.UUID) string {
+	return fmt.Sprintf("%s/v1/qr-store/image?id=%s", strings.TrimSuffix(s.serverURL(ctx), "/"), id)
+}
+
+// qrIDFromDeepLink extracts the qr store id from a deep link created with ports.QrStoreService.ToURL
+func qrIDFromDeepLink(deepLink string) (uuid.UUID, error) {
+	_, requestURI, found := strings.Cut(deepLink, "request_uri=")
+	if !found {
+		return uuid.Nil, errors.New("deep link without request_uri")
+	}
+	u, err := url.Parse(requestURI)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(u.Query().Get("id"))
+}
+
+func renderLandingPage(w http.ResponseWriter, tmpl *template.Template, status int, page landingPage) {
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.WriteHeader(status)
+	if err := tmpl.Execute(w, page); err != nil {
+		_, _ = w.Write([]byte("error rendering page"))
+	}
+}
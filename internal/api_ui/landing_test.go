@@ -0,0 +1,23 @@
+package api_ui
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestQrIDFromDeepLink(t *testing.T) {
+	id := uuid.New()
+	deepLink := services.NewQrStoreService(nil).ToURL("https://issuer.example.com", id)
+
+	got, err := qrIDFromDeepLink(deepLink)
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	_, err = qrIDFromDeepLink("https://issuer.example.com")
+	assert.Error(t, err)
+}
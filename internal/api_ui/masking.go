@@ -0,0 +1,113 @@
+package api_ui
+
+import (
+	"context"
+	"slices"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+const maskedValue = "****"
+
+type role string
+
+const (
+	roleOperator role = "operator"
+	roleAdmin    role = "admin"
+)
+
+type roleCtxKey struct{}
+
+func withRole(ctx context.Context, r role) context.Context {
+	return context.WithValue(ctx, roleCtxKey{}, r)
+}
+
+func roleFromContext(ctx context.Context) role {
+	r, ok := ctx.Value(roleCtxKey{}).(role)
+	if !ok {
+		return roleOperator
+	}
+	return r
+}
+
+// mustMask returns true if the credentialSubject attributes configured as PII must be masked for the caller
+func (s *Server) mustMask(ctx context.Context) bool {
+	return len(s.cfg.APIUI.MaskedAttributes) > 0 && roleFromContext(ctx) != roleAdmin
+}
+
+// maskCredential replaces the value of the masked credentialSubject attributes of the credential
+func (s *Server) maskCredential(ctx context.Context, credential Credential) Credential {
+	if !s.mustMask(ctx) || credential.CredentialSubject == nil {
+		return credential
+	}
+	credential.CredentialSubject = s.maskSubject(credential.CredentialSubject)
+	return credential
+}
+
+// maskVerificationBundle replaces the value of the masked credentialSubject attributes of the exported credential
+func (s *Server) maskVerificationBundle(ctx context.Context, bundle VerificationBundle) VerificationBundle {
+	if !s.mustMask(ctx) || bundle.Credential.CredentialSubject == nil {
+		return bundle
+	}
+	bundle.Credential.CredentialSubject = s.maskSubject(bundle.Credential.CredentialSubject)
+	return bundle
+}
+
+// maskLinkRecipients replaces the value of the masked credentialSubject attributes of the link recipients
+func (s *Server) maskLinkRecipients(ctx context.Context, recipients []LinkRecipient) []LinkRecipient {
+	if !s.mustMask(ctx) {
+		return recipients
+	}
+	for i := range recipients {
+		if recipients[i].CredentialSubject != nil {
+			recipients[i].CredentialSubject = s.maskSubject(recipients[i].CredentialSubject)
+		}
+	}
+	return recipients
+}
+
+// maskCredentialVersions replaces the values of the masked credentialSubject attributes in the changes of the versions
+func (s *Server) maskCredentialVersions(ctx context.Context, versions []CredentialVersion) []CredentialVersion {
+	if !s.mustMask(ctx) {
+		return versions
+	}
+	for i := range versions {
+		for j, change := range versions[i].Changes {
+			if !slices.Contains(s.cfg.APIUI.MaskedAttributes, change.Attribute) {
+				continue
+			}
+			if change.Before != nil {
+				versions[i].Changes[j].Before = maskedValue
+			}
+			if change.After != nil {
+				versions[i].Changes[j].After = maskedValue
+			}
+		}
+	}
+	return versions
+}
+
+// maskSubject returns a copy of the credentialSubject with the value of the masked attributes replaced
+func (s *Server) maskSubject(credentialSubject map[string]interface{}) map[string]interface{} {
+	subject := make(map[string]interface{}, len(credentialSubject))
+	for k, v := range credentialSubject {
+		subject[k] = v
+	}
+	for _, attr := range s.cfg.APIUI.MaskedAttributes {
+		if _, ok := subject[attr]; ok {
+			subject[attr] = maskedValue
+		}
+	}
+	return subject
+}
+
+// maskConnection masks the credentials and the email of the connection
+func (s *Server) maskConnection(ctx context.Context, conn GetConnectionResponse) GetConnectionResponse {
+	if s.mustMask(ctx) && conn.Email != nil {
+		conn.Email = common.ToPointer(maskedValue)
+	}
+	for i := range conn.Credentials {
+		conn.Credentials[i] = s.maskCredential(ctx, conn.Credentials[i])
+	}
+	return conn
+}
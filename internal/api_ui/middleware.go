@@ -5,11 +5,18 @@ import (
 	"crypto/subtle"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/mtls"
 )
 
 // LogMiddleware returns a middleware that adds general log configuration to each context request
@@ -19,7 +26,12 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 			if reqID := middleware.GetReqID(ctxReq); reqID != "" {
 				log.With("req-id", reqID)
 			}
-			return f(ctx, w, r, args)
+			ctxLog := forwarded.WithRequestBaseURL(withClientIP(withRole(ctx, roleFromContext(ctxReq)), forwarded.ClientIP(r)), r)
+			ctxLog = withRequestContext(ctxLog, r.Context())
+			if key := apiKeyFromContext(ctxReq); key != nil {
+				ctxLog = withAPIKey(ctxLog, key)
+			}
+			return f(ctxLog, w, r, args)
 		}
 	}
 }
@@ -27,20 +39,122 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 // BasicAuthMiddleware returns a middleware that performs an http basic authorization for endpoints configured with
 // basic auth in the api spec.
 // In uses the BasicAuthScopes value in context to figure if and endpoint needs authorization or not, because this
-// value is injected automatically by openapi when basic auth is selected
-func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
+// value is injected automatically by openapi when basic auth is selected.
+// If admin credentials are provided, requests authorized with them are tagged with the admin role, even when no
+// operator credentials are configured.
+// If apiKeyService is not nil, requests can also be authorized with an api key in the X-API-Key header. Api keys
+// are only valid for the operations covered by their scopes.
+func BasicAuthMiddleware(ctx context.Context, user, pass, adminUser, adminPass string, apiKeyService ports.APIKeyService) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
-			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
+			role := roleOperator
+			if secret := r.Header.Get(apiKeyHeader); ctxReq.Value(BasicAuthScopes) != nil && apiKeyService != nil && secret != "" {
+				key, err := authenticateAPIKey(ctxReq, r, apiKeyService, operationID, secret)
+				if err != nil {
+					return nil, err
+				}
+				return f(withAPIKey(withRole(ctx, role), key), w, r, args)
+			}
+			withOperator, withAdmin := user != "" && pass != "", adminUser != "" && adminPass != ""
+			if ctxReq.Value(BasicAuthScopes) != nil && (withOperator || withAdmin) {
 				userReq, passReq, ok := r.BasicAuth()
 				if !ok {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
-				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
+				switch {
+				case withAdmin && validCredentials(adminUser, adminPass, userReq, passReq):
+					role = roleAdmin
+				case withOperator && validCredentials(user, pass, userReq, passReq):
+				default:
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
 			}
+			return f(withRole(ctx, role), w, r, args)
+		}
+	}
+}
+
+// HolderAuthMiddleware returns a middleware that protects the holder portal endpoints. Holder endpoints are
+// rejected if the portal is disabled (nil holderPortalService) and the ones that need a holder session are
+// authorized with the bearer token returned when the session was created.
+// It must be the first middleware in the list, as it is evaluated after the basic auth middleware.
+func HolderAuthMiddleware(holderPortalService ports.HolderPortalService) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			needsSession, ok := holderOperations[operationID]
+			if !ok {
+				return f(ctx, w, r, args)
+			}
+			if holderPortalService == nil {
+				return nil, apiErrors.AuthError{Err: errors.New("holder portal is disabled")}
+			}
+			if !needsSession {
+				return f(ctx, w, r, args)
+			}
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+			}
+			session, err := holderPortalService.GetSession(ctx, token)
+			if err != nil {
+				return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+			}
+			return f(withHolderSession(ctx, session), w, r, args)
+		}
+	}
+}
+
+// WalletMiddleware returns a middleware that keeps the user agent and the accept headers of the requests sent by the
+// wallets of the holders, so the handlers can record which wallet sent them.
+// It must be placed before the log and basic auth middlewares in the list, as they replace the request context.
+func WalletMiddleware() StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if walletOperations[operationID] {
+				ctx = withWalletHeaders(ctx, r)
+			}
 			return f(ctx, w, r, args)
 		}
 	}
 }
+
+func authenticateAPIKey(ctx context.Context, r *http.Request, apiKeyService ports.APIKeyService, operationID string, secret string) (*domain.APIKey, error) {
+	ip := forwarded.ClientIP(r)
+	key, err := apiKeyService.Authenticate(ctx, secret, ip)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyUnauthorized) || errors.Is(err, services.ErrAPIKeyIPNotAllowed) {
+			log.Warn(ctx, "audit: api key rejected", "operation", operationID, "ip", ip.String(), "err", err)
+			return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+		}
+		log.Error(ctx, "authenticating api key", "err", err)
+		return nil, err
+	}
+	scope, ok := apiKeyOperations[operationID]
+	if !ok || !key.HasScope(scope) {
+		log.Warn(ctx, "audit: api key without scope for the operation", "apiKey", key.ID, "readOnly", key.ReadOnly, "operation", operationID, "scope", scope, "ip", ip.String())
+		return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+	}
+	log.Info(ctx, "audit: api key request", "apiKey", key.ID, "name", key.Name, "readOnly", key.ReadOnly, "operation", operationID, "scope", scope, "ip", ip.String())
+	return key, nil
+}
+
+func validCredentials(user, pass, userReq, passReq string) bool {
+	return subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) == 1 && subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) == 1
+}
+
+// ClientCertMiddleware returns a middleware that requires a client certificate, validated against the configured CA
+// bundle and subject allowlist, in the endpoints configured with basic auth in the api spec. Public endpoints are
+// served on standard TLS. It does nothing if mutual TLS is not enabled.
+func ClientCertMiddleware(cfg config.TLS) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if ctxReq.Value(BasicAuthScopes) != nil && cfg.MutualTLSEnabled() {
+				if err := mtls.Verify(r, cfg.ClientAllowedSubjects); err != nil {
+					log.Warn(ctxReq, "client certificate rejected", "operation", operationID, "err", err)
+					return nil, apiErrors.AuthError{Err: err}
+				}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package api_ui
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
+)
+
+func TestBasicAuthMiddleware(t *testing.T) {
+	ctx := context.Background()
+	handler := func(ctx context.Context, _ http.ResponseWriter, _ *http.Request, _ interface{}) (interface{}, error) {
+		return roleFromContext(ctx), nil
+	}
+
+	type testConfig struct {
+		name                  string
+		user, pass            string
+		adminUser, adminPass  string
+		reqUser, reqPass      string
+		expectedRole          role
+		expectedUnauthorized  bool
+		withoutAuthentication bool
+	}
+	for _, tc := range []testConfig{
+		{name: "operator", user: "user", pass: "password", adminUser: "admin", adminPass: "secret", reqUser: "user", reqPass: "password", expectedRole: roleOperator},
+		{name: "admin", user: "user", pass: "password", adminUser: "admin", adminPass: "secret", reqUser: "admin", reqPass: "secret", expectedRole: roleAdmin},
+		{name: "wrong credentials", user: "user", pass: "password", adminUser: "admin", adminPass: "secret", reqUser: "admin", reqPass: "password", expectedUnauthorized: true},
+		{name: "admin without operator credentials", adminUser: "admin", adminPass: "secret", reqUser: "admin", reqPass: "secret", expectedRole: roleAdmin},
+		{name: "empty credentials without operator credentials", adminUser: "admin", adminPass: "secret", expectedUnauthorized: true},
+		{name: "no basic auth without operator credentials", adminUser: "admin", adminPass: "secret", withoutAuthentication: true, expectedUnauthorized: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/credentials", nil)
+			if !tc.withoutAuthentication {
+				req.SetBasicAuth(tc.reqUser, tc.reqPass)
+			}
+			ctxReq := context.WithValue(ctx, BasicAuthScopes, []string{})
+			got, err := BasicAuthMiddleware(ctx, tc.user, tc.pass, tc.adminUser, tc.adminPass, nil)(handler, "GetCredentials")(ctxReq, httptest.NewRecorder(), req, nil)
+			if tc.expectedUnauthorized {
+				assert.ErrorAs(t, err, &apiErrors.AuthError{})
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, got)
+		})
+	}
+}
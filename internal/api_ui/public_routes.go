@@ -0,0 +1,78 @@
+package api_ui
+
+import (
+	"net/http"
+
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+// PublicRoutes are the endpoints that are not protected with basic auth, used by holders, wallets and verifiers,
+// and the holder portal endpoints. They get the public CORS and security headers configuration.
+var PublicRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/"},
+	{Method: http.MethodGet, Pattern: "/favicon.ico"},
+	{Method: http.MethodGet, Pattern: "/static/*"},
+	{Method: http.MethodGet, Pattern: "/status"},
+	{Method: http.MethodGet, Pattern: "/v1/sessions/{id}/events"},
+	{Method: http.MethodGet, Pattern: "/v1/authentication/qrcode"},
+	{Method: http.MethodPost, Pattern: "/v1/authentication/callback"},
+	{Method: http.MethodPost, Pattern: "/v1/connections/rebinding"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}/historical"},
+	{Method: http.MethodPost, Pattern: "/v1/credentials/revocation/status/batch"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/{id}/qrcode"},
+	{Method: http.MethodPost, Pattern: "/v1/agent"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store"},
+	{Method: http.MethodGet, Pattern: "/v1/catalog"},
+	{Method: http.MethodGet, Pattern: "/v1/organization-presentation"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store/image"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/links/{id}/qrcode"},
+	{Method: http.MethodPost, Pattern: "/v1/credentials/links/{id}/qrcode"},
+	{Method: http.MethodPost, Pattern: "/v1/credentials/links/callback"},
+	{Method: http.MethodPost, Pattern: "/v1/holder/sessions/{id}"},
+	{Method: http.MethodGet, Pattern: "/v1/holder/credentials"},
+	{Method: http.MethodPost, Pattern: "/v1/holder/credentials/{id}/reissue"},
+	{Method: http.MethodGet, Pattern: "/v1/holder/credentials/{id}/qrcode"},
+	{Method: http.MethodGet, Pattern: "/l/{linkID}"},
+	{Method: http.MethodGet, Pattern: "/l/{linkID}/events"},
+	{Method: http.MethodGet, Pattern: "/.well-known/jwks.json"},
+	{Method: http.MethodGet, Pattern: "/.well-known/did-configuration.json"},
+}
+
+// DocumentRoutes are the public endpoints that serve immutable documents, like the QR bodies. They get the documents
+// cache headers.
+var DocumentRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/favicon.ico"},
+	{Method: http.MethodGet, Pattern: "/static/*"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store"},
+	{Method: http.MethodGet, Pattern: "/v1/qr-store/image"},
+}
+
+// StatusRoutes are the public endpoints that serve the revocation statuses. They get the statuses cache headers.
+var StatusRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}/historical"},
+}
+
+// SignedRoutes are the public endpoints whose responses are signed when the response signing is configured, the
+// revocation statuses
+var SignedRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}"},
+	{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}/historical"},
+	{Method: http.MethodPost, Pattern: "/v1/credentials/revocation/status/batch"},
+}
+
+// MessageRoutes are the endpoints that accept packed messages from wallets. They get the messages limits.
+var MessageRoutes = []httpsecurity.Route{
+	{Method: http.MethodPost, Pattern: "/v1/authentication/callback"},
+	{Method: http.MethodPost, Pattern: "/v1/connections/rebinding"},
+	{Method: http.MethodPost, Pattern: "/v1/agent"},
+	{Method: http.MethodPost, Pattern: "/v1/credentials/links/callback"},
+	{Method: http.MethodPost, Pattern: "/v1/holder/sessions/{id}"},
+}
+
+// StreamingRoutes are the server sent events endpoints. They are kept open, so they have no timeout.
+var StreamingRoutes = []httpsecurity.Route{
+	{Method: http.MethodGet, Pattern: "/v1/sessions/{id}/events"},
+	{Method: http.MethodGet, Pattern: "/l/{linkID}/events"},
+}
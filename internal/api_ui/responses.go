@@ -1,13 +1,23 @@
 package api_ui
 
 import (
+	"context"
+	"fmt"
+	"math/big"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/timeapi"
 	"github.com/polygonid/sh-id-platform/pkg/pagination"
@@ -47,9 +57,37 @@ func (response CustomQrContentResponse) visit(w http.ResponseWriter) error {
 	return err
 }
 
+// SessionEventsResponse streams status events as server sent events.
+type SessionEventsResponse struct {
+	ctx  context.Context
+	poll statusPoller
+}
+
+// NewSessionEventsResponse returns a new SessionEventsResponse that streams the values returned by poll.
+func NewSessionEventsResponse(ctx context.Context, poll statusPoller) *SessionEventsResponse {
+	return &SessionEventsResponse{ctx: ctx, poll: poll}
+}
+
+// VisitGetSessionEventsResponse satisfies the GetSessionEventsResponseObject
+func (response SessionEventsResponse) VisitGetSessionEventsResponse(w http.ResponseWriter) error {
+	return streamStatusEvents(response.ctx, w, response.poll)
+}
+
+func sessionStatusResponse(s *domain.SessionStatus) SessionStatus {
+	resp := SessionStatus{
+		Type:   SessionStatusType(s.Type),
+		Status: SessionStatusStatus(s.Status),
+		QrCode: s.QRCodeLink,
+	}
+	if s.Message != "" {
+		resp.Message = common.ToPointer(s.Message)
+	}
+	return resp
+}
+
 func schemaResponse(s *domain.Schema) Schema {
 	hash, _ := s.Hash.MarshalText()
-	return Schema{
+	resp := Schema{
 		Id:          s.ID.String(),
 		Type:        s.Type,
 		Url:         s.URL,
@@ -59,7 +97,21 @@ func schemaResponse(s *domain.Schema) Schema {
 		Version:     s.Version,
 		Title:       s.Title,
 		Description: s.Description,
+
+		DefaultExpirationDays: s.DefaultExpirationDays,
+		MaxExpirationDays:     s.MaxExpirationDays,
+	}
+	if len(s.UniqueAttributes) > 0 {
+		resp.UniqueAttributes = common.ToPointer(s.UniqueAttributes)
+		resp.UniqueStrategy = common.ToPointer(SchemaUniqueStrategy(s.UniqueStrategy))
+	}
+	if s.SelfRevocation != "" {
+		resp.SelfRevocation = common.ToPointer(SchemaSelfRevocation(s.SelfRevocation))
 	}
+	if len(s.WebhookTemplate) > 0 {
+		resp.WebhookTemplate = common.ToPointer(WebhookTemplate(s.WebhookTemplate))
+	}
+	return resp
 }
 
 func schemaCollectionResponse(schemas []domain.Schema) []Schema {
@@ -113,6 +165,11 @@ func credentialResponse(w3c *verifiable.W3CCredential, credential *domain.Claim)
 		}
 	}
 
+	var ext domain.CredentialExtensions
+	if e, err := credential.GetCredentialExtensions(); err == nil {
+		ext = e
+	}
+
 	return Credential{
 		CredentialSubject: w3c.CredentialSubject,
 		CreatedAt:         TimeUTC(*w3c.IssuanceDate),
@@ -128,6 +185,10 @@ func credentialResponse(w3c *verifiable.W3CCredential, credential *domain.Claim)
 		UserID:            credential.OtherIdentifier,
 		RefreshService:    refreshService,
 		DisplayMethod:     displayService,
+		Evidence:          evidenceResponse(ext.Evidence),
+		TermsOfUse:        termsOfUseResponse(ext.TermsOfUse),
+		ExternalId:        credential.ExternalID,
+		ProfileId:         credential.ProfileID,
 	}
 }
 
@@ -202,23 +263,63 @@ func connectionResponse(conn *domain.Connection, w3cs []*verifiable.W3CCredentia
 	}
 
 	return GetConnectionResponse{
-		CreatedAt:   TimeUTC(conn.CreatedAt),
-		Id:          conn.ID.String(),
-		UserID:      conn.UserDID.String(),
-		IssuerID:    conn.IssuerDID.String(),
-		Credentials: credResp,
+		CreatedAt:      TimeUTC(conn.CreatedAt),
+		Id:             conn.ID.String(),
+		UserID:         conn.UserDID.String(),
+		IssuerID:       conn.IssuerDID.String(),
+		LastVerifiedAt: lastVerifiedAt(conn),
+		DisplayName:    conn.Profile.DisplayName,
+		ExternalId:     conn.Profile.ExternalID,
+		Email:          conn.Profile.Email,
+		Credentials:    credResp,
 	}
 }
 
-func stateTransactionsResponse(states []domain.IdentityState) StateTransactionsResponse {
+func trustStatusResponse(status domain.TrustStatus) TrustStatus {
+	resp := TrustStatus{
+		Trusted:   status.Trusted,
+		Source:    TrustStatusSource(status.Source),
+		CheckedAt: TimeUTC(status.CheckedAt),
+	}
+	if status.Name != "" {
+		resp.Name = common.ToPointer(status.Name)
+	}
+	return resp
+}
+
+func lastVerifiedAt(conn *domain.Connection) *TimeUTC {
+	if conn.LastVerifiedAt == nil {
+		return nil
+	}
+	return common.ToPointer(TimeUTC(*conn.LastVerifiedAt))
+}
+
+func stateTransactionsResponse(states []domain.StateTransaction, currentBlock *big.Int, cfg config.Ethereum) StateTransactionsResponse {
 	stateTransactions := make([]StateTransaction, len(states))
 	for i := range states {
-		stateTransactions[i] = toStateTransaction(states[i])
+		stateTransactions[i] = toStateTransaction(states[i], currentBlock, cfg)
 	}
 	return stateTransactions
 }
 
-func toStateTransaction(state domain.IdentityState) StateTransaction {
+func stateCheckpointsResponse(checkpoints []domain.StateCheckpoint) []StateCheckpoint {
+	resp := make([]StateCheckpoint, len(checkpoints))
+	for i, checkpoint := range checkpoints {
+		resp[i] = StateCheckpoint{
+			Id:         checkpoint.ID,
+			State:      checkpoint.State,
+			AnchorType: StateCheckpointAnchorType(checkpoint.AnchorType),
+			Reference:  checkpoint.Reference,
+			CreatedAt:  TimeUTC(checkpoint.CreatedAt),
+		}
+		if len(checkpoint.Receipt) > 0 {
+			resp[i].Receipt = common.ToPointer(checkpoint.Receipt)
+		}
+	}
+	return resp
+}
+
+func toStateTransaction(state domain.StateTransaction, currentBlock *big.Int, cfg config.Ethereum) StateTransaction {
 	var stateTran, txID string
 	if state.State != nil {
 		stateTran = *state.State
@@ -226,13 +327,88 @@ func toStateTransaction(state domain.IdentityState) StateTransaction {
 	if state.TxID != nil {
 		txID = *state.TxID
 	}
-	return StateTransaction{
-		Id:          state.StateID,
-		PublishDate: TimeUTC(state.ModifiedAt),
-		State:       stateTran,
-		Status:      getTransactionStatus(state.Status),
-		TxID:        txID,
+	resp := StateTransaction{
+		Id:                   state.StateID,
+		PublishDate:          TimeUTC(state.ModifiedAt),
+		State:                stateTran,
+		Status:               getTransactionStatus(state.Status),
+		TxID:                 txID,
+		GasUsed:              state.GasUsed,
+		TxFee:                state.TxFee,
+		TxFeeFiat:            state.TxFeeFiat,
+		TxFeeCurrency:        state.TxFeeCurrency,
+		CredentialIDs:        state.CredentialIDs,
+		RevokedCredentialIDs: state.RevokedCredentialIDs,
+	}
+	if resp.CredentialIDs == nil {
+		resp.CredentialIDs = []uuid.UUID{}
+	}
+	if resp.RevokedCredentialIDs == nil {
+		resp.RevokedCredentialIDs = []uuid.UUID{}
+	}
+	if state.BlockNumber != nil {
+		resp.BlockNumber = common.ToPointer(int64(*state.BlockNumber))
+		if currentBlock != nil {
+			resp.Confirmations = common.ToPointer(currentBlock.Int64() - int64(*state.BlockNumber))
+		}
+	}
+	if len(state.DroppedTxIDs) > 0 {
+		resp.DroppedTxIDs = common.ToPointer(state.DroppedTxIDs)
+	}
+	if state.Status == domain.StatusTransacted {
+		resp.RequiredConfirmations = common.ToPointer(cfg.ConfirmationBlockCount)
 	}
+	if cfg.ExplorerURL != "" && txID != "" {
+		resp.ExplorerURL = common.ToPointer(fmt.Sprintf("%s/tx/%s", strings.TrimSuffix(cfg.ExplorerURL, "/"), txID))
+	}
+	return resp
+}
+
+func pendingStateResponse(pending *domain.PendingState) PendingStateResponse {
+	counts := make(map[string]*PendingStateTypeCount)
+	countFor := func(schemaType string) *PendingStateTypeCount {
+		count, ok := counts[schemaType]
+		if !ok {
+			count = &PendingStateTypeCount{SchemaType: schemaType}
+			counts[schemaType] = count
+		}
+		return count
+	}
+
+	resp := PendingStateResponse{
+		Credentials: make([]PendingStateCredential, len(pending.Credentials)),
+		Revocations: make([]PendingStateRevocation, len(pending.Revocations)),
+	}
+	for i, credential := range pending.Credentials {
+		resp.Credentials[i] = PendingStateCredential{
+			Id:         credential.ID,
+			SchemaType: credential.SchemaType,
+			UserID:     credential.OtherIdentifier,
+		}
+		countFor(credential.SchemaType).Credentials++
+	}
+	for i, revocation := range pending.Revocations {
+		resp.Revocations[i] = PendingStateRevocation{Nonce: uint64(revocation.Nonce)}
+		if revocation.Credential != nil {
+			resp.Revocations[i].CredentialID = common.ToPointer(revocation.Credential.ID)
+			resp.Revocations[i].SchemaType = common.ToPointer(revocation.Credential.SchemaType)
+			countFor(revocation.Credential.SchemaType).Revocations++
+		}
+	}
+
+	resp.Summary = PendingStateSummary{
+		Credentials: len(resp.Credentials),
+		Revocations: len(resp.Revocations),
+		ByType:      make([]PendingStateTypeCount, 0, len(counts)),
+	}
+	for _, count := range counts {
+		resp.Summary.ByType = append(resp.Summary.ByType, *count)
+	}
+	sort.Slice(resp.Summary.ByType, func(i, j int) bool {
+		return resp.Summary.ByType[i].SchemaType < resp.Summary.ByType[j].SchemaType
+	})
+
+	return resp
 }
 
 func getTransactionStatus(status domain.IdentityStatus) StateTransactionStatus {
@@ -301,7 +477,7 @@ func getLinkResponse(link domain.Link) Link {
 		}
 	}
 
-	return Link{
+	resp := Link{
 		Id:                   link.ID,
 		Active:               link.Active,
 		CredentialSubject:    link.CredentialSubject,
@@ -317,7 +493,156 @@ func getLinkResponse(link domain.Link) Link {
 		CredentialExpiration: credentialExpiration,
 		RefreshService:       refreshService,
 		DisplayMethod:        displayMethod,
+		Locale:               link.Locale,
+		ExternalId:           link.ExternalID,
+		PresentationTemplate: link.PresentationTemplate,
+		Payment:              linkPaymentResponse(link.Payment),
+		Prerequisites:        linkPrerequisitesResponse(link.Prerequisites),
+		Restrictions:         linkRestrictionsResponse(link.Restrictions),
+		Throttle:             linkThrottleResponse(link.Throttle),
+	}
+	if link.ResultWebhook != nil {
+		resp.ResultWebhookURL = common.ToPointer(link.ResultWebhook.URL)
+	}
+	if link.CredentialExtensions != nil {
+		resp.Evidence = evidenceResponse(link.CredentialExtensions.Evidence)
+		resp.TermsOfUse = termsOfUseResponse(link.CredentialExtensions.TermsOfUse)
+	}
+	return resp
+}
+
+func evidenceResponse(evidence []domain.Evidence) *[]Evidence {
+	if len(evidence) == 0 {
+		return nil
+	}
+	resp := make([]Evidence, len(evidence))
+	for i, e := range evidence {
+		resp[i] = Evidence(e)
+	}
+	return &resp
+}
+
+func termsOfUseResponse(termsOfUse []domain.TermsOfUse) *[]TermsOfUse {
+	if len(termsOfUse) == 0 {
+		return nil
+	}
+	resp := make([]TermsOfUse, len(termsOfUse))
+	for i, t := range termsOfUse {
+		resp[i] = TermsOfUse(t)
+	}
+	return &resp
+}
+
+func linkRestrictionsResponse(restrictions *domain.LinkRestrictions) *LinkRestrictions {
+	if restrictions.Empty() {
+		return nil
+	}
+	resp := &LinkRestrictions{}
+	if len(restrictions.IPRanges) > 0 {
+		resp.IpRanges = common.ToPointer(restrictions.IPRanges)
 	}
+	if len(restrictions.Countries) > 0 {
+		resp.Countries = common.ToPointer(restrictions.Countries)
+	}
+	return resp
+}
+
+func linkThrottleResponse(throttle *domain.LinkThrottle) *LinkThrottle {
+	if throttle.Empty() {
+		return nil
+	}
+	resp := &LinkThrottle{MaxClaimsPerHour: throttle.MaxClaimsPerHour}
+	if throttle.TimeZone != "" {
+		resp.TimeZone = common.ToPointer(throttle.TimeZone)
+	}
+	if len(throttle.Windows) > 0 {
+		windows := make([]LinkTimeWindow, len(throttle.Windows))
+		for i, w := range throttle.Windows {
+			windows[i] = LinkTimeWindow{Start: w.Start, End: w.End}
+			if len(w.Days) > 0 {
+				days := make([]int, len(w.Days))
+				for j, day := range w.Days {
+					days[j] = int(day)
+				}
+				windows[i].Days = &days
+			}
+		}
+		resp.Windows = &windows
+	}
+	return resp
+}
+
+func linkPrerequisitesResponse(prerequisites []domain.LinkPrerequisite) *[]LinkPrerequisite {
+	if len(prerequisites) == 0 {
+		return nil
+	}
+	resp := make([]LinkPrerequisite, len(prerequisites))
+	for i, p := range prerequisites {
+		proofType := LinkPrerequisiteProofTypeBJJSignature2021
+		if p.CircuitID == circuits.AtomicQueryMTPV2CircuitID {
+			proofType = LinkPrerequisiteProofTypeIden3SparseMerkleTreeProof
+		}
+		resp[i] = LinkPrerequisite{
+			SchemaUrl:      p.SchemaURL,
+			CredentialType: p.CredentialType,
+			ProofType:      &proofType,
+			AllowedIssuers: common.ToPointer(p.AllowedIssuers),
+		}
+	}
+	return &resp
+}
+
+func linkPrerequisiteProofsResponse(proofs []domain.LinkPrerequisiteProof) []LinkPrerequisiteProof {
+	resp := make([]LinkPrerequisiteProof, len(proofs))
+	for i, p := range proofs {
+		resp[i] = LinkPrerequisiteProof{
+			Id:             p.ID,
+			SessionID:      p.SessionID,
+			UserDID:        p.UserDID.String(),
+			SchemaUrl:      p.SchemaURL,
+			CredentialType: p.CredentialType,
+			Proof:          zkProofResponse(p.Proof),
+			CreatedAt:      TimeUTC(p.CreatedAt),
+		}
+	}
+	return resp
+}
+
+// zkProofResponse returns the proof response as it was sent by the holder
+func zkProofResponse(proof protocol.ZeroKnowledgeProofResponse) map[string]interface{} {
+	resp := map[string]interface{}{
+		"id":          proof.ID,
+		"circuitId":   proof.CircuitID,
+		"proof":       proof.Proof,
+		"pub_signals": proof.PubSignals,
+	}
+	if len(proof.VerifiablePresentation) > 0 {
+		resp["vp"] = proof.VerifiablePresentation
+	}
+	return resp
+}
+
+func linkPaymentResponse(payment *domain.LinkPayment) *LinkPayment {
+	if payment == nil {
+		return nil
+	}
+	tokens := make([]PaymentToken, len(payment.Tokens))
+	for i, token := range payment.Tokens {
+		tokens[i] = PaymentToken{Currency: token.Currency}
+		if !token.IsNative() {
+			tokens[i].Address = common.ToPointer(token.Address)
+		}
+	}
+	resp := &LinkPayment{
+		Amount:    payment.Amount,
+		ChainId:   payment.ChainID,
+		Recipient: payment.Recipient,
+		Tokens:    tokens,
+	}
+	if payment.Description != "" {
+		resp.Description = common.ToPointer(payment.Description)
+	}
+	return resp
 }
 
 func getLinkSimpleResponse(link domain.Link) LinkSimple {
@@ -384,3 +709,600 @@ func getRevocationStatusResponse(rs *verifiable.RevocationStatus) RevocationStat
 
 	return response
 }
+
+// revocationStatusBatchResponse returns the tree roots of the state once, all the statuses share them
+func revocationStatusBatchResponse(nonces []uint64, statuses []*verifiable.RevocationStatus, expirationStatuses map[uint64]string) RevocationStatusBatchResponse {
+	resp := RevocationStatusBatchResponse{Statuses: make([]RevocationStatusBatchItem, 0, len(statuses))}
+	for i, rs := range statuses {
+		status := getRevocationStatusResponse(rs)
+		if i == 0 {
+			resp.Issuer = status.Issuer
+		}
+		item := RevocationStatusBatchItem{
+			Nonce:   nonces[i],
+			Revoked: rs.MTP.Existence,
+			Mtp:     status.Mtp,
+		}
+		if expiration, ok := expirationStatuses[nonces[i]]; ok {
+			item.ExpirationStatus = &expiration
+		}
+		resp.Statuses = append(resp.Statuses, item)
+	}
+	return resp
+}
+
+func connectionMergeResponse(merge domain.ConnectionMerge) ConnectionMerge {
+	return ConnectionMerge{
+		Id:                 merge.ID,
+		SourceConnectionID: merge.SourceConnectionID,
+		SourceUserID:       merge.SourceUserDID,
+		TargetConnectionID: merge.TargetConnectionID,
+		Credentials:        merge.Credentials,
+		CreatedAt:          TimeUTC(merge.CreatedAt),
+	}
+}
+
+func connectionDuplicatesResponse(duplicates []domain.ConnectionDuplicates) []ConnectionDuplicates {
+	resp := make([]ConnectionDuplicates, 0, len(duplicates))
+	for _, group := range duplicates {
+		item := ConnectionDuplicates{Reason: group.Reason, Value: group.Value}
+		for i, id := range group.ConnectionIDs {
+			item.Connections = append(item.Connections, struct {
+				Id     uuid.UUID `json:"id"`
+				UserID string    `json:"userID"`
+			}{Id: id, UserID: group.UserDIDs[i]})
+		}
+		resp = append(resp, item)
+	}
+	return resp
+}
+
+func historicalRevocationStatusResponse(state *domain.IdentityState, rs *verifiable.RevocationStatus) HistoricalRevocationStatusResponse {
+	return HistoricalRevocationStatusResponse{
+		Revoked:          rs.MTP.Existence,
+		PublishedState:   publishedStateResponse(state),
+		RevocationStatus: getRevocationStatusResponse(rs),
+	}
+}
+
+// publishedStateResponse uses the block time as publish date. The genesis state is not published in a block, so its
+// creation time is used.
+func publishedStateResponse(state *domain.IdentityState) PublishedState {
+	resp := PublishedState{
+		PublishDate: TimeUTC(state.CreatedAt),
+		TxID:        state.TxID,
+	}
+	if state.State != nil {
+		resp.State = *state.State
+	}
+	if state.BlockTimestamp != nil {
+		resp.PublishDate = TimeUTC(time.Unix(int64(*state.BlockTimestamp), 0))
+	}
+	if state.BlockNumber != nil {
+		resp.BlockNumber = common.ToPointer(int64(*state.BlockNumber))
+	}
+	return resp
+}
+
+func verificationBundleResponse(bundle *domain.VerificationBundle) VerificationBundle {
+	return VerificationBundle{
+		Credential:       bundle.Credential,
+		State:            publishedStateResponse(&bundle.State),
+		RevocationStatus: getRevocationStatusResponse(&bundle.RevocationStatus),
+		RevocationState:  publishedStateResponse(&bundle.RevocationState),
+		ChainID:          int32(bundle.ChainID),
+		StateContract:    bundle.StateContract,
+		Documents:        bundle.Documents,
+		ExportedAt:       TimeUTC(bundle.ExportedAt),
+	}
+}
+
+func credentialVersionsResponse(versions []domain.CredentialVersion) []CredentialVersion {
+	resp := make([]CredentialVersion, len(versions))
+	for i, version := range versions {
+		changes := make([]CredentialSubjectChange, len(version.Changes))
+		for j, change := range version.Changes {
+			changes[j] = CredentialSubjectChange{Attribute: change.Attribute, Before: change.Before, After: change.After}
+		}
+		resp[i] = CredentialVersion{
+			Id:                   version.ID,
+			CredentialId:         version.CredentialID,
+			PreviousCredentialId: version.PreviousCredentialID,
+			Version:              version.Version,
+			Changes:              changes,
+			CreatedAt:            TimeUTC(version.CreatedAt),
+		}
+	}
+	return resp
+}
+
+func credentialDependentsResponse(dependents []domain.CredentialDependent) []CredentialDependent {
+	resp := make([]CredentialDependent, len(dependents))
+	for i, dependent := range dependents {
+		resp[i] = CredentialDependent{
+			Id:             dependent.ID,
+			PrerequisiteId: dependent.PrerequisiteID,
+			SchemaType:     dependent.SchemaType,
+			RevNonce:       uint64(dependent.RevNonce),
+			Depth:          dependent.Depth,
+		}
+	}
+	return resp
+}
+
+func linkBatchResponse(links []domain.Link, serverURL string) CreateLinkBatchResponse {
+	items := make([]LinkBatchItem, len(links))
+	for i, link := range links {
+		items[i] = LinkBatchItem{
+			Id:         link.ID,
+			ExternalId: link.ExternalID,
+			QrCodeUrl:  fmt.Sprintf("%s/v1/credentials/links/%s/qrcode", serverURL, link.ID),
+		}
+	}
+	return CreateLinkBatchResponse{Links: items}
+}
+
+// linkRecipientsResponse maps the recipients of a link. The claim url is the landing page of the link with the code,
+// so it is only returned when the landing page is enabled.
+func linkRecipientsResponse(recipients []domain.LinkRecipient, serverURL string, landingPage bool) []LinkRecipient {
+	resp := make([]LinkRecipient, len(recipients))
+	for i, recipient := range recipients {
+		resp[i] = LinkRecipient{
+			Id:                recipient.ID,
+			Code:              recipient.Code,
+			CredentialSubject: recipient.CredentialSubject,
+			CredentialId:      recipient.ClaimID,
+		}
+		if landingPage {
+			resp[i].ClaimUrl = common.ToPointer(fmt.Sprintf("%s/l/%s?code=%s", serverURL, recipient.LinkID, url.QueryEscape(recipient.Code)))
+		}
+		if recipient.ConsumedAt != nil {
+			resp[i].ConsumedAt = common.ToPointer(TimeUTC(*recipient.ConsumedAt))
+		}
+	}
+	return resp
+}
+
+func campaignResponse(campaign *domain.Campaign) Campaign {
+	resp := Campaign{
+		Id:                 campaign.ID,
+		Name:               campaign.Name,
+		Description:        campaign.Description,
+		Status:             string(campaign.Status(time.Now())),
+		WebhookUrl:         campaign.WebhookURL,
+		Milestones:         campaign.Milestones,
+		NotifiedMilestones: campaign.NotifiedMilestones,
+		LinkIds:            campaign.LinkIDs,
+		Progress: CampaignProgress{
+			Links:             campaign.Progress.Links,
+			Invited:           campaign.Progress.Invited,
+			Claimed:           campaign.Progress.Claimed,
+			Revoked:           campaign.Progress.Revoked,
+			ClaimedPercentage: campaign.Progress.ClaimedPercentage(),
+		},
+		CreatedAt: TimeUTC(campaign.CreatedAt),
+	}
+	if campaign.StartAt != nil {
+		resp.StartAt = common.ToPointer(TimeUTC(*campaign.StartAt))
+	}
+	if campaign.EndAt != nil {
+		resp.EndAt = common.ToPointer(TimeUTC(*campaign.EndAt))
+	}
+	return resp
+}
+
+func revocationRuleResponse(rule *domain.RevocationRule) RevocationRule {
+	resp := RevocationRule{
+		Id:             rule.ID,
+		Name:           rule.Name,
+		SchemaType:     rule.SchemaType,
+		CronExpression: rule.CronExpression,
+		WebhookUrl:     rule.WebhookURL,
+		Enabled:        rule.Enabled,
+		LastRevoked:    rule.LastRevoked,
+		CreatedAt:      TimeUTC(rule.CreatedAt),
+	}
+	if rule.NextRunAt != nil {
+		resp.NextRunAt = common.ToPointer(TimeUTC(*rule.NextRunAt))
+	}
+	if rule.LastRunAt != nil {
+		resp.LastRunAt = common.ToPointer(TimeUTC(*rule.LastRunAt))
+	}
+	return resp
+}
+
+func credentialImportResponse(claimImport *domain.ClaimImport) CredentialImport {
+	return CredentialImport{
+		Id:              claimImport.ID,
+		CredentialId:    claimImport.ClaimID,
+		Source:          claimImport.Source,
+		SourceReference: claimImport.SourceReference,
+		OriginalIssuer:  claimImport.OriginalIssuer,
+		OriginalId:      claimImport.OriginalID,
+		DocumentHash:    claimImport.DocumentHash,
+		ImportedAt:      TimeUTC(claimImport.ImportedAt),
+	}
+}
+
+func credentialPreviewResponse(preview *domain.CredentialPreview) CredentialPreview {
+	resp := CredentialPreview{
+		Id:           preview.ID,
+		CredentialId: preview.CredentialID,
+		SchemaType:   preview.SchemaType,
+		SchemaUrl:    preview.SchemaURL,
+		Issuer:       preview.Issuer,
+		Status:       CredentialPreviewStatus(preview.Status),
+		Attributes:   make([]CredentialPreviewAttribute, len(preview.Attributes)),
+	}
+	if preview.IssuanceDate != nil {
+		resp.IssuanceDate = common.ToPointer(TimeUTC(*preview.IssuanceDate))
+	}
+	if preview.ExpirationDate != nil {
+		resp.ExpirationDate = common.ToPointer(TimeUTC(*preview.ExpirationDate))
+	}
+	for i, attribute := range preview.Attributes {
+		resp.Attributes[i] = CredentialPreviewAttribute{Name: attribute.Name, Value: attribute.Value}
+	}
+	if display := preview.Display; display != nil {
+		resp.Display = &CredentialDisplay{
+			Title:                common.ToPointer(display.Title),
+			Description:          common.ToPointer(display.Description),
+			IssuerName:           common.ToPointer(display.IssuerName),
+			TitleTextColor:       common.ToPointer(display.TitleTextColor),
+			DescriptionTextColor: common.ToPointer(display.DescriptionTextColor),
+			IssuerTextColor:      common.ToPointer(display.IssuerTextColor),
+			BackgroundImageUrl:   common.ToPointer(display.BackgroundImageURL),
+		}
+		resp.Display.Logo = &struct {
+			Alt *string `json:"alt,omitempty"`
+			Uri *string `json:"uri,omitempty"`
+		}{Alt: common.ToPointer(display.Logo.Alt), Uri: common.ToPointer(display.Logo.URI)}
+	}
+	return resp
+}
+
+func revocationNonceRangeResponse(nonceRange *domain.RevocationNonceRange) RevocationNonceRange {
+	return RevocationNonceRange{
+		Id:          nonceRange.ID,
+		Integration: nonceRange.Integration,
+		Start:       uint64(nonceRange.Start),
+		End:         uint64(nonceRange.End),
+		Next:        uint64(nonceRange.Next),
+		CreatedAt:   TimeUTC(nonceRange.CreatedAt),
+	}
+}
+
+func revocationRequestResponse(request *domain.RevocationRequest) RevocationRequest {
+	resp := RevocationRequest{
+		Id:           request.ID,
+		CredentialId: request.CredentialID,
+		UserDID:      request.UserDID.String(),
+		Reason:       request.Reason,
+		Status:       RevocationRequestStatus(request.Status),
+		AutoApproved: request.AutoApproved,
+		Note:         request.Note,
+		CreatedAt:    TimeUTC(request.CreatedAt),
+	}
+	if request.ResolvedAt != nil {
+		resp.ResolvedAt = common.ToPointer(TimeUTC(*request.ResolvedAt))
+	}
+	return resp
+}
+
+func revocationRulePreviewResponse(preview *domain.RevocationRulePreview) RevocationRulePreview {
+	nextRuns := make([]TimeUTC, len(preview.NextRuns))
+	for i, run := range preview.NextRuns {
+		nextRuns[i] = TimeUTC(run)
+	}
+	return RevocationRulePreview{
+		Rule:          revocationRuleResponse(preview.Rule),
+		CredentialIds: preview.CredentialIDs,
+		NextRuns:      nextRuns,
+	}
+}
+
+func walletCompatibilityResponse(profile *domain.WalletProfile) WalletCompatibility {
+	return WalletCompatibility{
+		UserID:             profile.UserDID.String(),
+		UserAgent:          profile.UserAgent,
+		MediaTypes:         profile.MediaTypes,
+		RejectedMediaTypes: profile.RejectedMediaTypes,
+		Accept:             profile.Accept,
+		MessageTypes:       profile.MessageTypes,
+		PreferredMediaType: string(profile.PreferredMediaType()),
+		QrFormat:           string(profile.QRFormat()),
+		SupportsDIDComm:    profile.SupportsDIDComm(),
+		Issues:             profile.Issues(),
+		FirstSeenAt:        TimeUTC(profile.FirstSeenAt),
+		LastSeenAt:         TimeUTC(profile.LastSeenAt),
+	}
+}
+
+func walletUsageResponse(usage []domain.WalletUsage) []WalletUsage {
+	resp := make([]WalletUsage, len(usage))
+	for i, u := range usage {
+		resp[i] = WalletUsage{
+			UserAgent:          u.UserAgent,
+			Connections:        u.Connections,
+			MediaTypes:         u.MediaTypes,
+			RejectedMediaTypes: u.RejectedMediaTypes,
+			Accept:             u.Accept,
+			PreferredMediaType: string(u.PreferredMediaType()),
+			QrFormat:           string(u.QRFormat()),
+			SupportsDIDComm:    u.SupportsDIDComm(),
+			Issues:             u.Issues(),
+			LastSeenAt:         TimeUTC(u.LastSeenAt),
+		}
+	}
+	return resp
+}
+
+func credentialPDFTemplateResponse(template *domain.CredentialPDFTemplate) CredentialPDFTemplate {
+	fields := make([]CredentialPDFField, len(template.Fields))
+	for i, field := range template.Fields {
+		fields[i] = CredentialPDFField{Attribute: field.Attribute, Label: field.Label}
+	}
+	return CredentialPDFTemplate{
+		Title:       template.Title,
+		Header:      template.Header,
+		Footer:      template.Footer,
+		AccentColor: template.AccentColor,
+		Fields:      fields,
+	}
+}
+
+func qrBrandingResponse(branding *domain.QRBranding) QRBranding {
+	resp := QRBranding{
+		ForegroundColor: branding.ForegroundColor,
+		BackgroundColor: branding.BackgroundColor,
+		ErrorCorrection: QRBrandingErrorCorrection(branding.ErrorCorrection),
+	}
+	if len(branding.Logo) > 0 {
+		resp.Logo = &branding.Logo
+	}
+	return resp
+}
+
+func presentationTemplateResponse(template *domain.PresentationTemplate) PresentationTemplate {
+	conditions := make([]PresentationCondition, len(template.Conditions))
+	for i, cond := range template.Conditions {
+		conditions[i] = PresentationCondition{Field: cond.Field, Operator: PresentationOperator(cond.Operator), Value: cond.Value}
+	}
+	disclosedFields := template.DisclosedFields
+	if disclosedFields == nil {
+		disclosedFields = []string{}
+	}
+	proofType := verifiable.BJJSignatureProofType
+	if template.CircuitID == circuits.AtomicQueryMTPV2CircuitID {
+		proofType = verifiable.Iden3SparseMerkleTreeProofType
+	}
+	requests := template.ProofRequests()
+	proofRequests := make([]map[string]interface{}, len(requests))
+	for i, req := range requests {
+		proofRequests[i] = map[string]interface{}{
+			"id":        req.ID,
+			"circuitId": req.CircuitID,
+			"query":     req.Query,
+		}
+	}
+	return PresentationTemplate{
+		Id:              template.ID,
+		Name:            template.Name,
+		Description:     template.Description,
+		SchemaUrl:       template.SchemaURL,
+		CredentialType:  template.CredentialType,
+		ProofType:       string(proofType),
+		AllowedIssuers:  template.AllowedIssuers,
+		Conditions:      conditions,
+		DisclosedFields: disclosedFields,
+		ProofRequests:   proofRequests,
+		CreatedAt:       TimeUTC(template.CreatedAt),
+	}
+}
+
+func credentialLayoutResponse(layout *domain.ClaimLayout) CredentialLayout {
+	slots := make([]CredentialLayoutSlot, len(layout.Slots))
+	for i, slot := range layout.Slots {
+		slots[i] = CredentialLayoutSlot{
+			Name:     slot.Name,
+			Value:    slot.Value.String(),
+			Contents: CredentialLayoutSlotContents(slot.Contents),
+			Field:    slot.Field,
+		}
+	}
+	entries := make([]CredentialLayoutEntry, len(layout.Entries))
+	for i, entry := range layout.Entries {
+		entries[i] = CredentialLayoutEntry{Field: entry.Field, Key: entry.Key.String(), Value: entry.Value.String()}
+	}
+	return CredentialLayout{
+		SchemaHash: layout.SchemaHash,
+		Merklized:  layout.Merklized,
+		Slots:      slots,
+		HIndex:     layout.HIndex.String(),
+		HValue:     layout.HValue.String(),
+		Hash:       layout.Hash.String(),
+		Entries:    entries,
+	}
+}
+
+func issuerProfileResponse(profile *domain.IssuerProfile) IssuerProfile {
+	allowedProofs := make([]string, len(profile.AllowedProofs))
+	for i, proof := range profile.AllowedProofs {
+		allowedProofs[i] = string(proof)
+	}
+	return IssuerProfile{
+		Id:            profile.ID,
+		Did:           profile.DID.String(),
+		Name:          profile.Name,
+		Description:   profile.Description,
+		LogoUrl:       profile.LogoURL,
+		AllowedProofs: allowedProofs,
+		CreatedAt:     TimeUTC(profile.CreatedAt),
+	}
+}
+
+func organizationCredentialResponse(credential *domain.OrganizationCredential) OrganizationCredential {
+	var expiresAt *TimeUTC
+	if credential.Credential.Expiration != nil {
+		expiresAt = common.ToPointer(TimeUTC(*credential.Credential.Expiration))
+	}
+	return OrganizationCredential{
+		Id:         credential.ID,
+		Type:       credential.Type(),
+		Issuer:     credential.Credential.Issuer,
+		Public:     credential.Public,
+		Expired:    credential.Expired(time.Now()),
+		ExpiresAt:  expiresAt,
+		Credential: credential.Document,
+		CreatedAt:  TimeUTC(credential.CreatedAt),
+	}
+}
+
+func organizationPresentationResponse(presentation *domain.OrganizationPresentation) OrganizationPresentation {
+	return OrganizationPresentation{
+		Context:              presentation.Context,
+		Type:                 presentation.Type,
+		Holder:               presentation.Holder,
+		VerifiableCredential: presentation.VerifiableCredential,
+	}
+}
+
+func migrationsStatusResponse(migrations []domain.Migration) MigrationsStatus {
+	return MigrationsStatus{
+		CurrentVersion: domain.CurrentMigrationVersion(migrations),
+		Pending:        domain.PendingMigrations(migrations),
+		Migrations:     migrationsResponse(migrations),
+	}
+}
+
+func migrationsResponse(migrations []domain.Migration) []Migration {
+	res := make([]Migration, len(migrations))
+	for i, m := range migrations {
+		res[i] = Migration{Version: m.Version, Name: m.Name, Applied: m.Applied, AppliedAt: m.AppliedAt}
+		if m.Duration > 0 {
+			res[i].DurationMs = common.ToPointer(m.Duration.Milliseconds())
+		}
+	}
+	return res
+}
+
+func keyMigrationResponse(m *domain.KeyMigration) KeyMigration {
+	steps := make([]KeyMigrationStep, 0, len(domain.KeyMigrationSteps))
+	for _, step := range m.Steps() {
+		steps = append(steps, KeyMigrationStep{Step: KeyMigrationStepStep(step.Step), Status: KeyMigrationStatus(step.Status)})
+	}
+	return KeyMigration{
+		Id:                 m.ID,
+		Step:               string(m.Step),
+		Status:             KeyMigrationStatus(m.Status),
+		Steps:              steps,
+		RevokeOldAuthClaim: m.RevokeOldAuthClaim,
+		OldAuthClaimId:     m.OldAuthClaimID,
+		NewAuthClaimId:     m.NewAuthClaimID,
+		Error:              m.Error,
+		CreatedAt:          TimeUTC(m.CreatedAt),
+		ModifiedAt:         TimeUTC(m.ModifiedAt),
+	}
+}
+
+func publicCatalogResponse(catalog []domain.CatalogEntry, serverURL string, landingPage bool) PublicCatalog {
+	issuer := CatalogIssuer{Did: cfg.APIUI.IssuerDID.String(), Name: cfg.APIUI.IssuerName}
+	if cfg.APIUI.IssuerLogo != "" {
+		issuer.Logo = common.ToPointer(cfg.APIUI.IssuerLogo)
+	}
+
+	credentials := make([]CatalogCredential, len(catalog))
+	for i, entry := range catalog {
+		link := entry.Link
+		credential := CatalogCredential{
+			LinkId:       link.ID,
+			Title:        link.Schema.Title,
+			Description:  link.Schema.Description,
+			SchemaType:   link.Schema.Type,
+			SchemaUrl:    link.Schema.URL,
+			ProofTypes:   getLinkProofs(link),
+			QrCodeUrl:    fmt.Sprintf("%s/v1/credentials/links/%s/qrcode", serverURL, link.ID),
+			Requirements: CatalogRequirements{Payment: linkPaymentResponse(link.Payment)},
+		}
+		if link.Schema.Version != "" {
+			credential.SchemaVersion = common.ToPointer(link.Schema.Version)
+		}
+		if link.ValidUntil != nil {
+			credential.Expiration = common.ToPointer(TimeUTC(*link.ValidUntil))
+		}
+		if link.CredentialExpiration != nil {
+			credential.CredentialExpiration = common.ToPointer(TimeUTC(*link.CredentialExpiration))
+		}
+		if link.MaxIssuance != nil {
+			credential.RemainingIssuance = common.ToPointer(*link.MaxIssuance - link.IssuedClaims)
+		}
+		if landingPage {
+			credential.LandingPageUrl = common.ToPointer(fmt.Sprintf("%s/l/%s", serverURL, link.ID))
+		}
+		if entry.PresentationTemplate != nil {
+			credential.Requirements.Presentation = catalogPresentationRequirement(entry.PresentationTemplate)
+		}
+		credentials[i] = credential
+	}
+
+	return PublicCatalog{Issuer: issuer, Credentials: credentials}
+}
+
+func catalogPresentationRequirement(template *domain.PresentationTemplate) *CatalogPresentationRequirement {
+	resp := presentationTemplateResponse(template)
+	return &CatalogPresentationRequirement{
+		Name:            resp.Name,
+		Description:     resp.Description,
+		SchemaUrl:       resp.SchemaUrl,
+		CredentialType:  resp.CredentialType,
+		AllowedIssuers:  resp.AllowedIssuers,
+		Conditions:      resp.Conditions,
+		DisclosedFields: resp.DisclosedFields,
+	}
+}
+
+func statsResponse(stats *domain.Stats) Stats {
+	return Stats{
+		CredentialsIssued:       stats.CredentialsIssued,
+		CredentialsRevoked:      stats.CredentialsRevoked,
+		ActiveLinks:             stats.ActiveLinks,
+		Connections:             stats.Connections,
+		PendingCredentials:      stats.PendingCredentials,
+		PendingRevocations:      stats.PendingRevocations,
+		PendingStates:           stats.PendingStates,
+		CredentialsIssuedPerDay: dailyCountsResponse(stats.CredentialsIssuedPerDay),
+		ConnectionsPerDay:       dailyCountsResponse(stats.ConnectionsPerDay),
+		FiatCurrency:            stats.FiatCurrency,
+		GasSpendPerMonth:        gasSpendResponse(stats.GasSpendPerMonth),
+	}
+}
+
+func gasSpendResponse(spends []domain.MonthlyGasSpend) []MonthlyGasSpend {
+	resp := make([]MonthlyGasSpend, len(spends))
+	for i, spend := range spends {
+		resp[i] = MonthlyGasSpend{
+			Month:            openapi_types.Date{Time: spend.Month},
+			StateTransitions: spend.StateTransitions,
+			GasUsed:          spend.GasUsed,
+			TxFee:            spend.TxFee,
+			TxFeeFiat:        spend.TxFeeFiat,
+		}
+	}
+	return resp
+}
+
+func dailyCountsResponse(counts []domain.DailyCount) []DailyCount {
+	resp := make([]DailyCount, len(counts))
+	for i, count := range counts {
+		resp[i] = DailyCount{Day: openapi_types.Date{Time: count.Day}, Count: count.Count}
+	}
+	return resp
+}
+
+func translationResponse(bundle *domain.TranslationBundle) Translation {
+	return Translation{
+		Locale:     bundle.Locale,
+		Messages:   bundle.Messages,
+		ModifiedAt: TimeUTC(bundle.ModifiedAt),
+	}
+}
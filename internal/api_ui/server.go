@@ -1,9 +1,12 @@
 package api_ui
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/url"
 	"os"
@@ -11,20 +14,26 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/forwarded"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/sqltools"
+	"github.com/polygonid/sh-id-platform/internal/tsclient"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
 	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
@@ -32,31 +41,131 @@ import (
 // Server implements StrictServerInterface and holds the implementation of all API controllers
 // This is the glue to the API autogenerated code
 type Server struct {
-	cfg                *config.Configuration
-	identityService    ports.IdentityService
-	claimService       ports.ClaimsService
-	schemaService      ports.SchemaService
-	connectionsService ports.ConnectionsService
-	linkService        ports.LinkService
-	qrService          ports.QrStoreService
-	publisherGateway   ports.Publisher
-	packageManager     *iden3comm.PackageManager
-	health             *health.Status
+	cfg                         *config.Configuration
+	identityService             ports.IdentityService
+	claimService                ports.ClaimsService
+	schemaService               ports.SchemaService
+	connectionsService          ports.ConnectionsService
+	linkService                 ports.LinkService
+	qrService                   ports.QrStoreService
+	publisherGateway            ports.Publisher
+	packageManager              *iden3comm.PackageManager
+	health                      *health.Status
+	holderPortal                ports.HolderPortalService
+	qrBrandingService           ports.QRBrandingService
+	sessionStatusService        ports.SessionStatusService
+	transactionService          ports.TransactionService
+	apiKeyService               ports.APIKeyService
+	authAttemptsService         ports.AuthAttemptsService
+	presentationTemplateService ports.PresentationTemplateService
+	statsService                ports.StatsService
+	verificationBundleService   ports.VerificationBundleService
+	translationService          ports.TranslationService
+	invitationService           ports.InvitationService
+	issuerProfileService        ports.IssuerProfileService
+	migrationsService           ports.MigrationsService
+	credentialPDFService        ports.CredentialPDFService
+	campaignService             ports.CampaignService
+	walletService               ports.WalletService
+	revocationRuleService       ports.RevocationRuleService
+	keyMigrationService         ports.KeyMigrationService
+	organizationCredentials     ports.OrganizationCredentialService
+	trustRegistryService        ports.TrustRegistryService
+	revocationRequestService    ports.RevocationRequestService
+	connectionRebindingService  ports.ConnectionRebindingService
+	stateCheckpointService      ports.StateCheckpointService
+	connectionMergeService      ports.ConnectionMergeService
+	connectionPruningService    ports.ConnectionPruningService
+	revocationCascadeService    ports.RevocationCascadeService
+	claimArchivalService        ports.ClaimArchivalService
+	revocationNonceService      ports.RevocationNonceService
+	claimImportService          ports.ClaimImportService
+}
+
+// Dependencies are the services the Server relies on. The ones not needed by the enabled features can be nil.
+type Dependencies struct {
+	IdentityService             ports.IdentityService
+	ClaimsService               ports.ClaimsService
+	SchemaService               ports.SchemaService
+	ConnectionsService          ports.ConnectionsService
+	LinkService                 ports.LinkService
+	QrService                   ports.QrStoreService
+	PublisherGateway            ports.Publisher
+	PackageManager              *iden3comm.PackageManager
+	Health                      *health.Status
+	HolderPortal                ports.HolderPortalService
+	QRBrandingService           ports.QRBrandingService
+	SessionStatusService        ports.SessionStatusService
+	TransactionService          ports.TransactionService
+	APIKeyService               ports.APIKeyService
+	AuthAttemptsService         ports.AuthAttemptsService
+	PresentationTemplateService ports.PresentationTemplateService
+	StatsService                ports.StatsService
+	VerificationBundleService   ports.VerificationBundleService
+	TranslationService          ports.TranslationService
+	InvitationService           ports.InvitationService
+	IssuerProfileService        ports.IssuerProfileService
+	MigrationsService           ports.MigrationsService
+	CredentialPDFService        ports.CredentialPDFService
+	CampaignService             ports.CampaignService
+	WalletService               ports.WalletService
+	OrganizationCredentials     ports.OrganizationCredentialService
+	TrustRegistryService        ports.TrustRegistryService
+	RevocationRuleService       ports.RevocationRuleService
+	KeyMigrationService         ports.KeyMigrationService
+	RevocationRequestService    ports.RevocationRequestService
+	ConnectionRebindingService  ports.ConnectionRebindingService
+	StateCheckpointService      ports.StateCheckpointService
+	ConnectionMergeService      ports.ConnectionMergeService
+	ConnectionPruningService    ports.ConnectionPruningService
+	RevocationCascadeService    ports.RevocationCascadeService
+	ClaimArchivalService        ports.ClaimArchivalService
+	RevocationNonceService      ports.RevocationNonceService
+	ClaimImportService          ports.ClaimImportService
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, claimsService ports.ClaimsService, schemaService ports.SchemaService, connectionsService ports.ConnectionsService, linkService ports.LinkService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status) *Server {
+func NewServer(cfg *config.Configuration, deps Dependencies) *Server {
 	return &Server{
-		cfg:                cfg,
-		identityService:    identityService,
-		claimService:       claimsService,
-		schemaService:      schemaService,
-		connectionsService: connectionsService,
-		linkService:        linkService,
-		qrService:          qrService,
-		publisherGateway:   publisherGateway,
-		packageManager:     packageManager,
-		health:             health,
+		cfg:                         cfg,
+		identityService:             deps.IdentityService,
+		claimService:                deps.ClaimsService,
+		schemaService:               deps.SchemaService,
+		connectionsService:          deps.ConnectionsService,
+		linkService:                 deps.LinkService,
+		qrService:                   deps.QrService,
+		publisherGateway:            deps.PublisherGateway,
+		packageManager:              deps.PackageManager,
+		health:                      deps.Health,
+		holderPortal:                deps.HolderPortal,
+		qrBrandingService:           deps.QRBrandingService,
+		sessionStatusService:        deps.SessionStatusService,
+		transactionService:          deps.TransactionService,
+		apiKeyService:               deps.APIKeyService,
+		authAttemptsService:         deps.AuthAttemptsService,
+		presentationTemplateService: deps.PresentationTemplateService,
+		statsService:                deps.StatsService,
+		verificationBundleService:   deps.VerificationBundleService,
+		translationService:          deps.TranslationService,
+		invitationService:           deps.InvitationService,
+		issuerProfileService:        deps.IssuerProfileService,
+		migrationsService:           deps.MigrationsService,
+		credentialPDFService:        deps.CredentialPDFService,
+		campaignService:             deps.CampaignService,
+		walletService:               deps.WalletService,
+		organizationCredentials:     deps.OrganizationCredentials,
+		trustRegistryService:        deps.TrustRegistryService,
+		revocationRuleService:       deps.RevocationRuleService,
+		keyMigrationService:         deps.KeyMigrationService,
+		revocationRequestService:    deps.RevocationRequestService,
+		connectionRebindingService:  deps.ConnectionRebindingService,
+		stateCheckpointService:      deps.StateCheckpointService,
+		connectionMergeService:      deps.ConnectionMergeService,
+		connectionPruningService:    deps.ConnectionPruningService,
+		revocationCascadeService:    deps.RevocationCascadeService,
+		claimArchivalService:        deps.ClaimArchivalService,
+		revocationNonceService:      deps.RevocationNonceService,
+		claimImportService:          deps.ClaimImportService,
 	}
 }
 
@@ -73,6 +182,135 @@ func (s *Server) GetSchema(ctx context.Context, request GetSchemaRequestObject)
 	return GetSchema200JSONResponse(schemaResponse(schema)), nil
 }
 
+// UpdateSchema replaces the expiration settings of a schema
+func (s *Server) UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error) {
+	schema, err := s.schemaService.UpdateExpiration(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.DefaultExpirationDays, request.Body.MaxExpirationDays)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateSchema404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		if errors.Is(err, services.ErrSchemaInvalidExpiration) {
+			return UpdateSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating schema", "err", err, "id", request.Id)
+		return UpdateSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: schema expiration updated", "id", request.Id, "defaultExpirationDays", request.Body.DefaultExpirationDays, "maxExpirationDays", request.Body.MaxExpirationDays)
+	return UpdateSchema200JSONResponse(schemaResponse(schema)), nil
+}
+
+// UpdateSchemaUniqueness replaces the unique attributes of a schema and the strategy applied to the duplicates
+func (s *Server) UpdateSchemaUniqueness(ctx context.Context, request UpdateSchemaUniquenessRequestObject) (UpdateSchemaUniquenessResponseObject, error) {
+	var strategy domain.SchemaUniqueStrategy
+	if request.Body.Strategy != nil {
+		strategy = domain.SchemaUniqueStrategy(*request.Body.Strategy)
+	}
+	schema, err := s.schemaService.UpdateUniqueness(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Attributes, strategy)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateSchemaUniqueness404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		if errors.Is(err, services.ErrSchemaInvalidUniqueness) {
+			return UpdateSchemaUniqueness400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating schema uniqueness", "err", err, "id", request.Id)
+		return UpdateSchemaUniqueness500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: schema uniqueness updated", "id", request.Id, "attributes", schema.UniqueAttributes, "strategy", schema.UniqueStrategy)
+	return UpdateSchemaUniqueness200JSONResponse(schemaResponse(schema)), nil
+}
+
+// UpdateSchemaSelfRevocation sets how the revocation requests of the holders for the credentials of a schema are handled
+func (s *Server) UpdateSchemaSelfRevocation(ctx context.Context, request UpdateSchemaSelfRevocationRequestObject) (UpdateSchemaSelfRevocationResponseObject, error) {
+	schema, err := s.schemaService.UpdateSelfRevocation(ctx, s.cfg.APIUI.IssuerDID, request.Id, domain.SchemaSelfRevocation(request.Body.Policy))
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateSchemaSelfRevocation404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		if errors.Is(err, services.ErrSchemaInvalidSelfRevocation) {
+			return UpdateSchemaSelfRevocation400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating schema self revocation", "err", err, "id", request.Id)
+		return UpdateSchemaSelfRevocation500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: schema self revocation updated", "id", request.Id, "policy", schema.SelfRevocationPolicy())
+	return UpdateSchemaSelfRevocation200JSONResponse(schemaResponse(schema)), nil
+}
+
+// UpdateSchemaWebhookTemplate sets the shape of the bodies posted to the webhooks about the credentials of a schema.
+// A request without template removes it.
+func (s *Server) UpdateSchemaWebhookTemplate(ctx context.Context, request UpdateSchemaWebhookTemplateRequestObject) (UpdateSchemaWebhookTemplateResponseObject, error) {
+	var template domain.WebhookTemplate
+	if request.Body.Template != nil {
+		template = domain.WebhookTemplate(*request.Body.Template)
+	}
+	schema, err := s.schemaService.UpdateWebhookTemplate(ctx, s.cfg.APIUI.IssuerDID, request.Id, template)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateSchemaWebhookTemplate404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		if errors.Is(err, services.ErrSchemaInvalidWebhookTemplate) {
+			return UpdateSchemaWebhookTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating schema webhook template", "err", err, "id", request.Id)
+		return UpdateSchemaWebhookTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: schema webhook template updated", "id", request.Id, "removed", len(schema.WebhookTemplate) == 0)
+	return UpdateSchemaWebhookTemplate200JSONResponse(schemaResponse(schema)), nil
+}
+
+// GetCredentialPDFTemplate returns the template used to render the credentials of a schema as PDF
+func (s *Server) GetCredentialPDFTemplate(ctx context.Context, request GetCredentialPDFTemplateRequestObject) (GetCredentialPDFTemplateResponseObject, error) {
+	template, err := s.credentialPDFService.GetTemplate(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return GetCredentialPDFTemplate404JSONResponse{N404JSONResponse{"schema not found"}}, nil
+		}
+		log.Error(ctx, "getting credential pdf template", "err", err, "id", request.Id)
+		return GetCredentialPDFTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetCredentialPDFTemplate200JSONResponse(credentialPDFTemplateResponse(template)), nil
+}
+
+// UpdateCredentialPDFTemplate sets the template used to render the credentials of a schema as PDF
+func (s *Server) UpdateCredentialPDFTemplate(ctx context.Context, request UpdateCredentialPDFTemplateRequestObject) (UpdateCredentialPDFTemplateResponseObject, error) {
+	template := &domain.CredentialPDFTemplate{
+		IssuerDID:   s.cfg.APIUI.IssuerDID,
+		SchemaID:    request.Id,
+		Title:       request.Body.Title,
+		Header:      request.Body.Header,
+		Footer:      request.Body.Footer,
+		AccentColor: request.Body.AccentColor,
+		Fields:      make([]domain.CredentialPDFField, len(request.Body.Fields)),
+	}
+	for i, field := range request.Body.Fields {
+		template.Fields[i] = domain.CredentialPDFField{Attribute: field.Attribute, Label: field.Label}
+	}
+	if err := s.credentialPDFService.SaveTemplate(ctx, template); err != nil {
+		if errors.Is(err, services.ErrCredentialPDFTemplateInvalid) {
+			return UpdateCredentialPDFTemplate400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateCredentialPDFTemplate404JSONResponse{N404JSONResponse{"schema not found"}}, nil
+		}
+		log.Error(ctx, "saving credential pdf template", "err", err, "id", request.Id)
+		return UpdateCredentialPDFTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return UpdateCredentialPDFTemplate200JSONResponse(credentialPDFTemplateResponse(template)), nil
+}
+
+// DeleteCredentialPDFTemplate removes the template of a schema, so the default one is used
+func (s *Server) DeleteCredentialPDFTemplate(ctx context.Context, request DeleteCredentialPDFTemplateRequestObject) (DeleteCredentialPDFTemplateResponseObject, error) {
+	if err := s.credentialPDFService.DeleteTemplate(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrCredentialPDFTemplateNotFound) {
+			return DeleteCredentialPDFTemplate404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential pdf template", "err", err, "id", request.Id)
+		return DeleteCredentialPDFTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return DeleteCredentialPDFTemplate200JSONResponse{Message: "Credential pdf template deleted"}, nil
+}
+
 // GetSchemas returns the list of schemas that match the request.Params.Query filter. If param query is nil it will return all
 func (s *Server) GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error) {
 	col, err := s.schemaService.GetAll(ctx, s.cfg.APIUI.IssuerDID, request.Params.Query)
@@ -97,8 +335,19 @@ func (s *Server) ImportSchema(ctx context.Context, request ImportSchemaRequestOb
 		return ImportSchema400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("bad request: %s", err.Error())}}, nil
 	}
 	iReq := ports.NewImportSchemaRequest(req.Url, req.SchemaType, req.Title, req.Version, req.Description)
+	iReq.DefaultExpirationDays = req.DefaultExpirationDays
+	iReq.MaxExpirationDays = req.MaxExpirationDays
+	if req.UniqueAttributes != nil {
+		iReq.UniqueAttributes = *req.UniqueAttributes
+	}
+	if req.UniqueStrategy != nil {
+		iReq.UniqueStrategy = domain.SchemaUniqueStrategy(*req.UniqueStrategy)
+	}
 	schema, err := s.schemaService.ImportSchema(ctx, s.cfg.APIUI.IssuerDID, iReq)
 	if err != nil {
+		if errors.Is(err, services.ErrSchemaInvalidExpiration) || errors.Is(err, services.ErrSchemaInvalidUniqueness) {
+			return ImportSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		log.Error(ctx, "Importing schema", "err", err, "req", req)
 		return ImportSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
@@ -138,11 +387,31 @@ func (s *Server) AuthCallback(ctx context.Context, request AuthCallbackRequestOb
 		return AuthCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
 	}
 
-	_, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.cfg.APIUI.ServerURL, s.cfg.APIUI.IssuerDID)
+	if msg, ok := s.checkAuthAttempt(ctx, request.Params.SessionID); !ok {
+		return AuthCallback400JSONResponse{N400JSONResponse{msg}}, nil
+	}
+
+	arm, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.serverURL(ctx), s.cfg.APIUI.IssuerDID)
+	s.recordAuthAttempt(ctx, request.Params.SessionID, err)
+	if errors.Is(err, services.ErrUntrustedHolder) || errors.Is(err, services.ErrUntrustedIssuer) || errors.Is(err, services.ErrConnectionDenied) {
+		return AuthCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
 	if err != nil {
 		log.Debug(ctx, "error authenticating", err.Error())
 		return AuthCallback500JSONResponse{}, nil
 	}
+	s.observeAuthWallet(ctx, *request.Body, arm)
+
+	if s.connectionRebindingService != nil {
+		_, err := s.connectionRebindingService.Complete(ctx, s.cfg.APIUI.IssuerDID, request.Params.SessionID, s.cfg.CredentialStatus.CredentialStatusType)
+		if errors.Is(err, services.ErrConnectionRebindingCodeInvalid) || errors.Is(err, services.ErrConnectionRebindingSameDID) || errors.Is(err, services.ErrConnectionRebindingTargetInUse) {
+			return AuthCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if err != nil {
+			log.Error(ctx, "rebinding connection", "err", err, "session", request.Params.SessionID)
+			return AuthCallback500JSONResponse{}, nil
+		}
+	}
 
 	return AuthCallback200Response{}, nil
 }
@@ -160,18 +429,39 @@ func (s *Server) GetAuthenticationConnection(ctx context.Context, req GetAuthent
 
 	return GetAuthenticationConnection200JSONResponse{
 		Connection: AuthenticationConnection{
-			Id:         conn.ID.String(),
-			UserID:     conn.UserDID.String(),
-			IssuerID:   conn.IssuerDID.String(),
-			CreatedAt:  TimeUTC(conn.CreatedAt),
-			ModifiedAt: TimeUTC(conn.ModifiedAt),
+			Id:             conn.ID.String(),
+			UserID:         conn.UserDID.String(),
+			IssuerID:       conn.IssuerDID.String(),
+			CreatedAt:      TimeUTC(conn.CreatedAt),
+			ModifiedAt:     TimeUTC(conn.ModifiedAt),
+			LastVerifiedAt: lastVerifiedAt(conn),
 		},
 	}, nil
 }
 
+// GetSessionEvents streams the status of an authentication session, a link session or a credential fetch
+func (s *Server) GetSessionEvents(ctx context.Context, request GetSessionEventsRequestObject) (GetSessionEventsResponseObject, error) {
+	if _, err := s.sessionStatusService.Get(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			return GetSessionEvents404JSONResponse{N404JSONResponse{Message: "session not found"}}, nil
+		}
+		log.Error(ctx, "get session events", "err", err, "id", request.Id)
+		return GetSessionEvents500JSONResponse{N500JSONResponse{Message: "Unexpected error while getting session status"}}, nil
+	}
+
+	return NewSessionEventsResponse(ctx, func(ctx context.Context) (any, string) {
+		status, err := s.sessionStatusService.Get(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+		if err != nil {
+			log.Debug(ctx, "get session events. Getting status", "err", err, "id", request.Id)
+			return SessionStatus{Status: SessionStatusStatusError, Message: common.ToPointer("session not found or expired")}, link_state.StatusError
+		}
+		return sessionStatusResponse(status), status.Status
+	}), nil
+}
+
 // AuthQRCode returns the qr code for authenticating a user
 func (s *Server) AuthQRCode(ctx context.Context, req AuthQRCodeRequestObject) (AuthQRCodeResponseObject, error) {
-	resp, err := s.identityService.CreateAuthenticationQRCode(ctx, s.cfg.APIUI.ServerURL, s.cfg.APIUI.IssuerDID)
+	resp, err := s.identityService.CreateAuthenticationQRCode(ctx, s.serverURL(ctx), s.cfg.APIUI.IssuerDID)
 	if err != nil {
 		return AuthQRCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
 	}
@@ -192,11 +482,213 @@ func (s *Server) AuthQRCode(ctx context.Context, req AuthQRCodeRequestObject) (A
 	}, nil
 }
 
+// GetConnectionReAuthQRCode returns a qr code addressed to the user of the given connection, so the holder
+// can prove that still controls the connection DID.
+func (s *Server) GetConnectionReAuthQRCode(ctx context.Context, req GetConnectionReAuthQRCodeRequestObject) (GetConnectionReAuthQRCodeResponseObject, error) {
+	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, req.Id, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionReAuthQRCode404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection re-authentication qr code, getting connection", "err", err, "req", req)
+		return GetConnectionReAuthQRCode500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
+	}
+
+	resp, err := s.identityService.CreateReAuthenticationQRCode(ctx, s.serverURL(ctx), s.cfg.APIUI.IssuerDID, conn.UserDID)
+	if err != nil {
+		log.Error(ctx, "get connection re-authentication qr code", "err", err, "req", req)
+		return GetConnectionReAuthQRCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+	}
+	if req.Params.Type != nil && *req.Params.Type == GetConnectionReAuthQRCodeParamsTypeRaw {
+		body, err := s.qrService.Find(ctx, resp.QrID)
+		if err != nil {
+			log.Error(ctx, "qr store. Finding qr", "err", err, "QrID", resp.QrID)
+			return GetConnectionReAuthQRCode500JSONResponse{N500JSONResponse{"error looking for qr body"}}, nil
+		}
+		return GetConnectionReAuthQRCode200JSONResponse{
+			QrCodeLink: string(body),
+			SessionID:  resp.SessionID.String(),
+		}, nil
+	}
+	return GetConnectionReAuthQRCode200JSONResponse{
+		QrCodeLink: resp.QRCodeURL,
+		SessionID:  resp.SessionID.String(),
+	}, nil
+}
+
+// CreateConnectionRebindingCode creates a recovery code for the holder of the connection
+func (s *Server) CreateConnectionRebindingCode(ctx context.Context, request CreateConnectionRebindingCodeRequestObject) (CreateConnectionRebindingCodeResponseObject, error) {
+	code, err := s.connectionRebindingService.CreateCode(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return CreateConnectionRebindingCode404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "creating connection rebinding code", "err", err, "connection", request.Id)
+		return CreateConnectionRebindingCode500JSONResponse{N500JSONResponse{"There was an error creating the rebinding code"}}, nil
+	}
+	return CreateConnectionRebindingCode201JSONResponse{Id: code.ID, Code: code.Code, ExpiresAt: TimeUTC(code.ExpiresAt)}, nil
+}
+
+// RedeemConnectionRebindingCode returns the authentication request for the new DID of the holder of a rebinding code
+func (s *Server) RedeemConnectionRebindingCode(ctx context.Context, request RedeemConnectionRebindingCodeRequestObject) (RedeemConnectionRebindingCodeResponseObject, error) {
+	if request.Body == nil || request.Body.Code == "" {
+		return RedeemConnectionRebindingCode400JSONResponse{N400JSONResponse{"code is required"}}, nil
+	}
+	resp, err := s.connectionRebindingService.Redeem(ctx, s.cfg.APIUI.IssuerDID, request.Body.Code, s.serverURL(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionRebindingCodeInvalid) {
+			return RedeemConnectionRebindingCode400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "redeeming connection rebinding code", "err", err)
+		return RedeemConnectionRebindingCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+	}
+	return RedeemConnectionRebindingCode200JSONResponse{
+		QrCodeLink: resp.QRCodeURL,
+		SessionID:  resp.SessionID.String(),
+	}, nil
+}
+
+// GetConnectionDuplicates returns the groups of connections that likely belong to the same holder
+func (s *Server) GetConnectionDuplicates(ctx context.Context, _ GetConnectionDuplicatesRequestObject) (GetConnectionDuplicatesResponseObject, error) {
+	duplicates, err := s.connectionMergeService.GetDuplicates(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get duplicate connections", "err", err)
+		return GetConnectionDuplicates500JSONResponse{N500JSONResponse{"There was an error retrieving the duplicate connections"}}, nil
+	}
+	return GetConnectionDuplicates200JSONResponse(connectionDuplicatesResponse(duplicates)), nil
+}
+
+// GetDeadConnections returns the connections without active credentials nor activity of the holder
+func (s *Server) GetDeadConnections(ctx context.Context, request GetDeadConnectionsRequestObject) (GetDeadConnectionsResponseObject, error) {
+	inactiveDays := s.cfg.ConnectionPruning.InactiveDays
+	if request.Params.InactiveDays != nil {
+		inactiveDays = *request.Params.InactiveDays
+	}
+	limit := 50
+	if request.Params.MaxResults != nil && *request.Params.MaxResults > 0 {
+		limit = int(*request.Params.MaxResults)
+	}
+	dead, err := s.connectionPruningService.GetDead(ctx, s.cfg.APIUI.IssuerDID, inactiveDays, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionPruningInactiveDays) {
+			return GetDeadConnections400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "get dead connections", "err", err)
+		return GetDeadConnections500JSONResponse{N500JSONResponse{"There was an error retrieving the dead connections"}}, nil
+	}
+	resp := make(GetDeadConnections200JSONResponse, 0, len(dead))
+	for _, connection := range dead {
+		resp = append(resp, DeadConnection{
+			Id:             connection.ID,
+			UserID:         connection.UserDID,
+			Credentials:    connection.Credentials,
+			LastActivityAt: TimeUTC(connection.LastActivityAt),
+		})
+	}
+	return resp, nil
+}
+
+// PruneConnections archives or deletes a batch of dead connections
+func (s *Server) PruneConnections(ctx context.Context, request PruneConnectionsRequestObject) (PruneConnectionsResponseObject, error) {
+	if request.Body == nil {
+		return PruneConnections400JSONResponse{N400JSONResponse{"action is required"}}, nil
+	}
+	req := ports.ConnectionPruneRequest{
+		InactiveDays: s.cfg.ConnectionPruning.InactiveDays,
+		Action:       request.Body.Action,
+		BatchSize:    s.cfg.ConnectionPruning.BatchSize,
+	}
+	if request.Body.InactiveDays != nil {
+		req.InactiveDays = *request.Body.InactiveDays
+	}
+	if request.Body.BatchSize != nil {
+		req.BatchSize = *request.Body.BatchSize
+	}
+	ids, err := s.connectionPruningService.Prune(ctx, s.cfg.APIUI.IssuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionPruningInactiveDays) || errors.Is(err, services.ErrConnectionPruningAction) || errors.Is(err, services.ErrConnectionPruningBatchSize) {
+			return PruneConnections400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return PruneConnections500JSONResponse{N500JSONResponse{"There was an error pruning the dead connections"}}, nil
+	}
+	return PruneConnections200JSONResponse{Action: req.Action, Connections: ids}, nil
+}
+
+// MergeConnection merges a duplicate connection of the holder into the connection of the request
+func (s *Server) MergeConnection(ctx context.Context, request MergeConnectionRequestObject) (MergeConnectionResponseObject, error) {
+	if request.Body == nil || request.Body.ConnectionID == uuid.Nil {
+		return MergeConnection400JSONResponse{N400JSONResponse{"connectionID is required"}}, nil
+	}
+	merge, err := s.connectionMergeService.Merge(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.ConnectionID, s.cfg.CredentialStatus.CredentialStatusType)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionMergeSameConnection) {
+			return MergeConnection400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return MergeConnection404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "merging connections", "err", err, "connection", request.Id, "duplicate", request.Body.ConnectionID)
+		return MergeConnection500JSONResponse{N500JSONResponse{"There was an error merging the connections"}}, nil
+	}
+	return MergeConnection200JSONResponse(connectionMergeResponse(*merge)), nil
+}
+
+// GetConnectionMerges returns the connections merged into the connection
+func (s *Server) GetConnectionMerges(ctx context.Context, request GetConnectionMergesRequestObject) (GetConnectionMergesResponseObject, error) {
+	merges, err := s.connectionMergeService.GetMerges(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionMerges404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection merges", "err", err, "connection", request.Id)
+		return GetConnectionMerges500JSONResponse{N500JSONResponse{"There was an error retrieving the connection merges"}}, nil
+	}
+	resp := make(GetConnectionMerges200JSONResponse, 0, len(merges))
+	for _, merge := range merges {
+		resp = append(resp, connectionMergeResponse(merge))
+	}
+	return resp, nil
+}
+
+// GetConnectionWallet returns what the issuer learned about the wallet of the holder of the connection
+func (s *Server) GetConnectionWallet(ctx context.Context, request GetConnectionWalletRequestObject) (GetConnectionWalletResponseObject, error) {
+	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, request.Id, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionWallet404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection wallet", "err", err, "id", request.Id)
+		return GetConnectionWallet500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
+	}
+	profile, err := s.walletService.Get(ctx, s.cfg.APIUI.IssuerDID, conn.UserDID)
+	if err != nil {
+		if errors.Is(err, services.ErrWalletProfileNotFound) {
+			return GetConnectionWallet404JSONResponse{N404JSONResponse{"The wallet of the connection has not sent any message yet"}}, nil
+		}
+		log.Error(ctx, "get connection wallet", "err", err, "id", request.Id)
+		return GetConnectionWallet500JSONResponse{N500JSONResponse{"There was an error retrieving the wallet of the connection"}}, nil
+	}
+	return GetConnectionWallet200JSONResponse(walletCompatibilityResponse(profile)), nil
+}
+
+// GetWalletCompatibilityReport returns the wallets used by the holders of the issuer grouped by user agent
+func (s *Server) GetWalletCompatibilityReport(ctx context.Context, _ GetWalletCompatibilityReportRequestObject) (GetWalletCompatibilityReportResponseObject, error) {
+	usage, err := s.walletService.GetUsage(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get wallet compatibility report", "err", err)
+		return GetWalletCompatibilityReport500JSONResponse{N500JSONResponse{"There was an error retrieving the wallets"}}, nil
+	}
+	return GetWalletCompatibilityReport200JSONResponse(walletUsageResponse(usage)), nil
+}
+
 // GetConnection returns a connection with its related credentials
 func (s *Server) GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error) {
 	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, request.Id, s.cfg.APIUI.IssuerDID)
 	if err != nil {
 		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			if apiversion.IsV2(ctx) {
+				return GetConnection404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+			}
 			return GetConnection400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
 		}
 		log.Debug(ctx, "get connection internal server error", "err", err, "req", request)
@@ -218,7 +710,12 @@ func (s *Server) GetConnection(ctx context.Context, request GetConnectionRequest
 		return GetConnection500JSONResponse{N500JSONResponse{"There was an error parsing the credential of the given connection"}}, nil
 	}
 
-	return GetConnection200JSONResponse(connectionResponse(conn, w3credentials, credentials)), nil
+	resp := connectionResponse(conn, w3credentials, credentials)
+	if s.trustRegistryService != nil {
+		resp.Trust = common.ToPointer(trustStatusResponse(s.trustRegistryService.Check(ctx, conn.UserDID.String())))
+	}
+
+	return GetConnection200JSONResponse(s.maskConnection(ctx, resp)), nil
 }
 
 // GetConnections returns the list of credentials of a determined issuer
@@ -240,10 +737,36 @@ func (s *Server) GetConnections(ctx context.Context, request GetConnectionsReque
 		return GetConnections500JSONResponse{N500JSONResponse{"Unexpected error while retrieving connections"}}, nil
 
 	}
+	for i := range resp.Items {
+		resp.Items[i] = s.maskConnection(ctx, resp.Items[i])
+	}
 
 	return GetConnections200JSONResponse(resp), nil
 }
 
+// UpdateConnection updates the profile of a connection
+func (s *Server) UpdateConnection(ctx context.Context, request UpdateConnectionRequestObject) (UpdateConnectionResponseObject, error) {
+	profile := domain.ConnectionProfile{
+		DisplayName: request.Body.DisplayName,
+		ExternalID:  request.Body.ExternalId,
+		Email:       request.Body.Email,
+	}
+	err := s.connectionsService.UpdateProfile(ctx, request.Id, s.cfg.APIUI.IssuerDID, profile)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionDoesNotExist):
+			return UpdateConnection404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		case errors.Is(err, services.ErrInvalidConnectionEmail), errors.Is(err, services.ErrConnectionProfileFieldTooLong):
+			return UpdateConnection400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "update connection profile", "err", err, "req", request.Id.String())
+		return UpdateConnection500JSONResponse{N500JSONResponse{"There was an error updating the connection"}}, nil
+	}
+
+	log.Info(ctx, "audit: connection profile updated", "connection", request.Id.String())
+	return UpdateConnection200JSONResponse{Message: "Connection updated"}, nil
+}
+
 // DeleteConnection deletes a connection
 func (s *Server) DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error) {
 	req := ports.NewDeleteRequest(request.Id, request.Params.DeleteCredentials, request.Params.RevokeCredentials)
@@ -259,6 +782,9 @@ func (s *Server) DeleteConnection(ctx context.Context, request DeleteConnectionR
 	if err != nil {
 		if errors.Is(err, services.ErrConnectionDoesNotExist) {
 			log.Info(ctx, "delete connection, non existing conn", "err", err, "req", request.Id.String())
+			if apiversion.IsV2(ctx) {
+				return DeleteConnection404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+			}
 			return DeleteConnection400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
 		}
 		log.Error(ctx, "delete connection", "err", err, "req", request.Id.String())
@@ -281,9 +807,16 @@ func (s *Server) DeleteConnectionCredentials(ctx context.Context, request Delete
 
 // GetCredential returns a credential
 func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error) {
+	unmasked := request.Params.Unmasked != nil && *request.Params.Unmasked
+	if unmasked && roleFromContext(ctx) != roleAdmin {
+		return GetCredential403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
 	credential, err := s.claimService.GetByID(ctx, &s.cfg.APIUI.IssuerDID, request.Id)
 	if err != nil {
 		if errors.Is(err, services.ErrClaimNotFound) {
+			if apiversion.IsV2(ctx) {
+				return GetCredential404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+			}
 			return GetCredential400JSONResponse{N400JSONResponse{"The given credential id does not exist"}}, nil
 		}
 		return GetCredential500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential information"}}, nil
@@ -294,7 +827,131 @@ func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequest
 		return GetCredential500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
 	}
 
-	return GetCredential200JSONResponse(credentialResponse(w3c, credential)), nil
+	resp := credentialResponse(w3c, credential)
+	if unmasked && len(s.cfg.APIUI.MaskedAttributes) > 0 {
+		log.Info(ctx, "audit: unmasked credential fetch", "credential", credential.ID.String(), "userID", credential.OtherIdentifier, "role", roleFromContext(ctx))
+	}
+
+	return GetCredential200JSONResponse(s.maskCredential(ctx, resp)), nil
+}
+
+// GetCredentialVerificationBundle exports the credential with everything needed to verify it offline.
+// Masking invalidates the proofs of the credential, so only admins can export verifiable bundles, with the
+// unmasked flag, and the access is audited.
+func (s *Server) GetCredentialVerificationBundle(ctx context.Context, request GetCredentialVerificationBundleRequestObject) (GetCredentialVerificationBundleResponseObject, error) {
+	unmasked := request.Params.Unmasked != nil && *request.Params.Unmasked
+	if unmasked && roleFromContext(ctx) != roleAdmin {
+		return GetCredentialVerificationBundle403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	bundle, err := s.verificationBundleService.Get(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialVerificationBundle404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		if errors.Is(err, services.ErrCredentialNotPublished) {
+			return GetCredentialVerificationBundle400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "exporting credential verification bundle", "err", err, "id", request.Id)
+		return GetCredentialVerificationBundle500JSONResponse{N500JSONResponse{"There was an error exporting the credential verification bundle"}}, nil
+	}
+
+	if unmasked && len(s.cfg.APIUI.MaskedAttributes) > 0 {
+		log.Info(ctx, "audit: unmasked credential verification bundle export", "credential", request.Id.String(), "role", roleFromContext(ctx))
+	}
+	return GetCredentialVerificationBundle200JSONResponse(s.maskVerificationBundle(ctx, verificationBundleResponse(bundle))), nil
+}
+
+// GetCredentialVersions returns the history of the credential with the changes of the credential subject
+func (s *Server) GetCredentialVersions(ctx context.Context, request GetCredentialVersionsRequestObject) (GetCredentialVersionsResponseObject, error) {
+	versions, err := s.claimService.GetVersions(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialVersions404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		log.Error(ctx, "getting credential versions", "err", err, "id", request.Id)
+		return GetCredentialVersions500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential versions"}}, nil
+	}
+	return GetCredentialVersions200JSONResponse(s.maskCredentialVersions(ctx, credentialVersionsResponse(versions))), nil
+}
+
+// GetCredentialDependents returns the active credentials that a cascade revocation of the credential would revoke
+func (s *Server) GetCredentialDependents(ctx context.Context, request GetCredentialDependentsRequestObject) (GetCredentialDependentsResponseObject, error) {
+	dependents, err := s.revocationCascadeService.Preview(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialDependents404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		log.Error(ctx, "getting credential dependents", "err", err, "id", request.Id)
+		return GetCredentialDependents500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential dependents"}}, nil
+	}
+	return GetCredentialDependents200JSONResponse(credentialDependentsResponse(dependents)), nil
+}
+
+// ArchiveCredentials moves a batch of old credentials to the archive
+func (s *Server) ArchiveCredentials(ctx context.Context, request ArchiveCredentialsRequestObject) (ArchiveCredentialsResponseObject, error) {
+	req := ports.ClaimArchiveRequest{
+		MinAgeDays: s.cfg.ClaimArchival.MinAgeDays,
+		BatchSize:  s.cfg.ClaimArchival.BatchSize,
+	}
+	if request.Body != nil && request.Body.OlderThanDays != nil {
+		req.MinAgeDays = *request.Body.OlderThanDays
+	}
+	if request.Body != nil && request.Body.BatchSize != nil {
+		req.BatchSize = *request.Body.BatchSize
+	}
+	ids, err := s.claimArchivalService.Archive(ctx, s.cfg.APIUI.IssuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimArchiveMinAge) || errors.Is(err, services.ErrClaimArchiveBatchSize) {
+			return ArchiveCredentials400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return ArchiveCredentials500JSONResponse{N500JSONResponse{"There was an error archiving the credentials"}}, nil
+	}
+	return ArchiveCredentials200JSONResponse{Credentials: ids}, nil
+}
+
+// RehydrateCredential moves an archived credential back to the credentials table
+func (s *Server) RehydrateCredential(ctx context.Context, request RehydrateCredentialRequestObject) (RehydrateCredentialResponseObject, error) {
+	credential, err := s.claimArchivalService.Rehydrate(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrArchivedClaimNotFound) {
+			return RehydrateCredential404JSONResponse{N404JSONResponse{"The given credential id is not archived"}}, nil
+		}
+		if errors.Is(err, services.ErrClaimRehydrationConflict) {
+			return RehydrateCredential409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		return RehydrateCredential500JSONResponse{N500JSONResponse{"There was an error rehydrating the credential"}}, nil
+	}
+
+	w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		return RehydrateCredential500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
+	}
+	return RehydrateCredential200JSONResponse(s.maskCredential(ctx, credentialResponse(w3c, credential))), nil
+}
+
+// GetCredentialPDF renders the credential as a printable PDF document
+func (s *Server) GetCredentialPDF(ctx context.Context, request GetCredentialPDFRequestObject) (GetCredentialPDFResponseObject, error) {
+	var opts ports.CredentialPDFOptions
+	unmasked := request.Params.Unmasked != nil && *request.Params.Unmasked
+	if unmasked && roleFromContext(ctx) != roleAdmin {
+		return GetCredentialPDF403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	if unmasked && len(s.cfg.APIUI.MaskedAttributes) > 0 {
+		log.Info(ctx, "audit: unmasked credential pdf", "credential", request.Id.String(), "role", roleFromContext(ctx))
+	}
+	if s.mustMask(ctx) {
+		opts.MaskedAttributes = s.cfg.APIUI.MaskedAttributes
+	}
+
+	doc, err := s.credentialPDFService.Render(ctx, s.cfg.APIUI.IssuerDID, request.Id, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialPDF404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		log.Error(ctx, "rendering credential pdf", "err", err, "id", request.Id)
+		return GetCredentialPDF500JSONResponse{N500JSONResponse{"There was an error rendering the credential pdf"}}, nil
+	}
+	return GetCredentialPDF200ApplicationpdfResponse{Body: bytes.NewReader(doc), ContentLength: int64(len(doc))}, nil
 }
 
 // GetCredentials returns a collection of credentials that matches the request.
@@ -304,6 +961,9 @@ func (s *Server) GetCredentials(ctx context.Context, request GetCredentialsReque
 		return GetCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 	}
 	credentials, total, err := s.claimService.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, services.ErrQueryFieldNotSupported) {
+		return GetCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
 	if err != nil {
 		log.Error(ctx, "loading credentials", "err", err, "req", request)
 		return GetCredentials500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
@@ -315,7 +975,7 @@ func (s *Server) GetCredentials(ctx context.Context, request GetCredentialsReque
 			log.Error(ctx, "creating credentials response", "err", err, "req", request)
 			return GetCredentials500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
 		}
-		response[i] = credentialResponse(w3c, credential)
+		response[i] = s.maskCredential(ctx, credentialResponse(w3c, credential))
 	}
 	return credentialsResponse(response, filter.Page, total, filter.MaxResults), nil
 }
@@ -325,6 +985,9 @@ func (s *Server) DeleteCredential(ctx context.Context, request DeleteCredentialR
 	err := s.claimService.Delete(ctx, request.Id)
 	if err != nil {
 		if errors.Is(err, services.ErrClaimNotFound) {
+			if apiversion.IsV2(ctx) {
+				return DeleteCredential404JSONResponse{N404JSONResponse{"The given credential does not exist"}}, nil
+			}
 			return DeleteCredential400JSONResponse{N400JSONResponse{"The given credential does not exist"}}, nil
 		}
 		return DeleteCredential500JSONResponse{N500JSONResponse{"There was an error deleting the credential"}}, nil
@@ -355,6 +1018,28 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 
 	req := ports.NewCreateClaimRequest(&s.cfg.APIUI.IssuerDID, request.Body.CredentialSchema, request.Body.CredentialSubject, request.Body.Expiration, request.Body.Type, nil, nil, nil, claimRequestProofs, nil, true, s.cfg.CredentialStatus.CredentialStatusType, toVerifiableRefreshService(request.Body.RefreshService), nil,
 		toDisplayMethodService(request.Body.DisplayMethod))
+	if request.Body.Force != nil {
+		req.Force = *request.Body.Force
+	}
+	req.ExternalID = request.Body.ExternalId
+	req.RequesterRole = string(roleFromContext(ctx))
+	req.Provenance = toAttributeProvenance(request.Body.Provenance)
+	req.Extensions = toCredentialExtensions(request.Body.Evidence, request.Body.TermsOfUse)
+	req.RevNonceRangeID = request.Body.RevNonceRangeID
+	if request.Body.FetchPolicy != nil {
+		req.FetchPolicy = toCredentialFetchPolicy(*request.Body.FetchPolicy)
+	}
+	if request.Body.ProfileID != nil {
+		profile, err := s.issuerProfileService.Authorize(ctx, s.cfg.APIUI.IssuerDID, *request.Body.ProfileID, claimRequestProofs)
+		if err != nil {
+			if errors.Is(err, services.ErrIssuerProfileNotFound) || errors.Is(err, services.ErrIssuerProfileProofNotAllowed) {
+				return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+			log.Error(ctx, "authorizing issuer profile", "err", err, "profile", *request.Body.ProfileID)
+			return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		req.ProfileID = &profile.ID
+	}
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
 		if errors.Is(err, services.ErrJSONLdContext) {
@@ -378,6 +1063,12 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		if errors.Is(err, services.ErrMalformedURL) {
 			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrExternalIDTooLong) || errors.Is(err, services.ErrInvalidProvenance) || errors.Is(err, services.ErrInvalidCredentialSection) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrInvalidFetchPolicy) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrUnsupportedRefreshServiceType) {
 			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
@@ -393,11 +1084,87 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		if errors.Is(err, services.ErrUnsupportedDisplayMethodType) {
 			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrIssuanceLimitExceeded) || errors.Is(err, services.ErrIssuanceCooldown) || errors.Is(err, services.ErrPolicyDenied) ||
+			errors.Is(err, services.ErrCredentialExpirationExceeded) || errors.Is(err, services.ErrCredentialUniqueKeyTaken) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrDuplicateCredential) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationNonceCollision) || errors.Is(err, services.ErrRevocationNonceRangeNotFound) ||
+			errors.Is(err, services.ErrRevocationNonceRangeExhausted) || errors.Is(err, services.ErrRevocationNoncesExhausted) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 	return CreateCredential201JSONResponse{Id: resp.ID.String()}, nil
 }
 
+// ImportCredential issues a credential built by another system under the identity of the issuer
+func (s *Server) ImportCredential(ctx context.Context, request ImportCredentialRequestObject) (ImportCredentialResponseObject, error) {
+	document, err := json.Marshal(request.Body.Credential)
+	if err != nil {
+		return ImportCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+	req := ports.ClaimImportRequest{
+		Credential:      document,
+		Source:          request.Body.Source,
+		SourceReference: request.Body.SourceReference,
+		SignatureProof:  request.Body.SignatureProof != nil && *request.Body.SignatureProof,
+		MTProof:         request.Body.MtProof != nil && *request.Body.MtProof,
+	}
+	claimImport, err := s.claimImportService.Import(ctx, s.cfg.APIUI.IssuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrCredentialAlreadyImported) || errors.Is(err, services.ErrRevocationNonceCollision) {
+			return ImportCredential409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLoadingSchema) {
+			return ImportCredential422JSONResponse{N422JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrInvalidImportedCredential) || errors.Is(err, services.ErrClaimImportSource) || errors.Is(err, services.ErrClaimImportProof) ||
+			errors.Is(err, services.ErrJSONLdContext) || errors.Is(err, services.ErrProcessSchema) || errors.Is(err, services.ErrParseClaim) ||
+			errors.Is(err, services.ErrInvalidCredentialSubject) || errors.Is(err, services.ErrMalformedURL) || errors.Is(err, services.ErrExternalIDTooLong) ||
+			errors.Is(err, services.ErrInvalidCredentialSection) || errors.Is(err, services.ErrCredentialExpirationExceeded) ||
+			errors.Is(err, services.ErrCredentialUniqueKeyTaken) || errors.Is(err, services.ErrDuplicateCredential) || errors.Is(err, services.ErrPolicyDenied) ||
+			errors.Is(err, services.ErrIssuanceLimitExceeded) || errors.Is(err, services.ErrIssuanceCooldown) || errors.Is(err, services.ErrRevocationNoncesExhausted) {
+			return ImportCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return ImportCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ImportCredential201JSONResponse(credentialImportResponse(claimImport)), nil
+}
+
+// GetCredentialImport returns the provenance of an imported credential
+func (s *Server) GetCredentialImport(ctx context.Context, request GetCredentialImportRequestObject) (GetCredentialImportResponseObject, error) {
+	claimImport, err := s.claimImportService.GetByClaimID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimImportNotFound) {
+			return GetCredentialImport404JSONResponse{N404JSONResponse{"The given credential id was not imported"}}, nil
+		}
+		log.Error(ctx, "getting credential import", "err", err, "id", request.Id)
+		return GetCredentialImport500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetCredentialImport200JSONResponse(credentialImportResponse(claimImport)), nil
+}
+
+// InspectCredentialLayout returns how the credential subject maps into the claim slots, without issuing the credential
+func (s *Server) InspectCredentialLayout(ctx context.Context, request InspectCredentialLayoutRequestObject) (InspectCredentialLayoutResponseObject, error) {
+	req := ports.NewCreateClaimRequest(&s.cfg.APIUI.IssuerDID, request.Body.CredentialSchema, request.Body.CredentialSubject, request.Body.Expiration, request.Body.Type, nil, nil, nil, ports.ClaimRequestProofs{}, nil, true, s.cfg.CredentialStatus.CredentialStatusType, nil, request.Body.RevNonce, nil)
+	layout, err := s.claimService.InspectLayout(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrLoadingSchema) {
+			return InspectCredentialLayout422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrMalformedURL) || errors.Is(err, services.ErrJSONLdContext) || errors.Is(err, services.ErrProcessSchema) ||
+			errors.Is(err, services.ErrInvalidCredentialSubject) || errors.Is(err, services.ErrParseClaim) {
+			return InspectCredentialLayout400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "inspecting credential layout", "err", err)
+		return InspectCredentialLayout500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return InspectCredentialLayout200JSONResponse(credentialLayoutResponse(layout)), nil
+}
+
 // RevokeCredential - revokes a credential per a given nonce
 func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error) {
 	if err := s.claimService.Revoke(ctx, s.cfg.APIUI.IssuerDID, uint64(request.Nonce), ""); err != nil {
@@ -409,6 +1176,13 @@ func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialR
 		log.Error(ctx, "revoke credential", "err", err, "req", request)
 		return RevokeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	if request.Params.Cascade != nil && *request.Params.Cascade {
+		if err := s.revocationCascadeService.Request(ctx, s.cfg.APIUI.IssuerDID, uint64(request.Nonce)); err != nil {
+			log.Error(ctx, "revoke credential dependents", "err", err, "req", request)
+			return RevokeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Info(ctx, "audit: cascade revocation requested", "nonce", request.Nonce)
+	}
 	return RevokeCredential202JSONResponse{
 		Message: "claim revocation request sent",
 	}, nil
@@ -423,8 +1197,57 @@ func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationS
 		}}, nil
 	}
 
-	return GetRevocationStatus200JSONResponse(getRevocationStatusResponse(rs)), err
-}
+	response := getRevocationStatusResponse(rs)
+	expirationStatuses, err := s.claimService.GetExpirationStatuses(ctx, s.cfg.APIUI.IssuerDID, []uint64{uint64(request.Nonce)})
+	if err != nil {
+		log.Error(ctx, "get expiration status", "err", err, "nonce", request.Nonce)
+	}
+	if status, ok := expirationStatuses[uint64(request.Nonce)]; ok {
+		response.ExpirationStatus = &status
+	}
+
+	return GetRevocationStatus200JSONResponse(response), nil
+}
+
+// GetRevocationStatusBatch - returns the revocation statuses of several credentials proved against the same state.
+// It is public as the revocation status of a credential.
+func (s *Server) GetRevocationStatusBatch(ctx context.Context, request GetRevocationStatusBatchRequestObject) (GetRevocationStatusBatchResponseObject, error) {
+	statuses, err := s.claimService.GetRevocationStatuses(ctx, s.cfg.APIUI.IssuerDID, request.Body.Nonces)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationStatusBatchSize) {
+			return GetRevocationStatusBatch400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get revocation statuses", "err", err, "nonces", len(request.Body.Nonces))
+		return GetRevocationStatusBatch500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	expirationStatuses, err := s.claimService.GetExpirationStatuses(ctx, s.cfg.APIUI.IssuerDID, request.Body.Nonces)
+	if err != nil {
+		log.Error(ctx, "get expiration statuses", "err", err, "nonces", len(request.Body.Nonces))
+	}
+
+	return GetRevocationStatusBatch200JSONResponse(revocationStatusBatchResponse(request.Body.Nonces, statuses, expirationStatuses)), nil
+}
+
+// GetHistoricalRevocationStatus - returns whether a credential was revoked in a past published state of the issuer.
+// It is public as the current revocation status.
+func (s *Server) GetHistoricalRevocationStatus(ctx context.Context, request GetHistoricalRevocationStatusRequestObject) (GetHistoricalRevocationStatusResponseObject, error) {
+	query := ports.StateQuery{State: request.Params.State, At: request.Params.Timestamp}
+	if query.State == nil && query.At == nil {
+		return GetHistoricalRevocationStatus400JSONResponse{N400JSONResponse{Message: "state or timestamp is required"}}, nil
+	}
+
+	state, rs, err := s.claimService.GetRevocationStatusAtState(ctx, s.cfg.APIUI.IssuerDID, uint64(request.Nonce), query)
+	if err != nil {
+		if errors.Is(err, services.ErrStateNotFound) {
+			return GetHistoricalRevocationStatus404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get historical revocation status", "err", err, "nonce", request.Nonce)
+		return GetHistoricalRevocationStatus500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetHistoricalRevocationStatus200JSONResponse(historicalRevocationStatusResponse(state, rs)), nil
+}
 
 // PublishState - publish the state onchange
 func (s *Server) PublishState(ctx context.Context, request PublishStateRequestObject) (PublishStateResponseObject, error) {
@@ -481,15 +1304,56 @@ func (s *Server) GetStateStatus(ctx context.Context, _ GetStateStatusRequestObje
 	return GetStateStatus200JSONResponse{PendingActions: pendingActions}, nil
 }
 
+// GetStatePending - get the credentials and revocations waiting to be published
+func (s *Server) GetStatePending(ctx context.Context, _ GetStatePendingRequestObject) (GetStatePendingResponseObject, error) {
+	pending, err := s.identityService.GetPendingState(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get pending state", "err", err)
+		return GetStatePending500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetStatePending200JSONResponse(pendingStateResponse(pending)), nil
+}
+
+// GetStats - get the aggregates used by the dashboards
+func (s *Server) GetStats(ctx context.Context, _ GetStatsRequestObject) (GetStatsResponseObject, error) {
+	stats, err := s.statsService.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get stats", "err", err)
+		return GetStats500JSONResponse{N500JSONResponse{Message: "error getting the stats"}}, nil
+	}
+
+	return GetStats200JSONResponse(statsResponse(stats)), nil
+}
+
 // GetStateTransactions - get the state transactions
 func (s *Server) GetStateTransactions(ctx context.Context, _ GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error) {
-	states, err := s.identityService.GetStates(ctx, s.cfg.APIUI.IssuerDID)
+	states, err := s.identityService.GetStateTransactions(ctx, s.cfg.APIUI.IssuerDID)
 	if err != nil {
 		log.Error(ctx, "get state transactions", "err", err)
 		return GetStateTransactions500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 
-	return GetStateTransactions200JSONResponse(stateTransactionsResponse(states)), nil
+	var currentBlock *big.Int
+	if s.transactionService != nil {
+		currentBlock, err = s.transactionService.GetCurrentBlock(ctx)
+		if err != nil {
+			log.Warn(ctx, "get state transactions. Getting current block", "err", err)
+			currentBlock = nil
+		}
+	}
+
+	return GetStateTransactions200JSONResponse(stateTransactionsResponse(states, currentBlock, s.cfg.Ethereum)), nil
+}
+
+// GetStateCheckpoints returns the anchors of the published states of the issuer
+func (s *Server) GetStateCheckpoints(ctx context.Context, _ GetStateCheckpointsRequestObject) (GetStateCheckpointsResponseObject, error) {
+	checkpoints, err := s.stateCheckpointService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get state checkpoints", "err", err)
+		return GetStateCheckpoints500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetStateCheckpoints200JSONResponse(stateCheckpointsResponse(checkpoints)), nil
 }
 
 // RevokeConnectionCredentials revoke all the non revoked credentials of the given connection
@@ -533,7 +1397,26 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 		expirationDate = request.Body.CredentialExpiration
 	}
 
-	createdLink, err := s.linkService.Save(ctx, s.cfg.APIUI.IssuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, toVerifiableRefreshService(request.Body.RefreshService), toDisplayMethodService(request.Body.DisplayMethod))
+	createdLink, err := s.linkService.Save(ctx, s.cfg.APIUI.IssuerDID, ports.CreateLinkRequest{
+		MaxIssuance:              request.Body.LimitedClaims,
+		ValidUntil:               request.Body.Expiration,
+		SchemaID:                 request.Body.SchemaID,
+		CredentialExpiration:     expirationDate,
+		CredentialSignatureProof: request.Body.SignatureProof,
+		CredentialMTPProof:       request.Body.MtProof,
+		CredentialAttributes:     credSubject,
+		RefreshService:           toVerifiableRefreshService(request.Body.RefreshService),
+		DisplayMethod:            toDisplayMethodService(request.Body.DisplayMethod),
+		ExternalID:               request.Body.ExternalId,
+		PresentationTemplate:     request.Body.PresentationTemplate,
+		Payment:                  toLinkPayment(request.Body.Payment),
+		Locale:                   request.Body.Locale,
+		Prerequisites:            toLinkPrerequisites(request.Body.Prerequisites),
+		Restrictions:             toLinkRestrictions(request.Body.Restrictions),
+		Throttle:                 toLinkThrottle(request.Body.Throttle),
+		CredentialExtensions:     common.ToPointer(toCredentialExtensions(request.Body.Evidence, request.Body.TermsOfUse)),
+		ResultWebhook:            toLinkResultWebhook(request.Body.ResultWebhook),
+	})
 	if err != nil {
 		log.Error(ctx, "error saving the link", "err", err.Error())
 		if errors.Is(err, services.ErrLoadingSchema) {
@@ -544,6 +1427,70 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 	return CreateLink201JSONResponse{Id: createdLink.ID.String()}, nil
 }
 
+// CloneLink creates a new link with the configuration of an existing one
+func (s *Server) CloneLink(ctx context.Context, request CloneLinkRequestObject) (CloneLinkResponseObject, error) {
+	link, err := s.linkService.Clone(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CloneLink404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "cloning a link", "err", err, "id", request.Id)
+		return CloneLink500JSONResponse{N500JSONResponse{Message: "error cloning the link"}}, nil
+	}
+	return CloneLink201JSONResponse{Id: link.ID.String()}, nil
+}
+
+// CreateLinkBatch creates single use links with the configuration of an existing one
+func (s *Server) CreateLinkBatch(ctx context.Context, request CreateLinkBatchRequestObject) (CreateLinkBatchResponseObject, error) {
+	var externalIDs []string
+	if request.Body.ExternalIds != nil {
+		externalIDs = *request.Body.ExternalIds
+	}
+	links, err := s.linkService.CreateBatch(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Count, externalIDs)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CreateLinkBatch404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkBatchInvalidSize) || errors.Is(err, services.ErrLinkBatchExternalIDs) || errors.Is(err, services.ErrExternalIDTooLong) {
+			return CreateLinkBatch400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating a link batch", "err", err, "id", request.Id)
+		return CreateLinkBatch500JSONResponse{N500JSONResponse{Message: "error creating the links"}}, nil
+	}
+	log.Info(ctx, "audit: link batch created", "template", request.Id, "count", len(links))
+	return CreateLinkBatch201JSONResponse(linkBatchResponse(links, s.serverURL(ctx))), nil
+}
+
+// ImportLinkRecipients - adds the recipients of a csv to a link
+func (s *Server) ImportLinkRecipients(ctx context.Context, request ImportLinkRecipientsRequestObject) (ImportLinkRecipientsResponseObject, error) {
+	recipients, err := s.linkService.ImportRecipients(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return ImportLinkRecipients404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkRecipientsInvalid) {
+			return ImportLinkRecipients400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "importing link recipients", "err", err, "id", request.Id)
+		return ImportLinkRecipients500JSONResponse{N500JSONResponse{Message: "error importing the link recipients"}}, nil
+	}
+	log.Info(ctx, "audit: link recipients imported", "link", request.Id, "count", len(recipients))
+	return ImportLinkRecipients201JSONResponse(s.maskLinkRecipients(ctx, linkRecipientsResponse(recipients, s.serverURL(ctx), s.cfg.APIUI.LandingPage.Enabled))), nil
+}
+
+// GetLinkRecipients - returns the recipients of a link
+func (s *Server) GetLinkRecipients(ctx context.Context, request GetLinkRecipientsRequestObject) (GetLinkRecipientsResponseObject, error) {
+	recipients, err := s.linkService.GetRecipients(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkRecipients404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link recipients", "err", err, "id", request.Id)
+		return GetLinkRecipients500JSONResponse{N500JSONResponse{Message: "error getting the link recipients"}}, nil
+	}
+	return GetLinkRecipients200JSONResponse(s.maskLinkRecipients(ctx, linkRecipientsResponse(recipients, s.serverURL(ctx), s.cfg.APIUI.LandingPage.Enabled))), nil
+}
+
 // GetLink returns a link from an id
 func (s *Server) GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error) {
 	link, err := s.linkService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
@@ -558,6 +1505,19 @@ func (s *Server) GetLink(ctx context.Context, request GetLinkRequestObject) (Get
 	return GetLink200JSONResponse(getLinkResponse(*link)), nil
 }
 
+// GetLinkPrerequisiteProofs returns the proofs the holders sent for the prerequisites of a link
+func (s *Server) GetLinkPrerequisiteProofs(ctx context.Context, request GetLinkPrerequisiteProofsRequestObject) (GetLinkPrerequisiteProofsResponseObject, error) {
+	proofs, err := s.linkService.GetPrerequisiteProofs(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkPrerequisiteProofs404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link prerequisite proofs", "err", err, "id", request.Id)
+		return GetLinkPrerequisiteProofs500JSONResponse{N500JSONResponse{Message: "error getting the link prerequisite proofs"}}, nil
+	}
+	return GetLinkPrerequisiteProofs200JSONResponse(linkPrerequisiteProofsResponse(proofs)), nil
+}
+
 // GetLinks - Returns a list of links based on a search criteria.
 func (s *Server) GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error) {
 	var err error
@@ -580,7 +1540,13 @@ func (s *Server) GetLinks(ctx context.Context, request GetLinksRequestObject) (G
 func (s *Server) AcivateLink(ctx context.Context, request AcivateLinkRequestObject) (AcivateLinkResponseObject, error) {
 	err := s.linkService.Activate(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Active)
 	if err != nil {
-		if errors.Is(err, repositories.ErrLinkDoesNotExist) || errors.Is(err, services.ErrLinkAlreadyActive) || errors.Is(err, services.ErrLinkAlreadyInactive) {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			if apiversion.IsV2(ctx) {
+				return AcivateLink404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+			}
+			return AcivateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkAlreadyActive) || errors.Is(err, services.ErrLinkAlreadyInactive) {
 			return AcivateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
 		log.Error(ctx, "error activating or deactivating link", err.Error(), "id", request.Id)
@@ -593,6 +1559,9 @@ func (s *Server) AcivateLink(ctx context.Context, request AcivateLinkRequestObje
 func (s *Server) DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error) {
 	if err := s.linkService.Delete(ctx, request.Id, s.cfg.APIUI.IssuerDID); err != nil {
 		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			if apiversion.IsV2(ctx) {
+				return DeleteLink404JSONResponse{N404JSONResponse{Message: "link does not exist"}}, nil
+			}
 			return DeleteLink400JSONResponse{N400JSONResponse{Message: "link does not exist"}}, nil
 		}
 		return DeleteLink500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
@@ -602,14 +1571,27 @@ func (s *Server) DeleteLink(ctx context.Context, request DeleteLinkRequestObject
 
 // CreateLinkQrCode - Creates a link QrCode
 func (s *Server) CreateLinkQrCode(ctx context.Context, req CreateLinkQrCodeRequestObject) (CreateLinkQrCodeResponseObject, error) {
-	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, req.Id, s.cfg.APIUI.ServerURL)
+	code := ""
+	if req.Params.Code != nil {
+		code = *req.Params.Code
+	}
+	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, req.Id, s.serverURL(ctx), code, clientIPFromContext(ctx))
 	if err != nil {
 		if errors.Is(err, services.ErrLinkNotFound) {
 			return CreateLinkQrCode404JSONResponse{N404JSONResponse{Message: "error: link not found"}}, nil
 		}
+		if errors.Is(err, services.ErrLinkRecipientCodeInvalid) {
+			return CreateLinkQrCode400JSONResponse{N400JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || errors.Is(err, services.ErrLinkInactive) {
 			return CreateLinkQrCode404JSONResponse{N404JSONResponse{Message: "error: " + err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrLinkRestricted) {
+			return CreateLinkQrCode403JSONResponse{N403JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkThrottled) {
+			return CreateLinkQrCode429JSONResponse{linkThrottledResponse(err)}, nil
+		}
 		log.Error(ctx, "Unexpected error while creating qr code", "err", err)
 		return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
 	}
@@ -621,23 +1603,107 @@ func (s *Server) CreateLinkQrCode(ctx context.Context, req CreateLinkQrCodeReque
 		return CreateLinkQrCode500JSONResponse{N500JSONResponse{"error looking for qr body"}}, nil
 	}
 
+	qrCodeLink := createLinkQrCodeResponse.QrCode
+	if req.Params.Type != nil && (*req.Params.Type == CreateLinkQrCodeParamsTypeEmbedded || *req.Params.Type == CreateLinkQrCodeParamsTypeAuto) {
+		if qrCodeLink, err = s.embeddedQrLink(ctx, createLinkQrCodeResponse.QrID, qrCodeRaw, *req.Params.Type == CreateLinkQrCodeParamsTypeAuto, req.Params.Compress); err != nil {
+			log.Error(ctx, "embedding the authentication request in the qr link", "err", err, "id", req.Id)
+			return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+		}
+	}
+	if req.Params.Type != nil && *req.Params.Type == CreateLinkQrCodeParamsTypeOob {
+		goal := fmt.Sprintf("Receive a %s credential", createLinkQrCodeResponse.Link.Schema.Type)
+		invitation, err := s.invitationService.Create(ctx, s.cfg.APIUI.IssuerDID, s.serverURL(ctx), domain.OutOfBandGoalCodeIssueCredential, goal, createLinkQrCodeResponse.QrID)
+		if err != nil {
+			log.Error(ctx, "creating link out-of-band invitation", "err", err, "id", req.Id)
+			return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating the invitation"}}, nil
+		}
+		qrCodeLink = invitation.URL
+		if qrCodeRaw, err = json.Marshal(invitation.Invitation); err != nil {
+			return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating the invitation"}}, nil
+		}
+	}
+
 	return CreateLinkQrCode200JSONResponse{
-		Issuer: IssuerDescription{
-			DisplayName: s.cfg.APIUI.IssuerName,
-			Logo:        s.cfg.APIUI.IssuerLogo,
-		},
-		QrCodeLink: createLinkQrCodeResponse.QrCode,
+		Issuer:     s.issuerDescription(s.localizer(ctx, req.Params.Locale, createLinkQrCodeResponse.Link.Locale)),
+		QrCodeLink: qrCodeLink,
 		QrCodeRaw:  string(qrCodeRaw),
 		SessionID:  createLinkQrCodeResponse.SessionID,
 		LinkDetail: getLinkSimpleResponse(*createLinkQrCodeResponse.Link),
 	}, nil
 }
 
+// CreateLinkQrCodeHTML - Creates a link QrCode and returns it as an html snippet to embed in emails
+func (s *Server) CreateLinkQrCodeHTML(ctx context.Context, req CreateLinkQrCodeHTMLRequestObject) (CreateLinkQrCodeHTMLResponseObject, error) {
+	code := ""
+	if req.Params.Code != nil {
+		code = *req.Params.Code
+	}
+	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, req.Id, s.serverURL(ctx), code, clientIPFromContext(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CreateLinkQrCodeHTML404JSONResponse{N404JSONResponse{Message: "error: link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkRecipientCodeInvalid) {
+			return CreateLinkQrCodeHTML400JSONResponse{N400JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || errors.Is(err, services.ErrLinkInactive) {
+			return CreateLinkQrCodeHTML404JSONResponse{N404JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkRestricted) {
+			return CreateLinkQrCodeHTML403JSONResponse{N403JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkThrottled) {
+			return CreateLinkQrCodeHTML429JSONResponse{linkThrottledResponse(err)}, nil
+		}
+		log.Error(ctx, "Unexpected error while creating qr code", "err", err)
+		return CreateLinkQrCodeHTML500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+	}
+
+	link := createLinkQrCodeResponse.Link
+	// the offer expires with the qr code body or with the link, whatever happens first
+	expiresAt := time.Now().Add(services.DefaultQRBodyTTL)
+	if link.ValidUntil != nil && link.ValidUntil.Before(expiresAt) {
+		expiresAt = *link.ValidUntil
+	}
+	localizer := s.localizer(ctx, req.Params.Locale, link.Locale)
+	snippet := domain.QRSnippet{
+		IssuerName: localizer.T(domain.TranslationKeyIssuerName, s.cfg.APIUI.IssuerName),
+		Title:      fmt.Sprintf("Receive your %s credential", link.Schema.Type),
+		Link:       createLinkQrCodeResponse.QrCode,
+		ExpiresAt:  &expiresAt,
+	}
+	html, err := s.qrBrandingService.RenderHTMLSnippet(ctx, s.cfg.APIUI.IssuerDID, snippet, qrSnippetSize(req.Params.Size))
+	if err != nil {
+		if errors.Is(err, services.ErrQRImageInvalidSize) {
+			return CreateLinkQrCodeHTML400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "rendering link qr code html snippet", "err", err, "id", req.Id)
+		return CreateLinkQrCodeHTML500JSONResponse{N500JSONResponse{"Unexpected error while rendering the qr code"}}, nil
+	}
+	return CreateLinkQrCodeHTML200TexthtmlResponse{Body: bytes.NewReader(html), ContentLength: int64(len(html))}, nil
+}
+
 // GetCredentialQrCode - returns a QR Code for fetching the credential
 func (s *Server) GetCredentialQrCode(ctx context.Context, req GetCredentialQrCodeRequestObject) (GetCredentialQrCodeResponseObject, error) {
-	resp, err := s.claimService.GetCredentialQrCode(ctx, &s.cfg.APIUI.IssuerDID, req.Id, s.cfg.APIUI.ServerURL)
+	if req.Params.Type != nil && *req.Params.Type == GetCredentialQrCodeParamsTypeNegotiate {
+		qrType, err := s.negotiateCredentialQrCodeType(ctx, req.Id)
+		if err != nil {
+			if errors.Is(err, services.ErrClaimNotFound) {
+				if apiversion.IsV2(ctx) {
+					return GetCredentialQrCode404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+				}
+				return GetCredentialQrCode400JSONResponse{N400JSONResponse{"Credential not found"}}, nil
+			}
+			return GetCredentialQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+		req.Params.Type = &qrType
+	}
+	resp, err := s.claimService.GetCredentialQrCode(ctx, &s.cfg.APIUI.IssuerDID, req.Id, s.serverURL(ctx), s.localizer(ctx, req.Params.Locale))
 	if err != nil {
 		if errors.Is(err, services.ErrClaimNotFound) {
+			if apiversion.IsV2(ctx) {
+				return GetCredentialQrCode404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+			}
 			return GetCredentialQrCode400JSONResponse{N400JSONResponse{"Credential not found"}}, nil
 		}
 		if errors.Is(err, services.ErrEmptyMTPProof) {
@@ -655,12 +1721,208 @@ func (s *Server) GetCredentialQrCode(ctx context.Context, req GetCredentialQrCod
 		}
 		qrContent = string(rawQrCode)
 	}
+	if req.Params.Type != nil && (*req.Params.Type == GetCredentialQrCodeParamsTypeEmbedded || *req.Params.Type == GetCredentialQrCodeParamsTypeAuto) {
+		rawQrCode, err := s.qrService.Find(ctx, resp.QrID)
+		if err != nil {
+			log.Error(ctx, "qr store. Finding qr", "err", err, "id", resp.QrID)
+			return GetCredentialQrCode500JSONResponse{N500JSONResponse{"error looking for qr body"}}, nil
+		}
+		if qrContent, err = s.embeddedQrLink(ctx, resp.QrID, rawQrCode, *req.Params.Type == GetCredentialQrCodeParamsTypeAuto, req.Params.Compress); err != nil {
+			log.Error(ctx, "embedding the credential offer in the qr link", "err", err, "id", req.Id)
+			return GetCredentialQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+		}
+	}
+	if req.Params.Type != nil && *req.Params.Type == GetCredentialQrCodeParamsTypeOob {
+		qrIDs := []uuid.UUID{resp.QrID}
+		if req.Params.Connect != nil && *req.Params.Connect {
+			auth, err := s.identityService.CreateAuthenticationQRCode(ctx, s.serverURL(ctx), s.cfg.APIUI.IssuerDID)
+			if err != nil {
+				log.Error(ctx, "creating the connection request of the invitation", "err", err)
+				return GetCredentialQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating the invitation"}}, nil
+			}
+			qrIDs = append([]uuid.UUID{auth.QrID}, qrIDs...)
+		}
+		goal := fmt.Sprintf("Receive a %s credential", resp.SchemaType)
+		invitation, err := s.invitationService.Create(ctx, s.cfg.APIUI.IssuerDID, s.serverURL(ctx), domain.OutOfBandGoalCodeIssueCredential, goal, qrIDs...)
+		if err != nil {
+			log.Error(ctx, "creating credential out-of-band invitation", "err", err, "id", req.Id)
+			return GetCredentialQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating the invitation"}}, nil
+		}
+		qrContent = invitation.URL
+	}
 	return GetCredentialQrCode200JSONResponse{
 		QrCodeLink: qrContent,
 		SchemaType: resp.SchemaType,
 	}, nil
 }
 
+// GetCredentialQrCodeHTML - returns the offer QR Code of a credential as an html snippet to embed in emails
+func (s *Server) GetCredentialQrCodeHTML(ctx context.Context, req GetCredentialQrCodeHTMLRequestObject) (GetCredentialQrCodeHTMLResponseObject, error) {
+	localizer := s.localizer(ctx, req.Params.Locale)
+	resp, err := s.claimService.GetCredentialQrCode(ctx, &s.cfg.APIUI.IssuerDID, req.Id, s.serverURL(ctx), localizer)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialQrCodeHTML404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		if errors.Is(err, services.ErrEmptyMTPProof) {
+			return GetCredentialQrCodeHTML400JSONResponse{N400JSONResponse{"State must be published before fetching MTP type credentials"}}, nil
+		}
+		log.Error(ctx, "getting credential qr code", "err", err, "id", req.Id)
+		return GetCredentialQrCodeHTML500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	snippet := domain.QRSnippet{
+		IssuerName: localizer.T(domain.TranslationKeyIssuerName, s.cfg.APIUI.IssuerName),
+		Title:      fmt.Sprintf("Receive your %s credential", resp.SchemaType),
+		Link:       resp.QrCodeURL,
+		ExpiresAt:  common.ToPointer(time.Now().Add(services.DefaultQRBodyTTL)),
+	}
+	html, err := s.qrBrandingService.RenderHTMLSnippet(ctx, s.cfg.APIUI.IssuerDID, snippet, qrSnippetSize(req.Params.Size))
+	if err != nil {
+		if errors.Is(err, services.ErrQRImageInvalidSize) {
+			return GetCredentialQrCodeHTML400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "rendering credential qr code html snippet", "err", err, "id", req.Id)
+		return GetCredentialQrCodeHTML500JSONResponse{N500JSONResponse{"Unexpected error while rendering the qr code"}}, nil
+	}
+	return GetCredentialQrCodeHTML200TexthtmlResponse{Body: bytes.NewReader(html), ContentLength: int64(len(html))}, nil
+}
+
+// embeddedQrLink returns the link with the body of the qr code embedded. With auto, it returns the shortest link that
+// fits in the configured max length, which can be the one pointing to the qr store.
+func (s *Server) embeddedQrLink(ctx context.Context, id uuid.UUID, body []byte, auto bool, compress *bool) (string, error) {
+	if auto {
+		return s.qrService.SelectURL(s.serverURL(ctx), id, body, s.cfg.QRCode.MaxEmbeddedLength, s.cfg.QRCode.Compression)
+	}
+	return s.qrService.ToEmbeddedURL(body, compress != nil && *compress)
+}
+
+// HolderCreateSession - exchanges an authentication session for a holder portal session
+func (s *Server) HolderCreateSession(ctx context.Context, request HolderCreateSessionRequestObject) (HolderCreateSessionResponseObject, error) {
+	session, err := s.holderPortal.CreateSession(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrHolderSessionNotFound) {
+			return HolderCreateSession404JSONResponse{N404JSONResponse{"authentication session not found or not authenticated yet"}}, nil
+		}
+		log.Error(ctx, "creating holder session", "err", err, "session", request.Id)
+		return HolderCreateSession500JSONResponse{N500JSONResponse{"Unexpected error while creating the holder session"}}, nil
+	}
+
+	return HolderCreateSession201JSONResponse{
+		Token:     session.Token,
+		UserID:    session.UserDID.String(),
+		ExpiresAt: TimeUTC(session.ExpiresAt),
+	}, nil
+}
+
+// HolderGetCredentials - returns the credentials of the authenticated holder
+func (s *Server) HolderGetCredentials(ctx context.Context, _ HolderGetCredentialsRequestObject) (HolderGetCredentialsResponseObject, error) {
+	credentials, err := s.holderPortal.GetCredentials(ctx, holderSessionFromContext(ctx))
+	if err != nil {
+		log.Error(ctx, "loading holder credentials", "err", err)
+		return HolderGetCredentials500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	response := make(HolderGetCredentials200JSONResponse, len(credentials))
+	for i, credential := range credentials {
+		w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+		if err != nil {
+			log.Error(ctx, "creating holder credentials response", "err", err)
+			return HolderGetCredentials500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
+		}
+		response[i] = credentialResponse(w3c, credential)
+	}
+	return response, nil
+}
+
+// HolderReissueCredential - issues again a credential of the authenticated holder
+func (s *Server) HolderReissueCredential(ctx context.Context, request HolderReissueCredentialRequestObject) (HolderReissueCredentialResponseObject, error) {
+	credential, err := s.holderPortal.ReissueCredential(ctx, holderSessionFromContext(ctx), request.Id, s.cfg.CredentialStatus.CredentialStatusType)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return HolderReissueCredential404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		if errors.Is(err, services.ErrHolderCredentialRevoked) || errors.Is(err, services.ErrIssuanceLimitExceeded) || errors.Is(err, services.ErrIssuanceCooldown) ||
+			errors.Is(err, services.ErrPolicyDenied) || errors.Is(err, services.ErrCredentialExpirationExceeded) || errors.Is(err, services.ErrCredentialUniqueKeyTaken) {
+			return HolderReissueCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "reissuing holder credential", "err", err, "id", request.Id)
+		return HolderReissueCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return HolderReissueCredential201JSONResponse{Id: credential.ID.String()}, nil
+}
+
+// HolderRequestRevocation - requests the revocation of a credential of the authenticated holder
+func (s *Server) HolderRequestRevocation(ctx context.Context, request HolderRequestRevocationRequestObject) (HolderRequestRevocationResponseObject, error) {
+	var reason string
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+	revocationRequest, err := s.revocationRequestService.Request(ctx, holderSessionFromContext(ctx), request.Id, reason)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return HolderRequestRevocation404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationRequestInvalid) || errors.Is(err, services.ErrSelfRevocationDisabled) || errors.Is(err, services.ErrCredentialRevokedAlready) {
+			return HolderRequestRevocation400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationRequestPending) {
+			return HolderRequestRevocation409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "requesting holder credential revocation", "err", err, "id", request.Id)
+		return HolderRequestRevocation500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return HolderRequestRevocation201JSONResponse(revocationRequestResponse(revocationRequest)), nil
+}
+
+// HolderGetCredentialQrCode - returns the offer QR Code of a credential of the authenticated holder
+func (s *Server) HolderGetCredentialQrCode(ctx context.Context, request HolderGetCredentialQrCodeRequestObject) (HolderGetCredentialQrCodeResponseObject, error) {
+	resp, err := s.holderPortal.GetCredentialQrCode(ctx, holderSessionFromContext(ctx), request.Id, s.serverURL(ctx), s.localizer(ctx, request.Params.Locale))
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return HolderGetCredentialQrCode404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		if errors.Is(err, services.ErrEmptyMTPProof) {
+			return HolderGetCredentialQrCode409JSONResponse{N409JSONResponse{"State must be published before fetching MTP type credentials"}}, nil
+		}
+		log.Error(ctx, "getting holder credential qr code", "err", err, "id", request.Id)
+		return HolderGetCredentialQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return HolderGetCredentialQrCode200JSONResponse{
+		QrCodeLink: resp.QrCodeURL,
+		SchemaType: resp.SchemaType,
+	}, nil
+}
+
+// HolderGetCredentialPreview returns a readable view of a credential of the holder
+func (s *Server) HolderGetCredentialPreview(ctx context.Context, request HolderGetCredentialPreviewRequestObject) (HolderGetCredentialPreviewResponseObject, error) {
+	preview, err := s.holderPortal.PreviewCredential(ctx, holderSessionFromContext(ctx), request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return HolderGetCredentialPreview404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		log.Error(ctx, "getting holder credential preview", "err", err, "id", request.Id)
+		return HolderGetCredentialPreview500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return HolderGetCredentialPreview200JSONResponse(credentialPreviewResponse(preview)), nil
+}
+
+// HolderGetCredentialPreviewHTML returns the preview of a credential of the holder as an html page
+func (s *Server) HolderGetCredentialPreviewHTML(ctx context.Context, request HolderGetCredentialPreviewHTMLRequestObject) (HolderGetCredentialPreviewHTMLResponseObject, error) {
+	html, err := s.holderPortal.PreviewCredentialHTML(ctx, holderSessionFromContext(ctx), request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return HolderGetCredentialPreviewHTML404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		log.Error(ctx, "rendering holder credential preview", "err", err, "id", request.Id)
+		return HolderGetCredentialPreviewHTML500JSONResponse{N500JSONResponse{"Unexpected error while rendering the preview"}}, nil
+	}
+	return HolderGetCredentialPreviewHTML200TexthtmlResponse{Body: bytes.NewReader(html), ContentLength: int64(len(html))}, nil
+}
+
 // CreateLinkQrCodeCallback - Callback endpoint for the link qr code creation.
 func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
@@ -668,11 +1930,25 @@ func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLin
 		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
 	}
 
-	arm, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.cfg.APIUI.ServerURL, s.cfg.APIUI.IssuerDID)
+	if msg, ok := s.checkAuthAttempt(ctx, request.Params.SessionID); !ok {
+		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{msg}}, nil
+	}
+
+	basicMessage, _, unpackErr := s.packageManager.Unpack([]byte(*request.Body))
+	if unpackErr == nil && basicMessage.Type == protocol.CredentialPaymentMessageType {
+		return s.linkPaymentCallback(ctx, request, basicMessage)
+	}
+
+	arm, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.serverURL(ctx), s.cfg.APIUI.IssuerDID)
+	s.recordAuthAttempt(ctx, request.Params.SessionID, err)
+	if errors.Is(err, services.ErrUntrustedHolder) || errors.Is(err, services.ErrUntrustedIssuer) || errors.Is(err, services.ErrConnectionDenied) {
+		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
 	if err != nil {
 		log.Debug(ctx, "error authenticating", err.Error())
 		return CreateLinkQrCodeCallback500JSONResponse{}, nil
 	}
+	s.observeAuthWallet(ctx, *request.Body, arm)
 
 	userDID, err := w3c.ParseDID(arm.From)
 	if err != nil {
@@ -680,15 +1956,141 @@ func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLin
 		return CreateLinkQrCodeCallback500JSONResponse{}, nil
 	}
 
-	err = s.linkService.IssueClaim(ctx, request.Params.SessionID.String(), s.cfg.APIUI.IssuerDID, *userDID, request.Params.LinkID, s.cfg.APIUI.ServerURL, s.cfg.CredentialStatus.CredentialStatusType)
+	err = s.linkService.SavePrerequisiteProofs(ctx, request.Params.SessionID.String(), s.cfg.APIUI.IssuerDID, *userDID, request.Params.LinkID, arm.Body.Scope)
+	if err != nil {
+		log.Debug(ctx, "error checking the link prerequisites", "err", err)
+		if errors.Is(err, services.ErrLinkPrerequisiteNotProved) {
+			return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return CreateLinkQrCodeCallback500JSONResponse{}, nil
+	}
+
+	if unpackErr == nil {
+		if err := s.saveLinkHolderProfile(ctx, request, *userDID, basicMessage); err != nil {
+			log.Debug(ctx, "error checking the link holder profile", "err", err)
+			if errors.Is(err, services.ErrLinkProfileProofInvalid) {
+				return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+			}
+			return CreateLinkQrCodeCallback500JSONResponse{}, nil
+		}
+	}
+
+	err = s.linkService.IssueClaim(ctx, request.Params.SessionID.String(), s.cfg.APIUI.IssuerDID, *userDID, request.Params.LinkID, s.serverURL(ctx), s.cfg.CredentialStatus.CredentialStatusType, clientIPFromContext(ctx))
 	if err != nil {
 		log.Debug(ctx, "error issuing the claim", "error", err)
+		if errors.Is(err, services.ErrLinkRestricted) {
+			return CreateLinkQrCodeCallback403JSONResponse{N403JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkThrottled) {
+			return s.linkThrottledCallbackResponse(err, arm.ThreadID, arm.From), nil
+		}
+		if errors.Is(err, services.ErrLinkHolderAlreadyIssued) || errors.Is(err, services.ErrPolicyDenied) || errors.Is(err, services.ErrCredentialExpirationExceeded) || errors.Is(err, services.ErrCredentialUniqueKeyTaken) {
+			return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return CreateLinkQrCodeCallback500JSONResponse{}, nil
+	}
+
+	return CreateLinkQrCodeCallback200Response{}, nil
+}
+
+// linkHolderProfileBody has the optional fields of the authorization response of a link with which a holder that
+// authenticated with a profile DID proves the genesis DID the profile derives from
+type linkHolderProfileBody struct {
+	GenesisDID   string `json:"genesisDID,omitempty"`
+	ProfileNonce string `json:"profileNonce,omitempty"`
+}
+
+// saveLinkHolderProfile checks the proof of the genesis DID of the profile of the holder, if it was sent, so the
+// link issues a single credential per identity
+func (s *Server) saveLinkHolderProfile(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject, userDID w3c.DID, basicMessage *iden3comm.BasicMessage) error {
+	var body linkHolderProfileBody
+	if err := json.Unmarshal(basicMessage.Body, &body); err != nil || body.GenesisDID == "" {
+		return nil
+	}
+	genesisDID, err := w3c.ParseDID(body.GenesisDID)
+	if err != nil {
+		return services.ErrLinkProfileProofInvalid
+	}
+	nonce, ok := new(big.Int).SetString(body.ProfileNonce, 10)
+	if !ok {
+		return services.ErrLinkProfileProofInvalid
+	}
+	return s.linkService.SaveHolderProfile(ctx, request.Params.SessionID.String(), userDID, request.Params.LinkID, *genesisDID, nonce)
+}
+
+// linkPaymentCallback verifies the payment message the holder sends after paying the payment request of a link
+// and issues the credential
+func (s *Server) linkPaymentCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject, basicMessage *iden3comm.BasicMessage) (CreateLinkQrCodeCallbackResponseObject, error) {
+	var msg protocol.CredentialPaymentMessage
+	if err := json.Unmarshal(basicMessage.Body, &msg.Body); err != nil {
+		log.Debug(ctx, "error parsing the payment message", "err", err)
+		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{"invalid payment message"}}, nil
+	}
+	msg.ID = basicMessage.ID
+	msg.ThreadID = basicMessage.ThreadID
+	msg.From = basicMessage.From
+	msg.To = basicMessage.To
+
+	err := s.linkService.Pay(ctx, request.Params.SessionID.String(), s.cfg.APIUI.IssuerDID, request.Params.LinkID, s.serverURL(ctx), s.cfg.CredentialStatus.CredentialStatusType, &msg, clientIPFromContext(ctx))
+	if err != nil {
+		log.Debug(ctx, "error paying the link", "err", err)
+		if errors.Is(err, services.ErrLinkRestricted) {
+			return CreateLinkQrCodeCallback403JSONResponse{N403JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkThrottled) {
+			return s.linkThrottledCallbackResponse(err, msg.ThreadID, msg.From), nil
+		}
+		if errors.Is(err, services.ErrPaymentNotFound) || errors.Is(err, services.ErrPaymentWrongSender) ||
+			errors.Is(err, gateways.ErrPaymentTxNotFound) || errors.Is(err, gateways.ErrPaymentTxFailed) ||
+			errors.Is(err, gateways.ErrPaymentTxTooOld) || errors.Is(err, gateways.ErrPaymentTxDoesNotMatch) ||
+			errors.Is(err, repositories.ErrPaymentTxAlreadyUsed) {
+			return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
 		return CreateLinkQrCodeCallback500JSONResponse{}, nil
 	}
 
 	return CreateLinkQrCodeCallback200Response{}, nil
 }
 
+// linkThrottledProblemCode is the code of the problem report sent to the wallets that claim a throttled link
+const linkThrottledProblemCode protocol.ProblemErrorCode = "w.link.throttled"
+
+// linkThrottledResponse returns the 429 response of a throttled link, with the seconds to wait before trying again
+func linkThrottledResponse(err error) N429JSONResponse {
+	return N429JSONResponse{
+		Body:    GenericErrorMessage{Message: err.Error()},
+		Headers: N429ResponseHeaders{RetryAfter: retryAfterSeconds(err)},
+	}
+}
+
+// linkThrottledCallbackResponse answers the wallet that claims a throttled link with a warning problem report, so it
+// can ask the holder to try again later
+func (s *Server) linkThrottledCallbackResponse(err error, threadID string, to string) CreateLinkQrCodeCallback429JSONResponse {
+	return CreateLinkQrCodeCallback429JSONResponse{
+		Body: ProblemReportMessage{
+			ID:       uuid.NewString(),
+			Typ:      packers.MediaTypePlainMessage,
+			Type:     protocol.ProblemReportMessageType,
+			ThreadID: threadID,
+			From:     s.cfg.APIUI.IssuerDID.String(),
+			To:       to,
+			Body: protocol.ProblemReportMessageBody{
+				Code:    linkThrottledProblemCode,
+				Comment: err.Error(),
+			},
+		},
+		Headers: CreateLinkQrCodeCallback429ResponseHeaders{RetryAfter: retryAfterSeconds(err)},
+	}
+}
+
+func retryAfterSeconds(err error) int {
+	var throttled *services.LinkThrottledError
+	if errors.As(err, &throttled) {
+		return int(throttled.RetryAfter() / time.Second)
+	}
+	return int(time.Minute / time.Second)
+}
+
 // GetLinkQRCode - returns te qr code for adding the credential
 func (s *Server) GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequestObject) (GetLinkQRCodeResponseObject, error) {
 	getQRCodeResponse, err := s.linkService.GetQRCode(ctx, request.Params.SessionID, s.cfg.APIUI.IssuerDID, request.Id)
@@ -699,7 +2101,7 @@ func (s *Server) GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequest
 		return GetLinkQRCode400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 	}
 
-	if getQRCodeResponse.State.Status == link_state.StatusPending || getQRCodeResponse.State.Status == link_state.StatusDone || getQRCodeResponse.State.Status == link_state.StatusPendingPublish {
+	if getQRCodeResponse.State.Status == link_state.StatusPending || getQRCodeResponse.State.Status == link_state.StatusDone || getQRCodeResponse.State.Status == link_state.StatusPendingPublish || getQRCodeResponse.State.Status == link_state.StatusPendingPayment {
 		return GetLinkQRCode200JSONResponse{
 			Status:     common.ToPointer(getQRCodeResponse.State.Status),
 			QrCode:     getQRCodeResponse.State.QRCode,
@@ -731,6 +2133,9 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 	}
 
 	agent, err := s.claimService.Agent(ctx, req, mediatype)
+	if s.walletService != nil && req.IssuerDID != nil && req.UserDID != nil {
+		s.walletService.Observe(ctx, *req.IssuerDID, *req.UserDID, walletObservation(ctx, mediatype, req.Type, errors.Is(err, services.ErrUnsupportedMediaType)))
+	}
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
 		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
@@ -747,10 +2152,26 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 	}, nil
 }
 
-// GetQrFromStore is the controller to get qr bodies
-func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error) {
-	if request.Params.Id == nil {
-		log.Warn(ctx, "qr store. Missing id parameter")
+// GetPublicCatalog returns the credentials offered through the active links of the issuer. It is not
+// authenticated, so it is only available when the public catalog is enabled.
+func (s *Server) GetPublicCatalog(ctx context.Context, _ GetPublicCatalogRequestObject) (GetPublicCatalogResponseObject, error) {
+	if !s.cfg.APIUI.PublicCatalog.Enabled {
+		return GetPublicCatalog404JSONResponse{N404JSONResponse{Message: "public catalog is not enabled"}}, nil
+	}
+
+	catalog, err := s.linkService.GetCatalog(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting the public catalog", "err", err)
+		return GetPublicCatalog500JSONResponse{N500JSONResponse{Message: "error getting the public catalog"}}, nil
+	}
+
+	return GetPublicCatalog200JSONResponse(publicCatalogResponse(catalog, s.serverURL(ctx), s.cfg.APIUI.LandingPage.Enabled)), nil
+}
+
+// GetQrFromStore is the controller to get qr bodies
+func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error) {
+	if request.Params.Id == nil {
+		log.Warn(ctx, "qr store. Missing id parameter")
 		return GetQrFromStore400JSONResponse{N400JSONResponse{"id is required"}}, nil
 	}
 	body, err := s.qrService.Find(ctx, *request.Params.Id)
@@ -761,6 +2182,732 @@ func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreReque
 	return NewQrContentResponse(body), nil
 }
 
+// GetQRBranding returns the options used to render the issuer qr code images
+func (s *Server) GetQRBranding(ctx context.Context, request GetQRBrandingRequestObject) (GetQRBrandingResponseObject, error) {
+	branding, err := s.qrBrandingService.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting qr branding", "err", err)
+		return GetQRBranding500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetQRBranding200JSONResponse(qrBrandingResponse(branding)), nil
+}
+
+// UpdateQRBranding updates the options used to render the issuer qr code images
+func (s *Server) UpdateQRBranding(ctx context.Context, request UpdateQRBrandingRequestObject) (UpdateQRBrandingResponseObject, error) {
+	branding := &domain.QRBranding{
+		IssuerDID:       s.cfg.APIUI.IssuerDID,
+		ForegroundColor: request.Body.ForegroundColor,
+		BackgroundColor: request.Body.BackgroundColor,
+		ErrorCorrection: domain.QRErrorCorrection(request.Body.ErrorCorrection),
+	}
+	if request.Body.Logo != nil {
+		branding.Logo = *request.Body.Logo
+	}
+	if err := s.qrBrandingService.Save(ctx, branding); err != nil {
+		if errors.Is(err, services.ErrQRBrandingInvalidColor) || errors.Is(err, services.ErrQRBrandingInvalidErrorCorrection) || errors.Is(err, services.ErrQRBrandingInvalidLogo) {
+			return UpdateQRBranding400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "saving qr branding", "err", err)
+		return UpdateQRBranding500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return UpdateQRBranding200JSONResponse(qrBrandingResponse(branding)), nil
+}
+
+// GetAPIKeys returns all the api keys
+func (s *Server) GetAPIKeys(ctx context.Context, _ GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error) {
+	keys, err := s.apiKeyService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting api keys", "err", err)
+		return GetAPIKeys500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetAPIKeys200JSONResponse, len(keys))
+	for i := range keys {
+		resp[i] = apiKeyResponse(&keys[i])
+	}
+	return resp, nil
+}
+
+// CreateAPIKey creates an api key and returns its secret
+func (s *Server) CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error) {
+	req := ports.CreateAPIKeyRequest{
+		Name:      request.Body.Name,
+		Scopes:    make([]domain.APIKeyScope, len(request.Body.Scopes)),
+		ExpiresAt: request.Body.ExpiresAt,
+		TenantID:  request.Body.TenantId,
+	}
+	for i, scope := range request.Body.Scopes {
+		req.Scopes[i] = domain.APIKeyScope(scope)
+	}
+	if request.Body.AllowedIPs != nil {
+		req.AllowedIPs = *request.Body.AllowedIPs
+	}
+	if req.TenantID != nil {
+		owns, err := s.tenantOwnsIssuer(ctx, *req.TenantID)
+		if err != nil {
+			return CreateAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+		if !owns {
+			return CreateAPIKey400JSONResponse{N400JSONResponse{"the tenant does not own the issuer"}}, nil
+		}
+	}
+	key, secret, err := s.apiKeyService.Create(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyNameEmpty) || errors.Is(err, services.ErrAPIKeyNoScopes) || errors.Is(err, services.ErrAPIKeyInvalidScope) ||
+			errors.Is(err, services.ErrAPIKeyInvalidIP) || errors.Is(err, services.ErrAPIKeyExpired) || errors.Is(err, repositories.ErrTenantDoesNotExist) {
+			return CreateAPIKey400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating api key", "err", err)
+		return CreateAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return CreateAPIKey201JSONResponse{ApiKey: apiKeyResponse(key), Key: secret}, nil
+}
+
+// CreateReadOnlyAPIKey creates a time-boxed read-only api key for a support engineer and returns its secret.
+// Only the admin can create them.
+func (s *Server) CreateReadOnlyAPIKey(ctx context.Context, request CreateReadOnlyAPIKeyRequestObject) (CreateReadOnlyAPIKeyResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return CreateReadOnlyAPIKey403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	req := ports.CreateReadOnlyAPIKeyRequest{
+		Name:     request.Body.Name,
+		ValidFor: time.Duration(request.Body.ValidForMinutes) * time.Minute,
+		TenantID: request.Body.TenantId,
+	}
+	if request.Body.AllowedIPs != nil {
+		req.AllowedIPs = *request.Body.AllowedIPs
+	}
+	if req.TenantID != nil {
+		owns, err := s.tenantOwnsIssuer(ctx, *req.TenantID)
+		if err != nil {
+			return CreateReadOnlyAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+		if !owns {
+			return CreateReadOnlyAPIKey400JSONResponse{N400JSONResponse{"the tenant does not own the issuer"}}, nil
+		}
+	}
+	key, secret, err := s.apiKeyService.CreateReadOnly(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyNameEmpty) || errors.Is(err, services.ErrAPIKeyInvalidIP) ||
+			errors.Is(err, services.ErrAPIKeyReadOnlyValidity) || errors.Is(err, repositories.ErrTenantDoesNotExist) {
+			return CreateReadOnlyAPIKey400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating read-only api key", "err", err)
+		return CreateReadOnlyAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return CreateReadOnlyAPIKey201JSONResponse{ApiKey: apiKeyResponse(key), Key: secret}, nil
+}
+
+// tenantOwnsIssuer returns whether the issuer of the server belongs to the tenant
+func (s *Server) tenantOwnsIssuer(ctx context.Context, tenantID uuid.UUID) (bool, error) {
+	issuer, err := s.identityService.GetByDID(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting issuer identity", "err", err)
+		return false, err
+	}
+	return issuer.TenantID != nil && *issuer.TenantID == tenantID, nil
+}
+
+// RevokeAPIKey revokes an api key
+func (s *Server) RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error) {
+	if err := s.apiKeyService.Revoke(ctx, request.Id); err != nil {
+		if errors.Is(err, services.ErrAPIKeyNotFound) {
+			return RevokeAPIKey404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "revoking api key", "err", err)
+		return RevokeAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return RevokeAPIKey200JSONResponse{Message: "api key revoked"}, nil
+}
+
+// RotateAPIKey replaces the secret of an api key
+func (s *Server) RotateAPIKey(ctx context.Context, request RotateAPIKeyRequestObject) (RotateAPIKeyResponseObject, error) {
+	key, secret, err := s.apiKeyService.Rotate(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyNotFound) {
+			return RotateAPIKey404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "rotating api key", "err", err)
+		return RotateAPIKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return RotateAPIKey200JSONResponse{ApiKey: apiKeyResponse(key), Key: secret}, nil
+}
+
+// GetPresentationTemplates returns all the presentation templates of the issuer
+func (s *Server) GetPresentationTemplates(ctx context.Context, _ GetPresentationTemplatesRequestObject) (GetPresentationTemplatesResponseObject, error) {
+	templates, err := s.presentationTemplateService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting presentation templates", "err", err)
+		return GetPresentationTemplates500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetPresentationTemplates200JSONResponse, len(templates))
+	for i := range templates {
+		resp[i] = presentationTemplateResponse(&templates[i])
+	}
+	return resp, nil
+}
+
+// CreatePresentationTemplate creates a presentation template that the links can reference by name
+func (s *Server) CreatePresentationTemplate(ctx context.Context, request CreatePresentationTemplateRequestObject) (CreatePresentationTemplateResponseObject, error) {
+	req := ports.CreatePresentationTemplateRequest{
+		Name:           request.Body.Name,
+		Description:    request.Body.Description,
+		SchemaURL:      request.Body.SchemaUrl,
+		CredentialType: request.Body.CredentialType,
+	}
+	if request.Body.ProofType != nil {
+		req.ProofType = string(*request.Body.ProofType)
+	}
+	if request.Body.AllowedIssuers != nil {
+		req.AllowedIssuers = *request.Body.AllowedIssuers
+	}
+	if request.Body.Conditions != nil {
+		req.Conditions = make([]domain.PresentationCondition, len(*request.Body.Conditions))
+		for i, cond := range *request.Body.Conditions {
+			req.Conditions[i] = domain.PresentationCondition{Field: cond.Field, Operator: domain.PresentationOperator(cond.Operator), Value: cond.Value}
+		}
+	}
+	if request.Body.DisclosedFields != nil {
+		req.DisclosedFields = *request.Body.DisclosedFields
+	}
+	template, err := s.presentationTemplateService.Create(ctx, s.cfg.APIUI.IssuerDID, req)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPresentationTemplateDuplicated) {
+			return CreatePresentationTemplate409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrPresentationTemplateInvalidName) || errors.Is(err, services.ErrPresentationTemplateInvalidSchema) ||
+			errors.Is(err, services.ErrPresentationTemplateEmptyType) || errors.Is(err, services.ErrPresentationTemplateInvalidProofType) ||
+			errors.Is(err, services.ErrPresentationTemplateInvalidIssuer) || errors.Is(err, services.ErrPresentationTemplateEmpty) ||
+			errors.Is(err, services.ErrPresentationTemplateInvalidCondition) || errors.Is(err, services.ErrPresentationTemplateRepeatedField) {
+			return CreatePresentationTemplate400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating presentation template", "err", err)
+		return CreatePresentationTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: presentation template created", "name", template.Name)
+	return CreatePresentationTemplate201JSONResponse(presentationTemplateResponse(template)), nil
+}
+
+// GetCampaigns returns the campaigns of the issuer with their progress
+func (s *Server) GetCampaigns(ctx context.Context, _ GetCampaignsRequestObject) (GetCampaignsResponseObject, error) {
+	campaigns, err := s.campaignService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting campaigns", "err", err)
+		return GetCampaigns500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetCampaigns200JSONResponse, len(campaigns))
+	for i := range campaigns {
+		resp[i] = campaignResponse(&campaigns[i])
+	}
+	return resp, nil
+}
+
+// CreateCampaign creates a campaign
+func (s *Server) CreateCampaign(ctx context.Context, request CreateCampaignRequestObject) (CreateCampaignResponseObject, error) {
+	campaign, err := s.campaignService.Create(ctx, s.cfg.APIUI.IssuerDID, ports.CampaignRequest{
+		Name:        &request.Body.Name,
+		Description: request.Body.Description,
+		StartAt:     request.Body.StartAt,
+		EndAt:       request.Body.EndAt,
+		WebhookURL:  request.Body.WebhookUrl,
+		Milestones:  request.Body.Milestones,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrCampaignInvalid) {
+			return CreateCampaign400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating campaign", "err", err)
+		return CreateCampaign500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: campaign created", "id", campaign.ID)
+	return CreateCampaign201JSONResponse(campaignResponse(campaign)), nil
+}
+
+// GetCampaign returns a campaign with its progress
+func (s *Server) GetCampaign(ctx context.Context, request GetCampaignRequestObject) (GetCampaignResponseObject, error) {
+	campaign, err := s.campaignService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) {
+			return GetCampaign404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting campaign", "err", err, "id", request.Id)
+		return GetCampaign500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetCampaign200JSONResponse(campaignResponse(campaign)), nil
+}
+
+// UpdateCampaign updates the fields of a campaign
+func (s *Server) UpdateCampaign(ctx context.Context, request UpdateCampaignRequestObject) (UpdateCampaignResponseObject, error) {
+	campaign, err := s.campaignService.Update(ctx, s.cfg.APIUI.IssuerDID, request.Id, ports.CampaignRequest{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		StartAt:     request.Body.StartAt,
+		EndAt:       request.Body.EndAt,
+		WebhookURL:  request.Body.WebhookUrl,
+		Milestones:  request.Body.Milestones,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) {
+			return UpdateCampaign404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrCampaignInvalid) {
+			return UpdateCampaign400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "updating campaign", "err", err, "id", request.Id)
+		return UpdateCampaign500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: campaign updated", "id", request.Id)
+	return UpdateCampaign200JSONResponse(campaignResponse(campaign)), nil
+}
+
+// DeleteCampaign deletes a campaign, but not its links and credentials
+func (s *Server) DeleteCampaign(ctx context.Context, request DeleteCampaignRequestObject) (DeleteCampaignResponseObject, error) {
+	if err := s.campaignService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) {
+			return DeleteCampaign404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting campaign", "err", err, "id", request.Id)
+		return DeleteCampaign500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: campaign deleted", "id", request.Id)
+	return DeleteCampaign200JSONResponse{Message: "campaign deleted"}, nil
+}
+
+// GetRevocationRules returns the revocation rules of the issuer
+func (s *Server) GetRevocationRules(ctx context.Context, _ GetRevocationRulesRequestObject) (GetRevocationRulesResponseObject, error) {
+	rules, err := s.revocationRuleService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting revocation rules", "err", err)
+		return GetRevocationRules500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetRevocationRules200JSONResponse, len(rules))
+	for i := range rules {
+		resp[i] = revocationRuleResponse(&rules[i])
+	}
+	return resp, nil
+}
+
+// CreateRevocationRule creates a revocation rule
+func (s *Server) CreateRevocationRule(ctx context.Context, request CreateRevocationRuleRequestObject) (CreateRevocationRuleResponseObject, error) {
+	rule, err := s.revocationRuleService.Create(ctx, s.cfg.APIUI.IssuerDID, ports.RevocationRuleRequest{
+		Name:           &request.Body.Name,
+		SchemaType:     &request.Body.SchemaType,
+		CronExpression: &request.Body.CronExpression,
+		WebhookURL:     request.Body.WebhookUrl,
+		Enabled:        request.Body.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRuleInvalid) {
+			return CreateRevocationRule400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating revocation rule", "err", err)
+		return CreateRevocationRule500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: revocation rule created", "id", rule.ID, "schemaType", rule.SchemaType, "cron", rule.CronExpression)
+	return CreateRevocationRule201JSONResponse(revocationRuleResponse(rule)), nil
+}
+
+// GetRevocationRule returns a revocation rule
+func (s *Server) GetRevocationRule(ctx context.Context, request GetRevocationRuleRequestObject) (GetRevocationRuleResponseObject, error) {
+	rule, err := s.revocationRuleService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRuleNotFound) {
+			return GetRevocationRule404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting revocation rule", "err", err, "id", request.Id)
+		return GetRevocationRule500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetRevocationRule200JSONResponse(revocationRuleResponse(rule)), nil
+}
+
+// UpdateRevocationRule updates the fields of a revocation rule
+func (s *Server) UpdateRevocationRule(ctx context.Context, request UpdateRevocationRuleRequestObject) (UpdateRevocationRuleResponseObject, error) {
+	rule, err := s.revocationRuleService.Update(ctx, s.cfg.APIUI.IssuerDID, request.Id, ports.RevocationRuleRequest{
+		Name:           request.Body.Name,
+		SchemaType:     request.Body.SchemaType,
+		CronExpression: request.Body.CronExpression,
+		WebhookURL:     request.Body.WebhookUrl,
+		Enabled:        request.Body.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRuleNotFound) {
+			return UpdateRevocationRule404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationRuleInvalid) {
+			return UpdateRevocationRule400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "updating revocation rule", "err", err, "id", request.Id)
+		return UpdateRevocationRule500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: revocation rule updated", "id", request.Id, "cron", rule.CronExpression, "enabled", rule.Enabled)
+	return UpdateRevocationRule200JSONResponse(revocationRuleResponse(rule)), nil
+}
+
+// DeleteRevocationRule deletes a revocation rule
+func (s *Server) DeleteRevocationRule(ctx context.Context, request DeleteRevocationRuleRequestObject) (DeleteRevocationRuleResponseObject, error) {
+	if err := s.revocationRuleService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrRevocationRuleNotFound) {
+			return DeleteRevocationRule404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting revocation rule", "err", err, "id", request.Id)
+		return DeleteRevocationRule500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: revocation rule deleted", "id", request.Id)
+	return DeleteRevocationRule200JSONResponse{Message: "revocation rule deleted"}, nil
+}
+
+// PreviewRevocationRule returns the credentials a revocation rule would revoke if it ran now, without revoking them
+func (s *Server) PreviewRevocationRule(ctx context.Context, request PreviewRevocationRuleRequestObject) (PreviewRevocationRuleResponseObject, error) {
+	preview, err := s.revocationRuleService.Preview(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRuleNotFound) {
+			return PreviewRevocationRule404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "previewing revocation rule", "err", err, "id", request.Id)
+		return PreviewRevocationRule500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return PreviewRevocationRule200JSONResponse(revocationRulePreviewResponse(preview)), nil
+}
+
+// GetRevocationNonceRanges returns the revocation nonce ranges reserved by the issuer
+func (s *Server) GetRevocationNonceRanges(ctx context.Context, _ GetRevocationNonceRangesRequestObject) (GetRevocationNonceRangesResponseObject, error) {
+	ranges, err := s.revocationNonceService.GetRanges(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting revocation nonce ranges", "err", err)
+		return GetRevocationNonceRanges500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetRevocationNonceRanges200JSONResponse, len(ranges))
+	for i := range ranges {
+		resp[i] = revocationNonceRangeResponse(&ranges[i])
+	}
+	return resp, nil
+}
+
+// ReserveRevocationNonceRange reserves a range of revocation nonces for an integration
+func (s *Server) ReserveRevocationNonceRange(ctx context.Context, request ReserveRevocationNonceRangeRequestObject) (ReserveRevocationNonceRangeResponseObject, error) {
+	nonceRange, err := s.revocationNonceService.ReserveRange(ctx, s.cfg.APIUI.IssuerDID, request.Body.Integration, request.Body.Size)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationNonceIntegration) || errors.Is(err, services.ErrRevocationNonceRangeSize) ||
+			errors.Is(err, services.ErrRevocationNoncesExhausted) {
+			return ReserveRevocationNonceRange400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return ReserveRevocationNonceRange500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ReserveRevocationNonceRange201JSONResponse(revocationNonceRangeResponse(nonceRange)), nil
+}
+
+// GetRevocationRequests returns the revocation requests sent by the holders
+func (s *Server) GetRevocationRequests(ctx context.Context, request GetRevocationRequestsRequestObject) (GetRevocationRequestsResponseObject, error) {
+	var status *domain.RevocationRequestStatus
+	if request.Params.Status != nil {
+		status = common.ToPointer(domain.RevocationRequestStatus(*request.Params.Status))
+	}
+	requests, err := s.revocationRequestService.GetAll(ctx, s.cfg.APIUI.IssuerDID, status)
+	if err != nil {
+		log.Error(ctx, "getting revocation requests", "err", err)
+		return GetRevocationRequests500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetRevocationRequests200JSONResponse, len(requests))
+	for i := range requests {
+		resp[i] = revocationRequestResponse(&requests[i])
+	}
+	return resp, nil
+}
+
+// ApproveRevocationRequest revokes the credential of a pending revocation request
+func (s *Server) ApproveRevocationRequest(ctx context.Context, request ApproveRevocationRequestRequestObject) (ApproveRevocationRequestResponseObject, error) {
+	revocationRequest, err := s.revocationRequestService.Approve(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Note)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRequestNotFound) || errors.Is(err, services.ErrClaimNotFound) {
+			return ApproveRevocationRequest404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationRequestResolved) {
+			return ApproveRevocationRequest409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "approving revocation request", "err", err, "id", request.Id)
+		return ApproveRevocationRequest500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: revocation request approved", "id", request.Id, "credential", revocationRequest.CredentialID)
+	return ApproveRevocationRequest200JSONResponse(revocationRequestResponse(revocationRequest)), nil
+}
+
+// RejectRevocationRequest rejects a pending revocation request keeping the credential
+func (s *Server) RejectRevocationRequest(ctx context.Context, request RejectRevocationRequestRequestObject) (RejectRevocationRequestResponseObject, error) {
+	revocationRequest, err := s.revocationRequestService.Reject(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Note)
+	if err != nil {
+		if errors.Is(err, services.ErrRevocationRequestNotFound) {
+			return RejectRevocationRequest404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrRevocationRequestResolved) {
+			return RejectRevocationRequest409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "rejecting revocation request", "err", err, "id", request.Id)
+		return RejectRevocationRequest500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: revocation request rejected", "id", request.Id, "credential", revocationRequest.CredentialID)
+	return RejectRevocationRequest200JSONResponse(revocationRequestResponse(revocationRequest)), nil
+}
+
+// AddCampaignLinks adds links to a campaign
+func (s *Server) AddCampaignLinks(ctx context.Context, request AddCampaignLinksRequestObject) (AddCampaignLinksResponseObject, error) {
+	if err := s.campaignService.AddLinks(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.LinkIds); err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) || errors.Is(err, services.ErrCampaignLinkNotFound) {
+			return AddCampaignLinks404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrCampaignEmptyItems) {
+			return AddCampaignLinks400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "adding links to campaign", "err", err, "id", request.Id)
+		return AddCampaignLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: links added to campaign", "id", request.Id, "links", len(request.Body.LinkIds))
+	return AddCampaignLinks200JSONResponse{Message: "links added"}, nil
+}
+
+// DeleteCampaignLink removes a link from a campaign
+func (s *Server) DeleteCampaignLink(ctx context.Context, request DeleteCampaignLinkRequestObject) (DeleteCampaignLinkResponseObject, error) {
+	if err := s.campaignService.RemoveLink(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.LinkID); err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) || errors.Is(err, services.ErrCampaignLinkNotFound) {
+			return DeleteCampaignLink404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "removing link from campaign", "err", err, "id", request.Id, "link", request.LinkID)
+		return DeleteCampaignLink500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: link removed from campaign", "id", request.Id, "link", request.LinkID)
+	return DeleteCampaignLink200JSONResponse{Message: "link removed"}, nil
+}
+
+// AddCampaignCredentials adds credentials issued without a link to a campaign
+func (s *Server) AddCampaignCredentials(ctx context.Context, request AddCampaignCredentialsRequestObject) (AddCampaignCredentialsResponseObject, error) {
+	if err := s.campaignService.AddCredentials(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.CredentialIds); err != nil {
+		if errors.Is(err, services.ErrCampaignNotFound) || errors.Is(err, services.ErrCampaignCredentialNotFound) {
+			return AddCampaignCredentials404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrCampaignEmptyItems) {
+			return AddCampaignCredentials400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "adding credentials to campaign", "err", err, "id", request.Id)
+		return AddCampaignCredentials500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: credentials added to campaign", "id", request.Id, "credentials", len(request.Body.CredentialIds))
+	return AddCampaignCredentials200JSONResponse{Message: "credentials added"}, nil
+}
+
+// GetPresentationTemplate returns a presentation template by name
+func (s *Server) GetPresentationTemplate(ctx context.Context, request GetPresentationTemplateRequestObject) (GetPresentationTemplateResponseObject, error) {
+	template, err := s.presentationTemplateService.GetByName(ctx, s.cfg.APIUI.IssuerDID, request.Name)
+	if err != nil {
+		if errors.Is(err, services.ErrPresentationTemplateNotFound) {
+			return GetPresentationTemplate404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting presentation template", "err", err, "name", request.Name)
+		return GetPresentationTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetPresentationTemplate200JSONResponse(presentationTemplateResponse(template)), nil
+}
+
+// DeletePresentationTemplate deletes a presentation template that is not used by any link
+func (s *Server) DeletePresentationTemplate(ctx context.Context, request DeletePresentationTemplateRequestObject) (DeletePresentationTemplateResponseObject, error) {
+	if err := s.presentationTemplateService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Name); err != nil {
+		if errors.Is(err, services.ErrPresentationTemplateNotFound) {
+			return DeletePresentationTemplate404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, repositories.ErrPresentationTemplateInUse) {
+			return DeletePresentationTemplate400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting presentation template", "err", err, "name", request.Name)
+		return DeletePresentationTemplate500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: presentation template deleted", "name", request.Name)
+	return DeletePresentationTemplate200JSONResponse{Message: "presentation template deleted"}, nil
+}
+
+// GetIssuerProfiles returns all the profiles of the issuer
+func (s *Server) GetIssuerProfiles(ctx context.Context, _ GetIssuerProfilesRequestObject) (GetIssuerProfilesResponseObject, error) {
+	profiles, err := s.issuerProfileService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting issuer profiles", "err", err)
+		return GetIssuerProfiles500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetIssuerProfiles200JSONResponse, len(profiles))
+	for i := range profiles {
+		resp[i] = issuerProfileResponse(&profiles[i])
+	}
+	return resp, nil
+}
+
+// CreateIssuerProfile creates a profile of the issuer that credentials can be issued on behalf of
+func (s *Server) CreateIssuerProfile(ctx context.Context, request CreateIssuerProfileRequestObject) (CreateIssuerProfileResponseObject, error) {
+	req := ports.CreateIssuerProfileRequest{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		LogoURL:     request.Body.LogoUrl,
+	}
+	if request.Body.AllowedProofs != nil {
+		req.AllowedProofs = *request.Body.AllowedProofs
+	}
+	profile, err := s.issuerProfileService.Create(ctx, s.cfg.APIUI.IssuerDID, req)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIssuerProfileDuplicated) {
+			return CreateIssuerProfile409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrIssuerProfileInvalidName) || errors.Is(err, services.ErrIssuerProfileInvalidLogo) ||
+			errors.Is(err, services.ErrIssuerProfileInvalidProofType) {
+			return CreateIssuerProfile400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating issuer profile", "err", err)
+		return CreateIssuerProfile500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: issuer profile created", "id", profile.ID, "did", profile.DID.String())
+	return CreateIssuerProfile201JSONResponse(issuerProfileResponse(profile)), nil
+}
+
+// GetIssuerProfile returns an issuer profile by id
+func (s *Server) GetIssuerProfile(ctx context.Context, request GetIssuerProfileRequestObject) (GetIssuerProfileResponseObject, error) {
+	profile, err := s.issuerProfileService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrIssuerProfileNotFound) {
+			return GetIssuerProfile404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting issuer profile", "err", err, "id", request.Id)
+		return GetIssuerProfile500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetIssuerProfile200JSONResponse(issuerProfileResponse(profile)), nil
+}
+
+// DeleteIssuerProfile deletes an issuer profile that has not issued credentials
+func (s *Server) DeleteIssuerProfile(ctx context.Context, request DeleteIssuerProfileRequestObject) (DeleteIssuerProfileResponseObject, error) {
+	if err := s.issuerProfileService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrIssuerProfileNotFound) {
+			return DeleteIssuerProfile404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, repositories.ErrIssuerProfileInUse) {
+			return DeleteIssuerProfile400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting issuer profile", "err", err, "id", request.Id)
+		return DeleteIssuerProfile500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: issuer profile deleted", "id", request.Id)
+	return DeleteIssuerProfile200JSONResponse{Message: "issuer profile deleted"}, nil
+}
+
+// GetOrganizationCredentials returns the credentials third parties issued to the issuer
+func (s *Server) GetOrganizationCredentials(ctx context.Context, request GetOrganizationCredentialsRequestObject) (GetOrganizationCredentialsResponseObject, error) {
+	var credentialType string
+	if request.Params.Type != nil {
+		credentialType = *request.Params.Type
+	}
+	credentials, err := s.organizationCredentials.GetAll(ctx, s.cfg.APIUI.IssuerDID, credentialType)
+	if err != nil {
+		log.Error(ctx, "getting organization credentials", "err", err)
+		return GetOrganizationCredentials500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetOrganizationCredentials200JSONResponse, len(credentials))
+	for i := range credentials {
+		resp[i] = organizationCredentialResponse(&credentials[i])
+	}
+	return resp, nil
+}
+
+// ReceiveOrganizationCredential stores a credential a third party issued to the issuer
+func (s *Server) ReceiveOrganizationCredential(ctx context.Context, request ReceiveOrganizationCredentialRequestObject) (ReceiveOrganizationCredentialResponseObject, error) {
+	public := request.Body.Public != nil && *request.Body.Public
+	credential, err := s.organizationCredentials.Receive(ctx, s.cfg.APIUI.IssuerDID, request.Body.Credential, public)
+	if err != nil {
+		if errors.Is(err, services.ErrOrganizationCredentialInvalid) || errors.Is(err, services.ErrOrganizationCredentialExpired) {
+			return ReceiveOrganizationCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, repositories.ErrOrganizationCredentialDuplicated) {
+			return ReceiveOrganizationCredential409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "receiving organization credential", "err", err)
+		return ReceiveOrganizationCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: organization credential received", "id", credential.ID, "type", credential.Type(), "issuer", credential.Credential.Issuer)
+	return ReceiveOrganizationCredential201JSONResponse(organizationCredentialResponse(credential)), nil
+}
+
+// GetOrganizationCredential returns an organization credential by id
+func (s *Server) GetOrganizationCredential(ctx context.Context, request GetOrganizationCredentialRequestObject) (GetOrganizationCredentialResponseObject, error) {
+	credential, err := s.organizationCredentials.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrOrganizationCredentialNotFound) {
+			return GetOrganizationCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting organization credential", "err", err, "id", request.Id)
+		return GetOrganizationCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetOrganizationCredential200JSONResponse(organizationCredentialResponse(credential)), nil
+}
+
+// UpdateOrganizationCredential sets whether an organization credential is presented to the verifiers
+func (s *Server) UpdateOrganizationCredential(ctx context.Context, request UpdateOrganizationCredentialRequestObject) (UpdateOrganizationCredentialResponseObject, error) {
+	credential, err := s.organizationCredentials.SetPublic(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Public)
+	if err != nil {
+		if errors.Is(err, services.ErrOrganizationCredentialNotFound) {
+			return UpdateOrganizationCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "updating organization credential", "err", err, "id", request.Id)
+		return UpdateOrganizationCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: organization credential updated", "id", request.Id, "public", request.Body.Public)
+	return UpdateOrganizationCredential200JSONResponse(organizationCredentialResponse(credential)), nil
+}
+
+// DeleteOrganizationCredential deletes an organization credential
+func (s *Server) DeleteOrganizationCredential(ctx context.Context, request DeleteOrganizationCredentialRequestObject) (DeleteOrganizationCredentialResponseObject, error) {
+	if err := s.organizationCredentials.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrOrganizationCredentialNotFound) {
+			return DeleteOrganizationCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting organization credential", "err", err, "id", request.Id)
+		return DeleteOrganizationCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: organization credential deleted", "id", request.Id)
+	return DeleteOrganizationCredential200JSONResponse{Message: "organization credential deleted"}, nil
+}
+
+// GetOrganizationPresentation returns the presentation of the public organization credentials of the issuer. It is
+// not authenticated.
+func (s *Server) GetOrganizationPresentation(ctx context.Context, request GetOrganizationPresentationRequestObject) (GetOrganizationPresentationResponseObject, error) {
+	var credentialType string
+	if request.Params.Type != nil {
+		credentialType = *request.Params.Type
+	}
+	presentation, err := s.organizationCredentials.Present(ctx, s.cfg.APIUI.IssuerDID, credentialType)
+	if err != nil {
+		log.Error(ctx, "getting organization presentation", "err", err)
+		return GetOrganizationPresentation500JSONResponse{N500JSONResponse{Message: "error getting the organization presentation"}}, nil
+	}
+	return GetOrganizationPresentation200JSONResponse(organizationPresentationResponse(presentation)), nil
+}
+
+// GetQrImageFromStore returns the png image of the qr code that links to a stored qr code body
+func (s *Server) GetQrImageFromStore(ctx context.Context, request GetQrImageFromStoreRequestObject) (GetQrImageFromStoreResponseObject, error) {
+	size := services.DefaultQRImageSize
+	if request.Params.Size != nil {
+		size = *request.Params.Size
+	}
+	img, err := s.qrBrandingService.RenderStoredQR(ctx, s.serverURL(ctx), request.Params.Id, size)
+	if err != nil {
+		if errors.Is(err, services.ErrQRImageInvalidSize) {
+			return GetQrImageFromStore400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrQRCodeLinkNotFound) {
+			return GetQrImageFromStore404JSONResponse{N404JSONResponse{"qr code not found"}}, nil
+		}
+		log.Error(ctx, "qr store. Rendering qr image", "err", err, "id", request.Params.Id)
+		return GetQrImageFromStore500JSONResponse{N500JSONResponse{"error rendering qr image"}}, nil
+	}
+	return GetQrImageFromStore200ImagepngResponse{Body: bytes.NewReader(img), ContentLength: int64(len(img))}, nil
+}
+
+func qrSnippetSize(size *int) int {
+	if size == nil {
+		return services.DefaultQRSnippetImageSize
+	}
+	return *size
+}
+
 func getConnectionsFilter(req GetConnectionsRequestObject) (*ports.NewGetAllConnectionsRequest, error) {
 	if req.Params.Page != nil && *req.Params.Page <= 0 {
 		return nil, errors.New("page must be greater than 0")
@@ -811,6 +2958,10 @@ func getCredentialsFilter(ctx context.Context, req GetCredentialsRequestObject)
 	if req.Params.Query != nil {
 		filter.FTSQuery = *req.Params.Query
 	}
+	if req.Params.ExternalId != nil {
+		filter.ExternalID = *req.Params.ExternalId
+	}
+	filter.ProfileID = req.Params.ProfileID
 
 	filter.MaxResults = 50
 	if req.Params.MaxResults != nil {
@@ -860,6 +3011,9 @@ func isBeforeNow(t time.Time) bool {
 func RegisterStatic(mux *chi.Mux) {
 	mux.Get("/", documentation)
 	mux.Get("/static/docs/api_ui/api.yaml", swagger)
+	mux.Get("/static/docs/api_ui/api.v2.yaml", apiversion.SpecHandler("api_ui/api.yaml"))
+	mux.Get("/static/clients/api_ui/client.ts", tsclient.Handler("api_ui/api.yaml"))
+	mux.Get("/static/clients/api_ui/client.v2.ts", tsclient.V2Handler("api_ui/api.yaml"))
 	mux.Get("/favicon.ico", favicon)
 }
 
@@ -896,6 +3050,89 @@ func toVerifiableRefreshService(s *RefreshService) *verifiable.RefreshService {
 	}
 }
 
+func toLinkPayment(p *LinkPayment) *domain.LinkPayment {
+	if p == nil {
+		return nil
+	}
+	tokens := make([]domain.PaymentToken, len(p.Tokens))
+	for i, token := range p.Tokens {
+		tokens[i] = domain.PaymentToken{Currency: token.Currency}
+		if token.Address != nil {
+			tokens[i].Address = *token.Address
+		}
+	}
+	payment := &domain.LinkPayment{
+		Amount:    p.Amount,
+		ChainID:   p.ChainId,
+		Recipient: p.Recipient,
+		Tokens:    tokens,
+	}
+	if p.Description != nil {
+		payment.Description = *p.Description
+	}
+	return payment
+}
+
+func toLinkPrerequisites(prerequisites *[]LinkPrerequisite) []ports.LinkPrerequisiteRequest {
+	if prerequisites == nil {
+		return nil
+	}
+	reqs := make([]ports.LinkPrerequisiteRequest, len(*prerequisites))
+	for i, p := range *prerequisites {
+		reqs[i] = ports.LinkPrerequisiteRequest{SchemaURL: p.SchemaUrl, CredentialType: p.CredentialType}
+		if p.ProofType != nil {
+			reqs[i].ProofType = string(*p.ProofType)
+		}
+		if p.AllowedIssuers != nil {
+			reqs[i].AllowedIssuers = *p.AllowedIssuers
+		}
+	}
+	return reqs
+}
+
+func toLinkRestrictions(restrictions *LinkRestrictions) *domain.LinkRestrictions {
+	if restrictions == nil {
+		return nil
+	}
+	r := &domain.LinkRestrictions{}
+	if restrictions.IpRanges != nil {
+		r.IPRanges = *restrictions.IpRanges
+	}
+	if restrictions.Countries != nil {
+		r.Countries = *restrictions.Countries
+	}
+	return r
+}
+
+func toLinkResultWebhook(webhook *LinkResultWebhook) *domain.LinkResultWebhook {
+	if webhook == nil {
+		return nil
+	}
+	return &domain.LinkResultWebhook{URL: webhook.Url, Secret: webhook.Secret}
+}
+
+func toLinkThrottle(throttle *LinkThrottle) *domain.LinkThrottle {
+	if throttle == nil {
+		return nil
+	}
+	t := &domain.LinkThrottle{MaxClaimsPerHour: throttle.MaxClaimsPerHour}
+	if throttle.TimeZone != nil {
+		t.TimeZone = *throttle.TimeZone
+	}
+	if throttle.Windows != nil {
+		for _, w := range *throttle.Windows {
+			window := domain.LinkTimeWindow{Start: w.Start, End: w.End}
+			if w.Days != nil {
+				for _, day := range *w.Days {
+					window.Days = append(window.Days, time.Weekday(day))
+				}
+			}
+			t.Windows = append(t.Windows, window)
+		}
+	}
+	return t
+}
+
 func toDisplayMethodService(s *DisplayMethod) *verifiable.DisplayMethod {
 	if s == nil {
 		return nil
@@ -905,3 +3142,246 @@ func toDisplayMethodService(s *DisplayMethod) *verifiable.DisplayMethod {
 		Type: verifiable.DisplayMethodType(s.Type),
 	}
 }
+
+func toCredentialFetchPolicy(p CredentialFetchPolicy) domain.CredentialFetchPolicy {
+	policy := domain.CredentialFetchPolicy{Type: domain.CredentialFetchPolicyType(p.Type)}
+	if p.FreshAuthMinutes != nil {
+		policy.FreshAuthMinutes = *p.FreshAuthMinutes
+	}
+	return policy
+}
+
+func toAttributeProvenance(p *map[string]AttributeProvenance) map[string]domain.AttributeProvenance {
+	if p == nil {
+		return nil
+	}
+	provenance := make(map[string]domain.AttributeProvenance, len(*p))
+	for attribute, ap := range *p {
+		provenance[attribute] = domain.AttributeProvenance{Source: ap.Source, VerificationMethod: ap.VerificationMethod, VerifiedAt: ap.VerifiedAt}
+	}
+	return provenance
+}
+
+func toCredentialExtensions(evidence *[]Evidence, termsOfUse *[]TermsOfUse) domain.CredentialExtensions {
+	var ext domain.CredentialExtensions
+	if evidence != nil {
+		for _, e := range *evidence {
+			ext.Evidence = append(ext.Evidence, domain.Evidence(e))
+		}
+	}
+	if termsOfUse != nil {
+		for _, t := range *termsOfUse {
+			ext.TermsOfUse = append(ext.TermsOfUse, domain.TermsOfUse(t))
+		}
+	}
+	return ext
+}
+
+// GetTranslations returns the translations of the issuer to every locale
+func (s *Server) GetTranslations(ctx context.Context, _ GetTranslationsRequestObject) (GetTranslationsResponseObject, error) {
+	bundles, err := s.translationService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting translations", "err", err)
+		return GetTranslations500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetTranslations200JSONResponse, len(bundles))
+	for i := range bundles {
+		resp[i] = translationResponse(&bundles[i])
+	}
+	return resp, nil
+}
+
+// GetTranslation returns the translations of the issuer to a locale
+func (s *Server) GetTranslation(ctx context.Context, request GetTranslationRequestObject) (GetTranslationResponseObject, error) {
+	bundle, err := s.translationService.Get(ctx, s.cfg.APIUI.IssuerDID, request.Locale)
+	if err != nil {
+		if errors.Is(err, services.ErrTranslationNotFound) {
+			return GetTranslation404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrTranslationInvalidLocale) {
+			return GetTranslation400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting translation", "err", err, "locale", request.Locale)
+		return GetTranslation500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetTranslation200JSONResponse(translationResponse(bundle)), nil
+}
+
+// SaveTranslation creates or replaces the translations of the issuer to a locale
+func (s *Server) SaveTranslation(ctx context.Context, request SaveTranslationRequestObject) (SaveTranslationResponseObject, error) {
+	bundle, err := s.translationService.Save(ctx, s.cfg.APIUI.IssuerDID, request.Locale, request.Body.Messages)
+	if err != nil {
+		if errors.Is(err, services.ErrTranslationInvalidLocale) || errors.Is(err, services.ErrTranslationEmptyMessages) ||
+			errors.Is(err, services.ErrTranslationInvalidKey) || errors.Is(err, services.ErrTranslationMessageTooLong) {
+			return SaveTranslation400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "saving translation", "err", err, "locale", request.Locale)
+		return SaveTranslation500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return SaveTranslation200JSONResponse(translationResponse(bundle)), nil
+}
+
+// DeleteTranslation deletes the translations of the issuer to a locale
+func (s *Server) DeleteTranslation(ctx context.Context, request DeleteTranslationRequestObject) (DeleteTranslationResponseObject, error) {
+	if err := s.translationService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Locale); err != nil {
+		if errors.Is(err, services.ErrTranslationNotFound) {
+			return DeleteTranslation404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrTranslationInvalidLocale) {
+			return DeleteTranslation400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting translation", "err", err, "locale", request.Locale)
+		return DeleteTranslation500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return DeleteTranslation200JSONResponse{Message: "translation deleted"}, nil
+}
+
+// GetMigrations returns the applied and pending database migrations
+func (s *Server) GetMigrations(ctx context.Context, _ GetMigrationsRequestObject) (GetMigrationsResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return GetMigrations403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	migrations, err := s.migrationsService.Status(ctx)
+	if err != nil {
+		log.Error(ctx, "get migrations", "err", err)
+		return GetMigrations500JSONResponse{N500JSONResponse{Message: "error getting the migrations"}}, nil
+	}
+	return GetMigrations200JSONResponse(migrationsStatusResponse(migrations)), nil
+}
+
+// RunMigrations applies the pending database migrations
+func (s *Server) RunMigrations(ctx context.Context, _ RunMigrationsRequestObject) (RunMigrationsResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return RunMigrations403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	log.Info(ctx, "audit: running database migrations")
+	applied, err := s.migrationsService.Up(ctx)
+	if err != nil {
+		if errors.Is(err, services.ErrMigrationsLocked) {
+			return RunMigrations409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "run migrations", "err", err, "applied", len(applied))
+		return RunMigrations500JSONResponse{N500JSONResponse{Message: fmt.Sprintf("error running the migrations, %d applied: %s", len(applied), err)}}, nil
+	}
+	migrations, err := s.migrationsService.Status(ctx)
+	if err != nil {
+		log.Error(ctx, "get migrations", "err", err)
+		return RunMigrations500JSONResponse{N500JSONResponse{Message: "migrations applied, error getting their status"}}, nil
+	}
+	log.Info(ctx, "audit: database migrations applied", "applied", len(applied), "version", domain.CurrentMigrationVersion(migrations))
+	return RunMigrations200JSONResponse{
+		CurrentVersion: domain.CurrentMigrationVersion(migrations),
+		Applied:        migrationsResponse(applied),
+	}, nil
+}
+
+// GetKeyMigrations returns the migrations of the issuer auth key to the hardware-backed custody
+func (s *Server) GetKeyMigrations(ctx context.Context, _ GetKeyMigrationsRequestObject) (GetKeyMigrationsResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return GetKeyMigrations403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	migrations, err := s.keyMigrationService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get key migrations", "err", err)
+		return GetKeyMigrations500JSONResponse{N500JSONResponse{Message: "error getting the key migrations"}}, nil
+	}
+	resp := make(GetKeyMigrations200JSONResponse, len(migrations))
+	for i := range migrations {
+		resp[i] = keyMigrationResponse(&migrations[i])
+	}
+	return resp, nil
+}
+
+// StartKeyMigration starts the migration of the issuer auth key to the hardware-backed custody
+func (s *Server) StartKeyMigration(ctx context.Context, request StartKeyMigrationRequestObject) (StartKeyMigrationResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return StartKeyMigration403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	revokeOldAuthClaim := request.Body.RevokeOldAuthClaim != nil && *request.Body.RevokeOldAuthClaim
+	log.Info(ctx, "audit: starting key migration", "revokeOldAuthClaim", revokeOldAuthClaim)
+	migration, err := s.keyMigrationService.Start(ctx, s.cfg.APIUI.IssuerDID, revokeOldAuthClaim)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrKeyMigrationNoCustody), errors.Is(err, services.ErrKeyMigrationAlreadyInCustody):
+			return StartKeyMigration400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrKeyMigrationInProgress):
+			return StartKeyMigration409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "start key migration", "err", err)
+		return StartKeyMigration500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: key migration started", "id", migration.ID, "step", migration.Step, "status", migration.Status)
+	return StartKeyMigration201JSONResponse(keyMigrationResponse(migration)), nil
+}
+
+// GetKeyMigration returns a key migration with the status of its steps
+func (s *Server) GetKeyMigration(ctx context.Context, request GetKeyMigrationRequestObject) (GetKeyMigrationResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return GetKeyMigration403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	migration, err := s.keyMigrationService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrKeyMigrationNotFound) {
+			return GetKeyMigration404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get key migration", "err", err, "id", request.Id)
+		return GetKeyMigration500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetKeyMigration200JSONResponse(keyMigrationResponse(migration)), nil
+}
+
+// ResumeKeyMigration runs the steps of a waiting or failed key migration
+func (s *Server) ResumeKeyMigration(ctx context.Context, request ResumeKeyMigrationRequestObject) (ResumeKeyMigrationResponseObject, error) {
+	if roleFromContext(ctx) != roleAdmin {
+		return ResumeKeyMigration403JSONResponse{N403JSONResponse{Message: "admin credentials required"}}, nil
+	}
+	log.Info(ctx, "audit: resuming key migration", "id", request.Id)
+	migration, err := s.keyMigrationService.Resume(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrKeyMigrationNotFound):
+			return ResumeKeyMigration404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrKeyMigrationCompleted), errors.Is(err, services.ErrKeyMigrationInProgress):
+			return ResumeKeyMigration409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "resume key migration", "err", err, "id", request.Id)
+		return ResumeKeyMigration500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	log.Info(ctx, "audit: key migration resumed", "id", migration.ID, "step", migration.Step, "status", migration.Status)
+	return ResumeKeyMigration200JSONResponse(keyMigrationResponse(migration)), nil
+}
+
+// serverURL returns the base url of the links built for the request of the context. It is the url the request was
+// sent to when it comes from a trusted proxy or an allowed host, and the server url otherwise.
+func (s *Server) serverURL(ctx context.Context) string {
+	return forwarded.BaseURL(ctx, s.cfg.APIUI.ServerURL)
+}
+
+// localizer returns a localizer for the first of the given locales the issuer has translations for
+func (s *Server) localizer(ctx context.Context, locales ...*string) *domain.Localizer {
+	if s.translationService == nil {
+		return nil
+	}
+	requested := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		if locale != nil && *locale != "" {
+			requested = append(requested, *locale)
+		}
+	}
+	if len(requested) == 0 {
+		return nil
+	}
+	return s.translationService.Localizer(ctx, s.cfg.APIUI.IssuerDID, requested...)
+}
+
+// issuerDescription returns the issuer name and logo shown to holders, translated with the localizer
+func (s *Server) issuerDescription(localizer *domain.Localizer) IssuerDescription {
+	issuer := IssuerDescription{
+		DisplayName: localizer.T(domain.TranslationKeyIssuerName, s.cfg.APIUI.IssuerName),
+		Logo:        s.cfg.APIUI.IssuerLogo,
+	}
+	if description, ok := localizer.Lookup(domain.TranslationKeyIssuerDescription); ok {
+		issuer.Description = common.ToPointer(description)
+	}
+	return issuer
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -49,7 +50,7 @@ func TestServer_CheckStatus(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	schemaService := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
 
 	mediaTypeManager := services.NewMediaTypeManager(
@@ -60,8 +61,29 @@ func TestServer_CheckStatus(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, "http://localhost", pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
-	server := NewServer(&cfg, identityService, claimsService, schemaService, NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), &health.Status{})
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     "http://localhost",
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      schemaService,
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+		Health:             &health.Status{},
+	})
 	handler := getHandler(context.Background(), server)
 
 	t.Run("should return 200", func(t *testing.T) {
@@ -77,7 +99,15 @@ func TestServer_CheckStatus(t *testing.T) {
 }
 
 func TestServer_AuthCallback(t *testing.T) {
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -130,7 +160,16 @@ func TestServer_GetAuthenticationConnection(t *testing.T) {
 	claimsRepository := repositories.NewClaims()
 	qrService := services.NewQrStoreService(cachex)
 	connectionsService := services.NewConnection(connectionRepository, claimsRepository, storage)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), connectionsService, NewLinkMock(), qrService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qKDJmySKNi4GD4vYdqfLb37MSTSijg77NoRZaKfDX")
@@ -241,8 +280,17 @@ func TestServer_AuthQRCode(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
-	server := NewServer(&cfg, identityService, NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), qrService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -365,10 +413,150 @@ func TestServer_AuthQRCode(t *testing.T) {
 	}
 }
 
+func TestServer_GetConnectionReAuthQRCode(t *testing.T) {
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	qrService := services.NewQrStoreService(cachex)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	sessionRepository := repositories.NewSessionCached(cachex)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qMHFTHn2SC3XkBEJrR4eH4Yk8jRGg5bzYYG1ZGECa")
+	require.NoError(t, err)
+	server.cfg.APIUI.IssuerDID = *issuerDID
+	server.cfg.APIUI.ServerURL = "https://testing.env"
+	handler := getHandler(context.Background(), server)
+
+	fixture := tests.NewFixture(storage)
+	conn := &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	}
+	connID := fixture.CreateConnection(t, conn)
+
+	type expected struct {
+		httpCode   int
+		qrWithLink bool
+	}
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		id       uuid.UUID
+		qrType   *GetConnectionReAuthQRCodeParamsType
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "Not authorized",
+			auth: authWrong,
+			id:   connID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Connection not found",
+			auth: authOk,
+			id:   uuid.New(),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "should get a qr code with a link by default",
+			auth: authOk,
+			id:   connID,
+			expected: expected{
+				httpCode:   http.StatusOK,
+				qrWithLink: true,
+			},
+		},
+		{
+			name:   "should get a RAW qr code as requested",
+			auth:   authOk,
+			id:     connID,
+			qrType: common.ToPointer(GetConnectionReAuthQRCodeParamsTypeRaw),
+			expected: expected{
+				httpCode:   http.StatusOK,
+				qrWithLink: false,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			apiURL := fmt.Sprintf("/v1/connections/%s/reauthentication/qrcode", tc.id)
+			if tc.qrType != nil {
+				apiURL += fmt.Sprintf("?type=%s", *tc.qrType)
+			}
+			req, err := http.NewRequest("GET", apiURL, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode != http.StatusOK {
+				return
+			}
+			var resp GetConnectionReAuthQRCode200JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.NotEmpty(t, resp.SessionID)
+
+			realQR := protocol.AuthorizationRequestMessage{}
+			if tc.expected.qrWithLink {
+				qrLink := checkQRfetchURL(t, resp.QrCodeLink)
+				rr := httptest.NewRecorder()
+				req, err := http.NewRequest(http.MethodGet, qrLink, nil)
+				require.NoError(t, err)
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &realQR))
+			} else {
+				require.NoError(t, json.Unmarshal([]byte(resp.QrCodeLink), &realQR))
+			}
+
+			assert.Equal(t, issuerDID.String(), realQR.From)
+			assert.Equal(t, userDID.String(), realQR.To)
+			assert.Equal(t, protocol.AuthorizationRequestMessageType, realQR.Type)
+			assert.True(t, strings.Contains(realQR.Body.CallbackURL, "https://testing.env/v1/authentication/callback?sessionID="+resp.SessionID))
+		})
+	}
+}
+
 func TestServer_GetSchema(t *testing.T) {
 	ctx := context.Background()
 	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      schemaSrv,
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -488,7 +676,15 @@ func TestServer_GetSchemas(t *testing.T) {
 	defer teardown()
 
 	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      schemaSrv,
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -611,7 +807,15 @@ func TestServer_ImportSchema(t *testing.T) {
 	const schemaType = "KYCCountryOfResidenceCredential"
 	ctx := context.Background()
 	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      schemaSrv,
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -708,7 +912,15 @@ func TestServer_ImportSchemaIPFS(t *testing.T) {
 	const schemaType = "testNewType"
 	ctx := context.Background()
 	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      schemaSrv,
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -815,7 +1027,7 @@ func TestServer_DeleteConnection(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -825,7 +1037,19 @@ func TestServer_DeleteConnection(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -834,7 +1058,15 @@ func TestServer_DeleteConnection(t *testing.T) {
 	issuerDID, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	server.cfg.APIUI.IssuerDID = *issuerDID
 	handler := getHandler(context.Background(), server)
 
@@ -972,7 +1204,15 @@ func TestServer_DeleteConnectionCredentials(t *testing.T) {
 	claimsRepository := repositories.NewClaims()
 
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	handler := getHandler(context.Background(), server)
 
 	fixture := tests.NewFixture(storage)
@@ -1074,7 +1314,7 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -1084,7 +1324,19 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, "http://localhost", pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     "http://localhost",
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -1093,7 +1345,15 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 	issuerDID, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	server.cfg.APIUI.IssuerDID = *issuerDID
 	handler := getHandler(context.Background(), server)
 
@@ -1194,7 +1454,7 @@ func TestServer_CreateCredential(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	pubSub := pubsub.NewMock()
 
 	mediaTypeManager := services.NewMediaTypeManager(
@@ -1205,7 +1465,19 @@ func TestServer_CreateCredential(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubSub,
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -1214,7 +1486,15 @@ func TestServer_CreateCredential(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	handler := getHandler(ctx, server)
 
@@ -1380,7 +1660,7 @@ func TestServer_DeleteCredential(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -1390,9 +1670,29 @@ func TestServer_DeleteCredential(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, "http://localhost", pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     "http://localhost",
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	handler := getHandler(context.Background(), server)
 
 	fixture := tests.NewFixture(storage)
@@ -1492,7 +1792,7 @@ func TestServer_GetCredential(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -1502,7 +1802,19 @@ func TestServer_GetCredential(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -1510,7 +1822,15 @@ func TestServer_GetCredential(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -1709,7 +2029,7 @@ func TestServer_GetCredentials(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -1719,7 +2039,19 @@ func TestServer_GetCredentials(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	schemaService := services.NewSchema(schemaRepository, schemaLoader)
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -1728,7 +2060,15 @@ func TestServer_GetCredentials(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -2189,7 +2529,7 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -2199,7 +2539,20 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		QrService:                qrService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -2207,7 +2560,16 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), qrService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	handler := getHandler(ctx, server)
 
 	credentialSubject := map[string]any{
@@ -2363,7 +2725,7 @@ func TestServer_GetConnection(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -2373,7 +2735,19 @@ func TestServer_GetConnection(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -2382,7 +2756,15 @@ func TestServer_GetConnection(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	fixture := tests.NewFixture(storage)
 	claim := fixture.NewClaim(t, did.String())
@@ -2538,6 +2920,22 @@ func TestServer_GetConnection(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("v2 answers not found for a connection that does not exist", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/connections/%s", uuid.New()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		apiversion.Middleware(handler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		var response apiversion.Envelope
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Error)
+		assert.Equal(t, "not_found", response.Error.Code)
+		assert.Equal(t, "The given connection does not exist", response.Error.Message)
+	})
 }
 
 func TestServer_GetConnections(t *testing.T) {
@@ -2557,7 +2955,7 @@ func TestServer_GetConnections(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -2567,7 +2965,19 @@ func TestServer_GetConnections(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
@@ -2576,7 +2986,15 @@ func TestServer_GetConnections(t *testing.T) {
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	fixture := tests.NewFixture(storage)
 
@@ -3244,7 +3662,7 @@ func TestServer_RevokeCredential(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -3254,7 +3672,19 @@ func TestServer_RevokeCredential(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 
 	fixture := tests.NewFixture(storage)
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
@@ -3266,7 +3696,15 @@ func TestServer_RevokeCredential(t *testing.T) {
 
 	cfg.APIUI.IssuerDID = *did
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	idClaim, err := uuid.NewUUID()
 	require.NoError(t, err)
@@ -3393,7 +3831,7 @@ func TestServer_CreateLink(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	pubSub := pubsub.NewMock()
 
 	mediaTypeManager := services.NewMediaTypeManager(
@@ -3404,9 +3842,21 @@ func TestServer_CreateLink(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubSub,
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRespository, schemaLoader, sessionRepository, pubSub, ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRespository, schemaLoader, sessionRepository, pubSub, ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -3419,7 +3869,15 @@ func TestServer_CreateLink(t *testing.T) {
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	handler := getHandler(ctx, server)
 
@@ -3628,7 +4086,7 @@ func TestServer_ActivateLink(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -3638,9 +4096,21 @@ func TestServer_ActivateLink(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -3653,10 +4123,25 @@ func TestServer_ActivateLink(t *testing.T) {
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	tomorrow := time.Now().Add(24 * time.Hour)
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, CredentialSubject{"birthday": 19790911, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 importedSchema.ID,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     CredentialSubject{"birthday": 19790911, "documentType": 12},
+	})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -3786,7 +4271,7 @@ func TestServer_GetLink(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -3796,9 +4281,21 @@ func TestServer_GetLink(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -3811,16 +4308,40 @@ func TestServer_GetLink(t *testing.T) {
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     common.ToPointer(tomorrow),
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	require.NoError(t, err)
 	hash, _ := link.Schema.Hash.MarshalText()
 
-	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkExpired, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &yesterday,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     common.ToPointer(tomorrow),
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -3971,7 +4492,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -3981,9 +4502,21 @@ func TestServer_GetAllLinks(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -3996,42 +4529,72 @@ func TestServer_GetAllLinks(t *testing.T) {
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link1, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, &tomorrow, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
-		&verifiable.RefreshService{
+	link1, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     &tomorrow,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		RefreshService: &verifiable.RefreshService{
 			ID:   "https://refresh.xyz",
 			Type: verifiable.Iden3RefreshService2023,
 		},
-		&verifiable.DisplayMethod{
+		DisplayMethod: &verifiable.DisplayMethod{
 			ID:   "https://display.xyz",
 			Type: verifiable.Iden3BasicDisplayMethodV1,
 		},
-	)
+	})
 	require.NoError(t, err)
 	linkActive := getLinkResponse(*link1)
 
 	time.Sleep(10 * time.Millisecond)
 
-	link2, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, &tomorrow, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
-		&verifiable.RefreshService{
+	link2, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &yesterday,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     &tomorrow,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		RefreshService: &verifiable.RefreshService{
 			ID:   "https://revreshv2.xyz",
 			Type: verifiable.Iden3RefreshService2023,
 		},
-		&verifiable.DisplayMethod{
+		DisplayMethod: &verifiable.DisplayMethod{
 			ID:   "https://display.xyz",
 			Type: verifiable.Iden3BasicDisplayMethodV1,
 		},
-	)
+	})
 	require.NoError(t, err)
 	linkExpired := getLinkResponse(*link2)
 	require.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 
-	link3, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, &tomorrow, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link3, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &yesterday,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     &tomorrow,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	link3.Active = false
 	require.NoError(t, err)
 	require.NoError(t, linkService.Activate(ctx, *did, link3.ID, false))
@@ -4192,29 +4755,29 @@ func TestServer_GetAllLinks(t *testing.T) {
 	}
 }
 
-func TestServer_DeleteLink(t *testing.T) {
+func TestServer_GetPublicCatalog(t *testing.T) {
 	const (
 		method     = "polygonid"
 		blockchain = "polygon"
 		network    = "mumbai"
 		BJJ        = "BJJ"
-		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		sUrl       = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
 		schemaType = "KYCCountryOfResidenceCredential"
 	)
 	ctx := context.Background()
 	identityRepo := repositories.NewIdentity()
 	claimsRepo := repositories.NewClaims()
-	schemaRepository := repositories.NewSchema(*storage)
 	identityStateRepo := repositories.NewIdentityState()
 	mtRepo := repositories.NewIdentityMerkleTreeRepository()
 	mtService := services.NewIdentityMerkleTrees(mtRepo)
 	revocationRepository := repositories.NewRevocation()
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
+	schemaRepository := repositories.NewSchema(*storage)
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4224,9 +4787,21 @@ func TestServer_DeleteLink(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -4234,89 +4809,87 @@ func TestServer_DeleteLink(t *testing.T) {
 	require.NoError(t, err)
 
 	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
-	iReq := ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"))
+	iReq := ports.NewImportSchemaRequest(sUrl, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"))
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, iReq)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
-
-	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
-	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
-	assert.NoError(t, err)
-	handler := getHandler(ctx, server)
-
-	type expected struct {
-		response DeleteLinkResponseObject
-		httpCode int
-	}
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
-	type testConfig struct {
-		name     string
-		id       uuid.UUID
-		auth     func() (string, string)
-		expected expected
+	tomorrow := time.Now().Add(24 * time.Hour)
+	payment := &domain.LinkPayment{
+		Amount:    "1000000000000000",
+		ChainID:   80002,
+		Recipient: "0x2C1DDDc4C8b6BdAaE831eF04bF4FfDfA575d8bA7",
+		Tokens:    []domain.PaymentToken{{Currency: "POL"}},
 	}
+	activeLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 importedSchema.ID,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Payment:                  payment,
+	})
+	require.NoError(t, err)
+	inactiveLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		SchemaID:                 importedSchema.ID,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
+	require.NoError(t, err)
+	require.NoError(t, linkService.Activate(ctx, *did, inactiveLink.ID, false))
 
-	for _, tc := range []testConfig{
-		{
-			name: "No auth header",
-			auth: authWrong,
-			id:   link.ID,
-			expected: expected{
-				httpCode: http.StatusUnauthorized,
-			},
-		},
-		{
-			name: "Claim link does not exist",
-			auth: authOk,
-			id:   uuid.New(),
-			expected: expected{
-				response: DeleteLink400JSONResponse{N400JSONResponse{Message: "link does not exist"}},
-				httpCode: http.StatusBadRequest,
-			},
-		},
-		{
-			name: "Happy path",
-			auth: authOk,
-			id:   link.ID,
-			expected: expected{
-				response: DeleteLink200JSONResponse{Message: "link deleted"},
-				httpCode: http.StatusOK,
-			},
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			rr := httptest.NewRecorder()
-			url := fmt.Sprintf("/v1/credentials/links/%s", tc.id)
+	handler := getHandler(ctx, server)
 
-			req, err := http.NewRequest(http.MethodDelete, url, tests.JSONBody(t, nil))
-			req.SetBasicAuth(tc.auth())
-			require.NoError(t, err)
+	t.Run("should return 404 if the catalog is disabled", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v1/catalog", nil)
+		require.NoError(t, err)
 
-			handler.ServeHTTP(rr, req)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
 
-			require.Equal(t, tc.expected.httpCode, rr.Code)
+	t.Run("should return the active links without auth", func(t *testing.T) {
+		cfg.APIUI.PublicCatalog.Enabled = true
+		defer func() { cfg.APIUI.PublicCatalog.Enabled = false }()
 
-			switch tc.expected.httpCode {
-			case http.StatusOK:
-				var response GenericMessage
-				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
-				expected, ok := tc.expected.response.(DeleteLink200JSONResponse)
-				assert.True(t, ok)
-				assert.Equal(t, expected.Message, response.Message)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v1/catalog", nil)
+		require.NoError(t, err)
 
-			case http.StatusBadRequest:
-				var response DeleteLink400JSONResponse
-				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
-				assert.EqualValues(t, tc.expected.response, response)
-			}
-		})
-	}
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetPublicCatalog200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, did.String(), response.Issuer.Did)
+		require.Len(t, response.Credentials, 1)
+		credential := response.Credentials[0]
+		assert.Equal(t, activeLink.ID, credential.LinkId)
+		assert.Equal(t, common.ToPointer("someTitle"), credential.Title)
+		assert.Equal(t, common.ToPointer("someDescription"), credential.Description)
+		assert.Equal(t, schemaType, credential.SchemaType)
+		assert.Equal(t, sUrl, credential.SchemaUrl)
+		assert.Equal(t, []string{"BJJSignature2021"}, credential.ProofTypes)
+		assert.Equal(t, common.ToPointer(10), credential.RemainingIssuance)
+		assert.Equal(t, fmt.Sprintf("%s/v1/credentials/links/%s/qrcode", cfg.APIUI.ServerURL, activeLink.ID), credential.QrCodeUrl)
+		require.NotNil(t, credential.Requirements.Payment)
+		assert.Equal(t, payment.Amount, credential.Requirements.Payment.Amount)
+		assert.Nil(t, credential.Requirements.Presentation)
+	})
 }
 
-func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
+func TestServer_CreateLinkBatch(t *testing.T) {
 	const (
 		method     = "polygonid"
 		blockchain = "polygon"
@@ -4328,17 +4901,17 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 	ctx := context.Background()
 	identityRepo := repositories.NewIdentity()
 	claimsRepo := repositories.NewClaims()
+	schemaRepository := repositories.NewSchema(*storage)
 	identityStateRepo := repositories.NewIdentityState()
 	mtRepo := repositories.NewIdentityMerkleTreeRepository()
 	mtService := services.NewIdentityMerkleTrees(mtRepo)
 	revocationRepository := repositories.NewRevocation()
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
-	schemaRepository := repositories.NewSchema(*storage)
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4348,15 +4921,24 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
-	iden2, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
-	require.NoError(t, err)
-
 	did, err := w3c.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
@@ -4365,22 +4947,345 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, iReq)
 	assert.NoError(t, err)
 
-	did2, err := w3c.ParseDID(iden2.Identifier)
-	require.NoError(t, err)
-
-	cfg.APIUI.IssuerDID = *did2
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
-	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
+	validUntil := common.ToPointer(time.Now().Add(24 * time.Hour))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               validUntil,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
-	type expected struct {
-		response DeleteLinkResponseObject
-		httpCode int
-	}
+	t.Run("should clone the link", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/credentials/links/%s/clone", link.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var response UUIDResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		clone, err := linkService.GetByID(ctx, *did, uuid.MustParse(response.Id))
+		require.NoError(t, err)
+		assert.NotEqual(t, link.ID, clone.ID)
+		assert.Equal(t, link.MaxIssuance, clone.MaxIssuance)
+		assert.Equal(t, link.CredentialSubject["birthday"], clone.CredentialSubject["birthday"])
+	})
+
+	type testConfig struct {
+		name     string
+		id       uuid.UUID
+		body     CreateLinkBatchRequest
+		httpCode int
+	}
+	for _, tc := range []testConfig{
+		{name: "unknown link", id: uuid.New(), body: CreateLinkBatchRequest{Count: 2}, httpCode: http.StatusNotFound},
+		{name: "count out of bounds", id: link.ID, body: CreateLinkBatchRequest{Count: 0}, httpCode: http.StatusBadRequest},
+		{name: "external ids mismatch", id: link.ID, body: CreateLinkBatchRequest{Count: 2, ExternalIds: &[]string{"a"}}, httpCode: http.StatusBadRequest},
+		{name: "happy path", id: link.ID, body: CreateLinkBatchRequest{Count: 2, ExternalIds: &[]string{"a", "b"}}, httpCode: http.StatusCreated},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/credentials/links/%s/batch", tc.id), tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code)
+			if tc.httpCode != http.StatusCreated {
+				return
+			}
+
+			var response CreateLinkBatchResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.Len(t, response.Links, tc.body.Count)
+			for i, item := range response.Links {
+				assert.Equal(t, (*tc.body.ExternalIds)[i], *item.ExternalId)
+				assert.Equal(t, fmt.Sprintf("%s/v1/credentials/links/%s/qrcode", cfg.APIUI.ServerURL, item.Id), item.QrCodeUrl)
+				created, err := linkService.GetByID(ctx, *did, item.Id)
+				require.NoError(t, err)
+				assert.Equal(t, 1, *created.MaxIssuance)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteLink(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	schemaRepository := repositories.NewSchema(*storage)
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	linkRepository := repositories.NewLink(*storage)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
+	iReq := ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"))
+	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, iReq)
+	assert.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+
+	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
+	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               validUntil,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
+	assert.NoError(t, err)
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		response DeleteLinkResponseObject
+		httpCode int
+	}
+
+	type testConfig struct {
+		name     string
+		id       uuid.UUID
+		auth     func() (string, string)
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			id:   link.ID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Claim link does not exist",
+			auth: authOk,
+			id:   uuid.New(),
+			expected: expected{
+				response: DeleteLink400JSONResponse{N400JSONResponse{Message: "link does not exist"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Happy path",
+			auth: authOk,
+			id:   link.ID,
+			expected: expected{
+				response: DeleteLink200JSONResponse{Message: "link deleted"},
+				httpCode: http.StatusOK,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v1/credentials/links/%s", tc.id)
+
+			req, err := http.NewRequest(http.MethodDelete, url, tests.JSONBody(t, nil))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response GenericMessage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				expected, ok := tc.expected.response.(DeleteLink200JSONResponse)
+				assert.True(t, ok)
+				assert.Equal(t, expected.Message, response.Message)
+
+			case http.StatusBadRequest:
+				var response DeleteLink400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.EqualValues(t, tc.expected.response, response)
+			}
+		})
+	}
+
+	t.Run("v2 answers not found for a link that does not exist", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/credentials/links/%s", uuid.New()), tests.JSONBody(t, nil))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		apiversion.Middleware(handler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		var response apiversion.Envelope
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Error)
+		assert.Equal(t, "link does not exist", response.Error.Message)
+	})
+}
+
+func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	linkRepository := repositories.NewLink(*storage)
+	schemaRepository := repositories.NewSchema(*storage)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
+	linkService := services.NewLinkService(storage, claimsService, nil, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	iden2, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), schemaLoader)
+	iReq := ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"))
+	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, iReq)
+	assert.NoError(t, err)
+
+	did2, err := w3c.ParseDID(iden2.Identifier)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did2
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+
+	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
+	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               validUntil,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
+	assert.NoError(t, err)
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		response DeleteLinkResponseObject
+		httpCode int
+	}
 
 	type testConfig struct {
 		name     string
@@ -4460,7 +5365,7 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4470,9 +5375,21 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -4487,15 +5404,39 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 	cfg.APIUI.IssuerDID = *did
 	// cfg.APIUI.ServerURL = "http://localhost/issuer-admin"
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, qrService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	validUntil := common.ToPointer(time.Now().Add(365 * 24 * time.Hour))
 	credentialExpiration := common.ToPointer(validUntil.Add(365 * 24 * time.Hour))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               validUntil,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	assert.NoError(t, err)
 
 	yesterday := time.Now().Add(-24 * time.Hour)
-	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkExpired, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               &yesterday,
+		SchemaID:                 importedSchema.ID,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -4622,7 +5563,7 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	qrService := services.NewQrStoreService(cachex)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4632,9 +5573,22 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		QrService:                qrService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL)
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, schemaLoader, sessionRepository, pubsub.NewMock(), ipfsGatewayURL, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
 
@@ -4649,11 +5603,28 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 	cfg.APIUI.IssuerDID = *did
 	cfg.APIUI.ServerURL = "http://localhost/issuer-admin"
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, qrService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        linkService,
+		QrService:          qrService,
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 0, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 0, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(10),
+		ValidUntil:               validUntil,
+		SchemaID:                 importedSchema.ID,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: true,
+		CredentialMTPProof:       true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -4812,7 +5783,7 @@ func TestServer_GetStateStatus(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4822,7 +5793,19 @@ func TestServer_GetStateStatus(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
 	credentialSubject := map[string]any{
@@ -4845,7 +5828,15 @@ func TestServer_GetStateStatus(t *testing.T) {
 		},
 	}
 
-	serverWithSignatureClaim := NewServer(cfg1, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	serverWithSignatureClaim := NewServer(cfg1, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(didSignatureClaim, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true, Iden3SparseMerkleTreeProof: false}, nil, true, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
 	require.NoError(t, err)
 	handlerWithSignatureClaim := getHandler(ctx, serverWithSignatureClaim)
@@ -4861,7 +5852,15 @@ func TestServer_GetStateStatus(t *testing.T) {
 			IssuerDID: *didWithMTPClaim,
 		},
 	}
-	serverWithMTPClaim := NewServer(cfgWithMTPClaim, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	serverWithMTPClaim := NewServer(cfgWithMTPClaim, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(didWithMTPClaim, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true, Iden3SparseMerkleTreeProof: true}, nil, true, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
 	require.NoError(t, err)
 	handlerWithMTPClaim := getHandler(ctx, serverWithMTPClaim)
@@ -4877,7 +5876,15 @@ func TestServer_GetStateStatus(t *testing.T) {
 			IssuerDID: *didWithRevokedClaim,
 		},
 	}
-	serverWithRevokedClaim := NewServer(cfgWithRevokedClaim, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	serverWithRevokedClaim := NewServer(cfgWithRevokedClaim, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 	cred, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(didWithRevokedClaim, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true, Iden3SparseMerkleTreeProof: false}, nil, true, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
 	require.NoError(t, err)
 	require.NoError(t, claimsService.Revoke(ctx, cfgWithRevokedClaim.APIUI.IssuerDID, uint64(cred.RevNonce), "not valid"))
@@ -4970,7 +5977,7 @@ func TestServer_GetStateTransactions(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -4980,7 +5987,19 @@ func TestServer_GetStateTransactions(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -4989,7 +6008,15 @@ func TestServer_GetStateTransactions(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	handler := getHandler(ctx, server)
 
@@ -5065,7 +6092,7 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	pubSub := pubsub.NewMock()
 
 	mediaTypeManager := services.NewMediaTypeManager(
@@ -5076,7 +6103,19 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, schemaLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubSub,
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -5085,7 +6124,15 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), nil, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -5147,4 +6194,876 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 			}
 		})
 	}
+
+	for _, tc := range []struct {
+		name     string
+		nonces   []uint64
+		expected int
+	}{
+		{name: "should get the revocation statuses of the batch", nonces: []uint64{uint64(createdCredential.RevNonce), 123456789}, expected: http.StatusOK},
+		{name: "empty batch", nonces: []uint64{}, expected: http.StatusBadRequest},
+		{name: "batch too large", nonces: make([]uint64, 101), expected: http.StatusBadRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/v1/credentials/revocation/status/batch", tests.JSONBody(t, RevocationStatusBatchRequest{Nonces: tc.nonces}))
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected, rr.Code)
+			if tc.expected == http.StatusOK {
+				var response GetRevocationStatusBatch200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.NotNil(t, response.Issuer.State)
+				require.Len(t, response.Statuses, len(tc.nonces))
+				for i, status := range response.Statuses {
+					assert.Equal(t, tc.nonces[i], status.Nonce)
+					assert.False(t, status.Revoked)
+					assert.NotNil(t, status.Mtp.Siblings)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_GetHistoricalRevocationStatus(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+	})
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:  NewIdentityMock(),
+		ClaimsService:    claimsService,
+		SchemaService:    NewSchemaMock(),
+		LinkService:      NewLinkMock(),
+		PublisherGateway: NewPublisherMock(),
+		PackageManager:   NewPackageManagerMock(),
+	})
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		state    string
+	}
+	type testConfig struct {
+		name     string
+		query    string
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:  "should get the revocation status at the genesis state by hash",
+			query: "state=" + *iden.State.State,
+			expected: expected{
+				httpCode: http.StatusOK,
+				state:    *iden.State.State,
+			},
+		},
+		{
+			name:  "should get the revocation status at the state published at the given time",
+			query: "timestamp=" + url.QueryEscape(time.Now().Add(time.Minute).UTC().Format(time.RFC3339)),
+			expected: expected{
+				httpCode: http.StatusOK,
+				state:    *iden.State.State,
+			},
+		},
+		{
+			name:  "should get a 404 if the issuer had no state at the given time",
+			query: "timestamp=2000-01-01T00:00:00Z",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:  "should get a 404 for an unknown state",
+			query: "state=13f9aadd4801d775e85a7ef45c2f6d02cdf83f0d724250417b165ff9cd88ee21",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "should get a 400 without state and timestamp",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v1/credentials/revocation/status/%d/historical?%s", 123456789, tc.query)
+			req, err := http.NewRequest("GET", url, nil)
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			if tc.expected.httpCode == http.StatusOK {
+				var response GetHistoricalRevocationStatus200JSONResponse
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.False(t, response.Revoked)
+				assert.Equal(t, tc.expected.state, response.PublishedState.State)
+				assert.Equal(t, tc.expected.state, *response.RevocationStatus.Issuer.State)
+			}
+		})
+	}
+}
+
+func TestServer_GetCredentialVerificationBundle(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+	})
+	bundleService := services.NewVerificationBundle(claimsService, identityStateRepo, schemaLoader, storage, cfg.Ethereum.ContractAddress)
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:           NewIdentityMock(),
+		ClaimsService:             claimsService,
+		SchemaService:             NewSchemaMock(),
+		LinkService:               NewLinkMock(),
+		PublisherGateway:          NewPublisherMock(),
+		PackageManager:            NewPackageManagerMock(),
+		VerificationBundleService: bundleService,
+	})
+	handler := getHandler(ctx, server)
+
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	typeC := "KYCAgeCredential"
+	merklizedRootPosition := "index"
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	pendingCredential, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	type expected struct {
+		httpCode int
+	}
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		id       uuid.UUID
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "no auth header",
+			auth: authWrong,
+			id:   pendingCredential.ID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "should get a 400 for a credential not published yet",
+			auth: authOk,
+			id:   pendingCredential.ID,
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "should get a 404 for an unknown credential",
+			auth: authOk,
+			id:   uuid.New(),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest("GET", fmt.Sprintf("/v1/credentials/%s/bundle", tc.id), nil)
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+		})
+	}
+}
+
+func TestServer_SaveTranslation(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	translationService := services.NewTranslation(repositories.NewTranslation(), storage)
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: "polygonid", Blockchain: "polygon", Network: "mumbai", KeyType: "BJJ"})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+		TranslationService: translationService,
+	})
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		locale   string
+	}
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		locale   string
+		body     TranslationRequest
+		expected expected
+	}{
+		{
+			name:     "no auth header",
+			auth:     authWrong,
+			locale:   "es",
+			body:     TranslationRequest{Messages: map[string]string{domain.TranslationKeyIssuerName: "Emisor"}},
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+		{
+			name:     "should get a 400 for an invalid locale",
+			auth:     authOk,
+			locale:   "not a locale",
+			body:     TranslationRequest{Messages: map[string]string{domain.TranslationKeyIssuerName: "Emisor"}},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:     "should get a 400 for an unknown key",
+			auth:     authOk,
+			locale:   "es",
+			body:     TranslationRequest{Messages: map[string]string{"issuer.logo": "logo.png"}},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:     "should get a 400 without messages",
+			auth:     authOk,
+			locale:   "es",
+			body:     TranslationRequest{Messages: map[string]string{}},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:   "should save the translation with the canonical locale",
+			auth:   authOk,
+			locale: "PT-br",
+			body: TranslationRequest{Messages: map[string]string{
+				domain.TranslationKeyIssuerName:                "Emissor",
+				domain.OfferDescriptionKey("KYCAgeCredential"): "Credencial de idade",
+			}},
+			expected: expected{httpCode: http.StatusOK, locale: "pt-BR"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPut, "/v1/translations/"+url.PathEscape(tc.locale), tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode == http.StatusOK {
+				var response SaveTranslation200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.locale, response.Locale)
+				assert.Equal(t, tc.body.Messages, response.Messages)
+			}
+		})
+	}
+
+	t.Run("should translate the issuer name of the link qr code", func(t *testing.T) {
+		localizer := server.localizer(ctx, common.ToPointer("pt-BR"))
+		assert.Equal(t, "Emissor", server.issuerDescription(localizer).DisplayName)
+		assert.Equal(t, cfg.APIUI.IssuerName, server.issuerDescription(server.localizer(ctx, common.ToPointer("fr"))).DisplayName)
+	})
+}
+
+func TestServer_MaskCredential(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Configuration{APIUI: config.APIUI{MaskedAttributes: []string{"documentNumber"}}}
+	server := NewServer(cfg, Dependencies{})
+	credential := Credential{CredentialSubject: map[string]interface{}{"documentNumber": "X1234567", "birthday": 19960424}}
+
+	t.Run("should mask the configured attributes for operators", func(t *testing.T) {
+		masked := server.maskCredential(withRole(ctx, roleOperator), credential)
+		assert.Equal(t, maskedValue, masked.CredentialSubject["documentNumber"])
+		assert.Equal(t, 19960424, masked.CredentialSubject["birthday"])
+		assert.Equal(t, "X1234567", credential.CredentialSubject["documentNumber"])
+	})
+
+	t.Run("should not mask the attributes for admins", func(t *testing.T) {
+		masked := server.maskCredential(withRole(ctx, roleAdmin), credential)
+		assert.Equal(t, "X1234567", masked.CredentialSubject["documentNumber"])
+	})
+
+	t.Run("should mask the credential subject of the link recipients for operators", func(t *testing.T) {
+		recipients := []LinkRecipient{{Code: "a", CredentialSubject: map[string]interface{}{"documentNumber": "X1234567"}}, {Code: "b"}}
+		masked := server.maskLinkRecipients(withRole(ctx, roleOperator), recipients)
+		assert.Equal(t, maskedValue, masked[0].CredentialSubject["documentNumber"])
+		assert.Nil(t, masked[1].CredentialSubject)
+
+		recipients = []LinkRecipient{{Code: "a", CredentialSubject: map[string]interface{}{"documentNumber": "X1234567"}}}
+		assert.Equal(t, "X1234567", server.maskLinkRecipients(withRole(ctx, roleAdmin), recipients)[0].CredentialSubject["documentNumber"])
+	})
+
+	t.Run("should not return unmasked credentials to operators", func(t *testing.T) {
+		resp, err := server.GetCredential(withRole(ctx, roleOperator), GetCredentialRequestObject{Id: uuid.New(), Params: GetCredentialParams{Unmasked: common.ToPointer(true)}})
+		require.NoError(t, err)
+		assert.IsType(t, GetCredential403JSONResponse{}, resp)
+	})
+
+	t.Run("should not render unmasked credential pdfs for operators", func(t *testing.T) {
+		resp, err := server.GetCredentialPDF(withRole(ctx, roleOperator), GetCredentialPDFRequestObject{Id: uuid.New(), Params: GetCredentialPDFParams{Unmasked: common.ToPointer(true)}})
+		require.NoError(t, err)
+		assert.IsType(t, GetCredentialPDF403JSONResponse{}, resp)
+	})
+
+	t.Run("should mask the verification bundles exported by operators", func(t *testing.T) {
+		bundles := &verificationBundleMock{bundle: &domain.VerificationBundle{
+			Credential: verifiable.W3CCredential{CredentialSubject: map[string]interface{}{"documentNumber": "X1234567", "birthday": 19960424}},
+		}}
+		server := NewServer(cfg, Dependencies{VerificationBundleService: bundles})
+
+		resp, err := server.GetCredentialVerificationBundle(withRole(ctx, roleOperator), GetCredentialVerificationBundleRequestObject{Id: uuid.New()})
+		require.NoError(t, err)
+		require.IsType(t, GetCredentialVerificationBundle200JSONResponse{}, resp)
+		subject := resp.(GetCredentialVerificationBundle200JSONResponse).Credential.CredentialSubject
+		assert.Equal(t, maskedValue, subject["documentNumber"])
+		assert.Equal(t, 19960424, subject["birthday"])
+		assert.Equal(t, "X1234567", bundles.bundle.Credential.CredentialSubject["documentNumber"])
+
+		resp, err = server.GetCredentialVerificationBundle(withRole(ctx, roleOperator), GetCredentialVerificationBundleRequestObject{Id: uuid.New(), Params: GetCredentialVerificationBundleParams{Unmasked: common.ToPointer(true)}})
+		require.NoError(t, err)
+		assert.IsType(t, GetCredentialVerificationBundle403JSONResponse{}, resp)
+
+		resp, err = server.GetCredentialVerificationBundle(withRole(ctx, roleAdmin), GetCredentialVerificationBundleRequestObject{Id: uuid.New(), Params: GetCredentialVerificationBundleParams{Unmasked: common.ToPointer(true)}})
+		require.NoError(t, err)
+		require.IsType(t, GetCredentialVerificationBundle200JSONResponse{}, resp)
+		assert.Equal(t, "X1234567", resp.(GetCredentialVerificationBundle200JSONResponse).Credential.CredentialSubject["documentNumber"])
+	})
+}
+
+type verificationBundleMock struct {
+	bundle *domain.VerificationBundle
+}
+
+func (m *verificationBundleMock) Get(_ context.Context, _ w3c.DID, _ uuid.UUID) (*domain.VerificationBundle, error) {
+	bundle := *m.bundle
+	return &bundle, nil
+}
+
+func TestServer_UpdateConnection(t *testing.T) {
+	connectionsRepository := repositories.NewConnections()
+	connectionsService := services.NewConnection(connectionsRepository, repositories.NewClaims(), storage)
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      NewClaimsMock(),
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: connectionsService,
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+	handler := getHandler(context.Background(), server)
+
+	fixture := tests.NewFixture(storage)
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+	server.cfg.APIUI.IssuerDID = *issuerDID
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qNytPv6dKKhfqopjBdXJU1vSVb3Lbgcidved32R64")
+	require.NoError(t, err)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	type expected struct {
+		httpCode int
+		profile  *domain.ConnectionProfile
+	}
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		connID   uuid.UUID
+		body     ConnectionProfile
+		expected expected
+	}{
+		{
+			name:     "no auth header",
+			auth:     authWrong,
+			connID:   connID,
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+		{
+			name:     "unknown connection",
+			auth:     authOk,
+			connID:   uuid.New(),
+			body:     ConnectionProfile{DisplayName: common.ToPointer("Jane Doe")},
+			expected: expected{httpCode: http.StatusNotFound},
+		},
+		{
+			name:     "invalid email",
+			auth:     authOk,
+			connID:   connID,
+			body:     ConnectionProfile{Email: common.ToPointer("not an email")},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:   "should update the profile",
+			auth:   authOk,
+			connID: connID,
+			body: ConnectionProfile{
+				DisplayName: common.ToPointer("Jane Doe"),
+				ExternalId:  common.ToPointer("EMP-1234"),
+				Email:       common.ToPointer("jane.doe@example.com"),
+			},
+			expected: expected{
+				httpCode: http.StatusOK,
+				profile: &domain.ConnectionProfile{
+					DisplayName: common.ToPointer("Jane Doe"),
+					ExternalID:  common.ToPointer("EMP-1234"),
+					Email:       common.ToPointer("jane.doe@example.com"),
+				},
+			},
+		},
+		{
+			name:   "should remove the display name",
+			auth:   authOk,
+			connID: connID,
+			body:   ConnectionProfile{DisplayName: common.ToPointer("")},
+			expected: expected{
+				httpCode: http.StatusOK,
+				profile: &domain.ConnectionProfile{
+					ExternalID: common.ToPointer("EMP-1234"),
+					Email:      common.ToPointer("jane.doe@example.com"),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/v1/connections/%s", tc.connID), tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.profile != nil {
+				conn, err := connectionsService.GetByIDAndIssuerID(context.Background(), tc.connID, *issuerDID)
+				require.NoError(t, err)
+				assert.Equal(t, *tc.expected.profile, conn.Profile)
+			}
+		})
+	}
+}
+
+func TestServer_RevocationRequests(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	schemaRepository := repositories.NewSchema(*storage)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	revocationRequestService := services.NewRevocationRequest(repositories.NewRevocationRequest(), claimsService, schemaRepository, storage)
+
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:          NewIdentityMock(),
+		ClaimsService:            claimsService,
+		SchemaService:            NewSchemaMock(),
+		ConnectionsService:       NewConnectionsMock(),
+		LinkService:              NewLinkMock(),
+		PublisherGateway:         NewPublisherMock(),
+		PackageManager:           NewPackageManagerMock(),
+		RevocationRequestService: revocationRequestService,
+	})
+	handler := getHandler(ctx, server)
+
+	fixture := tests.NewFixture(storage)
+	schema := &domain.Schema{
+		ID:             uuid.New(),
+		IssuerDID:      *did,
+		URL:            "https://domain.org/this/is/an/url",
+		Type:           "KYCAgeCredential",
+		CreatedAt:      time.Now(),
+		SelfRevocation: domain.SchemaSelfRevocationApproval,
+	}
+	schema.Hash = common.CreateSchemaHash([]byte(schema.URL + "#" + schema.Type))
+	fixture.CreateSchema(t, ctx, schema)
+	createClaim := func(nonce int64) uuid.UUID {
+		id := uuid.New()
+		fixture.CreateClaim(t, &domain.Claim{
+			ID:              id,
+			Identifier:      common.ToPointer(did.String()),
+			Issuer:          did.String(),
+			SchemaHash:      "ca938857241db9451ea329256b9c06e5",
+			SchemaURL:       schema.URL,
+			SchemaType:      schema.Type,
+			OtherIdentifier: userDID.String(),
+			RevNonce:        domain.RevNonceUint64(nonce),
+		})
+		return id
+	}
+	holderCtx := withHolderSession(ctx, &domain.HolderSession{UserDID: *userDID, IssuerDID: *did})
+	requestRevocation := func(t *testing.T, credentialID uuid.UUID) RevocationRequest {
+		t.Helper()
+		resp, err := server.HolderRequestRevocation(holderCtx, HolderRequestRevocationRequestObject{
+			Id:   credentialID,
+			Body: &HolderRevocationRequest{Reason: common.ToPointer("I lost my phone")},
+		})
+		require.NoError(t, err)
+		created, ok := resp.(HolderRequestRevocation201JSONResponse)
+		require.True(t, ok, "unexpected response %T", resp)
+		return RevocationRequest(created)
+	}
+	resolve := func(t *testing.T, id uuid.UUID, action string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/revocation-requests/%s/%s", id, action), strings.NewReader(`{"note":"checked by phone"}`))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("request", func(t *testing.T) {
+		credentialID := createClaim(5001)
+		created := requestRevocation(t, credentialID)
+		assert.Equal(t, RevocationRequestStatusPending, created.Status)
+		assert.Equal(t, credentialID, created.CredentialId)
+		assert.Equal(t, "I lost my phone", created.Reason)
+
+		resp, err := server.HolderRequestRevocation(holderCtx, HolderRequestRevocationRequestObject{Id: credentialID, Body: &HolderRevocationRequest{}})
+		require.NoError(t, err)
+		assert.IsType(t, HolderRequestRevocation409JSONResponse{}, resp)
+
+		resp, err = server.HolderRequestRevocation(holderCtx, HolderRequestRevocationRequestObject{Id: uuid.New(), Body: &HolderRevocationRequest{}})
+		require.NoError(t, err)
+		assert.IsType(t, HolderRequestRevocation404JSONResponse{}, resp)
+
+		resp, err = server.HolderRequestRevocation(holderCtx, HolderRequestRevocationRequestObject{Id: credentialID, Body: &HolderRevocationRequest{Reason: common.ToPointer(strings.Repeat("a", 501))}})
+		require.NoError(t, err)
+		assert.IsType(t, HolderRequestRevocation400JSONResponse{}, resp)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		credentialID := createClaim(5002)
+		created := requestRevocation(t, credentialID)
+
+		rr := resolve(t, created.Id, "reject")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response RevocationRequest
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, RevocationRequestStatusRejected, response.Status)
+		assert.Equal(t, "checked by phone", *response.Note)
+		claim, err := claimsService.GetByID(ctx, did, credentialID)
+		require.NoError(t, err)
+		assert.False(t, claim.Revoked)
+
+		assert.Equal(t, http.StatusConflict, resolve(t, created.Id, "approve").Code)
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		credentialID := createClaim(5003)
+		created := requestRevocation(t, credentialID)
+
+		rr := resolve(t, created.Id, "approve")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response RevocationRequest
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, RevocationRequestStatusApproved, response.Status)
+		assert.NotNil(t, response.ResolvedAt)
+		claim, err := claimsService.GetByID(ctx, did, credentialID)
+		require.NoError(t, err)
+		assert.True(t, claim.Revoked)
+
+		assert.Equal(t, http.StatusConflict, resolve(t, created.Id, "reject").Code)
+		assert.Equal(t, http.StatusNotFound, resolve(t, uuid.New(), "approve").Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v1/revocation-requests?status=pending", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response []RevocationRequest
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, RevocationRequestStatusPending, response[0].Status)
+	})
+}
+
+func TestServer_GetCredentialsQueryFieldNotSupported(t *testing.T) {
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    NewIdentityMock(),
+		ClaimsService:      &getAllClaimsMock{err: fmt.Errorf("%w: %s", services.ErrQueryFieldNotSupported, repositories.ErrQueryFieldEncrypted)},
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+	handler := getHandler(context.Background(), server)
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/v1/credentials?page=1", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var response GenericErrorMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, "unsupported query_field filter: the credentials data is encrypted, filtering by a field is not supported with pagination", response.Message)
+}
+
+type getAllClaimsMock struct {
+	ports.ClaimsService
+	err error
+}
+
+func (m *getAllClaimsMock) GetAll(_ context.Context, _ w3c.DID, _ *ports.ClaimsFilter) ([]*domain.Claim, uint, error) {
+	return nil, 0, m.err
+}
+
+func TestServer_GetStatePending(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	connectionsRepository := repositories.NewConnections()
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   schemaLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, Dependencies{
+		IdentityService:    identityService,
+		ClaimsService:      claimsService,
+		SchemaService:      NewSchemaMock(),
+		ConnectionsService: NewConnectionsMock(),
+		LinkService:        NewLinkMock(),
+		PublisherGateway:   NewPublisherMock(),
+		PackageManager:     NewPackageManagerMock(),
+	})
+	handler := getHandler(ctx, server)
+
+	const typeC = "KYCAgeCredential"
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	merklizedRootPosition := "index"
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	// signed credentials don't wait for the state, the revocation of one does
+	signed, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition,
+		ports.ClaimRequestProofs{BJJSignatureProof2021: true, Iden3SparseMerkleTreeProof: false}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+	mtp, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition,
+		ports.ClaimRequestProofs{BJJSignatureProof2021: false, Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+	require.NoError(t, claimsService.Revoke(ctx, *did, uint64(signed.RevNonce), "test"))
+
+	getPending := func(t *testing.T, auth func() (string, string)) (*httptest.ResponseRecorder, PendingStateResponse) {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v1/state/pending", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(auth())
+		handler.ServeHTTP(rr, req)
+		var response PendingStateResponse
+		if rr.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		}
+		return rr, response
+	}
+
+	t.Run("not authorized", func(t *testing.T) {
+		rr, _ := getPending(t, authWrong)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return the credentials and revocations of the next state", func(t *testing.T) {
+		rr, response := getPending(t, authOk)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Len(t, response.Credentials, 1)
+		assert.Equal(t, PendingStateCredential{Id: mtp.ID, SchemaType: mtp.SchemaType, UserID: mtp.OtherIdentifier}, response.Credentials[0])
+		require.Len(t, response.Revocations, 1)
+		assert.Equal(t, PendingStateRevocation{Nonce: uint64(signed.RevNonce), CredentialID: &signed.ID, SchemaType: &signed.SchemaType}, response.Revocations[0])
+		assert.Equal(t, PendingStateSummary{
+			Credentials: 1,
+			Revocations: 1,
+			ByType:      []PendingStateTypeCount{{SchemaType: signed.SchemaType, Credentials: 1, Revocations: 1}},
+		}, response.Summary)
+	})
+
+	t.Run("should be empty once the state is updated", func(t *testing.T) {
+		_, err := identityService.UpdateState(ctx, *did)
+		require.NoError(t, err)
+		rr, response := getPending(t, authOk)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, response.Credentials)
+		assert.Empty(t, response.Revocations)
+		assert.Equal(t, 0, response.Summary.Credentials)
+		assert.Equal(t, 0, response.Summary.Revocations)
+	})
 }
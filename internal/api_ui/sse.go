@@ -0,0 +1,75 @@
+package api_ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+const (
+	sseStatusEvent    = "status"
+	sseStatusInterval = 2 * time.Second
+	sseStatusTimeout  = 10 * time.Minute
+)
+
+var errStreamingNotSupported = errors.New("streaming not supported")
+
+// statusPoller returns the current value of a status stream and the status it is in.
+type statusPoller func(ctx context.Context) (event any, status string)
+
+// streamStatusEvents writes a status event every time the status returned by poll changes. The stream ends when
+// the status is done or error, the client goes away or the stream times out.
+func streamStatusEvents(ctx context.Context, w http.ResponseWriter, poll statusPoller) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errStreamingNotSupported
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(sseStatusInterval)
+	defer ticker.Stop()
+	timeout := time.After(sseStatusTimeout)
+
+	var last string
+	for {
+		event, status := poll(ctx)
+		if status != last {
+			if err := writeEvent(w, sseStatusEvent, event); err != nil {
+				log.Debug(ctx, "server sent events. Writing event", "err", err)
+				return nil
+			}
+			flusher.Flush()
+			last = status
+		}
+		if status == link_state.StatusDone || status == link_state.StatusError {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timeout:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, name string, event any) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
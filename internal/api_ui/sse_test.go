@@ -0,0 +1,23 @@
+package api_ui
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+func TestStreamStatusEvents(t *testing.T) {
+	w := httptest.NewRecorder()
+	poll := func(ctx context.Context) (any, string) {
+		return SessionStatus{Type: SessionStatusTypeAuthentication, Status: SessionStatusStatusDone}, link_state.StatusDone
+	}
+
+	require.NoError(t, streamStatusEvents(context.Background(), w, poll))
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "event: status\ndata: {\"status\":\"done\",\"type\":\"authentication\"}\n\n", w.Body.String())
+}
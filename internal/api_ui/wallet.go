@@ -0,0 +1,100 @@
+package api_ui
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type walletCtxKey struct{}
+
+// walletOperations are the operations called by the wallets of the holders, whose headers tell which wallet it is
+var walletOperations = map[string]bool{
+	"Agent":                    true,
+	"AuthCallback":             true,
+	"CreateLinkQrCodeCallback": true,
+}
+
+// walletHeaders are the headers of a wallet request
+type walletHeaders struct {
+	userAgent string
+	accept    []string
+}
+
+func withWalletHeaders(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, walletCtxKey{}, walletHeaders{
+		userAgent: strings.TrimSpace(r.UserAgent()),
+		accept:    acceptedMediaTypes(r.Header.Values("Accept")),
+	})
+}
+
+// walletObservation returns what the request of the wallet tells about it
+func walletObservation(ctx context.Context, mediaType iden3comm.MediaType, messageType iden3comm.ProtocolMessage, rejected bool) domain.WalletObservation {
+	headers, _ := ctx.Value(walletCtxKey{}).(walletHeaders)
+	return domain.WalletObservation{
+		UserAgent:   headers.userAgent,
+		Accept:      headers.accept,
+		MediaType:   mediaType,
+		MessageType: messageType,
+		Rejected:    rejected,
+	}
+}
+
+// observeWallet records the wallet of the holder that sent the message. It does nothing if the sender is unknown.
+func (s *Server) observeWallet(ctx context.Context, issuerDID w3c.DID, from string, mediaType iden3comm.MediaType, messageType iden3comm.ProtocolMessage, rejected bool) {
+	if s.walletService == nil || from == "" {
+		return
+	}
+	userDID, err := w3c.ParseDID(from)
+	if err != nil {
+		return
+	}
+	s.walletService.Observe(ctx, issuerDID, *userDID, walletObservation(ctx, mediaType, messageType, rejected))
+}
+
+// observeAuthWallet records the wallet of the holder that sent an authorization response to a callback
+func (s *Server) observeAuthWallet(ctx context.Context, body string, arm *protocol.AuthorizationResponseMessage) {
+	if arm == nil {
+		return
+	}
+	mediaType, err := s.packageManager.GetMediaType([]byte(body))
+	if err != nil {
+		return
+	}
+	s.observeWallet(ctx, s.cfg.APIUI.IssuerDID, arm.From, mediaType, arm.Type, false)
+}
+
+// negotiateCredentialQrCodeType returns the credential offer QR code type supported by the wallet of the holder of
+// the credential
+func (s *Server) negotiateCredentialQrCodeType(ctx context.Context, id uuid.UUID) (GetCredentialQrCodeParamsType, error) {
+	claim, err := s.claimService.GetByID(ctx, &s.cfg.APIUI.IssuerDID, id)
+	if err != nil {
+		return "", err
+	}
+	userDID, err := w3c.ParseDID(claim.OtherIdentifier)
+	if err != nil || s.walletService == nil {
+		return GetCredentialQrCodeParamsTypeLink, nil
+	}
+	return GetCredentialQrCodeParamsType(s.walletService.QRFormat(ctx, s.cfg.APIUI.IssuerDID, *userDID)), nil
+}
+
+// acceptedMediaTypes returns the media types of the accept headers without their parameters
+func acceptedMediaTypes(values []string) []string {
+	accept := make([]string, 0)
+	for _, value := range values {
+		for _, mediaType := range strings.Split(value, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			if mediaType = strings.TrimSpace(mediaType); mediaType != "" && mediaType != "*/*" {
+				accept = append(accept, mediaType)
+			}
+		}
+	}
+	return accept
+}
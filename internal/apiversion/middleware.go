@@ -0,0 +1,185 @@
+// Package apiversion serves the /v2 api surface on top of the /v1 handlers.
+//
+// Every /v1 endpoint is also available under /v2. The v2 responses are wrapped in a consistent envelope:
+//
+//	{"data": <v1 response>, "meta": {"apiVersion": "2", "requestId": "..."}}
+//	{"error": {"code": "not_found", "message": "..."}, "meta": {"apiVersion": "2", "requestId": "..."}}
+//
+// The v2 responses keep the status code of the v1 handler. The handlers that answer 400 in v1 for resources that do
+// not exist answer 404 in v2, telling both apart with IsV2. Non json responses, like images or server sent events,
+// are sent untouched. The v1 responses carry deprecation headers pointing to v2.
+package apiversion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// V1Prefix is the path prefix of the deprecated api
+	V1Prefix = "/v1/"
+	// V2Prefix is the path prefix of the current api
+	V2Prefix = "/v2/"
+
+	apiVersion2 = "2"
+)
+
+type v2Key struct{}
+
+// IsV2 returns whether the request of the context was made to the v2 api
+func IsV2(ctx context.Context) bool {
+	v2, _ := ctx.Value(v2Key{}).(bool)
+	return v2
+}
+
+// Envelope is the body of every v2 json response
+type Envelope struct {
+	Data  any    `json:"data,omitempty"`
+	Error *Error `json:"error,omitempty"`
+	Meta  Meta   `json:"meta"`
+}
+
+// Error describes a failed v2 request
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Meta contains information about the request
+type Meta struct {
+	APIVersion string `json:"apiVersion"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// V1Path returns the /v1 path that serves the /v2 path, or the path as it is if it is not a /v2 path
+func V1Path(path string) string {
+	if strings.HasPrefix(path, V2Prefix) {
+		return V1Prefix + strings.TrimPrefix(path, V2Prefix)
+	}
+	return path
+}
+
+// Middleware routes the /v2 requests to the /v1 handlers wrapping their responses in the v2 envelope,
+// and adds the deprecation headers to the /v1 responses. It must be added to the router, before routing takes place.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, V1Prefix):
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Link", "<"+V2Prefix+strings.TrimPrefix(r.URL.Path, V1Prefix)+`>; rel="successor-version"`)
+			next.ServeHTTP(w, r)
+		case strings.HasPrefix(r.URL.Path, V2Prefix):
+			r.URL.Path = V1Path(r.URL.Path)
+			if r.URL.RawPath != "" {
+				r.URL.RawPath = V1Path(r.URL.RawPath)
+			}
+			ew := &envelopeWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(ew, r.WithContext(context.WithValue(r.Context(), v2Key{}, true)))
+			ew.finish(middleware.GetReqID(r.Context()))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// envelopeWriter buffers the json responses so they can be wrapped in the envelope once the handler finishes.
+// Successful non json responses are written straight away.
+type envelopeWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	passthrough bool
+	body        bytes.Buffer
+}
+
+func (w *envelopeWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	contentType := w.Header().Get("Content-Type")
+	if status < http.StatusBadRequest && (status == http.StatusNoContent || !strings.HasPrefix(contentType, "application/json")) {
+		w.passthrough = true
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (w *envelopeWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.passthrough {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+// Flush lets the server sent events go through the envelope writer
+func (w *envelopeWriter) Flush() {
+	if !w.passthrough {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *envelopeWriter) finish(requestID string) {
+	if w.passthrough {
+		return
+	}
+
+	status := w.status
+	envelope := Envelope{Meta: Meta{APIVersion: apiVersion2, RequestID: requestID}}
+	if status >= http.StatusBadRequest {
+		envelope.Error = &Error{Code: errorCode(status), Message: errorMessage(w.body.Bytes(), status)}
+	} else if w.body.Len() > 0 {
+		envelope.Data = json.RawMessage(w.body.Bytes())
+		if !json.Valid(w.body.Bytes()) {
+			envelope.Data = w.body.String()
+		}
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		body = w.body.Bytes()
+	}
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Type", "application/json")
+	w.ResponseWriter.WriteHeader(status)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// errorMessage extracts the message of the v1 error responses, that can be a json object with a message,
+// a json string or plain text.
+func errorMessage(body []byte, status int) string {
+	var generic struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &generic); err == nil && generic.Message != "" {
+		return generic.Message
+	}
+	var text string
+	if err := json.Unmarshal(body, &text); err == nil && text != "" {
+		return text
+	}
+	if text := strings.TrimSpace(string(body)); text != "" {
+		return text
+	}
+	return http.StatusText(status)
+}
+
+// errorCode returns the snake case code of the http status, e.g not_found
+func errorCode(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "error"
+	}
+	return strings.ReplaceAll(strings.ToLower(text), " ", "_")
+}
@@ -0,0 +1,179 @@
+package apiversion
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	mux := chi.NewRouter()
+	mux.Use(Middleware)
+	mux.Get("/v1/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch chi.URLParam(r, "id") {
+		case "missing":
+			status := http.StatusBadRequest
+			if IsV2(r.Context()) {
+				status = http.StatusNotFound
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"The given item does not exist"}`))
+		case "unknown-schema":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"schema does not exist"}`))
+		case "invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid id"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"1"}`))
+		}
+	})
+	mux.Get("/v1/items/{id}/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("png"))
+	})
+	mux.Delete("/v1/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.Get("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	type expected struct {
+		status      int
+		contentType string
+		body        string
+		envelope    *Envelope
+		deprecation bool
+	}
+	for _, tc := range []struct {
+		name     string
+		method   string
+		url      string
+		expected expected
+	}{
+		{
+			name:   "v1 is served with deprecation headers",
+			method: http.MethodGet,
+			url:    "/v1/items/1",
+			expected: expected{
+				status:      http.StatusOK,
+				body:        `{"id":"1"}`,
+				deprecation: true,
+			},
+		},
+		{
+			name:   "v1 keeps the bad request for missing resources",
+			method: http.MethodGet,
+			url:    "/v1/items/missing",
+			expected: expected{
+				status:      http.StatusBadRequest,
+				body:        `{"message":"The given item does not exist"}`,
+				deprecation: true,
+			},
+		},
+		{
+			name:   "v2 wraps the data",
+			method: http.MethodGet,
+			url:    "/v2/items/1",
+			expected: expected{
+				status:   http.StatusOK,
+				envelope: &Envelope{Data: map[string]any{"id": "1"}, Meta: Meta{APIVersion: "2"}},
+			},
+		},
+		{
+			name:   "v2 answers not found for missing resources",
+			method: http.MethodGet,
+			url:    "/v2/items/missing",
+			expected: expected{
+				status:   http.StatusNotFound,
+				envelope: &Envelope{Error: &Error{Code: "not_found", Message: "The given item does not exist"}, Meta: Meta{APIVersion: "2"}},
+			},
+		},
+		{
+			name:   "v2 wraps bad requests",
+			method: http.MethodGet,
+			url:    "/v2/items/invalid",
+			expected: expected{
+				status:   http.StatusBadRequest,
+				envelope: &Envelope{Error: &Error{Code: "bad_request", Message: "invalid id"}, Meta: Meta{APIVersion: "2"}},
+			},
+		},
+		{
+			name:   "v2 keeps the status of the handler",
+			method: http.MethodGet,
+			url:    "/v2/items/unknown-schema",
+			expected: expected{
+				status:   http.StatusBadRequest,
+				envelope: &Envelope{Error: &Error{Code: "bad_request", Message: "schema does not exist"}, Meta: Meta{APIVersion: "2"}},
+			},
+		},
+		{
+			name:   "v2 wraps routing errors",
+			method: http.MethodGet,
+			url:    "/v2/unknown",
+			expected: expected{
+				status:   http.StatusNotFound,
+				envelope: &Envelope{Error: &Error{Code: "not_found", Message: "404 page not found"}, Meta: Meta{APIVersion: "2"}},
+			},
+		},
+		{
+			name:   "v2 does not wrap non json responses",
+			method: http.MethodGet,
+			url:    "/v2/items/1/image",
+			expected: expected{
+				status:      http.StatusOK,
+				contentType: "image/png",
+				body:        "png",
+			},
+		},
+		{
+			name:   "v2 does not wrap no content responses",
+			method: http.MethodDelete,
+			url:    "/v2/items/1",
+			expected: expected{
+				status: http.StatusNoContent,
+			},
+		},
+		{
+			name:   "unversioned paths are not modified",
+			method: http.MethodGet,
+			url:    "/status",
+			expected: expected{
+				status: http.StatusOK,
+				body:   "ok",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, tc.url, nil)
+			mux.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.status, rr.Code)
+			if tc.expected.deprecation {
+				assert.Equal(t, "true", rr.Header().Get("Deprecation"))
+				assert.Contains(t, rr.Header().Get("Link"), `rel="successor-version"`)
+			} else {
+				assert.Empty(t, rr.Header().Get("Deprecation"))
+			}
+			if tc.expected.contentType != "" {
+				assert.Equal(t, tc.expected.contentType, rr.Header().Get("Content-Type"))
+			}
+			if tc.expected.envelope == nil {
+				assert.Equal(t, tc.expected.body, rr.Body.String())
+				return
+			}
+			assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+			var envelope Envelope
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+			assert.Equal(t, *tc.expected.envelope, envelope)
+		})
+	}
+}
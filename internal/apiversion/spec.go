@@ -0,0 +1,218 @@
+package apiversion
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	metaSchema          = "EnvelopeMeta"
+	errorEnvelopeSchema = "ErrorEnvelope"
+)
+
+// SpecHandler serves the v2 openapi spec, built from the v1 spec at the given path
+func SpecHandler(v1SpecPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		v1, err := os.ReadFile(v1SpecPath)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("not found"))
+			return
+		}
+		spec, err := V2Spec(v1)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		_, _ = w.Write(spec)
+	}
+}
+
+// V2Spec returns the v2 openapi spec. The /v1 paths are moved to /v2, the json responses are wrapped in the
+// envelope and a not found response is documented for every operation with path parameters.
+func V2Spec(v1 []byte) ([]byte, error) {
+	var spec map[string]any
+	if err := yaml.Unmarshal(v1, &spec); err != nil {
+		return nil, err
+	}
+
+	if info, ok := spec["info"].(map[string]any); ok {
+		info["version"] = apiVersion2
+	}
+
+	components, _ := spec["components"].(map[string]any)
+	if components == nil {
+		components = map[string]any{}
+		spec["components"] = components
+	}
+	sharedResponses, _ := components["responses"].(map[string]any)
+
+	paths, _ := spec["paths"].(map[string]any)
+	v2Paths := make(map[string]any, len(paths))
+	for path, item := range paths {
+		if !strings.HasPrefix(path, V1Prefix) {
+			v2Paths[path] = item
+			continue
+		}
+		v2Path := V2Prefix + strings.TrimPrefix(path, V1Prefix)
+		v2Paths[v2Path] = v2PathItem(item, sharedResponses, strings.Contains(path, "{"))
+	}
+	spec["paths"] = v2Paths
+
+	schemas, _ := components["schemas"].(map[string]any)
+	if schemas == nil {
+		schemas = map[string]any{}
+		components["schemas"] = schemas
+	}
+	schemas[metaSchema] = map[string]any{
+		"type":     "object",
+		"required": []any{"apiVersion"},
+		"properties": map[string]any{
+			"apiVersion": map[string]any{"type": "string", "example": apiVersion2},
+			"requestId":  map[string]any{"type": "string"},
+		},
+	}
+	schemas[errorEnvelopeSchema] = map[string]any{
+		"type":     "object",
+		"required": []any{"error", "meta"},
+		"properties": map[string]any{
+			"error": map[string]any{
+				"type":     "object",
+				"required": []any{"code", "message"},
+				"properties": map[string]any{
+					"code":    map[string]any{"type": "string", "example": "not_found"},
+					"message": map[string]any{"type": "string"},
+				},
+			},
+			"meta": schemaRef(metaSchema),
+		},
+	}
+
+	return yaml.Marshal(spec)
+}
+
+func v2PathItem(item any, sharedResponses map[string]any, hasPathParams bool) any {
+	operations, ok := item.(map[string]any)
+	if !ok {
+		return item
+	}
+	v2Item := make(map[string]any, len(operations))
+	for method, op := range operations {
+		operation, ok := op.(map[string]any)
+		if !ok {
+			v2Item[method] = op
+			continue
+		}
+		v2Operation := make(map[string]any, len(operation))
+		for k, v := range operation {
+			v2Operation[k] = v
+		}
+		if id, ok := v2Operation["operationId"].(string); ok {
+			v2Operation["operationId"] = id + "V2"
+		}
+		if responses, ok := operation["responses"].(map[string]any); ok {
+			v2Operation["responses"] = v2Responses(responses, sharedResponses, hasPathParams)
+		}
+		v2Item[method] = v2Operation
+	}
+	return v2Item
+}
+
+func v2Responses(responses, sharedResponses map[string]any, hasPathParams bool) map[string]any {
+	v2 := make(map[string]any, len(responses)+1)
+	for code, resp := range responses {
+		status, err := strconv.Atoi(code)
+		if code == "default" || (err == nil && status >= http.StatusBadRequest) {
+			v2[code] = errorResponse(status)
+			continue
+		}
+		v2[code] = dataResponse(resolveResponse(resp, sharedResponses))
+	}
+	if _, ok := v2["404"]; !ok && hasPathParams {
+		v2["404"] = errorResponse(http.StatusNotFound)
+	}
+	return v2
+}
+
+func resolveResponse(resp any, sharedResponses map[string]any) any {
+	r, ok := resp.(map[string]any)
+	if !ok {
+		return resp
+	}
+	ref, ok := r["$ref"].(string)
+	if !ok {
+		return resp
+	}
+	if shared, ok := sharedResponses[strings.TrimPrefix(ref, "#/components/responses/")]; ok {
+		return shared
+	}
+	return resp
+}
+
+func dataResponse(resp any) any {
+	r, ok := resp.(map[string]any)
+	if !ok {
+		return resp
+	}
+	content, ok := r["content"].(map[string]any)
+	if !ok {
+		return resp
+	}
+	jsonContent, ok := content["application/json"].(map[string]any)
+	if !ok {
+		return resp
+	}
+
+	v2JSON := make(map[string]any, len(jsonContent))
+	for k, v := range jsonContent {
+		v2JSON[k] = v
+	}
+	v2JSON["schema"] = map[string]any{
+		"type":     "object",
+		"required": []any{"data", "meta"},
+		"properties": map[string]any{
+			"data": jsonContent["schema"],
+			"meta": schemaRef(metaSchema),
+		},
+	}
+	delete(v2JSON, "example")
+	delete(v2JSON, "examples")
+
+	v2Content := make(map[string]any, len(content))
+	for k, v := range content {
+		v2Content[k] = v
+	}
+	v2Content["application/json"] = v2JSON
+
+	v2Resp := make(map[string]any, len(r))
+	for k, v := range r {
+		v2Resp[k] = v
+	}
+	v2Resp["content"] = v2Content
+	return v2Resp
+}
+
+func errorResponse(status int) map[string]any {
+	description := http.StatusText(status)
+	if description == "" {
+		description = "Error"
+	}
+	return map[string]any{
+		"description": description,
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": schemaRef(errorEnvelopeSchema),
+			},
+		},
+	}
+}
+
+func schemaRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
@@ -0,0 +1,90 @@
+package apiversion
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestV2Spec(t *testing.T) {
+	for _, path := range []string{"../../api/api.yaml", "../../api_ui/api.yaml"} {
+		t.Run(path, func(t *testing.T) {
+			v1, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			v2, err := V2Spec(v1)
+			require.NoError(t, err)
+
+			var spec map[string]any
+			require.NoError(t, yaml.Unmarshal(v2, &spec))
+			assert.Equal(t, "2", spec["info"].(map[string]any)["version"])
+
+			paths := spec["paths"].(map[string]any)
+			for p := range paths {
+				assert.NotContains(t, p, V1Prefix)
+			}
+			schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+			assert.Contains(t, schemas, metaSchema)
+			assert.Contains(t, schemas, errorEnvelopeSchema)
+		})
+	}
+}
+
+func TestV2SpecEnvelope(t *testing.T) {
+	v1 := []byte(`
+openapi: 3.0.0
+info:
+  version: "1"
+paths:
+  /status:
+    get:
+      responses:
+        '200':
+          description: ok
+  /v1/items/{id}:
+    get:
+      operationId: GetItem
+      responses:
+        '200':
+          description: Item
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Item'
+        '400':
+          $ref: '#/components/responses/400'
+components:
+  responses:
+    '400':
+      description: Bad request
+  schemas:
+    Item:
+      type: object
+`)
+	v2, err := V2Spec(v1)
+	require.NoError(t, err)
+
+	var spec map[string]any
+	require.NoError(t, yaml.Unmarshal(v2, &spec))
+	paths := spec["paths"].(map[string]any)
+	assert.Contains(t, paths, "/status")
+	require.Contains(t, paths, "/v2/items/{id}")
+
+	op := paths["/v2/items/{id}"].(map[string]any)["get"].(map[string]any)
+	assert.Equal(t, "GetItemV2", op["operationId"])
+	responses := op["responses"].(map[string]any)
+
+	okSchema := responses["200"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+	properties := okSchema["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"$ref": "#/components/schemas/Item"}, properties["data"])
+	assert.Equal(t, map[string]any{"$ref": "#/components/schemas/EnvelopeMeta"}, properties["meta"])
+
+	for _, code := range []string{"400", "404"} {
+		require.Contains(t, responses, code)
+		schema := responses[code].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/ErrorEnvelope"}, schema)
+	}
+}
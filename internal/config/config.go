@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -14,7 +17,9 @@ import (
 	"strings"
 	"time"
 
+	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/vault/api"
+	core "github.com/iden3/go-iden3-core/v2"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/spf13/viper"
 
@@ -28,6 +33,156 @@ const (
 	ipfsGateway  = "https://cloudflare-ipfs.com"
 )
 
+const defaultHolderPortalSessionTTL = time.Hour
+
+const defaultBalanceMonitorCheckInterval = time.Minute
+
+const defaultStatusOracleTimeout = 5 * time.Second
+
+const defaultStatusOracleCacheTTL = 10 * time.Minute
+
+// defaultFetchBindingTTL is the lifetime of the stored credential offers
+const defaultFetchBindingTTL = 30 * 24 * time.Hour
+
+const (
+	defaultTrustRegistryCacheTTL = time.Hour
+	defaultTrustRegistryTimeout  = 5 * time.Second
+)
+
+// Trust registry types
+const (
+	TrustRegistryNone   = "none"
+	TrustRegistrySimple = "simple"
+	TrustRegistryEBSI   = "ebsi"
+	TrustRegistryTRAIN  = "train"
+)
+
+const defaultPolicyEngineTimeout = 5 * time.Second
+
+const defaultConnectionWebhookTimeout = 5 * time.Second
+
+// defaultSecretsReloadInterval is the time between the checks of the files of the rotated secrets
+const defaultSecretsReloadInterval = time.Minute
+
+// defaultRevocationRulesCheckInterval is the time between the checks of the due revocation rules
+const defaultRevocationRulesCheckInterval = time.Minute
+
+// defaultConnectionDuplicatesInterval is the time between the reports of the duplicate connections
+const defaultConnectionDuplicatesInterval = 24 * time.Hour
+
+// Defaults of the pruning of the dead connections
+const (
+	defaultConnectionPruningInterval     = 24 * time.Hour
+	defaultConnectionPruningInactiveDays = 180
+	defaultConnectionPruningBatchSize    = 100
+)
+
+// Defaults of the archival of the old claims
+const (
+	defaultClaimArchivalInterval   = 24 * time.Hour
+	defaultClaimArchivalMinAgeDays = 365
+	defaultClaimArchivalBatchSize  = 100
+)
+
+// Price feed types
+const (
+	PriceFeedNone   = "none"
+	PriceFeedStatic = "static"
+	PriceFeedHTTP   = "http"
+)
+
+const (
+	defaultPriceFeedCacheTTL = 5 * time.Minute
+	defaultPriceFeedTimeout  = 5 * time.Second
+)
+
+// GeoIP provider types
+const (
+	GeoIPNone = "none"
+	GeoIPHTTP = "http"
+)
+
+const (
+	defaultGeoIPCacheTTL = time.Hour
+	defaultGeoIPTimeout  = 5 * time.Second
+)
+
+// State checkpoint anchor types
+const (
+	StateCheckpointsEthereum       = "ethereum"
+	StateCheckpointsOpenTimestamps = "opentimestamps"
+)
+
+const (
+	defaultStateCheckpointsInterval    = 24 * time.Hour
+	defaultStateCheckpointsCalendarURL = "https://a.pool.opentimestamps.org"
+	defaultStateCheckpointsTimeout     = 30 * time.Second
+)
+
+// IssuanceQueueRedis is the type of the issuance queue read from a redis stream, the only one supported
+const IssuanceQueueRedis = "redis"
+
+const (
+	defaultIssuanceQueueTopic        = "issuer:issuance:commands"
+	defaultIssuanceQueueReplyTopic   = "issuer:issuance:results"
+	defaultIssuanceQueueGroup        = "issuer-node"
+	defaultIssuanceQueueBatchSize    = 10
+	defaultIssuanceQueueBlockTimeout = 5 * time.Second
+)
+
+// Revocation nonce generation modes
+const (
+	RevocationNonceRandom     = "random"
+	RevocationNonceSequential = "sequential"
+)
+
+// Scopes of the revocation nonce collision checks
+const (
+	RevocationNonceCollisionsNone   = "none"
+	RevocationNonceCollisionsIssuer = "issuer"
+	RevocationNonceCollisionsNode   = "node"
+)
+
+// defaultRevocationNonceMaxRangeSize is the max number of nonces of a reserved range
+const defaultRevocationNonceMaxRangeSize = 1000000
+
+// Policy engine types
+const (
+	PolicyEngineNone = "none"
+	PolicyEngineOPA  = "opa"
+)
+
+const (
+	credentialIDPlaceholder        = "{uuid}"
+	defaultCredentialIDURITemplate = "urn:uuid:" + credentialIDPlaceholder
+)
+
+const (
+	defaultAuthProtectionMaxFailures = 5
+	defaultAuthProtectionBaseDelay   = 500 * time.Millisecond
+	defaultAuthProtectionMaxDelay    = 30 * time.Second
+	defaultAuthProtectionWindow      = 15 * time.Minute
+)
+
+const (
+	defaultMaxBodySize        = 2 << 20   // 2 MiB
+	defaultMessageMaxBodySize = 512 << 10 // 512 KiB
+	defaultRequestTimeout     = 30 * time.Second
+	defaultSlowRequest        = 3 * time.Second
+	defaultReadHeaderTimeout  = 10 * time.Second
+)
+
+// defaultDocumentsMaxAge is the time the browsers and the CDNs keep the QR bodies and the static files
+const defaultDocumentsMaxAge = 10 * time.Minute
+
+// defaultQRMaxEmbeddedLength keeps the embedded links scannable by most of the phone cameras
+const defaultQRMaxEmbeddedLength = 1000
+
+const (
+	defaultRelayerGasLimit = 1_000_000
+	defaultRelayerTimeout  = 30 * time.Second
+)
+
 // Configuration holds the project configuration
 type Configuration struct {
 	ServerUrl                    string
@@ -48,9 +203,39 @@ type Configuration struct {
 	IPFS                         IPFS          `mapstructure:"IPFS"`
 	VaultUserPassAuthEnabled     bool
 	VaultUserPassAuthPassword    string
-	CredentialStatus             CredentialStatus   `mapstructure:"CredentialStatus"`
-	CustomDIDMethods             []CustomDIDMethods `mapstructure:"-"`
-	MediaTypeManager             MediaTypeManager   `mapstructure:"MediaTypeManager"`
+	CredentialStatus             CredentialStatus     `mapstructure:"CredentialStatus"`
+	CustomDIDMethods             []CustomDIDMethods   `mapstructure:"-"`
+	MediaTypeManager             MediaTypeManager     `mapstructure:"MediaTypeManager"`
+	IssuancePolicy               IssuancePolicy       `mapstructure:"IssuancePolicy"`
+	RevocationNonces             RevocationNonces     `mapstructure:"RevocationNonces"`
+	DataEncryption               DataEncryption       `mapstructure:"DataEncryption"`
+	MultiTenancy                 MultiTenancy         `mapstructure:"MultiTenancy"`
+	BalanceMonitor               BalanceMonitor       `mapstructure:"BalanceMonitor"`
+	Relayer                      Relayer              `mapstructure:"Relayer"`
+	Safe                         Safe                 `mapstructure:"Safe"`
+	TLS                          TLS                  `mapstructure:"TLS"`
+	HTTPSecurity                 HTTPSecurity         `mapstructure:"HTTPSecurity"`
+	HTTPLimits                   HTTPLimits           `mapstructure:"HTTPLimits"`
+	HTTPCache                    HTTPCache            `mapstructure:"HTTPCache"`
+	ResponseSigning              ResponseSigning      `mapstructure:"ResponseSigning"`
+	ForwardedHeaders             ForwardedHeaders     `mapstructure:"ForwardedHeaders"`
+	QRCode                       QRCode               `mapstructure:"QRCode"`
+	StatusOracle                 StatusOracle         `mapstructure:"StatusOracle"`
+	CredentialID                 CredentialID         `mapstructure:"CredentialID"`
+	FetchBinding                 FetchBinding         `mapstructure:"FetchBinding"`
+	TrustRegistry                TrustRegistry        `mapstructure:"TrustRegistry"`
+	PolicyEngine                 PolicyEngine         `mapstructure:"PolicyEngine"`
+	ConnectionWebhook            ConnectionWebhook    `mapstructure:"ConnectionWebhook"`
+	RevocationRules              RevocationRules      `mapstructure:"RevocationRules"`
+	ConnectionDuplicates         ConnectionDuplicates `mapstructure:"ConnectionDuplicates"`
+	ConnectionPruning            ConnectionPruning    `mapstructure:"ConnectionPruning"`
+	ClaimArchival                ClaimArchival        `mapstructure:"ClaimArchival"`
+	SyntheticIssuance            SyntheticIssuance    `mapstructure:"SyntheticIssuance"`
+	PriceFeed                    PriceFeed            `mapstructure:"PriceFeed"`
+	GeoIP                        GeoIP                `mapstructure:"GeoIP"`
+	StateCheckpoints             StateCheckpoints     `mapstructure:"StateCheckpoints"`
+	IssuanceQueue                IssuanceQueue        `mapstructure:"IssuanceQueue"`
+	Secrets                      Secrets              `mapstructure:"Secrets"`
 }
 
 // Database has the database configuration
@@ -61,7 +246,8 @@ type Database struct {
 
 // Cache configurations
 type Cache struct {
-	RedisUrl string `mapstructure:"RedisUrl" tip:"The redis url to use as a cache"`
+	RedisUrl            string        `mapstructure:"RedisUrl" tip:"The redis url to use as a cache"`
+	RevocationStatusTTL time.Duration `mapstructure:"RevocationStatusTTL" tip:"Time the revocation statuses are cached in memory. 0 disables the cache"`
 }
 
 // IPFS configurations
@@ -79,10 +265,13 @@ type ReverseHashService struct {
 // Ethereum struct
 type Ethereum struct {
 	URL                       string        `tip:"Ethereum url"`
+	FallbackURLs              []string      `tip:"Ethereum urls used when the main one is not available (comma separated)"`
+	RPCStrategy               string        `tip:"How requests are spread among the ethereum urls: failover or round-robin"`
+	RPCHealthCheckInterval    time.Duration `tip:"Time between health checks of the ethereum urls"`
 	ContractAddress           string        `tip:"Contract Address"`
 	DefaultGasLimit           int           `tip:"Default Gas Limit"`
 	ConfirmationTimeout       time.Duration `tip:"Confirmation timeout"`
-	ConfirmationBlockCount    int64         `tip:"Confirmation block count"`
+	ConfirmationBlockCount    int64         `tip:"Number of blocks on top of a state transaction to consider it confirmed"`
 	ReceiptTimeout            time.Duration `tip:"Receipt timeout"`
 	MinGasPrice               int           `tip:"Minimum Gas Price"`
 	MaxGasPrice               int           `tip:"The Datasource name locator"`
@@ -93,6 +282,7 @@ type Ethereum struct {
 	ResolverPrefix            string        `tip:"blockchain:network e.g polygon:amoy"`
 	InternalTransferAmountWei int64         `tip:"Internal transfer amount in wei"`
 	TransferAccountKeyPath    string        `tip:"Transfer account key path"`
+	ExplorerURL               string        `tip:"Block explorer url used to link state transactions e.g https://polygonscan.com"`
 }
 
 // CustomDIDMethods struct
@@ -145,11 +335,20 @@ type Circuit struct {
 
 // KeyStore defines the keystore
 type KeyStore struct {
-	Address              string `tip:"Keystore address"`
-	Token                string `tip:"Token"`
-	PluginIden3MountPath string `tip:"PluginIden3MountPath"`
-	UserPassEnabled      bool   `tip:"UserPassEnabled"`
-	UserPassPassword     string `tip:"UserPassPassword"`
+	Address              string        `tip:"Keystore address"`
+	Token                string        `tip:"Token"`
+	PluginIden3MountPath string        `tip:"PluginIden3MountPath"`
+	UserPassEnabled      bool          `tip:"UserPassEnabled"`
+	UserPassPassword     string        `tip:"UserPassPassword"`
+	CustodyURL           string        `tip:"URL of the remote signer of the HSM or cloud KMS the keys can be migrated to"`
+	CustodyToken         string        `tip:"Bearer token of the remote signer"`
+	CustodyTimeout       time.Duration `tip:"Timeout of the requests to the remote signer"`
+	Policies             string        `tip:"JSON list of the policies that limit what the keys can sign"`
+}
+
+// CustodyEnabled returns true if a remote signer is configured to keep the keys in a hardware-backed custody
+func (k KeyStore) CustodyEnabled() bool {
+	return k.CustodyURL != ""
 }
 
 // Log holds runtime configurations
@@ -166,9 +365,90 @@ type KeyStore struct {
 // 1: JSON
 // 2: Text
 // The default log formal is JSON
+//
+// ModuleLevels: Minimum log level of some modules (go packages), as a comma separated list, e.g. services=-4,repositories=4
+//
+// Syslog and Loki forward the logs, in json format, besides writing them to stdout. They are disabled by default.
+//
+// Sampling: limits the debug lines with the same message, like the polling requests, logged per second.
 type Log struct {
-	Level int `mapstructure:"Level" tip:"Minimum level to log: (-4:Debug, 0:Info, 4:Warning, 8:Error)"`
-	Mode  int `mapstructure:"Mode" tip:"Log format (1: JSON, 2:Structured text)"`
+	Level        int         `mapstructure:"Level" tip:"Minimum level to log: (-4:Debug, 0:Info, 4:Warning, 8:Error)"`
+	Mode         int         `mapstructure:"Mode" tip:"Log format (1: JSON, 2:Structured text)"`
+	ModuleLevels string      `mapstructure:"ModuleLevels" tip:"Minimum level to log per module, e.g. services=-4,repositories=4"`
+	Syslog       LogSyslog   `mapstructure:"Syslog"`
+	Loki         LogLoki     `mapstructure:"Loki"`
+	Sampling     LogSampling `mapstructure:"Sampling"`
+}
+
+// LogSyslog configures the forwarding of the logs to syslog
+type LogSyslog struct {
+	Enabled bool   `mapstructure:"Enabled" tip:"Forward the logs to syslog"`
+	Network string `mapstructure:"Network" tip:"Syslog network (udp, tcp). Empty for the local syslog"`
+	Address string `mapstructure:"Address" tip:"Syslog address. Empty for the local syslog"`
+	Tag     string `mapstructure:"Tag" tip:"Syslog tag"`
+}
+
+// LogLoki configures the forwarding of the logs to Loki
+type LogLoki struct {
+	URL           string        `mapstructure:"URL" tip:"Loki push url, e.g. http://loki:3100/loki/api/v1/push. Empty to disable"`
+	Labels        string        `mapstructure:"Labels" tip:"Labels of the log streams, e.g. app=issuer,env=prod"`
+	BatchSize     int           `mapstructure:"BatchSize" tip:"Max number of lines per push"`
+	FlushInterval time.Duration `mapstructure:"FlushInterval" tip:"Max time the lines wait to be pushed"`
+}
+
+// LogSampling configures the sampling of the debug lines
+type LogSampling struct {
+	Initial    int           `mapstructure:"Initial" tip:"Lines with the same message logged per tick before sampling. 0 disables the sampling"`
+	Thereafter int           `mapstructure:"Thereafter" tip:"After the initial lines, log one of every Thereafter lines"`
+	Tick       time.Duration `mapstructure:"Tick" tip:"Sampling period"`
+}
+
+// Options returns the options to set up the logger writing to w
+func (l *Log) Options(w io.Writer) (log.Options, error) {
+	opts := log.Options{Level: l.Level, Format: l.Mode, Writer: w}
+	if l.ModuleLevels != "" {
+		levels, err := parseKeyValues(l.ModuleLevels)
+		if err != nil {
+			return opts, fmt.Errorf("invalid log module levels: %w", err)
+		}
+		opts.ModuleLevels = make(map[string]int, len(levels))
+		for module, level := range levels {
+			if opts.ModuleLevels[module], err = strconv.Atoi(level); err != nil {
+				return opts, fmt.Errorf("invalid log level of module %s: %s", module, level)
+			}
+		}
+	}
+	if l.Syslog.Enabled {
+		opts.Syslog = &log.SyslogOptions{Network: l.Syslog.Network, Address: l.Syslog.Address, Tag: l.Syslog.Tag}
+	}
+	if l.Loki.URL != "" {
+		labels, err := parseKeyValues(l.Loki.Labels)
+		if err != nil {
+			return opts, fmt.Errorf("invalid loki labels: %w", err)
+		}
+		opts.Loki = &log.LokiOptions{URL: l.Loki.URL, Labels: labels, BatchSize: l.Loki.BatchSize, FlushInterval: l.Loki.FlushInterval}
+	}
+	if l.Sampling.Initial > 0 {
+		opts.Sampling = &log.SamplingOptions{Initial: l.Sampling.Initial, Thereafter: l.Sampling.Thereafter, Tick: l.Sampling.Tick}
+	}
+	return opts, nil
+}
+
+// parseKeyValues parses a comma separated list of key=value pairs
+func parseKeyValues(s string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", pair)
+		}
+		values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return values, nil
 }
 
 // HTTPBasicAuth configuration. Some of the endpoints are protected with basic http auth. Here you can set the
@@ -180,25 +460,61 @@ type HTTPBasicAuth struct {
 
 // APIUI - APIUI backend service configuration.
 type APIUI struct {
-	ServerPort         int       `mapstructure:"ServerPort" tip:"Server UI API backend port"`
-	ServerURL          string    `mapstructure:"ServerUrl" tip:"Server UI API backend url"`
-	APIUIAuth          APIUIAuth `mapstructure:"APIUIAuth" tip:"Server UI API backend basic auth credentials"`
-	IssuerName         string    `mapstructure:"IssuerName" tip:"Server UI API backend issuer name"`
-	IssuerLogo         string    `mapstructure:"IssuerLogo" tip:"Server UI API backend issuer logo (URL)"`
-	Issuer             string    `mapstructure:"IssuerDID" tip:"Server UI API backend issuer DID (already created in the issuer node)"`
-	IssuerDID          w3c.DID   `mapstructure:"-"`
-	SchemaCache        *bool     `mapstructure:"SchemaCache" tip:"Server UI API backend for enabling schema caching"`
-	IdentityMethod     string    `mapstructure:"IdentityMethod" tip:"Server UI API backend Identity Method"`
-	IdentityBlockchain string    `mapstructure:"IdentityBlockchain" tip:"Server UI API backend Identity Blockchain"`
-	IdentityNetwork    string    `mapstructure:"IdentityNetwork" tip:"Server UI API backend Identity Network"`
-	KeyType            string    `mapstructure:"KeyType" tip:"Server UI API backend Key Type"`
+	ServerPort         int            `mapstructure:"ServerPort" tip:"Server UI API backend port"`
+	ServerURL          string         `mapstructure:"ServerUrl" tip:"Server UI API backend url"`
+	APIUIAuth          APIUIAuth      `mapstructure:"APIUIAuth" tip:"Server UI API backend basic auth credentials"`
+	IssuerName         string         `mapstructure:"IssuerName" tip:"Server UI API backend issuer name"`
+	IssuerLogo         string         `mapstructure:"IssuerLogo" tip:"Server UI API backend issuer logo (URL)"`
+	Issuer             string         `mapstructure:"IssuerDID" tip:"Server UI API backend issuer DID (already created in the issuer node)"`
+	IssuerDID          w3c.DID        `mapstructure:"-"`
+	SchemaCache        *bool          `mapstructure:"SchemaCache" tip:"Server UI API backend for enabling schema caching"`
+	IdentityMethod     string         `mapstructure:"IdentityMethod" tip:"Server UI API backend Identity Method"`
+	IdentityBlockchain string         `mapstructure:"IdentityBlockchain" tip:"Server UI API backend Identity Blockchain"`
+	IdentityNetwork    string         `mapstructure:"IdentityNetwork" tip:"Server UI API backend Identity Network"`
+	KeyType            string         `mapstructure:"KeyType" tip:"Server UI API backend Key Type"`
+	MaskedAttributes   []string       `mapstructure:"MaskedAttributes" tip:"Server UI API backend credentialSubject attributes masked for non admin users (comma separated)"`
+	HolderPortal       HolderPortal   `mapstructure:"HolderPortal" tip:"Server UI API backend holder portal configuration"`
+	LandingPage        LandingPage    `mapstructure:"LandingPage" tip:"Server UI API backend link landing page configuration"`
+	AuthProtection     AuthProtection `mapstructure:"AuthProtection" tip:"Server UI API backend brute force protection of the authentication callbacks"`
+	PublicCatalog      PublicCatalog  `mapstructure:"PublicCatalog" tip:"Server UI API backend public credential catalog configuration"`
+}
+
+// AuthProtection configures the brute force protection of the authentication and link callbacks. Failed attempts
+// are tracked per session and per client ip. Every new attempt is delayed exponentially with the number of
+// previous failures, and a session is invalidated after MaxFailures failed attempts.
+type AuthProtection struct {
+	MaxFailures int           `mapstructure:"MaxFailures" tip:"Failed attempts after which the authentication session is invalidated"`
+	BaseDelay   time.Duration `mapstructure:"BaseDelay" tip:"Delay applied after the first failed attempt. It doubles with every failure"`
+	MaxDelay    time.Duration `mapstructure:"MaxDelay" tip:"Maximum delay applied to an attempt"`
+	Window      time.Duration `mapstructure:"Window" tip:"Time the failed attempts are remembered"`
+}
+
+// LandingPage configuration. When enabled, every link has a hosted landing page at /l/{linkID} with the
+// issuer branding, the credential description and the QR code.
+type LandingPage struct {
+	Enabled bool `mapstructure:"Enabled" tip:"Enable the hosted link landing pages"`
+}
+
+// PublicCatalog configuration. When enabled, the unauthenticated /v1/catalog endpoint lists the credentials the
+// issuer offers through its active links, so ecosystem directories can index them.
+type PublicCatalog struct {
+	Enabled bool `mapstructure:"Enabled" tip:"Enable the public credential catalog endpoint"`
+}
+
+// HolderPortal configuration. The holder portal endpoints let holders authenticated with their DID list and
+// reissue the credentials they received from the issuer.
+type HolderPortal struct {
+	Enabled    bool          `mapstructure:"Enabled" tip:"Enable the holder portal endpoints"`
+	SessionTTL time.Duration `mapstructure:"SessionTTL" tip:"Holder portal session duration"`
 }
 
 // APIUIAuth configuration. Some of the UI API endpoints are protected with basic http auth. Here you can set the
 // user and password to use.
 type APIUIAuth struct {
-	User     string `mapstructure:"User" tip:"Server UI APIBasic auth username"`
-	Password string `mapstructure:"Password" tip:"Server UI API Basic auth password"`
+	User          string `mapstructure:"User" tip:"Server UI APIBasic auth username"`
+	Password      string `mapstructure:"Password" tip:"Server UI API Basic auth password"`
+	AdminUser     string `mapstructure:"AdminUser" tip:"Server UI API Basic auth admin username. Admin users see masked attributes in clear"`
+	AdminPassword string `mapstructure:"AdminPassword" tip:"Server UI API Basic auth admin password"`
 }
 
 // MediaTypeManager enables or disables the media types manager
@@ -206,6 +522,396 @@ type MediaTypeManager struct {
 	Enabled *bool `mapstructure:"Enabled" tip:"Enable or disable the media type manager"`
 }
 
+// DataEncryption enables the application level encryption of the credentials data at rest.
+// The key encryption key is kept in vault.
+type DataEncryption struct {
+	Enabled bool `mapstructure:"Enabled" tip:"Encrypt the credentials data stored in the database. Filtering credentials by credentialSubject attributes is not available when enabled"`
+}
+
+// MultiTenancy allows tenants to use the core API with their own API key.
+type MultiTenancy struct {
+	Enabled bool `mapstructure:"Enabled" tip:"Allow tenants to authenticate with their id and API key. Tenants can only access their own identities"`
+}
+
+// BalanceMonitor watches the native token balance of the state publishing account and sends alerts when it is low.
+// The monitor runs in the core API server.
+type BalanceMonitor struct {
+	Enabled       bool                `mapstructure:"Enabled" tip:"Monitor the balance of the state publishing account"`
+	Threshold     string              `mapstructure:"Threshold" tip:"Balance in wei below which alerts are sent"`
+	CheckInterval time.Duration       `mapstructure:"CheckInterval" tip:"Time between balance checks"`
+	WebhookURL    string              `mapstructure:"WebhookURL" tip:"Url that receives a POST request with every balance alert"`
+	Email         BalanceMonitorEmail `mapstructure:"Email"`
+}
+
+// BalanceMonitorEmail configures the emails sent with the balance alerts. Emails are not sent if SMTPHost is empty.
+type BalanceMonitorEmail struct {
+	SMTPHost     string   `mapstructure:"SMTPHost" tip:"SMTP server host"`
+	SMTPPort     int      `mapstructure:"SMTPPort" tip:"SMTP server port"`
+	SMTPUser     string   `mapstructure:"SMTPUser" tip:"SMTP user"`
+	SMTPPassword string   `mapstructure:"SMTPPassword" tip:"SMTP password"`
+	From         string   `mapstructure:"From" tip:"Sender of the alert emails"`
+	To           []string `mapstructure:"To" tip:"Recipients of the alert emails (comma separated)"`
+}
+
+// ThresholdWei returns the balance threshold in wei
+func (b BalanceMonitor) ThresholdWei() (*big.Int, error) {
+	threshold, ok := new(big.Int).SetString(b.Threshold, 10)
+	if !ok || threshold.Sign() < 0 {
+		return nil, fmt.Errorf("invalid balance monitor threshold <%s>", b.Threshold)
+	}
+	return threshold, nil
+}
+
+// Relayer configures a transaction relayer that submits the state transitions instead of the publishing account,
+// so the node does not need a funded hot wallet.
+type Relayer struct {
+	Enabled   bool          `mapstructure:"Enabled" tip:"Submit state transitions through the relayer instead of the publishing account"`
+	URL       string        `mapstructure:"URL" tip:"Relayer endpoint that receives the transactions"`
+	StatusURL string        `mapstructure:"StatusURL" tip:"Relayer endpoint that returns a transaction, {id} is replaced by the relayer transaction id. Defaults to the relayer url followed by /{id}"`
+	APIKey    string        `mapstructure:"APIKey" tip:"Relayer api key sent as a bearer token"`
+	GasLimit  uint64        `mapstructure:"GasLimit" tip:"Gas limit of the relayed transactions"`
+	Speed     string        `mapstructure:"Speed" tip:"Relayer speed used for the gas price, e.g safeLow, average, fast or fastest"`
+	Timeout   time.Duration `mapstructure:"Timeout" tip:"Timeout of the requests to the relayer"`
+}
+
+// Safe configures the publishing of the state transitions through a Gnosis Safe multisig. The transitions are proposed
+// to the Safe transaction service, signed with the publishing key, and the node waits until the owners execute them.
+type Safe struct {
+	Enabled                bool   `mapstructure:"Enabled" tip:"Propose the state transitions to a Gnosis Safe instead of sending them"`
+	Address                string `mapstructure:"Address" tip:"Address of the Safe that submits the state transitions"`
+	TransactionServiceURL  string `mapstructure:"TransactionServiceURL" tip:"Url of the Safe transaction service, e.g https://safe-transaction-polygon.safe.global"`
+	TransactionServiceAuth string `mapstructure:"TransactionServiceAuth" tip:"Optional api key sent as a bearer token to the Safe transaction service"`
+}
+
+// TLS configures the https listener of the api servers. When a client CA is configured, the endpoints protected
+// with basic auth also require a client certificate signed by that CA (mutual TLS). Public endpoints used by
+// holders and wallets keep working with standard TLS.
+type TLS struct {
+	CertFile              string   `mapstructure:"CertFile" tip:"Server certificate. The servers listen on https when it is set"`
+	KeyFile               string   `mapstructure:"KeyFile" tip:"Server certificate private key"`
+	ClientCAFile          string   `mapstructure:"ClientCAFile" tip:"CA bundle used to validate the client certificates of the admin endpoints"`
+	ClientAllowedSubjects []string `mapstructure:"ClientAllowedSubjects" tip:"Common names or distinguished names of the client certificates allowed (comma separated). Any certificate signed by the CA if empty"`
+}
+
+// Enabled returns true if the servers must listen on https
+func (t TLS) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// MutualTLSEnabled returns true if client certificates are required for the admin endpoints
+func (t TLS) MutualTLSEnabled() bool {
+	return t.Enabled() && t.ClientCAFile != ""
+}
+
+// StatusOracle configures an external endpoint, e.g. an HR system, that tells whether the subject of a credential
+// is still eligible for it. It is called before serving the credentials fetched by the holders, and in background
+// when the revocation status is requested, and a revocation is queued when it answers that the subject is no longer
+// eligible. The answers are cached for each credential.
+type StatusOracle struct {
+	URL      string        `mapstructure:"URL" tip:"Url of the status oracle. The oracle is disabled if empty"`
+	APIKey   string        `mapstructure:"APIKey" tip:"Optional api key sent as a bearer token to the status oracle"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Timeout of the status oracle requests"`
+	CacheTTL time.Duration `mapstructure:"CacheTTL" tip:"Time the answer of the status oracle for a credential is reused"`
+}
+
+// Enabled returns true if a status oracle is configured
+func (s StatusOracle) Enabled() bool {
+	return s.URL != ""
+}
+
+// CredentialID configures the id of the issued credentials. The template must contain the {uuid} placeholder, that
+// is replaced with the credential uuid. Pointing it to the public credential metadata endpoint, e.g.
+// https://issuer.example.com/v1/credentials/{uuid}, makes the ids dereferenceable.
+type CredentialID struct {
+	URITemplate string `mapstructure:"URITemplate" tip:"Template of the credential id uri, e.g. https://credentials.acme.com/{uuid}. Defaults to urn:uuid:{uuid}"`
+}
+
+// FetchBinding binds the credential fetch requests of the agent to the thread of the offer they answer. Every
+// thread can fetch a credential once, so a captured fetch request cannot be replayed later. In strict mode, the
+// requests whose thread is not the one of an offer sent by the issuer are rejected too.
+type FetchBinding struct {
+	Enabled bool          `mapstructure:"Enabled" tip:"Bind the credential fetch requests to the thread of their offer"`
+	Strict  bool          `mapstructure:"Strict" tip:"Reject the credential fetch requests whose thread is not the one of an offer sent by the issuer"`
+	TTL     time.Duration `mapstructure:"TTL" tip:"Time the offered and consumed threads are remembered. Defaults to 720h, the lifetime of the offers"`
+}
+
+// TrustRegistry configures the registry the verifier consults to know whether the holders that authenticate and the
+// issuers of the credentials they prove are trusted. The allow and deny lists override the answer of the registry.
+type TrustRegistry struct {
+	Type                  string        `mapstructure:"Type" tip:"Type of the trust registry: none, simple (a json list of DIDs), ebsi (trusted issuers registry api) or train (trust validator)"`
+	URL                   string        `mapstructure:"URL" tip:"Url of the trust registry"`
+	TrustFrameworkPointer string        `mapstructure:"TrustFrameworkPointer" tip:"Trust framework pointer sent to the TRAIN trust validator"`
+	Timeout               time.Duration `mapstructure:"Timeout" tip:"Timeout of the trust registry requests"`
+	CacheTTL              time.Duration `mapstructure:"CacheTTL" tip:"Time the answers of the trust registry are cached"`
+	AllowList             []string      `mapstructure:"AllowList" tip:"DIDs always trusted (comma separated)"`
+	DenyList              []string      `mapstructure:"DenyList" tip:"DIDs never trusted (comma separated)"`
+	EnforceHolders        bool          `mapstructure:"EnforceHolders" tip:"Reject the authentication of holders that are not trusted"`
+	EnforceIssuers        bool          `mapstructure:"EnforceIssuers" tip:"Reject the authentication of holders proving credentials of issuers that are not trusted"`
+}
+
+// Enabled returns true if a trust registry or an allow or deny list is configured
+func (t TrustRegistry) Enabled() bool {
+	return t.Type != TrustRegistryNone || len(t.AllowList) > 0 || len(t.DenyList) > 0
+}
+
+// RevocationRules configures the scheduler of the revocation rules. The scheduler runs in the UI API server.
+type RevocationRules struct {
+	Enabled       bool          `mapstructure:"Enabled" tip:"Run the scheduled revocation rules"`
+	CheckInterval time.Duration `mapstructure:"CheckInterval" tip:"Time between the checks of the due revocation rules. Defaults to 1m"`
+}
+
+// ConnectionDuplicates configures the periodic report of the connections of the same holder authenticated with
+// several DIDs. The report runs in the UI API server and is written to the log.
+type ConnectionDuplicates struct {
+	Enabled  bool          `mapstructure:"Enabled" tip:"Report the duplicate connections periodically"`
+	Interval time.Duration `mapstructure:"Interval" tip:"Time between the reports of the duplicate connections. Defaults to 24h"`
+}
+
+// ConnectionPruning configures the maintenance job that archives or deletes the connections whose credentials are all
+// revoked or expired and whose holder has not been active for a while. The job runs in the UI API server.
+type ConnectionPruning struct {
+	Enabled      bool          `mapstructure:"Enabled" tip:"Prune the dead connections periodically"`
+	Interval     time.Duration `mapstructure:"Interval" tip:"Time between the prunings. Defaults to 24h"`
+	InactiveDays int           `mapstructure:"InactiveDays" tip:"Days without activity of the holder before a connection is dead. Defaults to 180"`
+	Action       string        `mapstructure:"Action" tip:"What to do with the dead connections: archive (default) or delete"`
+	BatchSize    int           `mapstructure:"BatchSize" tip:"Connections pruned in each transaction. Defaults to 100"`
+}
+
+// ClaimArchival configures the maintenance job that moves the old claims to the archived_claims table. The revocation
+// nonces of the archived claims stay in the revocation tree. The job runs in the UI API server.
+type ClaimArchival struct {
+	Enabled    bool          `mapstructure:"Enabled" tip:"Archive the old claims periodically"`
+	Interval   time.Duration `mapstructure:"Interval" tip:"Time between the archivals. Defaults to 24h"`
+	MinAgeDays int           `mapstructure:"MinAgeDays" tip:"Age in days of the claims to archive. Defaults to 365"`
+	BatchSize  int           `mapstructure:"BatchSize" tip:"Claims archived in each transaction. Defaults to 100"`
+}
+
+// SyntheticIssuance replaces the vault key store with an in-memory one and the state publishing with a simulated chain
+// in the UI API server, so load tests can issue credentials without vault and without spending gas. A new issuer DID
+// is created on every start. It must never be enabled in production: the keys are lost when the server stops and the
+// states are never published on chain.
+type SyntheticIssuance struct {
+	Enabled bool `mapstructure:"Enabled" tip:"Run the UI API server with an in-memory key store and a simulated chain. For load tests only"`
+}
+
+// PriceFeed configures the price of the native token of the chain, used to convert the fees of the state transitions
+// to fiat. The http feed reads the price from a json document, like the simple price api of CoinGecko.
+type PriceFeed struct {
+	Type     string        `mapstructure:"Type" tip:"Type of the price feed: none, static (a fixed price) or http (a json price api)"`
+	Currency string        `mapstructure:"Currency" tip:"Fiat currency of the prices, i.e: USD"`
+	Price    float64       `mapstructure:"Price" tip:"Price of the native token in the static price feed"`
+	URL      string        `mapstructure:"URL" tip:"Url of the http price feed, i.e: https://api.coingecko.com/api/v3/simple/price?ids=matic-network&vs_currencies=usd"`
+	Path     string        `mapstructure:"Path" tip:"Dot separated path of the price in the http price feed response, i.e: matic-network.usd"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Timeout of the http price feed requests"`
+	CacheTTL time.Duration `mapstructure:"CacheTTL" tip:"Time the http price feed prices are cached"`
+}
+
+// Enabled returns true if a price feed is configured
+func (p PriceFeed) Enabled() bool {
+	return p.Type != "" && p.Type != PriceFeedNone
+}
+
+// GeoIP configures the provider of the countries of the client ips, used by the links restricted to some countries.
+// The http provider reads the country code from a json document, like the ones of ipapi.co or ip-api.com.
+type GeoIP struct {
+	Type     string        `mapstructure:"Type" tip:"Type of the geoip provider: none or http (a json geoip api)"`
+	URL      string        `mapstructure:"URL" tip:"Url of the http geoip provider, where {ip} is replaced by the client ip, i.e: https://ipapi.co/{ip}/json/"`
+	Path     string        `mapstructure:"Path" tip:"Dot separated path of the country code in the http geoip provider response, i.e: country_code"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Timeout of the http geoip provider requests"`
+	CacheTTL time.Duration `mapstructure:"CacheTTL" tip:"Time the countries of the ips are cached"`
+}
+
+// Enabled returns true if a geoip provider is configured
+func (g GeoIP) Enabled() bool {
+	return g.Type != "" && g.Type != GeoIPNone
+}
+
+// StateCheckpoints configures the periodic anchoring of the latest published state of the identities to a secondary
+// chain or an OpenTimestamps calendar, for the long term verifiability of the states.
+type StateCheckpoints struct {
+	Enabled     bool          `mapstructure:"Enabled" tip:"Anchor the published states periodically"`
+	Type        string        `mapstructure:"Type" tip:"Type of the anchor: opentimestamps or ethereum (a transaction in a secondary chain)"`
+	Interval    time.Duration `mapstructure:"Interval" tip:"Time between the checkpoints. Defaults to 24h"`
+	CalendarURL string        `mapstructure:"CalendarURL" tip:"Url of the OpenTimestamps calendar. Defaults to https://a.pool.opentimestamps.org"`
+	EthereumURL string        `mapstructure:"EthereumURL" tip:"Rpc url of the secondary chain of the ethereum anchor"`
+	KeyPath     string        `mapstructure:"KeyPath" tip:"Ethereum key that pays the anchor transactions. Defaults to the publishing key"`
+	Timeout     time.Duration `mapstructure:"Timeout" tip:"Timeout of the OpenTimestamps calendar requests"`
+}
+
+// IssuanceQueue configures the consumer that issues the credentials of the commands the back office writes to a queue,
+// and writes the results to a reply queue. The consumer runs in the UI API server. Only redis streams are supported,
+// sqs and kafka are out of scope: their clients are not dependencies of this module.
+type IssuanceQueue struct {
+	Enabled      bool          `mapstructure:"Enabled" tip:"Issue the credentials of the commands read from a queue"`
+	Type         string        `mapstructure:"Type" tip:"Type of the queue: redis (a redis stream of the cache redis)"`
+	Topic        string        `mapstructure:"Topic" tip:"Stream the commands are read from. Defaults to issuer:issuance:commands"`
+	ReplyTopic   string        `mapstructure:"ReplyTopic" tip:"Stream the results are written to. Defaults to issuer:issuance:results"`
+	Group        string        `mapstructure:"Group" tip:"Consumer group shared by the issuer nodes. Defaults to issuer-node"`
+	Consumer     string        `mapstructure:"Consumer" tip:"Name of this node in the consumer group. Defaults to the hostname"`
+	BatchSize    int           `mapstructure:"BatchSize" tip:"Max commands read at once. Defaults to 10"`
+	BlockTimeout time.Duration `mapstructure:"BlockTimeout" tip:"Time a read waits for new commands. Defaults to 5s"`
+}
+
+// PolicyEngine configures the policy engine consulted before issuing every credential. The engine allows, denies or
+// modifies the credential with the business rules of the issuer.
+type PolicyEngine struct {
+	Type     string        `mapstructure:"Type" tip:"Type of the policy engine: none or opa (open policy agent data api)"`
+	URL      string        `mapstructure:"URL" tip:"Url of the policy decision, i.e: http://opa:8181/v1/data/issuer/decision"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Timeout of the policy engine requests"`
+	FailOpen bool          `mapstructure:"FailOpen" tip:"Issue the credentials when the policy engine can not be consulted. By default they are denied"`
+}
+
+// ConnectionWebhook configures the webhook consulted when a holder authenticates for the first time, before the
+// connection is created and any credential is offered. The webhook allows or denies the new connection.
+type ConnectionWebhook struct {
+	URL      string        `mapstructure:"URL" tip:"Url the new connections are posted to. The connections are not vetted if empty"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Timeout of the connection webhook requests"`
+	FailOpen bool          `mapstructure:"FailOpen" tip:"Create the connections when the webhook can not be consulted. By default they are denied"`
+}
+
+// Secrets configures the directory of the secrets that can be rotated without restarting: the files database-password,
+// vault-token and cache-password override the passwords of the database and redis urls and the vault token.
+type Secrets struct {
+	Dir            string        `mapstructure:"Dir" tip:"Directory of the rotated secrets, one file per secret. Secrets are not rotated if empty"`
+	ReloadInterval time.Duration `mapstructure:"ReloadInterval" tip:"Time between the checks of the secret files. They are also reloaded on SIGHUP"`
+}
+
+// HTTPSecurity configures the CORS policy and the security headers of the api servers. The admin group contains
+// the endpoints protected with basic auth and the public group the ones used by holders and wallets, like the agent,
+// the QR codes and the credential links, so they can be embedded in other web apps without opening the admin api.
+type HTTPSecurity struct {
+	Admin  EndpointSecurity `mapstructure:"Admin"`
+	Public EndpointSecurity `mapstructure:"Public"`
+}
+
+// HTTPLimits configures the max size of the request bodies and the max time to handle the requests of the api
+// servers. The messages group contains the endpoints that accept packed messages from wallets, like the agent and the
+// callbacks, so they can get tighter limits than the rest.
+type HTTPLimits struct {
+	Default           EndpointLimits `mapstructure:"Default"`
+	Messages          EndpointLimits `mapstructure:"Messages"`
+	SlowRequest       time.Duration  `mapstructure:"SlowRequest" tip:"Requests taking longer than this are logged as slow"`
+	ReadHeaderTimeout time.Duration  `mapstructure:"ReadHeaderTimeout" tip:"Max time to read the headers of a request"`
+}
+
+// HTTPCache configures the cache headers of the public endpoints fetched again and again by wallets and verifiers. The
+// documents group contains the immutable ones, like the QR bodies, and the statuses group the revocation statuses,
+// that change when the credentials are revoked. Both get an ETag and answer the conditional requests with 304 Not
+// Modified. The rest of the endpoints are not cacheable.
+type HTTPCache struct {
+	Documents EndpointCache `mapstructure:"Documents"`
+	Statuses  EndpointCache `mapstructure:"Statuses"`
+}
+
+// ResponseSigning configures the detached JWS signature of the responses of the public endpoints, like the revocation
+// statuses, sent in the X-JWS-Signature header. The public key is published in /.well-known/jwks.json.
+type ResponseSigning struct {
+	KeyPath string `mapstructure:"KeyPath" tip:"PEM file of the ECDSA P-256 private key that signs the responses. They are not signed if empty"`
+}
+
+// ForwardedHeaders configures how the api servers build the links of the responses, like the QR codes and the
+// callbacks, when they are deployed behind reverse proxies or several domains. The links are built from the
+// X-Forwarded-Host, X-Forwarded-Proto and X-Forwarded-Prefix headers of the requests sent by the trusted proxies, or
+// from the Host header of the requests sent directly to one of the allowed hosts. Only the rightmost value of each
+// forwarded header, the one set by the trusted proxy, is used. A request can also choose one of the allowed hosts
+// with the X-Base-URL header. Otherwise, or if nothing is configured, they are built from the server url.
+type ForwardedHeaders struct {
+	TrustedProxies []string `mapstructure:"TrustedProxies" tip:"IPs or CIDR ranges of the reverse proxies whose X-Forwarded headers are trusted (comma separated)"`
+	AllowedHosts   []string `mapstructure:"AllowedHosts" tip:"Hosts the links can be built for, and the X-Base-URL header can ask for (comma separated). Any host sent by a trusted proxy if empty"`
+}
+
+// Enabled returns true if the links can be built from the requests instead of the server url
+func (f ForwardedHeaders) Enabled() bool {
+	return len(f.TrustedProxies) > 0 || len(f.AllowedHosts) > 0
+}
+
+// TrustedNetworks returns the networks of the trusted proxies. A single ip is returned as a network of just that ip.
+func (f ForwardedHeaders) TrustedNetworks() ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(f.TrustedProxies))
+	for _, proxy := range f.TrustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// QRCode configures the links of the QR codes requested with the auto type. The message is embedded in the link
+// when the link is not longer than MaxEmbeddedLength, compressed first if Compression is enabled, and otherwise the
+// link points to the message in the QR store.
+// The compressed links have a c=deflate parameter that is not part of the iden3comm specification, so Compression
+// is disabled by default: only enable it if the wallets of the holders support compressed messages.
+type QRCode struct {
+	MaxEmbeddedLength int  `mapstructure:"MaxEmbeddedLength" tip:"Max length of a QR link with the message embedded"`
+	Compression       bool `mapstructure:"Compression" tip:"Compress the embedded messages that don't fit otherwise. Non-standard (c=deflate), incompatible with standard wallets. Disabled by default"`
+}
+
+// EndpointLimits configures the limits of a group of endpoints
+type EndpointLimits struct {
+	MaxBodySize int64         `mapstructure:"MaxBodySize" tip:"Max size in bytes of the request bodies"`
+	Timeout     time.Duration `mapstructure:"Timeout" tip:"Max time to handle a request"`
+}
+
+// EndpointCache configures the cache headers of a group of endpoints
+type EndpointCache struct {
+	MaxAge       time.Duration `mapstructure:"MaxAge" tip:"max-age of the Cache-Control header. The responses are revalidated with their ETag every time if empty"`
+	SharedMaxAge time.Duration `mapstructure:"SharedMaxAge" tip:"s-maxage of the Cache-Control header, the time the CDNs and the shared caches keep the responses. Not sent if empty"`
+}
+
+// EndpointSecurity configures the CORS policy and the security headers of a group of endpoints
+type EndpointSecurity struct {
+	AllowedOrigins        []string      `mapstructure:"AllowedOrigins" tip:"Origins allowed to call the endpoints (comma separated). Use * to allow any origin"`
+	AllowedHeaders        []string      `mapstructure:"AllowedHeaders" tip:"Request headers allowed in cross origin requests (comma separated). Use * to allow any header"`
+	ContentSecurityPolicy string        `mapstructure:"ContentSecurityPolicy" tip:"Value of the Content-Security-Policy header, e.g frame-ancestors https://app.example.com. Not sent if empty"`
+	HSTSMaxAge            time.Duration `mapstructure:"HSTSMaxAge" tip:"max-age of the Strict-Transport-Security header. Not sent if empty"`
+}
+
+// DuplicateCredentialsMode is the action taken when a credential identical to an existing one is requested
+type DuplicateCredentialsMode string
+
+const (
+	DuplicateCredentialsAllow  DuplicateCredentialsMode = ""       // DuplicateCredentialsAllow disables the duplicate credential detection
+	DuplicateCredentialsReturn DuplicateCredentialsMode = "Return" // DuplicateCredentialsReturn returns the existing credential instead of issuing a new one
+	DuplicateCredentialsReject DuplicateCredentialsMode = "Reject" // DuplicateCredentialsReject rejects the request unless it is forced
+)
+
+// IssuancePolicy limits the credentials that can be issued to the same user (connection) for the same schema.
+// Zero values disable the corresponding limit. DeferredMTPProof trades the wait for the state publish of the merkle
+// tree proof credentials for an extra signature.
+type IssuancePolicy struct {
+	MaxCredentialsPerSchema int                      `mapstructure:"MaxCredentialsPerSchema" tip:"Max number of non revoked credentials of the same schema per connection. 0 means no limit"`
+	Cooldown                time.Duration            `mapstructure:"Cooldown" tip:"Min time between two credentials of the same schema for the same connection. 0 means no cooldown"`
+	DuplicateCredentials    DuplicateCredentialsMode `mapstructure:"DuplicateCredentials" tip:"Action on requests for a credential identical to an existing non revoked one (Return, Reject). Empty disables the detection"`
+	DeferredMTPProof        bool                     `mapstructure:"DeferredMTPProof" tip:"Sign the credentials that ask for a merkle tree proof, so they can be used before the next state publish. The proof is attached, and the holder notified, after the publish"`
+}
+
+// RevocationNonces configures how the revocation nonces of the credentials are generated. The random nonces are 32 bits
+// long, the nonce ranges reserved for the integrations are allocated above them. In a node with several issuers, the
+// node collision scope checks the nonces against the credentials and the revocations of all of them.
+type RevocationNonces struct {
+	Mode           string `mapstructure:"Mode" tip:"How the nonces are generated: random (default) or sequential"`
+	CollisionScope string `mapstructure:"CollisionScope" tip:"Where the nonces must be unique: none (default), issuer or node (all the issuers of the node)"`
+	MaxRangeSize   uint64 `mapstructure:"MaxRangeSize" tip:"Max number of nonces of a reserved range. Defaults to 1000000"`
+}
+
 // Sanitize perform some basic checks and sanitizations in the configuration.
 // Returns true if config is acceptable, error otherwise.
 func (c *Configuration) Sanitize(ctx context.Context) error {
@@ -225,6 +931,539 @@ func (c *Configuration) Sanitize(ctx context.Context) error {
 		return err
 	}
 
+	if err := c.sanitizeIssuancePolicy(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeRevocationNonces(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeBalanceMonitor(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePublishingMode(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeTLS(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeForwardedHeaders(ctx); err != nil {
+		return err
+	}
+
+	c.sanitizeHTTPSecurity()
+	c.sanitizeHTTPLimits()
+	c.sanitizeHTTPCache()
+	c.sanitizeQRCode()
+
+	if err := c.sanitizeStatusOracle(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeCredentialID(ctx); err != nil {
+		return err
+	}
+
+	c.sanitizeFetchBinding()
+	c.sanitizeRevocationRules()
+	c.sanitizeConnectionDuplicates()
+
+	if err := c.sanitizeTrustRegistry(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePolicyEngine(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeConnectionWebhook(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeSecrets(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePriceFeed(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeGeoIP(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Configuration) sanitizeFetchBinding() {
+	if c.FetchBinding.TTL <= 0 {
+		c.FetchBinding.TTL = defaultFetchBindingTTL
+	}
+}
+
+func (c *Configuration) sanitizeRevocationRules() {
+	if c.RevocationRules.CheckInterval <= 0 {
+		c.RevocationRules.CheckInterval = defaultRevocationRulesCheckInterval
+	}
+}
+
+func (c *Configuration) sanitizeConnectionDuplicates() {
+	if c.ConnectionDuplicates.Interval <= 0 {
+		c.ConnectionDuplicates.Interval = defaultConnectionDuplicatesInterval
+	}
+}
+
+func (c *Configuration) sanitizeClaimArchival() {
+	if c.ClaimArchival.Interval <= 0 {
+		c.ClaimArchival.Interval = defaultClaimArchivalInterval
+	}
+	if c.ClaimArchival.MinAgeDays <= 0 {
+		c.ClaimArchival.MinAgeDays = defaultClaimArchivalMinAgeDays
+	}
+	if c.ClaimArchival.BatchSize <= 0 {
+		c.ClaimArchival.BatchSize = defaultClaimArchivalBatchSize
+	}
+}
+
+func (c *Configuration) sanitizeConnectionPruning(ctx context.Context) error {
+	if c.ConnectionPruning.Interval <= 0 {
+		c.ConnectionPruning.Interval = defaultConnectionPruningInterval
+	}
+	if c.ConnectionPruning.InactiveDays <= 0 {
+		c.ConnectionPruning.InactiveDays = defaultConnectionPruningInactiveDays
+	}
+	if c.ConnectionPruning.BatchSize <= 0 {
+		c.ConnectionPruning.BatchSize = defaultConnectionPruningBatchSize
+	}
+	switch c.ConnectionPruning.Action {
+	case "":
+		c.ConnectionPruning.Action = "archive"
+	case "archive", "delete":
+	default:
+		log.Error(ctx, "ISSUER_CONNECTION_PRUNING_ACTION is not valid", "action", c.ConnectionPruning.Action)
+		return fmt.Errorf("invalid connection pruning action %s", c.ConnectionPruning.Action)
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeSyntheticIssuance(ctx context.Context) error {
+	if !c.SyntheticIssuance.Enabled {
+		return nil
+	}
+	if c.APIUI.IdentityNetwork == string(core.Main) {
+		return fmt.Errorf("the synthetic issuance mode can not be used with the %s network", c.APIUI.IdentityNetwork)
+	}
+	if c.DataEncryption.Enabled {
+		return fmt.Errorf("the synthetic issuance mode does not use vault, so the data encryption can not be enabled")
+	}
+	if c.KeyStore.CustodyEnabled() {
+		return fmt.Errorf("the synthetic issuance mode can not be used with a key custody")
+	}
+	log.Warn(ctx, "SYNTHETIC ISSUANCE MODE: the keys are kept in memory and the states are not published on chain. Do not use it in production")
+	return nil
+}
+
+func (c *Configuration) sanitizePolicyEngine(ctx context.Context) error {
+	if c.PolicyEngine.Type == "" {
+		c.PolicyEngine.Type = PolicyEngineNone
+	}
+	switch c.PolicyEngine.Type {
+	case PolicyEngineNone:
+	case PolicyEngineOPA:
+		if _, err := url.ParseRequestURI(c.PolicyEngine.URL); err != nil {
+			log.Error(ctx, "ISSUER_POLICY_ENGINE_URL is not valid", "url", c.PolicyEngine.URL)
+			return fmt.Errorf("invalid policy engine url %s", c.PolicyEngine.URL)
+		}
+	default:
+		log.Error(ctx, "ISSUER_POLICY_ENGINE_TYPE is not valid", "type", c.PolicyEngine.Type)
+		return fmt.Errorf("invalid policy engine type %s", c.PolicyEngine.Type)
+	}
+	if c.PolicyEngine.Timeout == 0 {
+		c.PolicyEngine.Timeout = defaultPolicyEngineTimeout
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeConnectionWebhook(ctx context.Context) error {
+	if c.ConnectionWebhook.URL == "" {
+		return nil
+	}
+	if _, err := url.ParseRequestURI(c.ConnectionWebhook.URL); err != nil {
+		log.Error(ctx, "ISSUER_CONNECTION_WEBHOOK_URL is not valid", "url", c.ConnectionWebhook.URL)
+		return fmt.Errorf("invalid connection webhook url %s", c.ConnectionWebhook.URL)
+	}
+	if c.ConnectionWebhook.Timeout == 0 {
+		c.ConnectionWebhook.Timeout = defaultConnectionWebhookTimeout
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeSecrets(ctx context.Context) error {
+	if c.Secrets.Dir == "" {
+		return nil
+	}
+	if info, err := os.Stat(c.Secrets.Dir); err != nil || !info.IsDir() {
+		log.Error(ctx, "ISSUER_SECRETS_DIR is not a directory", "dir", c.Secrets.Dir)
+		return fmt.Errorf("invalid secrets directory %s", c.Secrets.Dir)
+	}
+	if c.Secrets.ReloadInterval == 0 {
+		c.Secrets.ReloadInterval = defaultSecretsReloadInterval
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeStateCheckpoints(ctx context.Context) error {
+	if !c.StateCheckpoints.Enabled {
+		return nil
+	}
+	if c.StateCheckpoints.Type == "" {
+		c.StateCheckpoints.Type = StateCheckpointsOpenTimestamps
+	}
+	switch c.StateCheckpoints.Type {
+	case StateCheckpointsOpenTimestamps:
+		if c.StateCheckpoints.CalendarURL == "" {
+			c.StateCheckpoints.CalendarURL = defaultStateCheckpointsCalendarURL
+		}
+		if _, err := url.ParseRequestURI(c.StateCheckpoints.CalendarURL); err != nil {
+			log.Error(ctx, "ISSUER_STATE_CHECKPOINTS_CALENDAR_URL is not valid", "url", c.StateCheckpoints.CalendarURL)
+			return fmt.Errorf("invalid state checkpoints calendar url %s", c.StateCheckpoints.CalendarURL)
+		}
+	case StateCheckpointsEthereum:
+		if _, err := url.ParseRequestURI(c.StateCheckpoints.EthereumURL); err != nil {
+			log.Error(ctx, "ISSUER_STATE_CHECKPOINTS_ETHEREUM_URL is not valid", "url", c.StateCheckpoints.EthereumURL)
+			return fmt.Errorf("invalid state checkpoints ethereum url %s", c.StateCheckpoints.EthereumURL)
+		}
+		if c.StateCheckpoints.KeyPath == "" {
+			c.StateCheckpoints.KeyPath = c.PublishingKeyPath
+		}
+	default:
+		log.Error(ctx, "ISSUER_STATE_CHECKPOINTS_TYPE is not valid", "type", c.StateCheckpoints.Type)
+		return fmt.Errorf("invalid state checkpoints type %s", c.StateCheckpoints.Type)
+	}
+	if c.StateCheckpoints.Interval <= 0 {
+		c.StateCheckpoints.Interval = defaultStateCheckpointsInterval
+	}
+	if c.StateCheckpoints.Timeout == 0 {
+		c.StateCheckpoints.Timeout = defaultStateCheckpointsTimeout
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeIssuanceQueue(ctx context.Context) error {
+	if !c.IssuanceQueue.Enabled {
+		return nil
+	}
+	if c.IssuanceQueue.Type == "" {
+		c.IssuanceQueue.Type = IssuanceQueueRedis
+	}
+	switch c.IssuanceQueue.Type {
+	case IssuanceQueueRedis:
+	default:
+		log.Error(ctx, "ISSUER_ISSUANCE_QUEUE_TYPE is not valid, only redis is supported", "type", c.IssuanceQueue.Type)
+		return fmt.Errorf("invalid issuance queue type %s, only redis is supported", c.IssuanceQueue.Type)
+	}
+	if c.IssuanceQueue.Topic == "" {
+		c.IssuanceQueue.Topic = defaultIssuanceQueueTopic
+	}
+	if c.IssuanceQueue.ReplyTopic == "" {
+		c.IssuanceQueue.ReplyTopic = defaultIssuanceQueueReplyTopic
+	}
+	if c.IssuanceQueue.Topic == c.IssuanceQueue.ReplyTopic {
+		return fmt.Errorf("the issuance queue and its reply queue must be different")
+	}
+	if c.IssuanceQueue.Group == "" {
+		c.IssuanceQueue.Group = defaultIssuanceQueueGroup
+	}
+	if c.IssuanceQueue.Consumer == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("the issuance queue consumer name must be provided: %w", err)
+		}
+		c.IssuanceQueue.Consumer = hostname
+	}
+	if c.IssuanceQueue.BatchSize <= 0 {
+		c.IssuanceQueue.BatchSize = defaultIssuanceQueueBatchSize
+	}
+	if c.IssuanceQueue.BlockTimeout <= 0 {
+		c.IssuanceQueue.BlockTimeout = defaultIssuanceQueueBlockTimeout
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeGeoIP(ctx context.Context) error {
+	if c.GeoIP.Type == "" {
+		c.GeoIP.Type = GeoIPNone
+	}
+	switch c.GeoIP.Type {
+	case GeoIPNone:
+		return nil
+	case GeoIPHTTP:
+		if !strings.Contains(c.GeoIP.URL, "{ip}") {
+			log.Error(ctx, "ISSUER_GEOIP_URL must include the {ip} placeholder", "url", c.GeoIP.URL)
+			return fmt.Errorf("invalid geoip url %s", c.GeoIP.URL)
+		}
+		if _, err := url.ParseRequestURI(strings.ReplaceAll(c.GeoIP.URL, "{ip}", "127.0.0.1")); err != nil {
+			log.Error(ctx, "ISSUER_GEOIP_URL is not valid", "url", c.GeoIP.URL)
+			return fmt.Errorf("invalid geoip url %s", c.GeoIP.URL)
+		}
+		if c.GeoIP.Path == "" {
+			log.Error(ctx, "ISSUER_GEOIP_PATH is required by the http geoip provider")
+			return errors.New("geoip path is required")
+		}
+	default:
+		log.Error(ctx, "ISSUER_GEOIP_TYPE is not valid", "type", c.GeoIP.Type)
+		return fmt.Errorf("invalid geoip type %s", c.GeoIP.Type)
+	}
+	if c.GeoIP.Timeout == 0 {
+		c.GeoIP.Timeout = defaultGeoIPTimeout
+	}
+	if c.GeoIP.CacheTTL == 0 {
+		c.GeoIP.CacheTTL = defaultGeoIPCacheTTL
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizePriceFeed(ctx context.Context) error {
+	if c.PriceFeed.Type == "" {
+		c.PriceFeed.Type = PriceFeedNone
+	}
+	switch c.PriceFeed.Type {
+	case PriceFeedNone:
+		return nil
+	case PriceFeedStatic:
+		if c.PriceFeed.Price <= 0 {
+			log.Error(ctx, "ISSUER_PRICE_FEED_PRICE must be greater than 0", "price", c.PriceFeed.Price)
+			return fmt.Errorf("invalid price feed price %v", c.PriceFeed.Price)
+		}
+	case PriceFeedHTTP:
+		if _, err := url.ParseRequestURI(c.PriceFeed.URL); err != nil {
+			log.Error(ctx, "ISSUER_PRICE_FEED_URL is not valid", "url", c.PriceFeed.URL)
+			return fmt.Errorf("invalid price feed url %s", c.PriceFeed.URL)
+		}
+		if c.PriceFeed.Path == "" {
+			log.Error(ctx, "ISSUER_PRICE_FEED_PATH is required by the http price feed")
+			return errors.New("price feed path is required")
+		}
+	default:
+		log.Error(ctx, "ISSUER_PRICE_FEED_TYPE is not valid", "type", c.PriceFeed.Type)
+		return fmt.Errorf("invalid price feed type %s", c.PriceFeed.Type)
+	}
+	if c.PriceFeed.Currency == "" {
+		log.Error(ctx, "ISSUER_PRICE_FEED_CURRENCY is required by the price feed")
+		return errors.New("price feed currency is required")
+	}
+	c.PriceFeed.Currency = strings.ToUpper(c.PriceFeed.Currency)
+	if c.PriceFeed.Timeout == 0 {
+		c.PriceFeed.Timeout = defaultPriceFeedTimeout
+	}
+	if c.PriceFeed.CacheTTL <= 0 {
+		c.PriceFeed.CacheTTL = defaultPriceFeedCacheTTL
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeTrustRegistry(ctx context.Context) error {
+	if c.TrustRegistry.Type == "" {
+		c.TrustRegistry.Type = TrustRegistryNone
+	}
+	switch c.TrustRegistry.Type {
+	case TrustRegistryNone:
+	case TrustRegistrySimple, TrustRegistryEBSI, TrustRegistryTRAIN:
+		if _, err := url.ParseRequestURI(c.TrustRegistry.URL); err != nil {
+			log.Error(ctx, "ISSUER_TRUST_REGISTRY_URL is not a valid url", "err", err)
+			return fmt.Errorf("invalid trust registry url: %w", err)
+		}
+	default:
+		log.Error(ctx, "ISSUER_TRUST_REGISTRY_TYPE is not valid", "type", c.TrustRegistry.Type)
+		return fmt.Errorf("invalid trust registry type %s", c.TrustRegistry.Type)
+	}
+	if (c.TrustRegistry.EnforceHolders || c.TrustRegistry.EnforceIssuers) && c.TrustRegistry.Type == TrustRegistryNone && len(c.TrustRegistry.AllowList) == 0 {
+		log.Error(ctx, "the trust registry can not be enforced without a registry or an allow list")
+		return fmt.Errorf("trust registry enforced without a registry or an allow list")
+	}
+	if c.TrustRegistry.Timeout == 0 {
+		c.TrustRegistry.Timeout = defaultTrustRegistryTimeout
+	}
+	if c.TrustRegistry.CacheTTL <= 0 {
+		c.TrustRegistry.CacheTTL = defaultTrustRegistryCacheTTL
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeAuthProtection() {
+	if c.APIUI.AuthProtection.MaxFailures <= 0 {
+		c.APIUI.AuthProtection.MaxFailures = defaultAuthProtectionMaxFailures
+	}
+	if c.APIUI.AuthProtection.BaseDelay == 0 {
+		c.APIUI.AuthProtection.BaseDelay = defaultAuthProtectionBaseDelay
+	}
+	if c.APIUI.AuthProtection.MaxDelay == 0 {
+		c.APIUI.AuthProtection.MaxDelay = defaultAuthProtectionMaxDelay
+	}
+	if c.APIUI.AuthProtection.Window == 0 {
+		c.APIUI.AuthProtection.Window = defaultAuthProtectionWindow
+	}
+}
+
+func (c *Configuration) sanitizeStatusOracle(ctx context.Context) error {
+	if !c.StatusOracle.Enabled() {
+		return nil
+	}
+	if _, err := url.ParseRequestURI(c.StatusOracle.URL); err != nil {
+		log.Error(ctx, "ISSUER_STATUS_ORACLE_URL is not a valid url", "err", err)
+		return fmt.Errorf("invalid status oracle url: %w", err)
+	}
+	if c.StatusOracle.Timeout == 0 {
+		c.StatusOracle.Timeout = defaultStatusOracleTimeout
+	}
+	if c.StatusOracle.CacheTTL == 0 {
+		c.StatusOracle.CacheTTL = defaultStatusOracleCacheTTL
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeCredentialID(ctx context.Context) error {
+	if c.CredentialID.URITemplate == "" {
+		c.CredentialID.URITemplate = defaultCredentialIDURITemplate
+	}
+	if strings.Count(c.CredentialID.URITemplate, credentialIDPlaceholder) != 1 {
+		log.Error(ctx, "ISSUER_CREDENTIAL_ID_URI_TEMPLATE must contain the {uuid} placeholder once", "template", c.CredentialID.URITemplate)
+		return fmt.Errorf("invalid credential id uri template: it must contain %s once", credentialIDPlaceholder)
+	}
+	u, err := url.Parse(strings.Replace(c.CredentialID.URITemplate, credentialIDPlaceholder, "00000000-0000-0000-0000-000000000000", 1))
+	if err != nil || u.Scheme == "" {
+		log.Error(ctx, "ISSUER_CREDENTIAL_ID_URI_TEMPLATE is not a valid uri", "err", err)
+		return fmt.Errorf("invalid credential id uri template: %s", c.CredentialID.URITemplate)
+	}
+	return nil
+}
+
+// sanitizeHTTPLimits sets the default limits of the groups not configured
+func (c *Configuration) sanitizeHTTPLimits() {
+	defaults := map[*EndpointLimits]EndpointLimits{
+		&c.HTTPLimits.Default:  {MaxBodySize: defaultMaxBodySize, Timeout: defaultRequestTimeout},
+		&c.HTTPLimits.Messages: {MaxBodySize: defaultMessageMaxBodySize, Timeout: defaultRequestTimeout},
+	}
+	for group, def := range defaults {
+		if group.MaxBodySize <= 0 {
+			group.MaxBodySize = def.MaxBodySize
+		}
+		if group.Timeout <= 0 {
+			group.Timeout = def.Timeout
+		}
+	}
+	if c.HTTPLimits.SlowRequest <= 0 {
+		c.HTTPLimits.SlowRequest = defaultSlowRequest
+	}
+	if c.HTTPLimits.ReadHeaderTimeout <= 0 {
+		c.HTTPLimits.ReadHeaderTimeout = defaultReadHeaderTimeout
+	}
+}
+
+// sanitizeHTTPCache sets the default max age of the documents. The statuses are revalidated every time by default.
+func (c *Configuration) sanitizeHTTPCache() {
+	if c.HTTPCache.Documents.MaxAge <= 0 {
+		c.HTTPCache.Documents.MaxAge = defaultDocumentsMaxAge
+	}
+	if c.HTTPCache.Statuses.MaxAge < 0 {
+		c.HTTPCache.Statuses.MaxAge = 0
+	}
+}
+
+func (c *Configuration) sanitizeQRCode() {
+	if c.QRCode.MaxEmbeddedLength <= 0 {
+		c.QRCode.MaxEmbeddedLength = defaultQRMaxEmbeddedLength
+	}
+}
+
+// sanitizeHTTPSecurity keeps the previous behaviour, any origin and header allowed, for the groups not configured
+func (c *Configuration) sanitizeHTTPSecurity() {
+	for _, group := range []*EndpointSecurity{&c.HTTPSecurity.Admin, &c.HTTPSecurity.Public} {
+		if len(group.AllowedOrigins) == 0 {
+			group.AllowedOrigins = []string{"*"}
+		}
+		if len(group.AllowedHeaders) == 0 {
+			group.AllowedHeaders = []string{"*"}
+		}
+	}
+}
+
+func (c *Configuration) sanitizeForwardedHeaders(ctx context.Context) error {
+	if _, err := c.ForwardedHeaders.TrustedNetworks(); err != nil {
+		log.Error(ctx, "ISSUER_HTTP_TRUSTED_PROXIES must be a comma separated list of ips or CIDR ranges", "err", err)
+		return err
+	}
+	for i, host := range c.ForwardedHeaders.AllowedHosts {
+		c.ForwardedHeaders.AllowedHosts[i] = strings.ToLower(strings.TrimSpace(host))
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeTLS(ctx context.Context) error {
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		log.Error(ctx, "ISSUER_TLS_CERT_FILE and ISSUER_TLS_KEY_FILE must be provided together")
+		return errors.New("tls certificate and key must be provided together")
+	}
+	if c.TLS.ClientCAFile != "" && !c.TLS.Enabled() {
+		log.Error(ctx, "ISSUER_TLS_CLIENT_CA_FILE requires ISSUER_TLS_CERT_FILE and ISSUER_TLS_KEY_FILE")
+		return errors.New("mutual tls requires a server certificate")
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizePublishingMode(ctx context.Context) error {
+	if c.Safe.Enabled {
+		if c.Relayer.Enabled {
+			log.Error(ctx, "ISSUER_RELAYER_ENABLED and ISSUER_SAFE_ENABLED can not be enabled at the same time")
+			return errors.New("only one of the relayer or the safe can be used to publish states")
+		}
+		if !ethCommon.IsHexAddress(c.Safe.Address) {
+			log.Error(ctx, "ISSUER_SAFE_ADDRESS must be a valid address", "address", c.Safe.Address)
+			return errors.New("invalid safe address")
+		}
+		if c.Safe.TransactionServiceURL == "" {
+			log.Error(ctx, "ISSUER_SAFE_TRANSACTION_SERVICE_URL is required when the safe is enabled")
+			return errors.New("safe transaction service url is required")
+		}
+	}
+	if !c.Relayer.Enabled {
+		return nil
+	}
+	if c.Relayer.URL == "" {
+		log.Error(ctx, "ISSUER_RELAYER_URL is required when the relayer is enabled")
+		return errors.New("relayer url is required")
+	}
+	if c.Relayer.StatusURL == "" {
+		c.Relayer.StatusURL = strings.TrimSuffix(c.Relayer.URL, "/") + "/{id}"
+	}
+	if !strings.Contains(c.Relayer.StatusURL, "{id}") {
+		log.Error(ctx, "ISSUER_RELAYER_STATUS_URL must contain the {id} placeholder")
+		return errors.New("relayer status url must contain {id}")
+	}
+	if c.Relayer.GasLimit == 0 {
+		c.Relayer.GasLimit = defaultRelayerGasLimit
+	}
+	if c.Relayer.Timeout == 0 {
+		c.Relayer.Timeout = defaultRelayerTimeout
+	}
+	return nil
+}
+
+func (c *Configuration) sanitizeBalanceMonitor(ctx context.Context) error {
+	if !c.BalanceMonitor.Enabled {
+		return nil
+	}
+	if _, err := c.BalanceMonitor.ThresholdWei(); err != nil {
+		log.Error(ctx, "ISSUER_BALANCE_MONITOR_THRESHOLD must be a balance in wei", "err", err)
+		return err
+	}
+	if c.BalanceMonitor.CheckInterval == 0 {
+		c.BalanceMonitor.CheckInterval = defaultBalanceMonitorCheckInterval
+	}
 	return nil
 }
 
@@ -240,7 +1479,7 @@ func (c *Configuration) SanitizeAPIUI(ctx context.Context) (err error) {
 	}
 
 	log.Info(ctx, "Checking vault token", "token", c.KeyStore.Token)
-	if c.KeyStore.Token == "" && !c.VaultUserPassAuthEnabled {
+	if c.KeyStore.Token == "" && !c.VaultUserPassAuthEnabled && !c.SyntheticIssuance.Enabled {
 		log.Error(ctx, "a vault token must be provided or vault userpass auth must be enabled", "vaultUserPassAuthEnabled", c.VaultUserPassAuthEnabled)
 		return fmt.Errorf("a vault token must be provided or vault userpass auth must be enabled")
 	}
@@ -256,11 +1495,97 @@ func (c *Configuration) SanitizeAPIUI(ctx context.Context) (err error) {
 		log.Info(ctx, "Issuer DID not provided in configuration file")
 	}
 
+	if c.APIUI.HolderPortal.SessionTTL == 0 {
+		c.APIUI.HolderPortal.SessionTTL = defaultHolderPortalSessionTTL
+	}
+
+	c.sanitizeAuthProtection()
+
 	err = c.sanitizeCredentialStatus(ctx, c.APIUI.ServerURL)
 	if err != nil {
 		log.Error(ctx, "error sanitizing credential status", "error", err)
 		return err
 	}
+
+	if err := c.sanitizeIssuancePolicy(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeRevocationNonces(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePublishingMode(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeTLS(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeForwardedHeaders(ctx); err != nil {
+		return err
+	}
+
+	c.sanitizeHTTPSecurity()
+	c.sanitizeHTTPLimits()
+	c.sanitizeHTTPCache()
+	c.sanitizeQRCode()
+
+	if err := c.sanitizeStatusOracle(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeCredentialID(ctx); err != nil {
+		return err
+	}
+
+	c.sanitizeFetchBinding()
+	c.sanitizeRevocationRules()
+	c.sanitizeConnectionDuplicates()
+
+	if err := c.sanitizeTrustRegistry(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePolicyEngine(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeConnectionWebhook(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeSecrets(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizePriceFeed(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeGeoIP(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeStateCheckpoints(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeConnectionPruning(ctx); err != nil {
+		return err
+	}
+
+	c.sanitizeClaimArchival()
+
+	if err := c.sanitizeIssuanceQueue(ctx); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeSyntheticIssuance(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -299,6 +1624,39 @@ func (c *Configuration) sanitizeCredentialStatus(_ context.Context, host string)
 	return nil
 }
 
+func (c *Configuration) sanitizeIssuancePolicy(ctx context.Context) error {
+	switch c.IssuancePolicy.DuplicateCredentials {
+	case DuplicateCredentialsAllow, DuplicateCredentialsReturn, DuplicateCredentialsReject:
+		return nil
+	default:
+		log.Error(ctx, "invalid duplicate credentials mode", "mode", c.IssuancePolicy.DuplicateCredentials)
+		return fmt.Errorf("ISSUER_ISSUANCE_POLICY_DUPLICATE_CREDENTIALS value is not valid")
+	}
+}
+
+func (c *Configuration) sanitizeRevocationNonces(ctx context.Context) error {
+	switch c.RevocationNonces.Mode {
+	case "":
+		c.RevocationNonces.Mode = RevocationNonceRandom
+	case RevocationNonceRandom, RevocationNonceSequential:
+	default:
+		log.Error(ctx, "ISSUER_REVOCATION_NONCES_MODE is not valid", "mode", c.RevocationNonces.Mode)
+		return fmt.Errorf("invalid revocation nonces mode %s", c.RevocationNonces.Mode)
+	}
+	switch c.RevocationNonces.CollisionScope {
+	case "":
+		c.RevocationNonces.CollisionScope = RevocationNonceCollisionsNone
+	case RevocationNonceCollisionsNone, RevocationNonceCollisionsIssuer, RevocationNonceCollisionsNode:
+	default:
+		log.Error(ctx, "ISSUER_REVOCATION_NONCES_COLLISION_SCOPE is not valid", "scope", c.RevocationNonces.CollisionScope)
+		return fmt.Errorf("invalid revocation nonces collision scope %s", c.RevocationNonces.CollisionScope)
+	}
+	if c.RevocationNonces.MaxRangeSize == 0 {
+		c.RevocationNonces.MaxRangeSize = defaultRevocationNonceMaxRangeSize
+	}
+	return nil
+}
+
 // CheckDID checks if the issuer did is provided in the configuration file. If not, it tries to get it from vault.
 func CheckDID(ctx context.Context, cfg *Configuration, vaultCli *api.Client) error {
 	log.Info(ctx, "Checking issuer did value", "did", cfg.APIUI.Issuer)
@@ -453,6 +1811,18 @@ func bindEnv() {
 
 	_ = viper.BindEnv("Log.Level", "ISSUER_LOG_LEVEL")
 	_ = viper.BindEnv("Log.Mode", "ISSUER_LOG_MODE")
+	_ = viper.BindEnv("Log.ModuleLevels", "ISSUER_LOG_MODULE_LEVELS")
+	_ = viper.BindEnv("Log.Syslog.Enabled", "ISSUER_LOG_SYSLOG_ENABLED")
+	_ = viper.BindEnv("Log.Syslog.Network", "ISSUER_LOG_SYSLOG_NETWORK")
+	_ = viper.BindEnv("Log.Syslog.Address", "ISSUER_LOG_SYSLOG_ADDRESS")
+	_ = viper.BindEnv("Log.Syslog.Tag", "ISSUER_LOG_SYSLOG_TAG")
+	_ = viper.BindEnv("Log.Loki.URL", "ISSUER_LOG_LOKI_URL")
+	_ = viper.BindEnv("Log.Loki.Labels", "ISSUER_LOG_LOKI_LABELS")
+	_ = viper.BindEnv("Log.Loki.BatchSize", "ISSUER_LOG_LOKI_BATCH_SIZE")
+	_ = viper.BindEnv("Log.Loki.FlushInterval", "ISSUER_LOG_LOKI_FLUSH_INTERVAL")
+	_ = viper.BindEnv("Log.Sampling.Initial", "ISSUER_LOG_SAMPLING_INITIAL")
+	_ = viper.BindEnv("Log.Sampling.Thereafter", "ISSUER_LOG_SAMPLING_THEREAFTER")
+	_ = viper.BindEnv("Log.Sampling.Tick", "ISSUER_LOG_SAMPLING_TICK")
 
 	_ = viper.BindEnv("HTTPBasicAuth.User", "ISSUER_API_AUTH_USER")
 	_ = viper.BindEnv("HTTPBasicAuth.Password", "ISSUER_API_AUTH_PASSWORD")
@@ -462,8 +1832,15 @@ func bindEnv() {
 	_ = viper.BindEnv("KeyStore.Address", "ISSUER_KEY_STORE_ADDRESS")
 	_ = viper.BindEnv("KeyStore.Token", "ISSUER_KEY_STORE_TOKEN")
 	_ = viper.BindEnv("KeyStore.PluginIden3MountPath", "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH")
+	_ = viper.BindEnv("KeyStore.CustodyURL", "ISSUER_KEY_STORE_CUSTODY_URL")
+	_ = viper.BindEnv("KeyStore.CustodyToken", "ISSUER_KEY_STORE_CUSTODY_TOKEN")
+	_ = viper.BindEnv("KeyStore.CustodyTimeout", "ISSUER_KEY_STORE_CUSTODY_TIMEOUT")
+	_ = viper.BindEnv("KeyStore.Policies", "ISSUER_KEY_STORE_POLICIES")
 
 	_ = viper.BindEnv("Ethereum.URL", "ISSUER_ETHEREUM_URL")
+	_ = viper.BindEnv("Ethereum.FallbackURLs", "ISSUER_ETHEREUM_FALLBACK_URLS")
+	_ = viper.BindEnv("Ethereum.RPCStrategy", "ISSUER_ETHEREUM_RPC_STRATEGY")
+	_ = viper.BindEnv("Ethereum.RPCHealthCheckInterval", "ISSUER_ETHEREUM_RPC_HEALTH_CHECK_INTERVAL")
 	_ = viper.BindEnv("Ethereum.ContractAddress", "ISSUER_ETHEREUM_CONTRACT_ADDRESS")
 	_ = viper.BindEnv("Ethereum.DefaultGasLimit", "ISSUER_ETHEREUM_DEFAULT_GAS_LIMIT")
 	_ = viper.BindEnv("Ethereum.ConfirmationTimeout", "ISSUER_ETHEREUM_CONFIRMATION_TIME_OUT")
@@ -478,6 +1855,7 @@ func bindEnv() {
 	_ = viper.BindEnv("Ethereum.ResolverPrefix", "ISSUER_ETHEREUM_RESOLVER_PREFIX")
 	_ = viper.BindEnv("Ethereum.InternalTransferAmountWei", "ISSUER_INTERNAL_TRANSFER_AMOUNT_WEI")
 	_ = viper.BindEnv("Ethereum.TransferAccountKeyPath", "ISSUER_ETHEREUM_TRANSFER_ACCOUNT_KEY_PATH")
+	_ = viper.BindEnv("Ethereum.ExplorerURL", "ISSUER_ETHEREUM_EXPLORER_URL")
 
 	_ = viper.BindEnv("CredentialStatus.Iden3CommAgentStatus.URL", "ISSUER_CREDENTIAL_STATUS_DIRECT_URL")
 	_ = viper.BindEnv("CredentialStatus.RHS.URL", "ISSUER_CREDENTIAL_STATUS_RHS_URL")
@@ -486,6 +1864,7 @@ func bindEnv() {
 	_ = viper.BindEnv("CredentialStatus.OnchainTreeStore.ChainID", "ISSUER_CREDENTIAL_STATUS_RHS_CHAIN_ID")
 	_ = viper.BindEnv("CredentialStatus.RHSMode", "ISSUER_CREDENTIAL_STATUS_RHS_MODE")
 	_ = viper.BindEnv("CredentialStatus.CredentialStatusType", "ISSUER_CREDENTIAL_STATUS_RHS_STATUS_TYPE")
+	_ = viper.BindEnv("CredentialStatus.ExpirationGracePeriod", "ISSUER_CREDENTIAL_STATUS_EXPIRATION_GRACE_PERIOD")
 
 	_ = viper.BindEnv("Prover.ServerURL", "ISSUER_PROVER_SERVER_URL")
 	_ = viper.BindEnv("Prover.ResponseTimeout", "ISSUER_PROVER_TIMEOUT")
@@ -493,6 +1872,7 @@ func bindEnv() {
 	_ = viper.BindEnv("Circuit.Path", "ISSUER_CIRCUIT_PATH")
 
 	_ = viper.BindEnv("Cache.RedisUrl", "ISSUER_REDIS_URL")
+	_ = viper.BindEnv("Cache.RevocationStatusTTL", "ISSUER_CACHE_REVOCATION_STATUS_TTL")
 	_ = viper.BindEnv("SchemaCache", "ISSUER_SCHEMA_CACHE")
 
 	_ = viper.BindEnv("VaultUserPassAuthEnabled", "ISSUER_VAULT_USERPASS_AUTH_ENABLED")
@@ -502,6 +1882,8 @@ func bindEnv() {
 	_ = viper.BindEnv("APIUI.ServerURL", "ISSUER_API_UI_SERVER_URL")
 	_ = viper.BindEnv("APIUI.APIUIAuth.User", "ISSUER_API_UI_AUTH_USER")
 	_ = viper.BindEnv("APIUI.APIUIAuth.Password", "ISSUER_API_UI_AUTH_PASSWORD")
+	_ = viper.BindEnv("APIUI.APIUIAuth.AdminUser", "ISSUER_API_UI_AUTH_ADMIN_USER")
+	_ = viper.BindEnv("APIUI.APIUIAuth.AdminPassword", "ISSUER_API_UI_AUTH_ADMIN_PASSWORD")
 	_ = viper.BindEnv("APIUI.IssuerName", "ISSUER_API_UI_ISSUER_NAME")
 	_ = viper.BindEnv("APIUI.IssuerLogo", "ISSUER_API_UI_ISSUER_LOGO")
 	_ = viper.BindEnv("APIUI.IssuerDID", "ISSUER_API_UI_ISSUER_DID")
@@ -510,11 +1892,168 @@ func bindEnv() {
 	_ = viper.BindEnv("APIUI.IdentityBlockchain", "ISSUER_API_IDENTITY_BLOCKCHAIN")
 	_ = viper.BindEnv("APIUI.IdentityNetwork", "ISSUER_API_IDENTITY_NETWORK")
 	_ = viper.BindEnv("APIUI.KeyType", "ISSUER_API_UI_KEY_TYPE")
+	_ = viper.BindEnv("APIUI.MaskedAttributes", "ISSUER_API_UI_MASKED_ATTRIBUTES")
+	_ = viper.BindEnv("APIUI.HolderPortal.Enabled", "ISSUER_API_UI_HOLDER_PORTAL_ENABLED")
+	_ = viper.BindEnv("APIUI.HolderPortal.SessionTTL", "ISSUER_API_UI_HOLDER_PORTAL_SESSION_TTL")
+	_ = viper.BindEnv("APIUI.AuthProtection.MaxFailures", "ISSUER_API_UI_AUTH_PROTECTION_MAX_FAILURES")
+	_ = viper.BindEnv("APIUI.AuthProtection.BaseDelay", "ISSUER_API_UI_AUTH_PROTECTION_BASE_DELAY")
+	_ = viper.BindEnv("APIUI.AuthProtection.MaxDelay", "ISSUER_API_UI_AUTH_PROTECTION_MAX_DELAY")
+	_ = viper.BindEnv("APIUI.AuthProtection.Window", "ISSUER_API_UI_AUTH_PROTECTION_WINDOW")
+	_ = viper.BindEnv("APIUI.LandingPage.Enabled", "ISSUER_API_UI_LANDING_PAGE_ENABLED")
+	_ = viper.BindEnv("APIUI.PublicCatalog.Enabled", "ISSUER_API_UI_PUBLIC_CATALOG_ENABLED")
 
 	_ = viper.BindEnv("ISSUER_CUSTOM_DID_METHODS")
 
 	_ = viper.BindEnv("MediaTypeManager.Enabled", "ISSUER_MEDIA_TYPE_MANAGER_ENABLED")
 
+	_ = viper.BindEnv("IssuancePolicy.MaxCredentialsPerSchema", "ISSUER_ISSUANCE_POLICY_MAX_CREDENTIALS_PER_SCHEMA")
+	_ = viper.BindEnv("IssuancePolicy.Cooldown", "ISSUER_ISSUANCE_POLICY_COOLDOWN")
+	_ = viper.BindEnv("IssuancePolicy.DuplicateCredentials", "ISSUER_ISSUANCE_POLICY_DUPLICATE_CREDENTIALS")
+	_ = viper.BindEnv("IssuancePolicy.DeferredMTPProof", "ISSUER_ISSUANCE_POLICY_DEFERRED_MTP_PROOF")
+
+	_ = viper.BindEnv("RevocationNonces.Mode", "ISSUER_REVOCATION_NONCES_MODE")
+	_ = viper.BindEnv("RevocationNonces.CollisionScope", "ISSUER_REVOCATION_NONCES_COLLISION_SCOPE")
+	_ = viper.BindEnv("RevocationNonces.MaxRangeSize", "ISSUER_REVOCATION_NONCES_MAX_RANGE_SIZE")
+
+	_ = viper.BindEnv("DataEncryption.Enabled", "ISSUER_DATA_ENCRYPTION_ENABLED")
+	_ = viper.BindEnv("MultiTenancy.Enabled", "ISSUER_MULTI_TENANCY_ENABLED")
+
+	_ = viper.BindEnv("BalanceMonitor.Enabled", "ISSUER_BALANCE_MONITOR_ENABLED")
+	_ = viper.BindEnv("BalanceMonitor.Threshold", "ISSUER_BALANCE_MONITOR_THRESHOLD")
+	_ = viper.BindEnv("BalanceMonitor.CheckInterval", "ISSUER_BALANCE_MONITOR_CHECK_INTERVAL")
+	_ = viper.BindEnv("BalanceMonitor.WebhookURL", "ISSUER_BALANCE_MONITOR_WEBHOOK_URL")
+	_ = viper.BindEnv("BalanceMonitor.Email.SMTPHost", "ISSUER_BALANCE_MONITOR_EMAIL_SMTP_HOST")
+	_ = viper.BindEnv("BalanceMonitor.Email.SMTPPort", "ISSUER_BALANCE_MONITOR_EMAIL_SMTP_PORT")
+	_ = viper.BindEnv("BalanceMonitor.Email.SMTPUser", "ISSUER_BALANCE_MONITOR_EMAIL_SMTP_USER")
+	_ = viper.BindEnv("BalanceMonitor.Email.SMTPPassword", "ISSUER_BALANCE_MONITOR_EMAIL_SMTP_PASSWORD")
+	_ = viper.BindEnv("BalanceMonitor.Email.From", "ISSUER_BALANCE_MONITOR_EMAIL_FROM")
+	_ = viper.BindEnv("BalanceMonitor.Email.To", "ISSUER_BALANCE_MONITOR_EMAIL_TO")
+
+	_ = viper.BindEnv("Relayer.Enabled", "ISSUER_RELAYER_ENABLED")
+	_ = viper.BindEnv("Relayer.URL", "ISSUER_RELAYER_URL")
+	_ = viper.BindEnv("Relayer.StatusURL", "ISSUER_RELAYER_STATUS_URL")
+	_ = viper.BindEnv("Relayer.APIKey", "ISSUER_RELAYER_API_KEY")
+	_ = viper.BindEnv("Relayer.GasLimit", "ISSUER_RELAYER_GAS_LIMIT")
+	_ = viper.BindEnv("Relayer.Speed", "ISSUER_RELAYER_SPEED")
+	_ = viper.BindEnv("Relayer.Timeout", "ISSUER_RELAYER_TIMEOUT")
+
+	_ = viper.BindEnv("Safe.Enabled", "ISSUER_SAFE_ENABLED")
+	_ = viper.BindEnv("Safe.Address", "ISSUER_SAFE_ADDRESS")
+	_ = viper.BindEnv("Safe.TransactionServiceURL", "ISSUER_SAFE_TRANSACTION_SERVICE_URL")
+	_ = viper.BindEnv("Safe.TransactionServiceAuth", "ISSUER_SAFE_TRANSACTION_SERVICE_AUTH")
+
+	_ = viper.BindEnv("TLS.CertFile", "ISSUER_TLS_CERT_FILE")
+	_ = viper.BindEnv("TLS.KeyFile", "ISSUER_TLS_KEY_FILE")
+	_ = viper.BindEnv("TLS.ClientCAFile", "ISSUER_TLS_CLIENT_CA_FILE")
+	_ = viper.BindEnv("TLS.ClientAllowedSubjects", "ISSUER_TLS_CLIENT_ALLOWED_SUBJECTS")
+
+	_ = viper.BindEnv("HTTPSecurity.Admin.AllowedOrigins", "ISSUER_HTTP_ADMIN_ALLOWED_ORIGINS")
+	_ = viper.BindEnv("HTTPSecurity.Admin.AllowedHeaders", "ISSUER_HTTP_ADMIN_ALLOWED_HEADERS")
+	_ = viper.BindEnv("HTTPSecurity.Admin.ContentSecurityPolicy", "ISSUER_HTTP_ADMIN_CONTENT_SECURITY_POLICY")
+	_ = viper.BindEnv("HTTPSecurity.Admin.HSTSMaxAge", "ISSUER_HTTP_ADMIN_HSTS_MAX_AGE")
+	_ = viper.BindEnv("HTTPSecurity.Public.AllowedOrigins", "ISSUER_HTTP_PUBLIC_ALLOWED_ORIGINS")
+	_ = viper.BindEnv("HTTPSecurity.Public.AllowedHeaders", "ISSUER_HTTP_PUBLIC_ALLOWED_HEADERS")
+	_ = viper.BindEnv("HTTPSecurity.Public.ContentSecurityPolicy", "ISSUER_HTTP_PUBLIC_CONTENT_SECURITY_POLICY")
+	_ = viper.BindEnv("HTTPSecurity.Public.HSTSMaxAge", "ISSUER_HTTP_PUBLIC_HSTS_MAX_AGE")
+
+	_ = viper.BindEnv("HTTPLimits.Default.MaxBodySize", "ISSUER_HTTP_MAX_BODY_SIZE")
+	_ = viper.BindEnv("HTTPLimits.Default.Timeout", "ISSUER_HTTP_TIMEOUT")
+	_ = viper.BindEnv("HTTPLimits.Messages.MaxBodySize", "ISSUER_HTTP_MESSAGES_MAX_BODY_SIZE")
+	_ = viper.BindEnv("HTTPLimits.Messages.Timeout", "ISSUER_HTTP_MESSAGES_TIMEOUT")
+	_ = viper.BindEnv("HTTPLimits.SlowRequest", "ISSUER_HTTP_SLOW_REQUEST")
+	_ = viper.BindEnv("HTTPLimits.ReadHeaderTimeout", "ISSUER_HTTP_READ_HEADER_TIMEOUT")
+	_ = viper.BindEnv("HTTPCache.Documents.MaxAge", "ISSUER_HTTP_DOCUMENTS_MAX_AGE")
+	_ = viper.BindEnv("HTTPCache.Documents.SharedMaxAge", "ISSUER_HTTP_DOCUMENTS_SHARED_MAX_AGE")
+	_ = viper.BindEnv("HTTPCache.Statuses.MaxAge", "ISSUER_HTTP_STATUSES_MAX_AGE")
+	_ = viper.BindEnv("HTTPCache.Statuses.SharedMaxAge", "ISSUER_HTTP_STATUSES_SHARED_MAX_AGE")
+	_ = viper.BindEnv("ResponseSigning.KeyPath", "ISSUER_HTTP_RESPONSE_SIGNING_KEY_PATH")
+
+	_ = viper.BindEnv("ForwardedHeaders.TrustedProxies", "ISSUER_HTTP_TRUSTED_PROXIES")
+	_ = viper.BindEnv("ForwardedHeaders.AllowedHosts", "ISSUER_HTTP_ALLOWED_HOSTS")
+
+	_ = viper.BindEnv("QRCode.MaxEmbeddedLength", "ISSUER_QR_MAX_EMBEDDED_LENGTH")
+	_ = viper.BindEnv("QRCode.Compression", "ISSUER_QR_COMPRESSION")
+
+	_ = viper.BindEnv("StatusOracle.URL", "ISSUER_STATUS_ORACLE_URL")
+	_ = viper.BindEnv("StatusOracle.APIKey", "ISSUER_STATUS_ORACLE_API_KEY")
+	_ = viper.BindEnv("StatusOracle.Timeout", "ISSUER_STATUS_ORACLE_TIMEOUT")
+	_ = viper.BindEnv("StatusOracle.CacheTTL", "ISSUER_STATUS_ORACLE_CACHE_TTL")
+	_ = viper.BindEnv("CredentialID.URITemplate", "ISSUER_CREDENTIAL_ID_URI_TEMPLATE")
+	_ = viper.BindEnv("FetchBinding.Enabled", "ISSUER_FETCH_BINDING_ENABLED")
+	_ = viper.BindEnv("FetchBinding.Strict", "ISSUER_FETCH_BINDING_STRICT")
+	_ = viper.BindEnv("FetchBinding.TTL", "ISSUER_FETCH_BINDING_TTL")
+	_ = viper.BindEnv("TrustRegistry.Type", "ISSUER_TRUST_REGISTRY_TYPE")
+	_ = viper.BindEnv("TrustRegistry.URL", "ISSUER_TRUST_REGISTRY_URL")
+	_ = viper.BindEnv("TrustRegistry.TrustFrameworkPointer", "ISSUER_TRUST_REGISTRY_TRUST_FRAMEWORK_POINTER")
+	_ = viper.BindEnv("TrustRegistry.Timeout", "ISSUER_TRUST_REGISTRY_TIMEOUT")
+	_ = viper.BindEnv("TrustRegistry.CacheTTL", "ISSUER_TRUST_REGISTRY_CACHE_TTL")
+	_ = viper.BindEnv("TrustRegistry.AllowList", "ISSUER_TRUST_REGISTRY_ALLOW_LIST")
+	_ = viper.BindEnv("TrustRegistry.DenyList", "ISSUER_TRUST_REGISTRY_DENY_LIST")
+	_ = viper.BindEnv("TrustRegistry.EnforceHolders", "ISSUER_TRUST_REGISTRY_ENFORCE_HOLDERS")
+	_ = viper.BindEnv("TrustRegistry.EnforceIssuers", "ISSUER_TRUST_REGISTRY_ENFORCE_ISSUERS")
+
+	_ = viper.BindEnv("PolicyEngine.Type", "ISSUER_POLICY_ENGINE_TYPE")
+	_ = viper.BindEnv("PolicyEngine.URL", "ISSUER_POLICY_ENGINE_URL")
+	_ = viper.BindEnv("PolicyEngine.Timeout", "ISSUER_POLICY_ENGINE_TIMEOUT")
+	_ = viper.BindEnv("PolicyEngine.FailOpen", "ISSUER_POLICY_ENGINE_FAIL_OPEN")
+
+	_ = viper.BindEnv("ConnectionWebhook.URL", "ISSUER_CONNECTION_WEBHOOK_URL")
+	_ = viper.BindEnv("ConnectionWebhook.Timeout", "ISSUER_CONNECTION_WEBHOOK_TIMEOUT")
+	_ = viper.BindEnv("ConnectionWebhook.FailOpen", "ISSUER_CONNECTION_WEBHOOK_FAIL_OPEN")
+
+	_ = viper.BindEnv("Secrets.Dir", "ISSUER_SECRETS_DIR")
+	_ = viper.BindEnv("Secrets.ReloadInterval", "ISSUER_SECRETS_RELOAD_INTERVAL")
+
+	_ = viper.BindEnv("RevocationRules.Enabled", "ISSUER_REVOCATION_RULES_ENABLED")
+	_ = viper.BindEnv("RevocationRules.CheckInterval", "ISSUER_REVOCATION_RULES_CHECK_INTERVAL")
+
+	_ = viper.BindEnv("ConnectionDuplicates.Enabled", "ISSUER_CONNECTION_DUPLICATES_ENABLED")
+	_ = viper.BindEnv("ConnectionDuplicates.Interval", "ISSUER_CONNECTION_DUPLICATES_INTERVAL")
+
+	_ = viper.BindEnv("ConnectionPruning.Enabled", "ISSUER_CONNECTION_PRUNING_ENABLED")
+	_ = viper.BindEnv("ConnectionPruning.Interval", "ISSUER_CONNECTION_PRUNING_INTERVAL")
+	_ = viper.BindEnv("ConnectionPruning.InactiveDays", "ISSUER_CONNECTION_PRUNING_INACTIVE_DAYS")
+	_ = viper.BindEnv("ConnectionPruning.Action", "ISSUER_CONNECTION_PRUNING_ACTION")
+	_ = viper.BindEnv("ConnectionPruning.BatchSize", "ISSUER_CONNECTION_PRUNING_BATCH_SIZE")
+
+	_ = viper.BindEnv("ClaimArchival.Enabled", "ISSUER_CLAIM_ARCHIVAL_ENABLED")
+	_ = viper.BindEnv("ClaimArchival.Interval", "ISSUER_CLAIM_ARCHIVAL_INTERVAL")
+	_ = viper.BindEnv("ClaimArchival.MinAgeDays", "ISSUER_CLAIM_ARCHIVAL_MIN_AGE_DAYS")
+	_ = viper.BindEnv("ClaimArchival.BatchSize", "ISSUER_CLAIM_ARCHIVAL_BATCH_SIZE")
+
+	_ = viper.BindEnv("SyntheticIssuance.Enabled", "ISSUER_SYNTHETIC_ISSUANCE_ENABLED")
+
+	_ = viper.BindEnv("PriceFeed.Type", "ISSUER_PRICE_FEED_TYPE")
+	_ = viper.BindEnv("PriceFeed.Currency", "ISSUER_PRICE_FEED_CURRENCY")
+	_ = viper.BindEnv("PriceFeed.Price", "ISSUER_PRICE_FEED_PRICE")
+	_ = viper.BindEnv("PriceFeed.URL", "ISSUER_PRICE_FEED_URL")
+	_ = viper.BindEnv("PriceFeed.Path", "ISSUER_PRICE_FEED_PATH")
+	_ = viper.BindEnv("PriceFeed.Timeout", "ISSUER_PRICE_FEED_TIMEOUT")
+	_ = viper.BindEnv("PriceFeed.CacheTTL", "ISSUER_PRICE_FEED_CACHE_TTL")
+
+	_ = viper.BindEnv("GeoIP.Type", "ISSUER_GEOIP_TYPE")
+	_ = viper.BindEnv("GeoIP.URL", "ISSUER_GEOIP_URL")
+	_ = viper.BindEnv("GeoIP.Path", "ISSUER_GEOIP_PATH")
+	_ = viper.BindEnv("GeoIP.Timeout", "ISSUER_GEOIP_TIMEOUT")
+	_ = viper.BindEnv("GeoIP.CacheTTL", "ISSUER_GEOIP_CACHE_TTL")
+
+	_ = viper.BindEnv("StateCheckpoints.Enabled", "ISSUER_STATE_CHECKPOINTS_ENABLED")
+	_ = viper.BindEnv("StateCheckpoints.Type", "ISSUER_STATE_CHECKPOINTS_TYPE")
+	_ = viper.BindEnv("StateCheckpoints.Interval", "ISSUER_STATE_CHECKPOINTS_INTERVAL")
+	_ = viper.BindEnv("StateCheckpoints.CalendarURL", "ISSUER_STATE_CHECKPOINTS_CALENDAR_URL")
+	_ = viper.BindEnv("StateCheckpoints.EthereumURL", "ISSUER_STATE_CHECKPOINTS_ETHEREUM_URL")
+	_ = viper.BindEnv("StateCheckpoints.KeyPath", "ISSUER_STATE_CHECKPOINTS_KEY_PATH")
+	_ = viper.BindEnv("StateCheckpoints.Timeout", "ISSUER_STATE_CHECKPOINTS_TIMEOUT")
+
+	_ = viper.BindEnv("IssuanceQueue.Enabled", "ISSUER_ISSUANCE_QUEUE_ENABLED")
+	_ = viper.BindEnv("IssuanceQueue.Type", "ISSUER_ISSUANCE_QUEUE_TYPE")
+	_ = viper.BindEnv("IssuanceQueue.Topic", "ISSUER_ISSUANCE_QUEUE_TOPIC")
+	_ = viper.BindEnv("IssuanceQueue.ReplyTopic", "ISSUER_ISSUANCE_QUEUE_REPLY_TOPIC")
+	_ = viper.BindEnv("IssuanceQueue.Group", "ISSUER_ISSUANCE_QUEUE_GROUP")
+	_ = viper.BindEnv("IssuanceQueue.Consumer", "ISSUER_ISSUANCE_QUEUE_CONSUMER")
+	_ = viper.BindEnv("IssuanceQueue.BatchSize", "ISSUER_ISSUANCE_QUEUE_BATCH_SIZE")
+	_ = viper.BindEnv("IssuanceQueue.BlockTimeout", "ISSUER_ISSUANCE_QUEUE_BLOCK_TIMEOUT")
+
 	viper.AutomaticEnv()
 }
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -110,3 +111,30 @@ func TestConfiguration_validateServerUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestConfiguration_sanitizeCredentialID(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		template string
+		expected string
+		error    bool
+	}{
+		{name: "default", template: "", expected: "urn:uuid:{uuid}"},
+		{name: "url", template: "https://credentials.acme.com/{uuid}", expected: "https://credentials.acme.com/{uuid}"},
+		{name: "urn", template: "urn:acme:{uuid}", expected: "urn:acme:{uuid}"},
+		{name: "no placeholder", template: "https://credentials.acme.com/", error: true},
+		{name: "placeholder twice", template: "https://credentials.acme.com/{uuid}/{uuid}", error: true},
+		{name: "no scheme", template: "credentials.acme.com/{uuid}", error: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Configuration{CredentialID: CredentialID{URITemplate: tc.template}}
+			err := cfg.sanitizeCredentialID(context.Background())
+			if tc.error {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg.CredentialID.URITemplate)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"strings"
+	"time"
 
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 )
@@ -26,6 +27,8 @@ type CredentialStatus struct {
 	RHSMode              RHSMode          `tip:"Reverse hash service mode (OffChain, OnChain, None)"`
 	SingleIssuer         bool
 	CredentialStatusType verifiable.CredentialStatusType `mapstructure:"CredentialStatusType" default:"Iden3commRevocationStatusV1"`
+	// ExpirationGracePeriod is the time the expired credentials are flagged as expired_grace instead of expired
+	ExpirationGracePeriod time.Duration `mapstructure:"ExpirationGracePeriod" tip:"Time the expired credentials are reported as expired_grace in the status responses and the status oracle requests"`
 }
 
 // Iden3CommAgentStatus is the type of direct status
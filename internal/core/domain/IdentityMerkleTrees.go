@@ -28,6 +28,22 @@ type IdentityMerkleTrees struct {
 	Identifier *w3c.DID
 	Trees      []*merkletree.MerkleTree
 	ImtModels  []*IdentityMerkleTree
+	Buffers    []MerkleTreeBuffer // Storages of the trees that keep the writes in memory, empty if they write directly
+}
+
+// MerkleTreeBuffer is a merkle tree storage that keeps the writes in memory until they are flushed
+type MerkleTreeBuffer interface {
+	Flush(ctx context.Context) error
+}
+
+// Flush writes the changes of the trees kept in memory. It does nothing when the trees write directly to the storage.
+func (imts *IdentityMerkleTrees) Flush(ctx context.Context) error {
+	for _, buffer := range imts.Buffers {
+		if err := buffer.Flush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 var (
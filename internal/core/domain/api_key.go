@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyScope is a permission granted to an API key
+type APIKeyScope string
+
+// API key scopes
+const (
+	APIKeyScopeCredentialsRead  APIKeyScope = "credentials:read"
+	APIKeyScopeCredentialsWrite APIKeyScope = "credentials:write"
+	APIKeyScopeConnectionsRead  APIKeyScope = "connections:read"
+	APIKeyScopeConnectionsWrite APIKeyScope = "connections:write"
+	APIKeyScopeLinksRead        APIKeyScope = "links:read"
+	APIKeyScopeLinksWrite       APIKeyScope = "links:write"
+	APIKeyScopeSchemasRead      APIKeyScope = "schemas:read"
+	APIKeyScopeSchemasWrite     APIKeyScope = "schemas:write"
+	APIKeyScopeStateRead        APIKeyScope = "state:read"
+	APIKeyScopeStatePublish     APIKeyScope = "state:publish"
+)
+
+// APIKeyScopes are all the valid scopes
+var APIKeyScopes = []APIKeyScope{
+	APIKeyScopeCredentialsRead,
+	APIKeyScopeCredentialsWrite,
+	APIKeyScopeConnectionsRead,
+	APIKeyScopeConnectionsWrite,
+	APIKeyScopeLinksRead,
+	APIKeyScopeLinksWrite,
+	APIKeyScopeSchemasRead,
+	APIKeyScopeSchemasWrite,
+	APIKeyScopeStateRead,
+	APIKeyScopeStatePublish,
+}
+
+// APIKeyReadOnlyScopes are the scopes of the read-only keys handed to the support engineers
+var APIKeyReadOnlyScopes = []APIKeyScope{
+	APIKeyScopeCredentialsRead,
+	APIKeyScopeConnectionsRead,
+	APIKeyScopeLinksRead,
+}
+
+// IsValid returns true if the scope is known
+func (s APIKeyScope) IsValid() bool {
+	for _, scope := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey is a credential to call the api with a limited set of permissions. Only the hash of the key is stored.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	KeyHash    string
+	Scopes     []APIKeyScope
+	AllowedIPs []string
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	// TenantID scopes the requests made with the key to the tenant. Nil for keys that are not scoped.
+	TenantID *uuid.UUID
+	// ReadOnly keys never grant a write scope, whatever scopes they were created with
+	ReadOnly bool
+}
+
+// IsActive returns true if the key is not revoked and not expired
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// HasScope returns true if the key was granted the scope. A write scope also grants the read one of the same resource.
+// Read-only keys only have read scopes.
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	resource, action, _ := strings.Cut(string(scope), ":")
+	if k.ReadOnly && action != "read" {
+		return false
+	}
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+		if action == "read" && string(s) == resource+":write" {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIP returns true if the key can be used from the ip. Keys without allowlist can be used from any ip.
+// Allowlist entries can be single ips or CIDR ranges.
+func (k *APIKey) AllowsIP(ip net.IP) bool {
+	if len(k.AllowedIPs) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, allowed := range k.AllowedIPs {
+		if _, network, err := net.ParseCIDR(allowed); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if allowedIP := net.ParseIP(allowed); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestAPIKey_IsActive(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name   string
+		key    APIKey
+		expect bool
+	}{
+		{name: "no expiration", key: APIKey{}, expect: true},
+		{name: "not expired", key: APIKey{ExpiresAt: common.ToPointer(now.Add(time.Hour))}, expect: true},
+		{name: "expired", key: APIKey{ExpiresAt: common.ToPointer(now.Add(-time.Hour))}, expect: false},
+		{name: "revoked", key: APIKey{RevokedAt: common.ToPointer(now.Add(-time.Hour))}, expect: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.key.IsActive(now))
+		})
+	}
+}
+
+func TestAPIKey_HasScope(t *testing.T) {
+	key := APIKey{Scopes: []APIKeyScope{APIKeyScopeCredentialsWrite, APIKeyScopeLinksRead}}
+	assert.True(t, key.HasScope(APIKeyScopeCredentialsWrite))
+	assert.True(t, key.HasScope(APIKeyScopeCredentialsRead))
+	assert.True(t, key.HasScope(APIKeyScopeLinksRead))
+	assert.False(t, key.HasScope(APIKeyScopeLinksWrite))
+	assert.False(t, key.HasScope(APIKeyScopeStatePublish))
+
+	readOnly := APIKey{Scopes: []APIKeyScope{APIKeyScopeCredentialsWrite, APIKeyScopeLinksRead}, ReadOnly: true}
+	assert.True(t, readOnly.HasScope(APIKeyScopeCredentialsRead))
+	assert.True(t, readOnly.HasScope(APIKeyScopeLinksRead))
+	assert.False(t, readOnly.HasScope(APIKeyScopeCredentialsWrite))
+}
+
+func TestAPIKey_AllowsIP(t *testing.T) {
+	key := APIKey{AllowedIPs: []string{"10.0.0.0/8", "203.0.113.7"}}
+	assert.True(t, key.AllowsIP(net.ParseIP("10.1.2.3")))
+	assert.True(t, key.AllowsIP(net.ParseIP("203.0.113.7")))
+	assert.False(t, key.AllowsIP(net.ParseIP("203.0.113.8")))
+	assert.False(t, key.AllowsIP(nil))
+	assert.True(t, (&APIKey{}).AllowsIP(net.ParseIP("192.168.1.1")))
+}
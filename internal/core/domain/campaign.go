@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// CampaignStatus is the status of a campaign according to its dates
+type CampaignStatus string
+
+const (
+	CampaignStatusScheduled CampaignStatus = "scheduled" // CampaignStatusScheduled the campaign has not started yet
+	CampaignStatusActive    CampaignStatus = "active"    // CampaignStatusActive the campaign has started and has not ended
+	CampaignStatusEnded     CampaignStatus = "ended"     // CampaignStatusEnded the end date of the campaign has passed
+)
+
+// Campaign groups the links and the credentials issued for the same purpose, like the credentials of an event,
+// to follow how many of them are claimed. The milestones are percentages of the invited holders that claimed their
+// credential, and the webhook receives a notification the first time each one is reached.
+type Campaign struct {
+	ID                 uuid.UUID
+	IssuerDID          w3c.DID
+	Name               string
+	Description        *string
+	StartAt            *time.Time
+	EndAt              *time.Time
+	WebhookURL         *string
+	Milestones         []int
+	NotifiedMilestones []int
+	CreatedAt          time.Time
+	LinkIDs            []uuid.UUID
+	Progress           CampaignProgress
+}
+
+// NewCampaign returns a new campaign of the issuer
+func NewCampaign(issuerDID w3c.DID, name string, description *string, startAt, endAt *time.Time, webhookURL *string, milestones []int) *Campaign {
+	return &Campaign{
+		ID:                 uuid.New(),
+		IssuerDID:          issuerDID,
+		Name:               name,
+		Description:        description,
+		StartAt:            startAt,
+		EndAt:              endAt,
+		WebhookURL:         webhookURL,
+		Milestones:         milestones,
+		NotifiedMilestones: []int{},
+		CreatedAt:          time.Now().UTC(),
+		LinkIDs:            []uuid.UUID{},
+	}
+}
+
+// Status returns the status of the campaign at the given time
+func (c *Campaign) Status(now time.Time) CampaignStatus {
+	if c.StartAt != nil && now.Before(*c.StartAt) {
+		return CampaignStatusScheduled
+	}
+	if c.EndAt != nil && !now.Before(*c.EndAt) {
+		return CampaignStatusEnded
+	}
+	return CampaignStatusActive
+}
+
+// ReachedMilestones returns the milestones reached by the progress of the campaign that were not notified yet,
+// in ascending order
+func (c *Campaign) ReachedMilestones() []int {
+	notified := make(map[int]bool, len(c.NotifiedMilestones))
+	for _, m := range c.NotifiedMilestones {
+		notified[m] = true
+	}
+	reached := make([]int, 0)
+	percentage := c.Progress.ClaimedPercentage()
+	for _, m := range c.Milestones {
+		if m <= percentage && !notified[m] {
+			reached = append(reached, m)
+		}
+	}
+	sort.Ints(reached)
+	return reached
+}
+
+// CampaignProgress is the aggregated progress of the links and the credentials of a campaign.
+// Invited is the number of credentials the campaign expects to issue: the recipients of every link that has them,
+// the maximum issuance of the other links, and the credentials added to the campaign.
+type CampaignProgress struct {
+	Links   int `json:"links"`
+	Invited int `json:"invited"`
+	Claimed int `json:"claimed"`
+	Revoked int `json:"revoked"`
+}
+
+// ClaimedPercentage returns the percentage of the invited holders that claimed their credential, 100 at most
+func (p CampaignProgress) ClaimedPercentage() int {
+	if p.Invited <= 0 {
+		return 0
+	}
+	if p.Claimed >= p.Invited {
+		return 100
+	}
+	return p.Claimed * 100 / p.Invited
+}
+
+// CampaignMilestone is the notification sent to the webhook of a campaign when a milestone is reached
+type CampaignMilestone struct {
+	CampaignID uuid.UUID        `json:"campaignId"`
+	Name       string           `json:"name"`
+	Milestone  int              `json:"milestone"`
+	Progress   CampaignProgress `json:"progress"`
+	ReachedAt  time.Time        `json:"reachedAt"`
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestCampaign_Status(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name     string
+		campaign Campaign
+		expect   CampaignStatus
+	}{
+		{name: "no dates", campaign: Campaign{}, expect: CampaignStatusActive},
+		{name: "not started", campaign: Campaign{StartAt: common.ToPointer(now.Add(time.Hour))}, expect: CampaignStatusScheduled},
+		{name: "started", campaign: Campaign{StartAt: common.ToPointer(now.Add(-time.Hour)), EndAt: common.ToPointer(now.Add(time.Hour))}, expect: CampaignStatusActive},
+		{name: "ended", campaign: Campaign{StartAt: common.ToPointer(now.Add(-2 * time.Hour)), EndAt: common.ToPointer(now.Add(-time.Hour))}, expect: CampaignStatusEnded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.campaign.Status(now))
+		})
+	}
+}
+
+func TestCampaignProgress_ClaimedPercentage(t *testing.T) {
+	assert.Equal(t, 0, CampaignProgress{}.ClaimedPercentage())
+	assert.Equal(t, 33, CampaignProgress{Invited: 3, Claimed: 1}.ClaimedPercentage())
+	assert.Equal(t, 100, CampaignProgress{Invited: 2, Claimed: 5}.ClaimedPercentage())
+}
+
+func TestCampaign_ReachedMilestones(t *testing.T) {
+	campaign := Campaign{
+		Milestones:         []int{100, 25, 50, 75},
+		NotifiedMilestones: []int{25},
+		Progress:           CampaignProgress{Invited: 10, Claimed: 6},
+	}
+	assert.Equal(t, []int{50}, campaign.ReachedMilestones())
+
+	campaign.NotifiedMilestones = []int{25, 50}
+	assert.Empty(t, campaign.ReachedMilestones())
+
+	campaign.Progress.Claimed = 10
+	assert.Equal(t, []int{75, 100}, campaign.ReachedMilestones())
+}
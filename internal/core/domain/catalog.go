@@ -0,0 +1,8 @@
+package domain
+
+// CatalogEntry is a credential the issuer offers through an active link. The entries are published in the public
+// catalog so ecosystem directories can index the credentials the issuer provides.
+type CatalogEntry struct {
+	Link                 Link
+	PresentationTemplate *PresentationTemplate
+}
@@ -44,14 +44,27 @@ type Claim struct {
 	CredentialStatus pgtype.JSONB    `json:"credential_status"`
 	HIndex           string          `json:"-"`
 
-	MtProof   bool       `json:"mt_poof"`
-	LinkID    *uuid.UUID `json:"-"`
-	CreatedAt time.Time  `json:"-"`
+	MtProof    bool       `json:"mt_poof"`
+	LinkID     *uuid.UUID `json:"-"`
+	ExternalID *string    `json:"-"`
+	ProfileID  *uuid.UUID `json:"-"`
+	CreatedAt  time.Time  `json:"-"`
+
+	FetchPolicy CredentialFetchPolicy `json:"-"`
+	// Supersedes is the credential replaced by this one, recorded as its previous version when it is saved
+	Supersedes *uuid.UUID `json:"-"`
 }
 
 // Credentials is the type of array of credential
 type Credentials []*Claim
 
+// Expiration statuses of a credential
+const (
+	ExpirationStatusValid   = "valid"
+	ExpirationStatusGrace   = "expired_grace" // expired, but within the grace period of the issuer
+	ExpirationStatusExpired = "expired"
+)
+
 // FromClaimer TODO add description
 func FromClaimer(claim *core.Claim, schemaURL, schemaType string) (*Claim, error) {
 	otherIdentifier := ""
@@ -133,6 +146,23 @@ func (c *Claim) ValidProof() bool {
 	return c.MTPProof.Status != pgtype.Null
 }
 
+// ExpirationStatus returns whether the credential is valid, expired within the grace period or expired at the given
+// time. Credentials without expiration are always valid.
+func (c *Claim) ExpirationStatus(now time.Time, gracePeriod time.Duration) string {
+	if c.Expiration == 0 {
+		return ExpirationStatusValid
+	}
+	expiration := time.Unix(c.Expiration, 0)
+	switch {
+	case now.Before(expiration):
+		return ExpirationStatusValid
+	case gracePeriod > 0 && now.Before(expiration.Add(gracePeriod)):
+		return ExpirationStatusGrace
+	default:
+		return ExpirationStatusExpired
+	}
+}
+
 // BuildTreeState returns circuits.TreeState structure
 func BuildTreeState(state, claimsTreeRoot, revocationTreeRoot, rootOfRoots *string) (circuits.TreeState, error) {
 	return circuits.TreeState{
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchivedClaim is a claim moved out of the claims table by the archival. Its revocation nonce stays in the
+// revocation tree and in the archive, so the claim can still be revoked, and its status checked, while archived.
+type ArchivedClaim struct {
+	ID              uuid.UUID
+	IssuerDID       string
+	OtherIdentifier *string
+	SchemaType      string
+	RevNonce        RevNonceUint64
+	Revoked         bool
+	CreatedAt       time.Time
+	ArchivedAt      time.Time
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// verifiableCredentialType is the base type every W3C credential has
+const verifiableCredentialType = "VerifiableCredential"
+
+// ClaimImport is the provenance of a claim imported from a credential built by another system, e.g. offline. The
+// issuer signs and anchors the claim under its own identity; DocumentHash identifies the imported document.
+type ClaimImport struct {
+	ID              uuid.UUID
+	IssuerDID       string
+	ClaimID         uuid.UUID
+	Source          string
+	SourceReference *string
+	OriginalIssuer  *string
+	OriginalID      *string
+	DocumentHash    string
+	ImportedAt      time.Time
+}
+
+// ImportedCredential is the part of a pre-built W3C credential the issuer needs to issue it again. The proofs of the
+// document are not kept, the issuer adds its own.
+type ImportedCredential struct {
+	ID                string                      `json:"id,omitempty"`
+	Type              []string                    `json:"type"`
+	Issuer            any                         `json:"issuer,omitempty"`
+	CredentialSchema  verifiable.CredentialSchema `json:"credentialSchema"`
+	CredentialSubject map[string]any              `json:"credentialSubject"`
+	ExpirationDate    *time.Time                  `json:"expirationDate,omitempty"`
+	CredentialStatus  *ImportedCredentialStatus   `json:"credentialStatus,omitempty"`
+	Evidence          []Evidence                  `json:"evidence,omitempty"`
+	TermsOfUse        []TermsOfUse                `json:"termsOfUse,omitempty"`
+}
+
+// ImportedCredentialStatus is the status section of an imported credential. Its revocation nonce, e.g. taken from a
+// reserved range, is kept.
+type ImportedCredentialStatus struct {
+	RevocationNonce *uint64 `json:"revocationNonce,omitempty"`
+}
+
+// Validate checks that the credential has a schema, a subject and a single type besides VerifiableCredential
+func (c ImportedCredential) Validate() error {
+	if strings.TrimSpace(c.CredentialSchema.ID) == "" {
+		return errors.New("the credential has no credentialSchema id")
+	}
+	if len(c.CredentialSubject) == 0 {
+		return errors.New("the credential has no credentialSubject")
+	}
+	if _, err := c.SchemaType(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SchemaType returns the type of the credential other than VerifiableCredential
+func (c ImportedCredential) SchemaType() (string, error) {
+	types := slices.DeleteFunc(slices.Clone(c.Type), func(t string) bool { return t == verifiableCredentialType })
+	if len(types) != 1 || strings.TrimSpace(types[0]) == "" {
+		return "", errors.New("the credential must have one type besides VerifiableCredential")
+	}
+	return types[0], nil
+}
+
+// IssuerID returns the id of the issuer of the document, given as a string or as an object with an id
+func (c ImportedCredential) IssuerID() *string {
+	switch issuer := c.Issuer.(type) {
+	case string:
+		if issuer != "" {
+			return &issuer
+		}
+	case map[string]any:
+		if id, ok := issuer["id"].(string); ok && id != "" {
+			return &id
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportedCredential(t *testing.T) {
+	var credential ImportedCredential
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "urn:uuid:3a8a9a4e-6b1a-4b8e-9d0a-6a1f0f3e2c11",
+		"type": ["VerifiableCredential", "KYCAgeCredential"],
+		"issuer": {"id": "did:example:offline-registry"},
+		"credentialSchema": {"id": "https://example.com/kyc.json", "type": "JsonSchema2023"},
+		"credentialSubject": {"birthday": 19960424},
+		"credentialStatus": {"revocationNonce": 4294967296},
+		"expirationDate": "2030-01-01T00:00:00Z"
+	}`), &credential))
+	require.NoError(t, credential.Validate())
+	schemaType, err := credential.SchemaType()
+	require.NoError(t, err)
+	assert.Equal(t, "KYCAgeCredential", schemaType)
+	assert.Equal(t, "did:example:offline-registry", *credential.IssuerID())
+	assert.Equal(t, uint64(4294967296), *credential.CredentialStatus.RevocationNonce)
+
+	credential.Issuer = "did:example:other"
+	assert.Equal(t, "did:example:other", *credential.IssuerID())
+	credential.Issuer = nil
+	assert.Nil(t, credential.IssuerID())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(c *ImportedCredential)
+	}{
+		{name: "no schema", modify: func(c *ImportedCredential) { c.CredentialSchema.ID = "" }},
+		{name: "no subject", modify: func(c *ImportedCredential) { c.CredentialSubject = nil }},
+		{name: "no type", modify: func(c *ImportedCredential) { c.Type = []string{"VerifiableCredential"} }},
+		{name: "two types", modify: func(c *ImportedCredential) { c.Type = []string{"KYCAgeCredential", "KYCCountryOfResidenceCredential"} }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			invalid := credential
+			invalid.Type = append([]string(nil), credential.Type...)
+			tc.modify(&invalid)
+			assert.Error(t, invalid.Validate())
+		})
+	}
+}
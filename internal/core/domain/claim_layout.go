@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+)
+
+// Claim slot contents
+const (
+	ClaimSlotHeader        = "schema hash, flags and version"
+	ClaimSlotSubject       = "subject id"
+	ClaimSlotRevocation    = "revocation nonce and expiration"
+	ClaimSlotMerklizedRoot = "merklized root"
+	ClaimSlotData          = "data"
+	ClaimSlotEmpty         = "empty"
+)
+
+// ClaimLayout shows how a credential is laid out in the index and value slots of its core claim
+type ClaimLayout struct {
+	SchemaHash string
+	Merklized  bool
+	Slots      []ClaimSlot
+	HIndex     *big.Int
+	HValue     *big.Int
+	Hash       *big.Int
+	Entries    []ClaimLayoutEntry
+}
+
+// ClaimSlot is one of the 8 slots of a core claim. Field is the credential subject field serialized in a data slot.
+type ClaimSlot struct {
+	Name     string
+	Value    *big.Int
+	Contents string
+	Field    *string
+}
+
+// ClaimLayoutEntry is a credential subject field of a merklized credential with the key and value of its entry
+// in the merklized tree
+type ClaimLayoutEntry struct {
+	Field string
+	Key   *big.Int
+	Value *big.Int
+}
+
+// NewClaimLayout returns the layout of the core claim. The hash is the one signed by the BJJ signature proofs.
+func NewClaimLayout(claim *core.Claim) (*ClaimLayout, error) {
+	hi, hv, err := claim.HiHv()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := poseidon.Hash([]*big.Int{hi, hv})
+	if err != nil {
+		return nil, err
+	}
+	idPosition, err := claim.GetIDPosition()
+	if err != nil {
+		return nil, err
+	}
+	merklizedPosition, err := claim.GetMerklizedPosition()
+	if err != nil {
+		return nil, err
+	}
+	schemaHash := claim.GetSchemaHash()
+
+	contents := [8]string{ClaimSlotHeader, ClaimSlotEmpty, ClaimSlotData, ClaimSlotData, ClaimSlotRevocation, ClaimSlotEmpty, ClaimSlotData, ClaimSlotData}
+	switch idPosition {
+	case core.IDPositionIndex:
+		contents[1] = ClaimSlotSubject
+	case core.IDPositionValue:
+		contents[5] = ClaimSlotSubject
+	}
+	switch merklizedPosition {
+	case core.MerklizedRootPositionIndex:
+		contents[2] = ClaimSlotMerklizedRoot
+	case core.MerklizedRootPositionValue:
+		contents[6] = ClaimSlotMerklizedRoot
+	}
+
+	names := [8]string{"i_0", "i_1", "i_2", "i_3", "v_0", "v_1", "v_2", "v_3"}
+	slots := make([]ClaimSlot, len(names))
+	for i, value := range claim.RawSlotsAsInts() {
+		slots[i] = ClaimSlot{Name: names[i], Value: value, Contents: contents[i]}
+		if contents[i] == ClaimSlotData && value.Sign() == 0 {
+			slots[i].Contents = ClaimSlotEmpty
+		}
+	}
+
+	return &ClaimLayout{
+		SchemaHash: hex.EncodeToString(schemaHash[:]),
+		Merklized:  merklizedPosition != core.MerklizedRootPositionNone,
+		Slots:      slots,
+		HIndex:     hi,
+		HValue:     hv,
+		Hash:       hash,
+	}, nil
+}
+
+// SetField records the credential subject field serialized in the slot with the given index, from 0 to 7
+func (l *ClaimLayout) SetField(slot int, field string) {
+	if slot < 0 || slot >= len(l.Slots) {
+		return
+	}
+	l.Slots[slot].Field = &field
+	l.Slots[slot].Contents = ClaimSlotData
+}
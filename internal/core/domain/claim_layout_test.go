@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClaimLayout(t *testing.T) {
+	did, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	id, err := core.IDFromDID(*did)
+	require.NoError(t, err)
+
+	t.Run("non merklized", func(t *testing.T) {
+		claim, err := core.NewClaim(core.SchemaHash{1}, core.WithIndexID(id), core.WithIndexDataInts(big.NewInt(19960424), nil), core.WithRevocationNonce(10))
+		require.NoError(t, err)
+
+		layout, err := NewClaimLayout(claim)
+		require.NoError(t, err)
+		require.Len(t, layout.Slots, 8)
+		assert.False(t, layout.Merklized)
+		assert.Equal(t, ClaimSlotSubject, layout.Slots[1].Contents)
+		assert.Equal(t, ClaimSlotData, layout.Slots[2].Contents)
+		assert.Equal(t, "19960424", layout.Slots[2].Value.String())
+		assert.Equal(t, ClaimSlotEmpty, layout.Slots[3].Contents)
+		assert.Equal(t, ClaimSlotEmpty, layout.Slots[5].Contents)
+
+		layout.SetField(2, "birthday")
+		require.NotNil(t, layout.Slots[2].Field)
+		assert.Equal(t, "birthday", *layout.Slots[2].Field)
+
+		hi, hv, err := claim.HiHv()
+		require.NoError(t, err)
+		assert.Equal(t, hi, layout.HIndex)
+		assert.Equal(t, hv, layout.HValue)
+		assert.NotEqual(t, 0, layout.Hash.Sign())
+	})
+
+	t.Run("merklized in value", func(t *testing.T) {
+		claim, err := core.NewClaim(core.SchemaHash{1}, core.WithValueID(id), core.WithValueMerklizedRoot(big.NewInt(5)))
+		require.NoError(t, err)
+
+		layout, err := NewClaimLayout(claim)
+		require.NoError(t, err)
+		assert.True(t, layout.Merklized)
+		assert.Equal(t, ClaimSlotEmpty, layout.Slots[1].Contents)
+		assert.Equal(t, ClaimSlotSubject, layout.Slots[5].Contents)
+		assert.Equal(t, ClaimSlotMerklizedRoot, layout.Slots[6].Contents)
+	})
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaim_ExpirationStatus(t *testing.T) {
+	now := time.Date(2024, 4, 8, 10, 0, 0, 0, time.UTC)
+	expiresAt := func(d time.Duration) *Claim { return &Claim{Expiration: now.Add(d).Unix()} }
+
+	assert.Equal(t, ExpirationStatusValid, (&Claim{}).ExpirationStatus(now, 0))
+	assert.Equal(t, ExpirationStatusValid, expiresAt(time.Hour).ExpirationStatus(now, 0))
+	assert.Equal(t, ExpirationStatusExpired, expiresAt(-time.Hour).ExpirationStatus(now, 0))
+	assert.Equal(t, ExpirationStatusGrace, expiresAt(-time.Hour).ExpirationStatus(now, 24*time.Hour))
+	assert.Equal(t, ExpirationStatusExpired, expiresAt(-48*time.Hour).ExpirationStatus(now, 24*time.Hour))
+}
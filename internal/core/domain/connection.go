@@ -10,12 +10,39 @@ import (
 
 // Connection struct
 type Connection struct {
-	ID          uuid.UUID
-	IssuerDID   w3c.DID
-	UserDID     w3c.DID
-	IssuerDoc   json.RawMessage
-	UserDoc     json.RawMessage
-	CreatedAt   time.Time
-	ModifiedAt  time.Time
-	Credentials *Credentials
+	ID             uuid.UUID
+	IssuerDID      w3c.DID
+	UserDID        w3c.DID
+	IssuerDoc      json.RawMessage
+	UserDoc        json.RawMessage
+	CreatedAt      time.Time
+	ModifiedAt     time.Time
+	LastVerifiedAt *time.Time
+	Profile        ConnectionProfile
+	Credentials    *Credentials
+}
+
+// ConnectionProfile identifies the holder of a connection for humans. It is provided by the holder in the
+// authentication response or set by the issuer.
+type ConnectionProfile struct {
+	DisplayName *string
+	ExternalID  *string
+	Email       *string
+}
+
+// NewConnectionVetting is the document posted to the connection webhook when a holder authenticates for the first
+// time, before the connection is created
+type NewConnectionVetting struct {
+	Issuer      string  `json:"issuer"`
+	UserDID     string  `json:"userDID"`
+	SessionID   string  `json:"sessionID"`
+	DisplayName *string `json:"displayName,omitempty"`
+	ExternalID  *string `json:"externalId,omitempty"`
+	Email       *string `json:"email,omitempty"`
+}
+
+// NewConnectionDecision is the answer of the connection webhook
+type NewConnectionDecision struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
 }
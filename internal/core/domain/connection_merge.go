@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// Reasons of the connection duplicates
+const (
+	ConnectionDuplicateExternalID = "externalId"
+	ConnectionDuplicateEmail      = "email"
+)
+
+// ConnectionMerge records the merge of a duplicate connection into the connection of the same holder that is kept.
+// The source connection no longer exists, so its user DID is kept in the record.
+type ConnectionMerge struct {
+	ID                 uuid.UUID
+	IssuerDID          w3c.DID
+	SourceConnectionID uuid.UUID
+	SourceUserDID      string
+	TargetConnectionID uuid.UUID
+	// Credentials is the number of active credentials of the source connection issued again to the target one
+	Credentials int
+	CreatedAt   time.Time
+}
+
+// ConnectionDuplicates is a group of connections of an issuer that share the external id or the email of their
+// profile, so they likely belong to the same holder authenticated with several DIDs.
+// The connections are sorted by creation date, the oldest first.
+type ConnectionDuplicates struct {
+	Reason        string
+	Value         string
+	ConnectionIDs []uuid.UUID
+	UserDIDs      []string
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions of the pruning of the dead connections
+const (
+	ConnectionPruneArchive = "archive" // the connections are moved to the archived_connections table
+	ConnectionPruneDelete  = "delete"
+)
+
+// DeadConnection is a connection whose credentials are all revoked or expired and whose holder has not been active
+// for a while: no authentication, no verification and no message to the agent.
+type DeadConnection struct {
+	ID             uuid.UUID
+	UserDID        string
+	Credentials    int
+	LastActivityAt time.Time
+}
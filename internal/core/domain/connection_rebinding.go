@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// ConnectionRebinding is a recovery code that lets a holder that lost their wallet move a connection to a new DID.
+// The holder redeems the code with a fresh authentication of the new DID, then the active credentials of the
+// connection are reissued to the new DID and the old ones are revoked. Only the hash of the code is stored.
+type ConnectionRebinding struct {
+	ID           uuid.UUID
+	IssuerDID    w3c.DID
+	ConnectionID uuid.UUID
+	CodeHash     string
+	// SessionID is the authentication session started with the code, nil until the code is redeemed
+	SessionID *uuid.UUID
+	// NewConnectionID is the connection of the new DID, nil until the rebinding completes
+	NewConnectionID *uuid.UUID
+	ExpiresAt       time.Time
+	UsedAt          *time.Time
+	CreatedAt       time.Time
+}
+
+// IsActive returns true if the code was not used and has not expired
+func (r *ConnectionRebinding) IsActive(now time.Time) bool {
+	return r.UsedAt == nil && now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// CredentialDependent is a credential issued by a link with prerequisites that depends, directly or through other
+// dependents, on a prerequisite credential of the same issuer. Depth is 1 for the direct dependents.
+type CredentialDependent struct {
+	ID             uuid.UUID
+	PrerequisiteID uuid.UUID
+	SchemaType     string
+	RevNonce       RevNonceUint64
+	Depth          int
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// AttributeProvenanceType is the type of the evidence entries with the provenance of an attribute
+const AttributeProvenanceType = "AttributeProvenance"
+
+// Evidence is an entry of the evidence section of a W3C credential
+type Evidence map[string]any
+
+// TermsOfUse is an entry of the termsOfUse section of a W3C credential, a policy the issuer attaches to the credential
+type TermsOfUse map[string]any
+
+// AttributeProvenance tells where the value of an attribute of the credential subject comes from and how the issuer
+// verified it
+type AttributeProvenance struct {
+	Source             string
+	VerificationMethod string
+	VerifiedAt         *time.Time
+}
+
+// ProvenanceEvidence returns the evidence entries with the provenance of the attributes, sorted by attribute
+func ProvenanceEvidence(provenance map[string]AttributeProvenance) []Evidence {
+	evidence := make([]Evidence, 0, len(provenance))
+	for attribute, p := range provenance {
+		entry := Evidence{
+			"type":               AttributeProvenanceType,
+			"attribute":          attribute,
+			"source":             p.Source,
+			"verificationMethod": p.VerificationMethod,
+		}
+		if p.VerifiedAt != nil {
+			entry["verifiedAt"] = p.VerifiedAt.UTC().Format(time.RFC3339)
+		}
+		evidence = append(evidence, entry)
+	}
+	sort.Slice(evidence, func(i, j int) bool { return evidence[i]["attribute"].(string) < evidence[j]["attribute"].(string) })
+	return evidence
+}
+
+// CredentialExtensions are the sections of a W3C credential that verifiable.W3CCredential does not model. They are
+// stored in the credential document next to the other sections.
+type CredentialExtensions struct {
+	Evidence   []Evidence   `json:"evidence,omitempty"`
+	TermsOfUse []TermsOfUse `json:"termsOfUse,omitempty"`
+}
+
+// Validate checks that every entry of the sections has a type and, when it has an id, that the id is a URL, as the
+// W3C data model requires
+func (e CredentialExtensions) Validate() error {
+	for i, entry := range e.Evidence {
+		if err := validateExtensionEntry(entry); err != nil {
+			return fmt.Errorf("evidence[%d]: %w", i, err)
+		}
+	}
+	for i, entry := range e.TermsOfUse {
+		if err := validateExtensionEntry(entry); err != nil {
+			return fmt.Errorf("termsOfUse[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateExtensionEntry(entry map[string]any) error {
+	switch t := entry["type"].(type) {
+	case string:
+		if t == "" {
+			return errors.New("empty type")
+		}
+	case []string:
+		if len(t) == 0 || slices.Contains(t, "") {
+			return errors.New("the types must be non empty strings")
+		}
+	case []any:
+		if len(t) == 0 {
+			return errors.New("empty type")
+		}
+		for _, v := range t {
+			if s, ok := v.(string); !ok || s == "" {
+				return errors.New("the types must be non empty strings")
+			}
+		}
+	default:
+		return errors.New("missing type")
+	}
+	if id, ok := entry["id"]; ok {
+		s, ok := id.(string)
+		if !ok {
+			return errors.New("the id must be a URL")
+		}
+		if _, err := url.ParseRequestURI(s); err != nil {
+			return errors.New("the id must be a URL")
+		}
+	}
+	return nil
+}
+
+// ExtendedCredential is a W3C credential with its extensions
+type ExtendedCredential struct {
+	verifiable.W3CCredential
+	CredentialExtensions
+}
+
+// GetCredentialExtensions returns the extensions stored in the credential document
+func (c *Claim) GetCredentialExtensions() (CredentialExtensions, error) {
+	var ext CredentialExtensions
+	if len(c.Data.Bytes) == 0 {
+		return ext, nil
+	}
+	err := json.Unmarshal(c.Data.Bytes, &ext)
+	return ext, err
+}
+
+// SetCredentialExtensions adds the extensions to the credential document, replacing the previous ones
+func (c *Claim) SetCredentialExtensions(ext CredentialExtensions) error {
+	document := make(map[string]json.RawMessage)
+	if len(c.Data.Bytes) > 0 {
+		if err := json.Unmarshal(c.Data.Bytes, &document); err != nil {
+			return err
+		}
+	}
+	if err := setDocumentSection(document, "evidence", ext.Evidence); err != nil {
+		return err
+	}
+	if err := setDocumentSection(document, "termsOfUse", ext.TermsOfUse); err != nil {
+		return err
+	}
+	return c.Data.Set(document)
+}
+
+func setDocumentSection[T any](document map[string]json.RawMessage, name string, entries []T) error {
+	delete(document, name)
+	if len(entries) == 0 {
+		return nil
+	}
+	section, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	document[name] = section
+	return nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaim_CredentialExtensions(t *testing.T) {
+	verifiedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	evidence := ProvenanceEvidence(map[string]AttributeProvenance{
+		"documentType": {Source: "kyc-provider", VerificationMethod: "video-call"},
+		"birthday":     {Source: "civil-registry", VerificationMethod: "document-check", VerifiedAt: &verifiedAt},
+	})
+	assert.Equal(t, []Evidence{
+		{"type": AttributeProvenanceType, "attribute": "birthday", "source": "civil-registry", "verificationMethod": "document-check", "verifiedAt": "2024-03-01T10:00:00Z"},
+		{"type": AttributeProvenanceType, "attribute": "documentType", "source": "kyc-provider", "verificationMethod": "video-call"},
+	}, evidence)
+
+	var claim Claim
+	require.NoError(t, claim.Data.Set(verifiable.W3CCredential{ID: "urn:uuid:1", Issuer: "did:example:issuer"}))
+	require.NoError(t, claim.SetCredentialExtensions(CredentialExtensions{Evidence: evidence}))
+
+	ext, err := claim.GetCredentialExtensions()
+	require.NoError(t, err)
+	require.Len(t, ext.Evidence, 2)
+	assert.Equal(t, "birthday", ext.Evidence[0]["attribute"])
+
+	vc, err := claim.GetVerifiableCredential()
+	require.NoError(t, err)
+	assert.Equal(t, "urn:uuid:1", vc.ID)
+
+	document, err := json.Marshal(ExtendedCredential{W3CCredential: vc, CredentialExtensions: ext})
+	require.NoError(t, err)
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(document, &fields))
+	assert.Equal(t, "did:example:issuer", fields["issuer"])
+	assert.Len(t, fields["evidence"], 2)
+
+	require.NoError(t, claim.SetCredentialExtensions(CredentialExtensions{TermsOfUse: []TermsOfUse{{"type": "IssuerPolicy"}}}))
+	ext, err = claim.GetCredentialExtensions()
+	require.NoError(t, err)
+	assert.Empty(t, ext.Evidence)
+	assert.Equal(t, []TermsOfUse{{"type": "IssuerPolicy"}}, ext.TermsOfUse)
+}
+
+func TestCredentialExtensions_Validate(t *testing.T) {
+	valid := CredentialExtensions{
+		Evidence:   []Evidence{{"type": []any{"DocumentVerification"}, "id": "https://issuer.example/evidence/1"}},
+		TermsOfUse: []TermsOfUse{{"type": "IssuerPolicy", "id": "https://issuer.example/policies/1"}},
+	}
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, CredentialExtensions{}.Validate())
+
+	assert.Error(t, CredentialExtensions{Evidence: []Evidence{{"verifier": "someone"}}}.Validate())
+	assert.Error(t, CredentialExtensions{Evidence: []Evidence{{"type": []any{}}}}.Validate())
+	assert.Error(t, CredentialExtensions{TermsOfUse: []TermsOfUse{{"type": ""}}}.Validate())
+	assert.Error(t, CredentialExtensions{TermsOfUse: []TermsOfUse{{"type": "IssuerPolicy", "id": "not a url"}}}.Validate())
+}
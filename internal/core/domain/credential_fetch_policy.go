@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"math/big"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// CredentialFetchPolicyType tells who can fetch a credential from the agent
+type CredentialFetchPolicyType string
+
+const (
+	CredentialFetchPolicySubject   CredentialFetchPolicyType = "subject"   // CredentialFetchPolicySubject only the subject DID of the credential
+	CredentialFetchPolicyProfiles  CredentialFetchPolicyType = "profiles"  // CredentialFetchPolicyProfiles the subject DID and the profiles derived from it
+	CredentialFetchPolicyFreshAuth CredentialFetchPolicyType = "freshAuth" // CredentialFetchPolicyFreshAuth the subject DID, if it authenticated with the issuer recently
+)
+
+// MaxCredentialFetchAuthMinutes is the longest time a fresh authentication policy can accept
+const MaxCredentialFetchAuthMinutes = 7 * 24 * 60
+
+// CredentialFetchPolicy is chosen when a credential is created and checked every time it is fetched.
+// FreshAuthMinutes is how old the last authentication of the subject can be for the fresh authentication policy.
+type CredentialFetchPolicy struct {
+	Type             CredentialFetchPolicyType
+	FreshAuthMinutes int
+}
+
+// Valid tells if the policy is one of the known ones and has the settings it needs
+func (p CredentialFetchPolicy) Valid() bool {
+	switch p.Type {
+	case "", CredentialFetchPolicySubject, CredentialFetchPolicyProfiles:
+		return p.FreshAuthMinutes == 0
+	case CredentialFetchPolicyFreshAuth:
+		return p.FreshAuthMinutes > 0 && p.FreshAuthMinutes <= MaxCredentialFetchAuthMinutes
+	default:
+		return false
+	}
+}
+
+// IsProfileOf tells if the profile DID is derived from the genesis DID with the nonce
+func IsProfileOf(profileDID w3c.DID, genesisDID w3c.DID, nonce *big.Int) bool {
+	if nonce == nil || nonce.Sign() <= 0 {
+		return false
+	}
+	genesisID, err := core.IDFromDID(genesisDID)
+	if err != nil {
+		return false
+	}
+	profileID, err := core.IDFromDID(profileDID)
+	if err != nil {
+		return false
+	}
+	expected, err := core.ProfileID(genesisID, nonce)
+	if err != nil {
+		return false
+	}
+	return expected.Equal(&profileID)
+}
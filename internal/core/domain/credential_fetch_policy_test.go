@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialFetchPolicy_Valid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy CredentialFetchPolicy
+		valid  bool
+	}{
+		{name: "default", policy: CredentialFetchPolicy{}, valid: true},
+		{name: "subject", policy: CredentialFetchPolicy{Type: CredentialFetchPolicySubject}, valid: true},
+		{name: "profiles", policy: CredentialFetchPolicy{Type: CredentialFetchPolicyProfiles}, valid: true},
+		{name: "profiles with minutes", policy: CredentialFetchPolicy{Type: CredentialFetchPolicyProfiles, FreshAuthMinutes: 5}, valid: false},
+		{name: "fresh auth", policy: CredentialFetchPolicy{Type: CredentialFetchPolicyFreshAuth, FreshAuthMinutes: 15}, valid: true},
+		{name: "fresh auth without minutes", policy: CredentialFetchPolicy{Type: CredentialFetchPolicyFreshAuth}, valid: false},
+		{name: "fresh auth too long", policy: CredentialFetchPolicy{Type: CredentialFetchPolicyFreshAuth, FreshAuthMinutes: MaxCredentialFetchAuthMinutes + 1}, valid: false},
+		{name: "unknown", policy: CredentialFetchPolicy{Type: "anyone"}, valid: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.valid, tc.policy.Valid())
+		})
+	}
+}
+
+func TestIsProfileOf(t *testing.T) {
+	genesis, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	genesisID, err := core.IDFromDID(*genesis)
+	require.NoError(t, err)
+	nonce := big.NewInt(12345)
+	profileID, err := core.ProfileID(genesisID, nonce)
+	require.NoError(t, err)
+	profile, err := core.ParseDIDFromID(profileID)
+	require.NoError(t, err)
+
+	assert.True(t, IsProfileOf(*profile, *genesis, nonce))
+	assert.False(t, IsProfileOf(*profile, *genesis, big.NewInt(1)))
+	assert.False(t, IsProfileOf(*profile, *genesis, nil))
+	assert.False(t, IsProfileOf(*genesis, *profile, nonce))
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// CredentialPDFTemplate configures how the credentials of a schema are rendered as PDF documents.
+// The logo and the colors of the QR code come from the QR branding of the issuer.
+type CredentialPDFTemplate struct {
+	IssuerDID   w3c.DID
+	SchemaID    uuid.UUID
+	Title       string // Defaults to the credential type when empty
+	Header      string
+	Footer      string
+	AccentColor string // #RRGGBB color of the header band
+	Fields      []CredentialPDFField
+	ModifiedAt  time.Time
+}
+
+// CredentialPDFField is a credentialSubject attribute shown in the document
+type CredentialPDFField struct {
+	Attribute string `json:"attribute"`
+	Label     string `json:"label"`
+}
+
+// NewDefaultCredentialPDFTemplate returns the template used for schemas without one. It shows all the attributes.
+func NewDefaultCredentialPDFTemplate(issuerDID w3c.DID, schemaID uuid.UUID) *CredentialPDFTemplate {
+	return &CredentialPDFTemplate{
+		IssuerDID:   issuerDID,
+		SchemaID:    schemaID,
+		AccentColor: "#1E1E2F",
+	}
+}
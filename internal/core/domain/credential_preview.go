@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a credential preview
+const (
+	CredentialPreviewActive  = "active"
+	CredentialPreviewRevoked = "revoked"
+	CredentialPreviewExpired = "expired"
+)
+
+// CredentialPreview is a readable view of a credential for the holders without a compatible wallet
+type CredentialPreview struct {
+	ID             uuid.UUID
+	CredentialID   string
+	SchemaType     string
+	SchemaURL      string
+	Issuer         string
+	IssuanceDate   *time.Time
+	ExpirationDate *time.Time
+	Status         string
+	Attributes     []CredentialPreviewAttribute
+	Display        *CredentialDisplay // nil when the credential has no display method or it cannot be resolved
+}
+
+// CredentialPreviewAttribute is an attribute of the credential subject with its value as text
+type CredentialPreviewAttribute struct {
+	Name  string
+	Value string
+}
+
+// CredentialDisplay is the document of an Iden3BasicDisplayMethodV1 display method
+type CredentialDisplay struct {
+	Title                string                 `json:"title"`
+	Description          string                 `json:"description"`
+	IssuerName           string                 `json:"issuerName"`
+	TitleTextColor       string                 `json:"titleTextColor"`
+	DescriptionTextColor string                 `json:"descriptionTextColor"`
+	IssuerTextColor      string                 `json:"issuerTextColor"`
+	BackgroundImageURL   string                 `json:"backgroundImageUrl"`
+	Logo                 CredentialDisplayImage `json:"logo"`
+}
+
+// CredentialDisplayImage is an image of a display method document
+type CredentialDisplayImage struct {
+	URI string `json:"uri"`
+	Alt string `json:"alt"`
+}
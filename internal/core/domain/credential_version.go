@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// CredentialVersion records that a credential superseded a previous one, with the changes of the credential subject.
+// The credentials superseding each other form a lineage: the first credential is the version 1 and has no record.
+type CredentialVersion struct {
+	ID                   uuid.UUID
+	IssuerDID            w3c.DID
+	LineageID            uuid.UUID
+	CredentialID         uuid.UUID
+	PreviousCredentialID uuid.UUID
+	Version              int
+	Changes              []CredentialSubjectChange
+	CreatedAt            time.Time
+}
+
+// CredentialSubjectChange is an attribute of the credential subject added, removed or changed by a new version.
+// Before is nil for the added attributes and After for the removed ones.
+type CredentialSubjectChange struct {
+	Attribute string `json:"attribute"`
+	Before    any    `json:"before,omitempty"`
+	After     any    `json:"after,omitempty"`
+}
+
+// DiffCredentialSubject returns the changes of the attributes from one credential subject to another, sorted by
+// attribute
+func DiffCredentialSubject(before, after map[string]any) []CredentialSubjectChange {
+	changes := make([]CredentialSubjectChange, 0)
+	for attribute, value := range before {
+		if newValue, ok := after[attribute]; !ok || !reflect.DeepEqual(value, newValue) {
+			changes = append(changes, CredentialSubjectChange{Attribute: attribute, Before: value, After: newValue})
+		}
+	}
+	for attribute, value := range after {
+		if _, ok := before[attribute]; !ok {
+			changes = append(changes, CredentialSubjectChange{Attribute: attribute, After: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Attribute < changes[j].Attribute })
+	return changes
+}
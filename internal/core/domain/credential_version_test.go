@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCredentialSubject(t *testing.T) {
+	before := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     float64(19960424),
+		"documentType": float64(2),
+		"address":      map[string]any{"city": "Barcelona"},
+	}
+	after := map[string]any{
+		"id":          "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":    float64(19960424),
+		"address":     map[string]any{"city": "Madrid"},
+		"nationality": "ES",
+	}
+
+	assert.Equal(t, []CredentialSubjectChange{
+		{Attribute: "address", Before: map[string]any{"city": "Barcelona"}, After: map[string]any{"city": "Madrid"}},
+		{Attribute: "documentType", Before: float64(2)},
+		{Attribute: "nationality", After: "ES"},
+	}, DiffCredentialSubject(before, after))
+	assert.Empty(t, DiffCredentialSubject(before, before))
+}
@@ -0,0 +1,12 @@
+package domain
+
+// DIDConfigurationContext is the JSON-LD context of the DIF well known DID configuration resource and of the domain
+// linkage credentials
+const DIDConfigurationContext = "https://identity.foundation/.well-known/did-configuration/v1"
+
+// DIDConfiguration is the DIF well known DID configuration resource, served at /.well-known/did-configuration.json of
+// an origin. Every linked DID is a domain linkage credential in the JWT format that binds a DID to the origin.
+type DIDConfiguration struct {
+	Context    string   `json:"@context"`
+	LinkedDIDs []string `json:"linked_dids"`
+}
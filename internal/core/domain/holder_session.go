@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// HolderSession is the session of a holder authenticated in the holder portal
+type HolderSession struct {
+	Token     string    `json:"token"`
+	UserDID   w3c.DID   `json:"userDID"`
+	IssuerDID w3c.DID   `json:"issuerDID"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
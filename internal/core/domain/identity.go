@@ -1,8 +1,12 @@
 package domain
 
 import (
+	"fmt"
 	"math/big"
+	"net/url"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
@@ -18,6 +22,30 @@ type Identity struct {
 	KeyType    string   `json:"keyType"`
 	Address    *string  `json:"address"`
 	Balance    *big.Int `json:"balance"`
+	TenantID   *uuid.UUID
+	ServerURL  *string // ServerURL is the public url the issuer agent is reached at, if it has its own
+}
+
+// AgentServerURL returns the server url of the identity, or the default one if it does not have its own
+func (i *Identity) AgentServerURL(defaultURL string) string {
+	if i == nil || i.ServerURL == nil {
+		return defaultURL
+	}
+	return *i.ServerURL
+}
+
+// NormalizeServerURL validates the url an issuer agent is reached at, i.e. https://acme.issuer.example.com or
+// https://issuer.example.com/acme, and returns it without the trailing slash
+func NormalizeServerURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid server url %q, it must be an absolute http or https url", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("invalid server url %q, it can not have user info, query or fragment", raw)
+	}
+	u.Host = strings.ToLower(u.Host)
+	return strings.TrimSuffix(u.String(), "/"), nil
 }
 
 // NewIdentityFromIdentifier default identity model from identity and root state
@@ -1,8 +1,11 @@
 package domain
 
 import (
+	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-circuits/v2"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
@@ -34,12 +37,43 @@ type IdentityState struct {
 	BlockTimestamp     *int           `json:"block_timestamp,omitempty"`
 	BlockNumber        *int           `json:"block_number,omitempty"`
 	TxID               *string        `json:"tx_id,omitempty"`
+	GasUsed            *int64         `json:"gas_used,omitempty"`
+	TxFee              *string        `json:"tx_fee,omitempty"`
+	TxFeeFiat          *string        `json:"tx_fee_fiat,omitempty"`
+	TxFeeCurrency      *string        `json:"tx_fee_currency,omitempty"`
+	DroppedTxIDs       []string       `json:"dropped_tx_ids,omitempty"`
 	PreviousState      *string        `json:"previous_state,omitempty"`
 	Status             IdentityStatus `json:"status,omitempty"`
 	ModifiedAt         time.Time      `json:"modified_at,omitempty"`
 	CreatedAt          time.Time      `json:"created_at,omitempty"`
 }
 
+// StateTransaction is a state transition of an identity with the credentials issued and revoked in it
+type StateTransaction struct {
+	IdentityState
+	CredentialIDs        []uuid.UUID
+	RevokedCredentialIDs []uuid.UUID
+}
+
+// StateTransitionContent contains the credentials issued and revoked in a state transition
+type StateTransitionContent struct {
+	CredentialIDs        []uuid.UUID
+	RevokedCredentialIDs []uuid.UUID
+}
+
+// PendingState contains the credentials and revocations that will be included in the next state transition
+type PendingState struct {
+	Credentials []Claim
+	Revocations []PendingRevocation
+}
+
+// PendingRevocation is a revocation waiting to be published. Credential is nil when the revocation nonce
+// does not belong to any credential of the issuer.
+type PendingRevocation struct {
+	Revocation
+	Credential *Claim
+}
+
 // PublishedState defines the domain object of publish state on chain
 type PublishedState struct {
 	TxID               *string
@@ -64,6 +98,25 @@ func (i *IdentityState) TreeState() circuits.TreeState {
 	}
 }
 
+// weiPerToken is the number of wei in a native token
+var weiPerToken = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+// SetTxFeeFiat sets the fee of the state transaction in fiat, with the given price of the native token.
+// The fee is rounded to 6 decimals.
+func (i *IdentityState) SetTxFeeFiat(price float64, currency string) error {
+	if i.TxFee == nil {
+		return nil
+	}
+	fee, ok := new(big.Float).SetString(*i.TxFee)
+	if !ok {
+		return fmt.Errorf("invalid transaction fee %s", *i.TxFee)
+	}
+	fiat := new(big.Float).Quo(fee.Mul(fee, big.NewFloat(price)), weiPerToken).Text('f', 6)
+	i.TxFeeFiat = &fiat
+	i.TxFeeCurrency = &currency
+	return nil
+}
+
 // ContainsID check if states contains id
 func ContainsID(states []IdentityState, id *w3c.DID) bool {
 	for i := range states {
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestIdentityState_SetTxFeeFiat(t *testing.T) {
+	state := IdentityState{TxFee: common.ToPointer("10281360000000000")}
+	require.NoError(t, state.SetTxFeeFiat(0.72, "USD"))
+	assert.Equal(t, "0.007403", *state.TxFeeFiat)
+	assert.Equal(t, "USD", *state.TxFeeCurrency)
+
+	state = IdentityState{}
+	require.NoError(t, state.SetTxFeeFiat(0.72, "USD"))
+	assert.Nil(t, state.TxFeeFiat)
+
+	state = IdentityState{TxFee: common.ToPointer("not a number")}
+	assert.Error(t, state.SetTxFeeFiat(0.72, "USD"))
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNormalizeServerURL(t *testing.T) {
+	for raw, expected := range map[string]string{
+		"https://ACME.issuer.example.com/": "https://acme.issuer.example.com",
+		" https://issuer.example.com/acme": "https://issuer.example.com/acme",
+		"http://localhost:3001":            "http://localhost:3001",
+	} {
+		got, err := NormalizeServerURL(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, expected, got)
+	}
+	for _, raw := range []string{"", "acme.example.com", "ftp://acme.example.com", "https://acme.example.com?a=b", "https://u:p@acme.example.com"} {
+		_, err := NormalizeServerURL(raw)
+		assert.Error(t, err, raw)
+	}
+
+	var identity *Identity
+	assert.Equal(t, "https://node", identity.AgentServerURL("https://node"))
+	identity = &Identity{ServerURL: common.ToPointer("https://acme.example.com")}
+	assert.Equal(t, "https://acme.example.com", identity.AgentServerURL("https://node"))
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2/packers"
+)
+
+// OutOfBandInvitationType is the DIDComm v2 message type of the out-of-band invitations
+const OutOfBandInvitationType = "https://didcomm.org/out-of-band/2.0/invitation"
+
+// Goal codes of the out-of-band invitations, as defined by the Aries goal codes RFC
+const (
+	OutOfBandGoalCodeIssueCredential = "aries.vc.issue"  // OutOfBandGoalCodeIssueCredential the invitation offers a credential
+	OutOfBandGoalCodeConnect         = "aries.rel.build" // OutOfBandGoalCodeConnect the invitation builds a connection with the issuer
+)
+
+// OutOfBandInvitation is a DIDComm v2 out-of-band invitation. The iden3comm messages the holder has to process,
+// like the authorization request and the credential offer, travel as attachments so a single scan can carry several of them.
+type OutOfBandInvitation struct {
+	Type        string                          `json:"type"`
+	ID          string                          `json:"id"`
+	From        string                          `json:"from"`
+	Body        OutOfBandInvitationBody         `json:"body"`
+	Attachments []OutOfBandInvitationAttachment `json:"attachments,omitempty"`
+}
+
+// OutOfBandInvitationBody is the body of an out-of-band invitation
+type OutOfBandInvitationBody struct {
+	GoalCode string   `json:"goal_code,omitempty"`
+	Goal     string   `json:"goal,omitempty"`
+	Accept   []string `json:"accept,omitempty"`
+}
+
+// OutOfBandInvitationAttachment is a message attached to an out-of-band invitation
+type OutOfBandInvitationAttachment struct {
+	ID        string                            `json:"id"`
+	MediaType string                            `json:"media_type"`
+	Data      OutOfBandInvitationAttachmentData `json:"data"`
+}
+
+// OutOfBandInvitationAttachmentData is the content of an attachment
+type OutOfBandInvitationAttachmentData struct {
+	JSON json.RawMessage `json:"json"`
+}
+
+// NewOutOfBandInvitation returns an invitation from the issuer with the given goal and the iden3comm plain messages as attachments
+func NewOutOfBandInvitation(from string, goalCode string, goal string, messages ...[]byte) *OutOfBandInvitation {
+	attachments := make([]OutOfBandInvitationAttachment, len(messages))
+	for i, msg := range messages {
+		attachments[i] = OutOfBandInvitationAttachment{
+			ID:        uuid.New().String(),
+			MediaType: string(packers.MediaTypePlainMessage),
+			Data:      OutOfBandInvitationAttachmentData{JSON: msg},
+		}
+	}
+	return &OutOfBandInvitation{
+		Type: OutOfBandInvitationType,
+		ID:   uuid.New().String(),
+		From: from,
+		Body: OutOfBandInvitationBody{
+			GoalCode: goalCode,
+			Goal:     goal,
+			Accept:   []string{"didcomm/v2", string(packers.MediaTypePlainMessage)},
+		},
+		Attachments: attachments,
+	}
+}
+
+// URL returns the invitation encoded in the _oob query parameter of baseURL
+func (i *OutOfBandInvitation) URL(baseURL string) (string, error) {
+	raw, err := json.Marshal(i)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/?_oob=%s", strings.TrimSuffix(baseURL, "/"), base64.RawURLEncoding.EncodeToString(raw)), nil
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutOfBandInvitation_URL(t *testing.T) {
+	auth := []byte(`{"type":"https://iden3-communication.io/authorization/1.0/request"}`)
+	offer := []byte(`{"type":"https://iden3-communication.io/credentials/1.0/offer"}`)
+	oob := NewOutOfBandInvitation("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", OutOfBandGoalCodeIssueCredential, "Receive a credential", auth, offer)
+
+	require.Len(t, oob.Attachments, 2)
+	assert.Equal(t, OutOfBandInvitationType, oob.Type)
+	assert.JSONEq(t, string(auth), string(oob.Attachments[0].Data.JSON))
+	assert.JSONEq(t, string(offer), string(oob.Attachments[1].Data.JSON))
+
+	u, err := oob.URL("https://issuer.example.com/")
+	require.NoError(t, err)
+	parsed, err := url.Parse(u)
+	require.NoError(t, err)
+	assert.Equal(t, "issuer.example.com", parsed.Host)
+
+	raw, err := base64.RawURLEncoding.DecodeString(parsed.Query().Get("_oob"))
+	require.NoError(t, err)
+	var decoded OutOfBandInvitation
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, oob.ID, decoded.ID)
+	assert.Equal(t, OutOfBandGoalCodeIssueCredential, decoded.Body.GoalCode)
+	assert.Len(t, decoded.Attachments, 2)
+}
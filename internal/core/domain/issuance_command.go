@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IssuanceCommandStatus is the outcome of an issuance command read from the issuance queue
+type IssuanceCommandStatus string
+
+const (
+	// IssuanceCommandIssued the credential of the command was issued
+	IssuanceCommandIssued IssuanceCommandStatus = "issued"
+
+	// IssuanceCommandFailed the command was malformed or the credential could not be issued
+	IssuanceCommandFailed IssuanceCommandStatus = "failed"
+)
+
+// IssuanceMessage is a message of the issuance queue, with the id the queue gave it and the command it carries
+type IssuanceMessage struct {
+	ID   string
+	Body []byte
+}
+
+// IssuanceCommand asks the issuer to issue a credential. The back office producing the commands sets the id, so it can
+// correlate the results written to the reply queue. The credential gets the external id of the command, or the command
+// id when it has none, so a command delivered again is answered with the credential issued the first time.
+type IssuanceCommand struct {
+	ID                string         `json:"id"`
+	CredentialSchema  string         `json:"credentialSchema"`
+	Type              string         `json:"type"`
+	CredentialSubject map[string]any `json:"credentialSubject"`
+	Expiration        *time.Time     `json:"expiration,omitempty"`
+	SignatureProof    *bool          `json:"signatureProof,omitempty"`
+	MtProof           *bool          `json:"mtProof,omitempty"`
+	ExternalID        *string        `json:"externalId,omitempty"`
+}
+
+// Validate checks that the command has an id, a schema, a type, a credential subject and at least one proof
+func (c IssuanceCommand) Validate() error {
+	if strings.TrimSpace(c.ID) == "" {
+		return errors.New("the command has no id")
+	}
+	if strings.TrimSpace(c.CredentialSchema) == "" || strings.TrimSpace(c.Type) == "" {
+		return errors.New("the command must have a credentialSchema and a type")
+	}
+	if len(c.CredentialSubject) == 0 {
+		return errors.New("the command has no credentialSubject")
+	}
+	if !c.WithSignatureProof() && !c.WithMTProof() {
+		return errors.New("the command must ask for at least one proof type")
+	}
+	return nil
+}
+
+// CredentialExternalID returns the external id of the credential of the command, that identifies the command
+func (c IssuanceCommand) CredentialExternalID() string {
+	if c.ExternalID != nil && *c.ExternalID != "" {
+		return *c.ExternalID
+	}
+	return c.ID
+}
+
+// WithSignatureProof returns true if the command asks for a signature proof
+func (c IssuanceCommand) WithSignatureProof() bool {
+	return c.SignatureProof != nil && *c.SignatureProof
+}
+
+// WithMTProof returns true if the command asks for a merkle tree proof
+func (c IssuanceCommand) WithMTProof() bool {
+	return c.MtProof != nil && *c.MtProof
+}
+
+// IssuanceCommandResult is written to the reply queue after a command is processed
+type IssuanceCommandResult struct {
+	CommandID    string                `json:"commandId"`
+	Status       IssuanceCommandStatus `json:"status"`
+	CredentialID *uuid.UUID            `json:"credentialId,omitempty"`
+	Error        string                `json:"error,omitempty"`
+	ProcessedAt  time.Time             `json:"processedAt"`
+}
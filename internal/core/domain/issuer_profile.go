@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// IssuerProfile is a profile of the issuer identity, e.g. a department, with its own display information and the
+// proofs it is allowed to issue credentials with. The profile DID is derived from the issuer DID and the profile nonce.
+type IssuerProfile struct {
+	ID            uuid.UUID
+	IssuerDID     w3c.DID
+	Nonce         *big.Int
+	DID           w3c.DID
+	Name          string
+	Description   *string
+	LogoURL       *string
+	AllowedProofs []verifiable.ProofType
+	CreatedAt     time.Time
+}
+
+// ProfileDID returns the DID of the profile of the issuer with the given nonce
+func ProfileDID(issuerDID w3c.DID, nonce *big.Int) (*w3c.DID, error) {
+	id, err := core.IDFromDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	profileID, err := core.ProfileID(id, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return core.ParseDIDFromID(profileID)
+}
+
+// Allows returns true if the key usage policy of the profile allows issuing credentials with the proof
+func (p *IssuerProfile) Allows(proof verifiable.ProofType) bool {
+	for _, allowed := range p.AllowedProofs {
+		if allowed == proof {
+			return true
+		}
+	}
+	return false
+}
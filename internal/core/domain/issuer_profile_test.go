@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileDID(t *testing.T) {
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+
+	did, err := ProfileDID(*issuerDID, big.NewInt(10))
+	require.NoError(t, err)
+	assert.NotEqual(t, issuerDID.String(), did.String())
+
+	same, err := ProfileDID(*issuerDID, big.NewInt(10))
+	require.NoError(t, err)
+	assert.Equal(t, did.String(), same.String())
+
+	other, err := ProfileDID(*issuerDID, big.NewInt(11))
+	require.NoError(t, err)
+	assert.NotEqual(t, did.String(), other.String())
+}
+
+func TestIssuerProfile_Allows(t *testing.T) {
+	profile := IssuerProfile{AllowedProofs: []verifiable.ProofType{verifiable.BJJSignatureProofType}}
+	assert.True(t, profile.Allows(verifiable.BJJSignatureProofType))
+	assert.False(t, profile.Allows(verifiable.Iden3SparseMerkleTreeProofType))
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// KeyMigrationStep is a step of the migration of the issuer auth key from vault to a hardware-backed custody
+type KeyMigrationStep string
+
+const (
+	KeyMigrationStepGenerateKey    KeyMigrationStep = "generate_key"    // KeyMigrationStepGenerateKey the new key is generated inside the custody
+	KeyMigrationStepAddAuthClaim   KeyMigrationStep = "add_auth_claim"  // KeyMigrationStepAddAuthClaim the auth claim of the new key is added to the identity
+	KeyMigrationStepPublishState   KeyMigrationStep = "publish_state"   // KeyMigrationStepPublishState the state with the new auth claim is published and confirmed
+	KeyMigrationStepVerifyIssuance KeyMigrationStep = "verify_issuance" // KeyMigrationStepVerifyIssuance a claim is signed with the new key and verified
+	KeyMigrationStepRetireOldKey   KeyMigrationStep = "retire_old_key"  // KeyMigrationStepRetireOldKey the old key is retired from vault
+	KeyMigrationStepDone           KeyMigrationStep = "done"            // KeyMigrationStepDone the migration is completed
+)
+
+// KeyMigrationSteps are the steps of a key migration, in order
+var KeyMigrationSteps = []KeyMigrationStep{
+	KeyMigrationStepGenerateKey,
+	KeyMigrationStepAddAuthClaim,
+	KeyMigrationStepPublishState,
+	KeyMigrationStepVerifyIssuance,
+	KeyMigrationStepRetireOldKey,
+}
+
+// KeyMigrationStatus is the status of a key migration, or of one of its steps
+type KeyMigrationStatus string
+
+const (
+	KeyMigrationStatusPending   KeyMigrationStatus = "pending"   // KeyMigrationStatusPending the step has not started yet
+	KeyMigrationStatusRunning   KeyMigrationStatus = "running"   // KeyMigrationStatusRunning the step is being run
+	KeyMigrationStatusWaiting   KeyMigrationStatus = "waiting"   // KeyMigrationStatusWaiting the step waits for the state to be confirmed
+	KeyMigrationStatusFailed    KeyMigrationStatus = "failed"    // KeyMigrationStatusFailed the step failed, it is run again when the migration is resumed
+	KeyMigrationStatusCompleted KeyMigrationStatus = "completed" // KeyMigrationStatusCompleted the step or the migration is completed
+)
+
+// KeyMigration moves the auth key of an issuer from vault to a hardware-backed custody without downtime. The new key
+// is added as a new auth claim, and the old one is only retired once the new one is published and issuing with it
+// works. The old auth claim is revoked only if requested, because that invalidates the credentials signed with it.
+type KeyMigration struct {
+	ID                 uuid.UUID
+	IssuerDID          w3c.DID
+	Step               KeyMigrationStep
+	Status             KeyMigrationStatus
+	RevokeOldAuthClaim bool
+	OldAuthClaimID     uuid.UUID
+	OldKeyID           string
+	NewKeyID           *string
+	NewAuthClaimID     *uuid.UUID
+	Error              *string
+	CreatedAt          time.Time
+	ModifiedAt         time.Time
+}
+
+// KeyMigrationStepStatus is the status of a step of a key migration
+type KeyMigrationStepStatus struct {
+	Step   KeyMigrationStep
+	Status KeyMigrationStatus
+}
+
+// NewKeyMigration returns a new key migration of the issuer auth claim and its vault key
+func NewKeyMigration(issuerDID w3c.DID, oldAuthClaimID uuid.UUID, oldKeyID string, revokeOldAuthClaim bool) *KeyMigration {
+	now := time.Now().UTC()
+	return &KeyMigration{
+		ID:                 uuid.New(),
+		IssuerDID:          issuerDID,
+		Step:               KeyMigrationStepGenerateKey,
+		Status:             KeyMigrationStatusRunning,
+		RevokeOldAuthClaim: revokeOldAuthClaim,
+		OldAuthClaimID:     oldAuthClaimID,
+		OldKeyID:           oldKeyID,
+		CreatedAt:          now,
+		ModifiedAt:         now,
+	}
+}
+
+// Completed returns true if all the steps are completed
+func (m *KeyMigration) Completed() bool {
+	return m.Step == KeyMigrationStepDone
+}
+
+// Next moves the migration to the step after the current one
+func (m *KeyMigration) Next() {
+	for i, step := range KeyMigrationSteps {
+		if step != m.Step {
+			continue
+		}
+		m.Step, m.Status = KeyMigrationStepDone, KeyMigrationStatusCompleted
+		if i+1 < len(KeyMigrationSteps) {
+			m.Step, m.Status = KeyMigrationSteps[i+1], KeyMigrationStatusRunning
+		}
+		break
+	}
+	m.Error = nil
+	m.ModifiedAt = time.Now().UTC()
+}
+
+// Wait marks the current step as waiting for the state to be confirmed
+func (m *KeyMigration) Wait() {
+	m.Status = KeyMigrationStatusWaiting
+	m.Error = nil
+	m.ModifiedAt = time.Now().UTC()
+}
+
+// Fail marks the current step as failed with the error
+func (m *KeyMigration) Fail(err error) {
+	msg := err.Error()
+	m.Status = KeyMigrationStatusFailed
+	m.Error = &msg
+	m.ModifiedAt = time.Now().UTC()
+}
+
+// Steps returns the status of every step of the migration
+func (m *KeyMigration) Steps() []KeyMigrationStepStatus {
+	steps := make([]KeyMigrationStepStatus, len(KeyMigrationSteps))
+	status := KeyMigrationStatusCompleted
+	for i, step := range KeyMigrationSteps {
+		switch {
+		case step == m.Step:
+			steps[i] = KeyMigrationStepStatus{Step: step, Status: m.Status}
+			status = KeyMigrationStatusPending
+		default:
+			steps[i] = KeyMigrationStepStatus{Step: step, Status: status}
+		}
+	}
+	return steps
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyMigration_Steps(t *testing.T) {
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+
+	m := NewKeyMigration(*did, uuid.New(), "iden3/did/BJJ:00", false)
+	assert.Equal(t, KeyMigrationStepGenerateKey, m.Step)
+	assert.Equal(t, KeyMigrationStatusRunning, m.Steps()[0].Status)
+	assert.Equal(t, KeyMigrationStatusPending, m.Steps()[4].Status)
+
+	m.Next()
+	m.Next()
+	m.Wait()
+	assert.Equal(t, []KeyMigrationStepStatus{
+		{Step: KeyMigrationStepGenerateKey, Status: KeyMigrationStatusCompleted},
+		{Step: KeyMigrationStepAddAuthClaim, Status: KeyMigrationStatusCompleted},
+		{Step: KeyMigrationStepPublishState, Status: KeyMigrationStatusWaiting},
+		{Step: KeyMigrationStepVerifyIssuance, Status: KeyMigrationStatusPending},
+		{Step: KeyMigrationStepRetireOldKey, Status: KeyMigrationStatusPending},
+	}, m.Steps())
+
+	m.Fail(errors.New("boom"))
+	assert.Equal(t, KeyMigrationStatusFailed, m.Status)
+	require.NotNil(t, m.Error)
+	assert.Equal(t, "boom", *m.Error)
+
+	m.Next()
+	assert.Nil(t, m.Error)
+	assert.Equal(t, KeyMigrationStepVerifyIssuance, m.Step)
+	m.Next()
+	assert.False(t, m.Completed())
+	m.Next()
+	assert.True(t, m.Completed())
+	assert.Equal(t, KeyMigrationStatusCompleted, m.Status)
+	for _, step := range m.Steps() {
+		assert.Equal(t, KeyMigrationStatusCompleted, step.Status)
+	}
+}
@@ -77,6 +77,33 @@ type Link struct {
 	IssuedClaims             int // TODO: Give a value when link redemption is implemented
 	RefreshService           *verifiable.RefreshService
 	DisplayMethod            *verifiable.DisplayMethod
+	ExternalID               *string
+	PresentationTemplate     *string
+	Payment                  *LinkPayment
+	Prerequisites            []LinkPrerequisite
+	Locale                   *string // Locale of the holder facing strings of the link when the request does not ask for one
+	Restrictions             *LinkRestrictions
+	Throttle                 *LinkThrottle
+	CredentialExtensions     *CredentialExtensions // evidence and termsOfUse sections of the credentials issued by the link
+	ResultWebhook            *LinkResultWebhook
+}
+
+// LinkResultWebhook is the url the result of every issuance of the link is posted to. The body is signed with the
+// secret.
+type LinkResultWebhook struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+}
+
+// LinkIssuanceResult is the body posted to the result webhook of a link after a credential is issued through it
+type LinkIssuanceResult struct {
+	LinkID       uuid.UUID      `json:"linkID"`
+	ExternalID   *string        `json:"externalID,omitempty"`
+	IssuerDID    string         `json:"issuerDID"`
+	UserDID      string         `json:"userDID"`
+	CredentialID uuid.UUID      `json:"credentialID"`
+	Attributes   map[string]any `json:"attributes"`
+	IssuedAt     time.Time      `json:"issuedAt"`
 }
 
 // NewLink - Constructor
@@ -109,6 +136,44 @@ func NewLink(
 	}
 }
 
+// Clone returns a new active link, with a new id and no credentials issued, with the same configuration as l
+func (l *Link) Clone() *Link {
+	clone := *l
+	clone.ID = uuid.New()
+	clone.CreatedAt = time.Time{}
+	clone.Active = true
+	clone.IssuedClaims = 0
+	clone.Prerequisites = append([]LinkPrerequisite(nil), l.Prerequisites...)
+	if l.Restrictions != nil {
+		clone.Restrictions = &LinkRestrictions{
+			IPRanges:  append([]string(nil), l.Restrictions.IPRanges...),
+			Countries: append([]string(nil), l.Restrictions.Countries...),
+		}
+	}
+	if l.Throttle != nil {
+		throttle := *l.Throttle
+		throttle.Windows = append([]LinkTimeWindow(nil), l.Throttle.Windows...)
+		clone.Throttle = &throttle
+	}
+	if l.ResultWebhook != nil {
+		webhook := *l.ResultWebhook
+		clone.ResultWebhook = &webhook
+	}
+	if l.CredentialExtensions != nil {
+		clone.CredentialExtensions = &CredentialExtensions{
+			Evidence:   append([]Evidence(nil), l.CredentialExtensions.Evidence...),
+			TermsOfUse: append([]TermsOfUse(nil), l.CredentialExtensions.TermsOfUse...),
+		}
+	}
+	if l.CredentialSubject != nil {
+		clone.CredentialSubject = make(CredentialSubject, len(l.CredentialSubject))
+		for k, v := range l.CredentialSubject {
+			clone.CredentialSubject[k] = v
+		}
+	}
+	return &clone
+}
+
 // IssuerCoreDID - return the Core DID value
 func (l *Link) IssuerCoreDID() *w3c.DID {
 	return common.ToPointer(w3c.DID(l.IssuerDID))
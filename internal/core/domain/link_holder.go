@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// LinkHolder is a holder that claimed the credential of a link with a profile DID and proved the genesis DID the
+// profile derives from. Only the profile DID and a digest of the genesis DID are stored, so the link issues one
+// credential per identity whatever the profile it is claimed with, without keeping the genesis DID of the holder.
+type LinkHolder struct {
+	LinkID    uuid.UUID
+	Digest    string
+	UserDID   string
+	ClaimID   uuid.UUID
+	CreatedAt time.Time
+}
+
+// LinkHolderDigest returns the digest of the genesis DID of a holder of the link. The link id is part of the
+// digest, so the holders of different links can't be correlated.
+func LinkHolderDigest(linkID uuid.UUID, genesisDID w3c.DID) string {
+	digest := sha256.Sum256([]byte(linkID.String() + "|" + genesisDID.String()))
+	return hex.EncodeToString(digest[:])
+}
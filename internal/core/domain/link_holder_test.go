@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkHolderDigest(t *testing.T) {
+	genesisDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	otherDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFXWZVHPy8R8AWaaCfm5PLF9uSFJgqqhmaTcwaEdQ")
+	require.NoError(t, err)
+	linkID, otherLinkID := uuid.New(), uuid.New()
+
+	digest := LinkHolderDigest(linkID, *genesisDID)
+	assert.Len(t, digest, 64)
+	assert.Equal(t, digest, LinkHolderDigest(linkID, *genesisDID))
+	assert.NotContains(t, digest, genesisDID.String())
+	assert.NotEqual(t, digest, LinkHolderDigest(otherLinkID, *genesisDID))
+	assert.NotEqual(t, digest, LinkHolderDigest(linkID, *otherDID))
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+// LinkPrerequisiteRequestIDBase is the id of the proof request of the first prerequisite of a link. The prerequisites
+// are requested along with the presentation template ones, so their ids start far enough to never collide.
+const LinkPrerequisiteRequestIDBase uint32 = 1000
+
+// LinkPrerequisite is a credential the holder must hold, and not revoked, before a link issues its credential.
+// The holder proves it with a query without conditions, so nothing about the prerequisite credential is disclosed.
+type LinkPrerequisite struct {
+	SchemaURL      string             `json:"schemaUrl"`
+	CredentialType string             `json:"credentialType"`
+	CircuitID      circuits.CircuitID `json:"circuitId"`
+	AllowedIssuers []string           `json:"allowedIssuers"`
+}
+
+// ProofRequest returns the zero knowledge proof request of the prerequisite with the given id
+func (p LinkPrerequisite) ProofRequest(id uint32) protocol.ZeroKnowledgeProofRequest {
+	return protocol.ZeroKnowledgeProofRequest{
+		ID:        id,
+		CircuitID: string(p.CircuitID),
+		Query: map[string]any{
+			"allowedIssuers": p.AllowedIssuers,
+			"context":        p.SchemaURL,
+			"type":           p.CredentialType,
+		},
+	}
+}
+
+// PrerequisiteProofRequests returns the proof requests of the link prerequisites
+func (l *Link) PrerequisiteProofRequests() []protocol.ZeroKnowledgeProofRequest {
+	requests := make([]protocol.ZeroKnowledgeProofRequest, len(l.Prerequisites))
+	for i, p := range l.Prerequisites {
+		requests[i] = p.ProofRequest(LinkPrerequisiteRequestIDBase + uint32(i))
+	}
+	return requests
+}
+
+// LinkPrerequisiteProof records the proof a holder sent to satisfy a prerequisite of a link
+type LinkPrerequisiteProof struct {
+	ID             uuid.UUID
+	IssuerDID      w3c.DID
+	LinkID         uuid.UUID
+	SessionID      uuid.UUID
+	UserDID        w3c.DID
+	SchemaURL      string
+	CredentialType string
+	Proof          protocol.ZeroKnowledgeProofResponse
+	CreatedAt      time.Time
+}
+
+// AcceptsIssuer tells whether a credential of the issuer satisfies the prerequisite
+func (p LinkPrerequisite) AcceptsIssuer(issuerDID w3c.DID) bool {
+	for _, issuer := range p.AllowedIssuers {
+		if issuer == "*" || issuer == issuerDID.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkRecipient is a recipient of a link with its own credential subject. The recipient claims the credential with
+// a one time code, so a single link issues personalized credentials to a list of recipients.
+type LinkRecipient struct {
+	ID                uuid.UUID
+	LinkID            uuid.UUID
+	Code              string
+	CredentialSubject CredentialSubject
+	ClaimID           *uuid.UUID
+	ConsumedAt        *time.Time
+	CreatedAt         time.Time
+}
+
+// NewLinkRecipient returns a recipient of the link that has not claimed its credential yet
+func NewLinkRecipient(linkID uuid.UUID, code string, credentialSubject CredentialSubject) *LinkRecipient {
+	return &LinkRecipient{
+		ID:                uuid.New(),
+		LinkID:            linkID,
+		Code:              code,
+		CredentialSubject: credentialSubject,
+		CreatedAt:         time.Now(),
+	}
+}
+
+// Consumed tells whether the code of the recipient was already used to issue its credential
+func (r *LinkRecipient) Consumed() bool {
+	return r.ConsumedAt != nil
+}
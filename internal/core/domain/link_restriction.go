@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// LinkRestrictions limits the networks and the countries a link can be claimed from, so region limited offers,
+// like event badges, can not be claimed remotely. Empty lists do not restrict anything.
+type LinkRestrictions struct {
+	IPRanges  []string `json:"ipRanges,omitempty"`  // IPRanges are the CIDR ranges of the allowed clients, i.e: 192.168.1.0/24
+	Countries []string `json:"countries,omitempty"` // Countries are the ISO 3166-1 alpha-2 codes of the allowed countries
+}
+
+// Normalize validates the restrictions, upper cases the countries and turns single ips into ranges
+func (r *LinkRestrictions) Normalize() error {
+	for i, ipRange := range r.IPRanges {
+		if ip := net.ParseIP(ipRange); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			r.IPRanges[i] = (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String()
+			continue
+		}
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			return fmt.Errorf("invalid ip range %q", ipRange)
+		}
+	}
+	for i, country := range r.Countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if len(country) != 2 || strings.Trim(country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			return fmt.Errorf("invalid country code %q", r.Countries[i])
+		}
+		r.Countries[i] = country
+	}
+	return nil
+}
+
+// Empty returns true if the restrictions do not restrict anything
+func (r *LinkRestrictions) Empty() bool {
+	return r == nil || (len(r.IPRanges) == 0 && len(r.Countries) == 0)
+}
+
+// AllowsIP returns true if there are no ip ranges or the ip is in any of them
+func (r *LinkRestrictions) AllowsIP(ip net.IP) bool {
+	if r == nil || len(r.IPRanges) == 0 {
+		return true
+	}
+	for _, ipRange := range r.IPRanges {
+		if _, network, err := net.ParseCIDR(ipRange); err == nil && ip != nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsCountry returns true if there are no countries or the country is one of them
+func (r *LinkRestrictions) AllowsCountry(country string) bool {
+	if r == nil || len(r.Countries) == 0 {
+		return true
+	}
+	for _, allowed := range r.Countries {
+		if strings.EqualFold(allowed, country) {
+			return true
+		}
+	}
+	return false
+}
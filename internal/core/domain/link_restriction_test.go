@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkRestrictions_Normalize(t *testing.T) {
+	r := LinkRestrictions{IPRanges: []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::1"}, Countries: []string{"es", " Pt "}}
+	require.NoError(t, r.Normalize())
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.10/32", "2001:db8::1/128"}, r.IPRanges)
+	assert.Equal(t, []string{"ES", "PT"}, r.Countries)
+
+	assert.Error(t, (&LinkRestrictions{IPRanges: []string{"10.0.0.0/33"}}).Normalize())
+	assert.Error(t, (&LinkRestrictions{Countries: []string{"ESP"}}).Normalize())
+	assert.Error(t, (&LinkRestrictions{Countries: []string{"E1"}}).Normalize())
+}
+
+func TestLinkRestrictions_Allows(t *testing.T) {
+	var none *LinkRestrictions
+	assert.True(t, none.Empty())
+	assert.True(t, none.AllowsIP(net.ParseIP("8.8.8.8")))
+	assert.True(t, none.AllowsCountry("US"))
+
+	r := &LinkRestrictions{IPRanges: []string{"10.0.0.0/8"}, Countries: []string{"ES"}}
+	assert.False(t, r.Empty())
+	assert.True(t, r.AllowsIP(net.ParseIP("10.1.2.3")))
+	assert.False(t, r.AllowsIP(net.ParseIP("8.8.8.8")))
+	assert.False(t, r.AllowsIP(nil))
+	assert.True(t, r.AllowsCountry("es"))
+	assert.False(t, r.AllowsCountry("US"))
+	assert.False(t, r.AllowsCountry(""))
+}
@@ -4,7 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 )
@@ -99,3 +103,53 @@ func TestLink_Status(t *testing.T) {
 		})
 	}
 }
+
+func TestLink_PrerequisiteProofRequests(t *testing.T) {
+	link := Link{Prerequisites: []LinkPrerequisite{
+		{SchemaURL: "https://example.com/kyc.jsonld", CredentialType: "KYCAgeCredential", CircuitID: circuits.AtomicQuerySigV2CircuitID, AllowedIssuers: []string{"*"}},
+		{SchemaURL: "https://example.com/employee.jsonld", CredentialType: "EmployeeCredential", CircuitID: circuits.AtomicQueryMTPV2CircuitID, AllowedIssuers: []string{"*"}},
+	}}
+	requests := link.PrerequisiteProofRequests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, LinkPrerequisiteRequestIDBase, requests[0].ID)
+	assert.Equal(t, LinkPrerequisiteRequestIDBase+1, requests[1].ID)
+	assert.Equal(t, string(circuits.AtomicQueryMTPV2CircuitID), requests[1].CircuitID)
+	assert.Equal(t, "EmployeeCredential", requests[1].Query["type"])
+	assert.NotContains(t, requests[1].Query, "credentialSubject")
+}
+
+func TestLinkPrerequisite_AcceptsIssuer(t *testing.T) {
+	issuer, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe")
+	require.NoError(t, err)
+
+	assert.True(t, LinkPrerequisite{AllowedIssuers: []string{"*"}}.AcceptsIssuer(*issuer))
+	assert.True(t, LinkPrerequisite{AllowedIssuers: []string{issuer.String()}}.AcceptsIssuer(*issuer))
+	assert.False(t, LinkPrerequisite{AllowedIssuers: []string{"did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"}}.AcceptsIssuer(*issuer))
+	assert.False(t, LinkPrerequisite{}.AcceptsIssuer(*issuer))
+}
+
+func TestLink_Clone(t *testing.T) {
+	link := Link{
+		ID:                uuid.New(),
+		CreatedAt:         time.Now(),
+		MaxIssuance:       common.ToPointer(10),
+		Active:            false,
+		IssuedClaims:      10,
+		CredentialSubject: CredentialSubject{"birthday": 19960424},
+		ExternalID:        common.ToPointer("campaign-1"),
+		ResultWebhook:     &LinkResultWebhook{URL: "https://campaigns.example.com/issuances", Secret: "9b1deb4d3b7d4bad9bdd2b0d7b3dcb6d"},
+	}
+
+	clone := link.Clone()
+	assert.NotEqual(t, link.ID, clone.ID)
+	assert.True(t, clone.Active)
+	assert.Zero(t, clone.IssuedClaims)
+	assert.True(t, clone.CreatedAt.IsZero())
+	assert.Equal(t, link.MaxIssuance, clone.MaxIssuance)
+	assert.Equal(t, link.ExternalID, clone.ExternalID)
+
+	clone.CredentialSubject["birthday"] = 20000101
+	assert.Equal(t, 19960424, link.CredentialSubject["birthday"])
+	clone.ResultWebhook.URL = "https://other.example.com"
+	assert.Equal(t, "https://campaigns.example.com/issuances", link.ResultWebhook.URL)
+}
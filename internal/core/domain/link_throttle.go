@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// LinkThrottle limits how fast and when a link can be claimed, to protect the downstream systems and the state
+// publishing from spikes, i.e: a badge link printed in a poster. Nil or empty fields do not limit anything.
+type LinkThrottle struct {
+	MaxClaimsPerHour *int             `json:"maxClaimsPerHour,omitempty"`
+	Windows          []LinkTimeWindow `json:"windows,omitempty"`  // Windows are the periods the link is active. Any period if empty
+	TimeZone         string           `json:"timeZone,omitempty"` // TimeZone is the IANA time zone of the windows. UTC by default
+}
+
+// LinkTimeWindow is a daily period, from Start to End, in HH:MM format. The window ends the next day if End is not
+// after Start.
+type LinkTimeWindow struct {
+	Days  []time.Weekday `json:"days,omitempty"` // Days the window starts on, 0 is Sunday. Every day if empty
+	Start string         `json:"start"`
+	End   string         `json:"end"`
+}
+
+// Normalize validates the throttle
+func (t *LinkThrottle) Normalize() error {
+	if t.MaxClaimsPerHour != nil && *t.MaxClaimsPerHour < 1 {
+		return fmt.Errorf("the max claims per hour must be positive")
+	}
+	if _, err := time.LoadLocation(t.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone %q", t.TimeZone)
+	}
+	for _, w := range t.Windows {
+		start, err := clockMinutes(w.Start)
+		if err != nil {
+			return err
+		}
+		end, err := clockMinutes(w.End)
+		if err != nil {
+			return err
+		}
+		if start == end {
+			return fmt.Errorf("the window %s-%s is empty", w.Start, w.End)
+		}
+		for _, day := range w.Days {
+			if day < time.Sunday || day > time.Saturday {
+				return fmt.Errorf("invalid week day %d", day)
+			}
+		}
+	}
+	return nil
+}
+
+// Empty returns true if the throttle does not limit anything
+func (t *LinkThrottle) Empty() bool {
+	return t == nil || (t.MaxClaimsPerHour == nil && len(t.Windows) == 0)
+}
+
+// Active returns true if there are no windows or now is in any of them
+func (t *LinkThrottle) Active(now time.Time) bool {
+	if t == nil || len(t.Windows) == 0 {
+		return true
+	}
+	now = now.In(t.location())
+	for _, w := range t.Windows {
+		// overnight windows that started yesterday may still be open
+		for _, offset := range []int{0, -1} {
+			start, end, ok := w.on(now, offset)
+			if ok && !now.Before(start) && now.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextActive returns the time the next window after now starts. It returns now if there are no windows.
+func (t *LinkThrottle) NextActive(now time.Time) time.Time {
+	if t == nil || len(t.Windows) == 0 {
+		return now
+	}
+	local := now.In(t.location())
+	var next time.Time
+	for _, w := range t.Windows {
+		for offset := 0; offset <= 7; offset++ {
+			start, _, ok := w.on(local, offset)
+			if ok && start.After(local) {
+				if next.IsZero() || start.Before(next) {
+					next = start
+				}
+				break
+			}
+		}
+	}
+	if next.IsZero() {
+		return now
+	}
+	return next
+}
+
+func (t *LinkThrottle) location() *time.Location {
+	loc, err := time.LoadLocation(t.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// on returns the window that starts offset days after the day of now, and false if the window does not start that day
+func (w LinkTimeWindow) on(now time.Time, offset int) (time.Time, time.Time, bool) {
+	startMinutes, err := clockMinutes(w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endMinutes, err := clockMinutes(w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	y, m, d := now.Date()
+	start := time.Date(y, m, d+offset, startMinutes/60, startMinutes%60, 0, 0, now.Location())
+	if len(w.Days) > 0 {
+		found := false
+		for _, day := range w.Days {
+			found = found || day == start.Weekday()
+		}
+		if !found {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+	if endMinutes <= startMinutes {
+		d++
+	}
+	end := time.Date(y, m, d+offset, endMinutes/60, endMinutes%60, 0, 0, now.Location())
+	return start, end, true
+}
+
+// clockMinutes returns the minutes since midnight of a HH:MM time
+func clockMinutes(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, the format is HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkThrottle_Normalize(t *testing.T) {
+	maxClaims := 10
+	require.NoError(t, (&LinkThrottle{MaxClaimsPerHour: &maxClaims, Windows: []LinkTimeWindow{{Start: "09:00", End: "17:30"}}}).Normalize())
+
+	zero := 0
+	assert.Error(t, (&LinkThrottle{MaxClaimsPerHour: &zero}).Normalize())
+	assert.Error(t, (&LinkThrottle{TimeZone: "Mars/Olympus"}).Normalize())
+	assert.Error(t, (&LinkThrottle{Windows: []LinkTimeWindow{{Start: "9am", End: "17:00"}}}).Normalize())
+	assert.Error(t, (&LinkThrottle{Windows: []LinkTimeWindow{{Start: "09:00", End: "09:00"}}}).Normalize())
+	assert.Error(t, (&LinkThrottle{Windows: []LinkTimeWindow{{Days: []time.Weekday{7}, Start: "09:00", End: "17:00"}}}).Normalize())
+}
+
+func TestLinkThrottle_Active(t *testing.T) {
+	var none *LinkThrottle
+	monday := time.Date(2024, 3, 25, 8, 0, 0, 0, time.UTC)
+	assert.True(t, none.Empty())
+	assert.True(t, none.Active(monday))
+	assert.Equal(t, monday, none.NextActive(monday))
+
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	throttle := &LinkThrottle{Windows: []LinkTimeWindow{
+		{Days: weekdays, Start: "09:00", End: "17:00"},
+		{Days: []time.Weekday{time.Saturday}, Start: "22:00", End: "02:00"},
+	}}
+	assert.False(t, throttle.Empty())
+	assert.False(t, throttle.Active(monday))
+	assert.Equal(t, monday.Add(time.Hour), throttle.NextActive(monday))
+	assert.True(t, throttle.Active(monday.Add(time.Hour)))
+	assert.False(t, throttle.Active(monday.Add(9*time.Hour)))
+
+	friday := time.Date(2024, 3, 29, 18, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 30, 22, 0, 0, 0, time.UTC), throttle.NextActive(friday))
+	assert.True(t, throttle.Active(time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC)))
+	assert.False(t, throttle.Active(time.Date(2024, 3, 31, 2, 0, 0, 0, time.UTC)))
+}
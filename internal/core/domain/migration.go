@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Migration is a database schema migration. Pending migrations are not applied yet.
+type Migration struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Duration  time.Duration // Only set for the migrations applied by the last run
+}
+
+// PendingMigrations returns the number of migrations not applied yet
+func PendingMigrations(migrations []Migration) int {
+	pending := 0
+	for _, m := range migrations {
+		if !m.Applied {
+			pending++
+		}
+	}
+	return pending
+}
+
+// CurrentMigrationVersion returns the highest version applied, or 0 if there is none
+func CurrentMigrationVersion(migrations []Migration) int64 {
+	var version int64
+	for _, m := range migrations {
+		if m.Applied && m.Version > version {
+			version = m.Version
+		}
+	}
+	return version
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrations(t *testing.T) {
+	now := time.Now()
+	migrations := []Migration{
+		{Version: 1, Name: "1_init.sql", Applied: true, AppliedAt: &now},
+		{Version: 3, Name: "3_applied_out_of_order.sql", Applied: true, AppliedAt: &now},
+		{Version: 2, Name: "2_pending.sql"},
+		{Version: 4, Name: "4_pending.sql"},
+	}
+	assert.Equal(t, 2, PendingMigrations(migrations))
+	assert.Equal(t, int64(3), CurrentMigrationVersion(migrations))
+	assert.Equal(t, 0, PendingMigrations(nil))
+	assert.Equal(t, int64(0), CurrentMigrationVersion(migrations[2:]))
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// verifiablePresentationType is the type of the W3C verifiable presentations
+const verifiablePresentationType = "VerifiablePresentation"
+
+// OrganizationCredential is a credential a third party issued to the issuer identity, e.g. an accreditation. The issuer
+// holds it and presents it to the verifiers that require the issuer to be eligible. Only public credentials are presented.
+// The document is kept as received, so the proofs of the issuer stay valid whatever the fields of the credential.
+type OrganizationCredential struct {
+	ID         uuid.UUID
+	IssuerDID  w3c.DID
+	Document   json.RawMessage
+	Credential verifiable.W3CCredential
+	Public     bool
+	CreatedAt  time.Time
+}
+
+// NewOrganizationCredential parses the document of a credential received by the issuer
+func NewOrganizationCredential(issuerDID w3c.DID, document json.RawMessage, public bool) (*OrganizationCredential, error) {
+	credential := &OrganizationCredential{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Document:  document,
+		Public:    public,
+		CreatedAt: time.Now(),
+	}
+	if err := json.Unmarshal(document, &credential.Credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// Type returns the most specific type of the credential
+func (c *OrganizationCredential) Type() string {
+	if len(c.Credential.Type) == 0 {
+		return ""
+	}
+	return c.Credential.Type[len(c.Credential.Type)-1]
+}
+
+// Expired tells whether the credential expired at the given time
+func (c *OrganizationCredential) Expired(now time.Time) bool {
+	return c.Credential.Expiration != nil && !c.Credential.Expiration.After(now)
+}
+
+// OrganizationPresentation is the W3C presentation of the organization credentials of the issuer. It is not signed,
+// the verifiers check the proofs of the credentials and that their subject is the holder.
+type OrganizationPresentation struct {
+	Context              []string          `json:"@context"`
+	Type                 []string          `json:"type"`
+	Holder               string            `json:"holder"`
+	VerifiableCredential []json.RawMessage `json:"verifiableCredential"`
+}
+
+// NewOrganizationPresentation returns the presentation of the credentials held by the issuer
+func NewOrganizationPresentation(issuerDID w3c.DID, credentials []OrganizationCredential) *OrganizationPresentation {
+	vcs := make([]json.RawMessage, len(credentials))
+	for i := range credentials {
+		vcs[i] = credentials[i].Document
+	}
+	return &OrganizationPresentation{
+		Context:              []string{verifiable.JSONLDSchemaW3CCredential2018},
+		Type:                 []string{verifiablePresentationType},
+		Holder:               issuerDID.String(),
+		VerifiableCredential: vcs,
+	}
+}
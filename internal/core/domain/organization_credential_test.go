@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationCredential(t *testing.T) {
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	document := json.RawMessage(`{
+		"id": "urn:uuid:5d6f3b3e-4c5a-4e0a-9d6c-2f1d1b0a7e11",
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiableCredential", "AccreditedIssuer"],
+		"issuer": "did:web:accreditation.example.com",
+		"expirationDate": "2030-01-01T00:00:00Z",
+		"credentialSubject": {"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"},
+		"evidence": [{"type": "Audit"}],
+		"proof": {"type": "Ed25519Signature2020", "proofValue": "z58DAdFfa9"}
+	}`)
+
+	credential, err := NewOrganizationCredential(*issuerDID, document, true)
+	require.NoError(t, err)
+	assert.Equal(t, "AccreditedIssuer", credential.Type())
+	assert.Equal(t, "did:web:accreditation.example.com", credential.Credential.Issuer)
+	assert.Len(t, credential.Credential.Proof, 1)
+	assert.False(t, credential.Expired(time.Date(2029, 12, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, credential.Expired(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	presentation := NewOrganizationPresentation(*issuerDID, []OrganizationCredential{*credential})
+	assert.Equal(t, []string{"VerifiablePresentation"}, presentation.Type)
+	assert.Equal(t, issuerDID.String(), presentation.Holder)
+	require.Len(t, presentation.VerifiableCredential, 1)
+	assert.JSONEq(t, string(document), string(presentation.VerifiableCredential[0]), "the credential is presented as received")
+
+	_, err = NewOrganizationCredential(*issuerDID, json.RawMessage(`{"type": "not an array"}`), false)
+	assert.Error(t, err)
+}
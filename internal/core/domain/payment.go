@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	PaymentRequestTypeCryptoV1 = "Iden3PaymentRequestCryptoV1" // PaymentRequestTypeCryptoV1 type of the on-chain payment requests sent to the holders
+	PaymentDataTypeCryptoV1    = "Iden3PaymentCryptoV1"        // PaymentDataTypeCryptoV1 type of the data of the on-chain payment requests
+)
+
+// PaymentStatus is the status of a payment requested to a holder
+type PaymentStatus string
+
+const (
+	PaymentStatusPending PaymentStatus = "pending" // PaymentStatusPending the holder has not paid yet
+	PaymentStatusPaid    PaymentStatus = "paid"    // PaymentStatusPaid the payment transaction has been verified
+)
+
+// PaymentToken is a token the holders can use to pay for a credential
+type PaymentToken struct {
+	Currency string `json:"currency"`
+	// Address of the ERC-20 contract of the token. Empty for the native currency of the chain.
+	Address string `json:"address,omitempty"`
+}
+
+// IsNative returns true if the token is the native currency of the chain
+func (t PaymentToken) IsNative() bool {
+	return t.Address == ""
+}
+
+// LinkPayment is the payment a holder has to make before a credential is issued through a link
+type LinkPayment struct {
+	// Amount is the price in the smallest unit of the token, e.g. wei
+	Amount      string         `json:"amount"`
+	ChainID     int            `json:"chainId"`
+	Recipient   string         `json:"recipient"`
+	Tokens      []PaymentToken `json:"tokens"`
+	Description string         `json:"description,omitempty"`
+}
+
+// Payment is a payment requested to a holder for a credential link. A payment is created for each accepted token
+// and the holder pays only one of them.
+type Payment struct {
+	ID        uuid.UUID
+	IssuerDID w3c.DID
+	LinkID    uuid.UUID
+	SessionID uuid.UUID
+	UserDID   w3c.DID
+	ChainID   int
+	Recipient string
+	Amount    string
+	Token     PaymentToken
+	Status    PaymentStatus
+	TxID      *string
+	CreatedAt time.Time
+	PaidAt    *time.Time
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// PolicyAction is the operation the policy engine is consulted for
+type PolicyAction string
+
+const (
+	PolicyActionCreateCredential PolicyAction = "createCredential" // PolicyActionCreateCredential a credential is created through the api
+	PolicyActionLinkCallback     PolicyAction = "linkCallback"     // PolicyActionLinkCallback a credential is issued to a holder that scanned a link
+)
+
+// PolicyEffect is the decision of the policy engine
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow  PolicyEffect = "allow"  // PolicyEffectAllow the credential is issued as requested
+	PolicyEffectDeny   PolicyEffect = "deny"   // PolicyEffectDeny the credential is not issued
+	PolicyEffectModify PolicyEffect = "modify" // PolicyEffectModify the credential is issued with the attributes and expiration of the decision
+)
+
+// PolicyInput is the document the policy engine evaluates before a credential is issued
+type PolicyInput struct {
+	Action     PolicyAction      `json:"action"`
+	Issuer     string            `json:"issuer"`
+	Subject    string            `json:"subject,omitempty"`
+	Schema     PolicySchema      `json:"schema"`
+	Attributes map[string]any    `json:"attributes"`
+	Expiration *time.Time        `json:"expiration,omitempty"`
+	Requester  PolicyRequester   `json:"requester"`
+	Connection *PolicyConnection `json:"connection,omitempty"`
+}
+
+// PolicySchema is the schema of the requested credential
+type PolicySchema struct {
+	URL  string `json:"url"`
+	Type string `json:"type"`
+}
+
+// PolicyRequester is who requested the credential. Role is the role of the api user or holder for link callbacks.
+type PolicyRequester struct {
+	Role string `json:"role"`
+}
+
+// PolicyConnection is the history of the connection between the issuer and the subject of the credential
+type PolicyConnection struct {
+	CreatedAt           time.Time  `json:"createdAt"`
+	LastVerifiedAt      *time.Time `json:"lastVerifiedAt,omitempty"`
+	CredentialsOfSchema int        `json:"credentialsOfSchema"`
+}
+
+// PolicyDecision is the answer of the policy engine. Attributes and Expiration replace the requested ones when the
+// effect is modify.
+type PolicyDecision struct {
+	Effect     PolicyEffect
+	Reason     string
+	Attributes map[string]any
+	Expiration *time.Time
+}
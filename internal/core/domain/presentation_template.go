@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+// PresentationOperator is a comparison operator allowed in the presentation template conditions
+type PresentationOperator string
+
+const (
+	PresentationOperatorEq  PresentationOperator = "$eq"  // PresentationOperatorEq the field must be equal to the value
+	PresentationOperatorNe  PresentationOperator = "$ne"  // PresentationOperatorNe the field must not be equal to the value
+	PresentationOperatorLt  PresentationOperator = "$lt"  // PresentationOperatorLt the field must be less than the value
+	PresentationOperatorGt  PresentationOperator = "$gt"  // PresentationOperatorGt the field must be greater than the value
+	PresentationOperatorIn  PresentationOperator = "$in"  // PresentationOperatorIn the field must be one of the values
+	PresentationOperatorNin PresentationOperator = "$nin" // PresentationOperatorNin the field must not be any of the values
+)
+
+// PresentationOperators are all the operators allowed in the presentation templates
+var PresentationOperators = []PresentationOperator{
+	PresentationOperatorEq,
+	PresentationOperatorNe,
+	PresentationOperatorLt,
+	PresentationOperatorGt,
+	PresentationOperatorIn,
+	PresentationOperatorNin,
+}
+
+// IsValid returns true if the operator is one of the allowed operators
+func (o PresentationOperator) IsValid() bool {
+	for _, op := range PresentationOperators {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// IsList returns true if the operator compares the field against a list of values
+func (o PresentationOperator) IsList() bool {
+	return o == PresentationOperatorIn || o == PresentationOperatorNin
+}
+
+// PresentationCondition is a condition the holder must prove about a field of the credential without revealing it
+type PresentationCondition struct {
+	Field    string               `json:"field"`
+	Operator PresentationOperator `json:"operator"`
+	Value    any                  `json:"value"`
+}
+
+// PresentationTemplate is a named proof request that the credential links reference, so the issuer only has
+// to pick the schema, the fields and the operators instead of writing the zero knowledge query.
+type PresentationTemplate struct {
+	ID              uuid.UUID
+	IssuerDID       w3c.DID
+	Name            string
+	Description     *string
+	SchemaURL       string
+	CredentialType  string
+	CircuitID       circuits.CircuitID
+	AllowedIssuers  []string
+	Conditions      []PresentationCondition
+	DisclosedFields []string
+	CreatedAt       time.Time
+}
+
+// ProofRequests returns the zero knowledge proof requests of the template. The zk circuits check a single field
+// per query, so a request is built for each condition and each disclosed field.
+func (t *PresentationTemplate) ProofRequests() []protocol.ZeroKnowledgeProofRequest {
+	subjects := make([]map[string]any, 0, len(t.Conditions)+len(t.DisclosedFields))
+	for _, cond := range t.Conditions {
+		subjects = append(subjects, map[string]any{cond.Field: map[string]any{string(cond.Operator): cond.Value}})
+	}
+	for _, field := range t.DisclosedFields {
+		subjects = append(subjects, map[string]any{field: map[string]any{}})
+	}
+
+	requests := make([]protocol.ZeroKnowledgeProofRequest, len(subjects))
+	for i, subject := range subjects {
+		requests[i] = protocol.ZeroKnowledgeProofRequest{
+			ID:        uint32(i + 1),
+			CircuitID: string(t.CircuitID),
+			Query: map[string]any{
+				"allowedIssuers":    t.AllowedIssuers,
+				"context":           t.SchemaURL,
+				"type":              t.CredentialType,
+				"credentialSubject": subject,
+			},
+		}
+	}
+	return requests
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/iden3/go-circuits/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresentationTemplate_ProofRequests(t *testing.T) {
+	template := PresentationTemplate{
+		SchemaURL:      "https://schemas.example.com/kyc.jsonld",
+		CredentialType: "KYCAgeCredential",
+		CircuitID:      circuits.AtomicQuerySigV2CircuitID,
+		AllowedIssuers: []string{"*"},
+		Conditions: []PresentationCondition{
+			{Field: "birthday", Operator: PresentationOperatorLt, Value: 20060101},
+			{Field: "documentType", Operator: PresentationOperatorIn, Value: []any{1, 2}},
+		},
+		DisclosedFields: []string{"country"},
+	}
+
+	requests := template.ProofRequests()
+	require.Len(t, requests, 3)
+	for i, req := range requests {
+		assert.Equal(t, uint32(i+1), req.ID)
+		assert.Equal(t, string(circuits.AtomicQuerySigV2CircuitID), req.CircuitID)
+		assert.Equal(t, []string{"*"}, req.Query["allowedIssuers"])
+		assert.Equal(t, "https://schemas.example.com/kyc.jsonld", req.Query["context"])
+		assert.Equal(t, "KYCAgeCredential", req.Query["type"])
+	}
+	assert.Equal(t, map[string]any{"birthday": map[string]any{"$lt": 20060101}}, requests[0].Query["credentialSubject"])
+	assert.Equal(t, map[string]any{"documentType": map[string]any{"$in": []any{1, 2}}}, requests[1].Query["credentialSubject"])
+	assert.Equal(t, map[string]any{"country": map[string]any{}}, requests[2].Query["credentialSubject"])
+}
+
+func TestPresentationOperator_IsValid(t *testing.T) {
+	for _, op := range PresentationOperators {
+		assert.True(t, op.IsValid())
+	}
+	assert.False(t, PresentationOperator("$regex").IsValid())
+	assert.True(t, PresentationOperatorNin.IsList())
+	assert.False(t, PresentationOperatorEq.IsList())
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// QRErrorCorrection is the error correction level used to render QR codes
+type QRErrorCorrection string
+
+// Error correction levels. Higher levels allow embedding bigger logos at the cost of denser QR codes.
+const (
+	QRErrorCorrectionLow     QRErrorCorrection = "L"
+	QRErrorCorrectionMedium  QRErrorCorrection = "M"
+	QRErrorCorrectionHigh    QRErrorCorrection = "Q"
+	QRErrorCorrectionHighest QRErrorCorrection = "H"
+)
+
+// QRBranding holds the options used by an issuer to render its QR codes
+type QRBranding struct {
+	IssuerDID       w3c.DID
+	Logo            []byte
+	ForegroundColor string
+	BackgroundColor string
+	ErrorCorrection QRErrorCorrection
+	ModifiedAt      time.Time
+}
+
+// NewDefaultQRBranding returns the branding used by issuers that did not configure one
+func NewDefaultQRBranding(issuerDID w3c.DID) *QRBranding {
+	return &QRBranding{
+		IssuerDID:       issuerDID,
+		ForegroundColor: "#000000",
+		BackgroundColor: "#FFFFFF",
+		ErrorCorrection: QRErrorCorrectionMedium,
+	}
+}
+
+// QRSnippet is the content of an html snippet with a qr code, meant to be embedded in emails
+type QRSnippet struct {
+	IssuerName string
+	Title      string
+	Link       string
+	ExpiresAt  *time.Time
+}
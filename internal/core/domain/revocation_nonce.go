@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevocationNonceRangesStart is the first nonce of the reserved ranges. The random and the sequential nonces are
+// below it, so the nonces generated by the issuer never fall in a reserved range.
+const RevocationNonceRangesStart uint64 = 1 << 32
+
+// RevocationNonceRange is a range of revocation nonces reserved for an integration that generates its own claims.
+// Start and End are inclusive. Next is the next nonce the issuer hands out from the range when a credential is issued
+// with it, End+1 once the range is exhausted.
+type RevocationNonceRange struct {
+	ID          uuid.UUID
+	IssuerDID   string
+	Integration string
+	Start       RevNonceUint64
+	End         RevNonceUint64
+	Next        RevNonceUint64
+	CreatedAt   time.Time
+}
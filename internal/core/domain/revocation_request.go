@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// RevocationRequestStatus is the status of a revocation request sent by a holder
+type RevocationRequestStatus string
+
+const (
+	RevocationRequestStatusPending  RevocationRequestStatus = "pending"  // RevocationRequestStatusPending waits for the operator
+	RevocationRequestStatusApproved RevocationRequestStatus = "approved" // RevocationRequestStatusApproved the credential was revoked
+	RevocationRequestStatusRejected RevocationRequestStatus = "rejected" // RevocationRequestStatusRejected the credential was kept
+)
+
+// RevocationRequest is the request of a credential subject to revoke their own credential, i.e: because the device
+// holding it was lost. Depending on the self revocation policy of the schema, it is approved as soon as it is sent or
+// it waits for the operator.
+type RevocationRequest struct {
+	ID           uuid.UUID
+	IssuerDID    w3c.DID
+	CredentialID uuid.UUID
+	UserDID      w3c.DID
+	Reason       string
+	Status       RevocationRequestStatus
+	// AutoApproved is true if the request was approved by the schema policy instead of the operator
+	AutoApproved bool
+	// Note is the explanation of the operator when the request is resolved
+	Note       *string
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+// NewRevocationRequest returns a new pending revocation request of the holder
+func NewRevocationRequest(issuerDID w3c.DID, credentialID uuid.UUID, userDID w3c.DID, reason string) *RevocationRequest {
+	return &RevocationRequest{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		CredentialID: credentialID,
+		UserDID:      userDID,
+		Reason:       reason,
+		Status:       RevocationRequestStatusPending,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// Resolve sets the final status of the request
+func (r *RevocationRequest) Resolve(status RevocationRequestStatus, note *string, now time.Time) {
+	r.Status = status
+	r.Note = note
+	r.ResolvedAt = &now
+}
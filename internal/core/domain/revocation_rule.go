@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// RevocationRule revokes on a schedule every non revoked credential of a schema type issued before each run, i.e:
+// every StudentID credential each August 31st. The schedule is a cron expression evaluated in UTC.
+type RevocationRule struct {
+	ID             uuid.UUID
+	IssuerDID      w3c.DID
+	Name           string
+	SchemaType     string
+	CronExpression string
+	WebhookURL     *string
+	Enabled        bool
+	NextRunAt      *time.Time
+	LastRunAt      *time.Time
+	LastRevoked    int
+	CreatedAt      time.Time
+}
+
+// NewRevocationRule returns a new enabled revocation rule of the issuer
+func NewRevocationRule(issuerDID w3c.DID, name string, schemaType string, cronExpression string, webhookURL *string) *RevocationRule {
+	return &RevocationRule{
+		ID:             uuid.New(),
+		IssuerDID:      issuerDID,
+		Name:           name,
+		SchemaType:     schemaType,
+		CronExpression: cronExpression,
+		WebhookURL:     webhookURL,
+		Enabled:        true,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+// RevocationRuleCredential is a credential a revocation rule revokes
+type RevocationRuleCredential struct {
+	ID       uuid.UUID
+	RevNonce uint64
+}
+
+// RevocationRulePreview is the dry run of a revocation rule: the credentials it would revoke if it ran now
+type RevocationRulePreview struct {
+	Rule          *RevocationRule
+	CredentialIDs []uuid.UUID
+	NextRuns      []time.Time
+}
+
+// RevocationRuleRun is the notification sent to the webhook of a revocation rule after each run
+type RevocationRuleRun struct {
+	RuleID     uuid.UUID   `json:"ruleId"`
+	Name       string      `json:"name"`
+	SchemaType string      `json:"schemaType"`
+	RunAt      time.Time   `json:"runAt"`
+	RevokedIDs []uuid.UUID `json:"revokedIds"`
+	FailedIDs  []uuid.UUID `json:"failedIds"`
+	NextRunAt  *time.Time  `json:"nextRunAt,omitempty"`
+}
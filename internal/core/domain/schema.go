@@ -31,6 +31,36 @@ const (
 	JSON SchemaFormat = "json"
 )
 
+// SchemaUniqueStrategy defines what happens when a credential is issued with the unique key of an active one
+type SchemaUniqueStrategy string
+
+const (
+	// SchemaUniqueStrategyReject rejects the new credential
+	SchemaUniqueStrategyReject SchemaUniqueStrategy = "reject"
+
+	// SchemaUniqueStrategyRevoke revokes the active credentials with the same unique key
+	SchemaUniqueStrategyRevoke SchemaUniqueStrategy = "revoke"
+)
+
+// SchemaSelfRevocation defines how the revocation requests sent by the holders of the credentials are handled
+type SchemaSelfRevocation string
+
+const (
+	// SchemaSelfRevocationDisabled rejects the revocation requests of the holders. It is the default.
+	SchemaSelfRevocationDisabled SchemaSelfRevocation = "disabled"
+
+	// SchemaSelfRevocationApproval keeps the revocation requests pending until the operator approves or rejects them
+	SchemaSelfRevocationApproval SchemaSelfRevocation = "approval"
+
+	// SchemaSelfRevocationAuto revokes the credentials as soon as their holders request it
+	SchemaSelfRevocationAuto SchemaSelfRevocation = "auto"
+)
+
+// Valid returns true if the self revocation policy is known
+func (p SchemaSelfRevocation) Valid() bool {
+	return p == SchemaSelfRevocationDisabled || p == SchemaSelfRevocationApproval || p == SchemaSelfRevocationAuto
+}
+
 // SchemaWords is a collection of schema attributes
 type SchemaWords []string
 
@@ -67,4 +97,93 @@ type Schema struct {
 	Hash        core.SchemaHash
 	Words       SchemaWords
 	CreatedAt   time.Time
+	// DefaultExpirationDays is the lifetime of the credentials issued without expiration
+	DefaultExpirationDays *int
+	// MaxExpirationDays is the maximum lifetime of the credentials, from the time they are issued
+	MaxExpirationDays *int
+	// UniqueAttributes are the credentialSubject attributes that identify a single active credential of the schema
+	UniqueAttributes []string
+	// UniqueStrategy is applied to the active credentials with the same unique key as a new one
+	UniqueStrategy SchemaUniqueStrategy
+	// SelfRevocation is the policy applied to the revocation requests of the holders
+	SelfRevocation SchemaSelfRevocation
+	// WebhookTemplate reshapes the bodies posted to the webhooks about the credentials of the schema
+	WebhookTemplate WebhookTemplate
+}
+
+// SelfRevocationPolicy returns the self revocation policy of the schema, disabled if it is not set
+func (s *Schema) SelfRevocationPolicy() SchemaSelfRevocation {
+	if s == nil || s.SelfRevocation == "" {
+		return SchemaSelfRevocationDisabled
+	}
+	return s.SelfRevocation
+}
+
+// ValidExpiration returns true if the expiration days are positive and the default does not exceed the maximum
+func (s *Schema) ValidExpiration() bool {
+	if s.DefaultExpirationDays != nil && *s.DefaultExpirationDays <= 0 {
+		return false
+	}
+	if s.MaxExpirationDays != nil && *s.MaxExpirationDays <= 0 {
+		return false
+	}
+	if s.DefaultExpirationDays != nil && s.MaxExpirationDays != nil && *s.DefaultExpirationDays > *s.MaxExpirationDays {
+		return false
+	}
+	return true
+}
+
+// CredentialExpiration returns the expiration of a credential of the schema issued at now with the requested
+// expiration. Without a requested expiration, the default one applies and, without default, the maximum one.
+// It returns false if the requested expiration exceeds the maximum.
+func (s *Schema) CredentialExpiration(requested *time.Time, now time.Time) (*time.Time, bool) {
+	expiration := requested
+	if expiration == nil && s.DefaultExpirationDays != nil {
+		defaultExpiration := now.AddDate(0, 0, *s.DefaultExpirationDays)
+		expiration = &defaultExpiration
+	}
+	if s.MaxExpirationDays == nil {
+		return expiration, true
+	}
+	maxExpiration := now.AddDate(0, 0, *s.MaxExpirationDays)
+	if expiration == nil {
+		return &maxExpiration, true
+	}
+	if expiration.After(maxExpiration) {
+		return nil, false
+	}
+	return expiration, true
+}
+
+// ValidUniqueness returns true if the unique attributes are not empty nor repeated and the strategy is known.
+// A schema without unique attributes cannot have a strategy.
+func (s *Schema) ValidUniqueness() bool {
+	if len(s.UniqueAttributes) == 0 {
+		return s.UniqueStrategy == ""
+	}
+	seen := make(map[string]bool, len(s.UniqueAttributes))
+	for _, attr := range s.UniqueAttributes {
+		if attr == "" || seen[attr] {
+			return false
+		}
+		seen[attr] = true
+	}
+	return s.UniqueStrategy == SchemaUniqueStrategyReject || s.UniqueStrategy == SchemaUniqueStrategyRevoke
+}
+
+// UniqueKey returns the values of the unique attributes in the credential subject.
+// It returns false if the schema has no unique attributes or the subject lacks any of them.
+func (s *Schema) UniqueKey(credentialSubject map[string]any) (map[string]any, bool) {
+	if len(s.UniqueAttributes) == 0 {
+		return nil, false
+	}
+	key := make(map[string]any, len(s.UniqueAttributes))
+	for _, attr := range s.UniqueAttributes {
+		value, ok := credentialSubject[attr]
+		if !ok || value == nil {
+			return nil, false
+		}
+		key[attr] = value
+	}
+	return key, true
 }
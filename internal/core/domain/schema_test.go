@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestSchema_CredentialExpiration(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	inAMonth := now.AddDate(0, 1, 0)
+	inTwoYears := now.AddDate(2, 0, 0)
+
+	t.Run("should keep the requested expiration without settings", func(t *testing.T) {
+		schema := Schema{}
+		expiration, ok := schema.CredentialExpiration(nil, now)
+		assert.True(t, ok)
+		assert.Nil(t, expiration)
+	})
+
+	t.Run("should apply the default expiration", func(t *testing.T) {
+		schema := Schema{DefaultExpirationDays: common.ToPointer(365)}
+		expiration, ok := schema.CredentialExpiration(nil, now)
+		assert.True(t, ok)
+		assert.Equal(t, now.AddDate(0, 0, 365), *expiration)
+
+		expiration, ok = schema.CredentialExpiration(&inAMonth, now)
+		assert.True(t, ok)
+		assert.Equal(t, inAMonth, *expiration)
+	})
+
+	t.Run("should enforce the maximum expiration", func(t *testing.T) {
+		schema := Schema{MaxExpirationDays: common.ToPointer(365)}
+		expiration, ok := schema.CredentialExpiration(nil, now)
+		assert.True(t, ok)
+		assert.Equal(t, now.AddDate(0, 0, 365), *expiration)
+
+		expiration, ok = schema.CredentialExpiration(&inAMonth, now)
+		assert.True(t, ok)
+		assert.Equal(t, inAMonth, *expiration)
+
+		_, ok = schema.CredentialExpiration(&inTwoYears, now)
+		assert.False(t, ok)
+	})
+}
+
+func TestSchema_ValidExpiration(t *testing.T) {
+	assert.True(t, (&Schema{}).ValidExpiration())
+	assert.True(t, (&Schema{DefaultExpirationDays: common.ToPointer(30), MaxExpirationDays: common.ToPointer(30)}).ValidExpiration())
+	assert.False(t, (&Schema{DefaultExpirationDays: common.ToPointer(0)}).ValidExpiration())
+	assert.False(t, (&Schema{MaxExpirationDays: common.ToPointer(-1)}).ValidExpiration())
+	assert.False(t, (&Schema{DefaultExpirationDays: common.ToPointer(60), MaxExpirationDays: common.ToPointer(30)}).ValidExpiration())
+}
+
+func TestSchema_ValidUniqueness(t *testing.T) {
+	assert.True(t, (&Schema{}).ValidUniqueness())
+	assert.True(t, (&Schema{UniqueAttributes: []string{"employeeId"}, UniqueStrategy: SchemaUniqueStrategyReject}).ValidUniqueness())
+	assert.True(t, (&Schema{UniqueAttributes: []string{"employeeId", "company"}, UniqueStrategy: SchemaUniqueStrategyRevoke}).ValidUniqueness())
+	assert.False(t, (&Schema{UniqueStrategy: SchemaUniqueStrategyReject}).ValidUniqueness())
+	assert.False(t, (&Schema{UniqueAttributes: []string{"employeeId"}}).ValidUniqueness())
+	assert.False(t, (&Schema{UniqueAttributes: []string{"employeeId"}, UniqueStrategy: "replace"}).ValidUniqueness())
+	assert.False(t, (&Schema{UniqueAttributes: []string{"employeeId", "employeeId"}, UniqueStrategy: SchemaUniqueStrategyReject}).ValidUniqueness())
+	assert.False(t, (&Schema{UniqueAttributes: []string{""}, UniqueStrategy: SchemaUniqueStrategyReject}).ValidUniqueness())
+}
+
+func TestSchema_UniqueKey(t *testing.T) {
+	schema := Schema{UniqueAttributes: []string{"employeeId", "company"}, UniqueStrategy: SchemaUniqueStrategyReject}
+
+	key, ok := schema.UniqueKey(map[string]any{"id": "did:iden3:1", "employeeId": "E-1", "company": "ACME", "role": "admin"})
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{"employeeId": "E-1", "company": "ACME"}, key)
+
+	_, ok = schema.UniqueKey(map[string]any{"employeeId": "E-1"})
+	assert.False(t, ok)
+
+	_, ok = (&Schema{}).UniqueKey(map[string]any{"employeeId": "E-1"})
+	assert.False(t, ok)
+}
+
+func TestSchema_SelfRevocationPolicy(t *testing.T) {
+	var schema *Schema
+	assert.Equal(t, SchemaSelfRevocationDisabled, schema.SelfRevocationPolicy())
+	assert.Equal(t, SchemaSelfRevocationDisabled, (&Schema{}).SelfRevocationPolicy())
+	assert.Equal(t, SchemaSelfRevocationAuto, (&Schema{SelfRevocation: SchemaSelfRevocationAuto}).SelfRevocationPolicy())
+
+	assert.True(t, SchemaSelfRevocationApproval.Valid())
+	assert.False(t, SchemaSelfRevocation("always").Valid())
+	assert.False(t, SchemaSelfRevocation("").Valid())
+}
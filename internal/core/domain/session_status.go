@@ -0,0 +1,19 @@
+package domain
+
+// SessionType is the kind of flow followed by a session
+type SessionType string
+
+// Session types
+const (
+	SessionTypeAuthentication SessionType = "authentication"
+	SessionTypeLink           SessionType = "link"
+	SessionTypeCredential     SessionType = "credential"
+)
+
+// SessionStatus is the status of an authentication session, a link session or a credential fetch
+type SessionStatus struct {
+	Type       SessionType
+	Status     string
+	Message    string
+	QRCodeLink *string
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// State anchor types
+const (
+	StateAnchorEthereum       = "ethereum"       // StateAnchorEthereum the state is the data of a transaction in a secondary chain
+	StateAnchorOpenTimestamps = "opentimestamps" // StateAnchorOpenTimestamps the state is timestamped by an OpenTimestamps calendar
+)
+
+// StateCheckpoint is the anchor of a published state of the issuer in a secondary chain or timestamping service, so
+// the state can be verified even if the state contract of the main chain is no longer available
+type StateCheckpoint struct {
+	ID         uuid.UUID
+	IssuerDID  w3c.DID
+	State      string
+	AnchorType string
+	// Reference is the transaction of the ethereum anchors or the calendar of the OpenTimestamps ones
+	Reference string
+	// Receipt is the OpenTimestamps timestamp returned by the calendar, that the verifiers upgrade to the bitcoin
+	// attestation. Empty for the ethereum anchors.
+	Receipt   []byte
+	CreatedAt time.Time
+}
+
+// NewStateCheckpoint returns a new checkpoint of the state of the issuer
+func NewStateCheckpoint(issuerDID w3c.DID, state string, anchorType string, reference string, receipt []byte) *StateCheckpoint {
+	return &StateCheckpoint{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		State:      state,
+		AnchorType: anchorType,
+		Reference:  reference,
+		Receipt:    receipt,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
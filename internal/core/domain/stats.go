@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// DailyCount is the number of events that happened in a day
+type DailyCount struct {
+	Day   time.Time
+	Count int
+}
+
+// MonthlyGasSpend is the spend of the state transitions confirmed in a month. TxFee is in wei and TxFeeFiat only adds
+// up the fees converted to the fiat currency of the stats.
+type MonthlyGasSpend struct {
+	Month            time.Time
+	StateTransitions int
+	GasUsed          int64
+	TxFee            string
+	TxFeeFiat        string
+}
+
+// Stats are the aggregates shown in the issuer dashboards. The daily and monthly series cover the last days and
+// months, oldest first, and include the days and months without events.
+type Stats struct {
+	CredentialsIssued       int
+	CredentialsRevoked      int
+	ActiveLinks             int
+	Connections             int
+	PendingCredentials      int
+	PendingRevocations      int
+	PendingStates           int
+	CredentialsIssuedPerDay []DailyCount
+	ConnectionsPerDay       []DailyCount
+	FiatCurrency            *string
+	GasSpendPerMonth        []MonthlyGasSpend
+}
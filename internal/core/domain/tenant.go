@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tenant is an organization served by the node. Tenants own identities, and through them schemas, links and
+// connections.
+type Tenant struct {
+	ID         uuid.UUID
+	Name       string
+	APIKeyHash string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// Keys of the holder facing strings that can be translated
+const (
+	TranslationKeyIssuerName        = "issuer.name"        // TranslationKeyIssuerName display name of the issuer
+	TranslationKeyIssuerDescription = "issuer.description" // TranslationKeyIssuerDescription description of the issuer shown to holders
+
+	TranslationKeyLandingInstructions   = "landing.instructions"   // TranslationKeyLandingInstructions scan instructions, {credential} is replaced by the credential type
+	TranslationKeyLandingOpenWallet     = "landing.openWallet"     // TranslationKeyLandingOpenWallet text of the deep link button
+	TranslationKeyLandingWaiting        = "landing.waiting"        // TranslationKeyLandingWaiting shown while waiting for the wallet
+	TranslationKeyLandingPendingPublish = "landing.pendingPublish" // TranslationKeyLandingPendingPublish shown while the credential state is published
+	TranslationKeyLandingPendingPayment = "landing.pendingPayment" // TranslationKeyLandingPendingPayment shown while waiting for the payment
+	TranslationKeyLandingReady          = "landing.ready"          // TranslationKeyLandingReady shown when the credential offer is ready
+	TranslationKeyErrorLinkNotFound     = "error.linkNotFound"     // TranslationKeyErrorLinkNotFound the link does not exist
+	TranslationKeyErrorLinkNotAvailable = "error.linkNotAvailable" // TranslationKeyErrorLinkNotAvailable the link expired, is inactive or reached its max issuance
+	TranslationKeyErrorLinkRestricted   = "error.linkRestricted"   // TranslationKeyErrorLinkRestricted the link can not be claimed from the location of the holder
+	TranslationKeyErrorLinkThrottled    = "error.linkThrottled"    // TranslationKeyErrorLinkThrottled the link reached its claims per hour or is out of its time windows
+	TranslationKeyErrorSessionFailed    = "error.sessionFailed"    // TranslationKeyErrorSessionFailed the link session failed
+	TranslationKeyErrorUnexpected       = "error.unexpected"       // TranslationKeyErrorUnexpected any other error
+)
+
+// Prefixes of the keys translated per credential type
+const (
+	translationKeyOfferDescriptionPrefix      = "offer.description."
+	translationKeyCredentialTitlePrefix       = "credential.title."
+	translationKeyCredentialDescriptionPrefix = "credential.description."
+)
+
+// TranslationKeys are the fixed keys that can be translated. The offer and credential keys are suffixed with the
+// credential type, see OfferDescriptionKey, CredentialTitleKey and CredentialDescriptionKey.
+var TranslationKeys = []string{
+	TranslationKeyIssuerName,
+	TranslationKeyIssuerDescription,
+	TranslationKeyLandingInstructions,
+	TranslationKeyLandingOpenWallet,
+	TranslationKeyLandingWaiting,
+	TranslationKeyLandingPendingPublish,
+	TranslationKeyLandingPendingPayment,
+	TranslationKeyLandingReady,
+	TranslationKeyErrorLinkNotFound,
+	TranslationKeyErrorLinkNotAvailable,
+	TranslationKeyErrorLinkRestricted,
+	TranslationKeyErrorLinkThrottled,
+	TranslationKeyErrorSessionFailed,
+	TranslationKeyErrorUnexpected,
+}
+
+// OfferDescriptionKey returns the key of the description of the credential offers of the given credential type
+func OfferDescriptionKey(credentialType string) string {
+	return translationKeyOfferDescriptionPrefix + credentialType
+}
+
+// CredentialTitleKey returns the key of the title shown to holders for the given credential type
+func CredentialTitleKey(credentialType string) string {
+	return translationKeyCredentialTitlePrefix + credentialType
+}
+
+// CredentialDescriptionKey returns the key of the description shown to holders for the given credential type
+func CredentialDescriptionKey(credentialType string) string {
+	return translationKeyCredentialDescriptionPrefix + credentialType
+}
+
+// IsValidTranslationKey returns true if the key is one of the fixed keys or a credential type key
+func IsValidTranslationKey(key string) bool {
+	for _, k := range TranslationKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range []string{translationKeyOfferDescriptionPrefix, translationKeyCredentialTitlePrefix, translationKeyCredentialDescriptionPrefix} {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// TranslationBundle contains the translations of the holder facing strings of an issuer to a locale
+type TranslationBundle struct {
+	IssuerDID  w3c.DID
+	Locale     string
+	Messages   map[string]string
+	ModifiedAt time.Time
+}
+
+// Localizer translates holder facing strings using a translation bundle. A localizer without bundle, including a nil
+// one, returns the default texts.
+type Localizer struct {
+	bundle *TranslationBundle
+}
+
+// NewLocalizer returns a localizer for the bundle, which can be nil
+func NewLocalizer(bundle *TranslationBundle) *Localizer {
+	return &Localizer{bundle: bundle}
+}
+
+// Locale returns the locale of the translations or an empty string if the default texts are used
+func (l *Localizer) Locale() string {
+	if l == nil || l.bundle == nil {
+		return ""
+	}
+	return l.bundle.Locale
+}
+
+// T returns the translation of key or def if there is no translation for it
+func (l *Localizer) T(key, def string) string {
+	if l == nil || l.bundle == nil {
+		return def
+	}
+	if msg, ok := l.bundle.Messages[key]; ok && msg != "" {
+		return msg
+	}
+	return def
+}
+
+// Lookup returns the translation of key, if any
+func (l *Localizer) Lookup(key string) (string, bool) {
+	if l == nil || l.bundle == nil {
+		return "", false
+	}
+	msg, ok := l.bundle.Messages[key]
+	return msg, ok && msg != ""
+}
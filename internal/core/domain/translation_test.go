@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidTranslationKey(t *testing.T) {
+	for _, key := range TranslationKeys {
+		assert.True(t, IsValidTranslationKey(key), key)
+	}
+	assert.True(t, IsValidTranslationKey(OfferDescriptionKey("KYCAgeCredential")))
+	assert.True(t, IsValidTranslationKey(CredentialTitleKey("KYCAgeCredential")))
+	assert.True(t, IsValidTranslationKey(CredentialDescriptionKey("KYCAgeCredential")))
+	assert.False(t, IsValidTranslationKey(OfferDescriptionKey("")))
+	assert.False(t, IsValidTranslationKey("issuer.logo"))
+	assert.False(t, IsValidTranslationKey(""))
+}
+
+func TestLocalizer(t *testing.T) {
+	var nilLocalizer *Localizer
+	assert.Equal(t, "", nilLocalizer.Locale())
+	assert.Equal(t, "Issuer", nilLocalizer.T(TranslationKeyIssuerName, "Issuer"))
+
+	localizer := NewLocalizer(&TranslationBundle{
+		Locale: "es",
+		Messages: map[string]string{
+			TranslationKeyIssuerName:        "Emisor",
+			TranslationKeyIssuerDescription: "",
+		},
+	})
+	assert.Equal(t, "es", localizer.Locale())
+	assert.Equal(t, "Emisor", localizer.T(TranslationKeyIssuerName, "Issuer"))
+	assert.Equal(t, "Waiting", localizer.T(TranslationKeyLandingWaiting, "Waiting"))
+
+	_, ok := localizer.Lookup(TranslationKeyIssuerDescription)
+	assert.False(t, ok)
+	msg, ok := localizer.Lookup(TranslationKeyIssuerName)
+	assert.True(t, ok)
+	assert.Equal(t, "Emisor", msg)
+
+	assert.Equal(t, "", NewLocalizer(nil).Locale())
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// TrustSource is where the trust status of a DID comes from
+type TrustSource string
+
+const (
+	TrustSourceRegistry    TrustSource = "registry"    // TrustSourceRegistry the trust registry answered
+	TrustSourceAllowList   TrustSource = "allowList"   // TrustSourceAllowList the DID is in the allow list
+	TrustSourceDenyList    TrustSource = "denyList"    // TrustSourceDenyList the DID is in the deny list
+	TrustSourceUnavailable TrustSource = "unavailable" // TrustSourceUnavailable the trust registry could not be consulted
+	TrustSourceDisabled    TrustSource = "disabled"    // TrustSourceDisabled there is no trust registry nor lists configured
+)
+
+// TrustStatus tells whether a holder or an issuer is trusted according to the trust registry and the override lists
+type TrustStatus struct {
+	DID       string
+	Trusted   bool
+	Source    TrustSource
+	Name      string // Name of the entity in the registry, if the registry has it
+	CheckedAt time.Time
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsageFilter narrows a usage report down to a tenant and/or an issuer
+type UsageFilter struct {
+	TenantID  *uuid.UUID
+	IssuerDID *string
+}
+
+// IssuerUsage are the billable events of an issuer in the period of a usage report. GasUsed and TxFee (in wei) add
+// up the state transitions confirmed on chain.
+type IssuerUsage struct {
+	IssuerDID         string
+	TenantID          *uuid.UUID
+	TenantName        *string
+	CredentialsIssued int
+	Verifications     int
+	StateTransitions  int
+	GasUsed           int64
+	TxFee             string
+}
+
+// UsageReport is the usage of every issuer between From (included) and To (excluded)
+type UsageReport struct {
+	From    time.Time
+	To      time.Time
+	Issuers []IssuerUsage
+}
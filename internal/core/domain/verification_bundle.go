@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// VerificationBundle contains everything needed to verify a credential offline: the credential with its proofs, the
+// published state its merkle tree proof is built against, a non revocation proof and the documents the credential
+// refers to, so it can be checked even if the issuer node is not available anymore.
+type VerificationBundle struct {
+	Credential       verifiable.W3CCredential
+	State            IdentityState // State the merkle tree proof of the credential is built against
+	RevocationStatus verifiable.RevocationStatus
+	RevocationState  IdentityState // Latest published state when the bundle was exported
+	ChainID          core.ChainID
+	StateContract    string
+	Documents        map[string]any // JSON-LD contexts and JSON schema of the credential indexed by url
+	ExportedAt       time.Time
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+)
+
+// WalletQRFormat is the format of the QR codes a wallet can read
+type WalletQRFormat string
+
+const (
+	WalletQRFormatLink WalletQRFormat = "link" // WalletQRFormatLink a link to the message stored by the issuer, readable by every wallet
+	WalletQRFormatAuto WalletQRFormat = "auto" // WalletQRFormatAuto the message embedded in the link when it fits, for iden3comm wallets
+	WalletQRFormatOOB  WalletQRFormat = "oob"  // WalletQRFormatOOB a DIDComm v2 out-of-band invitation
+)
+
+// WalletObservation is what a wallet request tells about the wallet: its user agent and accept headers and the
+// envelope and type of the iden3comm message. Rejected tells if the issuer refused the media type of the message.
+type WalletObservation struct {
+	UserAgent   string
+	Accept      []string
+	MediaType   iden3comm.MediaType
+	MessageType iden3comm.ProtocolMessage
+	Rejected    bool
+}
+
+// WalletCapabilities are the media types a wallet used and announced
+type WalletCapabilities struct {
+	MediaTypes         []string
+	RejectedMediaTypes []string
+	Accept             []string
+}
+
+// WalletProfile is what the issuer learned about the wallet of a holder from the messages it sent
+type WalletProfile struct {
+	WalletCapabilities
+	IssuerDID    w3c.DID
+	UserDID      w3c.DID
+	UserAgent    *string
+	MessageTypes []string
+	FirstSeenAt  time.Time
+	LastSeenAt   time.Time
+}
+
+// WalletUsage aggregates the profiles of the wallets with the same user agent
+type WalletUsage struct {
+	WalletCapabilities
+	UserAgent   *string
+	Connections int
+	LastSeenAt  time.Time
+}
+
+// SupportsDIDComm tells if the wallet accepts DIDComm v2 messages
+func (w WalletCapabilities) SupportsDIDComm() bool {
+	for _, accept := range w.Accept {
+		if accept == "didcomm/v2" || strings.HasPrefix(accept, "application/didcomm") {
+			return true
+		}
+	}
+	return false
+}
+
+// PreferredMediaType returns the most secure envelope the wallet is known to use: zero knowledge proofs, signed
+// messages or plain messages
+func (w WalletCapabilities) PreferredMediaType() iden3comm.MediaType {
+	for _, mediaType := range []iden3comm.MediaType{packers.MediaTypeZKPMessage, packers.MediaTypeSignedMessage} {
+		if w.uses(mediaType) {
+			return mediaType
+		}
+	}
+	return packers.MediaTypePlainMessage
+}
+
+// QRFormat returns the format of the QR codes for the wallet: out-of-band invitations for DIDComm wallets,
+// embedded messages for the wallets that speak iden3comm natively and links for the rest
+func (w WalletCapabilities) QRFormat() WalletQRFormat {
+	if w.SupportsDIDComm() {
+		return WalletQRFormatOOB
+	}
+	if w.uses(packers.MediaTypeZKPMessage) || w.uses(packers.MediaTypeSignedMessage) {
+		return WalletQRFormatAuto
+	}
+	return WalletQRFormatLink
+}
+
+// Issues returns the incompatibilities found between the wallet and the issuer
+func (w WalletCapabilities) Issues() []string {
+	issues := make([]string, 0)
+	for _, mediaType := range w.RejectedMediaTypes {
+		issues = append(issues, fmt.Sprintf("the wallet sent %s messages, which the media type policy of the issuer rejects", mediaType))
+	}
+	return issues
+}
+
+func (w WalletCapabilities) uses(mediaType iden3comm.MediaType) bool {
+	for _, m := range w.MediaTypes {
+		if m == string(mediaType) {
+			return true
+		}
+	}
+	for _, m := range w.Accept {
+		if m == string(mediaType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletCapabilities_Negotiation(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		capabilities WalletCapabilities
+		mediaType    iden3comm.MediaType
+		qrFormat     WalletQRFormat
+		didcomm      bool
+	}{
+		{name: "unknown wallet", capabilities: WalletCapabilities{}, mediaType: packers.MediaTypePlainMessage, qrFormat: WalletQRFormatLink},
+		{name: "plain messages", capabilities: WalletCapabilities{MediaTypes: []string{string(packers.MediaTypePlainMessage)}}, mediaType: packers.MediaTypePlainMessage, qrFormat: WalletQRFormatLink},
+		{name: "zkp messages", capabilities: WalletCapabilities{MediaTypes: []string{string(packers.MediaTypePlainMessage), string(packers.MediaTypeZKPMessage)}}, mediaType: packers.MediaTypeZKPMessage, qrFormat: WalletQRFormatAuto},
+		{name: "accepts signed messages", capabilities: WalletCapabilities{Accept: []string{string(packers.MediaTypeSignedMessage)}}, mediaType: packers.MediaTypeSignedMessage, qrFormat: WalletQRFormatAuto},
+		{name: "didcomm", capabilities: WalletCapabilities{MediaTypes: []string{string(packers.MediaTypeZKPMessage)}, Accept: []string{"application/didcomm-plain+json"}}, mediaType: packers.MediaTypeZKPMessage, qrFormat: WalletQRFormatOOB, didcomm: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.mediaType, tc.capabilities.PreferredMediaType())
+			assert.Equal(t, tc.qrFormat, tc.capabilities.QRFormat())
+			assert.Equal(t, tc.didcomm, tc.capabilities.SupportsDIDComm())
+		})
+	}
+}
+
+func TestWalletCapabilities_Issues(t *testing.T) {
+	assert.Empty(t, WalletCapabilities{MediaTypes: []string{string(packers.MediaTypeZKPMessage)}}.Issues())
+	assert.Len(t, WalletCapabilities{RejectedMediaTypes: []string{string(packers.MediaTypePlainMessage)}}.Issues(), 1)
+}
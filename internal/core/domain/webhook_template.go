@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// webhookTemplateReference matches the {{path}} references of the webhook templates
+var webhookTemplateReference = regexp.MustCompile(`{{\s*([^{}]*?)\s*}}`)
+
+// WebhookTemplate reshapes the body posted to a webhook so it matches the contract of the receiver. It is a JSON
+// object whose string values can reference the fields of the original body with {{path}}, a dot separated path such as
+// {{attributes.firstName}}. A value that is only a reference keeps the type of the referenced field, and the references
+// inside a longer string are replaced by their text. Any other value is copied as it is, so the template selects,
+// renames and adds static metadata to the fields of the body.
+type WebhookTemplate map[string]any
+
+// Validate checks that every reference of the template has a path and that no braces are left unbalanced
+func (t WebhookTemplate) Validate() error {
+	if len(t) == 0 {
+		return errors.New("empty template")
+	}
+	return validateTemplateValue("", map[string]any(t))
+}
+
+func validateTemplateValue(at string, value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if err := validateTemplateValue(templatePath(at, key), field); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if err := validateTemplateValue(fmt.Sprintf("%s[%d]", at, i), item); err != nil {
+				return err
+			}
+		}
+	case string:
+		for _, match := range webhookTemplateReference.FindAllStringSubmatch(v, -1) {
+			if match[1] == "" || strings.HasPrefix(match[1], ".") || strings.HasSuffix(match[1], ".") || strings.Contains(match[1], "..") {
+				return fmt.Errorf("%s: invalid reference %q", at, match[0])
+			}
+		}
+		rest := webhookTemplateReference.ReplaceAllString(v, "")
+		if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+			return fmt.Errorf("%s: unbalanced braces", at)
+		}
+	}
+	return nil
+}
+
+// Render returns the template with the references replaced by the values of the body. The references to missing
+// fields render as null, or as an empty text inside a longer string.
+func (t WebhookTemplate) Render(body map[string]any) map[string]any {
+	rendered, _ := renderTemplateValue(map[string]any(t), body).(map[string]any)
+	return rendered
+}
+
+func renderTemplateValue(value any, body map[string]any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		rendered := make(map[string]any, len(v))
+		for key, field := range v {
+			rendered[key] = renderTemplateValue(field, body)
+		}
+		return rendered
+	case []any:
+		rendered := make([]any, len(v))
+		for i, item := range v {
+			rendered[i] = renderTemplateValue(item, body)
+		}
+		return rendered
+	case string:
+		if match := webhookTemplateReference.FindStringSubmatchIndex(v); match != nil && match[0] == 0 && match[1] == len(v) {
+			found, _ := lookupTemplatePath(body, v[match[2]:match[3]])
+			return found
+		}
+		return webhookTemplateReference.ReplaceAllStringFunc(v, func(reference string) string {
+			found, ok := lookupTemplatePath(body, webhookTemplateReference.FindStringSubmatch(reference)[1])
+			if !ok {
+				return ""
+			}
+			return templateText(found)
+		})
+	default:
+		return v
+	}
+}
+
+func lookupTemplatePath(body map[string]any, path string) (any, bool) {
+	var current any = body
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// templateText returns the text of a value of a json body, without the exponent notation of the large numbers
+func templateText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		text, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(text)
+	}
+}
+
+func templatePath(at string, key string) string {
+	if at == "" {
+		return key
+	}
+	return at + "." + key
+}
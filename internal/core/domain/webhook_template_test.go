@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookTemplate_Render(t *testing.T) {
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"linkID":"9b1deb4d","userDID":"did:iden3:holder","credentialID":"1f0e","attributes":{"firstName":"Ada","birthday":19960424,"verified":true}}`), &body))
+
+	template := WebhookTemplate{
+		"event":     "credential.issued",
+		"version":   2,
+		"reference": "{{credentialID}}",
+		"holder": map[string]any{
+			"did":  "{{ userDID }}",
+			"name": "{{attributes.firstName}}",
+		},
+		"birthDate": "{{attributes.birthday}}",
+		"verified":  "{{attributes.verified}}",
+		"summary":   "{{attributes.firstName}} born on {{attributes.birthday}}{{attributes.lastName}}",
+		"lastName":  "{{attributes.lastName}}",
+		"tags":      []any{"kyc", "{{linkID}}"},
+	}
+	require.NoError(t, template.Validate())
+
+	assert.Equal(t, map[string]any{
+		"event":     "credential.issued",
+		"version":   2,
+		"reference": "1f0e",
+		"holder": map[string]any{
+			"did":  "did:iden3:holder",
+			"name": "Ada",
+		},
+		"birthDate": float64(19960424),
+		"verified":  true,
+		"summary":   "Ada born on 19960424",
+		"lastName":  nil,
+		"tags":      []any{"kyc", "9b1deb4d"},
+	}, template.Render(body))
+}
+
+func TestWebhookTemplate_Validate(t *testing.T) {
+	assert.Error(t, WebhookTemplate{}.Validate())
+	assert.Error(t, WebhookTemplate{"id": "{{}}"}.Validate())
+	assert.Error(t, WebhookTemplate{"id": "{{attributes.}}"}.Validate())
+	assert.Error(t, WebhookTemplate{"holder": map[string]any{"id": "{{userDID"}}.Validate())
+	assert.Error(t, WebhookTemplate{"tags": []any{"userDID}}"}}.Validate())
+	assert.NoError(t, WebhookTemplate{"source": "issuer-node"}.Validate())
+}
@@ -10,6 +10,11 @@ const (
 	CreateCredentialEvent = "createCredentialEvent" // CreateCredentialEvent create credential event
 	CreateConnectionEvent = "createConnectionEvent" // CreateConnectionEvent create connection MyEvent
 	CreateStateEvent      = "createStateEvent"      // CreateStateEvent create state event
+
+	FirstAuthenticationEvent = "firstAuthenticationEvent" // FirstAuthenticationEvent a holder authenticated for the first time and a connection was created
+
+	RevokeIneligibleCredentialEvent = "revokeIneligibleCredentialEvent" // RevokeIneligibleCredentialEvent revocation requested by the status oracle
+	RevokeDependentsEvent           = "revokeDependentsEvent"           // RevokeDependentsEvent revocation of the dependents of a revoked credential
 )
 
 // CreateState defines the createState data
@@ -58,3 +63,53 @@ func (ev *CreateConnection) Marshal() (msg pubsub.Message, err error) {
 func (ev *CreateConnection) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
+
+// FirstAuthentication defines the firstAuthentication data
+type FirstAuthentication struct {
+	ConnectionID string `json:"connectionID"`
+	IssuerID     string `json:"issuerID"`
+	UserID       string `json:"userID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *FirstAuthentication) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *FirstAuthentication) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// RevokeIneligibleCredential defines the revocation of a credential whose subject is no longer eligible according to the status oracle
+type RevokeIneligibleCredential struct {
+	CredentialID string `json:"credentialID"`
+	IssuerID     string `json:"issuerID"`
+	Reason       string `json:"reason"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *RevokeIneligibleCredential) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *RevokeIneligibleCredential) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// RevokeDependents defines the revocation of the credentials that depend on a revoked prerequisite credential
+type RevokeDependents struct {
+	CredentialID string `json:"credentialID"`
+	IssuerID     string `json:"issuerID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *RevokeDependents) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *RevokeDependents) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// APIKeyRepository defines the available methods for api keys repository
+type APIKeyRepository interface {
+	Save(ctx context.Context, conn db.Querier, key *domain.APIKey) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.APIKey, error)
+	GetByHash(ctx context.Context, conn db.Querier, keyHash string) (*domain.APIKey, error)
+	GetAll(ctx context.Context, conn db.Querier) ([]domain.APIKey, error)
+	UpdateHash(ctx context.Context, conn db.Querier, id uuid.UUID, keyHash string) error
+	Revoke(ctx context.Context, conn db.Querier, id uuid.UUID, revokedAt time.Time) error
+	UpdateLastUsed(ctx context.Context, conn db.Querier, id uuid.UUID, lastUsedAt time.Time) error
+}
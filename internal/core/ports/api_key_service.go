@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreateAPIKeyRequest is the information needed to create an api key
+type CreateAPIKeyRequest struct {
+	Name       string
+	Scopes     []domain.APIKeyScope
+	AllowedIPs []string
+	ExpiresAt  *time.Time
+	TenantID   *uuid.UUID
+}
+
+// CreateReadOnlyAPIKeyRequest is the information needed to create a time-boxed read-only api key for support access
+type CreateReadOnlyAPIKeyRequest struct {
+	Name       string
+	ValidFor   time.Duration
+	AllowedIPs []string
+	TenantID   *uuid.UUID
+}
+
+// APIKeyService is the interface implemented by the api key service
+type APIKeyService interface {
+	Create(ctx context.Context, req CreateAPIKeyRequest) (key *domain.APIKey, secret string, err error)
+	CreateReadOnly(ctx context.Context, req CreateReadOnlyAPIKeyRequest) (key *domain.APIKey, secret string, err error)
+	GetAll(ctx context.Context) ([]domain.APIKey, error)
+	Rotate(ctx context.Context, id uuid.UUID) (key *domain.APIKey, secret string, err error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, secret string, ip net.IP) (*domain.APIKey, error)
+}
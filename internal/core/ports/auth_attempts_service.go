@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"net"
+
+	"github.com/google/uuid"
+)
+
+// AuthAttemptsMetrics are the counters of the brute force protection of the authentication callbacks
+type AuthAttemptsMetrics struct {
+	Failures            uint64
+	Delayed             uint64
+	Rejected            uint64
+	SessionsInvalidated uint64
+}
+
+// AuthAttemptsService tracks the failed authentication attempts per session and client ip to protect the
+// authentication callbacks against token guessing.
+type AuthAttemptsService interface {
+	// Check delays the attempt according to the previous failures and returns an error if the session has been invalidated
+	Check(ctx context.Context, sessionID uuid.UUID, ip net.IP) error
+	Failed(ctx context.Context, sessionID uuid.UUID, ip net.IP)
+	Succeeded(ctx context.Context, sessionID uuid.UUID, ip net.IP)
+	Metrics() AuthAttemptsMetrics
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CampaignRepository defines the available methods for the campaigns repository
+type CampaignRepository interface {
+	Save(ctx context.Context, conn db.Querier, campaign *domain.Campaign) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.Campaign, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.Campaign, error)
+	GetByCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialIDs []uuid.UUID) ([]domain.Campaign, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+	AddLinks(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, linkIDs []uuid.UUID) error
+	RemoveLink(ctx context.Context, conn db.Querier, id uuid.UUID, linkID uuid.UUID) error
+	AddCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, credentialIDs []uuid.UUID) error
+	MarkMilestoneNotified(ctx context.Context, conn db.Querier, id uuid.UUID, milestone int) (bool, error)
+}
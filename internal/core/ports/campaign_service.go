@@ -0,0 +1,40 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+// CampaignRequest is the information needed to create or update a campaign. In the updates, nil fields are not modified.
+type CampaignRequest struct {
+	Name        *string
+	Description *string
+	StartAt     *time.Time
+	EndAt       *time.Time
+	WebhookURL  *string
+	Milestones  *[]int
+}
+
+// CampaignService is the interface implemented by the campaign service
+type CampaignService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, req CampaignRequest) (*domain.Campaign, error)
+	Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req CampaignRequest) (*domain.Campaign, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Campaign, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.Campaign, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	AddLinks(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, linkIDs []uuid.UUID) error
+	RemoveLink(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, linkID uuid.UUID) error
+	AddCredentials(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, credentialIDs []uuid.UUID) error
+	NotifyMilestones(ctx context.Context, e pubsub.Message) error
+}
+
+// CampaignWebhook sends the milestones reached by the campaigns to their webhooks
+type CampaignWebhook interface {
+	Notify(ctx context.Context, url string, milestone domain.CampaignMilestone) error
+}
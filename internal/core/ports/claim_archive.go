@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ClaimArchiveRepository defines the available methods for the archived claims repository
+type ClaimArchiveRepository interface {
+	Archive(ctx context.Context, conn db.Querier, issuerDID w3c.DID, createdBefore time.Time, limit int) ([]uuid.UUID, error)
+	Rehydrate(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ArchivedClaim, error)
+	RevokeByNonce(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64) (int64, error)
+}
+
+// ClaimArchiveRequest selects the claims to archive
+type ClaimArchiveRequest struct {
+	MinAgeDays int
+	BatchSize  int
+}
+
+// ClaimArchivalService is the interface implemented by the service that moves the old claims to the archive and back
+type ClaimArchivalService interface {
+	Archive(ctx context.Context, issuerDID w3c.DID, req ClaimArchiveRequest) ([]uuid.UUID, error)
+	ArchiveAll(ctx context.Context, issuerDID w3c.DID, req ClaimArchiveRequest)
+	Rehydrate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Claim, error)
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ClaimImportRepository defines the available methods for the claim imports repository
+type ClaimImportRepository interface {
+	Save(ctx context.Context, conn db.Querier, claimImport *domain.ClaimImport) error
+	GetByClaimID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, claimID uuid.UUID) (*domain.ClaimImport, error)
+	GetByDocumentHash(ctx context.Context, conn db.Querier, issuerDID w3c.DID, hash string) (*domain.ClaimImport, error)
+}
+
+// ClaimImportRequest is a pre-built W3C credential to issue under the identity of the issuer
+type ClaimImportRequest struct {
+	Credential      json.RawMessage
+	Source          string
+	SourceReference *string
+	SignatureProof  bool
+	MTProof         bool
+}
+
+// ClaimImportService is the interface implemented by the service that imports the credentials built by other systems
+type ClaimImportService interface {
+	Import(ctx context.Context, issuerDID w3c.DID, req ClaimImportRequest) (*domain.ClaimImport, error)
+	GetByClaimID(ctx context.Context, issuerDID w3c.DID, claimID uuid.UUID) (*domain.ClaimImport, error)
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -14,11 +15,14 @@ import (
 // ClaimsRepository is the interface that defines the available methods
 type ClaimsRepository interface {
 	Save(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error)
+	SaveBatch(ctx context.Context, conn db.Querier, claims []*domain.Claim) ([]uuid.UUID, error)
 	GetRevoked(ctx context.Context, conn db.Querier, currentState string) ([]*domain.Claim, error)
 	Revoke(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error
 	RevokeNonce(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error
+	RevokeBatch(ctx context.Context, conn db.Querier, revocations []*domain.Revocation) error
 	GetByRevocationNonce(ctx context.Context, conn db.Querier, identifier *w3c.DID, revocationNonce domain.RevNonceUint64) ([]*domain.Claim, error)
 	GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifier *w3c.DID, claimID uuid.UUID) (*domain.Claim, error)
+	GetByID(ctx context.Context, conn db.Querier, claimID uuid.UUID) (*domain.Claim, error)
 	FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier, subject *w3c.DID, schemaHash string) (*domain.Claim, error)
 	GetAllByIssuerID(ctx context.Context, conn db.Querier, identifier w3c.DID, filter *ClaimsFilter) ([]*domain.Claim, uint, error)
 	GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn db.Querier, connID uuid.UUID, issuerID w3c.DID) ([]*domain.Claim, error)
@@ -31,4 +35,8 @@ type ClaimsRepository interface {
 	GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID, linkID uuid.UUID) ([]*domain.Claim, error)
 	GetClaimsOfAConnection(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID) ([]*domain.Claim, error)
 	GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier, did *w3c.DID, state string) (claims []*domain.Claim, err error)
+	GetIssuanceStatsBySubjectAndSchema(ctx context.Context, conn db.Querier, issuerDID w3c.DID, subject string, schemaHash string) (count uint, lastCreatedAt *time.Time, err error)
+	GetNonRevokedBySubjectAndSchemaType(ctx context.Context, conn db.Querier, issuerDID w3c.DID, subject string, schemaType string) ([]*domain.Claim, error)
+	GetNonRevokedByUniqueKey(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string, key map[string]any) ([]*domain.Claim, error)
+	LockUniqueKey(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string, key map[string]any) error
 }
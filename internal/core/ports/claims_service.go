@@ -14,6 +14,7 @@ import (
 	"github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/sqltools"
 )
 
@@ -42,6 +43,20 @@ type CreateClaimRequest struct {
 	RefreshService        *verifiable.RefreshService
 	RevNonce              *uint64
 	DisplayMethod         *verifiable.DisplayMethod
+	Force                 bool
+	ExternalID            *string
+	ProfileID             *uuid.UUID
+	FetchPolicy           domain.CredentialFetchPolicy
+	RequesterRole         string
+	// Supersedes is the credential replaced by the new one. It does not count against the unique key of the schema.
+	Supersedes *uuid.UUID
+	// Provenance of the attributes of the credential subject, embedded in the evidence section of the credential
+	Provenance map[string]domain.AttributeProvenance
+	// Extensions are the evidence and termsOfUse sections given by the requester, embedded in the credential when the
+	// schema allows them
+	Extensions domain.CredentialExtensions
+	// RevNonceRangeID is the reserved range the revocation nonce is taken from when RevNonce is not given
+	RevNonceRangeID *uuid.UUID
 }
 
 // AgentRequest struct
@@ -74,6 +89,8 @@ type ClaimsFilter struct {
 	SchemaHash      string
 	SchemaType      string
 	Subject         string
+	ExternalID      string
+	ProfileID       *uuid.UUID
 	QueryField      string
 	QueryFieldValue string
 	FTSQuery        string
@@ -204,17 +221,34 @@ type GetCredentialQrCodeResponse struct {
 	QrID       uuid.UUID
 }
 
+// StateQuery selects a published state of the issuer by its hash or, when no hash is given, by the time it was published
+type StateQuery struct {
+	State *string
+	At    *time.Time
+}
+
 // ClaimsService is the interface implemented by the claim service
 type ClaimsService interface {
 	Save(ctx context.Context, claimReq *CreateClaimRequest) (*domain.Claim, error)
+	SaveBatch(ctx context.Context, claimReqs []*CreateClaimRequest) ([]*domain.Claim, error)
 	GetRevoked(ctx context.Context, currentState string) ([]*domain.Claim, error)
 	CreateCredential(ctx context.Context, req *CreateClaimRequest) (*domain.Claim, error)
+	SaveWithConn(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error)
+	InspectLayout(ctx context.Context, req *CreateClaimRequest) (*domain.ClaimLayout, error)
+	FindDuplicate(ctx context.Context, req *CreateClaimRequest) (*domain.Claim, error)
 	Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) error
+	RevokeWithConn(ctx context.Context, conn db.Querier, id w3c.DID, nonce uint64, description string) error
 	GetAll(ctx context.Context, did w3c.DID, filter *ClaimsFilter) ([]*domain.Claim, uint, error)
 	RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error
 	GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error)
+	GetRevocationStatusAtState(ctx context.Context, issuerDID w3c.DID, nonce uint64, query StateQuery) (*domain.IdentityState, *verifiable.RevocationStatus, error)
+	GetRevocationStatuses(ctx context.Context, issuerDID w3c.DID, nonces []uint64) ([]*verifiable.RevocationStatus, error)
+	GetExpirationStatuses(ctx context.Context, issuerDID w3c.DID, nonces []uint64) (map[uint64]string, error)
 	GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (*domain.Claim, error)
-	GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string) (*GetCredentialQrCodeResponse, error)
+	GetVersions(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]domain.CredentialVersion, error)
+	GetPublicByID(ctx context.Context, id uuid.UUID) (*domain.Claim, error)
+	GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string, localizer *domain.Localizer) (*GetCredentialQrCodeResponse, error)
+	RegisterOffer(ctx context.Context, threadID string, credentialIDs ...uuid.UUID)
 	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
 	GetAuthClaim(ctx context.Context, did *w3c.DID) (*domain.Claim, error)
 	GetAuthClaimForPublishing(ctx context.Context, did *w3c.DID, state string) (*domain.Claim, error)
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxConfirmationStatus is the status of a transaction followed by the ConfirmationTracker
+type TxConfirmationStatus string
+
+const (
+	// TxConfirmationPending the transaction is not mined yet or it does not have enough confirmations
+	TxConfirmationPending TxConfirmationStatus = "pending"
+	// TxConfirmationConfirmed the transaction is mined in the canonical chain with enough confirmations
+	TxConfirmationConfirmed TxConfirmationStatus = "confirmed"
+	// TxConfirmationFailed the transaction is mined in the canonical chain but it was reverted
+	TxConfirmationFailed TxConfirmationStatus = "failed"
+	// TxConfirmationDropped the transaction is not known by the node anymore, usually because a reorg dropped it
+	TxConfirmationDropped TxConfirmationStatus = "dropped"
+)
+
+// TxConfirmation is the result of checking a transaction
+type TxConfirmation struct {
+	Status        TxConfirmationStatus
+	Receipt       *types.Receipt
+	Confirmations int64
+}
+
+// ConfirmationTracker checks the confirmations of state transactions and detects the ones dropped by reorgs
+type ConfirmationTracker interface {
+	Check(ctx context.Context, txID string) (*TxConfirmation, error)
+}
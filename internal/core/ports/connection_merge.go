@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ConnectionMergeRepository defines the available methods for the connection merges repository
+type ConnectionMergeRepository interface {
+	Save(ctx context.Context, conn db.Querier, merge *domain.ConnectionMerge) error
+	GetByTargetConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error)
+	MoveToTarget(ctx context.Context, conn db.Querier, fromConnectionID uuid.UUID, toConnectionID uuid.UUID) error
+}
+
+// ConnectionMergeService is the interface implemented by the connection merge service
+type ConnectionMergeService interface {
+	Merge(ctx context.Context, issuerDID w3c.DID, targetID uuid.UUID, sourceID uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.ConnectionMerge, error)
+	GetMerges(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error)
+	GetDuplicates(ctx context.Context, issuerDID w3c.DID) ([]domain.ConnectionDuplicates, error)
+	ReportDuplicates(ctx context.Context, issuerDID w3c.DID)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ConnectionPruneRequest selects the dead connections to prune and what to do with them
+type ConnectionPruneRequest struct {
+	InactiveDays int
+	Action       string
+	BatchSize    int
+}
+
+// ConnectionPruningService is the interface implemented by the connection pruning service
+type ConnectionPruningService interface {
+	GetDead(ctx context.Context, issuerDID w3c.DID, inactiveDays int, limit int) ([]domain.DeadConnection, error)
+	Prune(ctx context.Context, issuerDID w3c.DID, req ConnectionPruneRequest) ([]uuid.UUID, error)
+	PruneAll(ctx context.Context, issuerDID w3c.DID, req ConnectionPruneRequest)
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ConnectionRebindingRepository defines the available methods for the connection rebindings repository
+type ConnectionRebindingRepository interface {
+	Save(ctx context.Context, conn db.Querier, rebinding *domain.ConnectionRebinding) error
+	GetByCodeHash(ctx context.Context, conn db.Querier, codeHash string) (*domain.ConnectionRebinding, error)
+	GetBySessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.ConnectionRebinding, error)
+	GetBySessionIDForUpdate(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.ConnectionRebinding, error)
+	SetSession(ctx context.Context, conn db.Querier, id uuid.UUID, sessionID uuid.UUID) error
+	MarkUsed(ctx context.Context, conn db.Querier, id uuid.UUID, newConnectionID uuid.UUID, usedAt time.Time) error
+}
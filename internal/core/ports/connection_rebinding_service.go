@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ConnectionRebindingCode is a new recovery code of a connection. The code is only available when it is created.
+type ConnectionRebindingCode struct {
+	ID        uuid.UUID
+	Code      string
+	ExpiresAt time.Time
+}
+
+// ConnectionRebindingService is the interface implemented by the connection rebinding service
+type ConnectionRebindingService interface {
+	CreateCode(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) (*ConnectionRebindingCode, error)
+	Redeem(ctx context.Context, issuerDID w3c.DID, code string, serverURL string) (*CreateAuthenticationQRCodeResponse, error)
+	Complete(ctx context.Context, issuerDID w3c.DID, sessionID uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.ConnectionRebinding, error)
+}
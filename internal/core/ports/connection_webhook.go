@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ConnectionWebhook is the interface implemented by the gateway that vets the holders that authenticate for the
+// first time
+type ConnectionWebhook interface {
+	Vet(ctx context.Context, vetting domain.NewConnectionVetting) (*domain.NewConnectionDecision, error)
+}
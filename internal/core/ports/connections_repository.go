@@ -21,4 +21,12 @@ type ConnectionsRepository interface {
 	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error)
 	SaveUserAuthentication(ctx context.Context, conn db.Querier, connID uuid.UUID, sessID uuid.UUID, mTime time.Time) error
+	MoveUserAuthentications(ctx context.Context, conn db.Querier, fromConnID uuid.UUID, toConnID uuid.UUID) error
+	UpdateProfile(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID, profile domain.ConnectionProfile) error
+	MergeProfile(ctx context.Context, conn db.Querier, fromConnID uuid.UUID, toConnID uuid.UUID) error
+	GetDuplicates(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.ConnectionDuplicates, error)
+	GetDead(ctx context.Context, conn db.Querier, issuerDID w3c.DID, inactiveSince time.Time, limit int) ([]domain.DeadConnection, error)
+	GetDeadForUpdate(ctx context.Context, conn db.Querier, issuerDID w3c.DID, inactiveSince time.Time, limit int) ([]domain.DeadConnection, error)
+	Archive(ctx context.Context, conn db.Querier, ids []uuid.UUID) error
+	DeleteMany(ctx context.Context, conn db.Querier, ids []uuid.UUID) error
 }
@@ -61,4 +61,5 @@ type ConnectionsService interface {
 	GetByUserID(ctx context.Context, issuerDID w3c.DID, userID w3c.DID) (*domain.Connection, error)
 	GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, request *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, sessionID uuid.UUID) (*domain.Connection, error)
+	UpdateProfile(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, profile domain.ConnectionProfile) error
 }
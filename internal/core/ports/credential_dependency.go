@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+// CredentialDependencyRepository defines the available methods for the credential dependencies repository
+type CredentialDependencyRepository interface {
+	SaveForPrerequisites(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID, userDID w3c.DID, prerequisites []domain.LinkPrerequisite) error
+	GetDependents(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialDependent, error)
+}
+
+// RevocationCascadeService is the interface implemented by the service that revokes the dependents of a revoked credential
+type RevocationCascadeService interface {
+	Preview(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialDependent, error)
+	Request(ctx context.Context, issuerDID w3c.DID, nonce uint64) error
+	RevokeDependents(ctx context.Context, payload pubsub.Message) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CredentialPDFTemplateRepository defines the available methods for the credential pdf templates repository
+type CredentialPDFTemplateRepository interface {
+	Save(ctx context.Context, conn db.Querier, template *domain.CredentialPDFTemplate) error
+	GetBySchemaID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID) (*domain.CredentialPDFTemplate, error)
+	GetBySchema(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string) (*domain.CredentialPDFTemplate, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID) error
+}
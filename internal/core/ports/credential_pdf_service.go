@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialPDFOptions are the options to render a credential as a PDF document
+type CredentialPDFOptions struct {
+	MaskedAttributes []string // credentialSubject attributes whose value is hidden
+}
+
+// CredentialPDFService is the interface implemented by the credential pdf service
+type CredentialPDFService interface {
+	GetTemplate(ctx context.Context, issuerDID w3c.DID, schemaID uuid.UUID) (*domain.CredentialPDFTemplate, error)
+	SaveTemplate(ctx context.Context, template *domain.CredentialPDFTemplate) error
+	DeleteTemplate(ctx context.Context, issuerDID w3c.DID, schemaID uuid.UUID) error
+	Render(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID, opts CredentialPDFOptions) ([]byte, error)
+}
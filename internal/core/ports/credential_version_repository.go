@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CredentialVersionRepository defines the available methods for the credential versions repository
+type CredentialVersionRepository interface {
+	Save(ctx context.Context, conn db.Querier, version *domain.CredentialVersion) error
+	GetLineage(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialVersion, error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+)
+
+// DataCipher is the interface implemented by the ciphers used to protect data at rest
+type DataCipher interface {
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, data []byte) ([]byte, error)
+}
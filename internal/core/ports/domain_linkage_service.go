@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// DomainLinkageService is the interface implemented by the domain linkage service
+type DomainLinkageService interface {
+	DIDConfiguration(ctx context.Context, did w3c.DID, origin string) (*domain.DIDConfiguration, error)
+}
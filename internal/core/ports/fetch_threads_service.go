@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FetchThreadsMetrics are the counters of the credential fetch requests checked against the offered threads
+type FetchThreadsMetrics struct {
+	Accepted   uint64
+	Replayed   uint64
+	Mismatched uint64
+	Unbound    uint64
+}
+
+// FetchThreadsService binds the credential fetch requests to the thread of the offer they answer, so a captured
+// fetch request cannot be replayed from a different session.
+type FetchThreadsService interface {
+	// Offer records the credentials offered in the thread
+	Offer(ctx context.Context, threadID string, credentialIDs ...uuid.UUID)
+	// Consume checks that the credential was offered in the thread and marks the thread as used
+	Consume(ctx context.Context, threadID string, credentialID uuid.UUID) error
+	Metrics() FetchThreadsMetrics
+}
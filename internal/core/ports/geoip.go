@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"net"
+)
+
+// GeoIPProvider is the interface implemented by the providers of the countries of the ips
+type GeoIPProvider interface {
+	// Country returns the ISO 3166-1 alpha-2 code of the country of the ip
+	Country(ctx context.Context, ip net.IP) (string, error)
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// HolderPortalService is the interface implemented by the holder portal service
+type HolderPortalService interface {
+	CreateSession(ctx context.Context, issuerDID w3c.DID, authSessionID uuid.UUID) (*domain.HolderSession, error)
+	GetSession(ctx context.Context, token string) (*domain.HolderSession, error)
+	GetCredentials(ctx context.Context, session *domain.HolderSession) ([]*domain.Claim, error)
+	ReissueCredential(ctx context.Context, session *domain.HolderSession, id uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.Claim, error)
+	GetCredentialQrCode(ctx context.Context, session *domain.HolderSession, id uuid.UUID, hostURL string, localizer *domain.Localizer) (*GetCredentialQrCodeResponse, error)
+	PreviewCredential(ctx context.Context, session *domain.HolderSession, id uuid.UUID) (*domain.CredentialPreview, error)
+	PreviewCredentialHTML(ctx context.Context, session *domain.HolderSession, id uuid.UUID) ([]byte, error)
+}
+
+// DisplayMethodResolver fetches the documents of the display methods of the credentials
+type DisplayMethodResolver interface {
+	Resolve(ctx context.Context, displayMethod verifiable.DisplayMethod) (*domain.CredentialDisplay, error)
+}
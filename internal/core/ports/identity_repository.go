@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -14,7 +15,10 @@ type IndentityRepository interface {
 	Save(ctx context.Context, conn db.Querier, identity *domain.Identity) error
 	GetByID(ctx context.Context, conn db.Querier, identifier w3c.DID) (*domain.Identity, error)
 	Get(ctx context.Context, conn db.Querier) (identities []string, err error)
+	GetByTenantID(ctx context.Context, conn db.Querier, tenantID uuid.UUID) (identities []string, err error)
 	GetUnprocessedIssuersIDs(ctx context.Context, conn db.Querier) (issuersIDs []*w3c.DID, err error)
 	HasUnprocessedStatesByID(ctx context.Context, conn db.Querier, identifier *w3c.DID) (bool, error)
 	HasUnprocessedAndFailedStatesByID(ctx context.Context, conn db.Querier, identifier *w3c.DID) (bool, error)
+	UpdateServerURL(ctx context.Context, conn db.Querier, identifier w3c.DID, serverURL *string) error
+	GetByServerURL(ctx context.Context, conn db.Querier, serverURL string) (*w3c.DID, error)
 }
@@ -20,6 +20,8 @@ type DIDCreationOptions struct {
 	Network                 core.NetworkID                  `json:"network"`
 	KeyType                 kms.KeyType                     `json:"keyType"`
 	AuthBJJCredentialStatus verifiable.CredentialStatusType `json:"authBJJCredentialStatus,omitempty"`
+	TenantID                *uuid.UUID                      `json:"-"`
+	ServerURL               *string                         `json:"-"`
 }
 
 // CreateAuthenticationQRCodeResponse represents the response of the CreateAuthenticationQRCode method
@@ -35,6 +37,7 @@ type IdentityService interface {
 	Create(ctx context.Context, hostURL string, didOptions *DIDCreationOptions) (*domain.Identity, error)
 	SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error)
 	Get(ctx context.Context) (identities []string, err error)
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (identities []string, err error)
 	UpdateState(ctx context.Context, did w3c.DID) (*domain.IdentityState, error)
 	Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	GetLatestStateByID(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
@@ -46,8 +49,15 @@ type IdentityService interface {
 	UpdateIdentityState(ctx context.Context, state *domain.IdentityState) error
 	GetTransactedStates(ctx context.Context) ([]domain.IdentityState, error)
 	GetStates(ctx context.Context, issuerDID w3c.DID) ([]domain.IdentityState, error)
+	GetPendingState(ctx context.Context, identifier w3c.DID) (*domain.PendingState, error)
+	GetStateTransactions(ctx context.Context, issuerDID w3c.DID) ([]domain.StateTransaction, error)
 	CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID) (*CreateAuthenticationQRCodeResponse, error)
+	CreateReAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID, userDID w3c.DID) (*CreateAuthenticationQRCodeResponse, error)
 	Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string, issuerDID w3c.DID) (*protocol.AuthorizationResponseMessage, error)
 	GetFailedState(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
 	PublishGenesisStateToRHS(ctx context.Context, did *w3c.DID) error
+	UpdateServerURL(ctx context.Context, did w3c.DID, serverURL *string) (*domain.Identity, error)
+	ServerURL(ctx context.Context, did w3c.DID, defaultURL string) string
+	GetByServerURL(ctx context.Context, serverURL string) (*w3c.DID, error)
+	AddAuthKey(ctx context.Context, did w3c.DID, keyID kms.KeyID, hostURL string, status verifiable.CredentialStatusType) (*domain.Claim, error)
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
@@ -13,9 +14,12 @@ import (
 type IdentityStateRepository interface {
 	Save(ctx context.Context, conn db.Querier, state domain.IdentityState) error
 	GetLatestStateByIdentifier(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityState, error)
+	GetConfirmedState(ctx context.Context, conn db.Querier, identifier w3c.DID, state string) (*domain.IdentityState, error)
+	GetConfirmedStateAt(ctx context.Context, conn db.Querier, identifier w3c.DID, at time.Time) (*domain.IdentityState, error)
 	GetStatesByStatus(ctx context.Context, conn db.Querier, status domain.IdentityStatus) ([]domain.IdentityState, error)
 	GetStates(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.IdentityState, error)
 	GetStatesByStatusAndIssuerID(ctx context.Context, conn db.Querier, status domain.IdentityStatus, issuerID w3c.DID) ([]domain.IdentityState, error)
+	GetStateTransitionContents(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (map[string]*domain.StateTransitionContent, error)
 	UpdateState(ctx context.Context, conn db.Querier, state *domain.IdentityState) (int64, error)
 	GetGenesisState(ctx context.Context, conn db.Querier, identifier string) (*domain.IdentityState, error)
 }
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreateInvitationResponse is the response of the InvitationService Create method
+type CreateInvitationResponse struct {
+	Invitation *domain.OutOfBandInvitation
+	URL        string
+	QrID       uuid.UUID
+}
+
+// InvitationService is the interface implemented by the out-of-band invitation service
+type InvitationService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, hostURL string, goalCode string, goal string, qrIDs ...uuid.UUID) (*CreateInvitationResponse, error)
+}
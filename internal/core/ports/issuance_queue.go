@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IssuanceQueue is the queue the back office writes the issuance commands to, with its reply queue
+type IssuanceQueue interface {
+	// Receive waits for the next commands. It returns the commands that were received but never acknowledged first.
+	Receive(ctx context.Context) ([]domain.IssuanceMessage, error)
+	Reply(ctx context.Context, result domain.IssuanceCommandResult) error
+	Ack(ctx context.Context, messageID string) error
+}
+
+// IssuanceConsumerService is the interface implemented by the service that issues the credentials of the commands of
+// the issuance queue
+type IssuanceConsumerService interface {
+	Run(ctx context.Context)
+	Issue(ctx context.Context, body []byte) domain.IssuanceCommandResult
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// IssuerProfileRepository defines the available methods for the issuer profiles repository
+type IssuerProfileRepository interface {
+	Save(ctx context.Context, conn db.Querier, profile *domain.IssuerProfile) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.IssuerProfile, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.IssuerProfile, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+}
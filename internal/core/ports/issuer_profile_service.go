@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreateIssuerProfileRequest is the information needed to create an issuer profile
+type CreateIssuerProfileRequest struct {
+	Name          string
+	Description   *string
+	LogoURL       *string
+	AllowedProofs []string
+}
+
+// IssuerProfileService is the interface implemented by the issuer profile service
+type IssuerProfileService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, req CreateIssuerProfileRequest) (*domain.IssuerProfile, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.IssuerProfile, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.IssuerProfile, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	Authorize(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, proofs ClaimRequestProofs) (*domain.IssuerProfile, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// KeyMigrationRepository defines the available methods for the key migrations repository
+type KeyMigrationRepository interface {
+	Save(ctx context.Context, conn db.Querier, m *domain.KeyMigration) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.KeyMigration, error)
+}
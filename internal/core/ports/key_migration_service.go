@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// KeyMigrationService is the interface implemented by the service that migrates the issuer keys to a hardware-backed
+// custody
+type KeyMigrationService interface {
+	Start(ctx context.Context, issuerDID w3c.DID, revokeOldAuthClaim bool) (*domain.KeyMigration, error)
+	Resume(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.KeyMigration, error)
+}
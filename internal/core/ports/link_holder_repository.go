@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// LinkHolderRepository defines the available methods for the repository of the holders that claimed a link with a profile DID
+type LinkHolderRepository interface {
+	Save(ctx context.Context, conn db.Querier, holder domain.LinkHolder) error
+	Get(ctx context.Context, conn db.Querier, linkID uuid.UUID, digest string) (*domain.LinkHolder, error)
+}
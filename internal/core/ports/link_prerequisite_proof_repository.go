@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// LinkPrerequisiteProofRepository defines the available methods for the link prerequisite proofs repository
+type LinkPrerequisiteProofRepository interface {
+	Save(ctx context.Context, conn db.Querier, proof *domain.LinkPrerequisiteProof) error
+	GetByLink(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkPrerequisiteProof, error)
+}
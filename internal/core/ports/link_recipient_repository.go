@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// LinkRecipientRepository defines the available methods for the link recipients repository
+type LinkRecipientRepository interface {
+	Save(ctx context.Context, conn db.Querier, recipients []domain.LinkRecipient) error
+	GetByCode(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string) (*domain.LinkRecipient, error)
+	GetByLink(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]domain.LinkRecipient, error)
+	Exists(ctx context.Context, conn db.Querier, linkID uuid.UUID) (bool, error)
+	Consume(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -16,4 +17,5 @@ type LinkRepository interface {
 	GetByID(ctx context.Context, issuerID w3c.DID, id uuid.UUID) (*domain.Link, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, status LinkStatus, query *string) ([]domain.Link, error)
 	Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error
+	ClaimsIssuedSince(ctx context.Context, id uuid.UUID, since time.Time) (int, *time.Time, error)
 }
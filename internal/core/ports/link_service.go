@@ -3,12 +3,16 @@ package ports
 import (
 	"context"
 	"fmt"
+	"io"
+	"math/big"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	linkState "github.com/polygonid/sh-id-platform/pkg/link"
@@ -22,6 +26,14 @@ type CreateQRCodeResponse struct {
 	SessionID string
 }
 
+// LinkPrerequisiteRequest is a credential the holder must hold before a link issues its credential
+type LinkPrerequisiteRequest struct {
+	SchemaURL      string
+	CredentialType string
+	ProofType      string
+	AllowedIssuers []string
+}
+
 // LinkStatus is a Link type request. All|Active|Inactive|Exceeded
 type LinkStatus string
 
@@ -47,14 +59,50 @@ type GetQRCodeResponse struct {
 	State *linkState.State
 }
 
+// CreateLinkRequest - the configuration of a new link
+type CreateLinkRequest struct {
+	MaxIssuance              *int
+	ValidUntil               *time.Time
+	SchemaID                 uuid.UUID
+	CredentialExpiration     *time.Time
+	CredentialSignatureProof bool
+	CredentialMTPProof       bool
+	CredentialAttributes     domain.CredentialSubject
+	RefreshService           *verifiable.RefreshService
+	DisplayMethod            *verifiable.DisplayMethod
+	ExternalID               *string
+	PresentationTemplate     *string
+	Payment                  *domain.LinkPayment
+	Locale                   *string
+	Prerequisites            []LinkPrerequisiteRequest
+	Restrictions             *domain.LinkRestrictions
+	Throttle                 *domain.LinkThrottle
+	CredentialExtensions     *domain.CredentialExtensions
+	ResultWebhook            *domain.LinkResultWebhook
+}
+
+// LinkResultWebhook posts the results of the issuances of the links to their webhooks
+type LinkResultWebhook interface {
+	Notify(ctx context.Context, webhook domain.LinkResultWebhook, result domain.LinkIssuanceResult, template domain.WebhookTemplate) error
+}
+
 // LinkService - the interface that defines the available methods
 type LinkService interface {
-	Save(ctx context.Context, did w3c.DID, maxIssuance *int, validUntil *time.Time, schemaID uuid.UUID, credentialExpiration *time.Time, credentialSignatureProof bool, credentialMTPProof bool, credentialAttributes domain.CredentialSubject, refreshService *verifiable.RefreshService, displayMethod *verifiable.DisplayMethod) (*domain.Link, error)
+	Save(ctx context.Context, did w3c.DID, req CreateLinkRequest) (*domain.Link, error)
+	Clone(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*domain.Link, error)
+	CreateBatch(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, count int, externalIDs []string) ([]domain.Link, error)
 	Activate(ctx context.Context, issuerID w3c.DID, linkID uuid.UUID, active bool) error
 	Delete(ctx context.Context, id uuid.UUID, did w3c.DID) error
 	GetByID(ctx context.Context, issuerID w3c.DID, id uuid.UUID) (*domain.Link, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, status LinkStatus, query *string) ([]domain.Link, error)
-	CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string) (*CreateQRCodeResponse, error)
-	IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string, CredentialStatusType verifiable.CredentialStatusType) error
+	CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string, code string, clientIP net.IP) (*CreateQRCodeResponse, error)
+	ImportRecipients(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, r io.Reader) ([]domain.LinkRecipient, error)
+	GetRecipients(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkRecipient, error)
+	IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string, CredentialStatusType verifiable.CredentialStatusType, clientIP net.IP) error
 	GetQRCode(ctx context.Context, sessionID uuid.UUID, issuerID w3c.DID, linkID uuid.UUID) (*GetQRCodeResponse, error)
+	GetCatalog(ctx context.Context, issuerDID w3c.DID) ([]domain.CatalogEntry, error)
+	SavePrerequisiteProofs(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, proofs []protocol.ZeroKnowledgeProofResponse) error
+	SaveHolderProfile(ctx context.Context, sessionID string, userDID w3c.DID, linkID uuid.UUID, genesisDID w3c.DID, nonce *big.Int) error
+	GetPrerequisiteProofs(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkPrerequisiteProof, error)
+	Pay(ctx context.Context, sessionID string, issuerDID w3c.DID, linkID uuid.UUID, hostURL string, credentialStatusType verifiable.CredentialStatusType, message *protocol.CredentialPaymentMessage, clientIP net.IP) error
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// MigrationsService is the interface implemented by the database migrations service
+type MigrationsService interface {
+	Status(ctx context.Context) ([]domain.Migration, error)
+	Up(ctx context.Context) ([]domain.Migration, error)
+}
@@ -13,4 +13,5 @@ import (
 type MtService interface {
 	CreateIdentityMerkleTrees(ctx context.Context, conn db.Querier) (*domain.IdentityMerkleTrees, error)
 	GetIdentityMerkleTrees(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityMerkleTrees, error)
+	GetIdentityMerkleTreesBuffered(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityMerkleTrees, error)
 }
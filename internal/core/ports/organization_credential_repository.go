@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// OrganizationCredentialsFilter filters the organization credentials of the issuer
+type OrganizationCredentialsFilter struct {
+	Type       string
+	OnlyPublic bool
+}
+
+// OrganizationCredentialRepository defines the available methods for the organization credentials repository
+type OrganizationCredentialRepository interface {
+	Save(ctx context.Context, conn db.Querier, credential *domain.OrganizationCredential) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.OrganizationCredential, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter OrganizationCredentialsFilter) ([]domain.OrganizationCredential, error)
+	SetPublic(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, public bool) error
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// OrganizationCredentialService is the interface implemented by the organization credentials service
+type OrganizationCredentialService interface {
+	Receive(ctx context.Context, issuerDID w3c.DID, document json.RawMessage, public bool) (*domain.OrganizationCredential, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.OrganizationCredential, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, credentialType string) ([]domain.OrganizationCredential, error)
+	SetPublic(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, public bool) (*domain.OrganizationCredential, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	Present(ctx context.Context, issuerDID w3c.DID, credentialType string) (*domain.OrganizationPresentation, error)
+}
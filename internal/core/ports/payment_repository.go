@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// PaymentRepository defines the available methods for the payments repository
+type PaymentRepository interface {
+	Save(ctx context.Context, conn db.Querier, payment *domain.Payment) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Payment, error)
+	GetPaid(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID, userDID w3c.DID) (*domain.Payment, error)
+	MarkPaid(ctx context.Context, conn db.Querier, id uuid.UUID, txID string, paidAt time.Time) error
+}
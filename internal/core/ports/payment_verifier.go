@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PaymentVerifier checks on-chain that a transaction pays a payment requested to a holder
+type PaymentVerifier interface {
+	Verify(ctx context.Context, payment *domain.Payment, txID string) error
+}
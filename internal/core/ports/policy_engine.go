@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PolicyEngine is the interface implemented by the policy engine gateways
+type PolicyEngine interface {
+	Evaluate(ctx context.Context, input domain.PolicyInput) (*domain.PolicyDecision, error)
+}
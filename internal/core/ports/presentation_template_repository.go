@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// PresentationTemplateRepository defines the available methods for the presentation templates repository
+type PresentationTemplateRepository interface {
+	Save(ctx context.Context, conn db.Querier, template *domain.PresentationTemplate) error
+	GetByName(ctx context.Context, conn db.Querier, issuerDID w3c.DID, name string) (*domain.PresentationTemplate, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.PresentationTemplate, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, name string) error
+}
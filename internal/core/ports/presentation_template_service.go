@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreatePresentationTemplateRequest is the information needed to create a presentation template
+type CreatePresentationTemplateRequest struct {
+	Name            string
+	Description     *string
+	SchemaURL       string
+	CredentialType  string
+	ProofType       string
+	AllowedIssuers  []string
+	Conditions      []domain.PresentationCondition
+	DisclosedFields []string
+}
+
+// PresentationTemplateService is the interface implemented by the presentation template service
+type PresentationTemplateService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, req CreatePresentationTemplateRequest) (*domain.PresentationTemplate, error)
+	GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*domain.PresentationTemplate, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.PresentationTemplate, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, name string) error
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// PriceFeed is the interface implemented by the price feeds of the native token of the chain
+type PriceFeed interface {
+	Price(ctx context.Context) (float64, error)
+	Currency() string
+}
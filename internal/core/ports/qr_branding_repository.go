@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// QRBrandingRepository defines the available methods for the qr branding repository
+type QRBrandingRepository interface {
+	Save(ctx context.Context, conn db.Querier, branding *domain.QRBranding) error
+	GetByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (*domain.QRBranding, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// QRBrandingService is the interface implemented by the qr branding service
+type QRBrandingService interface {
+	Get(ctx context.Context, issuerDID w3c.DID) (*domain.QRBranding, error)
+	Save(ctx context.Context, branding *domain.QRBranding) error
+	RenderStoredQR(ctx context.Context, hostURL string, id uuid.UUID, size int) ([]byte, error)
+	RenderHTMLSnippet(ctx context.Context, issuerDID w3c.DID, snippet domain.QRSnippet, size int) ([]byte, error)
+}
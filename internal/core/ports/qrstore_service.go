@@ -13,4 +13,6 @@ type QrStoreService interface {
 	Find(ctx context.Context, id uuid.UUID) ([]byte, error)
 	Store(ctx context.Context, qrCode []byte, ttl time.Duration) (uuid.UUID, error)
 	ToURL(hostURL string, id uuid.UUID) string
+	ToEmbeddedURL(qrCode []byte, compress bool) (string, error)
+	SelectURL(hostURL string, id uuid.UUID, qrCode []byte, maxLength int, compress bool) (string, error)
 }
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// RevocationNonceRepository defines the available methods for the revocation nonces repository
+type RevocationNonceRepository interface {
+	ReserveRange(ctx context.Context, conn db.Querier, issuerDID w3c.DID, integration string, size uint64) (*domain.RevocationNonceRange, error)
+	GetRanges(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.RevocationNonceRange, error)
+	NextInRange(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (uint64, error)
+	NextSequential(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (uint64, error)
+	// Used returns true if a credential, archived or not, or a revocation has the nonce. A nil issuer checks all the
+	// issuers of the node.
+	Used(ctx context.Context, conn db.Querier, issuerDID *w3c.DID, nonce uint64) (bool, error)
+}
+
+// RevocationNonceService is the interface implemented by the service that generates the revocation nonces of the
+// credentials and manages the ranges reserved for the integrations
+type RevocationNonceService interface {
+	Next(ctx context.Context, issuerDID w3c.DID, rangeID *uuid.UUID) (uint64, error)
+	Check(ctx context.Context, issuerDID w3c.DID, nonce uint64) error
+	ReserveRange(ctx context.Context, issuerDID w3c.DID, integration string, size uint64) (*domain.RevocationNonceRange, error)
+	GetRanges(ctx context.Context, issuerDID w3c.DID) ([]domain.RevocationNonceRange, error)
+}
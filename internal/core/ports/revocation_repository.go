@@ -12,4 +12,6 @@ import (
 // RevocationRepository interface that defines the available methods
 type RevocationRepository interface {
 	UpdateStatus(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error)
+	GetPending(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error)
+	UpdateIdentityState(ctx context.Context, conn db.Querier, revocations []*domain.Revocation, state string) error
 }
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// RevocationRequestRepository defines the available methods for the revocation requests repository
+type RevocationRequestRepository interface {
+	Save(ctx context.Context, conn db.Querier, request *domain.RevocationRequest) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRequest, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.RevocationRequestStatus) ([]domain.RevocationRequest, error)
+	Resolve(ctx context.Context, conn db.Querier, request *domain.RevocationRequest) error
+}
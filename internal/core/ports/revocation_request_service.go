@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// RevocationRequestService is the interface implemented by the revocation request service
+type RevocationRequestService interface {
+	Request(ctx context.Context, session *domain.HolderSession, credentialID uuid.UUID, reason string) (*domain.RevocationRequest, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.RevocationRequestStatus) ([]domain.RevocationRequest, error)
+	Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, note *string) (*domain.RevocationRequest, error)
+	Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, note *string) (*domain.RevocationRequest, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// RevocationRuleRepository defines the available methods for the revocation rules repository
+type RevocationRuleRepository interface {
+	Save(ctx context.Context, conn db.Querier, rule *domain.RevocationRule) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRule, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.RevocationRule, error)
+	GetDue(ctx context.Context, conn db.Querier, now time.Time) ([]domain.RevocationRule, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+	StartRun(ctx context.Context, conn db.Querier, id uuid.UUID, scheduledAt time.Time, nextRunAt *time.Time) (bool, error)
+	FinishRun(ctx context.Context, conn db.Querier, id uuid.UUID, revoked int) error
+	GetCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaType string, issuedBefore time.Time) ([]domain.RevocationRuleCredential, error)
+}
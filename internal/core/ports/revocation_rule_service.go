@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// RevocationRuleRequest is the information needed to create or update a revocation rule. In the updates, nil fields
+// are not modified.
+type RevocationRuleRequest struct {
+	Name           *string
+	SchemaType     *string
+	CronExpression *string
+	WebhookURL     *string
+	Enabled        *bool
+}
+
+// RevocationRuleService is the interface implemented by the revocation rule service
+type RevocationRuleService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, req RevocationRuleRequest) (*domain.RevocationRule, error)
+	Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req RevocationRuleRequest) (*domain.RevocationRule, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRule, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.RevocationRule, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	Preview(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRulePreview, error)
+	RunDue(ctx context.Context)
+}
+
+// RevocationRuleWebhook sends the result of the runs of the revocation rules to their webhooks
+type RevocationRuleWebhook interface {
+	Notify(ctx context.Context, url string, run domain.RevocationRuleRun) error
+}
@@ -14,4 +14,9 @@ type SchemaRepository interface {
 	Save(ctx context.Context, schema *domain.Schema) error
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	GetByURL(ctx context.Context, issuerDID w3c.DID, url string, sType string) (*domain.Schema, error)
+	UpdateExpiration(ctx context.Context, schema *domain.Schema) error
+	UpdateUniqueness(ctx context.Context, schema *domain.Schema) error
+	UpdateSelfRevocation(ctx context.Context, schema *domain.Schema) error
+	UpdateWebhookTemplate(ctx context.Context, schema *domain.Schema) error
 }
@@ -14,6 +14,10 @@ type SchemaService interface {
 	ImportSchema(ctx context.Context, issuerDID w3c.DID, req *ImportSchemaRequest) (*domain.Schema, error)
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	UpdateExpiration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, defaultExpirationDays *int, maxExpirationDays *int) (*domain.Schema, error)
+	UpdateUniqueness(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, attributes []string, strategy domain.SchemaUniqueStrategy) (*domain.Schema, error)
+	UpdateSelfRevocation(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, policy domain.SchemaSelfRevocation) (*domain.Schema, error)
+	UpdateWebhookTemplate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, template domain.WebhookTemplate) (*domain.Schema, error)
 }
 
 // ImportSchemaRequest defines the request for importing a schema
@@ -23,6 +27,12 @@ type ImportSchemaRequest struct {
 	Title       *string
 	Description *string
 	Version     string
+
+	DefaultExpirationDays *int
+	MaxExpirationDays     *int
+
+	UniqueAttributes []string
+	UniqueStrategy   domain.SchemaUniqueStrategy
 }
 
 // NewImportSchemaRequest creates a new ImportSchemaRequest
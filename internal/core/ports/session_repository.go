@@ -2,9 +2,11 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/iden3/iden3comm/v2/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
 )
 
@@ -14,4 +16,9 @@ type SessionRepository interface {
 	Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error
 	SetLink(ctx context.Context, key string, value link_state.State) error
 	GetLink(ctx context.Context, key string) (link_state.State, error)
+	SetLinkHolder(ctx context.Context, key string, value domain.LinkHolder) error
+	GetLinkHolder(ctx context.Context, key string) (domain.LinkHolder, error)
+	SetHolderSession(ctx context.Context, key string, value domain.HolderSession, ttl time.Duration) error
+	GetHolderSession(ctx context.Context, key string) (domain.HolderSession, error)
+	Delete(ctx context.Context, key string) error
 }
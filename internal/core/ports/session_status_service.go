@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SessionStatusService is the interface implemented by the session status service
+type SessionStatusService interface {
+	Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SessionStatus, error)
+}
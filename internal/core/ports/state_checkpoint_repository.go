@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StateCheckpointRepository defines the available methods for the state checkpoints repository
+type StateCheckpointRepository interface {
+	Save(ctx context.Context, conn db.Querier, checkpoint *domain.StateCheckpoint) error
+	GetLatest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, anchorType string) (*domain.StateCheckpoint, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.StateCheckpoint, error)
+	TryLock(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (bool, error)
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// StateCheckpointService is the interface implemented by the state checkpoint service
+type StateCheckpointService interface {
+	CheckpointAll(ctx context.Context)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.StateCheckpoint, error)
+}
+
+// StateAnchor anchors a state in a secondary chain or timestamping service
+type StateAnchor interface {
+	Type() string
+	// Anchor returns the reference of the anchor and, for the services that return one, its receipt
+	Anchor(ctx context.Context, state *merkletree.Hash) (reference string, receipt []byte, err error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatsRepository defines the available methods for the stats repository
+type StatsRepository interface {
+	Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, from time.Time, days int) (*domain.Stats, error)
+	GetGasSpend(ctx context.Context, conn db.Querier, issuerDID w3c.DID, from time.Time, months int, currency string) ([]domain.MonthlyGasSpend, error)
+}
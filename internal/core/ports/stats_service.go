@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// StatsService is the interface implemented by the stats service
+type StatsService interface {
+	Get(ctx context.Context, issuerDID w3c.DID) (*domain.Stats, error)
+}
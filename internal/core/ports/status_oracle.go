@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+// StatusOracleResult is the answer of the status oracle for a credential
+type StatusOracleResult struct {
+	Eligible bool
+	Reason   string
+}
+
+// StatusOracle is an external system that tells whether the subject of a credential is still eligible for it
+type StatusOracle interface {
+	Check(ctx context.Context, credential *domain.Claim) (*StatusOracleResult, error)
+}
+
+// StatusOracleService revokes the credentials reported as ineligible by the status oracle
+type StatusOracleService interface {
+	RevokeIneligibleCredential(ctx context.Context, payload pubsub.Message) error
+}
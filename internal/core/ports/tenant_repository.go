@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TenantRepository defines the available methods for tenants repository
+type TenantRepository interface {
+	Save(ctx context.Context, conn db.Querier, tenant *domain.Tenant) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error)
+	GetAll(ctx context.Context, conn db.Querier) ([]domain.Tenant, error)
+	Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error
+	OwnsIdentity(ctx context.Context, conn db.Querier, id uuid.UUID, identifier w3c.DID) (bool, error)
+}
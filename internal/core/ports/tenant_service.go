@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TenantService is the interface implemented by the tenant service
+type TenantService interface {
+	Create(ctx context.Context, name string) (tenant *domain.Tenant, apiKey string, err error)
+	GetAll(ctx context.Context) ([]domain.Tenant, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, id uuid.UUID, apiKey string) (*domain.Tenant, error)
+	OwnsIdentity(ctx context.Context, id uuid.UUID, identifier w3c.DID) (bool, error)
+}
@@ -14,4 +14,5 @@ type TransactionService interface {
 	GetHeaderByNumber(ctx context.Context, blockNumber *big.Int) (*types.Header, error)
 	CheckConfirmation(ctx context.Context, receipt *types.Receipt) (bool, error)
 	GetTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error)
+	GetCurrentBlock(ctx context.Context) (*big.Int, error)
 }
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TranslationRepository defines the available methods for the translation repository
+type TranslationRepository interface {
+	Save(ctx context.Context, conn db.Querier, bundle *domain.TranslationBundle) error
+	Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, locale string) (*domain.TranslationBundle, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.TranslationBundle, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, locale string) error
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TranslationService is the interface implemented by the translation service
+type TranslationService interface {
+	Save(ctx context.Context, issuerDID w3c.DID, locale string, messages map[string]string) (*domain.TranslationBundle, error)
+	Get(ctx context.Context, issuerDID w3c.DID, locale string) (*domain.TranslationBundle, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.TranslationBundle, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, locale string) error
+	Localizer(ctx context.Context, issuerDID w3c.DID, locales ...string) *domain.Localizer
+}
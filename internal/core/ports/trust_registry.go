@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TrustRegistryEntry is the registration of a DID in a trust registry
+type TrustRegistryEntry struct {
+	Name string
+}
+
+// TrustRegistry is the interface implemented by the trust registry gateways. Lookup returns nil if the DID is not
+// in the registry.
+type TrustRegistry interface {
+	Lookup(ctx context.Context, did string) (*TrustRegistryEntry, error)
+}
+
+// TrustRegistryService is the interface implemented by the trust registry service
+type TrustRegistryService interface {
+	Check(ctx context.Context, did string) domain.TrustStatus
+	VerifyAuthorization(ctx context.Context, arm *protocol.AuthorizationResponseMessage) error
+}
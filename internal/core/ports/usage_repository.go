@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// UsageRepository is the interface implemented by the usage repository
+type UsageRepository interface {
+	Get(ctx context.Context, conn db.Querier, from, to time.Time, filter domain.UsageFilter) ([]domain.IssuerUsage, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// UsageService is the interface implemented by the usage service
+type UsageService interface {
+	Get(ctx context.Context, from, to time.Time, filter domain.UsageFilter) (*domain.UsageReport, error)
+}
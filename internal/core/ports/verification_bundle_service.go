@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// VerificationBundleService is the interface implemented by the verification bundle service
+type VerificationBundleService interface {
+	Get(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID) (*domain.VerificationBundle, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// WalletProfileRepository defines the available methods for the wallet profiles repository
+type WalletProfileRepository interface {
+	Observe(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID, observation domain.WalletObservation) error
+	Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.WalletProfile, error)
+	GetUsage(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.WalletUsage, error)
+}
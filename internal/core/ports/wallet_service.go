@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// WalletService records the wallets of the holders from their requests and selects the formats they support
+type WalletService interface {
+	Observe(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, observation domain.WalletObservation)
+	Get(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (*domain.WalletProfile, error)
+	GetUsage(ctx context.Context, issuerDID w3c.DID) ([]domain.WalletUsage, error)
+	QRFormat(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) domain.WalletQRFormat
+}
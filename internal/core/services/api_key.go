@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	apiKeySize   = 32
+	apiKeyPrefix = "isk_"
+
+	maxReadOnlyAPIKeyValidity = 24 * time.Hour
+)
+
+var (
+	ErrAPIKeyNotFound     = errors.New("api key not found")                 // ErrAPIKeyNotFound the api key does not exist or is revoked
+	ErrAPIKeyNameEmpty    = errors.New("api key name cannot be empty")      // ErrAPIKeyNameEmpty the api key name is mandatory
+	ErrAPIKeyNoScopes     = errors.New("api key needs at least a scope")    // ErrAPIKeyNoScopes the api key has no scopes
+	ErrAPIKeyInvalidScope = errors.New("invalid api key scope")             // ErrAPIKeyInvalidScope unknown scope
+	ErrAPIKeyInvalidIP    = errors.New("invalid api key allowed ip")        // ErrAPIKeyInvalidIP the allowlist entry is not an ip or a CIDR
+	ErrAPIKeyExpired      = errors.New("api key expiration is in the past") // ErrAPIKeyExpired the expiration date is in the past
+	ErrAPIKeyUnauthorized = errors.New("invalid api key")                   // ErrAPIKeyUnauthorized unknown, revoked or expired key
+	ErrAPIKeyIPNotAllowed = errors.New("api key not allowed from this ip")  // ErrAPIKeyIPNotAllowed the ip is not in the allowlist
+)
+
+// ErrAPIKeyReadOnlyValidity the validity of a read-only key is not positive or exceeds the maximum
+var ErrAPIKeyReadOnlyValidity = fmt.Errorf("the validity of a read-only api key must be positive and at most %s", maxReadOnlyAPIKeyValidity)
+
+type apiKeyService struct {
+	repo    ports.APIKeyRepository
+	storage *db.Storage
+}
+
+// NewAPIKey returns a new api key service
+func NewAPIKey(repo ports.APIKeyRepository, storage *db.Storage) ports.APIKeyService {
+	return &apiKeyService{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Create creates a new api key and returns it with its secret. The secret is not stored, only its hash.
+func (s *apiKeyService) Create(ctx context.Context, req ports.CreateAPIKeyRequest) (*domain.APIKey, string, error) {
+	return s.create(ctx, req, false)
+}
+
+// CreateReadOnly creates an api key with the read scopes of the credentials, connections and links, that expires after
+// the requested validity, at most a day. It is meant for the support engineers: the key can never be granted a write
+// scope, and every request made with it is audit logged as any api key request.
+func (s *apiKeyService) CreateReadOnly(ctx context.Context, req ports.CreateReadOnlyAPIKeyRequest) (*domain.APIKey, string, error) {
+	if req.ValidFor <= 0 || req.ValidFor > maxReadOnlyAPIKeyValidity {
+		return nil, "", ErrAPIKeyReadOnlyValidity
+	}
+	expiresAt := time.Now().UTC().Add(req.ValidFor)
+	return s.create(ctx, ports.CreateAPIKeyRequest{
+		Name:       req.Name,
+		Scopes:     domain.APIKeyReadOnlyScopes,
+		AllowedIPs: req.AllowedIPs,
+		ExpiresAt:  &expiresAt,
+		TenantID:   req.TenantID,
+	}, true)
+}
+
+func (s *apiKeyService) create(ctx context.Context, req ports.CreateAPIKeyRequest, readOnly bool) (*domain.APIKey, string, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, "", ErrAPIKeyNameEmpty
+	}
+	if len(req.Scopes) == 0 {
+		return nil, "", ErrAPIKeyNoScopes
+	}
+	for _, scope := range req.Scopes {
+		if !scope.IsValid() {
+			return nil, "", fmt.Errorf("%w: %s", ErrAPIKeyInvalidScope, scope)
+		}
+	}
+	for _, ip := range req.AllowedIPs {
+		if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+			return nil, "", fmt.Errorf("%w: %s", ErrAPIKeyInvalidIP, ip)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrAPIKeyExpired
+	}
+
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+	key := &domain.APIKey{
+		ID:         uuid.New(),
+		Name:       name,
+		KeyHash:    hashAPIKey(secret),
+		Scopes:     req.Scopes,
+		AllowedIPs: req.AllowedIPs,
+		ExpiresAt:  req.ExpiresAt,
+		CreatedAt:  time.Now().UTC(),
+		TenantID:   req.TenantID,
+		ReadOnly:   readOnly,
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, key); err != nil {
+		log.Error(ctx, "saving api key", "err", err)
+		return nil, "", err
+	}
+	log.Info(ctx, "audit: api key created", "apiKey", key.ID, "name", key.Name, "scopes", key.Scopes, "allowedIPs", key.AllowedIPs, "expiresAt", key.ExpiresAt, "tenant", key.TenantID, "readOnly", key.ReadOnly)
+	return key, secret, nil
+}
+
+// GetAll returns all the api keys
+func (s *apiKeyService) GetAll(ctx context.Context) ([]domain.APIKey, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx)
+}
+
+// Rotate replaces the secret of an api key keeping its scopes, allowlist and expiration.
+// The previous secret stops working immediately.
+func (s *apiKeyService) Rotate(ctx context.Context, id uuid.UUID) (*domain.APIKey, string, error) {
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.UpdateHash(ctx, s.storage.Pgx, id, hashAPIKey(secret)); err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyDoesNotExist) {
+			return nil, "", ErrAPIKeyNotFound
+		}
+		return nil, "", err
+	}
+	key, err := s.repo.GetByID(ctx, s.storage.Pgx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	log.Info(ctx, "audit: api key rotated", "apiKey", key.ID, "name", key.Name)
+	return key, secret, nil
+}
+
+// Revoke revokes an api key
+func (s *apiKeyService) Revoke(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.Revoke(ctx, s.storage.Pgx, id, time.Now().UTC()); err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyDoesNotExist) {
+			return ErrAPIKeyNotFound
+		}
+		return err
+	}
+	log.Info(ctx, "audit: api key revoked", "apiKey", id)
+	return nil
+}
+
+// Authenticate returns the api key of the secret if it is active and can be used from the ip
+func (s *apiKeyService) Authenticate(ctx context.Context, secret string, ip net.IP) (*domain.APIKey, error) {
+	if !strings.HasPrefix(secret, apiKeyPrefix) {
+		return nil, ErrAPIKeyUnauthorized
+	}
+	key, err := s.repo.GetByHash(ctx, s.storage.Pgx, hashAPIKey(secret))
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyDoesNotExist) {
+			return nil, ErrAPIKeyUnauthorized
+		}
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if !key.IsActive(now) {
+		return nil, ErrAPIKeyUnauthorized
+	}
+	if !key.AllowsIP(ip) {
+		log.Warn(ctx, "audit: api key used from a not allowed ip", "apiKey", key.ID, "ip", ip.String())
+		return nil, ErrAPIKeyIPNotAllowed
+	}
+	if err := s.repo.UpdateLastUsed(ctx, s.storage.Pgx, key.ID, now); err != nil {
+		log.Warn(ctx, "updating api key last use", "err", err, "apiKey", key.ID)
+	}
+	key.LastUsedAt = &now
+	return key, nil
+}
+
+func newAPIKeySecret() (string, error) {
+	key := make([]byte, apiKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(key), nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const (
+	authFailuresSessionKeyPrefix = "auth-failures-session-"
+	authFailuresIPKeyPrefix      = "auth-failures-ip-"
+	authLockedSessionKeyPrefix   = "auth-locked-session-"
+)
+
+// ErrAuthSessionLocked the session was invalidated after too many failed authentication attempts
+var ErrAuthSessionLocked = errors.New("too many failed authentication attempts, request a new authentication")
+
+type authAttempts struct {
+	cache          cache.Cache
+	sessionManager ports.SessionRepository
+	cfg            config.AuthProtection
+
+	failures            atomic.Uint64
+	delayed             atomic.Uint64
+	rejected            atomic.Uint64
+	sessionsInvalidated atomic.Uint64
+}
+
+// NewAuthAttempts returns a new service that protects the authentication callbacks against brute force attacks
+func NewAuthAttempts(c cache.Cache, sessionManager ports.SessionRepository, cfg config.AuthProtection) ports.AuthAttemptsService {
+	return &authAttempts{
+		cache:          c,
+		sessionManager: sessionManager,
+		cfg:            cfg,
+	}
+}
+
+// Check rejects the attempts to invalidated sessions and waits the backoff delay of the session or ip
+// with more failed attempts.
+func (a *authAttempts) Check(ctx context.Context, sessionID uuid.UUID, ip net.IP) error {
+	if a.cache.Exists(ctx, authLockedSessionKeyPrefix+sessionID.String()) {
+		a.rejected.Add(1)
+		log.Warn(ctx, "authentication attempt to an invalidated session", "session", sessionID, "ip", ip)
+		return ErrAuthSessionLocked
+	}
+
+	failures := a.count(ctx, authFailuresSessionKeyPrefix+sessionID.String())
+	if ipFailures := a.count(ctx, ipKey(ip)); ipFailures > failures {
+		failures = ipFailures
+	}
+	if failures == 0 {
+		return nil
+	}
+
+	a.delayed.Add(1)
+	select {
+	case <-time.After(a.delay(failures)):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Failed records a failed attempt and invalidates the session when it reaches the maximum number of failures
+func (a *authAttempts) Failed(ctx context.Context, sessionID uuid.UUID, ip net.IP) {
+	a.failures.Add(1)
+	sessionFailures := a.increment(ctx, authFailuresSessionKeyPrefix+sessionID.String())
+	a.increment(ctx, ipKey(ip))
+	log.Warn(ctx, "failed authentication attempt", "session", sessionID, "ip", ip, "failures", sessionFailures)
+
+	if sessionFailures < a.cfg.MaxFailures {
+		return
+	}
+	if err := a.sessionManager.Delete(ctx, sessionID.String()); err != nil {
+		log.Error(ctx, "invalidating authentication session", "err", err, "session", sessionID)
+	}
+	if err := a.cache.Set(ctx, authLockedSessionKeyPrefix+sessionID.String(), true, a.cfg.Window); err != nil {
+		log.Error(ctx, "locking authentication session", "err", err, "session", sessionID)
+	}
+	a.sessionsInvalidated.Add(1)
+	log.Warn(ctx, "authentication session invalidated after too many failed attempts", "session", sessionID, "ip", ip)
+}
+
+// Succeeded forgets the failed attempts of the session and the ip
+func (a *authAttempts) Succeeded(ctx context.Context, sessionID uuid.UUID, ip net.IP) {
+	for _, key := range []string{authFailuresSessionKeyPrefix + sessionID.String(), ipKey(ip)} {
+		if err := a.cache.Delete(ctx, key); err != nil {
+			log.Error(ctx, "resetting failed authentication attempts", "err", err, "key", key)
+		}
+	}
+}
+
+// Metrics returns the counters since the service started
+func (a *authAttempts) Metrics() ports.AuthAttemptsMetrics {
+	return ports.AuthAttemptsMetrics{
+		Failures:            a.failures.Load(),
+		Delayed:             a.delayed.Load(),
+		Rejected:            a.rejected.Load(),
+		SessionsInvalidated: a.sessionsInvalidated.Load(),
+	}
+}
+
+// delay returns BaseDelay * 2^(failures-1), up to MaxDelay
+func (a *authAttempts) delay(failures int) time.Duration {
+	delay := a.cfg.BaseDelay
+	for i := 1; i < failures && delay < a.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > a.cfg.MaxDelay {
+		return a.cfg.MaxDelay
+	}
+	return delay
+}
+
+func (a *authAttempts) count(ctx context.Context, key string) int {
+	return int(a.cache.Counter(ctx, key))
+}
+
+func (a *authAttempts) increment(ctx context.Context, key string) int {
+	failures, err := a.cache.Increment(ctx, key, a.cfg.Window)
+	if err != nil {
+		log.Error(ctx, "recording failed authentication attempt", "err", err, "key", key)
+	}
+	return int(failures)
+}
+
+func ipKey(ip net.IP) string {
+	return authFailuresIPKeyPrefix + ip.String()
+}
@@ -0,0 +1,63 @@
+package services_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+func TestAuthAttempts(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache()
+	sessionManager := repositories.NewSessionCached(c)
+	cfg := config.AuthProtection{MaxFailures: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: 20 * time.Millisecond, Window: time.Minute}
+	authAttempts := services.NewAuthAttempts(c, sessionManager, cfg)
+	ip := net.ParseIP("192.0.2.1")
+
+	t.Run("should not delay the first attempt", func(t *testing.T) {
+		start := time.Now()
+		require.NoError(t, authAttempts.Check(ctx, uuid.New(), net.ParseIP("192.0.2.2")))
+		assert.Less(t, time.Since(start), cfg.BaseDelay)
+	})
+
+	t.Run("should delay the attempts after a failure", func(t *testing.T) {
+		sessionID := uuid.New()
+		authAttempts.Failed(ctx, sessionID, ip)
+		start := time.Now()
+		require.NoError(t, authAttempts.Check(ctx, sessionID, ip))
+		assert.GreaterOrEqual(t, time.Since(start), cfg.BaseDelay)
+
+		authAttempts.Succeeded(ctx, sessionID, ip)
+		start = time.Now()
+		require.NoError(t, authAttempts.Check(ctx, sessionID, ip))
+		assert.Less(t, time.Since(start), cfg.BaseDelay)
+	})
+
+	t.Run("should invalidate the session after too many failures", func(t *testing.T) {
+		sessionID := uuid.New()
+		require.NoError(t, sessionManager.Set(ctx, sessionID.String(), protocol.AuthorizationRequestMessage{ID: sessionID.String()}))
+		for i := 0; i < cfg.MaxFailures; i++ {
+			require.NoError(t, authAttempts.Check(ctx, sessionID, ip))
+			authAttempts.Failed(ctx, sessionID, ip)
+		}
+		assert.ErrorIs(t, authAttempts.Check(ctx, sessionID, ip), services.ErrAuthSessionLocked)
+		_, err := sessionManager.Get(ctx, sessionID.String())
+		assert.Error(t, err)
+
+		metrics := authAttempts.Metrics()
+		assert.Equal(t, uint64(1), metrics.SessionsInvalidated)
+		assert.Equal(t, uint64(1), metrics.Rejected)
+		assert.Equal(t, uint64(cfg.MaxFailures+1), metrics.Failures)
+	})
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const (
+	maxCampaignName  = 200
+	maxCampaignItems = 1000
+)
+
+// DefaultCampaignMilestones are the milestones of the campaigns created without them
+var DefaultCampaignMilestones = []int{25, 50, 75, 100}
+
+var (
+	ErrCampaignNotFound           = errors.New("campaign not found")                            // ErrCampaignNotFound the campaign does not exist
+	ErrCampaignInvalid            = errors.New("invalid campaign")                              // ErrCampaignInvalid the name, the dates, the webhook or the milestones are not valid
+	ErrCampaignLinkNotFound       = errors.New("link not found or not in the campaign")         // ErrCampaignLinkNotFound the link is not of the issuer or not in the campaign
+	ErrCampaignCredentialNotFound = errors.New("credential not found")                          // ErrCampaignCredentialNotFound the credential is not of the issuer
+	ErrCampaignEmptyItems         = errors.New("at least one id is needed, up to 1000 of them") // ErrCampaignEmptyItems no links or credentials, or too many of them, to add
+)
+
+type campaign struct {
+	repo    ports.CampaignRepository
+	webhook ports.CampaignWebhook
+	storage *db.Storage
+}
+
+// NewCampaign returns a new campaign service
+func NewCampaign(repo ports.CampaignRepository, webhook ports.CampaignWebhook, storage *db.Storage) ports.CampaignService {
+	return &campaign{
+		repo:    repo,
+		webhook: webhook,
+		storage: storage,
+	}
+}
+
+// Create validates and stores a new campaign. Campaigns without milestones get the default ones.
+func (s *campaign) Create(ctx context.Context, issuerDID w3c.DID, req ports.CampaignRequest) (*domain.Campaign, error) {
+	name := ""
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+	}
+	milestones := append([]int{}, DefaultCampaignMilestones...)
+	if req.Milestones != nil {
+		milestones = *req.Milestones
+	}
+	campaign := domain.NewCampaign(issuerDID, name, req.Description, req.StartAt, req.EndAt, req.WebhookURL, milestones)
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// Update modifies the fields of the request that are not nil. An empty webhook removes it.
+func (s *campaign) Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req ports.CampaignRequest) (*domain.Campaign, error) {
+	campaign, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		campaign.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		campaign.Description = req.Description
+	}
+	if req.StartAt != nil {
+		campaign.StartAt = req.StartAt
+	}
+	if req.EndAt != nil {
+		campaign.EndAt = req.EndAt
+	}
+	if req.WebhookURL != nil {
+		campaign.WebhookURL = req.WebhookURL
+		if *req.WebhookURL == "" {
+			campaign.WebhookURL = nil
+		}
+	}
+	if req.Milestones != nil {
+		campaign.Milestones = *req.Milestones
+	}
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// GetByID returns the campaign of the issuer with its progress
+func (s *campaign) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Campaign, error) {
+	campaign, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrCampaignDoesNotExist) {
+		return nil, ErrCampaignNotFound
+	}
+	return campaign, err
+}
+
+// GetAll returns all the campaigns of the issuer with their progress
+func (s *campaign) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.Campaign, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
+
+// Delete removes the campaign, but not its links and credentials
+func (s *campaign) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	err := s.repo.Delete(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrCampaignDoesNotExist) {
+		return ErrCampaignNotFound
+	}
+	return err
+}
+
+// AddLinks adds links of the issuer to the campaign. The credentials they issued and will issue are part of it.
+func (s *campaign) AddLinks(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, linkIDs []uuid.UUID) error {
+	linkIDs, err := campaignItems(linkIDs)
+	if err != nil {
+		return err
+	}
+	if _, err := s.GetByID(ctx, issuerDID, id); err != nil {
+		return err
+	}
+	err = s.repo.AddLinks(ctx, s.storage.Pgx, issuerDID, id, linkIDs)
+	if errors.Is(err, repositories.ErrCampaignLinkDoesNotExist) {
+		return ErrCampaignLinkNotFound
+	}
+	return err
+}
+
+// RemoveLink removes a link from the campaign
+func (s *campaign) RemoveLink(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, linkID uuid.UUID) error {
+	if _, err := s.GetByID(ctx, issuerDID, id); err != nil {
+		return err
+	}
+	err := s.repo.RemoveLink(ctx, s.storage.Pgx, id, linkID)
+	if errors.Is(err, repositories.ErrCampaignLinkDoesNotExist) {
+		return ErrCampaignLinkNotFound
+	}
+	return err
+}
+
+// AddCredentials adds credentials of the issuer to the campaign, like the ones of a bulk issuance. They count as
+// invited and claimed, so the milestones they reach are notified.
+func (s *campaign) AddCredentials(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, credentialIDs []uuid.UUID) error {
+	credentialIDs, err := campaignItems(credentialIDs)
+	if err != nil {
+		return err
+	}
+	if _, err := s.GetByID(ctx, issuerDID, id); err != nil {
+		return err
+	}
+	err = s.repo.AddCredentials(ctx, s.storage.Pgx, issuerDID, id, credentialIDs)
+	if errors.Is(err, repositories.ErrCampaignCredentialDoesNotExist) {
+		return ErrCampaignCredentialNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	campaign, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return err
+	}
+	s.notifyMilestones(ctx, campaign)
+	return nil
+}
+
+// NotifyMilestones handles the create credential events, sending the milestones reached by the campaigns of the
+// credentials to their webhooks
+func (s *campaign) NotifyMilestones(ctx context.Context, e pubsub.Message) error {
+	var cEvent event.CreateCredential
+	if err := cEvent.Unmarshal(e); err != nil {
+		return errors.New("notifyMilestones unexpected data type")
+	}
+	issuerDID, err := w3c.ParseDID(cEvent.IssuerID)
+	if err != nil {
+		log.Error(ctx, "notifyMilestones: failed to parse issuerID", "err", err, "issuerID", cEvent.IssuerID)
+		return err
+	}
+	credentialIDs := make([]uuid.UUID, 0, len(cEvent.CredentialIDs))
+	for _, id := range cEvent.CredentialIDs {
+		credentialID, err := uuid.Parse(id)
+		if err != nil {
+			log.Error(ctx, "notifyMilestones: failed to parse credential id", "err", err, "id", id)
+			return err
+		}
+		credentialIDs = append(credentialIDs, credentialID)
+	}
+
+	campaigns, err := s.repo.GetByCredentials(ctx, s.storage.Pgx, *issuerDID, credentialIDs)
+	if err != nil {
+		log.Error(ctx, "notifyMilestones: getting the campaigns of the credentials", "err", err, "issuerID", cEvent.IssuerID)
+		return err
+	}
+	for i := range campaigns {
+		s.notifyMilestones(ctx, &campaigns[i])
+	}
+	return nil
+}
+
+// notifyMilestones sends the milestones reached by the campaign that were not notified yet. Each one is recorded
+// before posting it, so it is notified once even if several credentials are issued at the same time.
+func (s *campaign) notifyMilestones(ctx context.Context, campaign *domain.Campaign) {
+	if campaign.WebhookURL == nil {
+		return
+	}
+	for _, milestone := range campaign.ReachedMilestones() {
+		marked, err := s.repo.MarkMilestoneNotified(ctx, s.storage.Pgx, campaign.ID, milestone)
+		if err != nil {
+			log.Error(ctx, "recording a campaign milestone", "err", err, "campaign", campaign.ID, "milestone", milestone)
+			return
+		}
+		if !marked {
+			continue
+		}
+		err = s.webhook.Notify(ctx, *campaign.WebhookURL, domain.CampaignMilestone{
+			CampaignID: campaign.ID,
+			Name:       campaign.Name,
+			Milestone:  milestone,
+			Progress:   campaign.Progress,
+			ReachedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			log.Error(ctx, "notifying a campaign milestone", "err", err, "campaign", campaign.ID, "milestone", milestone)
+			continue
+		}
+		log.Info(ctx, "campaign milestone notified", "campaign", campaign.ID, "milestone", milestone)
+	}
+}
+
+func validateCampaign(c *domain.Campaign) error {
+	if c.Name == "" || len(c.Name) > maxCampaignName {
+		return fmt.Errorf("%w: the name must have between 1 and %d characters", ErrCampaignInvalid, maxCampaignName)
+	}
+	if c.StartAt != nil && c.EndAt != nil && !c.EndAt.After(*c.StartAt) {
+		return fmt.Errorf("%w: the end date must be after the start date", ErrCampaignInvalid)
+	}
+	if c.WebhookURL != nil {
+		u, err := url.ParseRequestURI(*c.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: the webhook must be an http or https url", ErrCampaignInvalid)
+		}
+	}
+	seen := make(map[int]bool, len(c.Milestones))
+	for _, m := range c.Milestones {
+		if m < 1 || m > 100 {
+			return fmt.Errorf("%w: the milestones are percentages between 1 and 100", ErrCampaignInvalid)
+		}
+		if seen[m] {
+			return fmt.Errorf("%w: duplicated milestone %d", ErrCampaignInvalid, m)
+		}
+		seen[m] = true
+	}
+	return nil
+}
+
+// campaignItems removes the duplicated ids of the links or credentials to add to a campaign
+func campaignItems(ids []uuid.UUID) ([]uuid.UUID, error) {
+	unique := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 || len(unique) > maxCampaignItems {
+		return nil, ErrCampaignEmptyItems
+	}
+	return unique, nil
+}
@@ -0,0 +1,42 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestCampaign_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewCampaign(nil, nil, nil)
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	now := time.Now()
+
+	for _, tc := range []struct {
+		name string
+		req  ports.CampaignRequest
+	}{
+		{name: "no name", req: ports.CampaignRequest{}},
+		{name: "blank name", req: ports.CampaignRequest{Name: common.ToPointer("  ")}},
+		{name: "name too long", req: ports.CampaignRequest{Name: common.ToPointer(strings.Repeat("a", 201))}},
+		{name: "end before start", req: ports.CampaignRequest{Name: common.ToPointer("event"), StartAt: common.ToPointer(now), EndAt: common.ToPointer(now.Add(-time.Hour))}},
+		{name: "webhook not http", req: ports.CampaignRequest{Name: common.ToPointer("event"), WebhookURL: common.ToPointer("ftp://example.com/hook")}},
+		{name: "milestone out of range", req: ports.CampaignRequest{Name: common.ToPointer("event"), Milestones: &[]int{50, 101}}},
+		{name: "duplicated milestone", req: ports.CampaignRequest{Name: common.ToPointer("event"), Milestones: &[]int{50, 50}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.Create(ctx, *issuerDID, tc.req)
+			assert.ErrorIs(t, err, services.ErrCampaignInvalid)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// maxClaimArchiveBatchSize is the max number of claims archived in a transaction
+const maxClaimArchiveBatchSize = 1000
+
+var (
+	ErrClaimArchiveMinAge       = errors.New("olderThanDays must be at least 1")                               // ErrClaimArchiveMinAge the age of the claims to archive is not valid
+	ErrArchivedClaimNotFound    = errors.New("archived credential not found")                                  // ErrArchivedClaimNotFound the credential is not archived
+	ErrClaimRehydrationConflict = errors.New("a credential with the same index was issued after the archival") // ErrClaimRehydrationConflict the archived credential cannot be restored
+)
+
+// ErrClaimArchiveBatchSize the batch size of the archival is not valid
+var ErrClaimArchiveBatchSize = fmt.Errorf("the batch size must be between 1 and %d", maxClaimArchiveBatchSize)
+
+type claimArchival struct {
+	archiveRepository ports.ClaimArchiveRepository
+	claimsService     ports.ClaimsService
+	storage           *db.Storage
+}
+
+// NewClaimArchival returns a new claim archival service
+func NewClaimArchival(archiveRepository ports.ClaimArchiveRepository, claimsService ports.ClaimsService, storage *db.Storage) ports.ClaimArchivalService {
+	return &claimArchival{
+		archiveRepository: archiveRepository,
+		claimsService:     claimsService,
+		storage:           storage,
+	}
+}
+
+// Archive moves a batch of the claims older than req.MinAgeDays to the archive in a transaction and returns their ids.
+// The revocation nonces of the archived claims stay in the revocation tree, so their statuses can still be checked.
+func (s *claimArchival) Archive(ctx context.Context, issuerDID w3c.DID, req ports.ClaimArchiveRequest) ([]uuid.UUID, error) {
+	if req.MinAgeDays < 1 {
+		return nil, ErrClaimArchiveMinAge
+	}
+	if req.BatchSize < 1 || req.BatchSize > maxClaimArchiveBatchSize {
+		return nil, ErrClaimArchiveBatchSize
+	}
+
+	var ids []uuid.UUID
+	err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		ids, err = s.archiveRepository.Archive(ctx, tx, issuerDID, inactiveSince(req.MinAgeDays), req.BatchSize)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx, "archiving claims", "err", err)
+		return nil, err
+	}
+	if len(ids) > 0 {
+		log.Info(ctx, "audit: claims archived", "issuer", issuerDID.String(), "claims", ids)
+	}
+	return ids, nil
+}
+
+// ArchiveAll archives the old claims of the issuer batch by batch until none is left
+func (s *claimArchival) ArchiveAll(ctx context.Context, issuerDID w3c.DID, req ports.ClaimArchiveRequest) {
+	total := 0
+	for ctx.Err() == nil {
+		ids, err := s.Archive(ctx, issuerDID, req)
+		if err != nil {
+			return
+		}
+		total += len(ids)
+		if len(ids) < req.BatchSize {
+			break
+		}
+	}
+	log.Info(ctx, "claims archival finished", "issuer", issuerDID.String(), "claims", total)
+}
+
+// Rehydrate moves the archived claim back to the claims table and returns it
+func (s *claimArchival) Rehydrate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Claim, error) {
+	err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return s.archiveRepository.Rehydrate(ctx, tx, issuerDID, id)
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrArchivedClaimDoesNotExist) {
+			return nil, ErrArchivedClaimNotFound
+		}
+		if errors.Is(err, repositories.ErrArchivedClaimConflict) {
+			return nil, ErrClaimRehydrationConflict
+		}
+		log.Error(ctx, "rehydrating claim", "err", err, "id", id)
+		return nil, err
+	}
+	log.Info(ctx, "audit: claim rehydrated", "issuer", issuerDID.String(), "claim", id)
+	return s.claimsService.GetByID(ctx, &issuerDID, id)
+}
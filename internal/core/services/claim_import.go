@@ -0,0 +1,155 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// PolicyRequesterImport is the requester role of the credentials imported from documents built by other systems
+const PolicyRequesterImport = "import"
+
+// maxClaimImportSourceLength is the max length of the name of the system an imported credential comes from
+const maxClaimImportSourceLength = 255
+
+var (
+	ErrInvalidImportedCredential = errors.New("invalid imported credential")                                // ErrInvalidImportedCredential the imported document is not a credential the issuer can issue
+	ErrClaimImportSource         = errors.New("the source of the import is required, up to 255 characters") // ErrClaimImportSource the source of the import is not valid
+	ErrClaimImportProof          = errors.New("you must to provide at least one proof type")                // ErrClaimImportProof the import asks for no proof
+	ErrCredentialAlreadyImported = errors.New("the credential was already imported")                        // ErrCredentialAlreadyImported the issuer already imported the document
+	ErrClaimImportNotFound       = errors.New("the credential was not imported")                            // ErrClaimImportNotFound the credential does not come from an import
+)
+
+type claimImporter struct {
+	repository           ports.ClaimImportRepository
+	claimsService        ports.ClaimsService
+	storage              *db.Storage
+	credentialStatusType verifiable.CredentialStatusType
+}
+
+// NewClaimImport returns the service that imports the credentials built by other systems
+func NewClaimImport(repository ports.ClaimImportRepository, claimsService ports.ClaimsService, storage *db.Storage, credentialStatusType verifiable.CredentialStatusType) ports.ClaimImportService {
+	return &claimImporter{
+		repository:           repository,
+		claimsService:        claimsService,
+		storage:              storage,
+		credentialStatusType: credentialStatusType,
+	}
+}
+
+// Import issues the pre-built credential under the identity of the issuer: the subject is validated against the
+// schema of the credential, and the claim is signed and/or added to the claims tree as any other credential. The
+// revocation nonce, the expiration, the evidence and the terms of use of the document are kept. The provenance of the
+// import is recorded with the hash of the document, so the same document cannot be imported twice.
+func (s *claimImporter) Import(ctx context.Context, issuerDID w3c.DID, req ports.ClaimImportRequest) (*domain.ClaimImport, error) {
+	source := strings.TrimSpace(req.Source)
+	if source == "" || len(source) > maxClaimImportSourceLength {
+		return nil, ErrClaimImportSource
+	}
+	if !req.SignatureProof && !req.MTProof {
+		return nil, ErrClaimImportProof
+	}
+	var credential domain.ImportedCredential
+	if err := json.Unmarshal(req.Credential, &credential); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImportedCredential, err)
+	}
+	if err := credential.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImportedCredential, err)
+	}
+	schemaType, _ := credential.SchemaType()
+	hash, err := importedDocumentHash(req.Credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImportedCredential, err)
+	}
+
+	_, err = s.repository.GetByDocumentHash(ctx, s.storage.Pgx, issuerDID, hash)
+	if err == nil {
+		return nil, ErrCredentialAlreadyImported
+	}
+	if !errors.Is(err, repositories.ErrClaimImportDoesNotExist) {
+		log.Error(ctx, "getting claim import", "err", err)
+		return nil, err
+	}
+
+	var revNonce *uint64
+	if credential.CredentialStatus != nil {
+		revNonce = credential.CredentialStatus.RevocationNonce
+	}
+	proofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      req.SignatureProof,
+		Iden3SparseMerkleTreeProof: req.MTProof,
+	}
+	claimReq := ports.NewCreateClaimRequest(&issuerDID, credential.CredentialSchema.ID, credential.CredentialSubject, credential.ExpirationDate, schemaType, nil, nil, nil, proofs, nil, true, s.credentialStatusType, nil, revNonce, nil)
+	claimReq.ExternalID = req.SourceReference
+	claimReq.RequesterRole = PolicyRequesterImport
+	claimReq.Extensions = domain.CredentialExtensions{Evidence: credential.Evidence, TermsOfUse: credential.TermsOfUse}
+	claim, err := s.claimsService.Save(ctx, claimReq)
+	if err != nil {
+		log.Warn(ctx, "issuing imported credential", "err", err, "source", source)
+		return nil, err
+	}
+
+	claimImport := &domain.ClaimImport{
+		ID:              uuid.New(),
+		IssuerDID:       issuerDID.String(),
+		ClaimID:         claim.ID,
+		Source:          source,
+		SourceReference: req.SourceReference,
+		OriginalIssuer:  credential.IssuerID(),
+		DocumentHash:    hash,
+	}
+	if credential.ID != "" {
+		claimImport.OriginalID = &credential.ID
+	}
+	if err := s.repository.Save(ctx, s.storage.Pgx, claimImport); err != nil {
+		if errors.Is(err, repositories.ErrClaimImportDuplicated) {
+			log.Warn(ctx, "document imported concurrently", "claim", claim.ID, "hash", hash)
+			return nil, ErrCredentialAlreadyImported
+		}
+		log.Error(ctx, "saving claim import", "err", err, "claim", claim.ID)
+		return nil, err
+	}
+	log.Info(ctx, "audit: credential imported", "issuer", issuerDID.String(), "claim", claim.ID, "source", source, "hash", hash)
+	return claimImport, nil
+}
+
+// GetByClaimID returns the provenance of the imported credential
+func (s *claimImporter) GetByClaimID(ctx context.Context, issuerDID w3c.DID, claimID uuid.UUID) (*domain.ClaimImport, error) {
+	claimImport, err := s.repository.GetByClaimID(ctx, s.storage.Pgx, issuerDID, claimID)
+	if errors.Is(err, repositories.ErrClaimImportDoesNotExist) {
+		return nil, ErrClaimImportNotFound
+	}
+	return claimImport, err
+}
+
+// importedDocumentHash returns the sha256 of the document encoded with its keys sorted, so the formatting of the
+// document does not change it
+func importedDocumentHash(document json.RawMessage) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -7,13 +7,16 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core/v2"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-merkletree-sql/v2"
+	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
 	"github.com/iden3/go-schema-processor/v2/merklize"
 	"github.com/iden3/go-schema-processor/v2/processor"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
@@ -24,37 +27,80 @@ import (
 	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/credentialid"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	linkState "github.com/polygonid/sh-id-platform/pkg/link"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/rand"
 	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
 )
 
+// maxExternalIDLength is the max length of the external id integrators can attach to credentials and links
+const maxExternalIDLength = 256
+
+// statusOracleRevocationTTL is how long a queued revocation of an ineligible credential is remembered, so it is
+// queued only once while the notifications service processes it
+const statusOracleRevocationTTL = time.Hour
+
+// statusOracleWorkers is the number of background checks of the status oracle that run at once
+const statusOracleWorkers = 4
+
+// statusOracleQueueSize is the max number of background checks of the status oracle waiting for a worker. The checks
+// requested while the queue is full are dropped, the revocation status endpoints are public.
+const statusOracleQueueSize = 256
+
+// maxRevocationStatusBatchSize is the max number of revocation statuses returned at once
+const maxRevocationStatusBatchSize = 100
+
+// ErrExternalIDTooLong the external id provided by the integrator is longer than allowed
+var ErrExternalIDTooLong = fmt.Errorf("external id cannot be longer than %d characters", maxExternalIDLength)
+
+// ErrRevocationStatusBatchSize the batch of revocation statuses is empty or has more nonces than allowed
+var ErrRevocationStatusBatchSize = fmt.Errorf("between 1 and %d nonces are required", maxRevocationStatusBatchSize)
+
 var (
-	ErrClaimNotFound                     = errors.New("claim not found")                                               // ErrClaimNotFound Cannot retrieve the given claim
-	ErrSchemaNotFound                    = errors.New("schema not found")                                              // ErrSchemaNotFound Cannot retrieve the given schema from DB
-	ErrLinkNotFound                      = errors.New("link not found")                                                // ErrLinkNotFound Cannot get the given link from the DB
-	ErrJSONLdContext                     = errors.New("jsonLdContext must be a string")                                // ErrJSONLdContext Field jsonLdContext must be a string
-	ErrLoadingSchema                     = errors.New("cannot load schema")                                            // ErrLoadingSchema means the system cannot load the schema file
-	ErrMalformedURL                      = errors.New("malformed url")                                                 // ErrMalformedURL The schema url is wrong
-	ErrProcessSchema                     = errors.New("cannot process schema")                                         // ErrProcessSchema Cannot process schema
-	ErrParseClaim                        = errors.New("cannot parse claim")                                            // ErrParseClaim Cannot parse claim
-	ErrInvalidCredentialSubject          = errors.New("credential subject does not match the provided schema")         // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
-	ErrUnsupportedRefreshServiceType     = errors.New("unsupported refresh service type")                              // ErrUnsupportedRefreshServiceType means the refresh service type is not supported
-	ErrRefreshServiceLacksExpirationTime = errors.New("credential request with refresh service lacks expiration time") // ErrRefreshServiceLacksExpirationTime means the credential request includes a refresh service, but the expiration time is not set
-	ErrRefreshServiceLacksURL            = errors.New("credential request with refresh service lacks url")             // ErrRefreshServiceLacksURL means the credential request includes a refresh service, but the url is not set
-	ErrDisplayMethodLacksURL             = errors.New("credential request with display method lacks url")              // ErrDisplayMethodLacksURL means the credential request includes a display method, but the url is not set
-	ErrUnsupportedDisplayMethodType      = errors.New("unsupported display method type")                               // ErrUnsupportedDisplayMethodType means the display method type is not supported
-	ErrEmptyMTPProof                     = errors.New("mtp credentials must have a mtp proof to be fetched")           // ErrEmptyMTPProof means that a credential of MTP type can not be fetched if it does not contain the proof
+	ErrClaimNotFound                     = errors.New("claim not found")                                                                                          // ErrClaimNotFound Cannot retrieve the given claim
+	ErrSchemaNotFound                    = errors.New("schema not found")                                                                                         // ErrSchemaNotFound Cannot retrieve the given schema from DB
+	ErrLinkNotFound                      = errors.New("link not found")                                                                                           // ErrLinkNotFound Cannot get the given link from the DB
+	ErrJSONLdContext                     = errors.New("jsonLdContext must be a string")                                                                           // ErrJSONLdContext Field jsonLdContext must be a string
+	ErrLoadingSchema                     = errors.New("cannot load schema")                                                                                       // ErrLoadingSchema means the system cannot load the schema file
+	ErrMalformedURL                      = errors.New("malformed url")                                                                                            // ErrMalformedURL The schema url is wrong
+	ErrProcessSchema                     = errors.New("cannot process schema")                                                                                    // ErrProcessSchema Cannot process schema
+	ErrParseClaim                        = errors.New("cannot parse claim")                                                                                       // ErrParseClaim Cannot parse claim
+	ErrInvalidCredentialSubject          = errors.New("credential subject does not match the provided schema")                                                    // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
+	ErrUnsupportedRefreshServiceType     = errors.New("unsupported refresh service type")                                                                         // ErrUnsupportedRefreshServiceType means the refresh service type is not supported
+	ErrRefreshServiceLacksExpirationTime = errors.New("credential request with refresh service lacks expiration time")                                            // ErrRefreshServiceLacksExpirationTime means the credential request includes a refresh service, but the expiration time is not set
+	ErrRefreshServiceLacksURL            = errors.New("credential request with refresh service lacks url")                                                        // ErrRefreshServiceLacksURL means the credential request includes a refresh service, but the url is not set
+	ErrDisplayMethodLacksURL             = errors.New("credential request with display method lacks url")                                                         // ErrDisplayMethodLacksURL means the credential request includes a display method, but the url is not set
+	ErrUnsupportedDisplayMethodType      = errors.New("unsupported display method type")                                                                          // ErrUnsupportedDisplayMethodType means the display method type is not supported
+	ErrEmptyMTPProof                     = errors.New("mtp credentials must have a mtp proof to be fetched")                                                      // ErrEmptyMTPProof means that a credential of MTP type can not be fetched if it does not contain the proof
+	ErrIssuanceLimitExceeded             = errors.New("max number of credentials of this schema for the user reached")                                            // ErrIssuanceLimitExceeded means the user already holds the max number of credentials of the schema allowed by the issuance policy
+	ErrIssuanceCooldown                  = errors.New("credential of this schema issued to the user too recently")                                                // ErrIssuanceCooldown means the issuance policy cooldown period since the last credential of the schema has not elapsed
+	ErrDuplicateCredential               = errors.New("an identical credential has already been issued to the user")                                              // ErrDuplicateCredential means the user already holds a non revoked credential with the same type and subject
+	ErrUnsupportedMediaType              = errors.New("unsupported media type")                                                                                   // ErrUnsupportedMediaType means the media type policy does not allow the envelope of the agent message
+	ErrInvalidFetchPolicy                = errors.New("invalid credential fetch policy")                                                                          // ErrInvalidFetchPolicy means the fetch policy is unknown or the fresh authentication policy lacks a valid number of minutes
+	ErrCredentialFetchNotAllowed         = errors.New("claim doesn't relate to sender")                                                                           // ErrCredentialFetchNotAllowed means the fetch policy of the credential does not allow the sender to fetch it
+	ErrCredentialFetchAuthRequired       = errors.New("a recent authentication is required to fetch the credential")                                              // ErrCredentialFetchAuthRequired means the fetch policy of the credential requires the subject to authenticate again
+	ErrCredentialSubjectNotEligible      = errors.New("the credential subject is no longer eligible")                                                             // ErrCredentialSubjectNotEligible means the status oracle reported that the subject is no longer eligible for the credential
+	ErrStateNotFound                     = errors.New("published state not found")                                                                                // ErrStateNotFound means the issuer has no confirmed state matching the query
+	ErrStateQueryRequired                = errors.New("a state hash or a timestamp is required")                                                                  // ErrStateQueryRequired means the state query has neither a state hash nor a timestamp
+	ErrCredentialExpirationExceeded      = errors.New("the expiration exceeds the maximum allowed by the schema")                                                 // ErrCredentialExpirationExceeded means the requested expiration is later than the maximum expiration of the imported schema
+	ErrCredentialUniqueKeyTaken          = errors.New("an active credential with the same unique key already exists")                                             // ErrCredentialUniqueKeyTaken means the imported schema rejects the credentials with the unique key of an active one
+	ErrInvalidProvenance                 = errors.New("the provenance must name attributes of the credential subject, with their source and verification method") // ErrInvalidProvenance means the provenance of the attributes is incomplete
+	ErrInvalidCredentialSection          = errors.New("invalid evidence or termsOfUse section")                                                                   // ErrInvalidCredentialSection means an evidence or termsOfUse entry is malformed or the schema does not allow it
+	ErrQueryFieldNotSupported            = errors.New("unsupported query_field filter")                                                                           // ErrQueryFieldNotSupported means the credentials data is encrypted and the query_field filter can't be applied to the request
 )
 
 type claim struct {
@@ -70,25 +116,98 @@ type claim struct {
 	ipfsClient               *shell.Shell
 	revocationStatusResolver *revocation_status.RevocationStatusResolver
 	mediatypeManager         ports.MediatypeManager
+	issuancePolicy           config.IssuancePolicy
+	sessionManager           ports.SessionRepository
+	statusOracle             ports.StatusOracle
+	credentialIDTemplate     credentialid.Template
+	connectionsRepository    ports.ConnectionsRepository
+	fetchThreads             ports.FetchThreadsService
+	policyEngine             ports.PolicyEngine
+	policyEngineCfg          config.PolicyEngine
+	schemaRepository         ports.SchemaRepository
+	versionRepository        ports.CredentialVersionRepository
+	archiveRepository        ports.ClaimArchiveRepository
+	nonceService             ports.RevocationNonceService
+	expirationGracePeriod    time.Duration
+	oracleChecks             sync.Map
+	oracleQueue              chan statusOracleCheck
+	oracleRevocations        *invalidation.Local[struct{}]
+}
+
+// statusOracleCheck is a background check of the credentials of a nonce
+type statusOracleCheck struct {
+	ctx       context.Context
+	key       string
+	issuerDID w3c.DID
+	nonce     uint64
+}
+
+// ClaimDependencies are the collaborators of the claim service. The ones of the optional features can be left empty.
+type ClaimDependencies struct {
+	Repo                     ports.ClaimsRepository
+	IdentityService          ports.IdentityService
+	QrService                ports.QrStoreService
+	MtService                ports.MtService
+	IdentityStateRepository  ports.IdentityStateRepository
+	Loader                   loader.DocumentLoader
+	Storage                  *db.Storage
+	Host                     string
+	Publisher                pubsub.Publisher
+	IPFSGatewayURL           string
+	RevocationStatusResolver *revocation_status.RevocationStatusResolver
+	MediatypeManager         ports.MediatypeManager
+	IssuancePolicy           config.IssuancePolicy
+	SessionManager           ports.SessionRepository
+	StatusOracle             ports.StatusOracle
+	CredentialIDTemplate     credentialid.Template
+	ConnectionsRepository    ports.ConnectionsRepository
+	FetchThreads             ports.FetchThreadsService
+	PolicyEngine             ports.PolicyEngine
+	PolicyEngineConfig       config.PolicyEngine
+	SchemaRepository         ports.SchemaRepository
+	VersionRepository        ports.CredentialVersionRepository
+	ArchiveRepository        ports.ClaimArchiveRepository
+	NonceService             ports.RevocationNonceService
+	ExpirationGracePeriod    time.Duration
 }
 
 // NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocation_status.RevocationStatusResolver, mediatypeManager ports.MediatypeManager) ports.ClaimsService {
+func NewClaim(deps ClaimDependencies) ports.ClaimsService {
 	s := &claim{
-		host:                     host,
-		icRepo:                   repo,
-		identitySrv:              idenSrv,
-		mtService:                mtService,
-		qrService:                qrService,
-		identityStateRepository:  identityStateRepository,
-		storage:                  storage,
-		loader:                   ld,
-		publisher:                ps,
-		revocationStatusResolver: revocationStatusResolver,
-		mediatypeManager:         mediatypeManager,
-	}
-	if ipfsGatewayURL != "" {
-		s.ipfsClient = shell.NewShell(ipfsGatewayURL)
+		icRepo:                   deps.Repo,
+		identitySrv:              deps.IdentityService,
+		qrService:                deps.QrService,
+		mtService:                deps.MtService,
+		identityStateRepository:  deps.IdentityStateRepository,
+		loader:                   deps.Loader,
+		storage:                  deps.Storage,
+		host:                     deps.Host,
+		publisher:                deps.Publisher,
+		revocationStatusResolver: deps.RevocationStatusResolver,
+		mediatypeManager:         deps.MediatypeManager,
+		issuancePolicy:           deps.IssuancePolicy,
+		sessionManager:           deps.SessionManager,
+		statusOracle:             deps.StatusOracle,
+		credentialIDTemplate:     deps.CredentialIDTemplate,
+		connectionsRepository:    deps.ConnectionsRepository,
+		fetchThreads:             deps.FetchThreads,
+		policyEngine:             deps.PolicyEngine,
+		policyEngineCfg:          deps.PolicyEngineConfig,
+		schemaRepository:         deps.SchemaRepository,
+		versionRepository:        deps.VersionRepository,
+		archiveRepository:        deps.ArchiveRepository,
+		nonceService:             deps.NonceService,
+		expirationGracePeriod:    deps.ExpirationGracePeriod,
+		oracleRevocations:        invalidation.NewLocal[struct{}](statusOracleRevocationTTL),
+	}
+	if deps.IPFSGatewayURL != "" {
+		s.ipfsClient = shell.NewShell(deps.IPFSGatewayURL)
+	}
+	if deps.StatusOracle != nil {
+		s.oracleQueue = make(chan statusOracleCheck, statusOracleQueueSize)
+		for i := 0; i < statusOracleWorkers; i++ {
+			go s.statusOracleWorker()
+		}
 	}
 	return s
 }
@@ -98,11 +217,20 @@ func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, qrServ
 // 2.- Signature proof
 // 3.- MerkelTree proof
 func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	duplicate, err := c.FindDuplicate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate != nil {
+		log.Info(ctx, "returning existing credential instead of issuing a duplicate", "credential", duplicate.ID.String())
+		return duplicate, nil
+	}
+
 	claim, err := c.CreateCredential(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	claim.ID, err = c.icRepo.Save(ctx, c.storage.Pgx, claim)
+	claim.ID, err = c.SaveWithConn(ctx, c.storage.Pgx, claim)
 	if err != nil {
 		return nil, err
 	}
@@ -116,25 +244,175 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	return claim, nil
 }
 
+// revocationNonce returns the nonce of the request, checked for collisions, or a new one
+func (c *claim) revocationNonce(ctx context.Context, req *ports.CreateClaimRequest) (uint64, error) {
+	if c.nonceService == nil {
+		if req.RevNonce != nil {
+			return *req.RevNonce, nil
+		}
+		return rand.Int64()
+	}
+	if req.RevNonce != nil {
+		return *req.RevNonce, c.nonceService.Check(ctx, *req.DID, *req.RevNonce)
+	}
+	return c.nonceService.Next(ctx, *req.DID, req.RevNonceRangeID)
+}
+
+// deferMTProof adds a signature proof to the requests that only ask for a merkle tree proof when the deferred mtp
+// proof mode is enabled. The credential can be fetched and used right away, and the publisher attaches the merkle
+// tree proof and sends the credential offer again once the state that includes it is published.
+func (c *claim) deferMTProof(ctx context.Context, req *ports.CreateClaimRequest) {
+	if !c.issuancePolicy.DeferredMTPProof || !req.MTProof || req.SignatureProof {
+		return
+	}
+	log.Debug(ctx, "deferring the merkle tree proof of the credential, signing it", "schema", req.Schema)
+	req.SignatureProof = true
+}
+
+// SaveBatch creates the credentials of all the requests and saves them in a single transaction, so all of them
+// are issued or none. Every request is checked for duplicates like in Save, but not against the other requests
+// of the batch. The credentials are returned in the order of the requests.
+func (c *claim) SaveBatch(ctx context.Context, reqs []*ports.CreateClaimRequest) ([]*domain.Claim, error) {
+	claims := make([]*domain.Claim, len(reqs))
+	toSave := make([]*domain.Claim, 0, len(reqs))
+	signed := make(map[string][]string)
+	for i, req := range reqs {
+		duplicate, err := c.FindDuplicate(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("credential %d: %w", i, err)
+		}
+		if duplicate != nil {
+			log.Info(ctx, "returning existing credential instead of issuing a duplicate", "credential", duplicate.ID.String())
+			claims[i] = duplicate
+			continue
+		}
+
+		claim, err := c.CreateCredential(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("credential %d: %w", i, err)
+		}
+		claims[i] = claim
+		toSave = append(toSave, claim)
+		if req.SignatureProof {
+			signed[req.DID.String()] = append(signed[req.DID.String()], claim.ID.String())
+		}
+	}
+
+	err := c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, claim := range toSave {
+			if err := c.enforceUniqueKey(ctx, tx, claim); err != nil {
+				return err
+			}
+		}
+		_, err := c.icRepo.SaveBatch(ctx, tx, toSave)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx, "saving the credentials", "err", err, "credentials", len(toSave))
+		return nil, err
+	}
+
+	for issuer, ids := range signed {
+		err = c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: ids, IssuerID: issuer})
+		if err != nil {
+			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "issuer", issuer, "credentials", len(ids))
+		}
+	}
+
+	return claims, nil
+}
+
 // GetRevoked returns all the revoked credentials for the given state
 func (c *claim) GetRevoked(ctx context.Context, currentState string) ([]*domain.Claim, error) {
 	return c.icRepo.GetRevoked(ctx, c.storage.Pgx, currentState)
 }
 
+// FindDuplicate looks for a non revoked credential of the same type issued to the same subject with an identical
+// credentialSubject. It returns the existing credential when the issuance policy is configured to return duplicates or
+// ErrDuplicateCredential when it is configured to reject them. Forced requests and requests without a subject are
+// never considered duplicates.
+func (c *claim) FindDuplicate(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	if c.issuancePolicy.DuplicateCredentials == config.DuplicateCredentialsAllow || req.Force {
+		return nil, nil
+	}
+
+	subject, ok := req.CredentialSubject["id"].(string)
+	if !ok || subject == "" {
+		return nil, nil
+	}
+
+	requested := make(map[string]any, len(req.CredentialSubject)+1)
+	for k, v := range req.CredentialSubject {
+		requested[k] = v
+	}
+	requested["type"] = req.Type
+	requestedJSON, err := json.Marshal(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := c.icRepo.GetNonRevokedBySubjectAndSchemaType(ctx, c.storage.Pgx, *req.DID, subject, req.Type)
+	if err != nil {
+		log.Error(ctx, "getting credentials of the subject", "err", err, "subject", subject, "type", req.Type)
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		vc, err := candidate.GetVerifiableCredential()
+		if err != nil {
+			log.Warn(ctx, "cannot decode the credential", "err", err, "credential", candidate.ID.String())
+			continue
+		}
+		candidateJSON, err := json.Marshal(vc.CredentialSubject)
+		if err != nil {
+			return nil, err
+		}
+		if string(candidateJSON) != string(requestedJSON) {
+			continue
+		}
+
+		if c.issuancePolicy.DuplicateCredentials == config.DuplicateCredentialsReject {
+			log.Warn(ctx, "duplicate credential request rejected", "subject", subject, "existing", candidate.ID.String())
+			return nil, ErrDuplicateCredential
+		}
+		return candidate, nil
+	}
+
+	return nil, nil
+}
+
 // CreateCredential - Create a new Credential, but this method doesn't save it in the repository.
 func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	importedSchema, err := c.importedSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := applySchemaExpiration(ctx, req, importedSchema); err != nil {
+		return nil, err
+	}
+
 	if err := c.guardCreateClaimRequest(req); err != nil {
 		log.Warn(ctx, "validating create claim request", "req", req)
 		return nil, err
 	}
+	c.deferMTProof(ctx, req)
 
-	var nonce uint64
-	var err error
-	if req.RevNonce != nil {
-		nonce = *req.RevNonce
-	} else {
-		nonce, err = rand.Int64()
+	if err := validateCredentialExtensions(ctx, c.loader, req.Schema, req.Extensions, req.Provenance); err != nil {
+		return nil, err
+	}
+
+	if err := c.evaluatePolicy(ctx, req); err != nil {
+		return nil, err
 	}
+	// the policy engine may have modified the expiration
+	if err := applySchemaExpiration(ctx, req, importedSchema); err != nil {
+		return nil, err
+	}
+	if err := c.checkUniqueKey(ctx, req, importedSchema); err != nil {
+		return nil, err
+	}
+
+	nonce, err := c.revocationNonce(ctx, req)
 	if err != nil {
 		log.Error(ctx, "create a nonce", "err", err)
 		return nil, err
@@ -218,6 +496,10 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 	claim.Issuer = issuerDIDString
 	claim.ID = vcID
 
+	if err := c.guardIssuancePolicy(ctx, *req.DID, claim); err != nil {
+		return nil, err
+	}
+
 	if req.SignatureProof {
 		authClaim, err := c.GetAuthClaim(ctx, req.DID)
 		if err != nil {
@@ -225,7 +507,7 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 			return nil, err
 		}
 
-		proof, err := c.identitySrv.SignClaimEntry(ctx, authClaim, coreClaim)
+		proof, err := c.identitySrv.SignClaimEntry(kms.WithSigningOperation(ctx, kms.SigningOperationCredential, req.Schema), authClaim, coreClaim)
 		if err != nil {
 			log.Error(ctx, "cannot sign claim entry", "err", err)
 			return nil, err
@@ -256,6 +538,14 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		log.Error(ctx, "cannot set the credential", "err", err)
 		return nil, err
 	}
+	extensions := req.Extensions
+	extensions.Evidence = append(domain.ProvenanceEvidence(req.Provenance), req.Extensions.Evidence...)
+	if len(extensions.Evidence) > 0 || len(extensions.TermsOfUse) > 0 {
+		if err := claim.SetCredentialExtensions(extensions); err != nil {
+			log.Error(ctx, "cannot set the credential extensions", "err", err)
+			return nil, err
+		}
+	}
 
 	err = claim.CredentialStatus.Set(vc.CredentialStatus)
 	if err != nil {
@@ -265,14 +555,218 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 
 	claim.MtProof = req.MTProof
 	claim.LinkID = req.LinkID
+	claim.ExternalID = req.ExternalID
+	claim.ProfileID = req.ProfileID
+	claim.FetchPolicy = req.FetchPolicy
+	claim.Supersedes = req.Supersedes
 	claim.CreatedAt = *vc.IssuanceDate
 	return claim, nil
 }
 
+// SaveWithConn saves a credential created with CreateCredential using the given connection. The unique key of its
+// imported schema is enforced in the same transaction: the active credentials with the same key are revoked, or the
+// credential is rejected, depending on the schema. When the credential supersedes another one, the changes of the
+// credential subject are recorded as a new version in the same transaction.
+func (c *claim) SaveWithConn(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.enforceUniqueKey(ctx, tx, claim); err != nil {
+			return err
+		}
+		var err error
+		if id, err = c.icRepo.Save(ctx, tx, claim); err != nil {
+			return err
+		}
+		return c.saveVersion(ctx, tx, id, claim)
+	})
+	return id, err
+}
+
+// saveVersion records the changes of the credential subject from the credential superseded by the new one
+func (c *claim) saveVersion(ctx context.Context, tx db.Querier, id uuid.UUID, claim *domain.Claim) error {
+	if c.versionRepository == nil || claim.Supersedes == nil {
+		return nil
+	}
+	issuerDID, err := w3c.ParseDID(claim.Issuer)
+	if err != nil {
+		return err
+	}
+	previous, err := c.icRepo.GetByIdAndIssuer(ctx, tx, issuerDID, *claim.Supersedes)
+	if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+		log.Warn(ctx, "the superseded credential does not exist", "credential", id.String(), "supersedes", claim.Supersedes.String())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	previousVC, err := previous.GetVerifiableCredential()
+	if err != nil {
+		return err
+	}
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		return err
+	}
+
+	version := &domain.CredentialVersion{
+		ID:                   uuid.New(),
+		IssuerDID:            *issuerDID,
+		CredentialID:         id,
+		PreviousCredentialID: previous.ID,
+		Changes:              domain.DiffCredentialSubject(previousVC.CredentialSubject, vc.CredentialSubject),
+		CreatedAt:            time.Now().UTC(),
+	}
+	if err := c.versionRepository.Save(ctx, tx, version); err != nil {
+		log.Error(ctx, "saving credential version", "err", err, "credential", id.String(), "supersedes", previous.ID.String())
+		return err
+	}
+	log.Info(ctx, "audit: credential version saved", "credential", id.String(), "supersedes", previous.ID.String(), "version", version.Version, "changes", len(version.Changes))
+	return nil
+}
+
+// GetVersions returns the versions of the lineage of the credential, the oldest first. It is empty when the
+// credential neither superseded nor was superseded by another one.
+func (c *claim) GetVersions(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]domain.CredentialVersion, error) {
+	if _, err := c.GetByID(ctx, &issuerDID, id); err != nil {
+		return nil, err
+	}
+	if c.versionRepository == nil {
+		return []domain.CredentialVersion{}, nil
+	}
+	return c.versionRepository.GetLineage(ctx, c.storage.Pgx, issuerDID, id)
+}
+
+// InspectLayout builds the core claim of the credential request, without signing nor saving it, and returns how
+// the credential subject is laid out in its slots. Errors carry the reason reported by the schema processor.
+func (c *claim) InspectLayout(ctx context.Context, req *ports.CreateClaimRequest) (*domain.ClaimLayout, error) {
+	if err := c.guardCreateClaimRequest(req); err != nil {
+		return nil, err
+	}
+	var nonce uint64
+	if req.RevNonce != nil {
+		nonce = *req.RevNonce
+	}
+
+	schema, err := schemaPkg.LoadSchema(ctx, c.loader, req.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLoadingSchema, err)
+	}
+	if schema.Metadata == nil {
+		return nil, fmt.Errorf("%w: schema has no $metadata", ErrProcessSchema)
+	}
+	jsonLdContext, ok := schema.Metadata.Uris["jsonLdContext"].(string)
+	if !ok {
+		return nil, ErrJSONLdContext
+	}
+	jsonLD, err := jsonschema.Load(ctx, jsonLdContext, c.loader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLoadingSchema, err)
+	}
+	if _, err := merklize.TypeIDFromContext(jsonLD.BytesNoErr(), req.Type); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProcessSchema, err)
+	}
+
+	vc, err := c.createVC(ctx, req, uuid.New(), jsonLdContext, nonce)
+	if err != nil {
+		log.Error(ctx, "creating verifiable credential", "err", err)
+		return nil, err
+	}
+	opts := &processor.CoreClaimOptions{
+		RevNonce:              nonce,
+		MerklizedRootPosition: common.DefineMerklizedRootPosition(schema.Metadata, req.MerklizedRootPosition),
+		Version:               req.Version,
+		SubjectPosition:       req.SubjectPos,
+		Updatable:             false,
+	}
+	if c.ipfsClient != nil {
+		opts.MerklizerOpts = []merklize.MerklizeOption{merklize.WithDocumentLoader(c.loader)}
+	}
+	coreClaim, err := schemaPkg.Process(ctx, c.loader, req.Schema, vc, opts)
+	if err != nil {
+		if errors.Is(err, schemaPkg.ErrValidateData) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCredentialSubject, err)
+		}
+		if errors.Is(err, schemaPkg.ErrParseClaim) {
+			return nil, fmt.Errorf("%w: %s", ErrParseClaim, err)
+		}
+		if errors.Is(err, schemaPkg.ErrLoadSchema) {
+			return nil, fmt.Errorf("%w: %s", ErrLoadingSchema, err)
+		}
+		return nil, err
+	}
+
+	layout, err := domain.NewClaimLayout(coreClaim)
+	if err != nil {
+		log.Error(ctx, "building claim layout", "err", err)
+		return nil, err
+	}
+	fields := credentialSubjectFields(vc.CredentialSubject, "")
+	if !layout.Merklized {
+		parser := jsonSuite.Parser{}
+		for _, field := range fields {
+			if slot, err := parser.GetFieldSlotIndex(field, req.Type, jsonLD.BytesNoErr()); err == nil {
+				layout.SetField(slot, field)
+			}
+		}
+		return layout, nil
+	}
+
+	mz, err := vc.Merklize(ctx, opts.MerklizerOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParseClaim, err)
+	}
+	for _, field := range fields {
+		path, err := mz.ResolveDocPath("credentialSubject." + field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: resolving %s: %s", ErrParseClaim, field, err)
+		}
+		key, err := path.MtEntry()
+		if err != nil {
+			return nil, fmt.Errorf("%w: hashing the path of %s: %s", ErrParseClaim, field, err)
+		}
+		_, value, err := mz.Proof(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrParseClaim, err)
+		}
+		if value == nil {
+			continue
+		}
+		mtValue, err := value.MtEntry()
+		if err != nil {
+			return nil, fmt.Errorf("%w: hashing the value of %s: %s", ErrParseClaim, field, err)
+		}
+		layout.Entries = append(layout.Entries, domain.ClaimLayoutEntry{Field: field, Key: key, Value: mtValue})
+	}
+	return layout, nil
+}
+
+// credentialSubjectFields returns the paths of the leaf fields of the credential subject, sorted, but the subject
+// id and type
+func credentialSubjectFields(subject map[string]any, prefix string) []string {
+	fields := make([]string, 0, len(subject))
+	for name, value := range subject {
+		if prefix == "" && (name == "id" || name == "type") {
+			continue
+		}
+		if nested, ok := value.(map[string]any); ok {
+			fields = append(fields, credentialSubjectFields(nested, prefix+name+".")...)
+			continue
+		}
+		fields = append(fields, prefix+name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
 func (c *claim) Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) error {
 	return c.revoke(ctx, &id, nonce, description, c.storage.Pgx)
 }
 
+// RevokeWithConn revokes the claim using the given connection, so the revocation is part of the caller transaction
+func (c *claim) RevokeWithConn(ctx context.Context, conn db.Querier, id w3c.DID, nonce uint64, description string) error {
+	return c.revoke(ctx, &id, nonce, description, conn)
+}
+
 func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error {
 	credentials, err := c.icRepo.GetNonRevokedByConnectionAndIssuerID(ctx, c.storage.Pgx, connID, issuerID)
 	if err != nil {
@@ -315,8 +809,23 @@ func (c *claim) GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (*dom
 	return claim, nil
 }
 
-// GetCredentialQrCode creates a credential QR code for the given credential and returns the QR Link to be used
-func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string) (*ports.GetCredentialQrCodeResponse, error) {
+// GetPublicByID returns a claim by id regardless of its issuer. It backs the public credential metadata endpoint,
+// so the callers must not expose the credential subject.
+func (c *claim) GetPublicByID(ctx context.Context, id uuid.UUID) (*domain.Claim, error) {
+	claim, err := c.icRepo.GetByID(ctx, c.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, ErrClaimNotFound
+		}
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+// GetCredentialQrCode creates a credential QR code for the given credential and returns the QR Link to be used.
+// The offer description is translated with the localizer, which can be nil.
+func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string, localizer *domain.Localizer) (*ports.GetCredentialQrCodeResponse, error) {
 	getCredentialType := func(claim domain.Claim) string {
 		credentialType := claim.SchemaType
 		const schemaParts = 2
@@ -340,7 +849,7 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 		Body: protocol.CredentialsOfferMessageBody{
 			Credentials: []protocol.CredentialOffer{
 				{
-					Description: getCredentialType(*claim),
+					Description: localizer.T(domain.OfferDescriptionKey(getCredentialType(*claim)), getCredentialType(*claim)),
 					ID:          claim.ID.String(),
 				},
 			},
@@ -362,6 +871,7 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 	if err != nil {
 		return nil, err
 	}
+	c.RegisterOffer(ctx, qrCode.ThreadID, claim.ID)
 	return &ports.GetCredentialQrCodeResponse{
 		QrCodeURL:  c.qrService.ToURL(hostURL, qrID),
 		SchemaType: getCredentialType(*claim),
@@ -369,9 +879,16 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 	}, nil
 }
 
+// RegisterOffer records the thread of an offer of the credentials, so the fetch requests can be bound to it
+func (c *claim) RegisterOffer(ctx context.Context, threadID string, credentialIDs ...uuid.UUID) {
+	if c.fetchThreads != nil {
+		c.fetchThreads.Offer(ctx, threadID, credentialIDs...)
+	}
+}
+
 func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
 	if !c.mediatypeManager.AllowMediaType(req.Type, mediatype) {
-		err := fmt.Errorf("unsupported media type '%s' for message type '%s'", mediatype, req.Type)
+		err := fmt.Errorf("%w '%s' for message type '%s'", ErrUnsupportedMediaType, mediatype, req.Type)
 		log.Error(ctx, "agent: unsupported media type", "err", err)
 		return nil, err
 	}
@@ -411,6 +928,9 @@ func (c *claim) GetAll(ctx context.Context, did w3c.DID, filter *ports.ClaimsFil
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return nil, 0, ErrClaimNotFound
 		}
+		if errors.Is(err, repositories.ErrQueryFieldEncrypted) || errors.Is(err, repositories.ErrQueryFieldTooManyClaims) {
+			return nil, 0, fmt.Errorf("%w: %s", ErrQueryFieldNotSupported, err)
+		}
 		return nil, 0, err
 	}
 
@@ -418,47 +938,145 @@ func (c *claim) GetAll(ctx context.Context, did w3c.DID, filter *ports.ClaimsFil
 }
 
 func (c *claim) GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error) {
-	rID := new(big.Int).SetUint64(nonce)
-	revocationStatus := &verifiable.RevocationStatus{}
+	if c.statusOracle != nil {
+		c.checkStatusOracleInBackground(ctx, issuerDID, nonce)
+	}
 
 	state, err := c.identityStateRepository.GetLatestStateByIdentifier(ctx, c.storage.Pgx, &issuerDID)
 	if err != nil {
 		return nil, err
 	}
 
-	revocationStatus.Issuer.State = state.State
-	revocationStatus.Issuer.ClaimsTreeRoot = state.ClaimsTreeRoot
-	revocationStatus.Issuer.RevocationTreeRoot = state.RevocationTreeRoot
-	revocationStatus.Issuer.RootOfRoots = state.RootOfRoots
+	return c.revocationStatusAtState(ctx, issuerDID, nonce, state)
+}
+
+// GetRevocationStatuses returns the revocation statuses of the nonces. All of them are proved against the latest state
+// of the issuer, and the merkle trees are loaded once.
+func (c *claim) GetRevocationStatuses(ctx context.Context, issuerDID w3c.DID, nonces []uint64) ([]*verifiable.RevocationStatus, error) {
+	if len(nonces) == 0 || len(nonces) > maxRevocationStatusBatchSize {
+		return nil, ErrRevocationStatusBatchSize
+	}
+	if c.statusOracle != nil {
+		for _, nonce := range nonces {
+			c.checkStatusOracleInBackground(ctx, issuerDID, nonce)
+		}
+	}
 
-	if state.RevocationTreeRoot == nil {
-		var mtp *merkletree.Proof
-		mtp, err = merkletree.NewProofFromData(false, nil, nil)
+	state, err := c.identityStateRepository.GetLatestStateByIdentifier(ctx, c.storage.Pgx, &issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.revocationStatusesAtState(ctx, issuerDID, nonces, state)
+}
+
+// GetExpirationStatuses returns the expiration status of the credentials of the nonces, taking the grace period of the
+// issuer into account. The revocation proofs don't depend on the expiration, so it is only informational. The nonces
+// without credentials are left out.
+func (c *claim) GetExpirationStatuses(ctx context.Context, issuerDID w3c.DID, nonces []uint64) (map[uint64]string, error) {
+	now := time.Now()
+	statuses := make(map[uint64]string, len(nonces))
+	for _, nonce := range nonces {
+		claims, err := c.icRepo.GetByRevocationNonce(ctx, c.storage.Pgx, &issuerDID, domain.RevNonceUint64(nonce))
 		if err != nil {
+			if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+				continue
+			}
 			return nil, err
 		}
-		revocationStatus.MTP = *mtp
-		return revocationStatus, nil
+		for _, claim := range claims {
+			status := claim.ExpirationStatus(now, c.expirationGracePeriod)
+			if current, ok := statuses[nonce]; !ok || expirationStatusRank[status] < expirationStatusRank[current] {
+				statuses[nonce] = status
+			}
+		}
 	}
+	return statuses, nil
+}
+
+// expirationStatusRank orders the expiration statuses, when several credentials share a nonce the best one is reported
+var expirationStatusRank = map[string]int{
+	domain.ExpirationStatusValid:   0,
+	domain.ExpirationStatusGrace:   1,
+	domain.ExpirationStatusExpired: 2,
+}
 
-	revocationTreeHash, err := merkletree.NewHashFromHex(*state.RevocationTreeRoot)
+// GetRevocationStatusAtState returns the revocation status of the nonce in a past published state of the issuer.
+// The proof is built against the revocation tree root of that state, the merkle tree keeps the nodes of every version.
+func (c *claim) GetRevocationStatusAtState(ctx context.Context, issuerDID w3c.DID, nonce uint64, query ports.StateQuery) (*domain.IdentityState, *verifiable.RevocationStatus, error) {
+	var state *domain.IdentityState
+	var err error
+	switch {
+	case query.State != nil:
+		state, err = c.identityStateRepository.GetConfirmedState(ctx, c.storage.Pgx, issuerDID, *query.State)
+	case query.At != nil:
+		state, err = c.identityStateRepository.GetConfirmedStateAt(ctx, c.storage.Pgx, issuerDID, *query.At)
+	default:
+		return nil, nil, ErrStateQueryRequired
+	}
 	if err != nil {
-		return nil, err
+		if errors.Is(err, repositories.ErrIdentityStateDoesNotExist) {
+			return nil, nil, ErrStateNotFound
+		}
+		return nil, nil, err
 	}
-	identityTrees, err := c.mtService.GetIdentityMerkleTrees(ctx, c.storage.Pgx, &issuerDID)
+
+	revocationStatus, err := c.revocationStatusAtState(ctx, issuerDID, nonce, state)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return state, revocationStatus, nil
+}
 
-	// revocation / non revocation MTP for the latest identity state
-	proof, err := identityTrees.GenerateRevocationProof(ctx, rID, revocationTreeHash)
+func (c *claim) revocationStatusAtState(ctx context.Context, issuerDID w3c.DID, nonce uint64, state *domain.IdentityState) (*verifiable.RevocationStatus, error) {
+	statuses, err := c.revocationStatusesAtState(ctx, issuerDID, []uint64{nonce}, state)
 	if err != nil {
 		return nil, err
 	}
+	return statuses[0], nil
+}
+
+func (c *claim) revocationStatusesAtState(ctx context.Context, issuerDID w3c.DID, nonces []uint64, state *domain.IdentityState) ([]*verifiable.RevocationStatus, error) {
+	var identityTrees *domain.IdentityMerkleTrees
+	var revocationTreeHash *merkletree.Hash
+	if state.RevocationTreeRoot != nil {
+		var err error
+		if revocationTreeHash, err = merkletree.NewHashFromHex(*state.RevocationTreeRoot); err != nil {
+			return nil, err
+		}
+		if identityTrees, err = c.mtService.GetIdentityMerkleTrees(ctx, c.storage.Pgx, &issuerDID); err != nil {
+			return nil, err
+		}
+	}
 
-	revocationStatus.MTP = *proof
+	statuses := make([]*verifiable.RevocationStatus, 0, len(nonces))
+	for _, nonce := range nonces {
+		revocationStatus := &verifiable.RevocationStatus{}
+		revocationStatus.Issuer.State = state.State
+		revocationStatus.Issuer.ClaimsTreeRoot = state.ClaimsTreeRoot
+		revocationStatus.Issuer.RevocationTreeRoot = state.RevocationTreeRoot
+		revocationStatus.Issuer.RootOfRoots = state.RootOfRoots
 
-	return revocationStatus, nil
+		if identityTrees == nil {
+			mtp, err := merkletree.NewProofFromData(false, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			revocationStatus.MTP = *mtp
+			statuses = append(statuses, revocationStatus)
+			continue
+		}
+
+		// revocation / non revocation MTP for the given identity state
+		proof, err := identityTrees.GenerateRevocationProof(ctx, new(big.Int).SetUint64(nonce), revocationTreeHash)
+		if err != nil {
+			return nil, err
+		}
+		revocationStatus.MTP = *proof
+		statuses = append(statuses, revocationStatus)
+	}
+
+	return statuses, nil
 }
 
 func (c *claim) GetAuthClaimForPublishing(ctx context.Context, did *w3c.DID, state string) (*domain.Claim, error) {
@@ -559,12 +1177,18 @@ func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domai
 			return fmt.Errorf("claim has not been updated %v", claims[i])
 		}
 	}
-	_, err = c.identityStateRepository.UpdateState(ctx, c.storage.Pgx, currentState)
-	if err != nil {
-		return fmt.Errorf("can't update identity state: %w", err)
-	}
-
-	return nil
+	// The revocation statuses cached by the replicas are computed from the latest confirmed state, so they are
+	// invalidated in the same transaction that confirms the new one.
+	return c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := c.identityStateRepository.UpdateState(ctx, tx, currentState); err != nil {
+			return fmt.Errorf("can't update identity state: %w", err)
+		}
+		msg := invalidation.Message{Cache: RevocationStatusCacheName, Prefixes: []string{revocationStatusPrefix(*did)}}
+		if err := invalidation.Notify(ctx, tx, msg); err != nil {
+			return fmt.Errorf("can't invalidate revocation statuses: %w", err)
+		}
+		return nil
+	})
 }
 
 func (c *claim) GetByStateIDWithMTPProof(ctx context.Context, did *w3c.DID, state string) ([]*domain.Claim, error) {
@@ -595,12 +1219,15 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 	claims, err = c.icRepo.GetByRevocationNonce(ctx, querier, did, domain.RevNonceUint64(nonce))
 	if err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			if c.archiveRepository != nil {
+				return c.revokeArchived(ctx, did, &revocation, querier)
+			}
 			return err
 		}
 		return fmt.Errorf("error getting the claim by revocation nonce: %w", err)
 	}
 
-	err = c.storage.Pgx.BeginFunc(ctx,
+	err = querier.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
 			for _, claim := range claims {
 				claim.Revoked = true
@@ -621,6 +1248,20 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 	return nil
 }
 
+// revokeArchived revokes the nonce of a claim moved to the archive, and flags the archived claim as revoked
+func (c *claim) revokeArchived(ctx context.Context, did *w3c.DID, revocation *domain.Revocation, querier db.Querier) error {
+	return querier.BeginFunc(ctx, func(tx pgx.Tx) error {
+		archived, err := c.archiveRepository.RevokeByNonce(ctx, tx, *did, revocation.Nonce)
+		if err != nil {
+			return fmt.Errorf("error revoking the archived claim: %w", err)
+		}
+		if archived == 0 {
+			return repositories.ErrClaimDoesNotExist
+		}
+		return c.icRepo.RevokeNonce(ctx, tx, revocation)
+	})
+}
+
 func (c *claim) getRevocationStatus(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
 	revData := &protocol.RevocationStatusRequestMessageBody{}
 	err := json.Unmarshal(basicMessage.Body, revData)
@@ -646,14 +1287,17 @@ func (c *claim) getRevocationStatus(ctx context.Context, basicMessage *ports.Age
 }
 
 func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
-	fetchRequestBody := &protocol.CredentialFetchRequestMessageBody{}
+	fetchRequestBody := &credentialFetchRequestBody{}
 	err := json.Unmarshal(basicMessage.Body, fetchRequestBody)
 	if err != nil {
 		log.Error(ctx, "unmarshalling agent body", "err", err)
 		return nil, fmt.Errorf("invalid credential fetch request body: %w", err)
 	}
 
-	claimID, err := urn.UUIDFromURNString(fetchRequestBody.ID)
+	claimID, err := c.credentialIDTemplate.UUID(fetchRequestBody.ID)
+	if err != nil {
+		claimID, err = urn.UUIDFromURNString(fetchRequestBody.ID)
+	}
 	if err != nil {
 		claimID, err = uuid.Parse(fetchRequestBody.ID)
 		if err != nil {
@@ -668,29 +1312,169 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 		return nil, fmt.Errorf("failed get claim by claimID: %w", err)
 	}
 
-	if claim.OtherIdentifier != basicMessage.UserDID.String() {
-		err := fmt.Errorf("claim doesn't relate to sender")
-		log.Error(ctx, "claim doesn't relate to sender", err, "claimID", claim.ID)
+	if err := c.authorizeFetch(ctx, claim, basicMessage, fetchRequestBody.ProfileNonce); err != nil {
+		log.Warn(ctx, "credential fetch not allowed by the fetch policy", "err", err, "claimID", claim.ID, "policy", claim.FetchPolicy.Type, "sender", basicMessage.UserDID.String())
 		return nil, err
 	}
 
+	if !c.checkStatusOracle(ctx, claim) {
+		return nil, ErrCredentialSubjectNotEligible
+	}
+
+	if c.fetchThreads != nil {
+		if err := c.fetchThreads.Consume(ctx, basicMessage.ThreadID, claim.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	vc, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
 	if err != nil {
 		log.Error(ctx, "creating W3 credential", "err", err)
 		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
 	}
+	extensions, err := claim.GetCredentialExtensions()
+	if err != nil {
+		log.Error(ctx, "reading the credential extensions", "err", err)
+		return nil, err
+	}
+
+	if c.sessionManager != nil {
+		fetched := linkState.State{Status: linkState.StatusDone}
+		if err := c.sessionManager.SetLink(ctx, linkState.CredentialFetchCacheKey(claim.ID.String()), fetched); err != nil {
+			log.Warn(ctx, "saving credential fetch status", "err", err, "claimID", claim.ID)
+		}
+	}
 
 	return &domain.Agent{
 		ID:       uuid.NewString(),
 		Typ:      packers.MediaTypePlainMessage,
 		Type:     protocol.CredentialIssuanceResponseMessageType,
 		ThreadID: basicMessage.ThreadID,
-		Body:     protocol.IssuanceMessageBody{Credential: *vc},
+		Body:     issuanceMessageBody{Credential: domain.ExtendedCredential{W3CCredential: *vc, CredentialExtensions: extensions}},
 		From:     basicMessage.IssuerDID.String(),
 		To:       basicMessage.UserDID.String(),
 	}, err
 }
 
+// issuanceMessageBody is the body of protocol.CredentialIssuanceResponseMessageType. It replaces
+// protocol.IssuanceMessageBody to deliver the credential with its extensions.
+type issuanceMessageBody struct {
+	Credential domain.ExtendedCredential `json:"credential"`
+}
+
+// credentialFetchRequestBody is the body of a credential fetch request. Holders fetching a credential issued to their
+// genesis DID from one of its profiles send the nonce of the profile, so the issuer can derive the profile DID.
+type credentialFetchRequestBody struct {
+	protocol.CredentialFetchRequestMessageBody
+	ProfileNonce string `json:"profileNonce,omitempty"`
+}
+
+// authorizeFetch checks that the fetch policy of the credential allows the sender of the request to fetch it
+func (c *claim) authorizeFetch(ctx context.Context, claim *domain.Claim, req *ports.AgentRequest, profileNonce string) error {
+	sender := req.UserDID.String()
+	switch claim.FetchPolicy.Type {
+	case "", domain.CredentialFetchPolicySubject:
+		if claim.OtherIdentifier != sender {
+			return ErrCredentialFetchNotAllowed
+		}
+	case domain.CredentialFetchPolicyProfiles:
+		if claim.OtherIdentifier == sender {
+			return nil
+		}
+		subject, err := w3c.ParseDID(claim.OtherIdentifier)
+		if err != nil {
+			return ErrCredentialFetchNotAllowed
+		}
+		nonce, ok := new(big.Int).SetString(profileNonce, 10)
+		if !ok || !domain.IsProfileOf(*req.UserDID, *subject, nonce) {
+			return ErrCredentialFetchNotAllowed
+		}
+	case domain.CredentialFetchPolicyFreshAuth:
+		if claim.OtherIdentifier != sender {
+			return ErrCredentialFetchNotAllowed
+		}
+		if c.connectionsRepository == nil {
+			return ErrCredentialFetchAuthRequired
+		}
+		conn, err := c.connectionsRepository.GetByUserID(ctx, c.storage.Pgx, *req.IssuerDID, *req.UserDID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+				return ErrCredentialFetchAuthRequired
+			}
+			return err
+		}
+		maxAge := time.Duration(claim.FetchPolicy.FreshAuthMinutes) * time.Minute
+		if conn.LastVerifiedAt == nil || time.Since(*conn.LastVerifiedAt) > maxAge {
+			return ErrCredentialFetchAuthRequired
+		}
+	default:
+		return ErrCredentialFetchNotAllowed
+	}
+	return nil
+}
+
+// checkStatusOracleInBackground queues a check of the credentials of the nonce with the status oracle, so the
+// revocation status response is not delayed. Only one check of the same nonce is queued or running at a time, and the
+// check is dropped if the queue is full.
+func (c *claim) checkStatusOracleInBackground(ctx context.Context, issuerDID w3c.DID, nonce uint64) {
+	key := fmt.Sprintf("%s/%d", issuerDID.String(), nonce)
+	if _, running := c.oracleChecks.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+	select {
+	case c.oracleQueue <- statusOracleCheck{ctx: context.WithoutCancel(ctx), key: key, issuerDID: issuerDID, nonce: nonce}:
+	default:
+		c.oracleChecks.Delete(key)
+		log.Debug(ctx, "status oracle queue is full, check dropped", "nonce", nonce)
+	}
+}
+
+// statusOracleWorker runs the queued checks of the status oracle
+func (c *claim) statusOracleWorker() {
+	for check := range c.oracleQueue {
+		claims, err := c.icRepo.GetByRevocationNonce(check.ctx, c.storage.Pgx, &check.issuerDID, domain.RevNonceUint64(check.nonce))
+		if err != nil && !errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			log.Error(check.ctx, "loading the credentials for the status oracle", "err", err, "nonce", check.nonce)
+		}
+		for _, claim := range claims {
+			c.checkStatusOracle(check.ctx, claim)
+		}
+		c.oracleChecks.Delete(check.key)
+	}
+}
+
+// checkStatusOracle asks the status oracle whether the subject of the credential is still eligible for it.
+// Oracle failures are logged and the credential is considered eligible. When the subject is no longer eligible,
+// the revocation of the credential is queued, once, and false is returned.
+func (c *claim) checkStatusOracle(ctx context.Context, claim *domain.Claim) bool {
+	if c.statusOracle == nil || claim.Revoked || claim.OtherIdentifier == "" {
+		return true
+	}
+
+	result, err := c.statusOracle.Check(ctx, claim)
+	if err != nil {
+		log.Error(ctx, "status oracle request failed", "err", err, "credential", claim.ID)
+		return true
+	}
+	if result.Eligible {
+		return true
+	}
+
+	log.Warn(ctx, "status oracle reported an ineligible credential subject", "credential", claim.ID, "subject", claim.OtherIdentifier, "reason", result.Reason)
+	if !c.oracleRevocations.SetIfAbsent(claim.ID.String(), struct{}{}) {
+		return false
+	}
+	err = c.publisher.Publish(ctx, event.RevokeIneligibleCredentialEvent, &event.RevokeIneligibleCredential{
+		CredentialID: claim.ID.String(),
+		IssuerID:     claim.Issuer,
+		Reason:       result.Reason,
+	})
+	if err != nil {
+		log.Error(ctx, "queueing the revocation of an ineligible credential", "err", err, "credential", claim.ID)
+	}
+	return false
+}
+
 func (c *claim) createVC(ctx context.Context, claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, nonce uint64) (verifiable.W3CCredential, error) {
 	vCredential, err := c.newVerifiableCredential(ctx, claimReq, vcID, jsonLdContext, nonce) // create vc credential
 	if err != nil {
@@ -700,6 +1484,182 @@ func (c *claim) createVC(ctx context.Context, claimReq *ports.CreateClaimRequest
 	return vCredential, nil
 }
 
+// guardIssuancePolicy checks that issuing the given claim does not break the configured issuance policy for the
+// connection between the issuer and the claim subject.
+func (c *claim) guardIssuancePolicy(ctx context.Context, issuerDID w3c.DID, claim *domain.Claim) error {
+	if claim.OtherIdentifier == "" {
+		return nil
+	}
+	if c.issuancePolicy.MaxCredentialsPerSchema <= 0 && c.issuancePolicy.Cooldown <= 0 {
+		return nil
+	}
+
+	count, lastCreatedAt, err := c.icRepo.GetIssuanceStatsBySubjectAndSchema(ctx, c.storage.Pgx, issuerDID, claim.OtherIdentifier, claim.SchemaHash)
+	if err != nil {
+		log.Error(ctx, "getting issuance stats", "err", err, "subject", claim.OtherIdentifier, "schemaHash", claim.SchemaHash)
+		return err
+	}
+
+	if c.issuancePolicy.MaxCredentialsPerSchema > 0 && count >= uint(c.issuancePolicy.MaxCredentialsPerSchema) {
+		log.Warn(ctx, "issuance policy. max credentials per schema reached", "subject", claim.OtherIdentifier, "schemaHash", claim.SchemaHash, "count", count)
+		return ErrIssuanceLimitExceeded
+	}
+
+	if c.issuancePolicy.Cooldown > 0 && lastCreatedAt != nil && time.Since(*lastCreatedAt) < c.issuancePolicy.Cooldown {
+		log.Warn(ctx, "issuance policy. cooldown period not elapsed", "subject", claim.OtherIdentifier, "schemaHash", claim.SchemaHash, "lastCreatedAt", lastCreatedAt)
+		return ErrIssuanceCooldown
+	}
+
+	return nil
+}
+
+// importedSchema returns the schema of the request imported by the issuer, or nil if it has not been imported
+func (c *claim) importedSchema(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Schema, error) {
+	if c.schemaRepository == nil {
+		return nil, nil
+	}
+	schema, err := c.schemaRepository.GetByURL(ctx, *req.DID, req.Schema, req.Type)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(ctx, "getting imported schema", "err", err, "schema", req.Schema, "type", req.Type)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// applySchemaExpiration sets the default expiration of the imported schema when the request has none and rejects
+// the expirations later than the maximum of the schema
+func applySchemaExpiration(ctx context.Context, req *ports.CreateClaimRequest, schema *domain.Schema) error {
+	if schema == nil {
+		return nil
+	}
+	expiration, ok := schema.CredentialExpiration(req.Expiration, time.Now().UTC())
+	if !ok {
+		log.Warn(ctx, "credential expiration exceeds the schema maximum", "schema", req.Schema, "expiration", req.Expiration, "maxDays", *schema.MaxExpirationDays)
+		return ErrCredentialExpirationExceeded
+	}
+	req.Expiration = expiration
+	return nil
+}
+
+// checkUniqueKey rejects early the request if its imported schema rejects the duplicates of the unique key and there
+// is an active credential with it, other than the one it supersedes. The key is enforced again when the credential is
+// saved.
+func (c *claim) checkUniqueKey(ctx context.Context, req *ports.CreateClaimRequest, schema *domain.Schema) error {
+	if schema == nil || schema.UniqueStrategy == domain.SchemaUniqueStrategyRevoke {
+		return nil
+	}
+	key, ok := schema.UniqueKey(req.CredentialSubject)
+	if !ok {
+		return nil
+	}
+	conflicts, err := c.icRepo.GetNonRevokedByUniqueKey(ctx, c.storage.Pgx, *req.DID, req.Schema, req.Type, key)
+	if err != nil {
+		log.Error(ctx, "getting credentials by unique key", "err", err, "schema", req.Schema, "type", req.Type)
+		return err
+	}
+	for _, conflict := range conflicts {
+		if req.Supersedes != nil && conflict.ID == *req.Supersedes {
+			continue
+		}
+		log.Warn(ctx, "credential rejected by the schema unique key", "schema", req.Schema, "existing", conflict.ID.String())
+		return ErrCredentialUniqueKeyTaken
+	}
+	return nil
+}
+
+// enforceUniqueKey locks the unique key of the imported schema of the new credential until the transaction ends, so
+// the credentials with the same key are issued one at a time, and revokes the active credentials with the key, or
+// returns ErrCredentialUniqueKeyTaken, depending on the schema. tx must be a transaction.
+func (c *claim) enforceUniqueKey(ctx context.Context, tx db.Querier, claim *domain.Claim) error {
+	if c.schemaRepository == nil {
+		return nil
+	}
+	issuerDID, err := w3c.ParseDID(claim.Issuer)
+	if err != nil {
+		return err
+	}
+	schema, err := c.schemaRepository.GetByURL(ctx, *issuerDID, claim.SchemaURL, claim.SchemaType)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return nil
+	}
+	if err != nil {
+		log.Error(ctx, "getting imported schema", "err", err, "schema", claim.SchemaURL, "type", claim.SchemaType)
+		return err
+	}
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		return err
+	}
+	key, ok := schema.UniqueKey(vc.CredentialSubject)
+	if !ok {
+		return nil
+	}
+
+	if err := c.icRepo.LockUniqueKey(ctx, tx, *issuerDID, claim.SchemaURL, claim.SchemaType, key); err != nil {
+		log.Error(ctx, "locking the unique key", "err", err, "schema", claim.SchemaURL, "type", claim.SchemaType)
+		return err
+	}
+	conflicts, err := c.icRepo.GetNonRevokedByUniqueKey(ctx, tx, *issuerDID, claim.SchemaURL, claim.SchemaType, key)
+	if err != nil {
+		log.Error(ctx, "getting credentials by unique key", "err", err, "schema", claim.SchemaURL, "type", claim.SchemaType)
+		return err
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if schema.UniqueStrategy != domain.SchemaUniqueStrategyRevoke {
+		log.Warn(ctx, "credential rejected by the schema unique key", "schema", claim.SchemaURL, "existing", conflicts[0].ID.String())
+		return ErrCredentialUniqueKeyTaken
+	}
+
+	// the schema keeps a single active credential per unique key, so the new one supersedes the previous ones
+	if claim.Supersedes == nil {
+		claim.Supersedes = &conflicts[0].ID
+	}
+	for _, credential := range conflicts {
+		if err := c.revoke(ctx, issuerDID, uint64(credential.RevNonce), "superseded by credential "+claim.ID.String(), tx); err != nil {
+			log.Error(ctx, "revoking the credential with the same unique key", "err", err, "credential", credential.ID.String())
+			return err
+		}
+		log.Info(ctx, "audit: credential revoked by the schema unique key", "credential", credential.ID.String(), "supersededBy", claim.ID.String())
+	}
+	return nil
+}
+
+// validateCredentialExtensions checks the evidence and termsOfUse entries are well formed and the schema of the
+// credential allows them and, when it describes them, that they match the description
+func validateCredentialExtensions(ctx context.Context, ld loader.DocumentLoader, schemaURL string, ext domain.CredentialExtensions, provenance map[string]domain.AttributeProvenance) error {
+	if len(ext.Evidence) == 0 && len(ext.TermsOfUse) == 0 {
+		return nil
+	}
+	if err := ext.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCredentialSection, err)
+	}
+	schema, err := jsonschema.Load(ctx, schemaURL, ld)
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "schema", schemaURL)
+		return ErrLoadingSchema
+	}
+	sections := make(map[string]any)
+	if len(ext.Evidence) > 0 {
+		// the provenance goes to the same section, so the schema sees the whole of it
+		sections["evidence"] = append(domain.ProvenanceEvidence(provenance), ext.Evidence...)
+	}
+	if len(ext.TermsOfUse) > 0 {
+		sections["termsOfUse"] = ext.TermsOfUse
+	}
+	for name, value := range sections {
+		if err := schema.ValidateCredentialSection(name, value); err != nil {
+			log.Warn(ctx, "credential section rejected by the schema", "section", name, "err", err)
+			return fmt.Errorf("%w: %s", ErrInvalidCredentialSection, err)
+		}
+	}
+	return nil
+}
+
 func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
 	type guardFunc func() error
 
@@ -734,6 +1694,29 @@ func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
 				return ErrUnsupportedRefreshServiceType
 			}
 		},
+		// check external id length
+		func() error {
+			if req.ExternalID != nil && len(*req.ExternalID) > maxExternalIDLength {
+				return ErrExternalIDTooLong
+			}
+			return nil
+		},
+		// check the provenance names attributes of the credential subject
+		func() error {
+			for attribute, provenance := range req.Provenance {
+				if _, ok := req.CredentialSubject[attribute]; !ok || provenance.Source == "" || provenance.VerificationMethod == "" {
+					return ErrInvalidProvenance
+				}
+			}
+			return nil
+		},
+		// check the fetch policy
+		func() error {
+			if !req.FetchPolicy.Valid() {
+				return ErrInvalidFetchPolicy
+			}
+			return nil
+		},
 		// check display method in correct uri
 		func() error {
 			if req.DisplayMethod == nil {
@@ -806,7 +1789,7 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 
 	issuanceDate := time.Now().UTC()
 	return verifiable.W3CCredential{
-		ID:                string(c.buildCredentialID(vcID)),
+		ID:                c.buildCredentialID(vcID),
 		Context:           credentialCtx,
 		Type:              credentialType,
 		Expiration:        claimReq.Expiration,
@@ -823,6 +1806,6 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 	}, nil
 }
 
-func (c *claim) buildCredentialID(credID uuid.UUID) urn.URN {
-	return urn.FromUUID(credID)
+func (c *claim) buildCredentialID(credID uuid.UUID) string {
+	return c.credentialIDTemplate.Build(credID)
 }
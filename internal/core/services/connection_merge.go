@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const connectionMergeRevoked = "revoked by the merge of the connection into another connection of the holder"
+
+// ErrConnectionMergeSameConnection the connection cannot be merged into itself
+var ErrConnectionMergeSameConnection = errors.New("a connection cannot be merged into itself")
+
+type connectionMerge struct {
+	repo                  ports.ConnectionMergeRepository
+	connectionsRepository ports.ConnectionsRepository
+	claimsRepository      ports.ClaimsRepository
+	claimsService         ports.ClaimsService
+	publisher             pubsub.Publisher
+	storage               *db.Storage
+}
+
+// NewConnectionMerge returns a new connection merge service
+func NewConnectionMerge(repo ports.ConnectionMergeRepository, connectionsRepository ports.ConnectionsRepository, claimsRepository ports.ClaimsRepository, claimsService ports.ClaimsService, publisher pubsub.Publisher, storage *db.Storage) ports.ConnectionMergeService {
+	return &connectionMerge{
+		repo:                  repo,
+		connectionsRepository: connectionsRepository,
+		claimsRepository:      claimsRepository,
+		claimsService:         claimsService,
+		publisher:             publisher,
+		storage:               storage,
+	}
+}
+
+// Merge merges the source connection into the target one, when the same holder has authenticated with two DIDs.
+// The active credentials of the source connection are issued again to the DID of the target connection. In a single
+// transaction, the old credentials are revoked, the new ones are saved, the authentications, the missing profile
+// fields and the previous merges are moved to the target connection, and the source connection is removed.
+func (s *connectionMerge) Merge(ctx context.Context, issuerDID w3c.DID, targetID uuid.UUID, sourceID uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.ConnectionMerge, error) {
+	if targetID == sourceID {
+		return nil, ErrConnectionMergeSameConnection
+	}
+	target, err := s.connectionsRepository.GetByIDAndIssuerID(ctx, s.storage.Pgx, targetID, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+	source, err := s.connectionsRepository.GetByIDAndIssuerID(ctx, s.storage.Pgx, sourceID, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+
+	oldCredentials, err := s.claimsRepository.GetNonRevokedByConnectionAndIssuerID(ctx, s.storage.Pgx, source.ID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	newCredentials := make([]*domain.Claim, 0, len(oldCredentials))
+	for _, old := range oldCredentials {
+		req, err := reissueRequest(issuerDID, old, credentialStatusType)
+		if err != nil {
+			log.Error(ctx, "merging connections. Reading credential", "err", err, "credential", old.ID)
+			return nil, err
+		}
+		req.CredentialSubject["id"] = target.UserDID.String()
+		req.Supersedes = &old.ID
+		credential, err := s.claimsService.CreateCredential(ctx, req)
+		if err != nil {
+			log.Error(ctx, "merging connections. Creating credential", "err", err, "credential", old.ID)
+			return nil, err
+		}
+		newCredentials = append(newCredentials, credential)
+	}
+
+	merge := &domain.ConnectionMerge{
+		ID:                 uuid.New(),
+		IssuerDID:          issuerDID,
+		SourceConnectionID: source.ID,
+		SourceUserDID:      source.UserDID.String(),
+		TargetConnectionID: target.ID,
+		Credentials:        len(newCredentials),
+		CreatedAt:          time.Now().UTC(),
+	}
+	err = s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, old := range oldCredentials {
+			if err := s.claimsService.RevokeWithConn(ctx, tx, issuerDID, uint64(old.RevNonce), connectionMergeRevoked); err != nil {
+				return err
+			}
+		}
+		for _, credential := range newCredentials {
+			if credential.ID, err = s.claimsService.SaveWithConn(ctx, tx, credential); err != nil {
+				return err
+			}
+		}
+		if err := s.connectionsRepository.MoveUserAuthentications(ctx, tx, source.ID, target.ID); err != nil {
+			return err
+		}
+		if err := s.connectionsRepository.MergeProfile(ctx, tx, source.ID, target.ID); err != nil {
+			return err
+		}
+		if err := s.repo.MoveToTarget(ctx, tx, source.ID, target.ID); err != nil {
+			return err
+		}
+		if err := s.connectionsRepository.Delete(ctx, tx, source.ID, issuerDID); err != nil {
+			return err
+		}
+		return s.repo.Save(ctx, tx, merge)
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		log.Error(ctx, "merging connections", "err", err, "source", source.ID, "target", target.ID)
+		return nil, err
+	}
+	log.Info(ctx, "audit: connections merged", "id", merge.ID, "source", source.ID, "target", target.ID, "credentials", len(newCredentials))
+
+	ids := make([]string, 0, len(newCredentials))
+	for _, credential := range newCredentials {
+		if credential.SignatureProof.Status == pgtype.Present {
+			ids = append(ids, credential.ID.String())
+		}
+	}
+	if len(ids) > 0 {
+		err := s.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: ids, IssuerID: issuerDID.String()})
+		if err != nil {
+			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "issuer", issuerDID.String(), "credentials", len(ids))
+		}
+	}
+	return merge, nil
+}
+
+// GetMerges returns the connections merged into the connection, the newest first
+func (s *connectionMerge) GetMerges(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error) {
+	if _, err := s.connectionsRepository.GetByIDAndIssuerID(ctx, s.storage.Pgx, connectionID, issuerDID); err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+	return s.repo.GetByTargetConnectionID(ctx, s.storage.Pgx, issuerDID, connectionID)
+}
+
+// GetDuplicates returns the groups of connections of the issuer that likely belong to the same holder
+func (s *connectionMerge) GetDuplicates(ctx context.Context, issuerDID w3c.DID) ([]domain.ConnectionDuplicates, error) {
+	return s.connectionsRepository.GetDuplicates(ctx, s.storage.Pgx, issuerDID)
+}
+
+// ReportDuplicates logs the groups of duplicate connections of the issuer, so they can be reviewed and merged
+func (s *connectionMerge) ReportDuplicates(ctx context.Context, issuerDID w3c.DID) {
+	duplicates, err := s.GetDuplicates(ctx, issuerDID)
+	if err != nil {
+		log.Error(ctx, "reporting duplicate connections", "err", err, "issuer", issuerDID.String())
+		return
+	}
+	connections := 0
+	for _, group := range duplicates {
+		connections += len(group.ConnectionIDs)
+		log.Warn(ctx, "duplicate connections", "issuer", issuerDID.String(), "reason", group.Reason, "connections", group.ConnectionIDs)
+	}
+	log.Info(ctx, "duplicate connections report", "issuer", issuerDID.String(), "groups", len(duplicates), "connections", connections)
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// maxConnectionPruneBatchSize is the max number of connections pruned in a transaction
+const maxConnectionPruneBatchSize = 1000
+
+var (
+	ErrConnectionPruningInactiveDays = errors.New("inactiveDays must be at least 1")                            // ErrConnectionPruningInactiveDays the inactivity period is not valid
+	ErrConnectionPruningAction       = errors.New("the action of the pruning must be either archive or delete") // ErrConnectionPruningAction the action of the pruning is not valid
+)
+
+// ErrConnectionPruningBatchSize the batch size of the pruning is not valid
+var ErrConnectionPruningBatchSize = fmt.Errorf("the batch size must be between 1 and %d", maxConnectionPruneBatchSize)
+
+type connectionPruning struct {
+	connectionsRepository ports.ConnectionsRepository
+	storage               *db.Storage
+}
+
+// NewConnectionPruning returns a new connection pruning service
+func NewConnectionPruning(connectionsRepository ports.ConnectionsRepository, storage *db.Storage) ports.ConnectionPruningService {
+	return &connectionPruning{
+		connectionsRepository: connectionsRepository,
+		storage:               storage,
+	}
+}
+
+// GetDead returns the connections whose credentials are all revoked or expired and without activity in the last
+// inactiveDays, the least recently active first
+func (s *connectionPruning) GetDead(ctx context.Context, issuerDID w3c.DID, inactiveDays int, limit int) ([]domain.DeadConnection, error) {
+	if inactiveDays < 1 {
+		return nil, ErrConnectionPruningInactiveDays
+	}
+	return s.connectionsRepository.GetDead(ctx, s.storage.Pgx, issuerDID, inactiveSince(inactiveDays), limit)
+}
+
+// Prune archives or deletes a batch of dead connections in a transaction and returns their ids. The credentials of
+// the connections are kept, so their revocation statuses can still be checked.
+func (s *connectionPruning) Prune(ctx context.Context, issuerDID w3c.DID, req ports.ConnectionPruneRequest) ([]uuid.UUID, error) {
+	if req.InactiveDays < 1 {
+		return nil, ErrConnectionPruningInactiveDays
+	}
+	if req.Action != domain.ConnectionPruneArchive && req.Action != domain.ConnectionPruneDelete {
+		return nil, ErrConnectionPruningAction
+	}
+	if req.BatchSize < 1 || req.BatchSize > maxConnectionPruneBatchSize {
+		return nil, ErrConnectionPruningBatchSize
+	}
+
+	var ids []uuid.UUID
+	err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		dead, err := s.connectionsRepository.GetDeadForUpdate(ctx, tx, issuerDID, inactiveSince(req.InactiveDays), req.BatchSize)
+		if err != nil {
+			return err
+		}
+		ids = make([]uuid.UUID, 0, len(dead))
+		for _, connection := range dead {
+			ids = append(ids, connection.ID)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if req.Action == domain.ConnectionPruneArchive {
+			return s.connectionsRepository.Archive(ctx, tx, ids)
+		}
+		return s.connectionsRepository.DeleteMany(ctx, tx, ids)
+	})
+	if err != nil {
+		log.Error(ctx, "pruning dead connections", "err", err, "action", req.Action)
+		return nil, err
+	}
+	if len(ids) > 0 {
+		log.Info(ctx, "audit: dead connections pruned", "issuer", issuerDID.String(), "action", req.Action, "connections", ids)
+	}
+	return ids, nil
+}
+
+// PruneAll prunes the dead connections of the issuer batch by batch until none is left
+func (s *connectionPruning) PruneAll(ctx context.Context, issuerDID w3c.DID, req ports.ConnectionPruneRequest) {
+	total := 0
+	for ctx.Err() == nil {
+		ids, err := s.Prune(ctx, issuerDID, req)
+		if err != nil {
+			return
+		}
+		total += len(ids)
+		if len(ids) < req.BatchSize {
+			break
+		}
+	}
+	log.Info(ctx, "dead connections pruning finished", "issuer", issuerDID.String(), "action", req.Action, "connections", total)
+}
+
+func inactiveSince(inactiveDays int) time.Time {
+	return time.Now().UTC().AddDate(0, 0, -inactiveDays)
+}
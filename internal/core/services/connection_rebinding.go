@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const (
+	connectionRebindingCodeSize  = 10 // 80 bits, 16 base32 characters
+	connectionRebindingCodeGroup = 4
+	connectionRebindingCodeTTL   = 24 * time.Hour
+	connectionRebindingRevoked   = "revoked by the rebinding of the connection to a new DID"
+)
+
+var (
+	ErrConnectionRebindingCodeInvalid = errors.New("the rebinding code is invalid or has expired")         // ErrConnectionRebindingCodeInvalid the code does not exist, was used or has expired
+	ErrConnectionRebindingSameDID     = errors.New("the connection cannot be rebound to the same DID")     // ErrConnectionRebindingSameDID the holder authenticated with the DID of the connection
+	ErrConnectionRebindingTargetInUse = errors.New("the new DID already has credentials from this issuer") // ErrConnectionRebindingTargetInUse the connection of the new DID has credentials
+)
+
+type connectionRebinding struct {
+	repo                  ports.ConnectionRebindingRepository
+	connectionsRepository ports.ConnectionsRepository
+	claimsRepository      ports.ClaimsRepository
+	claimsService         ports.ClaimsService
+	identityService       ports.IdentityService
+	publisher             pubsub.Publisher
+	storage               *db.Storage
+}
+
+// NewConnectionRebinding returns a new connection rebinding service
+func NewConnectionRebinding(repo ports.ConnectionRebindingRepository, connectionsRepository ports.ConnectionsRepository, claimsRepository ports.ClaimsRepository, claimsService ports.ClaimsService, identityService ports.IdentityService, publisher pubsub.Publisher, storage *db.Storage) ports.ConnectionRebindingService {
+	return &connectionRebinding{
+		repo:                  repo,
+		connectionsRepository: connectionsRepository,
+		claimsRepository:      claimsRepository,
+		claimsService:         claimsService,
+		identityService:       identityService,
+		publisher:             publisher,
+		storage:               storage,
+	}
+}
+
+// CreateCode creates a recovery code for the holder of the connection. Only the hash of the code is stored, so it
+// must be handed to the holder right away.
+func (s *connectionRebinding) CreateCode(ctx context.Context, issuerDID w3c.DID, connectionID uuid.UUID) (*ports.ConnectionRebindingCode, error) {
+	if _, err := s.connectionsRepository.GetByIDAndIssuerID(ctx, s.storage.Pgx, connectionID, issuerDID); err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+
+	code, err := newConnectionRebindingCode()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	rebinding := &domain.ConnectionRebinding{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		ConnectionID: connectionID,
+		CodeHash:     hashConnectionRebindingCode(code),
+		ExpiresAt:    now.Add(connectionRebindingCodeTTL),
+		CreatedAt:    now,
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, rebinding); err != nil {
+		log.Error(ctx, "saving connection rebinding", "err", err, "connection", connectionID)
+		return nil, err
+	}
+	log.Info(ctx, "audit: connection rebinding code created", "id", rebinding.ID, "connection", connectionID)
+	return &ports.ConnectionRebindingCode{ID: rebinding.ID, Code: code, ExpiresAt: rebinding.ExpiresAt}, nil
+}
+
+// Redeem starts the authentication of the new DID of the holder. The connection is rebound when the holder answers
+// the authentication request.
+func (s *connectionRebinding) Redeem(ctx context.Context, issuerDID w3c.DID, code string, serverURL string) (*ports.CreateAuthenticationQRCodeResponse, error) {
+	rebinding, err := s.repo.GetByCodeHash(ctx, s.storage.Pgx, hashConnectionRebindingCode(code))
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionRebindingDoesNotExist) {
+			return nil, ErrConnectionRebindingCodeInvalid
+		}
+		return nil, err
+	}
+	if rebinding.IssuerDID.String() != issuerDID.String() || !rebinding.IsActive(time.Now()) {
+		return nil, ErrConnectionRebindingCodeInvalid
+	}
+
+	qr, err := s.identityService.CreateAuthenticationQRCode(ctx, serverURL, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetSession(ctx, s.storage.Pgx, rebinding.ID, qr.SessionID); err != nil {
+		if errors.Is(err, repositories.ErrConnectionRebindingDoesNotExist) {
+			return nil, ErrConnectionRebindingCodeInvalid
+		}
+		return nil, err
+	}
+	log.Info(ctx, "audit: connection rebinding code redeemed", "id", rebinding.ID, "session", qr.SessionID)
+	return qr, nil
+}
+
+// Complete rebinds the connection of the code redeemed in the authentication session to the connection of the DID
+// that answered it. It returns nil when the session was not started with a code.
+// The active credentials of the old connection are issued again to the new DID. In a single transaction, the old
+// credentials are revoked, the new ones are saved, the authentications and the profile are moved to the new
+// connection, the old connection is removed and the code is marked as used.
+func (s *connectionRebinding) Complete(ctx context.Context, issuerDID w3c.DID, sessionID uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.ConnectionRebinding, error) {
+	rebinding, err := s.repo.GetBySessionID(ctx, s.storage.Pgx, sessionID)
+	if errors.Is(err, repositories.ErrConnectionRebindingDoesNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rebinding.IssuerDID.String() != issuerDID.String() || !rebinding.IsActive(time.Now()) {
+		return nil, ErrConnectionRebindingCodeInvalid
+	}
+
+	oldConn, err := s.connectionsRepository.GetByIDAndIssuerID(ctx, s.storage.Pgx, rebinding.ConnectionID, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionRebindingCodeInvalid
+		}
+		return nil, err
+	}
+	newConn, err := s.connectionsRepository.GetByUserSessionID(ctx, s.storage.Pgx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if newConn.UserDID.String() == oldConn.UserDID.String() {
+		return nil, ErrConnectionRebindingSameDID
+	}
+	existing, err := s.claimsRepository.GetNonRevokedByConnectionAndIssuerID(ctx, s.storage.Pgx, newConn.ID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrConnectionRebindingTargetInUse
+	}
+
+	oldCredentials, err := s.claimsRepository.GetNonRevokedByConnectionAndIssuerID(ctx, s.storage.Pgx, oldConn.ID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	newCredentials := make([]*domain.Claim, 0, len(oldCredentials))
+	for _, old := range oldCredentials {
+		req, err := reissueRequest(issuerDID, old, credentialStatusType)
+		if err != nil {
+			log.Error(ctx, "rebinding connection. Reading credential", "err", err, "credential", old.ID)
+			return nil, err
+		}
+		req.CredentialSubject["id"] = newConn.UserDID.String()
+		req.Supersedes = &old.ID
+		credential, err := s.claimsService.CreateCredential(ctx, req)
+		if err != nil {
+			log.Error(ctx, "rebinding connection. Creating credential", "err", err, "credential", old.ID)
+			return nil, err
+		}
+		newCredentials = append(newCredentials, credential)
+	}
+
+	err = s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		locked, err := s.repo.GetBySessionIDForUpdate(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if !locked.IsActive(time.Now()) {
+			return ErrConnectionRebindingCodeInvalid
+		}
+		for _, old := range oldCredentials {
+			if err := s.claimsService.RevokeWithConn(ctx, tx, issuerDID, uint64(old.RevNonce), connectionRebindingRevoked); err != nil {
+				return err
+			}
+		}
+		for _, credential := range newCredentials {
+			if credential.ID, err = s.claimsService.SaveWithConn(ctx, tx, credential); err != nil {
+				return err
+			}
+		}
+		if err := s.connectionsRepository.MoveUserAuthentications(ctx, tx, oldConn.ID, newConn.ID); err != nil {
+			return err
+		}
+		if err := s.connectionsRepository.UpdateProfile(ctx, tx, newConn.ID, issuerDID, oldConn.Profile); err != nil {
+			return err
+		}
+		if err := s.connectionsRepository.Delete(ctx, tx, oldConn.ID, issuerDID); err != nil {
+			return err
+		}
+		return s.repo.MarkUsed(ctx, tx, rebinding.ID, newConn.ID, time.Now().UTC())
+	})
+	if err != nil {
+		log.Error(ctx, "rebinding connection", "err", err, "id", rebinding.ID, "connection", oldConn.ID)
+		return nil, err
+	}
+	log.Info(ctx, "audit: connection rebound to a new DID", "id", rebinding.ID, "connection", oldConn.ID, "newConnection", newConn.ID, "credentials", len(newCredentials))
+
+	ids := make([]string, 0, len(newCredentials))
+	for _, credential := range newCredentials {
+		if credential.SignatureProof.Status == pgtype.Present {
+			ids = append(ids, credential.ID.String())
+		}
+	}
+	if len(ids) > 0 {
+		err := s.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: ids, IssuerID: issuerDID.String()})
+		if err != nil {
+			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "issuer", issuerDID.String(), "credentials", len(ids))
+		}
+	}
+
+	rebinding.NewConnectionID = &newConn.ID
+	return rebinding, nil
+}
+
+// newConnectionRebindingCode returns a random code in groups of base32 characters, easy to read out and type
+func newConnectionRebindingCode() (string, error) {
+	raw := make([]byte, connectionRebindingCodeSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+	groups := make([]string, 0, len(encoded)/connectionRebindingCodeGroup)
+	for i := 0; i < len(encoded); i += connectionRebindingCodeGroup {
+		groups = append(groups, encoded[i:i+connectionRebindingCodeGroup])
+	}
+	return strings.Join(groups, "-"), nil
+}
+
+// hashConnectionRebindingCode hashes the code ignoring the case, the separators and the spaces
+func hashConnectionRebindingCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/mail"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -15,8 +17,16 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
-// ErrConnectionDoesNotExist connection does not exist
-var ErrConnectionDoesNotExist = errors.New("connection does not exist")
+var (
+	ErrConnectionDoesNotExist = errors.New("connection does not exist")               // ErrConnectionDoesNotExist connection does not exist
+	ErrInvalidConnectionEmail = errors.New("invalid email in the connection profile") // ErrInvalidConnectionEmail the email of the connection profile is not valid
+)
+
+// maxConnectionProfileFieldLength is the max length of the connection profile fields
+const maxConnectionProfileFieldLength = 256
+
+// ErrConnectionProfileFieldTooLong a connection profile field is longer than allowed
+var ErrConnectionProfileFieldTooLong = fmt.Errorf("connection profile fields cannot be longer than %d characters", maxConnectionProfileFieldLength)
 
 type connection struct {
 	connRepo   ports.ConnectionsRepository
@@ -102,6 +112,34 @@ func (c *connection) GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, fi
 	return conns, count, err
 }
 
+// UpdateProfile sets the profile fields that are not nil. Empty values remove the field.
+func (c *connection) UpdateProfile(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, profile domain.ConnectionProfile) error {
+	if err := validateConnectionProfile(profile); err != nil {
+		return err
+	}
+	if err := c.connRepo.UpdateProfile(ctx, c.storage.Pgx, id, issuerDID, profile); err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return ErrConnectionDoesNotExist
+		}
+		return err
+	}
+	return nil
+}
+
+func validateConnectionProfile(profile domain.ConnectionProfile) error {
+	for _, field := range []*string{profile.DisplayName, profile.ExternalID, profile.Email} {
+		if field != nil && len(*field) > maxConnectionProfileFieldLength {
+			return ErrConnectionProfileFieldTooLong
+		}
+	}
+	if profile.Email != nil && *profile.Email != "" {
+		if _, err := mail.ParseAddress(*profile.Email); err != nil {
+			return ErrInvalidConnectionEmail
+		}
+	}
+	return nil
+}
+
 func (c *connection) delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, pgx db.Querier) error {
 	err := c.connRepo.Delete(ctx, pgx, id, issuerDID)
 	if err != nil {
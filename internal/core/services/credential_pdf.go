@@ -0,0 +1,433 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pdf"
+	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+const (
+	maxCredentialPDFTextLength = 500
+	maxCredentialPDFFields     = 100
+	credentialPDFMaskedValue   = "********"
+
+	// Layout of the document, in points
+	pdfMargin       = 48.0
+	pdfHeaderHeight = 110.0
+	pdfLogoSide     = 64.0
+	pdfQRSide       = 150.0
+	pdfLabelWidth   = 170.0
+	pdfBodySize     = 10.0
+	pdfLineHeight   = 14.0
+)
+
+var (
+	ErrCredentialPDFTemplateInvalid  = errors.New("invalid credential pdf template")         // ErrCredentialPDFTemplateInvalid the template has wrong values
+	ErrCredentialPDFTemplateNotFound = errors.New("credential pdf template not found")       // ErrCredentialPDFTemplateNotFound the schema has no template
+	errCredentialPDFNoSubject        = errors.New("the credential has no credentialSubject") // errCredentialPDFNoSubject the credential can't be rendered
+)
+
+var (
+	pdfTextColor  = color.RGBA{R: 0x1e, G: 0x1e, B: 0x2f, A: 0xff}
+	pdfMutedColor = color.RGBA{R: 0x6b, G: 0x6b, B: 0x7b, A: 0xff}
+	pdfRuleColor  = color.RGBA{R: 0xdd, G: 0xdd, B: 0xe3, A: 0xff}
+	pdfAlertColor = color.RGBA{R: 0xc0, G: 0x1c, B: 0x28, A: 0xff}
+)
+
+type credentialPDF struct {
+	repo                ports.CredentialPDFTemplateRepository
+	schemaRepo          ports.SchemaRepository
+	claimsService       ports.ClaimsService
+	qrBrandingService   ports.QRBrandingService
+	storage             *db.Storage
+	verificationBaseURL string
+}
+
+// NewCredentialPDF returns a new credential pdf service. The QR code of the documents links to the credential id
+// when it can be dereferenced, or to the public credential metadata endpoint of verificationBaseURL otherwise.
+func NewCredentialPDF(repo ports.CredentialPDFTemplateRepository, schemaRepo ports.SchemaRepository, claimsService ports.ClaimsService, qrBrandingService ports.QRBrandingService, storage *db.Storage, verificationBaseURL string) ports.CredentialPDFService {
+	return &credentialPDF{
+		repo:                repo,
+		schemaRepo:          schemaRepo,
+		claimsService:       claimsService,
+		qrBrandingService:   qrBrandingService,
+		storage:             storage,
+		verificationBaseURL: strings.TrimSuffix(verificationBaseURL, "/"),
+	}
+}
+
+// GetTemplate returns the template of the schema or the default one if it has not been configured
+func (c *credentialPDF) GetTemplate(ctx context.Context, issuerDID w3c.DID, schemaID uuid.UUID) (*domain.CredentialPDFTemplate, error) {
+	if _, err := c.schemaRepo.GetByID(ctx, issuerDID, schemaID); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		return nil, err
+	}
+	template, err := c.repo.GetBySchemaID(ctx, c.storage.Pgx, issuerDID, schemaID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialPDFTemplateDoesNotExist) {
+			return domain.NewDefaultCredentialPDFTemplate(issuerDID, schemaID), nil
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+// SaveTemplate validates and stores the template of a schema
+func (c *credentialPDF) SaveTemplate(ctx context.Context, template *domain.CredentialPDFTemplate) error {
+	if err := validateCredentialPDFTemplate(template); err != nil {
+		return err
+	}
+	if _, err := c.schemaRepo.GetByID(ctx, template.IssuerDID, template.SchemaID); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return ErrSchemaNotFound
+		}
+		return err
+	}
+	template.ModifiedAt = time.Now()
+	return c.repo.Save(ctx, c.storage.Pgx, template)
+}
+
+// DeleteTemplate removes the template of a schema, so its credentials are rendered with the default one
+func (c *credentialPDF) DeleteTemplate(ctx context.Context, issuerDID w3c.DID, schemaID uuid.UUID) error {
+	if err := c.repo.Delete(ctx, c.storage.Pgx, issuerDID, schemaID); err != nil {
+		if errors.Is(err, repositories.ErrCredentialPDFTemplateDoesNotExist) {
+			return ErrCredentialPDFTemplateNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Render returns the credential as a printable PDF document, using the template of its schema and the issuer branding
+func (c *credentialPDF) Render(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID, opts ports.CredentialPDFOptions) ([]byte, error) {
+	credential, err := c.claimsService.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		return nil, err
+	}
+	if vc.CredentialSubject == nil {
+		return nil, errCredentialPDFNoSubject
+	}
+
+	template, err := c.repo.GetBySchema(ctx, c.storage.Pgx, issuerDID, credential.SchemaURL, credential.SchemaType)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrCredentialPDFTemplateDoesNotExist) {
+			return nil, err
+		}
+		template = domain.NewDefaultCredentialPDFTemplate(issuerDID, uuid.Nil)
+	}
+
+	branding, err := c.qrBrandingService.Get(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := renderQRImage(c.verificationLink(vc, credentialID), branding, DefaultQRImageSize)
+	if err != nil {
+		return nil, err
+	}
+	var logo image.Image
+	if len(branding.Logo) > 0 {
+		if logo, _, err = image.Decode(bytes.NewReader(branding.Logo)); err != nil {
+			log.Warn(ctx, "rendering credential pdf. Invalid branding logo", "err", err)
+			logo = nil
+		}
+	}
+	accent, err := parseHexColor(template.AccentColor)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New(pdf.A4Width, pdf.A4Height)
+	r := &pdfRenderer{doc: doc}
+	title := template.Title
+	if title == "" {
+		title = credential.SchemaType
+	}
+	doc.SetTitle(title)
+	r.header(title, template.Header, accent, logo)
+
+	r.section("Credential")
+	r.row("Issuer", issuerDID.String())
+	r.row("Credential ID", vc.ID)
+	r.row("Type", credential.SchemaType)
+	if vc.IssuanceDate != nil {
+		r.row("Issued", vc.IssuanceDate.UTC().Format(time.RFC1123))
+	}
+	if vc.Expiration != nil {
+		r.row("Expires", vc.Expiration.UTC().Format(time.RFC1123))
+	}
+	if credential.Revoked {
+		r.rowColor("Status", "Revoked", pdfAlertColor)
+	} else {
+		r.row("Status", "Issued")
+	}
+
+	r.section("Attributes")
+	masked := make(map[string]bool, len(opts.MaskedAttributes))
+	for _, attr := range opts.MaskedAttributes {
+		masked[attr] = true
+	}
+	for _, field := range credentialPDFFields(template, vc.CredentialSubject) {
+		value, ok := vc.CredentialSubject[field.Attribute]
+		if !ok {
+			continue
+		}
+		text := credentialPDFValue(value)
+		if masked[field.Attribute] {
+			text = credentialPDFMaskedValue
+		}
+		r.row(field.Label, text)
+	}
+
+	r.verification(qr, c.verificationLink(vc, credentialID))
+	r.footer(template.Footer)
+
+	return doc.Bytes()
+}
+
+// verificationLink returns the url encoded in the qr code of the document
+func (c *credentialPDF) verificationLink(vc *verifiable.W3CCredential, credentialID uuid.UUID) string {
+	if strings.HasPrefix(vc.ID, "https://") || strings.HasPrefix(vc.ID, "http://") {
+		return vc.ID
+	}
+	return fmt.Sprintf("%s/v1/credentials/%s", c.verificationBaseURL, credentialID)
+}
+
+func validateCredentialPDFTemplate(template *domain.CredentialPDFTemplate) error {
+	if !hexColorRegexp.MatchString(template.AccentColor) {
+		return fmt.Errorf("%w: accent color must use the #RRGGBB format", ErrCredentialPDFTemplateInvalid)
+	}
+	for name, text := range map[string]string{"title": template.Title, "header": template.Header, "footer": template.Footer} {
+		if len(text) > maxCredentialPDFTextLength {
+			return fmt.Errorf("%w: %s can not be longer than %d characters", ErrCredentialPDFTemplateInvalid, name, maxCredentialPDFTextLength)
+		}
+	}
+	if len(template.Fields) > maxCredentialPDFFields {
+		return fmt.Errorf("%w: up to %d fields are allowed", ErrCredentialPDFTemplateInvalid, maxCredentialPDFFields)
+	}
+	seen := make(map[string]bool, len(template.Fields))
+	for _, field := range template.Fields {
+		if field.Attribute == "" {
+			return fmt.Errorf("%w: fields must have an attribute", ErrCredentialPDFTemplateInvalid)
+		}
+		if seen[field.Attribute] {
+			return fmt.Errorf("%w: duplicated field %s", ErrCredentialPDFTemplateInvalid, field.Attribute)
+		}
+		seen[field.Attribute] = true
+	}
+	return nil
+}
+
+// credentialPDFFields returns the fields of the template or, if it has none, all the credentialSubject attributes
+// sorted by name, with the subject id first.
+func credentialPDFFields(template *domain.CredentialPDFTemplate, subject map[string]any) []domain.CredentialPDFField {
+	if len(template.Fields) > 0 {
+		fields := make([]domain.CredentialPDFField, len(template.Fields))
+		for i, field := range template.Fields {
+			fields[i] = field
+			if fields[i].Label == "" {
+				fields[i].Label = field.Attribute
+			}
+		}
+		return fields
+	}
+
+	attributes := make([]string, 0, len(subject))
+	for attr := range subject {
+		if attr != "id" && attr != "type" {
+			attributes = append(attributes, attr)
+		}
+	}
+	sort.Strings(attributes)
+	fields := make([]domain.CredentialPDFField, 0, len(attributes)+1)
+	if _, ok := subject["id"]; ok {
+		fields = append(fields, domain.CredentialPDFField{Attribute: "id", Label: "Subject"})
+	}
+	for _, attr := range attributes {
+		fields = append(fields, domain.CredentialPDFField{Attribute: attr, Label: attr})
+	}
+	return fields
+}
+
+func credentialPDFValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+// pdfRenderer writes the blocks of the document one after the other, adding pages when needed
+type pdfRenderer struct {
+	doc *pdf.Document
+	y   float64
+}
+
+func (r *pdfRenderer) header(title, subtitle string, accent color.Color, logo image.Image) {
+	r.doc.Rect(0, 0, pdf.A4Width, pdfHeaderHeight, accent)
+	textX := pdfMargin
+	if logo != nil {
+		b := logo.Bounds()
+		w, h := pdfLogoSide, pdfLogoSide
+		if b.Dx() > b.Dy() {
+			h = pdfLogoSide * float64(b.Dy()) / float64(b.Dx())
+		} else if b.Dy() > b.Dx() {
+			w = pdfLogoSide * float64(b.Dx()) / float64(b.Dy())
+		}
+		r.doc.Image(pdfMargin, (pdfHeaderHeight-h)/2, w, h, logo)
+		textX += pdfLogoSide + 16
+	}
+	width := pdf.A4Width - pdfMargin - textX
+	lines := wrapText(title, pdf.HelveticaBold, 20, width)
+	if len(lines) > 2 {
+		lines = lines[:2]
+	}
+	y := 50.0
+	for _, line := range lines {
+		r.doc.Text(textX, y, pdf.HelveticaBold, 20, color.White, line)
+		y += 24
+	}
+	if subtitle != "" {
+		r.doc.Text(textX, y, pdf.Helvetica, 11, color.White, firstLine(subtitle, pdf.Helvetica, 11, width))
+	}
+	r.y = pdfHeaderHeight + 36
+}
+
+func (r *pdfRenderer) section(title string) {
+	r.ensure(3 * pdfLineHeight)
+	r.doc.Text(pdfMargin, r.y, pdf.HelveticaBold, 13, pdfTextColor, title)
+	r.y += 8
+	r.doc.Rect(pdfMargin, r.y, pdf.A4Width-2*pdfMargin, 0.8, pdfRuleColor)
+	r.y += pdfLineHeight + 4
+}
+
+func (r *pdfRenderer) row(label, value string) {
+	r.rowColor(label, value, pdfTextColor)
+}
+
+func (r *pdfRenderer) rowColor(label, value string, c color.Color) {
+	valueX := pdfMargin + pdfLabelWidth
+	labelLines := wrapText(label, pdf.HelveticaBold, pdfBodySize, pdfLabelWidth-12)
+	valueLines := wrapText(value, pdf.Helvetica, pdfBodySize, pdf.A4Width-pdfMargin-valueX)
+	lines := len(labelLines)
+	if len(valueLines) > lines {
+		lines = len(valueLines)
+	}
+	r.ensure(float64(lines) * pdfLineHeight)
+	for i := 0; i < lines; i++ {
+		if i < len(labelLines) {
+			r.doc.Text(pdfMargin, r.y, pdf.HelveticaBold, pdfBodySize, pdfMutedColor, labelLines[i])
+		}
+		if i < len(valueLines) {
+			r.doc.Text(valueX, r.y, pdf.Helvetica, pdfBodySize, c, valueLines[i])
+		}
+		r.y += pdfLineHeight
+	}
+	r.y += 4
+}
+
+func (r *pdfRenderer) verification(qr image.Image, link string) {
+	r.y += 12
+	r.ensure(pdfQRSide + 4*pdfLineHeight)
+	x := (pdf.A4Width - pdfQRSide) / 2
+	r.doc.Image(x, r.y, pdfQRSide, pdfQRSide, qr)
+	r.y += pdfQRSide + pdfLineHeight
+	r.centered("Scan to verify this credential", pdf.HelveticaBold, pdfBodySize, pdfTextColor)
+	for _, line := range wrapText(link, pdf.Helvetica, 8, pdf.A4Width-2*pdfMargin) {
+		r.centered(line, pdf.Helvetica, 8, pdfMutedColor)
+	}
+}
+
+func (r *pdfRenderer) footer(text string) {
+	if text == "" {
+		return
+	}
+	lines := wrapText(text, pdf.Helvetica, 8, pdf.A4Width-2*pdfMargin)
+	r.y += pdfLineHeight
+	r.ensure(float64(len(lines)) * pdfLineHeight)
+	for _, line := range lines {
+		r.centered(line, pdf.Helvetica, 8, pdfMutedColor)
+	}
+}
+
+func (r *pdfRenderer) centered(text string, font pdf.Font, size float64, c color.Color) {
+	r.doc.Text((pdf.A4Width-pdf.TextWidth(font, size, text))/2, r.y, font, size, c, text)
+	r.y += pdfLineHeight
+}
+
+// ensure adds a page if the block does not fit in the current one
+func (r *pdfRenderer) ensure(height float64) {
+	if r.y+height > pdf.A4Height-pdfMargin {
+		r.doc.AddPage()
+		r.y = pdfMargin + pdfLineHeight
+	}
+}
+
+// wrapText splits the text in lines not wider than width, breaking long words like DIDs when needed
+func wrapText(text string, font pdf.Font, size, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if pdf.TextWidth(font, size, candidate) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			line = ""
+			for _, r := range word {
+				if pdf.TextWidth(font, size, line+string(r)) > width && line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				line += string(r)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func firstLine(text string, font pdf.Font, size, width float64) string {
+	lines := wrapText(text, font, size, width)
+	if len(lines) == 0 {
+		return ""
+	}
+	return lines[0]
+}
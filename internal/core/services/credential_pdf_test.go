@@ -0,0 +1,48 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestCredentialPDF_SaveTemplateValidation(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewCredentialPDF(nil, nil, nil, nil, nil, "https://issuer.example.com")
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+
+	valid := func() *domain.CredentialPDFTemplate {
+		template := domain.NewDefaultCredentialPDFTemplate(*issuerDID, uuid.New())
+		template.Fields = []domain.CredentialPDFField{{Attribute: "birthday", Label: "Date of birth"}}
+		return template
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*domain.CredentialPDFTemplate)
+	}{
+		{name: "invalid accent color", modify: func(tpl *domain.CredentialPDFTemplate) { tpl.AccentColor = "blue" }},
+		{name: "title too long", modify: func(tpl *domain.CredentialPDFTemplate) { tpl.Title = strings.Repeat("a", 501) }},
+		{name: "field without attribute", modify: func(tpl *domain.CredentialPDFTemplate) {
+			tpl.Fields = append(tpl.Fields, domain.CredentialPDFField{Label: "Name"})
+		}},
+		{name: "duplicated field", modify: func(tpl *domain.CredentialPDFTemplate) {
+			tpl.Fields = append(tpl.Fields, domain.CredentialPDFField{Attribute: "birthday"})
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			template := valid()
+			tc.modify(template)
+			assert.ErrorIs(t, service.SaveTemplate(ctx, template), services.ErrCredentialPDFTemplateInvalid)
+		})
+	}
+}
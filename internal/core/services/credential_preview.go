@@ -0,0 +1,159 @@
+package services
+
+import (
+	"bytes"
+	"html/template"
+	"sort"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	credentialPreviewTitleColor      = "#1E1E2F"
+	credentialPreviewTextColor       = "#6B6B7B"
+	credentialPreviewBackgroundColor = "#F4F4F7"
+)
+
+// credentialPreviewTemplate renders a standalone page. The display method colors are checked before, and
+// html/template drops the image urls with unsafe schemes.
+var credentialPreviewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+</head>
+<body style="margin:0;padding:24px;font-family:Helvetica,Arial,sans-serif;color:#1E1E2F;background-color:#FFFFFF;">
+<div style="max-width:480px;margin:0 auto;">
+<div style="padding:24px;border-radius:12px;background-color:{{.BackgroundColor}};{{if .BackgroundImage}}background-image:url('{{.BackgroundImage}}');background-size:cover;{{end}}">
+{{- if .Logo}}
+<img src="{{.Logo}}" alt="{{.LogoAlt}}" style="display:block;max-height:48px;margin-bottom:16px;">
+{{- end}}
+<div style="font-size:22px;font-weight:bold;color:{{.TitleColor}};">{{.Title}}</div>
+{{- if .Description}}
+<div style="margin-top:8px;font-size:14px;color:{{.DescriptionColor}};">{{.Description}}</div>
+{{- end}}
+<div style="margin-top:16px;font-size:13px;color:{{.IssuerColor}};">{{.IssuerName}}</div>
+</div>
+<p style="margin:16px 0;font-size:14px;font-weight:bold;color:{{.StatusColor}};">{{.Status}}</p>
+<table role="presentation" style="width:100%;border-collapse:collapse;font-size:14px;">
+{{- range .Rows}}
+<tr><td style="padding:8px 8px 8px 0;border-bottom:1px solid #DDDDE3;color:#6B6B7B;vertical-align:top;">{{.Name}}</td><td style="padding:8px 0;border-bottom:1px solid #DDDDE3;word-break:break-all;">{{.Value}}</td></tr>
+{{- end}}
+</table>
+</div>
+</body>
+</html>
+`))
+
+// credentialPreviewAttributes returns the attributes of the credential subject sorted by name, with the subject id
+// first
+func credentialPreviewAttributes(subject map[string]any) []domain.CredentialPreviewAttribute {
+	names := make([]string, 0, len(subject))
+	for name := range subject {
+		if name != "id" && name != "type" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := subject["id"]; ok {
+		names = append([]string{"id"}, names...)
+	}
+	attributes := make([]domain.CredentialPreviewAttribute, len(names))
+	for i, name := range names {
+		attributes[i] = domain.CredentialPreviewAttribute{Name: name, Value: credentialPDFValue(subject[name])}
+	}
+	return attributes
+}
+
+// credentialPreviewStatus returns whether the credential is active, revoked or expired
+func credentialPreviewStatus(claim *domain.Claim, vc verifiable.W3CCredential, now time.Time) string {
+	switch {
+	case claim.Revoked:
+		return domain.CredentialPreviewRevoked
+	case vc.Expiration != nil && vc.Expiration.Before(now):
+		return domain.CredentialPreviewExpired
+	default:
+		return domain.CredentialPreviewActive
+	}
+}
+
+// renderCredentialPreview renders the preview as an html page, with the look of its display method when it has one
+func renderCredentialPreview(preview *domain.CredentialPreview) ([]byte, error) {
+	type row struct {
+		Name  string
+		Value string
+	}
+	data := struct {
+		Title            string
+		Description      string
+		IssuerName       string
+		TitleColor       string
+		DescriptionColor string
+		IssuerColor      string
+		BackgroundColor  string
+		BackgroundImage  string
+		Logo             string
+		LogoAlt          string
+		Status           string
+		StatusColor      string
+		Rows             []row
+	}{
+		Title:            preview.SchemaType,
+		IssuerName:       preview.Issuer,
+		TitleColor:       credentialPreviewTitleColor,
+		DescriptionColor: credentialPreviewTextColor,
+		IssuerColor:      credentialPreviewTextColor,
+		BackgroundColor:  credentialPreviewBackgroundColor,
+		Status:           "Active",
+		StatusColor:      "#2E7D32",
+	}
+	if display := preview.Display; display != nil {
+		if display.Title != "" {
+			data.Title = display.Title
+		}
+		if display.IssuerName != "" {
+			data.IssuerName = display.IssuerName
+		}
+		data.Description = display.Description
+		data.TitleColor = previewColor(display.TitleTextColor, data.TitleColor)
+		data.DescriptionColor = previewColor(display.DescriptionTextColor, data.DescriptionColor)
+		data.IssuerColor = previewColor(display.IssuerTextColor, data.IssuerColor)
+		data.BackgroundImage = display.BackgroundImageURL
+		data.Logo = display.Logo.URI
+		data.LogoAlt = display.Logo.Alt
+	}
+	switch preview.Status {
+	case domain.CredentialPreviewRevoked:
+		data.Status, data.StatusColor = "Revoked", "#C01C28"
+	case domain.CredentialPreviewExpired:
+		data.Status, data.StatusColor = "Expired", "#C01C28"
+	}
+
+	if preview.IssuanceDate != nil {
+		data.Rows = append(data.Rows, row{Name: "Issued", Value: preview.IssuanceDate.UTC().Format(time.RFC1123)})
+	}
+	if preview.ExpirationDate != nil {
+		data.Rows = append(data.Rows, row{Name: "Expires", Value: preview.ExpirationDate.UTC().Format(time.RFC1123)})
+	}
+	for _, attribute := range preview.Attributes {
+		data.Rows = append(data.Rows, row{Name: attribute.Name, Value: attribute.Value})
+	}
+
+	var buf bytes.Buffer
+	if err := credentialPreviewTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// previewColor returns the color of the display method if it is a #RRGGBB color, or the default one otherwise
+func previewColor(color string, defaultColor string) string {
+	if hexColorRegexp.MatchString(color) {
+		return color
+	}
+	return defaultColor
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// domainLinkageValidity is the validity of the domain linkage credentials. They are signed again on every request,
+	// so a rotated key signs the next one.
+	domainLinkageValidity = 24 * time.Hour
+	// domainLinkageVerificationMethod is the verification method of the ethereum key in the DID documents of the
+	// identities based on an ethereum key, an EcdsaSecp256k1RecoveryMethod2020 with the address of the DID
+	domainLinkageVerificationMethod = "ethereum-based-id"
+	// domainLinkageAlgorithm is the JOSE algorithm of the secp256k1 signatures with the recovery id, the only one the
+	// verifiers can check against the address of the verification method
+	domainLinkageAlgorithm = "ES256K-R"
+)
+
+// ErrDomainLinkageKeyType the domain linkage credential can't be signed with the keys of the identity
+var ErrDomainLinkageKeyType = errors.New("the domain linkage credential is signed with the ethereum key of the identity, " +
+	"identities with only babyjubjub keys can't sign it: create the issuer identity with an ETH key type")
+
+type domainLinkage struct {
+	identityService ports.IdentityService
+	kms             kms.KMSType
+	now             func() time.Time
+}
+
+// NewDomainLinkage returns a new domain linkage service, that signs the domain linkage credentials of the DID
+// configuration with the ethereum key of the identity
+func NewDomainLinkage(identityService ports.IdentityService, keyStore kms.KMSType) ports.DomainLinkageService {
+	return &domainLinkage{
+		identityService: identityService,
+		kms:             keyStore,
+		now:             time.Now,
+	}
+}
+
+// DIDConfiguration returns the DID configuration of the origin with a domain linkage credential of the identity. The
+// origin is reduced to its scheme and host.
+func (d *domainLinkage) DIDConfiguration(ctx context.Context, did w3c.DID, origin string) (*domain.DIDConfiguration, error) {
+	originURL, err := url.Parse(origin)
+	if err != nil || originURL.Scheme == "" || originURL.Host == "" {
+		return nil, fmt.Errorf("invalid origin %q", origin)
+	}
+	identity, err := d.identityService.GetByDID(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	if kms.KeyType(identity.KeyType) != kms.KeyTypeEthereum {
+		return nil, ErrDomainLinkageKeyType
+	}
+
+	keyIDs, err := d.kms.KeysByIdentity(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	var keyID *kms.KeyID
+	for i := range keyIDs {
+		if keyIDs[i].Type == kms.KeyTypeEthereum {
+			keyID = &keyIDs[i]
+			break
+		}
+	}
+	if keyID == nil {
+		log.Error(ctx, "the identity has no ethereum key in the key store", "did", did.String())
+		return nil, ErrDomainLinkageKeyType
+	}
+
+	credential, err := d.signDomainLinkageCredential(ctx, *keyID, did, originURL.Scheme+"://"+originURL.Host)
+	if err != nil {
+		log.Error(ctx, "signing the domain linkage credential", "err", err, "did", did.String())
+		return nil, err
+	}
+	return &domain.DIDConfiguration{Context: domain.DIDConfigurationContext, LinkedDIDs: []string{credential}}, nil
+}
+
+// signDomainLinkageCredential returns the domain linkage credential of the DID and the origin in the JWT format
+func (d *domainLinkage) signDomainLinkageCredential(ctx context.Context, keyID kms.KeyID, did w3c.DID, origin string) (string, error) {
+	now := d.now().UTC().Truncate(time.Second)
+	expiration := now.Add(domainLinkageValidity)
+	header, err := json.Marshal(map[string]string{
+		"alg": domainLinkageAlgorithm,
+		"typ": "JWT",
+		"kid": did.String() + "#" + domainLinkageVerificationMethod,
+	})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(map[string]any{
+		"iss": did.String(),
+		"sub": did.String(),
+		"nbf": now.Unix(),
+		"exp": expiration.Unix(),
+		"vc": map[string]any{
+			"@context":       []string{"https://www.w3.org/2018/credentials/v1", domain.DIDConfigurationContext},
+			"type":           []string{"VerifiableCredential", "DomainLinkageCredential"},
+			"issuer":         did.String(),
+			"issuanceDate":   now.Format(time.RFC3339),
+			"expirationDate": expiration.Format(time.RFC3339),
+			"credentialSubject": map[string]string{
+				"id":     did.String(),
+				"origin": origin,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := d.kms.Sign(kms.WithSigningOperation(ctx, kms.SigningOperationDomainLinkage, ""), keyID, digest[:])
+	if err != nil {
+		return "", err
+	}
+	// the key store signs as the ethereum transactions, R || S || V, the ES256K-R signature with V as 0 or 1
+	if len(signature) != 65 {
+		return "", fmt.Errorf("invalid ethereum signature length %d", len(signature))
+	}
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
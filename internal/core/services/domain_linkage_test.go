@@ -0,0 +1,104 @@
+package services_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+type domainLinkageIdentityServiceMock struct {
+	ports.IdentityService
+	identity *domain.Identity
+}
+
+func (m *domainLinkageIdentityServiceMock) GetByDID(_ context.Context, _ w3c.DID) (*domain.Identity, error) {
+	return m.identity, nil
+}
+
+func TestDomainLinkage_DIDConfiguration(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	keyStore, err := kms.OpenInMemory()
+	require.NoError(t, err)
+
+	t.Run("should sign the domain linkage credential with the ethereum key", func(t *testing.T) {
+		keyID, err := keyStore.CreateKey(kms.KeyTypeEthereum, did)
+		require.NoError(t, err)
+		pubKeyBytes, err := keyStore.PublicKey(keyID)
+		require.NoError(t, err)
+		pubKey, err := crypto.DecompressPubkey(pubKeyBytes)
+		require.NoError(t, err)
+
+		identity := &domain.Identity{Identifier: did.String(), KeyType: string(kms.KeyTypeEthereum)}
+		service := services.NewDomainLinkage(&domainLinkageIdentityServiceMock{identity: identity}, keyStore)
+		configuration, err := service.DIDConfiguration(ctx, *did, "https://issuer.example.com/ui?tab=1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.DIDConfigurationContext, configuration.Context)
+		require.Len(t, configuration.LinkedDIDs, 1)
+
+		parts := strings.Split(configuration.LinkedDIDs[0], ".")
+		require.Len(t, parts, 3)
+		var header map[string]string
+		decodeJWTPart(t, parts[0], &header)
+		assert.Equal(t, "ES256K-R", header["alg"])
+		assert.Equal(t, did.String()+"#ethereum-based-id", header["kid"])
+
+		var payload struct {
+			Iss string `json:"iss"`
+			Sub string `json:"sub"`
+			VC  struct {
+				Type              []string          `json:"type"`
+				Issuer            string            `json:"issuer"`
+				CredentialSubject map[string]string `json:"credentialSubject"`
+			} `json:"vc"`
+		}
+		decodeJWTPart(t, parts[1], &payload)
+		assert.Equal(t, did.String(), payload.Iss)
+		assert.Equal(t, did.String(), payload.Sub)
+		assert.Equal(t, []string{"VerifiableCredential", "DomainLinkageCredential"}, payload.VC.Type)
+		assert.Equal(t, did.String(), payload.VC.Issuer)
+		assert.Equal(t, map[string]string{"id": did.String(), "origin": "https://issuer.example.com"}, payload.VC.CredentialSubject)
+
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		signer, err := crypto.SigToPub(digest[:], signature)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(*pubKey), crypto.PubkeyToAddress(*signer))
+	})
+
+	t.Run("should fail for the identities with only babyjubjub keys", func(t *testing.T) {
+		identity := &domain.Identity{Identifier: did.String(), KeyType: string(kms.KeyTypeBabyJubJub)}
+		service := services.NewDomainLinkage(&domainLinkageIdentityServiceMock{identity: identity}, keyStore)
+		_, err := service.DIDConfiguration(ctx, *did, "https://issuer.example.com")
+		assert.ErrorIs(t, err, services.ErrDomainLinkageKeyType)
+	})
+
+	t.Run("should fail for an invalid origin", func(t *testing.T) {
+		identity := &domain.Identity{Identifier: did.String(), KeyType: string(kms.KeyTypeEthereum)}
+		service := services.NewDomainLinkage(&domainLinkageIdentityServiceMock{identity: identity}, keyStore)
+		_, err := service.DIDConfiguration(ctx, *did, "issuer.example.com")
+		assert.Error(t, err)
+	})
+}
+
+func decodeJWTPart(t *testing.T, part string, v any) {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(part)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, v))
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const (
+	fetchOfferedThreadKeyPrefix  = "fetch-offered-thread-"
+	fetchConsumedThreadKeyPrefix = "fetch-consumed-thread-"
+)
+
+var (
+	ErrFetchThreadRequired = errors.New("the credential fetch request must have the thread id of the offer")     // ErrFetchThreadRequired the fetch request has no thread id
+	ErrFetchThreadConsumed = errors.New("the credential has already been fetched in the offer thread")           // ErrFetchThreadConsumed a fetch request of the credential was already accepted in the thread
+	ErrFetchThreadMismatch = errors.New("the credential was not offered in the thread of the fetch request")     // ErrFetchThreadMismatch the thread is the one of an offer of other credentials
+	ErrFetchThreadUnknown  = errors.New("the thread of the credential fetch request is not the one of an offer") // ErrFetchThreadUnknown the issuer did not send an offer in the thread, in strict mode
+)
+
+type fetchThreads struct {
+	cache cache.Cache
+	cfg   config.FetchBinding
+
+	accepted   atomic.Uint64
+	replayed   atomic.Uint64
+	mismatched atomic.Uint64
+	unbound    atomic.Uint64
+}
+
+// NewFetchThreads returns a new service that binds the credential fetch requests to the thread of their offer.
+// It does nothing if the fetch binding is not enabled.
+func NewFetchThreads(c cache.Cache, cfg config.FetchBinding) ports.FetchThreadsService {
+	return &fetchThreads{
+		cache: c,
+		cfg:   cfg,
+	}
+}
+
+// Offer records the credentials offered in the thread for the configured TTL
+func (f *fetchThreads) Offer(ctx context.Context, threadID string, credentialIDs ...uuid.UUID) {
+	if !f.cfg.Enabled || threadID == "" || len(credentialIDs) == 0 {
+		return
+	}
+	ids := make([]string, len(credentialIDs))
+	for i, id := range credentialIDs {
+		ids[i] = id.String()
+	}
+	if err := f.cache.Set(ctx, fetchOfferedThreadKeyPrefix+threadID, ids, f.cfg.TTL); err != nil {
+		log.Error(ctx, "recording the thread of the credential offer", "err", err, "thread", threadID)
+	}
+}
+
+// Consume accepts the first fetch request of a thread for each credential offered in it. Threads unknown to the
+// issuer, e.g. of offers sent before the binding was enabled, are accepted unless the binding is strict.
+// The consumed threads are remembered for the configured TTL.
+func (f *fetchThreads) Consume(ctx context.Context, threadID string, credentialID uuid.UUID) error {
+	if !f.cfg.Enabled {
+		return nil
+	}
+	if threadID == "" {
+		f.unbound.Add(1)
+		log.Warn(ctx, "credential fetch request without thread", "credential", credentialID)
+		return ErrFetchThreadRequired
+	}
+	consumedKey := fetchConsumedThreadKeyPrefix + threadID + "-" + credentialID.String()
+	if f.cache.Exists(ctx, consumedKey) {
+		f.replayed.Add(1)
+		log.Warn(ctx, "credential fetch request replayed", "thread", threadID, "credential", credentialID)
+		return ErrFetchThreadConsumed
+	}
+
+	var offered []string
+	if f.cache.Get(ctx, fetchOfferedThreadKeyPrefix+threadID, &offered) {
+		if !containsString(offered, credentialID.String()) {
+			f.mismatched.Add(1)
+			log.Warn(ctx, "credential fetch request for a credential not offered in the thread", "thread", threadID, "credential", credentialID)
+			return ErrFetchThreadMismatch
+		}
+	} else {
+		f.unbound.Add(1)
+		if f.cfg.Strict {
+			log.Warn(ctx, "credential fetch request in an unknown thread", "thread", threadID, "credential", credentialID)
+			return ErrFetchThreadUnknown
+		}
+	}
+
+	if err := f.cache.Set(ctx, consumedKey, threadID, f.cfg.TTL); err != nil {
+		log.Error(ctx, "recording the consumed credential offer thread", "err", err, "thread", threadID)
+		return err
+	}
+	f.accepted.Add(1)
+	return nil
+}
+
+// Metrics returns the counters since the service started
+func (f *fetchThreads) Metrics() ports.FetchThreadsMetrics {
+	return ports.FetchThreadsMetrics{
+		Accepted:   f.accepted.Load(),
+		Replayed:   f.replayed.Load(),
+		Mismatched: f.mismatched.Load(),
+		Unbound:    f.unbound.Load(),
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+func TestFetchThreads(t *testing.T) {
+	ctx := context.Background()
+	credentialID, otherCredentialID := uuid.New(), uuid.New()
+
+	t.Run("should accept everything when the binding is disabled", func(t *testing.T) {
+		fetchThreads := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: false})
+		require.NoError(t, fetchThreads.Consume(ctx, "", credentialID))
+		require.NoError(t, fetchThreads.Consume(ctx, "thread", credentialID))
+		require.NoError(t, fetchThreads.Consume(ctx, "thread", credentialID))
+		assert.Equal(t, ports.FetchThreadsMetrics{}, fetchThreads.Metrics())
+	})
+
+	t.Run("should accept every credential of the offer once per thread", func(t *testing.T) {
+		fetchThreads := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: true, TTL: time.Minute})
+		threadID := uuid.NewString()
+		fetchThreads.Offer(ctx, threadID, credentialID, otherCredentialID)
+
+		require.NoError(t, fetchThreads.Consume(ctx, threadID, credentialID))
+		require.NoError(t, fetchThreads.Consume(ctx, threadID, otherCredentialID))
+		assert.ErrorIs(t, fetchThreads.Consume(ctx, threadID, credentialID), services.ErrFetchThreadConsumed)
+		assert.Equal(t, ports.FetchThreadsMetrics{Accepted: 2, Replayed: 1}, fetchThreads.Metrics())
+	})
+
+	t.Run("should reject a credential not offered in the thread", func(t *testing.T) {
+		fetchThreads := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: true, TTL: time.Minute})
+		threadID := uuid.NewString()
+		fetchThreads.Offer(ctx, threadID, credentialID)
+
+		assert.ErrorIs(t, fetchThreads.Consume(ctx, threadID, otherCredentialID), services.ErrFetchThreadMismatch)
+		assert.Equal(t, ports.FetchThreadsMetrics{Mismatched: 1}, fetchThreads.Metrics())
+	})
+
+	t.Run("should require the thread of the fetch request", func(t *testing.T) {
+		fetchThreads := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: true, TTL: time.Minute})
+		assert.ErrorIs(t, fetchThreads.Consume(ctx, "", credentialID), services.ErrFetchThreadRequired)
+		assert.Equal(t, ports.FetchThreadsMetrics{Unbound: 1}, fetchThreads.Metrics())
+	})
+
+	t.Run("should accept unknown threads once unless the binding is strict", func(t *testing.T) {
+		fetchThreads := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: true, TTL: time.Minute})
+		threadID := uuid.NewString()
+		require.NoError(t, fetchThreads.Consume(ctx, threadID, credentialID))
+		assert.ErrorIs(t, fetchThreads.Consume(ctx, threadID, credentialID), services.ErrFetchThreadConsumed)
+
+		strict := services.NewFetchThreads(cache.NewMemoryCache(), config.FetchBinding{Enabled: true, Strict: true, TTL: time.Minute})
+		assert.ErrorIs(t, strict.Consume(ctx, uuid.NewString(), credentialID), services.ErrFetchThreadUnknown)
+		assert.Equal(t, ports.FetchThreadsMetrics{Unbound: 1}, strict.Metrics())
+	})
+}
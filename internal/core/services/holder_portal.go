@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	holderSessionTokenSize = 32
+	holderSessionKeyPrefix = "holder-session-"
+)
+
+var (
+	ErrHolderSessionNotFound   = errors.New("holder session not found")               // ErrHolderSessionNotFound the session does not exist or has expired
+	ErrHolderCredentialRevoked = errors.New("revoked credentials cannot be reissued") // ErrHolderCredentialRevoked the credential is revoked
+)
+
+type holderPortal struct {
+	sessionManager        ports.SessionRepository
+	connectionsService    ports.ConnectionsService
+	claimsService         ports.ClaimsService
+	displayMethodResolver ports.DisplayMethodResolver
+	sessionTTL            time.Duration
+}
+
+// NewHolderPortal returns a new holder portal service. The display method resolver may be nil, the previews are
+// rendered without the display methods then.
+func NewHolderPortal(sessionManager ports.SessionRepository, connectionsService ports.ConnectionsService, claimsService ports.ClaimsService, displayMethodResolver ports.DisplayMethodResolver, sessionTTL time.Duration) ports.HolderPortalService {
+	return &holderPortal{
+		sessionManager:        sessionManager,
+		connectionsService:    connectionsService,
+		claimsService:         claimsService,
+		displayMethodResolver: displayMethodResolver,
+		sessionTTL:            sessionTTL,
+	}
+}
+
+// CreateSession exchanges an authentication session already answered by the holder for a holder session.
+// The authentication session is removed, so it cannot be exchanged twice.
+func (h *holderPortal) CreateSession(ctx context.Context, issuerDID w3c.DID, authSessionID uuid.UUID) (*domain.HolderSession, error) {
+	if _, err := h.sessionManager.Get(ctx, authSessionID.String()); err != nil {
+		return nil, ErrHolderSessionNotFound
+	}
+
+	conn, err := h.connectionsService.GetByUserSessionID(ctx, authSessionID)
+	if err != nil {
+		if errors.Is(err, ErrConnectionDoesNotExist) {
+			return nil, ErrHolderSessionNotFound
+		}
+		return nil, err
+	}
+	if conn.IssuerDID.String() != issuerDID.String() {
+		return nil, ErrHolderSessionNotFound
+	}
+
+	token := make([]byte, holderSessionTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	session := domain.HolderSession{
+		Token:     hex.EncodeToString(token),
+		UserDID:   conn.UserDID,
+		IssuerDID: issuerDID,
+		ExpiresAt: time.Now().Add(h.sessionTTL).UTC(),
+	}
+	if err := h.sessionManager.SetHolderSession(ctx, holderSessionKeyPrefix+session.Token, session, h.sessionTTL); err != nil {
+		return nil, err
+	}
+	if err := h.sessionManager.Delete(ctx, authSessionID.String()); err != nil {
+		log.Warn(ctx, "removing authentication session", "err", err, "session", authSessionID)
+	}
+
+	return &session, nil
+}
+
+// GetSession returns the holder session of the given token
+func (h *holderPortal) GetSession(ctx context.Context, token string) (*domain.HolderSession, error) {
+	session, err := h.sessionManager.GetHolderSession(ctx, holderSessionKeyPrefix+token)
+	if err != nil {
+		return nil, ErrHolderSessionNotFound
+	}
+	return &session, nil
+}
+
+// GetCredentials returns the credentials issued to the session holder
+func (h *holderPortal) GetCredentials(ctx context.Context, session *domain.HolderSession) ([]*domain.Claim, error) {
+	credentials, _, err := h.claimsService.GetAll(ctx, session.IssuerDID, &ports.ClaimsFilter{Subject: session.UserDID.String()})
+	if err != nil {
+		if errors.Is(err, ErrClaimNotFound) {
+			return []*domain.Claim{}, nil
+		}
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// ReissueCredential issues a new credential with the same content as the given one. The new credential keeps the
+// validity period of the original one.
+func (h *holderPortal) ReissueCredential(ctx context.Context, session *domain.HolderSession, id uuid.UUID, credentialStatusType verifiable.CredentialStatusType) (*domain.Claim, error) {
+	claim, err := h.getCredential(ctx, session, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Revoked {
+		return nil, ErrHolderCredentialRevoked
+	}
+
+	req, err := reissueRequest(session.IssuerDID, claim, credentialStatusType)
+	if err != nil {
+		log.Error(ctx, "reissuing credential. Reading credential", "err", err, "id", id)
+		return nil, err
+	}
+	req.RequesterRole = PolicyRequesterHolder
+	req.Supersedes = &claim.ID
+
+	return h.claimsService.Save(ctx, req)
+}
+
+// GetCredentialQrCode returns the offer qr code of a credential of the session holder
+func (h *holderPortal) GetCredentialQrCode(ctx context.Context, session *domain.HolderSession, id uuid.UUID, hostURL string, localizer *domain.Localizer) (*ports.GetCredentialQrCodeResponse, error) {
+	if _, err := h.getCredential(ctx, session, id); err != nil {
+		return nil, err
+	}
+	return h.claimsService.GetCredentialQrCode(ctx, &session.IssuerDID, id, hostURL, localizer)
+}
+
+// PreviewCredential returns a readable view of a credential of the session holder, with its status and the document
+// of its display method. A display method that cannot be resolved is left out of the preview.
+func (h *holderPortal) PreviewCredential(ctx context.Context, session *domain.HolderSession, id uuid.UUID) (*domain.CredentialPreview, error) {
+	claim, err := h.getCredential(ctx, session, id)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		log.Error(ctx, "previewing credential. Reading credential", "err", err, "id", id)
+		return nil, err
+	}
+
+	preview := &domain.CredentialPreview{
+		ID:             claim.ID,
+		CredentialID:   vc.ID,
+		SchemaType:     claim.SchemaType,
+		SchemaURL:      claim.SchemaURL,
+		Issuer:         claim.Issuer,
+		IssuanceDate:   vc.IssuanceDate,
+		ExpirationDate: vc.Expiration,
+		Status:         credentialPreviewStatus(claim, vc, time.Now()),
+		Attributes:     credentialPreviewAttributes(vc.CredentialSubject),
+	}
+	if vc.DisplayMethod != nil && h.displayMethodResolver != nil {
+		display, err := h.displayMethodResolver.Resolve(ctx, *vc.DisplayMethod)
+		if err != nil {
+			log.Warn(ctx, "previewing credential without its display method", "err", err, "id", id, "displayMethod", vc.DisplayMethod.ID)
+		} else {
+			preview.Display = display
+		}
+	}
+	return preview, nil
+}
+
+// PreviewCredentialHTML returns the preview of a credential of the session holder as an html page
+func (h *holderPortal) PreviewCredentialHTML(ctx context.Context, session *domain.HolderSession, id uuid.UUID) ([]byte, error) {
+	preview, err := h.PreviewCredential(ctx, session, id)
+	if err != nil {
+		return nil, err
+	}
+	return renderCredentialPreview(preview)
+}
+
+// getCredential returns the credential only if it was issued to the session holder
+func (h *holderPortal) getCredential(ctx context.Context, session *domain.HolderSession, id uuid.UUID) (*domain.Claim, error) {
+	claim, err := h.claimsService.GetByID(ctx, &session.IssuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.OtherIdentifier != session.UserDID.String() {
+		return nil, ErrClaimNotFound
+	}
+	return claim, nil
+}
+
+// reissueRequest returns the request to issue again the content of the credential. The new credential keeps the
+// validity period, the evidence and the terms of use of the original one and skips the duplicates check.
+func reissueRequest(issuerDID w3c.DID, claim *domain.Claim, credentialStatusType verifiable.CredentialStatusType) (*ports.CreateClaimRequest, error) {
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	credentialSubject := make(map[string]any, len(vc.CredentialSubject))
+	for k, v := range vc.CredentialSubject {
+		credentialSubject[k] = v
+	}
+	typ, _ := credentialSubject["type"].(string)
+	delete(credentialSubject, "type")
+
+	var expiration *time.Time
+	if vc.Expiration != nil && vc.IssuanceDate != nil {
+		exp := time.Now().Add(vc.Expiration.Sub(*vc.IssuanceDate))
+		expiration = &exp
+	}
+
+	claimRequestProofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      claim.SignatureProof.Status == pgtype.Present,
+		Iden3SparseMerkleTreeProof: claim.MtProof,
+	}
+	extensions, err := claim.GetCredentialExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	req := ports.NewCreateClaimRequest(&issuerDID, claim.SchemaURL, credentialSubject, expiration, typ, nil, nil, nil, claimRequestProofs, nil, true, credentialStatusType, vc.RefreshService, nil, vc.DisplayMethod)
+	req.Force = true
+	req.Extensions = extensions
+	return req, nil
+}
@@ -0,0 +1,100 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+type previewClaimsServiceMock struct {
+	ports.ClaimsService
+	claim *domain.Claim
+}
+
+func (m *previewClaimsServiceMock) GetByID(_ context.Context, _ *w3c.DID, id uuid.UUID) (*domain.Claim, error) {
+	if m.claim == nil || m.claim.ID != id {
+		return nil, services.ErrClaimNotFound
+	}
+	return m.claim, nil
+}
+
+type displayMethodResolverMock struct {
+	display *domain.CredentialDisplay
+	err     error
+}
+
+func (m *displayMethodResolverMock) Resolve(_ context.Context, _ verifiable.DisplayMethod) (*domain.CredentialDisplay, error) {
+	return m.display, m.err
+}
+
+func TestHolderPortal_PreviewCredential(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	session := &domain.HolderSession{UserDID: *userDID, IssuerDID: *issuerDID}
+
+	claim := &domain.Claim{
+		ID:              uuid.New(),
+		Issuer:          issuerDID.String(),
+		OtherIdentifier: userDID.String(),
+		SchemaType:      "KYCAgeCredential",
+		SchemaURL:       "https://example.com/kyc.json",
+	}
+	require.NoError(t, claim.Data.Set(`{
+		"id": "urn:uuid:3a8a9a4e-6b1a-4b8e-9d0a-6a1f0f3e2c11",
+		"type": ["VerifiableCredential", "KYCAgeCredential"],
+		"issuer": "`+issuerDID.String()+`",
+		"issuanceDate": "2024-04-01T00:00:00Z",
+		"expirationDate": "2020-01-01T00:00:00Z",
+		"credentialSubject": {"type": "KYCAgeCredential", "id": "`+userDID.String()+`", "name": "<b>Alice</b>", "birthday": 19960424},
+		"displayMethod": {"id": "https://example.com/display.json", "type": "Iden3BasicDisplayMethodV1"}
+	}`))
+	claims := &previewClaimsServiceMock{claim: claim}
+
+	resolver := &displayMethodResolverMock{display: &domain.CredentialDisplay{Title: "Age", TitleTextColor: "red;}"}}
+	portal := services.NewHolderPortal(nil, nil, claims, resolver, 0)
+
+	preview, err := portal.PreviewCredential(ctx, session, claim.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CredentialPreviewExpired, preview.Status)
+	assert.Equal(t, []domain.CredentialPreviewAttribute{
+		{Name: "id", Value: userDID.String()},
+		{Name: "birthday", Value: "19960424"},
+		{Name: "name", Value: "<b>Alice</b>"},
+	}, preview.Attributes)
+	require.NotNil(t, preview.Display)
+	assert.Equal(t, "Age", preview.Display.Title)
+
+	html, err := portal.PreviewCredentialHTML(ctx, session, claim.ID)
+	require.NoError(t, err)
+	assert.Contains(t, string(html), "<title>Age</title>")
+	assert.Contains(t, string(html), "&lt;b&gt;Alice&lt;/b&gt;")
+	assert.NotContains(t, string(html), "red;}")
+
+	claim.Revoked = true
+	resolver.err = errors.New("unreachable")
+	preview, err = portal.PreviewCredential(ctx, session, claim.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CredentialPreviewRevoked, preview.Status)
+	assert.Nil(t, preview.Display)
+
+	html, err = portal.PreviewCredentialHTML(ctx, session, claim.ID)
+	require.NoError(t, err)
+	assert.Contains(t, string(html), "<title>KYCAgeCredential</title>")
+
+	other := &domain.HolderSession{UserDID: *issuerDID, IssuerDID: *issuerDID}
+	_, err = portal.PreviewCredential(ctx, other, claim.ID)
+	assert.ErrorIs(t, err, services.ErrClaimNotFound)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/circuit/signer"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite"
@@ -57,6 +58,16 @@ var (
 	ErrAssigningMTPProof = errors.New("error assigning the MTP Proof from Auth Claim. If this identity has keyType=ETH you must to publish the state first")
 	// ErrNoClaimsFoundToProcess - means that there are no claims to process
 	ErrNoClaimsFoundToProcess = errors.New("no MTP or revoked claims found to process")
+	// ErrAuthenticationWrongSender - means that the authentication response was not sent by the DID the request was addressed to
+	ErrAuthenticationWrongSender = errors.New("authentication response sender is not the target of the request")
+	// ErrIdentityNotFound - means that the identity does not exist
+	ErrIdentityNotFound = errors.New("identity not found")
+	// ErrInvalidServerURL - means that the server url of the identity is not an absolute http or https url
+	ErrInvalidServerURL = errors.New("invalid server url")
+	// ErrServerURLInUse - means that other identity is reached at the server url already
+	ErrServerURLInUse = errors.New("the server url is used by other identity already")
+	// ErrConnectionDenied - means that the connection webhook denied the connection with a holder that authenticated for the first time
+	ErrConnectionDenied = errors.New("the connection was denied")
 )
 
 type identity struct {
@@ -72,6 +83,8 @@ type identity struct {
 	qrService               ports.QrStoreService
 	kms                     kms.KMSType
 	verifier                *auth.Verifier
+	trustRegistry           ports.TrustRegistryService
+	connectionWebhook       ports.ConnectionWebhook
 
 	ignoreRHSErrors          bool
 	pubsub                   pubsub.Publisher
@@ -82,7 +95,7 @@ type identity struct {
 
 // NewIdentity creates a new identity
 // nolint
-func NewIdentity(kms kms.KMSType, identityRepository ports.IndentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimsRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionsRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, ps pubsub.Client, credentialStatusSettings config.CredentialStatus, rhsFactory reverse_hash.Factory, revocationStatusResolver *revocation_status.RevocationStatusResolver) ports.IdentityService {
+func NewIdentity(kms kms.KMSType, identityRepository ports.IndentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimsRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionsRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, ps pubsub.Client, credentialStatusSettings config.CredentialStatus, rhsFactory reverse_hash.Factory, revocationStatusResolver *revocation_status.RevocationStatusResolver, trustRegistry ports.TrustRegistryService, connectionWebhook ports.ConnectionWebhook) ports.IdentityService {
 	return &identity{
 		identityRepository:       identityRepository,
 		imtRepository:            imtRepository,
@@ -101,6 +114,8 @@ func NewIdentity(kms kms.KMSType, identityRepository ports.IndentityRepository,
 		credentialStatusSettings: credentialStatusSettings,
 		rhsFactory:               rhsFactory,
 		revocationStatusResolver: revocationStatusResolver,
+		trustRegistry:            trustRegistry,
+		connectionWebhook:        connectionWebhook,
 	}
 }
 
@@ -108,9 +123,58 @@ func (i *identity) GetByDID(ctx context.Context, identifier w3c.DID) (*domain.Id
 	return i.identityRepository.GetByID(ctx, i.storage.Pgx, identifier)
 }
 
+// UpdateServerURL sets the public url the agent of the identity is reached at, or removes it if nil, so the identity
+// falls back to the server url of the node
+func (i *identity) UpdateServerURL(ctx context.Context, did w3c.DID, serverURL *string) (*domain.Identity, error) {
+	if serverURL != nil {
+		normalized, err := domain.NormalizeServerURL(*serverURL)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidServerURL, err)
+		}
+		serverURL = &normalized
+	}
+	err := i.identityRepository.UpdateServerURL(ctx, i.storage.Pgx, did, serverURL)
+	switch {
+	case errors.Is(err, repositories.ErrIdentityDoesNotExist):
+		return nil, ErrIdentityNotFound
+	case errors.Is(err, repositories.ErrIdentityServerURLInUse):
+		return nil, ErrServerURLInUse
+	case err != nil:
+		return nil, err
+	}
+	return i.identityRepository.GetByID(ctx, i.storage.Pgx, did)
+}
+
+// ServerURL returns the public url the agent of the identity is reached at, or the default one if it does not have
+// its own
+func (i *identity) ServerURL(ctx context.Context, did w3c.DID, defaultURL string) string {
+	identity, err := i.identityRepository.GetByID(ctx, i.storage.Pgx, did)
+	if err != nil {
+		log.Warn(ctx, "getting the server url of the identity", "err", err, "did", did.String())
+		return defaultURL
+	}
+	return identity.AgentServerURL(defaultURL)
+}
+
+// GetByServerURL returns the identity whose agent is reached at the server url
+func (i *identity) GetByServerURL(ctx context.Context, serverURL string) (*w3c.DID, error) {
+	did, err := i.identityRepository.GetByServerURL(ctx, i.storage.Pgx, strings.TrimSuffix(serverURL, "/"))
+	if errors.Is(err, repositories.ErrIdentityDoesNotExist) {
+		return nil, ErrIdentityNotFound
+	}
+	return did, err
+}
+
 func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports.DIDCreationOptions) (*domain.Identity, error) {
 	var identifier *w3c.DID
 	var err error
+	if didOptions != nil && didOptions.ServerURL != nil {
+		serverURL, err := domain.NormalizeServerURL(*didOptions.ServerURL)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidServerURL, err)
+		}
+		didOptions.ServerURL = &serverURL
+	}
 	err = i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
 			var keyType kms.KeyType
@@ -132,6 +196,9 @@ func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports
 		})
 	if err != nil {
 		log.Error(ctx, "creating identity", "err", err, "id", identifier)
+		if errors.Is(err, repositories.ErrIdentityServerURLInUse) {
+			return nil, ErrServerURLInUse
+		}
 		return nil, fmt.Errorf("cannot create identity: %w", err)
 	}
 
@@ -201,6 +268,42 @@ func (i *identity) Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	return identity != nil, nil
 }
 
+// AddAuthKey adds a new auth claim of the BJJ key to the identity. The claim is added to the claims tree with the next
+// state transition, which is signed with the current auth claim, so both keys are valid once it is published.
+func (i *identity) AddAuthKey(ctx context.Context, did w3c.DID, keyID kms.KeyID, hostURL string, status verifiable.CredentialStatusType) (*domain.Claim, error) {
+	if keyID.Type != kms.KeyTypeBabyJubJub {
+		return nil, kms.ErrIncorrectKeyType
+	}
+
+	identity, err := i.identityRepository.GetByID(ctx, i.storage.Pgx, did)
+	if err != nil {
+		return nil, fmt.Errorf("can't get identity: %w", err)
+	}
+
+	pubKey, err := bjjPubKey(i.kms, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	authClaim, err := newAuthClaim(pubKey)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("can't create auth claim"))
+	}
+
+	authClaimModel, err := i.authClaimToModel(ctx, &did, identity, authClaim, nil, pubKey, hostURL, status, false)
+	if err != nil {
+		log.Error(ctx, "auth claim to model", "err", err)
+		return nil, err
+	}
+
+	authClaimModel.ID, err = i.claimsRepository.Save(ctx, i.storage.Pgx, authClaimModel)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("can't save auth claim"))
+	}
+
+	return authClaimModel, nil
+}
+
 // getKeyIDFromAuthClaim finds BJJ KeyID of auth claim
 // in registered key providers
 func (i *identity) getKeyIDFromAuthClaim(ctx context.Context, authClaim *domain.Claim) (kms.KeyID, error) {
@@ -250,6 +353,11 @@ func (i *identity) Get(ctx context.Context) (identities []string, err error) {
 	return i.identityRepository.Get(ctx, i.storage.Pgx)
 }
 
+// GetByTenantID returns the identifiers of the identities that belong to the given tenant
+func (i *identity) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (identities []string, err error) {
+	return i.identityRepository.GetByTenantID(ctx, i.storage.Pgx, tenantID)
+}
+
 // GetLatestStateByID get latest identity state by identifier
 func (i *identity) GetLatestStateByID(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error) {
 	// check that identity exists in the db
@@ -317,7 +425,8 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 
 	err := i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
-			iTrees, err := i.mtService.GetIdentityMerkleTrees(ctx, tx, &did)
+			// The new claims are added to the trees in memory and written at once after the new roots are calculated
+			iTrees, err := i.mtService.GetIdentityMerkleTreesBuffered(ctx, tx, &did)
 			if err != nil {
 				return err
 			}
@@ -353,6 +462,11 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 				return err
 			}
 
+			if err := iTrees.Flush(ctx); err != nil {
+				log.Error(ctx, "writing the merkle trees", "err", err)
+				return err
+			}
+
 			err = i.update(ctx, tx, &did, *newState)
 			if err != nil {
 				log.Error(ctx, "updating claims", "err", err)
@@ -364,6 +478,11 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 				return fmt.Errorf("error saving new identity state: %w", err)
 			}
 
+			err = i.revocationRepository.UpdateIdentityState(ctx, tx, updatedRevocations, *newState.State)
+			if err != nil {
+				return fmt.Errorf("error updating revocations state: %w", err)
+			}
+
 			rhsPublishers, err := i.rhsFactory.BuildPublishers(ctx, reverse_hash.RHSMode(i.credentialStatusSettings.RHSMode), &kms.KeyID{
 				Type: kms.KeyTypeEthereum,
 				ID:   i.credentialStatusSettings.OnchainTreeStore.PublishingKeyPath,
@@ -449,7 +568,18 @@ func (i *identity) Authenticate(ctx context.Context, message string, sessionID u
 		return nil, err
 	}
 
-	issuerDoc := newDIDDocument(serverURL, issuerDID)
+	if authReq.To != "" && authReq.To != arm.From {
+		log.Warn(ctx, "authentication response from unexpected sender", "expected", authReq.To, "from", arm.From)
+		return nil, ErrAuthenticationWrongSender
+	}
+
+	if i.trustRegistry != nil {
+		if err := i.trustRegistry.VerifyAuthorization(ctx, arm); err != nil {
+			return nil, err
+		}
+	}
+
+	issuerDoc := newDIDDocument(i.ServerURL(ctx, issuerDID, serverURL), issuerDID)
 	bytesIssuerDoc, err := json.Marshal(issuerDoc)
 	if err != nil {
 		log.Error(ctx, "failed to marshal issuerDoc", "err", err)
@@ -463,15 +593,22 @@ func (i *identity) Authenticate(ctx context.Context, message string, sessionID u
 		return nil, err
 	}
 
+	now := time.Now()
 	conn := &domain.Connection{
-		ID:         uuid.New(),
-		IssuerDID:  issuerDID,
-		UserDID:    *userDID,
-		IssuerDoc:  bytesIssuerDoc,
-		UserDoc:    arm.Body.DIDDoc,
-		CreatedAt:  time.Now(),
-		ModifiedAt: time.Now(),
+		ID:             uuid.New(),
+		IssuerDID:      issuerDID,
+		UserDID:        *userDID,
+		IssuerDoc:      bytesIssuerDoc,
+		UserDoc:        arm.Body.DIDDoc,
+		CreatedAt:      now,
+		ModifiedAt:     now,
+		LastVerifiedAt: &now,
+		Profile:        connectionProfileFromAuthMessage(ctx, arm.Body.Message),
+	}
+	if err := i.vetNewConnection(ctx, conn, sessionID); err != nil {
+		return nil, err
 	}
+
 	var connID uuid.UUID
 	if err := i.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
 		connID, err = i.connectionsRepository.Save(ctx, i.storage.Pgx, conn)
@@ -489,17 +626,101 @@ func (i *identity) Authenticate(ctx context.Context, message string, sessionID u
 		if err != nil {
 			log.Error(ctx, "sending connection notification", "err", err.Error(), "connection", connID)
 		}
+		err = i.pubsub.Publish(ctx, event.FirstAuthenticationEvent, &event.FirstAuthentication{ConnectionID: connID.String(), IssuerID: issuerDID.String(), UserID: userDID.String()})
+		if err != nil {
+			log.Error(ctx, "sending first authentication event", "err", err.Error(), "connection", connID)
+		}
 	}
 
 	return arm, nil
 }
 
+// vetNewConnection consults the connection webhook when the holder has no connection with the issuer yet, so the
+// holder can be checked before the connection is created and the pending credentials are offered
+func (i *identity) vetNewConnection(ctx context.Context, conn *domain.Connection, sessionID uuid.UUID) error {
+	if i.connectionWebhook == nil {
+		return nil
+	}
+	_, err := i.connectionsRepository.GetByUserID(ctx, i.storage.Pgx, conn.IssuerDID, conn.UserDID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+		return err
+	}
+
+	decision, err := i.connectionWebhook.Vet(ctx, domain.NewConnectionVetting{
+		Issuer:      conn.IssuerDID.String(),
+		UserDID:     conn.UserDID.String(),
+		SessionID:   sessionID.String(),
+		DisplayName: conn.Profile.DisplayName,
+		ExternalID:  conn.Profile.ExternalID,
+		Email:       conn.Profile.Email,
+	})
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "audit: new connection vetted", "allow", decision.Allow, "reason", decision.Reason, "issuer", conn.IssuerDID.String(), "userDID", conn.UserDID.String())
+	if !decision.Allow {
+		if decision.Reason != "" {
+			return fmt.Errorf("%w: %s", ErrConnectionDenied, decision.Reason)
+		}
+		return ErrConnectionDenied
+	}
+	return nil
+}
+
+// connectionProfileFromAuthMessage reads the optional profile the holder can include in the message of the
+// authentication response, e.g {"profile": {"displayName": "Jane Doe", "email": "jane@example.com"}}.
+// Messages without a valid profile are ignored.
+func connectionProfileFromAuthMessage(ctx context.Context, message string) domain.ConnectionProfile {
+	if message == "" {
+		return domain.ConnectionProfile{}
+	}
+	var body struct {
+		Profile *struct {
+			DisplayName *string `json:"displayName"`
+			ExternalID  *string `json:"externalId"`
+			Email       *string `json:"email"`
+		} `json:"profile"`
+	}
+	if err := json.Unmarshal([]byte(message), &body); err != nil || body.Profile == nil {
+		return domain.ConnectionProfile{}
+	}
+	profile := domain.ConnectionProfile{
+		DisplayName: body.Profile.DisplayName,
+		ExternalID:  body.Profile.ExternalID,
+		Email:       body.Profile.Email,
+	}
+	if err := validateConnectionProfile(profile); err != nil {
+		log.Warn(ctx, "ignoring invalid connection profile in the authentication response", "err", err)
+		return domain.ConnectionProfile{}
+	}
+	return profile
+}
+
 func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID) (*ports.CreateAuthenticationQRCodeResponse, error) {
+	return i.createAuthenticationQRCode(ctx, serverURL, issuerDID, nil)
+}
+
+// CreateReAuthenticationQRCode creates an authentication request addressed to the given user DID. Only that DID
+// is able to answer it, so a successful callback proves that the holder still controls the DID of the connection.
+func (i *identity) CreateReAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID, userDID w3c.DID) (*ports.CreateAuthenticationQRCodeResponse, error) {
+	return i.createAuthenticationQRCode(ctx, serverURL, issuerDID, &userDID)
+}
+
+func (i *identity) createAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID, userDID *w3c.DID) (*ports.CreateAuthenticationQRCodeResponse, error) {
 	sessionID := uuid.New()
 	reqID := uuid.New().String()
 
+	var to string
+	if userDID != nil {
+		to = userDID.String()
+	}
+
 	qrCode := &protocol.AuthorizationRequestMessage{
 		From:     issuerDID.String(),
+		To:       to,
 		ID:       reqID,
 		ThreadID: reqID,
 		Typ:      packers.MediaTypePlainMessage,
@@ -624,6 +845,8 @@ func (i *identity) createEthIdentity(ctx context.Context, tx db.Querier, hostURL
 		return nil, nil, err
 	}
 
+	identity.TenantID = didOptions.TenantID
+	identity.ServerURL = didOptions.ServerURL
 	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
 		log.Error(ctx, "saving identity", "err", err)
 		return nil, nil, errors.Join(err, errors.New("can't save identity"))
@@ -731,6 +954,8 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, hostURL st
 		return nil, nil, fmt.Errorf("can't save auth claim: %w", err)
 	}
 
+	identity.TenantID = didOptions.TenantID
+	identity.ServerURL = didOptions.ServerURL
 	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
 		return nil, nil, fmt.Errorf("can't save identity: %w", err)
 	}
@@ -881,6 +1106,31 @@ func (i *identity) GetStates(ctx context.Context, issuerDID w3c.DID) ([]domain.I
 	return i.identityStateRepository.GetStates(ctx, i.storage.Pgx, issuerDID)
 }
 
+// GetStateTransactions returns the state transitions of the issuer with the credentials issued and revoked in each one
+func (i *identity) GetStateTransactions(ctx context.Context, issuerDID w3c.DID) ([]domain.StateTransaction, error) {
+	states, err := i.identityStateRepository.GetStates(ctx, i.storage.Pgx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := i.identityStateRepository.GetStateTransitionContents(ctx, i.storage.Pgx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]domain.StateTransaction, len(states))
+	for j := range states {
+		transactions[j] = domain.StateTransaction{IdentityState: states[j]}
+		if states[j].State == nil {
+			continue
+		}
+		if content, ok := contents[*states[j].State]; ok {
+			transactions[j].CredentialIDs = content.CredentialIDs
+			transactions[j].RevokedCredentialIDs = content.RevokedCredentialIDs
+		}
+	}
+	return transactions, nil
+}
+
 func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context) ([]*w3c.DID, error) {
 	return i.identityRepository.GetUnprocessedIssuersIDs(ctx, i.storage.Pgx)
 }
@@ -889,6 +1139,36 @@ func (i *identity) HasUnprocessedStatesByID(ctx context.Context, identifier w3c.
 	return i.identityRepository.HasUnprocessedStatesByID(ctx, i.storage.Pgx, &identifier)
 }
 
+// GetPendingState returns the credentials and revocations that will be included in the next state transition
+func (i *identity) GetPendingState(ctx context.Context, identifier w3c.DID) (*domain.PendingState, error) {
+	credentials, err := i.claimsRepository.GetAllByState(ctx, i.storage.Pgx, &identifier, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	revocations, err := i.revocationRepository.GetPending(ctx, i.storage.Pgx, &identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &domain.PendingState{
+		Credentials: credentials,
+		Revocations: make([]domain.PendingRevocation, len(revocations)),
+	}
+	for j, rev := range revocations {
+		pending.Revocations[j] = domain.PendingRevocation{Revocation: *rev}
+		claims, err := i.claimsRepository.GetByRevocationNonce(ctx, i.storage.Pgx, &identifier, rev.Nonce)
+		if err != nil && !errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, err
+		}
+		if len(claims) > 0 {
+			pending.Revocations[j].Credential = claims[0]
+		}
+	}
+
+	return pending, nil
+}
+
 func (i *identity) HasUnprocessedAndFailedStatesByID(ctx context.Context, identifier w3c.DID) (bool, error) {
 	return i.identityRepository.HasUnprocessedAndFailedStatesByID(ctx, i.storage.Pgx, &identifier)
 }
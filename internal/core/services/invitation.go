@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrInvitationWithoutMessages an invitation needs at least one message to attach
+var ErrInvitationWithoutMessages = errors.New("the invitation needs at least one message")
+
+type invitation struct {
+	qrService ports.QrStoreService
+}
+
+// NewInvitation returns a new out-of-band invitation service
+func NewInvitation(qrService ports.QrStoreService) ports.InvitationService {
+	return &invitation{qrService: qrService}
+}
+
+// Create returns an out-of-band invitation attaching the messages of the given qr codes, in order.
+// The invitation is stored in the qr store too, so it can also be fetched from its short url.
+func (i *invitation) Create(ctx context.Context, issuerDID w3c.DID, hostURL string, goalCode string, goal string, qrIDs ...uuid.UUID) (*ports.CreateInvitationResponse, error) {
+	if len(qrIDs) == 0 {
+		return nil, ErrInvitationWithoutMessages
+	}
+
+	messages := make([][]byte, len(qrIDs))
+	for j, id := range qrIDs {
+		raw, err := i.qrService.Find(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		messages[j] = raw
+	}
+
+	oob := domain.NewOutOfBandInvitation(issuerDID.String(), goalCode, goal, messages...)
+	raw, err := json.Marshal(oob)
+	if err != nil {
+		log.Error(ctx, "marshalling the invitation", "err", err)
+		return nil, err
+	}
+	id, err := i.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+	if err != nil {
+		return nil, err
+	}
+	url, err := oob.URL(hostURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.CreateInvitationResponse{
+		Invitation: oob,
+		URL:        url,
+		QrID:       id,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// PolicyRequesterQueue is the requester role of the credentials issued from the commands of the issuance queue
+const PolicyRequesterQueue = "queue"
+
+// issuanceQueueRetryDelay is the time the consumer waits before reading the queue again after it fails
+const issuanceQueueRetryDelay = 5 * time.Second
+
+type issuanceConsumer struct {
+	queue                ports.IssuanceQueue
+	claimsService        ports.ClaimsService
+	issuerDID            w3c.DID
+	credentialStatusType verifiable.CredentialStatusType
+}
+
+// NewIssuanceConsumer returns the service that issues the credentials of the commands of the issuance queue
+func NewIssuanceConsumer(queue ports.IssuanceQueue, claimsService ports.ClaimsService, issuerDID w3c.DID, credentialStatusType verifiable.CredentialStatusType) ports.IssuanceConsumerService {
+	return &issuanceConsumer{
+		queue:                queue,
+		claimsService:        claimsService,
+		issuerDID:            issuerDID,
+		credentialStatusType: credentialStatusType,
+	}
+}
+
+// Run issues the credentials of the commands of the queue until the context is cancelled. A command is acknowledged
+// once its result is written to the reply queue, so a command whose result could not be written is processed again.
+func (s *issuanceConsumer) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		messages, err := s.queue.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error(ctx, "reading the issuance queue", "err", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(issuanceQueueRetryDelay):
+			}
+			continue
+		}
+		for _, message := range messages {
+			result := s.Issue(ctx, message.Body)
+			if err := s.queue.Reply(ctx, result); err != nil {
+				log.Error(ctx, "writing the issuance result", "err", err, "message", message.ID, "command", result.CommandID)
+				continue
+			}
+			if err := s.queue.Ack(ctx, message.ID); err != nil {
+				log.Error(ctx, "acknowledging the issuance command", "err", err, "message", message.ID, "command", result.CommandID)
+			}
+		}
+	}
+}
+
+// Issue validates the command of the body and issues its credential. The queue delivers the commands at least once, so
+// a command whose credential was already issued is answered with it. The failures are returned in the result.
+func (s *issuanceConsumer) Issue(ctx context.Context, body []byte) domain.IssuanceCommandResult {
+	var command domain.IssuanceCommand
+	if err := json.Unmarshal(body, &command); err != nil {
+		log.Warn(ctx, "malformed issuance command", "err", err)
+		return issuanceFailure(command.ID, "malformed command: "+err.Error())
+	}
+	if err := command.Validate(); err != nil {
+		log.Warn(ctx, "invalid issuance command", "err", err, "command", command.ID)
+		return issuanceFailure(command.ID, err.Error())
+	}
+
+	externalID := command.CredentialExternalID()
+	issued, _, err := s.claimsService.GetAll(ctx, s.issuerDID, &ports.ClaimsFilter{ExternalID: externalID, MaxResults: 1, Page: common.ToPointer(uint(1))})
+	if err != nil && !errors.Is(err, ErrClaimNotFound) {
+		log.Error(ctx, "looking for the credential of the issuance command", "err", err, "command", command.ID)
+		return issuanceFailure(command.ID, "cannot check if the command was already processed: "+err.Error())
+	}
+	if len(issued) > 0 {
+		log.Info(ctx, "issuance command already processed", "command", command.ID, "credential", issued[0].ID)
+		return issuanceSuccess(command.ID, issued[0].ID)
+	}
+
+	proofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      command.WithSignatureProof(),
+		Iden3SparseMerkleTreeProof: command.WithMTProof(),
+	}
+	req := ports.NewCreateClaimRequest(&s.issuerDID, command.CredentialSchema, command.CredentialSubject, command.Expiration, command.Type, nil, nil, nil, proofs, nil, true, s.credentialStatusType, nil, nil, nil)
+	req.ExternalID = &externalID
+	req.RequesterRole = PolicyRequesterQueue
+	credential, err := s.claimsService.Save(ctx, req)
+	if err != nil {
+		log.Warn(ctx, "issuing the credential of the issuance command", "err", err, "command", command.ID)
+		return issuanceFailure(command.ID, err.Error())
+	}
+	log.Info(ctx, "audit: credential issued from the issuance queue", "command", command.ID, "credential", credential.ID)
+	return issuanceSuccess(command.ID, credential.ID)
+}
+
+func issuanceSuccess(commandID string, credentialID uuid.UUID) domain.IssuanceCommandResult {
+	return domain.IssuanceCommandResult{
+		CommandID:    commandID,
+		Status:       domain.IssuanceCommandIssued,
+		CredentialID: &credentialID,
+		ProcessedAt:  time.Now().UTC(),
+	}
+}
+
+func issuanceFailure(commandID string, reason string) domain.IssuanceCommandResult {
+	return domain.IssuanceCommandResult{
+		CommandID:   commandID,
+		Status:      domain.IssuanceCommandFailed,
+		Error:       reason,
+		ProcessedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,171 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+type issuanceClaimsServiceMock struct {
+	ports.ClaimsService
+	requests []*ports.CreateClaimRequest
+	claims   []*domain.Claim
+	err      error
+}
+
+func (m *issuanceClaimsServiceMock) Save(_ context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	m.requests = append(m.requests, req)
+	if m.err != nil {
+		return nil, m.err
+	}
+	claim := &domain.Claim{ID: uuid.New(), ExternalID: req.ExternalID}
+	m.claims = append(m.claims, claim)
+	return claim, nil
+}
+
+func (m *issuanceClaimsServiceMock) GetAll(_ context.Context, _ w3c.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, uint, error) {
+	var claims []*domain.Claim
+	for _, claim := range m.claims {
+		if claim.ExternalID != nil && *claim.ExternalID == filter.ExternalID {
+			claims = append(claims, claim)
+		}
+	}
+	return claims, uint(len(claims)), nil
+}
+
+type issuanceQueueMock struct {
+	messages []domain.IssuanceMessage
+	results  []domain.IssuanceCommandResult
+	acked    []string
+	cancel   context.CancelFunc
+}
+
+func (q *issuanceQueueMock) Receive(_ context.Context) ([]domain.IssuanceMessage, error) {
+	if len(q.messages) == 0 {
+		q.cancel()
+		return nil, nil
+	}
+	messages := q.messages
+	q.messages = nil
+	return messages, nil
+}
+
+func (q *issuanceQueueMock) Reply(_ context.Context, result domain.IssuanceCommandResult) error {
+	q.results = append(q.results, result)
+	return nil
+}
+
+func (q *issuanceQueueMock) Ack(_ context.Context, messageID string) error {
+	q.acked = append(q.acked, messageID)
+	return nil
+}
+
+func TestIssuanceConsumer_Issue(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	claimsService := &issuanceClaimsServiceMock{}
+	consumer := services.NewIssuanceConsumer(nil, claimsService, *issuerDID, verifiable.Iden3commRevocationStatusV1)
+
+	result := consumer.Issue(ctx, []byte(`{"id":"order-1","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"id":"did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi","birthday":19960424},"signatureProof":true,"externalId":"crm-42"}`))
+	assert.Equal(t, "order-1", result.CommandID)
+	assert.Equal(t, domain.IssuanceCommandIssued, result.Status)
+	assert.NotNil(t, result.CredentialID)
+	require.Len(t, claimsService.requests, 1)
+	req := claimsService.requests[0]
+	assert.True(t, req.SignatureProof)
+	assert.False(t, req.MTProof)
+	assert.Equal(t, "crm-42", *req.ExternalID)
+	assert.Equal(t, services.PolicyRequesterQueue, req.RequesterRole)
+
+	for _, tc := range []struct {
+		name      string
+		body      string
+		commandID string
+	}{
+		{name: "malformed json", body: `{"id":`},
+		{name: "no id", body: `{"credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true}`},
+		{name: "no schema", body: `{"id":"order-2","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true}`, commandID: "order-2"},
+		{name: "no subject", body: `{"id":"order-3","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","mtProof":true}`, commandID: "order-3"},
+		{name: "no proof", body: `{"id":"order-4","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":false}`, commandID: "order-4"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := consumer.Issue(ctx, []byte(tc.body))
+			assert.Equal(t, domain.IssuanceCommandFailed, result.Status)
+			assert.Equal(t, tc.commandID, result.CommandID)
+			assert.NotEmpty(t, result.Error)
+			assert.Nil(t, result.CredentialID)
+		})
+	}
+	assert.Len(t, claimsService.requests, 1)
+
+	claimsService.err = errors.New("schema not found")
+	result = consumer.Issue(ctx, []byte(`{"id":"order-5","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true}`))
+	assert.Equal(t, domain.IssuanceCommandFailed, result.Status)
+	assert.Equal(t, "schema not found", result.Error)
+}
+
+func TestIssuanceConsumer_Run(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	queue := &issuanceQueueMock{
+		messages: []domain.IssuanceMessage{
+			{ID: "1-0", Body: []byte(`{"id":"order-1","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true}`)},
+			{ID: "2-0", Body: []byte(`not a command`)},
+		},
+		cancel: cancel,
+	}
+	consumer := services.NewIssuanceConsumer(queue, &issuanceClaimsServiceMock{}, *issuerDID, verifiable.Iden3commRevocationStatusV1)
+
+	consumer.Run(ctx)
+	require.Len(t, queue.results, 2)
+	assert.Equal(t, domain.IssuanceCommandIssued, queue.results[0].Status)
+	assert.Equal(t, domain.IssuanceCommandFailed, queue.results[1].Status)
+	assert.Equal(t, []string{"1-0", "2-0"}, queue.acked)
+}
+
+func TestIssuanceConsumer_Redelivery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	command := []byte(`{"id":"order-1","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true}`)
+	withExternalID := []byte(`{"id":"order-2","credentialSchema":"https://example.com/kyc.json","type":"KYCAgeCredential","credentialSubject":{"birthday":1},"mtProof":true,"externalId":"crm-42"}`)
+	// the commands are delivered again, e.g. when the node stopped before acknowledging them
+	queue := &issuanceQueueMock{
+		messages: []domain.IssuanceMessage{
+			{ID: "1-0", Body: command},
+			{ID: "2-0", Body: withExternalID},
+			{ID: "1-0", Body: command},
+			{ID: "2-0", Body: withExternalID},
+		},
+		cancel: cancel,
+	}
+	claimsService := &issuanceClaimsServiceMock{}
+	consumer := services.NewIssuanceConsumer(queue, claimsService, *issuerDID, verifiable.Iden3commRevocationStatusV1)
+
+	consumer.Run(ctx)
+	require.Len(t, claimsService.requests, 2)
+	assert.Equal(t, "order-1", *claimsService.requests[0].ExternalID)
+	assert.Equal(t, "crm-42", *claimsService.requests[1].ExternalID)
+	require.Len(t, queue.results, 4)
+	for i, result := range queue.results {
+		assert.Equal(t, domain.IssuanceCommandIssued, result.Status)
+		require.NotNil(t, result.CredentialID)
+		assert.Equal(t, claimsService.claims[i%2].ID, *result.CredentialID)
+	}
+	assert.Equal(t, []string{"1-0", "2-0", "1-0", "2-0"}, queue.acked)
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// maxIssuerProfileNameLength is the max length of the issuer profile names
+const maxIssuerProfileNameLength = 128
+
+var (
+	ErrIssuerProfileNotFound         = errors.New("issuer profile not found")                                                     // ErrIssuerProfileNotFound the profile does not exist
+	ErrIssuerProfileInvalidName      = fmt.Errorf("issuer profile name must have 1 to %d characters", maxIssuerProfileNameLength) // ErrIssuerProfileInvalidName the profile name is empty or too long
+	ErrIssuerProfileInvalidLogo      = errors.New("issuer profile logo must be a valid url")                                      // ErrIssuerProfileInvalidLogo the logo is not an url
+	ErrIssuerProfileInvalidProofType = errors.New("unsupported issuer profile proof type")                                        // ErrIssuerProfileInvalidProofType the key usage policy has an unknown proof
+	ErrIssuerProfileProofNotAllowed  = errors.New("the key usage policy of the issuer profile does not allow the proof")          // ErrIssuerProfileProofNotAllowed the credential asks for a proof the profile cannot use
+)
+
+// issuerProfileNonceBits is the size of the random nonces the profile DIDs are derived with
+const issuerProfileNonceBits = 128
+
+type issuerProfile struct {
+	repo    ports.IssuerProfileRepository
+	storage *db.Storage
+}
+
+// NewIssuerProfile returns a new issuer profile service
+func NewIssuerProfile(repo ports.IssuerProfileRepository, storage *db.Storage) ports.IssuerProfileService {
+	return &issuerProfile{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Create validates and stores a new issuer profile. The profile DID is derived from the issuer DID with a random nonce.
+func (s *issuerProfile) Create(ctx context.Context, issuerDID w3c.DID, req ports.CreateIssuerProfileRequest) (*domain.IssuerProfile, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxIssuerProfileNameLength {
+		return nil, ErrIssuerProfileInvalidName
+	}
+	if req.LogoURL != nil {
+		if _, err := url.ParseRequestURI(*req.LogoURL); err != nil {
+			return nil, ErrIssuerProfileInvalidLogo
+		}
+	}
+	allowedProofs, err := issuerProfileProofs(req.AllowedProofs)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), issuerProfileNonceBits))
+	if err != nil {
+		log.Error(ctx, "creating the issuer profile nonce", "err", err)
+		return nil, err
+	}
+	did, err := domain.ProfileDID(issuerDID, nonce)
+	if err != nil {
+		log.Error(ctx, "deriving the issuer profile did", "err", err, "issuer", issuerDID)
+		return nil, err
+	}
+
+	profile := &domain.IssuerProfile{
+		ID:            uuid.New(),
+		IssuerDID:     issuerDID,
+		Nonce:         nonce,
+		DID:           *did,
+		Name:          name,
+		Description:   req.Description,
+		LogoURL:       req.LogoURL,
+		AllowedProofs: allowedProofs,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// GetByID returns the issuer profile with the given id
+func (s *issuerProfile) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.IssuerProfile, error) {
+	profile, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrIssuerProfileDoesNotExist) {
+		return nil, ErrIssuerProfileNotFound
+	}
+	return profile, err
+}
+
+// GetAll returns all the profiles of the issuer
+func (s *issuerProfile) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.IssuerProfile, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
+
+// Delete removes an issuer profile that has not issued credentials
+func (s *issuerProfile) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	err := s.repo.Delete(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrIssuerProfileDoesNotExist) {
+		return ErrIssuerProfileNotFound
+	}
+	return err
+}
+
+// Authorize returns the profile if its key usage policy allows issuing a credential with the requested proofs
+func (s *issuerProfile) Authorize(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, proofs ports.ClaimRequestProofs) (*domain.IssuerProfile, error) {
+	profile, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if proofs.BJJSignatureProof2021 && !profile.Allows(verifiable.BJJSignatureProofType) {
+		return nil, fmt.Errorf("%w: %s", ErrIssuerProfileProofNotAllowed, verifiable.BJJSignatureProofType)
+	}
+	if proofs.Iden3SparseMerkleTreeProof && !profile.Allows(verifiable.Iden3SparseMerkleTreeProofType) {
+		return nil, fmt.Errorf("%w: %s", ErrIssuerProfileProofNotAllowed, verifiable.Iden3SparseMerkleTreeProofType)
+	}
+	return profile, nil
+}
+
+// issuerProfileProofs returns the proofs of the key usage policy. Profiles can use all the proofs by default.
+func issuerProfileProofs(proofs []string) ([]verifiable.ProofType, error) {
+	if len(proofs) == 0 {
+		return []verifiable.ProofType{verifiable.BJJSignatureProofType, verifiable.Iden3SparseMerkleTreeProofType}, nil
+	}
+	allowed := make([]verifiable.ProofType, 0, len(proofs))
+	seen := make(map[verifiable.ProofType]bool, len(proofs))
+	for _, proof := range proofs {
+		p := verifiable.ProofType(proof)
+		if p != verifiable.BJJSignatureProofType && p != verifiable.Iden3SparseMerkleTreeProofType {
+			return nil, fmt.Errorf("%w: %s", ErrIssuerProfileInvalidProofType, proof)
+		}
+		if !seen[p] {
+			seen[p] = true
+			allowed = append(allowed, p)
+		}
+	}
+	return allowed, nil
+}
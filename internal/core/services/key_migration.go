@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/jackc/pgtype"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	ErrKeyMigrationNotFound         = errors.New("key migration not found")                                  // ErrKeyMigrationNotFound the key migration does not exist
+	ErrKeyMigrationNoCustody        = errors.New("no hardware-backed custody configured for the keys")       // ErrKeyMigrationNoCustody the custody key provider is not configured
+	ErrKeyMigrationAlreadyInCustody = errors.New("the issuer key is already in the hardware-backed custody") // ErrKeyMigrationAlreadyInCustody the current auth key is a custody key
+	ErrKeyMigrationInProgress       = errors.New("the issuer has a key migration in progress already")       // ErrKeyMigrationInProgress other migration of the issuer is not completed or failed
+	ErrKeyMigrationCompleted        = errors.New("the key migration is completed")                           // ErrKeyMigrationCompleted the migration can not be resumed
+)
+
+type keyMigration struct {
+	repo            ports.KeyMigrationRepository
+	identityService ports.IdentityService
+	claimsService   ports.ClaimsService
+	publisher       ports.Publisher
+	kms             kms.KMSType
+	storage         *db.Storage
+	hostURL         string
+}
+
+// NewKeyMigration returns a new service to migrate the issuer auth keys from vault to a hardware-backed custody. The
+// host url is the one the auth credential of the new key is served from.
+func NewKeyMigration(repo ports.KeyMigrationRepository, identityService ports.IdentityService, claimsService ports.ClaimsService, publisher ports.Publisher, keyStore kms.KMSType, storage *db.Storage, hostURL string) ports.KeyMigrationService {
+	return &keyMigration{
+		repo:            repo,
+		identityService: identityService,
+		claimsService:   claimsService,
+		publisher:       publisher,
+		kms:             keyStore,
+		storage:         storage,
+		hostURL:         hostURL,
+	}
+}
+
+// Start starts the migration of the current auth key of the issuer and runs its steps until the state with the new
+// auth claim has to be confirmed. The old auth claim is revoked in the last step only if revokeOldAuthClaim is true.
+func (s *keyMigration) Start(ctx context.Context, issuerDID w3c.DID, revokeOldAuthClaim bool) (*domain.KeyMigration, error) {
+	if !s.kms.HasCustody(kms.KeyTypeBabyJubJub) {
+		return nil, ErrKeyMigrationNoCustody
+	}
+	authClaim, err := s.claimsService.GetAuthClaim(ctx, &issuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("getting the auth claim: %w", err)
+	}
+	keyID, err := s.identityService.GetKeyIDFromAuthClaim(ctx, authClaim)
+	if err != nil {
+		return nil, fmt.Errorf("getting the key of the auth claim: %w", err)
+	}
+	if kms.IsCustodyKey(keyID) {
+		return nil, ErrKeyMigrationAlreadyInCustody
+	}
+
+	m := domain.NewKeyMigration(issuerDID, authClaim.ID, keyID.ID, revokeOldAuthClaim)
+	if err := s.repo.Save(ctx, s.storage.Pgx, m); err != nil {
+		if errors.Is(err, repositories.ErrKeyMigrationInProgress) {
+			return nil, ErrKeyMigrationInProgress
+		}
+		return nil, err
+	}
+	return s.run(ctx, m)
+}
+
+// Resume runs the steps of a waiting or failed migration from the current one
+func (s *keyMigration) Resume(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error) {
+	m, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if m.Completed() {
+		return nil, ErrKeyMigrationCompleted
+	}
+	m.Status = domain.KeyMigrationStatusRunning
+	if err := s.repo.Save(ctx, s.storage.Pgx, m); err != nil {
+		if errors.Is(err, repositories.ErrKeyMigrationInProgress) {
+			return nil, ErrKeyMigrationInProgress
+		}
+		return nil, err
+	}
+	return s.run(ctx, m)
+}
+
+// GetByID returns the key migration of the issuer
+func (s *keyMigration) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error) {
+	m, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrKeyMigrationDoesNotExist) {
+		return nil, ErrKeyMigrationNotFound
+	}
+	return m, err
+}
+
+// GetAll returns the key migrations of the issuer, the newest first
+func (s *keyMigration) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.KeyMigration, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
+
+// run runs the steps of the migration until it is completed, it fails or it has to wait for the state to be confirmed.
+// The progress is saved after every step, so a failed step is run again when the migration is resumed.
+func (s *keyMigration) run(ctx context.Context, m *domain.KeyMigration) (*domain.KeyMigration, error) {
+	for m.Status == domain.KeyMigrationStatusRunning {
+		step := m.Step
+		done, err := s.runStep(ctx, m)
+		switch {
+		case err != nil:
+			log.Error(ctx, "key migration step failed", "err", err, "id", m.ID, "step", step)
+			m.Fail(err)
+		case done:
+			log.Info(ctx, "audit: key migration step completed", "id", m.ID, "issuer", m.IssuerDID.String(), "step", step)
+			m.Next()
+		default:
+			m.Wait()
+		}
+		if err := s.repo.Save(ctx, s.storage.Pgx, m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// runStep runs the current step, it returns false if the step has to wait
+func (s *keyMigration) runStep(ctx context.Context, m *domain.KeyMigration) (bool, error) {
+	switch m.Step {
+	case domain.KeyMigrationStepGenerateKey:
+		return true, s.generateKey(m)
+	case domain.KeyMigrationStepAddAuthClaim:
+		return true, s.addAuthClaim(ctx, m)
+	case domain.KeyMigrationStepPublishState:
+		return s.publishState(ctx, m)
+	case domain.KeyMigrationStepVerifyIssuance:
+		return true, s.verifyIssuance(ctx, m)
+	case domain.KeyMigrationStepRetireOldKey:
+		return true, s.retireOldKey(ctx, m)
+	default:
+		return false, fmt.Errorf("unknown key migration step %q", m.Step)
+	}
+}
+
+func (s *keyMigration) generateKey(m *domain.KeyMigration) error {
+	if m.NewKeyID != nil {
+		return nil
+	}
+	keyID, err := s.kms.CreateCustodyKey(kms.KeyTypeBabyJubJub, &m.IssuerDID)
+	if err != nil {
+		return fmt.Errorf("creating the key in the custody: %w", err)
+	}
+	m.NewKeyID = &keyID.ID
+	return nil
+}
+
+func (s *keyMigration) addAuthClaim(ctx context.Context, m *domain.KeyMigration) error {
+	if m.NewAuthClaimID != nil {
+		return nil
+	}
+	oldAuthClaim, err := s.claimsService.GetByID(ctx, &m.IssuerDID, m.OldAuthClaimID)
+	if err != nil {
+		return fmt.Errorf("getting the old auth claim: %w", err)
+	}
+	status, err := oldAuthClaim.GetCredentialStatus()
+	if err != nil {
+		return fmt.Errorf("getting the credential status of the old auth claim: %w", err)
+	}
+	authClaim, err := s.identityService.AddAuthKey(ctx, m.IssuerDID, kms.KeyID{Type: kms.KeyTypeBabyJubJub, ID: *m.NewKeyID}, s.hostURL, status.Type)
+	if err != nil {
+		return fmt.Errorf("adding the auth claim of the new key: %w", err)
+	}
+	m.NewAuthClaimID = &authClaim.ID
+	return nil
+}
+
+// publishState publishes the state with the new auth claim, signed with the old key, and waits for its confirmation
+func (s *keyMigration) publishState(ctx context.Context, m *domain.KeyMigration) (bool, error) {
+	authClaim, err := s.claimsService.GetByID(ctx, &m.IssuerDID, *m.NewAuthClaimID)
+	if err != nil {
+		return false, fmt.Errorf("getting the new auth claim: %w", err)
+	}
+	if authClaim.MTPProof.Status == pgtype.Present {
+		return true, nil
+	}
+	if authClaim.IdentityState == nil {
+		// the publisher may be publishing other state already, the new auth claim goes in the next one
+		if _, err := s.publisher.PublishState(ctx, &m.IssuerDID); err != nil {
+			log.Warn(ctx, "publishing the state with the new auth claim", "err", err, "id", m.ID)
+		}
+	}
+	return false, nil
+}
+
+// verifyIssuance checks the new auth claim is the one used to issue and that the claims signed with it are valid
+func (s *keyMigration) verifyIssuance(ctx context.Context, m *domain.KeyMigration) error {
+	authClaim, err := s.claimsService.GetAuthClaim(ctx, &m.IssuerDID)
+	if err != nil {
+		return fmt.Errorf("getting the auth claim: %w", err)
+	}
+	if authClaim.ID != *m.NewAuthClaimID {
+		return errors.New("the credentials are still signed with the old auth claim")
+	}
+
+	nonce, err := common.RandInt64()
+	if err != nil {
+		return err
+	}
+	entry, err := core.NewClaim(core.SchemaHash{}, core.WithIndexDataInts(new(big.Int).SetUint64(nonce), nil))
+	if err != nil {
+		return err
+	}
+	proof, err := s.identityService.SignClaimEntry(kms.WithSigningOperation(ctx, kms.SigningOperationKeyRotation, ""), authClaim, entry)
+	if err != nil {
+		return fmt.Errorf("signing with the new key: %w", err)
+	}
+
+	sigBytes, err := hex.DecodeString(proof.Signature)
+	if err != nil {
+		return err
+	}
+	var sigComp babyjub.SignatureComp
+	if len(sigBytes) != len(sigComp) {
+		return errors.New("invalid signature length")
+	}
+	copy(sigComp[:], sigBytes)
+	sig, err := sigComp.Decompress()
+	if err != nil {
+		return fmt.Errorf("decompressing the signature: %w", err)
+	}
+	hi, hv, err := entry.HiHv()
+	if err != nil {
+		return err
+	}
+	message, err := poseidon.Hash([]*big.Int{hi, hv})
+	if err != nil {
+		return err
+	}
+	slots := authClaim.CoreClaim.Get().RawSlotsAsInts()
+	pubKey := babyjub.PublicKey{X: slots[2], Y: slots[3]}
+	if !pubKey.VerifyPoseidon(message, sig) {
+		return errors.New("the signature of the new key is not valid")
+	}
+	return nil
+}
+
+// retireOldKey revokes the old auth claim, if requested, and retires its key from vault
+func (s *keyMigration) retireOldKey(ctx context.Context, m *domain.KeyMigration) error {
+	if m.RevokeOldAuthClaim {
+		oldAuthClaim, err := s.claimsService.GetByID(ctx, &m.IssuerDID, m.OldAuthClaimID)
+		if err != nil {
+			return fmt.Errorf("getting the old auth claim: %w", err)
+		}
+		if !oldAuthClaim.Revoked {
+			if err := s.claimsService.Revoke(ctx, m.IssuerDID, uint64(oldAuthClaim.RevNonce), "auth key migrated to custody"); err != nil {
+				return fmt.Errorf("revoking the old auth claim: %w", err)
+			}
+		}
+	}
+
+	oldKeyID := kms.KeyID{Type: kms.KeyTypeBabyJubJub, ID: m.OldKeyID}
+	keyIDs, err := s.kms.KeysByIdentity(ctx, m.IssuerDID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(keyIDs, oldKeyID) {
+		return nil
+	}
+	if err := s.kms.RetireKey(ctx, oldKeyID); err != nil {
+		return fmt.Errorf("retiring the old key: %w", err)
+	}
+	return nil
+}
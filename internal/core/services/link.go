@@ -5,9 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"net"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
@@ -40,71 +45,188 @@ var (
 	ErrLinkInactive = errors.New("cannot issue a credential for an inactive link")
 	// ErrClaimAlreadyIssued - claim already issued
 	ErrClaimAlreadyIssued = errors.New("the claim was already issued for the user")
+	// ErrLinkInvalidPayment - the link payment configuration is not valid
+	ErrLinkInvalidPayment = errors.New("invalid link payment")
+	// ErrPaymentNotFound - the paid payment was not requested in the link session
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrPaymentWrongSender - the payment message was not sent by the holder the payment was requested to
+	ErrPaymentWrongSender = errors.New("payment message sent by an unexpected holder")
+	// ErrLinkInvalidPrerequisite - a prerequisite credential of the link is not valid
+	ErrLinkInvalidPrerequisite = errors.New("invalid link prerequisite")
+	// ErrLinkPrerequisiteNotProved - the holder did not send the proof of a prerequisite credential of the link
+	ErrLinkPrerequisiteNotProved = errors.New("the holder did not prove a prerequisite credential of the link")
+	// ErrLinkInvalidRestrictions - the ip ranges or the countries of the link restrictions are not valid
+	ErrLinkInvalidRestrictions = errors.New("invalid link restrictions")
+	// ErrLinkRestricted - the link can not be claimed from the network or the country of the client
+	ErrLinkRestricted = errors.New("the link can not be claimed from this location")
+	// ErrLinkInvalidThrottle - the claims per hour or the time windows of the link throttle are not valid
+	ErrLinkInvalidThrottle = errors.New("invalid link throttle")
+	// ErrLinkThrottled - the link reached its claims per hour or is out of its time windows
+	ErrLinkThrottled = errors.New("the link is busy right now, please try again later")
+	// ErrLinkBatchInvalidSize - the number of links requested in a batch is out of bounds
+	ErrLinkBatchInvalidSize = fmt.Errorf("the number of links must be between 1 and %d", maxLinkBatchSize)
+	// ErrLinkBatchExternalIDs - the external ids of a batch don't match the number of links
+	ErrLinkBatchExternalIDs = errors.New("one external id per link is required")
+	// ErrLinkInvalidResultWebhook - the url or the secret of the link result webhook are not valid
+	ErrLinkInvalidResultWebhook = errors.New("invalid link result webhook")
 )
 
+const (
+	maxLinkBatchSize                 = 1000 // maxLinkBatchSize is the max number of links created in a single batch
+	minLinkResultWebhookSecretLength = 16   // minLinkResultWebhookSecretLength is the min length of the secret that signs the link results
+)
+
+// LinkThrottledError is returned when a throttled link can not be claimed until RetryAt. It wraps ErrLinkThrottled.
+type LinkThrottledError struct {
+	RetryAt time.Time
+}
+
+func (e *LinkThrottledError) Error() string {
+	return ErrLinkThrottled.Error()
+}
+
+// Unwrap returns ErrLinkThrottled
+func (e *LinkThrottledError) Unwrap() error {
+	return ErrLinkThrottled
+}
+
+// RetryAfter returns the time left until the link can be claimed again, rounded up to seconds
+func (e *LinkThrottledError) RetryAfter() time.Duration {
+	wait := time.Until(e.RetryAt)
+	if wait < time.Second {
+		return time.Second
+	}
+	return (wait + time.Second - 1).Truncate(time.Second)
+}
+
 // Link - represents a link in the issuer node
 type Link struct {
-	storage          *db.Storage
-	claimsService    ports.ClaimsService
-	qrService        ports.QrStoreService
-	claimRepository  ports.ClaimsRepository
-	linkRepository   ports.LinkRepository
-	schemaRepository ports.SchemaRepository
-	loader           loader.DocumentLoader
-	sessionManager   ports.SessionRepository
-	publisher        pubsub.Publisher
-	ipfsGateway      string
+	storage                        *db.Storage
+	claimsService                  ports.ClaimsService
+	qrService                      ports.QrStoreService
+	claimRepository                ports.ClaimsRepository
+	linkRepository                 ports.LinkRepository
+	schemaRepository               ports.SchemaRepository
+	loader                         loader.DocumentLoader
+	sessionManager                 ports.SessionRepository
+	publisher                      pubsub.Publisher
+	ipfsGateway                    string
+	presentationTemplateRepository ports.PresentationTemplateRepository
+	paymentRepository              ports.PaymentRepository
+	paymentVerifier                ports.PaymentVerifier
+	translationService             ports.TranslationService
+	prerequisiteProofRepository    ports.LinkPrerequisiteProofRepository
+	linkRecipientRepository        ports.LinkRecipientRepository
+	linkHolderRepository           ports.LinkHolderRepository
+	geoIP                          ports.GeoIPProvider
+	resultWebhook                  ports.LinkResultWebhook
+	credentialDependencyRepository ports.CredentialDependencyRepository
 }
 
 // NewLinkService - constructor
-func NewLinkService(storage *db.Storage, claimsService ports.ClaimsService, qrService ports.QrStoreService, claimRepository ports.ClaimsRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, ipfsGatewayURL string) ports.LinkService {
+func NewLinkService(storage *db.Storage, claimsService ports.ClaimsService, qrService ports.QrStoreService, claimRepository ports.ClaimsRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, ipfsGatewayURL string, presentationTemplateRepository ports.PresentationTemplateRepository, paymentRepository ports.PaymentRepository, paymentVerifier ports.PaymentVerifier, translationService ports.TranslationService, prerequisiteProofRepository ports.LinkPrerequisiteProofRepository, linkRecipientRepository ports.LinkRecipientRepository, linkHolderRepository ports.LinkHolderRepository, geoIP ports.GeoIPProvider, resultWebhook ports.LinkResultWebhook, credentialDependencyRepository ports.CredentialDependencyRepository) ports.LinkService {
 	return &Link{
-		storage:          storage,
-		claimsService:    claimsService,
-		qrService:        qrService,
-		claimRepository:  claimRepository,
-		linkRepository:   linkRepository,
-		schemaRepository: schemaRepository,
-		loader:           ld,
-		sessionManager:   sessionManager,
-		publisher:        publisher,
-		ipfsGateway:      ipfsGatewayURL,
+		storage:                        storage,
+		claimsService:                  claimsService,
+		qrService:                      qrService,
+		claimRepository:                claimRepository,
+		linkRepository:                 linkRepository,
+		schemaRepository:               schemaRepository,
+		loader:                         ld,
+		sessionManager:                 sessionManager,
+		publisher:                      publisher,
+		ipfsGateway:                    ipfsGatewayURL,
+		presentationTemplateRepository: presentationTemplateRepository,
+		paymentRepository:              paymentRepository,
+		paymentVerifier:                paymentVerifier,
+		translationService:             translationService,
+		prerequisiteProofRepository:    prerequisiteProofRepository,
+		linkRecipientRepository:        linkRecipientRepository,
+		linkHolderRepository:           linkHolderRepository,
+		geoIP:                          geoIP,
+		resultWebhook:                  resultWebhook,
+		credentialDependencyRepository: credentialDependencyRepository,
 	}
 }
 
 // Save - save a new credential
-func (ls *Link) Save(
-	ctx context.Context,
-	did w3c.DID,
-	maxIssuance *int,
-	validUntil *time.Time,
-	schemaID uuid.UUID,
-	credentialExpiration *time.Time,
-	credentialSignatureProof bool,
-	credentialMTPProof bool,
-	credentialSubject domain.CredentialSubject,
-	refreshService *verifiable.RefreshService,
-	displayMethod *verifiable.DisplayMethod,
-) (*domain.Link, error) {
-	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, schemaID)
+func (ls *Link) Save(ctx context.Context, did w3c.DID, req ports.CreateLinkRequest) (*domain.Link, error) {
+	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, req.SchemaID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := ls.validateCredentialSubjectAgainstSchema(ctx, credentialSubject, schemaDB); err != nil {
+	if err := ls.validateCredentialSubjectAgainstSchema(ctx, req.CredentialAttributes, schemaDB); err != nil {
 		log.Error(ctx, "validating credential subject", "err", err)
 		return nil, ErrParseClaim
 	}
-	if err = ls.validateRefreshService(refreshService, credentialExpiration); err != nil {
+	if err = ls.validateRefreshService(req.RefreshService, req.CredentialExpiration); err != nil {
 		log.Error(ctx, "validating refresh service", "err", err)
 		return nil, err
 	}
-	if err = ls.validateDisplayMethod(displayMethod); err != nil {
+	if err = ls.validateDisplayMethod(req.DisplayMethod); err != nil {
 		log.Error(ctx, "validating display method", "err", err)
 		return nil, err
 	}
+	if req.ExternalID != nil && len(*req.ExternalID) > maxExternalIDLength {
+		return nil, ErrExternalIDTooLong
+	}
+	if req.PresentationTemplate != nil {
+		if _, err := ls.getPresentationTemplate(ctx, did, *req.PresentationTemplate); err != nil {
+			return nil, err
+		}
+	}
+	if err = ls.validatePayment(req.Payment); err != nil {
+		log.Error(ctx, "validating link payment", "err", err)
+		return nil, err
+	}
+	linkPrerequisites, err := toLinkPrerequisites(req.Prerequisites)
+	if err != nil {
+		log.Error(ctx, "validating link prerequisites", "err", err)
+		return nil, err
+	}
+	if err = ls.validateRestrictions(req.Restrictions); err != nil {
+		log.Error(ctx, "validating link restrictions", "err", err)
+		return nil, err
+	}
+	if err = validateLinkResultWebhook(req.ResultWebhook); err != nil {
+		return nil, err
+	}
+	if req.Throttle != nil {
+		if err := req.Throttle.Normalize(); err != nil {
+			log.Error(ctx, "validating link throttle", "err", err)
+			return nil, fmt.Errorf("%w: %s", ErrLinkInvalidThrottle, err)
+		}
+	}
+	if req.CredentialExtensions != nil {
+		if err := validateCredentialExtensions(ctx, ls.loader, schemaDB.URL, *req.CredentialExtensions, nil); err != nil {
+			return nil, err
+		}
+	}
+	if req.Locale != nil {
+		canonical, err := canonicalLocale(*req.Locale)
+		if err != nil {
+			return nil, err
+		}
+		req.Locale = &canonical
+	}
 
-	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject, refreshService, displayMethod)
+	link := domain.NewLink(did, req.MaxIssuance, req.ValidUntil, req.SchemaID, req.CredentialExpiration, req.CredentialSignatureProof, req.CredentialMTPProof, req.CredentialAttributes, req.RefreshService, req.DisplayMethod)
+	link.ExternalID = req.ExternalID
+	link.PresentationTemplate = req.PresentationTemplate
+	link.Payment = req.Payment
+	link.Prerequisites = linkPrerequisites
+	link.Locale = req.Locale
+	if !req.Restrictions.Empty() {
+		link.Restrictions = req.Restrictions
+	}
+	if !req.Throttle.Empty() {
+		link.Throttle = req.Throttle
+	}
+	if req.CredentialExtensions != nil && (len(req.CredentialExtensions.Evidence) > 0 || len(req.CredentialExtensions.TermsOfUse) > 0) {
+		link.CredentialExtensions = req.CredentialExtensions
+	}
+	link.ResultWebhook = req.ResultWebhook
 	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
 	if err != nil {
 		return nil, err
@@ -115,6 +237,67 @@ func (ls *Link) Save(
 	return link, nil
 }
 
+// Clone - creates a new link with the same configuration as an existing one
+func (ls *Link) Clone(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*domain.Link, error) {
+	link, err := ls.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := link.Clone()
+	if _, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// CreateBatch - creates count single use links, that issue one credential each, with the configuration of
+// an existing link. When externalIDs are given, one per link, each link gets one of them so the integrator can
+// match the links with its recipients. All the links are created or none of them.
+func (ls *Link) CreateBatch(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, count int, externalIDs []string) ([]domain.Link, error) {
+	if count < 1 || count > maxLinkBatchSize {
+		return nil, ErrLinkBatchInvalidSize
+	}
+	if len(externalIDs) > 0 && len(externalIDs) != count {
+		return nil, ErrLinkBatchExternalIDs
+	}
+	for _, externalID := range externalIDs {
+		if len(externalID) > maxExternalIDLength {
+			return nil, ErrExternalIDTooLong
+		}
+	}
+
+	template, err := ls.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]domain.Link, count)
+	for i := range links {
+		link := template.Clone()
+		maxIssuance := 1
+		link.MaxIssuance = &maxIssuance
+		if len(externalIDs) > 0 {
+			externalID := externalIDs[i]
+			link.ExternalID = &externalID
+		}
+		links[i] = *link
+	}
+
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for i := range links {
+			if _, err := ls.linkRepository.Save(ctx, tx, &links[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
 // Activate - activates or deactivates a credential link
 func (ls *Link) Activate(ctx context.Context, issuerID w3c.DID, linkID uuid.UUID, active bool) error {
 	link, err := ls.linkRepository.GetByID(ctx, issuerID, linkID)
@@ -153,13 +336,43 @@ func (ls *Link) GetAll(ctx context.Context, issuerDID w3c.DID, status ports.Link
 	return ls.linkRepository.GetAll(ctx, issuerDID, status, query)
 }
 
+// GetCatalog returns the credentials offered through the active links of the issuer, with the presentation
+// template the holders must satisfy, if any
+func (ls *Link) GetCatalog(ctx context.Context, issuerDID w3c.DID) ([]domain.CatalogEntry, error) {
+	links, err := ls.linkRepository.GetAll(ctx, issuerDID, ports.LinkActive, nil)
+	if err != nil {
+		log.Error(ctx, "cannot fetch the active links", "err", err)
+		return nil, err
+	}
+
+	templates, err := ls.presentationTemplateRepository.GetAll(ctx, ls.storage.Pgx, issuerDID)
+	if err != nil {
+		log.Error(ctx, "cannot fetch the presentation templates", "err", err)
+		return nil, err
+	}
+	templatesByName := make(map[string]*domain.PresentationTemplate, len(templates))
+	for i := range templates {
+		templatesByName[templates[i].Name] = &templates[i]
+	}
+
+	catalog := make([]domain.CatalogEntry, len(links))
+	for i, link := range links {
+		catalog[i] = domain.CatalogEntry{Link: link}
+		if link.PresentationTemplate != nil {
+			catalog[i].PresentationTemplate = templatesByName[*link.PresentationTemplate]
+		}
+	}
+	return catalog, nil
+}
+
 // Delete - delete a link by id
 func (ls *Link) Delete(ctx context.Context, id uuid.UUID, did w3c.DID) error {
 	return ls.linkRepository.Delete(ctx, id, did)
 }
 
-// CreateQRCode - generates a qr code for a link
-func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string) (*ports.CreateQRCodeResponse, error) {
+// CreateQRCode - generates a qr code for a link. Links with recipients require the claim code of one of them
+// that has not claimed its credential yet.
+func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string, code string, clientIP net.IP) (*ports.CreateQRCodeResponse, error) {
 	link, err := ls.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		return nil, err
@@ -170,7 +383,34 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 		return nil, err
 	}
 
+	if err := ls.checkRestrictions(ctx, link, clientIP); err != nil {
+		return nil, err
+	}
+
+	if err := ls.checkThrottle(ctx, link); err != nil {
+		return nil, err
+	}
+
+	if _, err := ls.recipientByCode(ctx, linkID, code); err != nil {
+		return nil, err
+	}
+
+	scope := make([]protocol.ZeroKnowledgeProofRequest, 0)
+	if link.PresentationTemplate != nil {
+		template, err := ls.getPresentationTemplate(ctx, issuerDID, *link.PresentationTemplate)
+		if err != nil {
+			log.Error(ctx, "loading the link presentation template", "err", err, "template", *link.PresentationTemplate)
+			return nil, err
+		}
+		scope = template.ProofRequests()
+	}
+	scope = append(scope, link.PrerequisiteProofRequests()...)
+
 	sessionID := uuid.New().String()
+	callbackURL := fmt.Sprintf("%s/v1/credentials/links/callback?sessionID=%s&linkID=%s", serverURL, sessionID, linkID.String())
+	if code != "" {
+		callbackURL += "&" + linkRecipientCodeColumn + "=" + url.QueryEscape(code)
+	}
 	reqID := uuid.New().String()
 	qrCode := &protocol.AuthorizationRequestMessage{
 		From:     issuerDID.String(),
@@ -179,9 +419,9 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 		Typ:      packers.MediaTypePlainMessage,
 		Type:     protocol.AuthorizationRequestMessageType,
 		Body: protocol.AuthorizationRequestMessageBody{
-			CallbackURL: fmt.Sprintf("%s/v1/credentials/links/callback?sessionID=%s&linkID=%s", serverURL, sessionID, linkID.String()),
+			CallbackURL: callbackURL,
 			Reason:      authReason,
-			Scope:       make([]protocol.ZeroKnowledgeProofRequest, 0),
+			Scope:       scope,
 		},
 	}
 
@@ -214,7 +454,7 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 }
 
 // IssueClaim - Create a new claim
-func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string, credentialStatusType verifiable.CredentialStatusType) error {
+func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string, credentialStatusType verifiable.CredentialStatusType, clientIP net.IP) error {
 	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the link", "err", err)
@@ -237,6 +477,62 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.
 		return err
 	}
 
+	if err := ls.checkRestrictions(ctx, link, clientIP); err != nil {
+		if errors.Is(err, ErrLinkRestricted) {
+			setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+			if setLinkError != nil {
+				log.Error(ctx, "cannot set the state", "err", setLinkError)
+				return setLinkError
+			}
+		}
+		return err
+	}
+
+	if err := ls.checkThrottle(ctx, link); err != nil {
+		if errors.Is(err, ErrLinkThrottled) {
+			setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+			if setLinkError != nil {
+				log.Error(ctx, "cannot set the state", "err", setLinkError)
+				return setLinkError
+			}
+		}
+		return err
+	}
+
+	recipient, err := ls.sessionRecipient(ctx, sessionID, linkID)
+	if err != nil {
+		log.Error(ctx, "cannot fetch the link recipient", "err", err)
+		if errors.Is(err, ErrLinkRecipientCodeInvalid) {
+			setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+			if setLinkError != nil {
+				log.Error(ctx, "cannot set the state", "err", setLinkError)
+				return setLinkError
+			}
+		}
+		return err
+	}
+	credentialSubject := link.CredentialSubject
+	var holder *domain.LinkHolder
+	if recipient != nil {
+		// every recipient gets its own credential, even if the holder already got one from the link
+		credentialSubject = recipient.CredentialSubject
+		issuedByUser = nil
+	} else {
+		// holders claiming with an ephemeral profile DID get one credential per genesis identity if they proved it
+		holder = ls.sessionHolder(ctx, sessionID, linkID, userDID)
+	}
+	if err := ls.checkHolder(ctx, holder, userDID); err != nil {
+		log.Warn(ctx, "the link was already claimed by the holder", "err", err, "link", linkID, "user", userDID)
+		if errors.Is(err, ErrLinkHolderAlreadyIssued) {
+			setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+			if setLinkError != nil {
+				log.Error(ctx, "cannot set the state", "err", setLinkError)
+				return setLinkError
+			}
+		}
+		return err
+	}
+
 	var credentialIssuedID uuid.UUID
 	var credentialIssued *domain.Claim
 
@@ -246,16 +542,27 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.
 		return err
 	}
 
+	if link.Payment != nil && len(issuedByUser) == 0 {
+		_, err := ls.paymentRepository.GetPaid(ctx, ls.storage.Pgx, issuerDID, linkID, userDID)
+		if errors.Is(err, repositories.ErrPaymentDoesNotExist) {
+			return ls.requestPayment(ctx, sessionID, issuerDID, userDID, link, schema, hostURL)
+		}
+		if err != nil {
+			log.Error(ctx, "cannot fetch the link payment", "err", err)
+			return err
+		}
+	}
+
 	claimRequestProofs := ports.ClaimRequestProofs{
 		BJJSignatureProof2021:      link.CredentialSignatureProof,
 		Iden3SparseMerkleTreeProof: link.CredentialMTPProof,
 	}
 	if len(issuedByUser) == 0 {
-		link.CredentialSubject["id"] = userDID.String()
+		credentialSubject["id"] = userDID.String()
 
 		claimReq := ports.NewCreateClaimRequest(&issuerDID,
 			schema.URL,
-			link.CredentialSubject,
+			credentialSubject,
 			link.CredentialExpiration,
 			schema.Type,
 			nil, nil, nil,
@@ -267,30 +574,85 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.
 			nil,
 			link.DisplayMethod,
 		)
+		claimReq.ExternalID = link.ExternalID
+		claimReq.RequesterRole = PolicyRequesterHolder
+		if link.CredentialExtensions != nil {
+			claimReq.Extensions = *link.CredentialExtensions
+		}
 
-		credentialIssued, err = ls.claimsService.CreateCredential(ctx, claimReq)
+		credentialIssued, err = ls.claimsService.FindDuplicate(ctx, claimReq)
 		if err != nil {
-			log.Error(ctx, "cannot create the claim", "err", err.Error())
+			log.Error(ctx, "cannot check for duplicated credentials", "err", err.Error())
+			if errors.Is(err, ErrDuplicateCredential) {
+				setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+				if setLinkError != nil {
+					log.Error(ctx, "cannot set the state", "err", setLinkError)
+					return setLinkError
+				}
+			}
 			return err
 		}
 
-		err = ls.storage.Pgx.BeginFunc(ctx,
-			func(tx pgx.Tx) error {
-				link.IssuedClaims += 1
-				_, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
-				if err != nil {
-					return err
+		if credentialIssued != nil {
+			credentialIssuedID = credentialIssued.ID
+		} else {
+			credentialIssued, err = ls.claimsService.CreateCredential(ctx, claimReq)
+			if err != nil {
+				log.Error(ctx, "cannot create the claim", "err", err.Error())
+				if errors.Is(err, ErrIssuanceLimitExceeded) || errors.Is(err, ErrIssuanceCooldown) || errors.Is(err, ErrPolicyDenied) || errors.Is(err, ErrCredentialExpirationExceeded) || errors.Is(err, ErrCredentialUniqueKeyTaken) {
+					setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+					if setLinkError != nil {
+						log.Error(ctx, "cannot set the state", "err", setLinkError)
+						return setLinkError
+					}
 				}
+				return err
+			}
 
-				credentialIssuedID, err = ls.claimRepository.Save(ctx, ls.storage.Pgx, credentialIssued)
-				if err != nil {
-					return err
-				}
+			err = ls.storage.Pgx.BeginFunc(ctx,
+				func(tx pgx.Tx) error {
+					link.IssuedClaims += 1
+					_, err := ls.linkRepository.Save(ctx, tx, link)
+					if err != nil {
+						return err
+					}
 
-				return nil
-			})
-		if err != nil {
-			return err
+					credentialIssuedID, err = ls.claimsService.SaveWithConn(ctx, tx, credentialIssued)
+					if err != nil {
+						return err
+					}
+					if len(link.Prerequisites) > 0 {
+						// so the credential can be revoked in cascade with the prerequisite credentials the holder got from this issuer
+						if err := ls.credentialDependencyRepository.SaveForPrerequisites(ctx, tx, issuerDID, credentialIssuedID, userDID, link.Prerequisites); err != nil {
+							return err
+						}
+					}
+
+					if recipient != nil {
+						return ls.linkRecipientRepository.Consume(ctx, tx, recipient.ID, credentialIssuedID)
+					}
+					if holder != nil {
+						holder.ClaimID, holder.CreatedAt = credentialIssuedID, time.Now()
+						if err := ls.linkHolderRepository.Save(ctx, tx, *holder); err != nil {
+							if errors.Is(err, repositories.ErrLinkHolderDuplicated) {
+								return ErrLinkHolderAlreadyIssued
+							}
+							return err
+						}
+					}
+					return nil
+				})
+			if err != nil {
+				if errors.Is(err, ErrLinkHolderAlreadyIssued) {
+					setLinkError := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
+					if setLinkError != nil {
+						log.Error(ctx, "cannot set the state", "err", setLinkError)
+						return setLinkError
+					}
+				}
+				return err
+			}
+			ls.notifyResult(ctx, link, schema, issuerDID, userDID, credentialIssuedID, credentialSubject)
 		}
 	} else {
 		credentialIssuedID = issuedByUser[0].ID
@@ -315,7 +677,7 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.
 			URL: fmt.Sprintf("%s/v1/agent", hostURL),
 			Credentials: []linkState.CredentialLink{{
 				ID:          credentialIssued.ID.String(),
-				Description: schema.Type,
+				Description: ls.offerDescription(ctx, issuerDID, link, schema),
 			}},
 		},
 		From: issuerDID.String(),
@@ -333,6 +695,7 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID w3c.
 		log.Error(ctx, "cannot store the qr code", "err", err)
 		return err
 	}
+	ls.claimsService.RegisterOffer(ctx, r.ThreadID, credentialIssued.ID)
 
 	if link.CredentialSignatureProof {
 		err = ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateDone(ls.qrService.ToURL(hostURL, id)))
@@ -367,6 +730,271 @@ func (ls *Link) GetQRCode(ctx context.Context, sessionID uuid.UUID, issuerID w3c
 	}, nil
 }
 
+// Pay verifies the transactions of the payment message sent by the holder to the link callback and issues the
+// credential once the payment requested in the link session is paid.
+func (ls *Link) Pay(ctx context.Context, sessionID string, issuerDID w3c.DID, linkID uuid.UUID, hostURL string, credentialStatusType verifiable.CredentialStatusType, message *protocol.CredentialPaymentMessage, clientIP net.IP) error {
+	if len(message.Body.Payments) == 0 {
+		return ErrPaymentNotFound
+	}
+
+	var userDID w3c.DID
+	for _, paid := range message.Body.Payments {
+		paymentID, err := uuid.Parse(paid.ID)
+		if err != nil {
+			return ErrPaymentNotFound
+		}
+		payment, err := ls.paymentRepository.GetByID(ctx, ls.storage.Pgx, paymentID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrPaymentDoesNotExist) {
+				return ErrPaymentNotFound
+			}
+			return err
+		}
+		if payment.IssuerDID.String() != issuerDID.String() || payment.LinkID != linkID || payment.SessionID.String() != sessionID {
+			return ErrPaymentNotFound
+		}
+		if message.From != payment.UserDID.String() {
+			log.Warn(ctx, "payment message from unexpected holder", "payment", payment.ID, "from", message.From)
+			return ErrPaymentWrongSender
+		}
+		userDID = payment.UserDID
+		if payment.Status == domain.PaymentStatusPaid {
+			continue
+		}
+
+		txID := paid.PaymentData.TxID
+		if err := ls.paymentVerifier.Verify(ctx, payment, txID); err != nil {
+			log.Warn(ctx, "payment verification failed", "err", err, "payment", payment.ID, "tx", txID)
+			return err
+		}
+		if err := ls.paymentRepository.MarkPaid(ctx, ls.storage.Pgx, payment.ID, txID, time.Now()); err != nil {
+			log.Error(ctx, "cannot set the payment as paid", "err", err, "payment", payment.ID, "tx", txID)
+			return err
+		}
+		log.Info(ctx, "link payment verified", "payment", payment.ID, "link", linkID, "tx", txID)
+	}
+
+	return ls.IssueClaim(ctx, sessionID, issuerDID, userDID, linkID, hostURL, credentialStatusType, clientIP)
+}
+
+// SavePrerequisiteProofs checks that the holder proved every prerequisite credential of the link in the
+// authorization response and records the proofs. The proofs were already verified when the response was authenticated.
+func (ls *Link) SavePrerequisiteProofs(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, proofs []protocol.ZeroKnowledgeProofResponse) error {
+	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		log.Error(ctx, "cannot fetch the link", "err", err)
+		return err
+	}
+	if len(link.Prerequisites) == 0 {
+		return nil
+	}
+	session, err := uuid.Parse(sessionID)
+	if err != nil {
+		return err
+	}
+
+	proofsByID := make(map[uint32]protocol.ZeroKnowledgeProofResponse, len(proofs))
+	for _, proof := range proofs {
+		proofsByID[proof.ID] = proof
+	}
+	now := time.Now()
+	records := make([]domain.LinkPrerequisiteProof, len(link.Prerequisites))
+	for i, request := range link.PrerequisiteProofRequests() {
+		proof, ok := proofsByID[request.ID]
+		if !ok || proof.CircuitID != request.CircuitID {
+			log.Warn(ctx, "link prerequisite not proved", "link", linkID, "user", userDID, "type", link.Prerequisites[i].CredentialType)
+			if err := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(ErrLinkPrerequisiteNotProved)); err != nil {
+				log.Error(ctx, "cannot set the state", "err", err)
+				return err
+			}
+			return ErrLinkPrerequisiteNotProved
+		}
+		records[i] = domain.LinkPrerequisiteProof{
+			ID:             uuid.New(),
+			IssuerDID:      issuerDID,
+			LinkID:         linkID,
+			SessionID:      session,
+			UserDID:        userDID,
+			SchemaURL:      link.Prerequisites[i].SchemaURL,
+			CredentialType: link.Prerequisites[i].CredentialType,
+			Proof:          proof,
+			CreatedAt:      now,
+		}
+	}
+
+	return ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for i := range records {
+			if err := ls.prerequisiteProofRepository.Save(ctx, tx, &records[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetPrerequisiteProofs returns the proofs the holders sent for the prerequisites of a link
+func (ls *Link) GetPrerequisiteProofs(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkPrerequisiteProof, error) {
+	if _, err := ls.GetByID(ctx, issuerDID, linkID); err != nil {
+		return nil, err
+	}
+	return ls.prerequisiteProofRepository.GetByLink(ctx, ls.storage.Pgx, issuerDID, linkID)
+}
+
+// requestPayment sends the holder a payment request with one payment for each token accepted by the link.
+// The holder pays one of them and sends the payment message to the link callback.
+func (ls *Link) requestPayment(ctx context.Context, sessionID string, issuerDID w3c.DID, userDID w3c.DID, link *domain.Link, schema *domain.Schema, hostURL string) error {
+	session, err := uuid.Parse(sessionID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	payments := make([]protocol.CredentialPaymentInfo, len(link.Payment.Tokens))
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for i, token := range link.Payment.Tokens {
+			payment := &domain.Payment{
+				ID:        uuid.New(),
+				IssuerDID: issuerDID,
+				LinkID:    link.ID,
+				SessionID: session,
+				UserDID:   userDID,
+				ChainID:   link.Payment.ChainID,
+				Recipient: link.Payment.Recipient,
+				Amount:    link.Payment.Amount,
+				Token:     token,
+				Status:    domain.PaymentStatusPending,
+				CreatedAt: now,
+			}
+			if err := ls.paymentRepository.Save(ctx, tx, payment); err != nil {
+				return err
+			}
+			payments[i] = paymentRequestInfo(payment, schema, link.Payment.Description, now.Add(DefaultQRBodyTTL))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "cannot save the link payments", "err", err)
+		return err
+	}
+
+	reqID := uuid.NewString()
+	msg := protocol.CredentialPaymentRequestMessage{
+		ID:       reqID,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialPaymentRequestMessageType,
+		ThreadID: reqID,
+		Body: protocol.CredentialPaymentRequestBody{
+			Agent:    fmt.Sprintf("%s/v1/credentials/links/callback?sessionID=%s&linkID=%s", hostURL, sessionID, link.ID.String()),
+			Payments: payments,
+		},
+		From: issuerDID.String(),
+		To:   userDID.String(),
+	}
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	id, err := ls.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+	if err != nil {
+		log.Error(ctx, "cannot store the payment request", "err", err)
+		return err
+	}
+
+	return ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(link.ID.String(), sessionID), *linkState.NewStatePendingPayment(ls.qrService.ToURL(hostURL, id)))
+}
+
+// paymentRequestInfo returns the payment request of a payment. ERC-20 payments use the token contract as currency,
+// so the wallets know which token to transfer.
+func paymentRequestInfo(payment *domain.Payment, schema *domain.Schema, description string, expiration time.Time) protocol.CredentialPaymentInfo {
+	currency := payment.Token.Currency
+	if !payment.Token.IsNative() {
+		currency = payment.Token.Address
+	}
+	return protocol.CredentialPaymentInfo{
+		Credentials: []protocol.CredentialInfo{{Type: schema.Type, Context: schema.URL}},
+		Type:        domain.PaymentRequestTypeCryptoV1,
+		Data: protocol.CredentialPaymentData{
+			ID:       payment.ID.String(),
+			Type:     domain.PaymentDataTypeCryptoV1,
+			Amount:   payment.Amount,
+			ChainID:  strconv.Itoa(payment.ChainID),
+			Address:  payment.Recipient,
+			Currency: currency,
+		},
+		Expiration:  expiration.UTC().Format(time.RFC3339),
+		Description: description,
+	}
+}
+
+// toLinkPrerequisites validates the prerequisite credentials of a link
+func toLinkPrerequisites(reqs []ports.LinkPrerequisiteRequest) ([]domain.LinkPrerequisite, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	prerequisites := make([]domain.LinkPrerequisite, len(reqs))
+	for i, req := range reqs {
+		circuitID, err := presentationCircuitID(req.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unsupported proof type %s", ErrLinkInvalidPrerequisite, req.ProofType)
+		}
+		if _, err := url.ParseRequestURI(req.SchemaURL); err != nil {
+			return nil, fmt.Errorf("%w: schema must be a valid url", ErrLinkInvalidPrerequisite)
+		}
+		credentialType := strings.TrimSpace(req.CredentialType)
+		if credentialType == "" {
+			return nil, fmt.Errorf("%w: credential type cannot be empty", ErrLinkInvalidPrerequisite)
+		}
+		allowedIssuers := req.AllowedIssuers
+		if len(allowedIssuers) == 0 {
+			allowedIssuers = []string{"*"}
+		}
+		for _, issuer := range allowedIssuers {
+			if issuer == "*" {
+				continue
+			}
+			if _, err := w3c.ParseDID(issuer); err != nil {
+				return nil, fmt.Errorf("%w: allowed issuer %s is not a did", ErrLinkInvalidPrerequisite, issuer)
+			}
+		}
+		prerequisites[i] = domain.LinkPrerequisite{
+			SchemaURL:      req.SchemaURL,
+			CredentialType: credentialType,
+			CircuitID:      circuitID,
+			AllowedIssuers: allowedIssuers,
+		}
+	}
+	return prerequisites, nil
+}
+
+// validatePayment checks the payment configuration of a link
+func (ls *Link) validatePayment(payment *domain.LinkPayment) error {
+	if payment == nil {
+		return nil
+	}
+	amount, ok := new(big.Int).SetString(payment.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("%w: the amount must be a positive integer in the smallest unit of the token", ErrLinkInvalidPayment)
+	}
+	if payment.ChainID <= 0 {
+		return fmt.Errorf("%w: invalid chain id %d", ErrLinkInvalidPayment, payment.ChainID)
+	}
+	if !common.IsHexAddress(payment.Recipient) {
+		return fmt.Errorf("%w: invalid recipient address %s", ErrLinkInvalidPayment, payment.Recipient)
+	}
+	if len(payment.Tokens) == 0 {
+		return fmt.Errorf("%w: at least one token must be accepted", ErrLinkInvalidPayment)
+	}
+	for _, token := range payment.Tokens {
+		if token.Currency == "" {
+			return fmt.Errorf("%w: token currency cannot be empty", ErrLinkInvalidPayment)
+		}
+		if !token.IsNative() && !common.IsHexAddress(token.Address) {
+			return fmt.Errorf("%w: invalid token address %s", ErrLinkInvalidPayment, token.Address)
+		}
+	}
+	return nil
+}
+
 func (ls *Link) validate(ctx context.Context, link *domain.Link) error {
 	if link.ValidUntil != nil && time.Now().UTC().After(*link.ValidUntil) {
 		log.Debug(ctx, "cannot issue a credential for an expired link")
@@ -386,6 +1014,122 @@ func (ls *Link) validate(ctx context.Context, link *domain.Link) error {
 	return nil
 }
 
+// validateLinkResultWebhook checks the result webhook is an http or https url with a secret long enough to sign
+// the results
+func validateLinkResultWebhook(webhook *domain.LinkResultWebhook) error {
+	if webhook == nil {
+		return nil
+	}
+	u, err := url.ParseRequestURI(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: the url must be an http or https url", ErrLinkInvalidResultWebhook)
+	}
+	if len(webhook.Secret) < minLinkResultWebhookSecretLength {
+		return fmt.Errorf("%w: the secret must have at least %d characters", ErrLinkInvalidResultWebhook, minLinkResultWebhookSecretLength)
+	}
+	return nil
+}
+
+// notifyResult posts the credential issued through the link to the result webhook of the link, shaped by the webhook
+// template of the schema, without delaying the holder. Failures are only logged.
+func (ls *Link) notifyResult(ctx context.Context, link *domain.Link, schema *domain.Schema, issuerDID w3c.DID, userDID w3c.DID, credentialID uuid.UUID, attributes map[string]any) {
+	if link.ResultWebhook == nil || ls.resultWebhook == nil {
+		return
+	}
+	webhook, template := *link.ResultWebhook, schema.WebhookTemplate
+	result := domain.LinkIssuanceResult{
+		LinkID:       link.ID,
+		ExternalID:   link.ExternalID,
+		IssuerDID:    issuerDID.String(),
+		UserDID:      userDID.String(),
+		CredentialID: credentialID,
+		Attributes:   attributes,
+		IssuedAt:     time.Now().UTC(),
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := ls.resultWebhook.Notify(ctx, webhook, result, template); err != nil {
+			log.Error(ctx, "posting the link result", "err", err, "link", result.LinkID, "credential", result.CredentialID)
+			return
+		}
+		log.Info(ctx, "audit: link result posted", "link", result.LinkID, "credential", result.CredentialID, "userDID", result.UserDID)
+	}()
+}
+
+// validateRestrictions normalizes the link restrictions. The countries can only be checked with a geoip provider.
+func (ls *Link) validateRestrictions(restrictions *domain.LinkRestrictions) error {
+	if restrictions == nil {
+		return nil
+	}
+	if err := restrictions.Normalize(); err != nil {
+		return fmt.Errorf("%w: %s", ErrLinkInvalidRestrictions, err)
+	}
+	if len(restrictions.Countries) > 0 && ls.geoIP == nil {
+		return fmt.Errorf("%w: the countries require a geoip provider", ErrLinkInvalidRestrictions)
+	}
+	return nil
+}
+
+// checkRestrictions returns ErrLinkRestricted if the link can not be claimed from the network or the country of the
+// client. The country is unknown if the geoip provider fails, so the restricted links are not claimable until it
+// recovers. The violations are logged for auditing.
+func (ls *Link) checkRestrictions(ctx context.Context, link *domain.Link, clientIP net.IP) error {
+	restrictions := link.Restrictions
+	if restrictions.Empty() {
+		return nil
+	}
+	if !restrictions.AllowsIP(clientIP) {
+		log.Warn(ctx, "audit: link claim from a restricted network", "link", link.ID, "ip", clientIP)
+		return ErrLinkRestricted
+	}
+	if len(restrictions.Countries) == 0 {
+		return nil
+	}
+	var country string
+	if ls.geoIP != nil && clientIP != nil {
+		var err error
+		if country, err = ls.geoIP.Country(ctx, clientIP); err != nil {
+			log.Error(ctx, "getting the country of the client ip", "err", err, "ip", clientIP)
+		}
+	}
+	if !restrictions.AllowsCountry(country) {
+		log.Warn(ctx, "audit: link claim from a restricted country", "link", link.ID, "ip", clientIP, "country", country)
+		return ErrLinkRestricted
+	}
+	return nil
+}
+
+// checkThrottle returns a LinkThrottledError, with the time the link can be claimed again, if the link is out of its
+// time windows or already issued its credentials per hour.
+func (ls *Link) checkThrottle(ctx context.Context, link *domain.Link) error {
+	throttle := link.Throttle
+	if throttle.Empty() {
+		return nil
+	}
+	now := time.Now().UTC()
+	if !throttle.Active(now) {
+		log.Debug(ctx, "link claimed out of its time windows", "link", link.ID)
+		return &LinkThrottledError{RetryAt: throttle.NextActive(now)}
+	}
+	if throttle.MaxClaimsPerHour == nil {
+		return nil
+	}
+	issued, oldest, err := ls.linkRepository.ClaimsIssuedSince(ctx, link.ID, now.Add(-time.Hour))
+	if err != nil {
+		log.Error(ctx, "counting the credentials issued by the link", "err", err, "link", link.ID)
+		return err
+	}
+	if issued >= *throttle.MaxClaimsPerHour {
+		retryAt := now
+		if oldest != nil {
+			retryAt = oldest.Add(time.Hour)
+		}
+		log.Warn(ctx, "link claims per hour exceeded", "link", link.ID, "issued", issued)
+		return &LinkThrottledError{RetryAt: retryAt}
+	}
+	return nil
+}
+
 func (ls *Link) validateCredentialSubjectAgainstSchema(ctx context.Context, cSubject domain.CredentialSubject, schemaDB *domain.Schema) error {
 	return jsonschema.ValidateCredentialSubject(ctx, ls.loader, schemaDB.URL, schemaDB.Type, cSubject)
 }
@@ -433,3 +1177,21 @@ func (ls *Link) validateDisplayMethod(dm *verifiable.DisplayMethod) error {
 		return ErrUnsupportedDisplayMethodType
 	}
 }
+
+// offerDescription returns the description of the credential offer of the link, translated to the link locale
+func (ls *Link) offerDescription(ctx context.Context, issuerDID w3c.DID, link *domain.Link, schema *domain.Schema) string {
+	if ls.translationService == nil || link.Locale == nil {
+		return schema.Type
+	}
+	localizer := ls.translationService.Localizer(ctx, issuerDID, *link.Locale)
+	return localizer.T(domain.OfferDescriptionKey(schema.Type), schema.Type)
+}
+
+// getPresentationTemplate returns the presentation template the link asks the holder to prove before issuing
+func (ls *Link) getPresentationTemplate(ctx context.Context, issuerDID w3c.DID, name string) (*domain.PresentationTemplate, error) {
+	template, err := ls.presentationTemplateRepository.GetByName(ctx, ls.storage.Pgx, issuerDID, name)
+	if errors.Is(err, repositories.ErrPresentationTemplateDoesNotExist) {
+		return nil, ErrPresentationTemplateNotFound
+	}
+	return template, err
+}
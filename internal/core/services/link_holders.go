@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	linkState "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+var (
+	// ErrLinkProfileProofInvalid - the profile DID of the holder is not derived from the genesis DID with the nonce
+	ErrLinkProfileProofInvalid = errors.New("the profile DID is not derived from the genesis DID with the nonce")
+	// ErrLinkHolderAlreadyIssued - the identity of the holder already claimed the link with another profile DID
+	ErrLinkHolderAlreadyIssued = errors.New("the credential of the link was already issued to another profile of the holder")
+)
+
+// SaveHolderProfile checks that the profile DID the holder authenticated with derives from the genesis DID with the
+// nonce and keeps the digest of the genesis DID in the session. The genesis DID itself is never stored.
+func (ls *Link) SaveHolderProfile(ctx context.Context, sessionID string, userDID w3c.DID, linkID uuid.UUID, genesisDID w3c.DID, nonce *big.Int) error {
+	if !domain.IsProfileOf(userDID, genesisDID, nonce) {
+		log.Warn(ctx, "invalid link holder profile proof", "link", linkID, "user", userDID)
+		if err := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(ErrLinkProfileProofInvalid)); err != nil {
+			log.Error(ctx, "cannot set the state", "err", err)
+			return err
+		}
+		return ErrLinkProfileProofInvalid
+	}
+	holder := domain.LinkHolder{
+		LinkID:  linkID,
+		Digest:  domain.LinkHolderDigest(linkID, genesisDID),
+		UserDID: userDID.String(),
+	}
+	return ls.sessionManager.SetLinkHolder(ctx, linkState.HolderProfileCacheKey(linkID.String(), sessionID), holder)
+}
+
+// sessionHolder returns the holder that proved the genesis DID of its profile in the session, or nil if it didn't
+func (ls *Link) sessionHolder(ctx context.Context, sessionID string, linkID uuid.UUID, userDID w3c.DID) *domain.LinkHolder {
+	holder, err := ls.sessionManager.GetLinkHolder(ctx, linkState.HolderProfileCacheKey(linkID.String(), sessionID))
+	if err != nil || holder.UserDID != userDID.String() {
+		return nil
+	}
+	return &holder
+}
+
+// checkHolder fails if the identity of the holder already claimed the link with a profile DID other than userDID.
+// Claims with the same DID are handled as any other holder.
+func (ls *Link) checkHolder(ctx context.Context, holder *domain.LinkHolder, userDID w3c.DID) error {
+	if holder == nil {
+		return nil
+	}
+	previous, err := ls.linkHolderRepository.Get(ctx, ls.storage.Pgx, holder.LinkID, holder.Digest)
+	if errors.Is(err, repositories.ErrLinkHolderDoesNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if previous.UserDID != userDID.String() {
+		return ErrLinkHolderAlreadyIssued
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	// linkRecipientCodeColumn is the optional column of the recipients csv with the claim code of every recipient
+	linkRecipientCodeColumn = "code"
+	maxLinkRecipients       = 10000
+	maxLinkRecipientCode    = 64
+	linkRecipientCodeLength = 10
+	linkRecipientCodeChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // no 0, O, 1 or I, codes may be typed by hand
+)
+
+var (
+	// ErrLinkRecipientsInvalid - the recipients csv is not valid
+	ErrLinkRecipientsInvalid = errors.New("invalid link recipients")
+	// ErrLinkRecipientCodeInvalid - the claim code does not exist for the link or it was already used
+	ErrLinkRecipientCodeInvalid = errors.New("the claim code is not valid or it was already used")
+)
+
+// ImportRecipients - adds the recipients of the csv to the link. The first row is the header, with the credential
+// subject attributes and, optionally, the code column. Every other row is a recipient with its credential subject,
+// that overrides the link one, and its claim code, generated when empty. Values are converted to the types of the
+// schema attributes. All the recipients are imported or none of them.
+func (ls *Link) ImportRecipients(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, r io.Reader) ([]domain.LinkRecipient, error) {
+	link, err := ls.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := jsonschema.Load(ctx, link.Schema.URL, ls.loader)
+	if err != nil {
+		log.Error(ctx, "loading the link schema", "err", err, "url", link.Schema.URL)
+		return nil, err
+	}
+	attributes, err := schema.Attributes()
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		types[attr.ID] = attr.Type
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read the header: %s", ErrLinkRecipientsInvalid, err)
+	}
+	for i, column := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if header[i] == "id" {
+			return nil, fmt.Errorf("%w: the id column is not allowed, it is the did of the holder", ErrLinkRecipientsInvalid)
+		}
+		if _, ok := types[header[i]]; !ok && header[i] != linkRecipientCodeColumn {
+			return nil, fmt.Errorf("%w: %s is not an attribute of the schema", ErrLinkRecipientsInvalid, header[i])
+		}
+	}
+
+	recipients := make([]domain.LinkRecipient, 0)
+	codes := make(map[string]bool)
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: %s", ErrLinkRecipientsInvalid, row, err)
+		}
+		if len(recipients) == maxLinkRecipients {
+			return nil, fmt.Errorf("%w: up to %d recipients are allowed", ErrLinkRecipientsInvalid, maxLinkRecipients)
+		}
+
+		subject := make(domain.CredentialSubject, len(link.CredentialSubject)+len(record))
+		for k, v := range link.CredentialSubject {
+			if k != "id" {
+				subject[k] = v
+			}
+		}
+		code := ""
+		for i, value := range record {
+			if header[i] == linkRecipientCodeColumn {
+				code = strings.TrimSpace(value)
+				continue
+			}
+			if value == "" {
+				continue
+			}
+			if subject[header[i]], err = csvAttributeValue(value, types[header[i]]); err != nil {
+				return nil, fmt.Errorf("%w: row %d: %s: %s", ErrLinkRecipientsInvalid, row, header[i], err)
+			}
+		}
+		if err := ls.validateCredentialSubjectAgainstSchema(ctx, subject, link.Schema); err != nil {
+			return nil, fmt.Errorf("%w: row %d: %s", ErrLinkRecipientsInvalid, row, err)
+		}
+
+		if code == "" {
+			if code, err = newLinkRecipientCode(); err != nil {
+				return nil, err
+			}
+		}
+		if len(code) > maxLinkRecipientCode {
+			return nil, fmt.Errorf("%w: row %d: the code can not be longer than %d characters", ErrLinkRecipientsInvalid, row, maxLinkRecipientCode)
+		}
+		if codes[code] {
+			return nil, fmt.Errorf("%w: row %d: duplicated code %s", ErrLinkRecipientsInvalid, row, code)
+		}
+		codes[code] = true
+		recipients = append(recipients, *domain.NewLinkRecipient(linkID, code, subject))
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("%w: the csv has no recipients", ErrLinkRecipientsInvalid)
+	}
+
+	if err := ls.linkRecipientRepository.Save(ctx, ls.storage.Pgx, recipients); err != nil {
+		if errors.Is(err, repositories.ErrLinkRecipientDuplicatedCode) {
+			return nil, fmt.Errorf("%w: %s", ErrLinkRecipientsInvalid, err)
+		}
+		return nil, err
+	}
+	return recipients, nil
+}
+
+// GetRecipients - returns the recipients of a link
+func (ls *Link) GetRecipients(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkRecipient, error) {
+	if _, err := ls.GetByID(ctx, issuerDID, linkID); err != nil {
+		return nil, err
+	}
+	return ls.linkRecipientRepository.GetByLink(ctx, ls.storage.Pgx, linkID)
+}
+
+// recipientByCode returns the recipient of the link with the code, that must not have claimed its credential yet.
+// Links without recipients return nil and must be claimed without a code.
+func (ls *Link) recipientByCode(ctx context.Context, linkID uuid.UUID, code string) (*domain.LinkRecipient, error) {
+	if code == "" {
+		hasRecipients, err := ls.linkRecipientRepository.Exists(ctx, ls.storage.Pgx, linkID)
+		if err != nil {
+			return nil, err
+		}
+		if hasRecipients {
+			return nil, ErrLinkRecipientCodeInvalid
+		}
+		return nil, nil
+	}
+	recipient, err := ls.linkRecipientRepository.GetByCode(ctx, ls.storage.Pgx, linkID, code)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkRecipientDoesNotExist) {
+			return nil, ErrLinkRecipientCodeInvalid
+		}
+		return nil, err
+	}
+	if recipient.Consumed() {
+		return nil, ErrLinkRecipientCodeInvalid
+	}
+	return recipient, nil
+}
+
+// sessionRecipient returns the recipient of the code the session was created with, or nil for links without
+// recipients. The code is read from the callback url of the authorization request stored when the session was
+// created, so the holder can't change it.
+func (ls *Link) sessionRecipient(ctx context.Context, sessionID string, linkID uuid.UUID) (*domain.LinkRecipient, error) {
+	authRequest, err := ls.sessionManager.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	callback, err := url.Parse(authRequest.Body.CallbackURL)
+	if err != nil {
+		return nil, err
+	}
+	return ls.recipientByCode(ctx, linkID, callback.Query().Get(linkRecipientCodeColumn))
+}
+
+// csvAttributeValue converts a csv value to the type of the schema attribute
+func csvAttributeValue(value string, attrType string) (any, error) {
+	switch attrType {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			if _, ok := new(big.Int).SetString(value, 10); !ok {
+				return nil, fmt.Errorf("%s is not an integer", value)
+			}
+		}
+		return json.Number(value), nil
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%s is not a number", value)
+		}
+		return json.Number(value), nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a boolean", value)
+		}
+		return b, nil
+	case "object", "array":
+		var v any
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("%s is not a json %s", value, attrType)
+		}
+		return v, nil
+	}
+	return value, nil
+}
+
+func newLinkRecipientCode() (string, error) {
+	code := make([]byte, linkRecipientCodeLength)
+	max := big.NewInt(int64(len(linkRecipientCodeChars)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = linkRecipientCodeChars[n.Int64()]
+	}
+	return string(code), nil
+}
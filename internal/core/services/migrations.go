@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	dbSchema "github.com/polygonid/sh-id-platform/internal/db/schema"
+)
+
+// MigrationsLockTimeout is the time to wait for other replica running the migrations before giving up
+const MigrationsLockTimeout = 10 * time.Second
+
+// ErrMigrationsLocked is returned when other replica is running the migrations
+var ErrMigrationsLocked = errors.New("the migrations are being run by other replica")
+
+type migrations struct {
+	databaseURL string
+}
+
+// NewMigrations returns a new service to check and run the migrations of the database
+func NewMigrations(databaseURL string) ports.MigrationsService {
+	return &migrations{databaseURL: databaseURL}
+}
+
+// Status returns all the migrations, applied and pending, sorted by version
+func (m *migrations) Status(ctx context.Context) ([]domain.Migration, error) {
+	status, err := dbSchema.Status(ctx, m.databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainMigrations(status), nil
+}
+
+// Up runs the pending migrations and returns the applied ones. Only one replica runs them at a time, the others
+// fail with ErrMigrationsLocked. Once started, the migrations are not canceled with the context.
+func (m *migrations) Up(ctx context.Context) ([]domain.Migration, error) {
+	applied, err := dbSchema.Up(context.WithoutCancel(ctx), m.databaseURL, MigrationsLockTimeout)
+	if errors.Is(err, dbSchema.ErrLocked) {
+		return nil, ErrMigrationsLocked
+	}
+	return toDomainMigrations(applied), err
+}
+
+func toDomainMigrations(migrations []dbSchema.Migration) []domain.Migration {
+	res := make([]domain.Migration, len(migrations))
+	for i, m := range migrations {
+		res[i] = domain.Migration{Version: m.Version, Name: m.Name, Applied: m.Applied, AppliedAt: m.AppliedAt, Duration: m.Duration}
+	}
+	return res
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
 const (
@@ -80,6 +81,17 @@ func (mts *mtService) CreateIdentityMerkleTrees(ctx context.Context, conn db.Que
 }
 
 func (mts *mtService) GetIdentityMerkleTrees(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityMerkleTrees, error) {
+	return mts.getIdentityMerkleTrees(ctx, conn, identifier, false)
+}
+
+// GetIdentityMerkleTreesBuffered returns the identity trees keeping the writes in memory until the trees are flushed.
+// conn must be a transaction, and the trees must be flushed in it before reading them with other storage.
+func (mts *mtService) GetIdentityMerkleTreesBuffered(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityMerkleTrees, error) {
+	return mts.getIdentityMerkleTrees(ctx, conn, identifier, true)
+}
+
+func (mts *mtService) getIdentityMerkleTrees(ctx context.Context, conn db.Querier, identifier *w3c.DID, buffered bool) (*domain.IdentityMerkleTrees, error) {
+	var buffers []domain.MerkleTreeBuffer
 	trees := make([]*merkletree.MerkleTree, mtTypesCount)
 	imtModels := make([]*domain.IdentityMerkleTree, mtTypesCount)
 	imts, err := mts.imtRepo.GetByIdentifierAndTypes(ctx, conn, identifier, mtTypes)
@@ -93,7 +105,12 @@ func (mts *mtService) GetIdentityMerkleTrees(ctx context.Context, conn db.Querie
 			return nil, errNotFound
 		}
 		imtModels[mtType] = imt
-		treeStorage := sql.NewSqlStorage(conn, imt.ID)
+		var treeStorage merkletree.Storage = sql.NewSqlStorage(conn, imt.ID)
+		if buffered {
+			buffer := repositories.NewMTNodesBuffer(conn, imt.ID)
+			buffers = append(buffers, buffer)
+			treeStorage = buffer
+		}
 		tree, err := merkletree.NewMerkleTree(ctx, treeStorage, mtDepth)
 		if err != nil {
 			return nil, err
@@ -105,6 +122,7 @@ func (mts *mtService) GetIdentityMerkleTrees(ctx context.Context, conn db.Querie
 		Identifier: identifier,
 		Trees:      trees,
 		ImtModels:  imtModels,
+		Buffers:    buffers,
 	}
 	return imTrees, nil
 }
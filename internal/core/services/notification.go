@@ -144,7 +144,7 @@ func (n *notification) sendCreateCredentialNotification(ctx context.Context, iss
 		credentials[i] = credential
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(connection, credentials...)
+	credOfferBytes, subjectDIDDoc, err := n.credentialOffer(ctx, connection, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID)
 		return err
@@ -186,7 +186,7 @@ func (n *notification) sendCreateConnectionNotification(ctx context.Context, iss
 		return err
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(conn, credentials...)
+	credOfferBytes, subjectDIDDoc, err := n.credentialOffer(ctx, conn, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateConnectionNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID, "connID", connID)
 		return err
@@ -212,7 +212,22 @@ func (n *notification) send(ctx context.Context, credOfferBytes []byte, subjectD
 	return nil
 }
 
-func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
+// credentialOffer returns the offer of the credentials and registers its thread, so the wallet can fetch them
+func (n *notification) credentialOffer(ctx context.Context, conn *domain.Connection, credentials ...*domain.Claim) ([]byte, verifiable.DIDDocument, error) {
+	threadID := uuid.NewString()
+	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(conn, threadID, credentials...)
+	if err != nil {
+		return nil, verifiable.DIDDocument{}, err
+	}
+	ids := make([]uuid.UUID, len(credentials))
+	for i, credential := range credentials {
+		ids[i] = credential.ID
+	}
+	n.credService.RegisterOffer(ctx, threadID, ids...)
+	return credOfferBytes, subjectDIDDoc, nil
+}
+
+func getCredentialOfferData(conn *domain.Connection, threadID string, credentials ...*domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
 	var managedDIDDoc verifiable.DIDDocument
 	err = json.Unmarshal(conn.IssuerDoc, &managedDIDDoc)
 	if err != nil {
@@ -224,7 +239,7 @@ func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Clai
 		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal managedService, err: %v", err.Error())
 	}
 
-	credOfferBytes, err = notifications.NewOfferMsg(managedService.ServiceEndpoint, credentials...)
+	credOfferBytes, err = notifications.NewOfferMsg(managedService.ServiceEndpoint, threadID, credentials...)
 	if err != nil {
 		return nil, verifiable.DIDDocument{}, fmt.Errorf("newOfferMsg, err: %v", err.Error())
 	}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	ErrOrganizationCredentialNotFound = errors.New("organization credential not found")   // ErrOrganizationCredentialNotFound the credential does not exist
+	ErrOrganizationCredentialInvalid  = errors.New("invalid organization credential")     // ErrOrganizationCredentialInvalid the credential is malformed or was not issued to the issuer
+	ErrOrganizationCredentialExpired  = errors.New("the organization credential expired") // ErrOrganizationCredentialExpired the credential can't be presented anymore
+)
+
+type organizationCredential struct {
+	repo    ports.OrganizationCredentialRepository
+	storage *db.Storage
+}
+
+// NewOrganizationCredential returns a new service of the credentials third parties issued to the issuer identity
+func NewOrganizationCredential(repo ports.OrganizationCredentialRepository, storage *db.Storage) ports.OrganizationCredentialService {
+	return &organizationCredential{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Receive stores a credential issued to the issuer by a third party. The credential subject must be the issuer and
+// the credential must have a proof. The proofs are not verified here, the verifiers check them when it is presented.
+func (s *organizationCredential) Receive(ctx context.Context, issuerDID w3c.DID, document json.RawMessage, public bool) (*domain.OrganizationCredential, error) {
+	credential, err := domain.NewOrganizationCredential(issuerDID, document, public)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOrganizationCredentialInvalid, err)
+	}
+	if err := validateOrganizationCredential(credential); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, credential); err != nil {
+		log.Error(ctx, "saving the organization credential", "err", err)
+		return nil, err
+	}
+	return credential, nil
+}
+
+// GetByID returns the organization credential with the given id
+func (s *organizationCredential) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.OrganizationCredential, error) {
+	credential, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrOrganizationCredentialDoesNotExist) {
+		return nil, ErrOrganizationCredentialNotFound
+	}
+	return credential, err
+}
+
+// GetAll returns the organization credentials of the issuer, optionally of a single type
+func (s *organizationCredential) GetAll(ctx context.Context, issuerDID w3c.DID, credentialType string) ([]domain.OrganizationCredential, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID, ports.OrganizationCredentialsFilter{Type: credentialType})
+}
+
+// SetPublic sets whether the organization credential is presented to the verifiers
+func (s *organizationCredential) SetPublic(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, public bool) (*domain.OrganizationCredential, error) {
+	err := s.repo.SetPublic(ctx, s.storage.Pgx, issuerDID, id, public)
+	if errors.Is(err, repositories.ErrOrganizationCredentialDoesNotExist) {
+		return nil, ErrOrganizationCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, issuerDID, id)
+}
+
+// Delete removes the organization credential with the given id
+func (s *organizationCredential) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	err := s.repo.Delete(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrOrganizationCredentialDoesNotExist) {
+		return ErrOrganizationCredentialNotFound
+	}
+	return err
+}
+
+// Present returns the presentation of the public credentials of the issuer that have not expired, optionally of a
+// single type
+func (s *organizationCredential) Present(ctx context.Context, issuerDID w3c.DID, credentialType string) (*domain.OrganizationPresentation, error) {
+	credentials, err := s.repo.GetAll(ctx, s.storage.Pgx, issuerDID, ports.OrganizationCredentialsFilter{Type: credentialType, OnlyPublic: true})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	valid := make([]domain.OrganizationCredential, 0, len(credentials))
+	for i := range credentials {
+		if !credentials[i].Expired(now) {
+			valid = append(valid, credentials[i])
+		}
+	}
+	return domain.NewOrganizationPresentation(issuerDID, valid), nil
+}
+
+func validateOrganizationCredential(credential *domain.OrganizationCredential) error {
+	vc := credential.Credential
+	if len(vc.Type) == 0 {
+		return fmt.Errorf("%w: the credential has no type", ErrOrganizationCredentialInvalid)
+	}
+	if vc.Issuer == "" || vc.Issuer == credential.IssuerDID.String() {
+		return fmt.Errorf("%w: the credential must be issued by a third party", ErrOrganizationCredentialInvalid)
+	}
+	if subject, _ := vc.CredentialSubject["id"].(string); subject != credential.IssuerDID.String() {
+		return fmt.Errorf("%w: the credential subject must be the issuer %s", ErrOrganizationCredentialInvalid, credential.IssuerDID.String())
+	}
+	if len(vc.Proof) == 0 {
+		return fmt.Errorf("%w: the credential has no proof", ErrOrganizationCredentialInvalid)
+	}
+	if credential.Expired(time.Now()) {
+		return ErrOrganizationCredentialExpired
+	}
+	return nil
+}
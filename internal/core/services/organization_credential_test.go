@@ -0,0 +1,49 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestOrganizationCredential_ReceiveInvalid(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	organizationCredentials := services.NewOrganizationCredential(nil, nil)
+
+	document := func(issuer, subject, expiration, proof string) json.RawMessage {
+		return json.RawMessage(fmt.Sprintf(`{
+			"@context": ["https://www.w3.org/2018/credentials/v1"],
+			"type": ["VerifiableCredential", "AccreditedIssuer"],
+			"issuer": %q,
+			"expirationDate": %q,
+			"credentialSubject": {"id": %q}%s
+		}`, issuer, expiration, subject, proof))
+	}
+	const proof = `, "proof": {"type": "Ed25519Signature2020", "proofValue": "z58DAdFfa9"}`
+
+	for _, tc := range []struct {
+		name     string
+		document json.RawMessage
+		err      error
+	}{
+		{name: "malformed", document: json.RawMessage(`{"type": 1}`), err: services.ErrOrganizationCredentialInvalid},
+		{name: "other subject", document: document("did:web:accreditation.example.com", "did:web:other.example.com", "2099-01-01T00:00:00Z", proof), err: services.ErrOrganizationCredentialInvalid},
+		{name: "self issued", document: document(issuerDID.String(), issuerDID.String(), "2099-01-01T00:00:00Z", proof), err: services.ErrOrganizationCredentialInvalid},
+		{name: "without proof", document: document("did:web:accreditation.example.com", issuerDID.String(), "2099-01-01T00:00:00Z", ""), err: services.ErrOrganizationCredentialInvalid},
+		{name: "expired", document: document("did:web:accreditation.example.com", issuerDID.String(), "2020-01-01T00:00:00Z", proof), err: services.ErrOrganizationCredentialExpired},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := organizationCredentials.Receive(ctx, *issuerDID, tc.document, true)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
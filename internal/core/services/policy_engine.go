@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// PolicyRequesterHolder is the requester role of the credentials issued to the holders that scan a link
+const PolicyRequesterHolder = "holder"
+
+var (
+	ErrPolicyDenied      = errors.New("the issuance policy denied the credential") // ErrPolicyDenied the policy engine denied the credential
+	ErrPolicyUnavailable = errors.New("the policy engine could not be consulted")  // ErrPolicyUnavailable the policy engine failed and it is not configured to fail open
+)
+
+// evaluatePolicy consults the policy engine before the credential of the request is created. Modify decisions replace
+// the attributes and the expiration of the request.
+func (c *claim) evaluatePolicy(ctx context.Context, req *ports.CreateClaimRequest) error {
+	if c.policyEngine == nil {
+		return nil
+	}
+
+	input := domain.PolicyInput{
+		Action:     domain.PolicyActionCreateCredential,
+		Issuer:     req.DID.String(),
+		Schema:     domain.PolicySchema{URL: req.Schema, Type: req.Type},
+		Attributes: req.CredentialSubject,
+		Expiration: req.Expiration,
+		Requester:  domain.PolicyRequester{Role: req.RequesterRole},
+	}
+	if req.LinkID != nil {
+		input.Action = domain.PolicyActionLinkCallback
+	}
+	if subject, ok := req.CredentialSubject["id"].(string); ok && subject != "" {
+		input.Subject = subject
+		connection, err := c.policyConnection(ctx, *req.DID, subject, req.Type)
+		if err != nil {
+			return err
+		}
+		input.Connection = connection
+	}
+
+	decision, err := c.policyEngine.Evaluate(ctx, input)
+	if err != nil {
+		log.Error(ctx, "consulting the policy engine", "err", err, "action", input.Action, "schema", req.Schema)
+		if c.policyEngineCfg.FailOpen {
+			log.Warn(ctx, "audit: policy engine unavailable, credential allowed", "action", input.Action, "schema", req.Schema, "subject", input.Subject)
+			return nil
+		}
+		return ErrPolicyUnavailable
+	}
+
+	log.Info(ctx, "audit: policy decision", "effect", decision.Effect, "reason", decision.Reason, "action", input.Action, "issuer", input.Issuer,
+		"schema", req.Schema, "type", req.Type, "subject", input.Subject, "requester", input.Requester.Role)
+
+	switch decision.Effect {
+	case domain.PolicyEffectDeny:
+		if decision.Reason != "" {
+			return fmt.Errorf("%w: %s", ErrPolicyDenied, decision.Reason)
+		}
+		return ErrPolicyDenied
+	case domain.PolicyEffectModify:
+		if decision.Attributes != nil {
+			attributes := make(map[string]any, len(decision.Attributes)+1)
+			for k, v := range decision.Attributes {
+				attributes[k] = v
+			}
+			// the policy can not change the subject of the credential
+			if input.Subject != "" {
+				attributes["id"] = input.Subject
+			} else {
+				delete(attributes, "id")
+			}
+			req.CredentialSubject = attributes
+		}
+		if decision.Expiration != nil {
+			req.Expiration = decision.Expiration
+		}
+	}
+	return nil
+}
+
+// policyConnection returns the history of the connection of the issuer with the subject, or nil if they are not
+// connected
+func (c *claim) policyConnection(ctx context.Context, issuerDID w3c.DID, subject string, schemaType string) (*domain.PolicyConnection, error) {
+	userDID, err := w3c.ParseDID(subject)
+	if err != nil || c.connectionsRepository == nil {
+		return nil, nil
+	}
+	conn, err := c.connectionsRepository.GetByUserID(ctx, c.storage.Pgx, issuerDID, *userDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, nil
+		}
+		log.Error(ctx, "getting the connection of the subject", "err", err, "subject", subject)
+		return nil, err
+	}
+	credentials, err := c.icRepo.GetNonRevokedBySubjectAndSchemaType(ctx, c.storage.Pgx, issuerDID, subject, schemaType)
+	if err != nil {
+		log.Error(ctx, "getting credentials of the subject", "err", err, "subject", subject, "type", schemaType)
+		return nil, err
+	}
+	return &domain.PolicyConnection{
+		CreatedAt:           conn.CreatedAt,
+		LastVerifiedAt:      conn.LastVerifiedAt,
+		CredentialsOfSchema: len(credentials),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+type policyEngineStub struct {
+	decision *domain.PolicyDecision
+	err      error
+	input    domain.PolicyInput
+}
+
+func (p *policyEngineStub) Evaluate(_ context.Context, input domain.PolicyInput) (*domain.PolicyDecision, error) {
+	p.input = input
+	return p.decision, p.err
+}
+
+func TestClaim_CreateCredentialPolicy(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe")
+	require.NoError(t, err)
+	newRequest := func() *ports.CreateClaimRequest {
+		return &ports.CreateClaimRequest{
+			DID:               issuerDID,
+			Schema:            "https://example.com/schema.json",
+			Type:              "KYCAgeCredential",
+			CredentialSubject: map[string]any{"birthday": 19960424},
+			RequesterRole:     "operator",
+		}
+	}
+	newClaim := func(engine ports.PolicyEngine, cfg config.PolicyEngine) ports.ClaimsService {
+		return services.NewClaim(services.ClaimDependencies{
+			PolicyEngine:       engine,
+			PolicyEngineConfig: cfg,
+		})
+	}
+
+	t.Run("should reject the credentials denied by the policy", func(t *testing.T) {
+		engine := &policyEngineStub{decision: &domain.PolicyDecision{Effect: domain.PolicyEffectDeny, Reason: "minors are not allowed"}}
+		_, err := newClaim(engine, config.PolicyEngine{}).CreateCredential(ctx, newRequest())
+		assert.ErrorIs(t, err, services.ErrPolicyDenied)
+		assert.ErrorContains(t, err, "minors are not allowed")
+		assert.Equal(t, domain.PolicyActionCreateCredential, engine.input.Action)
+		assert.Equal(t, "operator", engine.input.Requester.Role)
+		assert.Equal(t, "KYCAgeCredential", engine.input.Schema.Type)
+		assert.Equal(t, 19960424, engine.input.Attributes["birthday"])
+	})
+
+	t.Run("should reject the credentials when the policy engine fails", func(t *testing.T) {
+		engine := &policyEngineStub{err: errors.New("connection refused")}
+		_, err := newClaim(engine, config.PolicyEngine{}).CreateCredential(ctx, newRequest())
+		assert.ErrorIs(t, err, services.ErrPolicyUnavailable)
+	})
+
+	t.Run("should go on when the policy engine fails open", func(t *testing.T) {
+		engine := &policyEngineStub{err: errors.New("connection refused")}
+		_, err := newClaim(engine, config.PolicyEngine{FailOpen: true}).CreateCredential(ctx, newRequest())
+		assert.NotErrorIs(t, err, services.ErrPolicyUnavailable)
+	})
+}
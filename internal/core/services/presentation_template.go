@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var presentationTemplateNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+var (
+	ErrPresentationTemplateNotFound         = errors.New("presentation template not found")                                       // ErrPresentationTemplateNotFound the template does not exist
+	ErrPresentationTemplateInvalidName      = errors.New("presentation template name must be 1 to 64 letters, digits, _ or -")    // ErrPresentationTemplateInvalidName the template name is not valid
+	ErrPresentationTemplateInvalidSchema    = errors.New("presentation template schema must be a valid url")                      // ErrPresentationTemplateInvalidSchema the schema context is not an url
+	ErrPresentationTemplateEmptyType        = errors.New("presentation template credential type cannot be empty")                 // ErrPresentationTemplateEmptyType the credential type is mandatory
+	ErrPresentationTemplateInvalidProofType = errors.New("unsupported presentation template proof type")                          // ErrPresentationTemplateInvalidProofType the proof type has no circuit
+	ErrPresentationTemplateInvalidIssuer    = errors.New("presentation template allowed issuers must be dids or *")               // ErrPresentationTemplateInvalidIssuer an allowed issuer is not a did
+	ErrPresentationTemplateEmpty            = errors.New("presentation template needs at least a condition or a disclosed field") // ErrPresentationTemplateEmpty the template requests nothing
+	ErrPresentationTemplateInvalidCondition = errors.New("invalid presentation template condition")                               // ErrPresentationTemplateInvalidCondition a condition has no field, an unknown operator or a wrong value
+	ErrPresentationTemplateRepeatedField    = errors.New("presentation template field is requested more than once")               // ErrPresentationTemplateRepeatedField a field is disclosed and also used in a condition, or disclosed twice
+)
+
+type presentationTemplate struct {
+	repo    ports.PresentationTemplateRepository
+	storage *db.Storage
+}
+
+// NewPresentationTemplate returns a new presentation template service
+func NewPresentationTemplate(repo ports.PresentationTemplateRepository, storage *db.Storage) ports.PresentationTemplateService {
+	return &presentationTemplate{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Create validates and stores a new presentation template
+func (s *presentationTemplate) Create(ctx context.Context, issuerDID w3c.DID, req ports.CreatePresentationTemplateRequest) (*domain.PresentationTemplate, error) {
+	circuitID, err := presentationCircuitID(req.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	allowedIssuers := req.AllowedIssuers
+	if len(allowedIssuers) == 0 {
+		allowedIssuers = []string{"*"}
+	}
+	template := &domain.PresentationTemplate{
+		ID:              uuid.New(),
+		IssuerDID:       issuerDID,
+		Name:            req.Name,
+		Description:     req.Description,
+		SchemaURL:       req.SchemaURL,
+		CredentialType:  strings.TrimSpace(req.CredentialType),
+		CircuitID:       circuitID,
+		AllowedIssuers:  allowedIssuers,
+		Conditions:      req.Conditions,
+		DisclosedFields: req.DisclosedFields,
+		CreatedAt:       time.Now(),
+	}
+	if err := validatePresentationTemplate(template); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// GetByName returns the presentation template of the issuer with the given name
+func (s *presentationTemplate) GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*domain.PresentationTemplate, error) {
+	template, err := s.repo.GetByName(ctx, s.storage.Pgx, issuerDID, name)
+	if errors.Is(err, repositories.ErrPresentationTemplateDoesNotExist) {
+		return nil, ErrPresentationTemplateNotFound
+	}
+	return template, err
+}
+
+// GetAll returns all the presentation templates of the issuer
+func (s *presentationTemplate) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.PresentationTemplate, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
+
+// Delete removes a presentation template that is not referenced by any link
+func (s *presentationTemplate) Delete(ctx context.Context, issuerDID w3c.DID, name string) error {
+	err := s.repo.Delete(ctx, s.storage.Pgx, issuerDID, name)
+	if errors.Is(err, repositories.ErrPresentationTemplateDoesNotExist) {
+		return ErrPresentationTemplateNotFound
+	}
+	return err
+}
+
+func presentationCircuitID(proofType string) (circuits.CircuitID, error) {
+	switch verifiable.ProofType(proofType) {
+	case "", verifiable.BJJSignatureProofType:
+		return circuits.AtomicQuerySigV2CircuitID, nil
+	case verifiable.Iden3SparseMerkleTreeProofType:
+		return circuits.AtomicQueryMTPV2CircuitID, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrPresentationTemplateInvalidProofType, proofType)
+	}
+}
+
+func validatePresentationTemplate(t *domain.PresentationTemplate) error {
+	if !presentationTemplateNameRegexp.MatchString(t.Name) {
+		return ErrPresentationTemplateInvalidName
+	}
+	if _, err := url.ParseRequestURI(t.SchemaURL); err != nil {
+		return ErrPresentationTemplateInvalidSchema
+	}
+	if t.CredentialType == "" {
+		return ErrPresentationTemplateEmptyType
+	}
+	for _, issuer := range t.AllowedIssuers {
+		if issuer == "*" {
+			continue
+		}
+		if _, err := w3c.ParseDID(issuer); err != nil {
+			return fmt.Errorf("%w: %s", ErrPresentationTemplateInvalidIssuer, issuer)
+		}
+	}
+	if len(t.Conditions) == 0 && len(t.DisclosedFields) == 0 {
+		return ErrPresentationTemplateEmpty
+	}
+
+	fields := make(map[string]bool)
+	for _, cond := range t.Conditions {
+		if err := validatePresentationCondition(cond); err != nil {
+			return err
+		}
+		fields[cond.Field] = true
+	}
+	for _, field := range t.DisclosedFields {
+		if field == "" {
+			return fmt.Errorf("%w: empty disclosed field", ErrPresentationTemplateInvalidCondition)
+		}
+		if fields[field] {
+			return fmt.Errorf("%w: %s", ErrPresentationTemplateRepeatedField, field)
+		}
+		fields[field] = true
+	}
+	return nil
+}
+
+func validatePresentationCondition(cond domain.PresentationCondition) error {
+	if cond.Field == "" {
+		return fmt.Errorf("%w: empty field", ErrPresentationTemplateInvalidCondition)
+	}
+	if !cond.Operator.IsValid() {
+		return fmt.Errorf("%w: unknown operator %s for field %s", ErrPresentationTemplateInvalidCondition, cond.Operator, cond.Field)
+	}
+	if cond.Value == nil {
+		return fmt.Errorf("%w: missing value for field %s", ErrPresentationTemplateInvalidCondition, cond.Field)
+	}
+	kind := reflect.TypeOf(cond.Value).Kind()
+	isList := kind == reflect.Slice || kind == reflect.Array
+	if cond.Operator.IsList() {
+		if !isList || reflect.ValueOf(cond.Value).Len() == 0 {
+			return fmt.Errorf("%w: operator %s needs a non empty list of values for field %s", ErrPresentationTemplateInvalidCondition, cond.Operator, cond.Field)
+		}
+		return nil
+	}
+	if isList || kind == reflect.Map {
+		return fmt.Errorf("%w: operator %s needs a single value for field %s", ErrPresentationTemplateInvalidCondition, cond.Operator, cond.Field)
+	}
+	return nil
+}
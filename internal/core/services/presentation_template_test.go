@@ -0,0 +1,61 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestPresentationTemplate_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	service := services.NewPresentationTemplate(nil, nil)
+
+	valid := func() ports.CreatePresentationTemplateRequest {
+		return ports.CreatePresentationTemplateRequest{
+			Name:           "over-18",
+			SchemaURL:      "https://schemas.example.com/kyc.jsonld",
+			CredentialType: "KYCAgeCredential",
+			Conditions:     []domain.PresentationCondition{{Field: "birthday", Operator: domain.PresentationOperatorLt, Value: 20060101}},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(req *ports.CreatePresentationTemplateRequest)
+		err    error
+	}{
+		{"invalid name", func(req *ports.CreatePresentationTemplateRequest) { req.Name = "over 18" }, services.ErrPresentationTemplateInvalidName},
+		{"invalid schema", func(req *ports.CreatePresentationTemplateRequest) { req.SchemaURL = "kyc" }, services.ErrPresentationTemplateInvalidSchema},
+		{"empty type", func(req *ports.CreatePresentationTemplateRequest) { req.CredentialType = " " }, services.ErrPresentationTemplateEmptyType},
+		{"unknown proof type", func(req *ports.CreatePresentationTemplateRequest) { req.ProofType = "RSA" }, services.ErrPresentationTemplateInvalidProofType},
+		{"invalid issuer", func(req *ports.CreatePresentationTemplateRequest) { req.AllowedIssuers = []string{"acme"} }, services.ErrPresentationTemplateInvalidIssuer},
+		{"nothing requested", func(req *ports.CreatePresentationTemplateRequest) { req.Conditions = nil }, services.ErrPresentationTemplateEmpty},
+		{"unknown operator", func(req *ports.CreatePresentationTemplateRequest) { req.Conditions[0].Operator = "$regex" }, services.ErrPresentationTemplateInvalidCondition},
+		{"missing value", func(req *ports.CreatePresentationTemplateRequest) { req.Conditions[0].Value = nil }, services.ErrPresentationTemplateInvalidCondition},
+		{"list operator with single value", func(req *ports.CreatePresentationTemplateRequest) {
+			req.Conditions[0].Operator = domain.PresentationOperatorIn
+		}, services.ErrPresentationTemplateInvalidCondition},
+		{"single operator with list value", func(req *ports.CreatePresentationTemplateRequest) {
+			req.Conditions[0].Value = []any{1, 2}
+		}, services.ErrPresentationTemplateInvalidCondition},
+		{"field disclosed and compared", func(req *ports.CreatePresentationTemplateRequest) {
+			req.DisclosedFields = []string{"birthday"}
+		}, services.ErrPresentationTemplateRepeatedField},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid()
+			tc.modify(&req)
+			_, err := service.Create(ctx, *did, req)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/jpeg" // register jpeg decoder for logos
+	"image/png"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/skip2/go-qrcode"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	// DefaultQRImageSize is the size in pixels of the rendered qr codes when no size is requested
+	DefaultQRImageSize = 512
+	minQRImageSize     = 128
+	maxQRImageSize     = 2048
+	maxQRLogoBytes     = 256 * 1024
+	qrLogoRatio        = 5 // the logo takes 1/qrLogoRatio of the qr code side
+
+	// DefaultQRSnippetImageSize is the size in pixels of the qr code embedded in the html snippets
+	DefaultQRSnippetImageSize = 256
+	qrSnippetDisplaySize      = 240
+)
+
+var (
+	ErrQRBrandingInvalidColor           = errors.New("colors must use the #RRGGBB format")           // ErrQRBrandingInvalidColor wrong color format
+	ErrQRBrandingInvalidErrorCorrection = errors.New("error correction must be one of L, M, Q or H") // ErrQRBrandingInvalidErrorCorrection unknown level
+	ErrQRBrandingInvalidLogo            = errors.New("logo must be a png or jpeg image up to 256KB") // ErrQRBrandingInvalidLogo the logo cannot be decoded or is too big
+)
+
+// ErrQRImageInvalidSize the requested image size is out of bounds
+var ErrQRImageInvalidSize = fmt.Errorf("size must be between %d and %d", minQRImageSize, maxQRImageSize)
+
+var hexColorRegexp = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+type qrBrandingService struct {
+	repo      ports.QRBrandingRepository
+	qrService ports.QrStoreService
+	storage   *db.Storage
+}
+
+// NewQRBranding returns a new qr branding service
+func NewQRBranding(repo ports.QRBrandingRepository, qrService ports.QrStoreService, storage *db.Storage) ports.QRBrandingService {
+	return &qrBrandingService{
+		repo:      repo,
+		qrService: qrService,
+		storage:   storage,
+	}
+}
+
+// Get returns the qr branding of an issuer or the default one if the issuer has not configured it
+func (q *qrBrandingService) Get(ctx context.Context, issuerDID w3c.DID) (*domain.QRBranding, error) {
+	branding, err := q.repo.GetByIssuerID(ctx, q.storage.Pgx, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrQRBrandingDoesNotExist) {
+			return domain.NewDefaultQRBranding(issuerDID), nil
+		}
+		return nil, err
+	}
+	return branding, nil
+}
+
+// Save validates and stores the qr branding of an issuer
+func (q *qrBrandingService) Save(ctx context.Context, branding *domain.QRBranding) error {
+	if !hexColorRegexp.MatchString(branding.ForegroundColor) || !hexColorRegexp.MatchString(branding.BackgroundColor) {
+		return ErrQRBrandingInvalidColor
+	}
+	if _, err := recoveryLevel(branding.ErrorCorrection); err != nil {
+		return err
+	}
+	if len(branding.Logo) > 0 {
+		if len(branding.Logo) > maxQRLogoBytes {
+			return ErrQRBrandingInvalidLogo
+		}
+		if _, _, err := image.DecodeConfig(bytes.NewReader(branding.Logo)); err != nil {
+			return ErrQRBrandingInvalidLogo
+		}
+	}
+	branding.ModifiedAt = time.Now()
+	return q.repo.Save(ctx, q.storage.Pgx, branding)
+}
+
+// RenderStoredQR renders as a png image the link to a qr code body previously stored in the qr store.
+// The branding of the issuer that created the qr code is applied.
+func (q *qrBrandingService) RenderStoredQR(ctx context.Context, hostURL string, id uuid.UUID, size int) ([]byte, error) {
+	if size < minQRImageSize || size > maxQRImageSize {
+		return nil, ErrQRImageInvalidSize
+	}
+
+	body, err := q.qrService.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := q.issuerBranding(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderQR(q.qrService.ToURL(hostURL, id), branding, size)
+}
+
+// qrSnippetTemplate only uses inline styles and tables, as most email clients ignore style sheets
+var qrSnippetTemplate = template.Must(template.New("snippet").Parse(`<table role="presentation" cellpadding="0" cellspacing="0" border="0" style="border-collapse:collapse;margin:0 auto;font-family:Helvetica,Arial,sans-serif;color:#1E1E2F;text-align:center;">
+<tr><td style="padding:16px 16px 8px 16px;font-size:18px;font-weight:bold;">{{.Title}}</td></tr>
+{{- if .IssuerName}}
+<tr><td style="padding:0 16px 8px 16px;font-size:14px;color:#6B6B7B;">{{.IssuerName}}</td></tr>
+{{- end}}
+<tr><td style="padding:8px 16px;"><a href="{{.Link}}"><img src="{{.Image}}" width="{{.Size}}" height="{{.Size}}" alt="Scan this QR code with your wallet" style="display:block;margin:0 auto;border:0;"></a></td></tr>
+<tr><td style="padding:8px 16px;"><a href="{{.Link}}" style="display:inline-block;padding:10px 20px;border-radius:4px;background-color:{{.ButtonColor}};color:#FFFFFF;font-size:14px;font-weight:bold;text-decoration:none;">Open in your wallet</a></td></tr>
+{{- if .ExpiresAt}}
+<tr><td style="padding:8px 16px 16px 16px;font-size:12px;color:#6B6B7B;">This offer expires on {{.ExpiresAt}}.</td></tr>
+{{- end}}
+</table>
+`))
+
+// RenderHTMLSnippet returns a self contained html fragment with the qr code of the link, inlined as a png data uri,
+// a button with the deep link and the expiration of the offer, rendered with the branding of the issuer.
+func (q *qrBrandingService) RenderHTMLSnippet(ctx context.Context, issuerDID w3c.DID, snippet domain.QRSnippet, size int) ([]byte, error) {
+	if size < minQRImageSize || size > maxQRImageSize {
+		return nil, ErrQRImageInvalidSize
+	}
+	if !strings.HasPrefix(snippet.Link, "iden3comm://") && !strings.HasPrefix(snippet.Link, "https://") && !strings.HasPrefix(snippet.Link, "http://") {
+		return nil, fmt.Errorf("unsupported qr snippet link: %s", snippet.Link)
+	}
+
+	branding, err := q.Get(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	img, err := renderQR(snippet.Link, branding, size)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		IssuerName  string
+		Title       string
+		Link        template.URL
+		Image       template.URL
+		Size        int
+		ButtonColor string
+		ExpiresAt   string
+	}{
+		IssuerName: snippet.IssuerName,
+		Title:      snippet.Title,
+		// Both urls are built by the issuer, html/template would reject the iden3comm and data schemes otherwise
+		Link:        template.URL(snippet.Link),
+		Image:       template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(img)),
+		Size:        qrSnippetDisplaySize,
+		ButtonColor: branding.ForegroundColor,
+	}
+	if snippet.ExpiresAt != nil {
+		data.ExpiresAt = snippet.ExpiresAt.UTC().Format("January 2, 2006 15:04 MST")
+	}
+
+	var buf bytes.Buffer
+	if err := qrSnippetTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// issuerBranding returns the branding of the issuer in the from field of the qr code body
+func (q *qrBrandingService) issuerBranding(ctx context.Context, body []byte) (*domain.QRBranding, error) {
+	var message struct {
+		From string `json:"from"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil || message.From == "" {
+		return domain.NewDefaultQRBranding(w3c.DID{}), nil
+	}
+	issuerDID, err := w3c.ParseDID(message.From)
+	if err != nil {
+		log.Warn(ctx, "qr code body with an invalid from field", "from", message.From)
+		return domain.NewDefaultQRBranding(w3c.DID{}), nil
+	}
+	return q.Get(ctx, *issuerDID)
+}
+
+func renderQR(content string, branding *domain.QRBranding, size int) ([]byte, error) {
+	img, err := renderQRImage(content, branding, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderQRImage renders the qr code of the content with the branding colors and logo
+func renderQRImage(content string, branding *domain.QRBranding, size int) (image.Image, error) {
+	level, err := recoveryLevel(branding.ErrorCorrection)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, err
+	}
+	if qr.ForegroundColor, err = parseHexColor(branding.ForegroundColor); err != nil {
+		return nil, err
+	}
+	if qr.BackgroundColor, err = parseHexColor(branding.BackgroundColor); err != nil {
+		return nil, err
+	}
+
+	var img image.Image = qr.Image(size)
+	if len(branding.Logo) > 0 {
+		logo, _, err := image.Decode(bytes.NewReader(branding.Logo))
+		if err != nil {
+			return nil, ErrQRBrandingInvalidLogo
+		}
+		img = embedLogo(img, logo)
+	}
+	return img, nil
+}
+
+// embedLogo draws the logo, scaled to fit, in the center of the qr code
+func embedLogo(qr image.Image, logo image.Image) image.Image {
+	bounds := qr.Bounds()
+	canvas := image.NewRGBA(bounds)
+	draw.Draw(canvas, bounds, qr, bounds.Min, draw.Src)
+
+	side := bounds.Dx() / qrLogoRatio
+	scaled := scaleImage(logo, side)
+	sb := scaled.Bounds()
+	offset := image.Pt(bounds.Min.X+(bounds.Dx()-sb.Dx())/2, bounds.Min.Y+(bounds.Dy()-sb.Dy())/2)
+	draw.Draw(canvas, sb.Add(offset), scaled, sb.Min, draw.Over)
+	return canvas
+}
+
+// scaleImage resizes the image with nearest neighbour interpolation, keeping the aspect ratio, to fit in a square
+// of the given side.
+func scaleImage(src image.Image, side int) image.Image {
+	sb := src.Bounds()
+	w, h := side, side
+	if sb.Dx() > sb.Dy() {
+		h = side * sb.Dy() / sb.Dx()
+	} else if sb.Dy() > sb.Dx() {
+		w = side * sb.Dx() / sb.Dy()
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.Set(x, y, src.At(sb.Min.X+x*sb.Dx()/w, sb.Min.Y+y*sb.Dy()/h))
+		}
+	}
+	return dst
+}
+
+func recoveryLevel(level domain.QRErrorCorrection) (qrcode.RecoveryLevel, error) {
+	switch level {
+	case domain.QRErrorCorrectionLow:
+		return qrcode.Low, nil
+	case domain.QRErrorCorrectionMedium:
+		return qrcode.Medium, nil
+	case domain.QRErrorCorrectionHigh:
+		return qrcode.High, nil
+	case domain.QRErrorCorrectionHighest:
+		return qrcode.Highest, nil
+	}
+	return 0, ErrQRBrandingInvalidErrorCorrection
+}
+
+func parseHexColor(s string) (color.Color, error) {
+	if !hexColorRegexp.MatchString(s) {
+		return nil, ErrQRBrandingInvalidColor
+	}
+	var r, g, b uint8
+	if _, err := fmt.Sscanf(s, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		return nil, ErrQRBrandingInvalidColor
+	}
+	return color.RGBA{R: r, G: g, B: b, A: 0xff}, nil
+}
@@ -0,0 +1,93 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+func TestQRBranding_RenderStoredQR(t *testing.T) {
+	ctx := context.Background()
+	qrService := services.NewQrStoreService(cache.NewMemoryCache())
+	brandingService := services.NewQRBranding(nil, qrService, nil)
+
+	id, err := qrService.Store(ctx, []byte(`{"body":{}}`), services.DefaultQRBodyTTL)
+	require.NoError(t, err)
+
+	t.Run("should render the default qr", func(t *testing.T) {
+		raw, err := brandingService.RenderStoredQR(ctx, "https://issuer.example.com", id, services.DefaultQRImageSize)
+		require.NoError(t, err)
+		img, err := png.Decode(bytes.NewReader(raw))
+		require.NoError(t, err)
+		assert.Equal(t, services.DefaultQRImageSize, img.Bounds().Dx())
+	})
+
+	t.Run("should fail with an invalid size", func(t *testing.T) {
+		_, err := brandingService.RenderStoredQR(ctx, "https://issuer.example.com", id, 10)
+		assert.ErrorIs(t, err, services.ErrQRImageInvalidSize)
+	})
+
+	t.Run("should fail with an unknown qr", func(t *testing.T) {
+		_, err := brandingService.RenderStoredQR(ctx, "https://issuer.example.com", uuid.New(), services.DefaultQRImageSize)
+		assert.ErrorIs(t, err, services.ErrQRCodeLinkNotFound)
+	})
+}
+
+type qrBrandingRepositoryStub struct{}
+
+func (qrBrandingRepositoryStub) Save(context.Context, db.Querier, *domain.QRBranding) error {
+	return nil
+}
+
+func (qrBrandingRepositoryStub) GetByIssuerID(context.Context, db.Querier, w3c.DID) (*domain.QRBranding, error) {
+	return nil, repositories.ErrQRBrandingDoesNotExist
+}
+
+func TestQRBranding_RenderHTMLSnippet(t *testing.T) {
+	ctx := context.Background()
+	brandingService := services.NewQRBranding(qrBrandingRepositoryStub{}, services.NewQrStoreService(cache.NewMemoryCache()), &db.Storage{})
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	expiresAt := time.Date(2030, time.January, 2, 15, 4, 0, 0, time.UTC)
+
+	t.Run("should render the snippet with the inline qr", func(t *testing.T) {
+		snippet := domain.QRSnippet{
+			IssuerName: "ACME <Issuer>",
+			Title:      "Receive your KYCAgeCredential credential",
+			Link:       "iden3comm://?request_uri=https://issuer.example.com/v1/qr-store?id=" + uuid.NewString(),
+			ExpiresAt:  &expiresAt,
+		}
+		raw, err := brandingService.RenderHTMLSnippet(ctx, *issuerDID, snippet, services.DefaultQRSnippetImageSize)
+		require.NoError(t, err)
+		html := string(raw)
+		assert.Contains(t, html, `href="`+snippet.Link+`"`)
+		assert.Contains(t, html, `src="data:image/png;base64,`)
+		assert.Contains(t, html, "ACME &lt;Issuer&gt;")
+		assert.Contains(t, html, "This offer expires on January 2, 2030 15:04 UTC.")
+	})
+
+	t.Run("should omit the expiration notice", func(t *testing.T) {
+		raw, err := brandingService.RenderHTMLSnippet(ctx, *issuerDID, domain.QRSnippet{Title: "Offer", Link: "https://issuer.example.com/offer"}, services.DefaultQRSnippetImageSize)
+		require.NoError(t, err)
+		assert.False(t, strings.Contains(string(raw), "expires"))
+	})
+
+	t.Run("should fail with an unsupported link", func(t *testing.T) {
+		_, err := brandingService.RenderHTMLSnippet(ctx, *issuerDID, domain.QRSnippet{Title: "Offer", Link: "javascript:alert(1)"}, services.DefaultQRSnippetImageSize)
+		assert.Error(t, err)
+	})
+}
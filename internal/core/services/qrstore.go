@@ -1,9 +1,14 @@
 package services
 
 import (
+	"bytes"
+	"compress/flate"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"sync"
 	"time"
 
@@ -16,8 +21,13 @@ import (
 // DefaultQRBodyTTL is the default time to live for a QRcode body
 const DefaultQRBodyTTL = 30 * 24 * time.Hour
 
-// ErrQRCodeLinkNotFound is the error returned when a QR code link is not found in the QR storage
-var ErrQRCodeLinkNotFound = errors.New("qr code link not found")
+// QREmbeddedCompression is the value of the c parameter of the embedded links with a deflated message
+const QREmbeddedCompression = "deflate"
+
+var (
+	ErrQRCodeLinkNotFound   = errors.New("qr code link not found")        // ErrQRCodeLinkNotFound is the error returned when a QR code link is not found in the QR storage
+	ErrQRInvalidEmbeddedURL = errors.New("invalid embedded qr code link") // ErrQRInvalidEmbeddedURL is the error returned when a link has no valid embedded message
+)
 
 // QrStoreService implements the ports.QrStoreService interface.
 // It provides methods to store and retrieve the body of QR codes and to provide support
@@ -63,6 +73,73 @@ func (s *QrStoreService) ToURL(hostURL string, id uuid.UUID) string {
 	return fmt.Sprintf("iden3comm://?request_uri=%s/v1/qr-store?id=%s", hostURL, id.String())
 }
 
+// ToEmbeddedURL constructs a url with the body of a QR code embedded in the i_m parameter, base64url encoded.
+// With compress, the body is deflated before encoding it and the url gets the c=deflate parameter.
+func (s *QrStoreService) ToEmbeddedURL(qrCode []byte, compress bool) (string, error) {
+	if !compress {
+		return "iden3comm://?i_m=" + base64.RawURLEncoding.EncodeToString(qrCode), nil
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(qrCode); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("iden3comm://?i_m=%s&c=%s", base64.RawURLEncoding.EncodeToString(buf.Bytes()), QREmbeddedCompression), nil
+}
+
+// SelectURL returns the shortest url that wallets can scan for the body of a QR code already stored with the given id.
+// The body is embedded when the url is not longer than maxLength, trying the compressed url if compress is enabled.
+// Otherwise, it returns the url pointing to the QR store.
+func (s *QrStoreService) SelectURL(hostURL string, id uuid.UUID, qrCode []byte, maxLength int, compress bool) (string, error) {
+	embedded, err := s.ToEmbeddedURL(qrCode, false)
+	if err != nil {
+		return "", err
+	}
+	if len(embedded) <= maxLength {
+		return embedded, nil
+	}
+	if compress {
+		if embedded, err = s.ToEmbeddedURL(qrCode, true); err != nil {
+			return "", err
+		}
+		if len(embedded) <= maxLength {
+			return embedded, nil
+		}
+	}
+	return s.ToURL(hostURL, id), nil
+}
+
+// FromEmbeddedURL returns the body of a QR code embedded in a url built with ToEmbeddedURL
+func FromEmbeddedURL(link string) ([]byte, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrQRInvalidEmbeddedURL, err)
+	}
+	query := u.Query()
+	body, err := base64.RawURLEncoding.DecodeString(query.Get("i_m"))
+	if err != nil || len(body) == 0 {
+		return nil, fmt.Errorf("%w: missing or malformed i_m parameter", ErrQRInvalidEmbeddedURL)
+	}
+	switch query.Get("c") {
+	case "":
+		return body, nil
+	case QREmbeddedCompression:
+		raw, err := io.ReadAll(flate.NewReader(bytes.NewReader(body)))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrQRInvalidEmbeddedURL, err)
+		}
+		return raw, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported compression %s", ErrQRInvalidEmbeddedURL, query.Get("c"))
+	}
+}
+
 func (s *QrStoreService) key(id uuid.UUID) string {
 	return "issuer-node:qr-code:" + id.String()
 }
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const revocationCascadeRevoked = "revoked with its prerequisite credential %s"
+
+type revocationCascade struct {
+	repo             ports.CredentialDependencyRepository
+	claimsRepository ports.ClaimsRepository
+	claimsService    ports.ClaimsService
+	publisher        pubsub.Publisher
+	storage          *db.Storage
+}
+
+// NewRevocationCascade returns the service that revokes the credentials issued by links with prerequisites when a
+// prerequisite credential they depend on is revoked
+func NewRevocationCascade(repo ports.CredentialDependencyRepository, claimsRepository ports.ClaimsRepository, claimsService ports.ClaimsService, publisher pubsub.Publisher, storage *db.Storage) ports.RevocationCascadeService {
+	return &revocationCascade{
+		repo:             repo,
+		claimsRepository: claimsRepository,
+		claimsService:    claimsService,
+		publisher:        publisher,
+		storage:          storage,
+	}
+}
+
+// Preview returns the active credentials that would be revoked along with the credential, without revoking anything
+func (s *revocationCascade) Preview(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialDependent, error) {
+	if _, err := s.claimsService.GetByID(ctx, &issuerDID, credentialID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDependents(ctx, s.storage.Pgx, issuerDID, credentialID)
+}
+
+// Request asks the background worker to revoke the dependents of the credential with the revocation nonce
+func (s *revocationCascade) Request(ctx context.Context, issuerDID w3c.DID, nonce uint64) error {
+	credentials, err := s.claimsRepository.GetByRevocationNonce(ctx, s.storage.Pgx, &issuerDID, domain.RevNonceUint64(nonce))
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		err := s.publisher.Publish(ctx, event.RevokeDependentsEvent, &event.RevokeDependents{CredentialID: credential.ID.String(), IssuerID: issuerDID.String()})
+		if err != nil {
+			log.Error(ctx, "publish RevokeDependentsEvent", "err", err, "credential", credential.ID)
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeDependents revokes, in a single transaction, the active credentials that depend on the credential of the event
+func (s *revocationCascade) RevokeDependents(ctx context.Context, payload pubsub.Message) error {
+	var ev event.RevokeDependents
+	if err := ev.Unmarshal(payload); err != nil {
+		return errors.New("revokeDependents unexpected data type")
+	}
+
+	issuerDID, err := w3c.ParseDID(ev.IssuerID)
+	if err != nil {
+		log.Error(ctx, "revokeDependents: failed to parse issuerID", "err", err, "issuerID", ev.IssuerID)
+		return err
+	}
+	credID, err := uuid.Parse(ev.CredentialID)
+	if err != nil {
+		log.Error(ctx, "revokeDependents: failed to parse credentialID", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+
+	dependents, err := s.repo.GetDependents(ctx, s.storage.Pgx, *issuerDID, credID)
+	if err != nil {
+		log.Error(ctx, "revokeDependents: loading the dependents", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	err = s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, dependent := range dependents {
+			if err := s.claimsService.RevokeWithConn(ctx, tx, *issuerDID, uint64(dependent.RevNonce), fmt.Sprintf(revocationCascadeRevoked, dependent.PrerequisiteID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "revokeDependents: revoking the dependents", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+	for _, dependent := range dependents {
+		log.Info(ctx, "audit: credential revoked with its prerequisite", "credentialID", dependent.ID, "prerequisiteID", dependent.PrerequisiteID, "rootID", ev.CredentialID, "issuerID", ev.IssuerID)
+	}
+	return nil
+}
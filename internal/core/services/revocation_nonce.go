@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/rand"
+)
+
+// maxRevocationNonceAttempts is the number of nonces generated before giving up when all of them collide
+const maxRevocationNonceAttempts = 10
+
+// maxRevocationNonceIntegrationLength is the max length of the name of the integration of a reserved range
+const maxRevocationNonceIntegrationLength = 255
+
+var (
+	ErrRevocationNonceCollision      = errors.New("the revocation nonce is already used")                   // ErrRevocationNonceCollision the nonce is already used in the collision scope
+	ErrRevocationNonceRangeNotFound  = errors.New("revocation nonce range not found")                       // ErrRevocationNonceRangeNotFound the range is not reserved by the issuer
+	ErrRevocationNonceRangeExhausted = errors.New("all the nonces of the revocation nonce range were used") // ErrRevocationNonceRangeExhausted the range has no nonces left
+	ErrRevocationNoncesExhausted     = errors.New("no revocation nonces left")                              // ErrRevocationNoncesExhausted there is no room for more nonces
+	ErrRevocationNonceIntegration    = errors.New("the integration name is required, up to 255 characters") // ErrRevocationNonceIntegration the integration of the range is not valid
+	ErrRevocationNonceRangeSize      = errors.New("invalid revocation nonce range size")                    // ErrRevocationNonceRangeSize the size of the range is not valid
+)
+
+type revocationNonce struct {
+	repository ports.RevocationNonceRepository
+	storage    *db.Storage
+	cfg        config.RevocationNonces
+}
+
+// NewRevocationNonce returns a new revocation nonce service
+func NewRevocationNonce(repository ports.RevocationNonceRepository, storage *db.Storage, cfg config.RevocationNonces) ports.RevocationNonceService {
+	return &revocationNonce{
+		repository: repository,
+		storage:    storage,
+		cfg:        cfg,
+	}
+}
+
+// Next returns the nonce of a new credential of the issuer: the next one of the reserved range when rangeID is set,
+// otherwise a random or a sequential one as configured. The nonces already used in the collision scope are skipped.
+func (s *revocationNonce) Next(ctx context.Context, issuerDID w3c.DID, rangeID *uuid.UUID) (uint64, error) {
+	for attempt := 0; attempt < maxRevocationNonceAttempts; attempt++ {
+		nonce, err := s.generate(ctx, issuerDID, rangeID)
+		if err != nil {
+			return 0, err
+		}
+		err = s.Check(ctx, issuerDID, nonce)
+		if errors.Is(err, ErrRevocationNonceCollision) {
+			log.Warn(ctx, "revocation nonce collision, generating another one", "issuer", issuerDID.String(), "nonce", nonce)
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return nonce, nil
+	}
+	log.Error(ctx, "cannot generate a free revocation nonce", "issuer", issuerDID.String(), "attempts", maxRevocationNonceAttempts)
+	return 0, ErrRevocationNonceCollision
+}
+
+// Check returns ErrRevocationNonceCollision if a credential or a revocation in the collision scope has the nonce
+func (s *revocationNonce) Check(ctx context.Context, issuerDID w3c.DID, nonce uint64) error {
+	var scope *w3c.DID
+	switch s.cfg.CollisionScope {
+	case config.RevocationNonceCollisionsIssuer:
+		scope = &issuerDID
+	case config.RevocationNonceCollisionsNode:
+	default:
+		return nil
+	}
+	used, err := s.repository.Used(ctx, s.storage.Pgx, scope, nonce)
+	if err != nil {
+		return err
+	}
+	if used {
+		return fmt.Errorf("%w: %d", ErrRevocationNonceCollision, nonce)
+	}
+	return nil
+}
+
+// ReserveRange reserves size nonces for an integration that generates its own claims. The ranges never overlap, even
+// between the issuers of the node, and the nonces generated by the issuer never fall in them.
+func (s *revocationNonce) ReserveRange(ctx context.Context, issuerDID w3c.DID, integration string, size uint64) (*domain.RevocationNonceRange, error) {
+	integration = strings.TrimSpace(integration)
+	if integration == "" || len(integration) > maxRevocationNonceIntegrationLength {
+		return nil, ErrRevocationNonceIntegration
+	}
+	if size < 1 || size > s.cfg.MaxRangeSize {
+		return nil, fmt.Errorf("%w: the size must be between 1 and %d", ErrRevocationNonceRangeSize, s.cfg.MaxRangeSize)
+	}
+
+	var nonceRange *domain.RevocationNonceRange
+	err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		nonceRange, err = s.repository.ReserveRange(ctx, tx, issuerDID, integration, size)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrRevocationNonceSpaceExhausted) {
+			return nil, ErrRevocationNoncesExhausted
+		}
+		log.Error(ctx, "reserving revocation nonce range", "err", err, "integration", integration)
+		return nil, err
+	}
+	log.Info(ctx, "audit: revocation nonce range reserved", "issuer", issuerDID.String(), "integration", integration, "start", uint64(nonceRange.Start), "end", uint64(nonceRange.End))
+	return nonceRange, nil
+}
+
+// GetRanges returns the ranges reserved by the issuer
+func (s *revocationNonce) GetRanges(ctx context.Context, issuerDID w3c.DID) ([]domain.RevocationNonceRange, error) {
+	return s.repository.GetRanges(ctx, s.storage.Pgx, issuerDID)
+}
+
+func (s *revocationNonce) generate(ctx context.Context, issuerDID w3c.DID, rangeID *uuid.UUID) (uint64, error) {
+	var nonce uint64
+	var err error
+	switch {
+	case rangeID != nil:
+		nonce, err = s.repository.NextInRange(ctx, s.storage.Pgx, issuerDID, *rangeID)
+	case s.cfg.Mode == config.RevocationNonceSequential:
+		nonce, err = s.repository.NextSequential(ctx, s.storage.Pgx, issuerDID)
+	default:
+		return rand.Int64()
+	}
+	switch {
+	case errors.Is(err, repositories.ErrRevocationNonceRangeDoesNotExist):
+		return 0, ErrRevocationNonceRangeNotFound
+	case errors.Is(err, repositories.ErrRevocationNonceRangeExhausted):
+		return 0, ErrRevocationNonceRangeExhausted
+	case errors.Is(err, repositories.ErrRevocationNonceSpaceExhausted):
+		return 0, ErrRevocationNoncesExhausted
+	}
+	return nonce, err
+}
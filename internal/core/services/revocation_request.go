@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	maxRevocationRequestReason   = 500
+	revocationRequestDescription = "revoked at the request of the holder"
+)
+
+var (
+	ErrRevocationRequestNotFound = errors.New("revocation request not found")                                                // ErrRevocationRequestNotFound the revocation request does not exist
+	ErrRevocationRequestInvalid  = errors.New("the reason of the revocation request is too long")                            // ErrRevocationRequestInvalid the reason is too long
+	ErrSelfRevocationDisabled    = errors.New("the holders cannot request the revocation of the credentials of this schema") // ErrSelfRevocationDisabled the schema does not accept revocation requests
+	ErrCredentialRevokedAlready  = errors.New("the credential is revoked already")                                           // ErrCredentialRevokedAlready the credential is revoked
+	ErrRevocationRequestPending  = repositories.ErrRevocationRequestPending                                                  // ErrRevocationRequestPending the credential has a pending revocation request
+	ErrRevocationRequestResolved = repositories.ErrRevocationRequestResolved                                                 // ErrRevocationRequestResolved the revocation request is not pending
+)
+
+type revocationRequest struct {
+	repo             ports.RevocationRequestRepository
+	claimsService    ports.ClaimsService
+	schemaRepository ports.SchemaRepository
+	storage          *db.Storage
+}
+
+// NewRevocationRequest returns a new revocation request service
+func NewRevocationRequest(repo ports.RevocationRequestRepository, claimsService ports.ClaimsService, schemaRepository ports.SchemaRepository, storage *db.Storage) ports.RevocationRequestService {
+	return &revocationRequest{
+		repo:             repo,
+		claimsService:    claimsService,
+		schemaRepository: schemaRepository,
+		storage:          storage,
+	}
+}
+
+// Request stores the request of the session holder to revoke one of their credentials. It follows the self
+// revocation policy of the schema of the credential: with auto, the credential is revoked and the request approved
+// at once, with approval, the request waits for the operator, and otherwise, it is rejected.
+func (s *revocationRequest) Request(ctx context.Context, session *domain.HolderSession, credentialID uuid.UUID, reason string) (*domain.RevocationRequest, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxRevocationRequestReason {
+		return nil, ErrRevocationRequestInvalid
+	}
+	claim, err := s.claimsService.GetByID(ctx, &session.IssuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if claim.OtherIdentifier != session.UserDID.String() {
+		return nil, ErrClaimNotFound
+	}
+	if claim.Revoked {
+		return nil, ErrCredentialRevokedAlready
+	}
+
+	policy, err := s.selfRevocationPolicy(ctx, session.IssuerDID, claim)
+	if err != nil {
+		return nil, err
+	}
+	request := domain.NewRevocationRequest(session.IssuerDID, credentialID, session.UserDID, reason)
+	switch policy {
+	case domain.SchemaSelfRevocationApproval:
+		if err := s.repo.Save(ctx, s.storage.Pgx, request); err != nil {
+			return nil, err
+		}
+		log.Info(ctx, "audit: credential revocation requested by the holder", "id", request.ID, "credential", credentialID, "user", session.UserDID.String())
+	case domain.SchemaSelfRevocationAuto:
+		request.AutoApproved = true
+		request.Resolve(domain.RevocationRequestStatusApproved, nil, time.Now().UTC())
+		err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			if err := s.repo.Save(ctx, tx, request); err != nil {
+				return err
+			}
+			return s.claimsService.RevokeWithConn(ctx, tx, session.IssuerDID, uint64(claim.RevNonce), revocationDescription(reason))
+		})
+		if err != nil {
+			log.Error(ctx, "revoking credential requested by the holder", "err", err, "id", request.ID, "credential", credentialID)
+			return nil, err
+		}
+		log.Info(ctx, "audit: credential revoked at the request of the holder", "id", request.ID, "credential", credentialID, "user", session.UserDID.String())
+	default:
+		return nil, ErrSelfRevocationDisabled
+	}
+	return request, nil
+}
+
+// GetAll returns the revocation requests of the issuer, optionally filtered by status
+func (s *revocationRequest) GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.RevocationRequestStatus) ([]domain.RevocationRequest, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID, status)
+}
+
+// Approve revokes the credential of a pending revocation request. The request is resolved in the same transaction,
+// so it is only approved if the credential is revoked, and only once.
+func (s *revocationRequest) Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, note *string) (*domain.RevocationRequest, error) {
+	request, err := s.getPending(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := s.claimsService.GetByID(ctx, &issuerDID, request.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+	request.Resolve(domain.RevocationRequestStatusApproved, note, time.Now().UTC())
+	err = s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := s.repo.Resolve(ctx, tx, request); err != nil {
+			return err
+		}
+		if claim.Revoked {
+			return nil
+		}
+		return s.claimsService.RevokeWithConn(ctx, tx, issuerDID, uint64(claim.RevNonce), revocationDescription(request.Reason))
+	})
+	if err != nil {
+		log.Error(ctx, "approving revocation request", "err", err, "id", id)
+		return nil, err
+	}
+	return request, nil
+}
+
+// Reject resolves a pending revocation request keeping the credential
+func (s *revocationRequest) Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, note *string) (*domain.RevocationRequest, error) {
+	request, err := s.getPending(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	request.Resolve(domain.RevocationRequestStatusRejected, note, time.Now().UTC())
+	if err := s.repo.Resolve(ctx, s.storage.Pgx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *revocationRequest) getPending(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRequest, error) {
+	request, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRevocationRequestDoesNotExist) {
+			return nil, ErrRevocationRequestNotFound
+		}
+		return nil, err
+	}
+	if request.Status != domain.RevocationRequestStatusPending {
+		return nil, ErrRevocationRequestResolved
+	}
+	return request, nil
+}
+
+// selfRevocationPolicy returns the policy of the schema of the credential imported by the issuer, disabled if the
+// schema has not been imported
+func (s *revocationRequest) selfRevocationPolicy(ctx context.Context, issuerDID w3c.DID, claim *domain.Claim) (domain.SchemaSelfRevocation, error) {
+	schemaType := claim.SchemaType
+	if _, typ, found := strings.Cut(schemaType, "#"); found {
+		schemaType = typ
+	}
+	schema, err := s.schemaRepository.GetByURL(ctx, issuerDID, claim.SchemaURL, schemaType)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return domain.SchemaSelfRevocationDisabled, nil
+	}
+	if err != nil {
+		log.Error(ctx, "getting the schema of the credential", "err", err, "schema", claim.SchemaURL, "type", schemaType)
+		return "", err
+	}
+	return schema.SelfRevocationPolicy(), nil
+}
+
+func revocationDescription(reason string) string {
+	if reason == "" {
+		return revocationRequestDescription
+	}
+	return fmt.Sprintf("%s: %s", revocationRequestDescription, reason)
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cron"
+)
+
+const (
+	maxRevocationRuleName       = 200
+	revocationRulePreviewedRuns = 5
+)
+
+var (
+	ErrRevocationRuleNotFound = errors.New("revocation rule not found") // ErrRevocationRuleNotFound the revocation rule does not exist
+	ErrRevocationRuleInvalid  = errors.New("invalid revocation rule")   // ErrRevocationRuleInvalid the name, the schema type, the cron expression or the webhook are not valid
+)
+
+type revocationRule struct {
+	repo          ports.RevocationRuleRepository
+	claimsService ports.ClaimsService
+	webhook       ports.RevocationRuleWebhook
+	storage       *db.Storage
+}
+
+// NewRevocationRule returns a new revocation rule service
+func NewRevocationRule(repo ports.RevocationRuleRepository, claimsService ports.ClaimsService, webhook ports.RevocationRuleWebhook, storage *db.Storage) ports.RevocationRuleService {
+	return &revocationRule{
+		repo:          repo,
+		claimsService: claimsService,
+		webhook:       webhook,
+		storage:       storage,
+	}
+}
+
+// Create validates and stores a new enabled revocation rule, scheduling its first run
+func (s *revocationRule) Create(ctx context.Context, issuerDID w3c.DID, req ports.RevocationRuleRequest) (*domain.RevocationRule, error) {
+	var name, schemaType, cronExpression string
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+	}
+	if req.SchemaType != nil {
+		schemaType = strings.TrimSpace(*req.SchemaType)
+	}
+	if req.CronExpression != nil {
+		cronExpression = strings.TrimSpace(*req.CronExpression)
+	}
+	rule := domain.NewRevocationRule(issuerDID, name, schemaType, cronExpression, req.WebhookURL)
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := scheduleRevocationRule(rule, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Update modifies the fields of the request that are not nil and schedules again the next run. An empty webhook
+// removes it.
+func (s *revocationRule) Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req ports.RevocationRuleRequest) (*domain.RevocationRule, error) {
+	rule, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.SchemaType != nil {
+		rule.SchemaType = strings.TrimSpace(*req.SchemaType)
+	}
+	if req.CronExpression != nil {
+		rule.CronExpression = strings.TrimSpace(*req.CronExpression)
+	}
+	if req.WebhookURL != nil {
+		rule.WebhookURL = req.WebhookURL
+		if *req.WebhookURL == "" {
+			rule.WebhookURL = nil
+		}
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := scheduleRevocationRule(rule, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, s.storage.Pgx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// GetByID returns the revocation rule of the issuer
+func (s *revocationRule) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRule, error) {
+	rule, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrRevocationRuleDoesNotExist) {
+		return nil, ErrRevocationRuleNotFound
+	}
+	return rule, err
+}
+
+// GetAll returns all the revocation rules of the issuer
+func (s *revocationRule) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.RevocationRule, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
+
+// Delete removes the revocation rule
+func (s *revocationRule) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	err := s.repo.Delete(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrRevocationRuleDoesNotExist) {
+		return ErrRevocationRuleNotFound
+	}
+	return err
+}
+
+// Preview returns the credentials the rule would revoke if it ran now and its next runs, without revoking anything
+func (s *revocationRule) Preview(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRulePreview, error) {
+	rule, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	credentials, err := s.repo.GetCredentials(ctx, s.storage.Pgx, issuerDID, rule.SchemaType, now)
+	if err != nil {
+		log.Error(ctx, "getting the credentials of the revocation rule", "err", err, "rule", id)
+		return nil, err
+	}
+	preview := &domain.RevocationRulePreview{
+		Rule:          rule,
+		CredentialIDs: make([]uuid.UUID, len(credentials)),
+		NextRuns:      []time.Time{},
+	}
+	for i, credential := range credentials {
+		preview.CredentialIDs[i] = credential.ID
+	}
+	if rule.Enabled {
+		if preview.NextRuns, err = revocationRuleNextRuns(rule.CronExpression, now, revocationRulePreviewedRuns); err != nil {
+			return nil, err
+		}
+	}
+	return preview, nil
+}
+
+// RunDue runs the enabled revocation rules whose next run is due. The runs missed while the scheduler was not running
+// are executed once.
+func (s *revocationRule) RunDue(ctx context.Context) {
+	now := time.Now().UTC()
+	rules, err := s.repo.GetDue(ctx, s.storage.Pgx, now)
+	if err != nil {
+		log.Error(ctx, "getting the due revocation rules", "err", err)
+		return
+	}
+	for i := range rules {
+		s.run(ctx, &rules[i], now)
+	}
+}
+
+func (s *revocationRule) run(ctx context.Context, rule *domain.RevocationRule, now time.Time) {
+	scheduledAt := *rule.NextRunAt
+	if err := scheduleRevocationRule(rule, now); err != nil {
+		log.Error(ctx, "scheduling the revocation rule", "err", err, "rule", rule.ID)
+		return
+	}
+	started, err := s.repo.StartRun(ctx, s.storage.Pgx, rule.ID, scheduledAt, rule.NextRunAt)
+	if err != nil {
+		log.Error(ctx, "starting the run of the revocation rule", "err", err, "rule", rule.ID)
+		return
+	}
+	if !started {
+		return
+	}
+
+	credentials, err := s.repo.GetCredentials(ctx, s.storage.Pgx, rule.IssuerDID, rule.SchemaType, scheduledAt)
+	if err != nil {
+		log.Error(ctx, "getting the credentials of the revocation rule", "err", err, "rule", rule.ID)
+		return
+	}
+	run := domain.RevocationRuleRun{
+		RuleID:     rule.ID,
+		Name:       rule.Name,
+		SchemaType: rule.SchemaType,
+		RunAt:      now,
+		RevokedIDs: make([]uuid.UUID, 0, len(credentials)),
+		FailedIDs:  make([]uuid.UUID, 0),
+		NextRunAt:  rule.NextRunAt,
+	}
+	description := fmt.Sprintf("revoked by the revocation rule %s", rule.Name)
+	for _, credential := range credentials {
+		if err := s.claimsService.Revoke(ctx, rule.IssuerDID, credential.RevNonce, description); err != nil {
+			log.Error(ctx, "revoking a credential of the revocation rule", "err", err, "rule", rule.ID, "credential", credential.ID)
+			run.FailedIDs = append(run.FailedIDs, credential.ID)
+			continue
+		}
+		run.RevokedIDs = append(run.RevokedIDs, credential.ID)
+	}
+	if err := s.repo.FinishRun(ctx, s.storage.Pgx, rule.ID, len(run.RevokedIDs)); err != nil {
+		log.Error(ctx, "recording the run of the revocation rule", "err", err, "rule", rule.ID)
+	}
+	log.Info(ctx, "audit: revocation rule run", "rule", rule.ID, "name", rule.Name, "schemaType", rule.SchemaType,
+		"revoked", len(run.RevokedIDs), "failed", len(run.FailedIDs), "nextRunAt", rule.NextRunAt)
+
+	if rule.WebhookURL == nil {
+		return
+	}
+	if err := s.webhook.Notify(ctx, *rule.WebhookURL, run); err != nil {
+		log.Error(ctx, "notifying the run of the revocation rule", "err", err, "rule", rule.ID)
+	}
+}
+
+// scheduleRevocationRule validates the rule and sets its next run after the given time, none if it is disabled
+func scheduleRevocationRule(rule *domain.RevocationRule, after time.Time) error {
+	if rule.Name == "" || len(rule.Name) > maxRevocationRuleName {
+		return fmt.Errorf("%w: the name must have between 1 and %d characters", ErrRevocationRuleInvalid, maxRevocationRuleName)
+	}
+	if rule.SchemaType == "" {
+		return fmt.Errorf("%w: the schema type is required", ErrRevocationRuleInvalid)
+	}
+	if rule.WebhookURL != nil {
+		u, err := url.ParseRequestURI(*rule.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: the webhook must be an http or https url", ErrRevocationRuleInvalid)
+		}
+	}
+	runs, err := revocationRuleNextRuns(rule.CronExpression, after, 1)
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return fmt.Errorf("%w: the cron expression never runs", ErrRevocationRuleInvalid)
+	}
+	rule.NextRunAt = nil
+	if rule.Enabled {
+		rule.NextRunAt = &runs[0]
+	}
+	return nil
+}
+
+// revocationRuleNextRuns returns up to n runs of the cron expression after the given time, in UTC
+func revocationRuleNextRuns(cronExpression string, after time.Time, n int) ([]time.Time, error) {
+	schedule, err := cron.Parse(cronExpression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRevocationRuleInvalid, err)
+	}
+	runs := make([]time.Time, 0, n)
+	next := after.UTC()
+	for len(runs) < n {
+		if next = schedule.Next(next); next.IsZero() {
+			break
+		}
+		runs = append(runs, next)
+	}
+	return runs, nil
+}
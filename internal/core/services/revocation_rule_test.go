@@ -0,0 +1,49 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestRevocationRule_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewRevocationRule(nil, nil, nil, nil)
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	valid := func() ports.RevocationRuleRequest {
+		return ports.RevocationRuleRequest{
+			Name:           common.ToPointer("end of the academic year"),
+			SchemaType:     common.ToPointer("StudentID"),
+			CronExpression: common.ToPointer("0 0 31 8 *"),
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(req *ports.RevocationRuleRequest)
+	}{
+		{name: "no name", modify: func(req *ports.RevocationRuleRequest) { req.Name = nil }},
+		{name: "name too long", modify: func(req *ports.RevocationRuleRequest) { req.Name = common.ToPointer(strings.Repeat("a", 201)) }},
+		{name: "no schema type", modify: func(req *ports.RevocationRuleRequest) { req.SchemaType = common.ToPointer(" ") }},
+		{name: "no cron expression", modify: func(req *ports.RevocationRuleRequest) { req.CronExpression = nil }},
+		{name: "invalid cron expression", modify: func(req *ports.RevocationRuleRequest) { req.CronExpression = common.ToPointer("0 0 32 8 *") }},
+		{name: "cron expression that never runs", modify: func(req *ports.RevocationRuleRequest) { req.CronExpression = common.ToPointer("0 0 30 2 *") }},
+		{name: "webhook not http", modify: func(req *ports.RevocationRuleRequest) { req.WebhookURL = common.ToPointer("ftp://example.com/hook") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid()
+			tc.modify(&req)
+			_, err := service.Create(ctx, *issuerDID, req)
+			assert.ErrorIs(t, err, services.ErrRevocationRuleInvalid)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
+)
+
+// RevocationStatusCacheName identifies the revocation status cache in the cache invalidation messages
+const RevocationStatusCacheName = "revocation_status"
+
+type revocationStatusCached struct {
+	ports.ClaimsService
+	cache *invalidation.Local[*verifiable.RevocationStatus]
+}
+
+// NewClaimsRevocationStatusCached decorates the claims service with an in memory cache of revocation statuses.
+// Statuses change only when a new state of the issuer is confirmed, and UpdateClaimsMTPAndState notifies it
+// to all the replicas, so ttl only bounds the staleness when a notification is lost.
+// The returned service must be registered in the invalidation.Listener with RevocationStatusCacheName.
+func NewClaimsRevocationStatusCached(claims ports.ClaimsService, ttl time.Duration) *revocationStatusCached {
+	return &revocationStatusCached{ClaimsService: claims, cache: invalidation.NewLocal[*verifiable.RevocationStatus](ttl)}
+}
+
+// GetRevocationStatus returns the cached revocation status of the nonce, loading it on a miss
+func (c *revocationStatusCached) GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error) {
+	key := revocationStatusKey(issuerDID, nonce)
+	if status, err := c.cache.Get(key); err == nil {
+		return status, nil
+	}
+	status, err := c.ClaimsService.GetRevocationStatus(ctx, issuerDID, nonce)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, status)
+	return status, nil
+}
+
+// Invalidate removes the given keys and all the keys starting with any of the prefixes
+func (c *revocationStatusCached) Invalidate(keys []string, prefixes []string) {
+	c.cache.Invalidate(keys, prefixes)
+}
+
+// Purge removes all the cached statuses
+func (c *revocationStatusCached) Purge() {
+	c.cache.Purge()
+}
+
+func revocationStatusKey(issuerDID w3c.DID, nonce uint64) string {
+	return fmt.Sprintf("%s%d", revocationStatusPrefix(issuerDID), nonce)
+}
+
+// revocationStatusPrefix is the prefix of all the cached statuses of an issuer
+func revocationStatusPrefix(issuerDID w3c.DID) string {
+	return issuerDID.String() + "/"
+}
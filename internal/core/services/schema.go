@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,15 +11,26 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
+var (
+	ErrSchemaInvalidExpiration      = errors.New("the expiration days must be positive and the default cannot exceed the maximum")                 // ErrSchemaInvalidExpiration the expiration days of the schema are not positive or the default exceeds the maximum
+	ErrSchemaInvalidUniqueness      = errors.New("the unique attributes must not be empty nor repeated and the strategy must be reject or revoke") // ErrSchemaInvalidUniqueness the unique attributes of the schema are empty or repeated or the strategy is unknown
+	ErrSchemaInvalidSelfRevocation  = errors.New("the self revocation policy must be disabled, approval or auto")                                  // ErrSchemaInvalidSelfRevocation the self revocation policy is unknown
+	ErrSchemaInvalidWebhookTemplate = errors.New("invalid webhook template")                                                                       // ErrSchemaInvalidWebhookTemplate the webhook template of the schema has malformed references
+)
+
 type schema struct {
-	repo   ports.SchemaRepository
-	loader loader.DocumentLoader
+	repo    ports.SchemaRepository
+	loader  loader.DocumentLoader
+	storage *db.Storage
+	cache   *loader.DocumentCache
 }
 
 // NewSchema is the schema service constructor
@@ -26,6 +38,12 @@ func NewSchema(repo ports.SchemaRepository, loader loader.DocumentLoader) *schem
 	return &schema{repo: repo, loader: loader}
 }
 
+// NewSchemaWithCache is the schema service constructor for a loader that keeps the documents in cache.
+// Importing a schema refreshes its document in the cache of this replica and invalidates it in the others.
+func NewSchemaWithCache(repo ports.SchemaRepository, loader loader.DocumentLoader, storage *db.Storage, cache *loader.DocumentCache) *schema {
+	return &schema{repo: repo, loader: loader, storage: storage, cache: cache}
+}
+
 // GetByID returns a domain.Schema by ID
 func (s *schema) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error) {
 	schema, err := s.repo.GetByID(ctx, issuerDID, id)
@@ -43,8 +61,105 @@ func (s *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 	return s.repo.GetAll(ctx, issuerDID, query)
 }
 
+// UpdateExpiration replaces the expiration settings of the schema. Nil values remove the setting.
+func (s *schema) UpdateExpiration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, defaultExpirationDays *int, maxExpirationDays *int) (*domain.Schema, error) {
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema.DefaultExpirationDays = defaultExpirationDays
+	schema.MaxExpirationDays = maxExpirationDays
+	if !schema.ValidExpiration() {
+		return nil, ErrSchemaInvalidExpiration
+	}
+	if err := s.repo.UpdateExpiration(ctx, schema); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		log.Error(ctx, "updating schema expiration", "err", err, "id", id)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// UpdateUniqueness replaces the unique attributes of the schema and the strategy applied to the duplicates.
+// Empty attributes remove the constraint and, with attributes, the strategy defaults to reject.
+func (s *schema) UpdateUniqueness(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, attributes []string, strategy domain.SchemaUniqueStrategy) (*domain.Schema, error) {
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema.UniqueAttributes, schema.UniqueStrategy = uniqueness(attributes, strategy)
+	if !schema.ValidUniqueness() {
+		return nil, ErrSchemaInvalidUniqueness
+	}
+	if err := s.repo.UpdateUniqueness(ctx, schema); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		log.Error(ctx, "updating schema uniqueness", "err", err, "id", id)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// UpdateSelfRevocation sets how the revocation requests of the holders of the credentials of the schema are handled
+func (s *schema) UpdateSelfRevocation(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, policy domain.SchemaSelfRevocation) (*domain.Schema, error) {
+	if !policy.Valid() {
+		return nil, ErrSchemaInvalidSelfRevocation
+	}
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema.SelfRevocation = policy
+	if err := s.repo.UpdateSelfRevocation(ctx, schema); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		log.Error(ctx, "updating schema self revocation", "err", err, "id", id)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// UpdateWebhookTemplate replaces the template of the bodies posted to the webhooks about the credentials of the schema.
+// An empty template removes it, so the webhooks receive the bodies as they are.
+func (s *schema) UpdateWebhookTemplate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, template domain.WebhookTemplate) (*domain.Schema, error) {
+	if len(template) > 0 {
+		if err := template.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrSchemaInvalidWebhookTemplate, err)
+		}
+	}
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema.WebhookTemplate = template
+	if err := s.repo.UpdateWebhookTemplate(ctx, schema); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		log.Error(ctx, "updating schema webhook template", "err", err, "id", id)
+		return nil, err
+	}
+	return schema, nil
+}
+
 // ImportSchema process an schema url and imports into the system
 func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.ImportSchemaRequest) (*domain.Schema, error) {
+	if s.cache != nil {
+		s.cache.Invalidate([]string{req.URL}, nil)
+	}
+	expiration := domain.Schema{DefaultExpirationDays: req.DefaultExpirationDays, MaxExpirationDays: req.MaxExpirationDays}
+	if !expiration.ValidExpiration() {
+		return nil, ErrSchemaInvalidExpiration
+	}
+	uniqueAttributes, uniqueStrategy := uniqueness(req.UniqueAttributes, req.UniqueStrategy)
+	unique := domain.Schema{UniqueAttributes: uniqueAttributes, UniqueStrategy: uniqueStrategy}
+	if !unique.ValidUniqueness() {
+		return nil, ErrSchemaInvalidUniqueness
+	}
 	remoteSchema, err := jsonschema.Load(ctx, req.URL, s.loader)
 	if err != nil {
 		log.Error(ctx, "loading jsonschema", "err", err, "jsonschema", req.URL)
@@ -73,11 +188,33 @@ func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.Impor
 		Hash:        hash,
 		Words:       attributeNames.SchemaAttrs(),
 		CreatedAt:   time.Now(),
+
+		DefaultExpirationDays: req.DefaultExpirationDays,
+		MaxExpirationDays:     req.MaxExpirationDays,
+		UniqueAttributes:      uniqueAttributes,
+		UniqueStrategy:        uniqueStrategy,
 	}
 
 	if err := s.repo.Save(ctx, schema); err != nil {
 		log.Error(ctx, "saving imported schema", "err", err)
 		return nil, err
 	}
+	if s.storage != nil {
+		msg := invalidation.Message{Cache: loader.DocumentCacheName, Keys: []string{req.URL}}
+		if err := invalidation.Notify(ctx, s.storage.Pgx, msg); err != nil {
+			log.Warn(ctx, "invalidating the schema in other replicas", "err", err, "jsonschema", req.URL)
+		}
+	}
 	return schema, nil
 }
+
+// uniqueness returns the unique attributes and the strategy to store, rejecting the duplicates when no strategy is given
+func uniqueness(attributes []string, strategy domain.SchemaUniqueStrategy) ([]string, domain.SchemaUniqueStrategy) {
+	if len(attributes) == 0 {
+		return nil, strategy
+	}
+	if strategy == "" {
+		strategy = domain.SchemaUniqueStrategyReject
+	}
+	return attributes, strategy
+}
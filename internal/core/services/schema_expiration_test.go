@@ -0,0 +1,71 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestClaim_CreateCredentialSchemaExpiration(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe")
+	require.NoError(t, err)
+	const schemaURL = "https://example.com/schema.json"
+
+	schemaRepo := repositories.NewSchemaInMemory()
+	require.NoError(t, schemaRepo.Save(ctx, &domain.Schema{
+		ID:                    uuid.New(),
+		IssuerDID:             *issuerDID,
+		URL:                   schemaURL,
+		Type:                  "KYCAgeCredential",
+		CreatedAt:             time.Now(),
+		DefaultExpirationDays: common.ToPointer(30),
+		MaxExpirationDays:     common.ToPointer(365),
+	}))
+	// the stub denies every credential, so the requests do not go further than the policy
+	engine := &policyEngineStub{decision: &domain.PolicyDecision{Effect: domain.PolicyEffectDeny}}
+	claimService := services.NewClaim(services.ClaimDependencies{
+		PolicyEngine:     engine,
+		SchemaRepository: schemaRepo,
+	})
+	newRequest := func(expiration *time.Time) *ports.CreateClaimRequest {
+		return &ports.CreateClaimRequest{
+			DID:               issuerDID,
+			Schema:            schemaURL,
+			Type:              "KYCAgeCredential",
+			CredentialSubject: map[string]any{"birthday": 19960424},
+			Expiration:        expiration,
+		}
+	}
+
+	t.Run("should apply the default expiration of the schema", func(t *testing.T) {
+		_, err := claimService.CreateCredential(ctx, newRequest(nil))
+		assert.ErrorIs(t, err, services.ErrPolicyDenied)
+		require.NotNil(t, engine.input.Expiration)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *engine.input.Expiration, time.Minute)
+	})
+
+	t.Run("should reject the expirations beyond the maximum of the schema", func(t *testing.T) {
+		_, err := claimService.CreateCredential(ctx, newRequest(common.ToPointer(time.Now().AddDate(2, 0, 0))))
+		assert.ErrorIs(t, err, services.ErrCredentialExpirationExceeded)
+	})
+
+	t.Run("should not change the credentials of other schemas", func(t *testing.T) {
+		req := newRequest(nil)
+		req.Type = "KYCCountryOfResidenceCredential"
+		_, err := claimService.CreateCredential(ctx, req)
+		assert.ErrorIs(t, err, services.ErrPolicyDenied)
+		assert.Nil(t, engine.input.Expiration)
+	})
+}
@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestSchema_UpdateUniqueness(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe")
+	require.NoError(t, err)
+
+	schemaRepo := repositories.NewSchemaInMemory()
+	schema := &domain.Schema{ID: uuid.New(), IssuerDID: *issuerDID, URL: "https://example.com/schema.json", Type: "EmployeeCredential", CreatedAt: time.Now()}
+	require.NoError(t, schemaRepo.Save(ctx, schema))
+	schemaService := services.NewSchema(schemaRepo, nil)
+
+	t.Run("should reject the duplicates by default", func(t *testing.T) {
+		updated, err := schemaService.UpdateUniqueness(ctx, *issuerDID, schema.ID, []string{"employeeId"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"employeeId"}, updated.UniqueAttributes)
+		assert.Equal(t, domain.SchemaUniqueStrategyReject, updated.UniqueStrategy)
+	})
+
+	t.Run("should remove the constraint without attributes", func(t *testing.T) {
+		updated, err := schemaService.UpdateUniqueness(ctx, *issuerDID, schema.ID, nil, "")
+		require.NoError(t, err)
+		assert.Empty(t, updated.UniqueAttributes)
+		assert.Empty(t, updated.UniqueStrategy)
+	})
+
+	t.Run("should reject invalid settings", func(t *testing.T) {
+		_, err := schemaService.UpdateUniqueness(ctx, *issuerDID, schema.ID, []string{"employeeId", "employeeId"}, domain.SchemaUniqueStrategyRevoke)
+		assert.ErrorIs(t, err, services.ErrSchemaInvalidUniqueness)
+		_, err = schemaService.UpdateUniqueness(ctx, *issuerDID, schema.ID, []string{"employeeId"}, "replace")
+		assert.ErrorIs(t, err, services.ErrSchemaInvalidUniqueness)
+	})
+
+	t.Run("should not find other schemas", func(t *testing.T) {
+		_, err := schemaService.UpdateUniqueness(ctx, *issuerDID, uuid.New(), []string{"employeeId"}, "")
+		assert.ErrorIs(t, err, services.ErrSchemaNotFound)
+	})
+}
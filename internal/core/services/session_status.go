@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	linkState "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+// ErrSessionNotFound is returned when the id does not belong to any session or credential
+var ErrSessionNotFound = errors.New("session not found")
+
+type sessionStatus struct {
+	sessionManager     ports.SessionRepository
+	connectionsService ports.ConnectionsService
+	linkService        ports.LinkService
+	claimsService      ports.ClaimsService
+}
+
+// NewSessionStatus returns a new session status service
+func NewSessionStatus(sessionManager ports.SessionRepository, connectionsService ports.ConnectionsService, linkService ports.LinkService, claimsService ports.ClaimsService) ports.SessionStatusService {
+	return &sessionStatus{
+		sessionManager:     sessionManager,
+		connectionsService: connectionsService,
+		linkService:        linkService,
+		claimsService:      claimsService,
+	}
+}
+
+// Get returns the status of the session with the given id. The id can be the session id of an authentication
+// or a link qr code, or the id of a credential offered to a holder. In the last case the status is done once the
+// holder has fetched the credential.
+func (s *sessionStatus) Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SessionStatus, error) {
+	authReq, authErr := s.sessionManager.Get(ctx, id.String())
+	if authErr == nil {
+		if linkID, ok := linkIDFromCallback(authReq.Body.CallbackURL); ok {
+			return s.linkStatus(ctx, issuerDID, id, linkID)
+		}
+	}
+
+	conn, err := s.connectionsService.GetByUserSessionID(ctx, id)
+	if err == nil && conn.IssuerDID.String() == issuerDID.String() {
+		return &domain.SessionStatus{Type: domain.SessionTypeAuthentication, Status: linkState.StatusDone}, nil
+	}
+	if err != nil && !errors.Is(err, ErrConnectionDoesNotExist) {
+		return nil, err
+	}
+	if authErr == nil && authReq.From == issuerDID.String() {
+		return &domain.SessionStatus{Type: domain.SessionTypeAuthentication, Status: linkState.StatusPending}, nil
+	}
+
+	return s.credentialStatus(ctx, issuerDID, id)
+}
+
+func (s *sessionStatus) linkStatus(ctx context.Context, issuerDID w3c.DID, sessionID, linkID uuid.UUID) (*domain.SessionStatus, error) {
+	resp, err := s.linkService.GetQRCode(ctx, sessionID, issuerDID, linkID)
+	if err != nil {
+		if errors.Is(err, ErrLinkNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return &domain.SessionStatus{
+		Type:       domain.SessionTypeLink,
+		Status:     resp.State.Status,
+		Message:    resp.State.Message,
+		QRCodeLink: resp.State.QRCode,
+	}, nil
+}
+
+func (s *sessionStatus) credentialStatus(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SessionStatus, error) {
+	if _, err := s.claimsService.GetByID(ctx, &issuerDID, id); err != nil {
+		if errors.Is(err, ErrClaimNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	status := linkState.StatusPending
+	if fetched, err := s.sessionManager.GetLink(ctx, linkState.CredentialFetchCacheKey(id.String())); err == nil {
+		status = fetched.Status
+	}
+	return &domain.SessionStatus{Type: domain.SessionTypeCredential, Status: status}, nil
+}
+
+// linkIDFromCallback returns the link id of the callback url of a link authorization request
+func linkIDFromCallback(callbackURL string) (uuid.UUID, bool) {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	linkID, err := uuid.Parse(u.Query().Get("linkID"))
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return linkID, true
+}
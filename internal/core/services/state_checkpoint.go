@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+type stateCheckpoint struct {
+	repo            ports.StateCheckpointRepository
+	identityService ports.IdentityService
+	stateRepo       ports.IdentityStateRepository
+	anchor          ports.StateAnchor
+	storage         *db.Storage
+}
+
+// NewStateCheckpoint returns a new state checkpoint service. With a nil anchor the states are not anchored, and only
+// the existing checkpoints are returned.
+func NewStateCheckpoint(repo ports.StateCheckpointRepository, identityService ports.IdentityService, stateRepo ports.IdentityStateRepository, anchor ports.StateAnchor, storage *db.Storage) ports.StateCheckpointService {
+	return &stateCheckpoint{
+		repo:            repo,
+		identityService: identityService,
+		stateRepo:       stateRepo,
+		anchor:          anchor,
+		storage:         storage,
+	}
+}
+
+// CheckpointAll anchors the latest confirmed state of every identity that was not anchored yet
+func (s *stateCheckpoint) CheckpointAll(ctx context.Context) {
+	if s.anchor == nil {
+		return
+	}
+	identities, err := s.identityService.Get(ctx)
+	if err != nil {
+		log.Error(ctx, "getting the identities to checkpoint", "err", err)
+		return
+	}
+	for _, identifier := range identities {
+		did, err := w3c.ParseDID(identifier)
+		if err != nil {
+			log.Error(ctx, "parsing the did of the identity to checkpoint", "err", err, "did", identifier)
+			continue
+		}
+		if err := s.checkpoint(ctx, *did); err != nil {
+			log.Error(ctx, "anchoring the state", "err", err, "did", identifier, "anchor", s.anchor.Type())
+		}
+	}
+}
+
+func (s *stateCheckpoint) checkpoint(ctx context.Context, did w3c.DID) error {
+	return s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		locked, err := s.repo.TryLock(ctx, tx, did)
+		if err != nil || !locked {
+			return err
+		}
+
+		state, err := s.stateRepo.GetLatestStateByIdentifier(ctx, tx, &did)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if state.State == nil {
+			return nil
+		}
+		latest, err := s.repo.GetLatest(ctx, tx, did, s.anchor.Type())
+		if err != nil && !errors.Is(err, repositories.ErrStateCheckpointDoesNotExist) {
+			return err
+		}
+		if latest != nil && latest.State == *state.State {
+			return nil
+		}
+
+		hash, err := merkletree.NewHashFromHex(*state.State)
+		if err != nil {
+			return err
+		}
+		reference, receipt, err := s.anchor.Anchor(ctx, hash)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Save(ctx, tx, domain.NewStateCheckpoint(did, *state.State, s.anchor.Type(), reference, receipt)); err != nil {
+			return err
+		}
+		log.Info(ctx, "audit: state anchored", "did", did.String(), "state", *state.State, "anchor", s.anchor.Type(), "reference", reference)
+		return nil
+	})
+}
+
+// GetAll returns the checkpoints of the issuer, the newest first
+func (s *stateCheckpoint) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.StateCheckpoint, error) {
+	return s.repo.GetAll(ctx, s.storage.Pgx, issuerDID)
+}
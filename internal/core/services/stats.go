@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatsDays is the number of days covered by the daily series of the stats
+const StatsDays = 30
+
+// StatsMonths is the number of months covered by the monthly gas spend of the stats
+const StatsMonths = 12
+
+type stats struct {
+	repo      ports.StatsRepository
+	storage   *db.Storage
+	priceFeed config.PriceFeed
+}
+
+// NewStats returns a new stats service. The gas spend is converted to the currency of the price feed, if any.
+func NewStats(repo ports.StatsRepository, storage *db.Storage, priceFeed config.PriceFeed) ports.StatsService {
+	return &stats{
+		repo:      repo,
+		storage:   storage,
+		priceFeed: priceFeed,
+	}
+}
+
+// Get returns the issuer aggregates. The daily series cover the last StatsDays days in UTC, today included, and
+// the gas spend the last StatsMonths months, the current one included.
+func (s *stats) Get(ctx context.Context, issuerDID w3c.DID) (*domain.Stats, error) {
+	now := time.Now()
+	res, err := s.repo.Get(ctx, s.storage.Pgx, issuerDID, statsFrom(now), StatsDays)
+	if err != nil {
+		return nil, err
+	}
+
+	var currency string
+	if s.priceFeed.Enabled() {
+		currency = s.priceFeed.Currency
+		res.FiatCurrency = &currency
+	}
+	if res.GasSpendPerMonth, err = s.repo.GetGasSpend(ctx, s.storage.Pgx, issuerDID, statsMonthsFrom(now), StatsMonths, currency); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// statsFrom returns the start of the first day of the daily series that ends the day of now
+func statsFrom(now time.Time) time.Time {
+	today := now.UTC().Truncate(24 * time.Hour)
+	return today.AddDate(0, 0, -(StatsDays - 1))
+}
+
+// statsMonthsFrom returns the start of the first month of the monthly series that ends the month of now
+func statsMonthsFrom(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -(StatsMonths - 1), 0)
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+type statusOracle struct {
+	claimsService ports.ClaimsService
+}
+
+// NewStatusOracle returns the service that revokes the credentials reported as ineligible by the status oracle
+func NewStatusOracle(claimsService ports.ClaimsService) ports.StatusOracleService {
+	return &statusOracle{claimsService: claimsService}
+}
+
+// RevokeIneligibleCredential revokes the credential of the event, unless it is already revoked
+func (s *statusOracle) RevokeIneligibleCredential(ctx context.Context, payload pubsub.Message) error {
+	var ev event.RevokeIneligibleCredential
+	if err := ev.Unmarshal(payload); err != nil {
+		return errors.New("revokeIneligibleCredential unexpected data type")
+	}
+
+	issuerDID, err := w3c.ParseDID(ev.IssuerID)
+	if err != nil {
+		log.Error(ctx, "revokeIneligibleCredential: failed to parse issuerID", "err", err, "issuerID", ev.IssuerID)
+		return err
+	}
+	credID, err := uuid.Parse(ev.CredentialID)
+	if err != nil {
+		log.Error(ctx, "revokeIneligibleCredential: failed to parse credentialID", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+
+	credential, err := s.claimsService.GetByID(ctx, issuerDID, credID)
+	if err != nil {
+		log.Error(ctx, "revokeIneligibleCredential: loading the credential", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+	if credential.Revoked {
+		return nil
+	}
+
+	description := "subject no longer eligible according to the status oracle"
+	if ev.Reason != "" {
+		description += ": " + ev.Reason
+	}
+	if err := s.claimsService.Revoke(ctx, *issuerDID, uint64(credential.RevNonce), description); err != nil {
+		log.Error(ctx, "revokeIneligibleCredential: revoking the credential", "err", err, "credentialID", ev.CredentialID)
+		return err
+	}
+	log.Info(ctx, "audit: credential revoked by the status oracle", "credentialID", ev.CredentialID, "issuerID", ev.IssuerID, "reason", ev.Reason)
+	return nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const tenantAPIKeySize = 32
+
+var (
+	ErrTenantNotFound      = errors.New("tenant not found")                       // ErrTenantNotFound the tenant does not exist
+	ErrTenantNameEmpty     = errors.New("tenant name cannot be empty")            // ErrTenantNameEmpty the tenant name is mandatory
+	ErrTenantDuplicated    = errors.New("there is another tenant with that name") // ErrTenantDuplicated the tenant name is already taken
+	ErrTenantHasIdentities = errors.New("the tenant still owns identities")       // ErrTenantHasIdentities the tenant cannot be deleted
+	ErrTenantUnauthorized  = errors.New("invalid tenant credentials")             // ErrTenantUnauthorized wrong tenant id or API key
+)
+
+type tenant struct {
+	repo    ports.TenantRepository
+	storage *db.Storage
+}
+
+// NewTenant returns a new tenant service
+func NewTenant(repo ports.TenantRepository, storage *db.Storage) ports.TenantService {
+	return &tenant{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Create creates a new tenant and returns it with its API key. The API key is not stored, only its hash.
+func (t *tenant) Create(ctx context.Context, name string) (*domain.Tenant, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrTenantNameEmpty
+	}
+
+	key := make([]byte, tenantAPIKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, "", err
+	}
+	apiKey := hex.EncodeToString(key)
+
+	tenant := &domain.Tenant{
+		ID:         uuid.New(),
+		Name:       name,
+		APIKeyHash: hashAPIKey(apiKey),
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := t.repo.Save(ctx, t.storage.Pgx, tenant); err != nil {
+		if errors.Is(err, repositories.ErrTenantDuplicated) {
+			return nil, "", ErrTenantDuplicated
+		}
+		log.Error(ctx, "saving tenant", "err", err)
+		return nil, "", err
+	}
+
+	return tenant, apiKey, nil
+}
+
+// GetAll returns all the tenants
+func (t *tenant) GetAll(ctx context.Context) ([]domain.Tenant, error) {
+	return t.repo.GetAll(ctx, t.storage.Pgx)
+}
+
+// Delete removes a tenant that does not own identities
+func (t *tenant) Delete(ctx context.Context, id uuid.UUID) error {
+	err := t.repo.Delete(ctx, t.storage.Pgx, id)
+	if errors.Is(err, repositories.ErrTenantDoesNotExist) {
+		return ErrTenantNotFound
+	}
+	if errors.Is(err, repositories.ErrTenantHasIdentities) {
+		return ErrTenantHasIdentities
+	}
+	return err
+}
+
+// Authenticate returns the tenant if the API key is valid
+func (t *tenant) Authenticate(ctx context.Context, id uuid.UUID, apiKey string) (*domain.Tenant, error) {
+	tenant, err := t.repo.GetByID(ctx, t.storage.Pgx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTenantDoesNotExist) {
+			return nil, ErrTenantUnauthorized
+		}
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tenant.APIKeyHash), []byte(hashAPIKey(apiKey))) != 1 {
+		return nil, ErrTenantUnauthorized
+	}
+
+	return tenant, nil
+}
+
+// OwnsIdentity returns true if the identity belongs to the tenant
+func (t *tenant) OwnsIdentity(ctx context.Context, id uuid.UUID, identifier w3c.DID) (bool, error) {
+	return t.repo.OwnsIdentity(ctx, t.storage.Pgx, id, identifier)
+}
+
+func hashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
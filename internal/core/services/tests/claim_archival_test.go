@@ -0,0 +1,118 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestClaimArchival(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	archiveRepository := repositories.NewClaimArchive()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	qrService := services.NewQrStoreService(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		ArchiveRepository:        archiveRepository,
+	})
+	archivalService := services.NewClaimArchival(archiveRepository, claimsService, storage)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	merklizedRootPosition := "index"
+	issue := func(t *testing.T, birthday int, createdAt time.Time) uuid.UUID {
+		t.Helper()
+		credentialSubject := map[string]any{
+			"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+			"birthday":     birthday,
+			"documentType": 2,
+		}
+		claim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+		require.NoError(t, err)
+		_, err = storage.Pgx.Exec(ctx, `UPDATE claims SET created_at = $1 WHERE id = $2`, createdAt, claim.ID)
+		require.NoError(t, err)
+		return claim.ID
+	}
+	oldID := issue(t, 19960424, time.Now().AddDate(-2, 0, 0))
+	recentID := issue(t, 19960425, time.Now())
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := archivalService.Archive(ctx, *did, ports.ClaimArchiveRequest{MinAgeDays: 0, BatchSize: 10})
+		assert.ErrorIs(t, err, services.ErrClaimArchiveMinAge)
+		_, err = archivalService.Archive(ctx, *did, ports.ClaimArchiveRequest{MinAgeDays: 365, BatchSize: 0})
+		assert.ErrorIs(t, err, services.ErrClaimArchiveBatchSize)
+	})
+
+	t.Run("archive, revoke and rehydrate", func(t *testing.T) {
+		ids, err := archivalService.Archive(ctx, *did, ports.ClaimArchiveRequest{MinAgeDays: 365, BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{oldID}, ids)
+
+		_, err = claimsService.GetByID(ctx, did, oldID)
+		assert.ErrorIs(t, err, services.ErrClaimNotFound)
+		_, err = claimsService.GetByID(ctx, did, recentID)
+		assert.NoError(t, err)
+
+		archived, err := archiveRepository.GetByID(ctx, storage.Pgx, *did, oldID)
+		require.NoError(t, err)
+		assert.False(t, archived.Revoked)
+		require.NoError(t, claimsService.Revoke(ctx, *did, uint64(archived.RevNonce), "archived"))
+
+		credential, err := archivalService.Rehydrate(ctx, *did, oldID)
+		require.NoError(t, err)
+		assert.Equal(t, oldID, credential.ID)
+		assert.True(t, credential.Revoked)
+
+		_, err = archivalService.Rehydrate(ctx, *did, oldID)
+		assert.ErrorIs(t, err, services.ErrArchivedClaimNotFound)
+	})
+}
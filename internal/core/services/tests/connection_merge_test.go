@@ -0,0 +1,150 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestConnectionMerge(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	qrService := services.NewQrStoreService(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	mergeService := services.NewConnectionMerge(repositories.NewConnectionMerge(), connectionsRepository, claimsRepo, claimsService, pubsub.NewMock(), storage)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	sourceUserDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	targetUserDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	connect := func(t *testing.T, userDID *w3c.DID, profile domain.ConnectionProfile) uuid.UUID {
+		t.Helper()
+		id, err := connectionsRepository.Save(ctx, storage.Pgx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *did,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+			Profile:    profile,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	sourceConnID := connect(t, sourceUserDID, domain.ConnectionProfile{DisplayName: common.ToPointer("Jane Doe"), Email: common.ToPointer("Jane.Doe@example.com")})
+	targetConnID := connect(t, targetUserDID, domain.ConnectionProfile{Email: common.ToPointer("jane.doe@example.com")})
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	credentialSubject := map[string]any{
+		"id":           sourceUserDID.String(),
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "index"
+	sourceCredential, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	t.Run("duplicates", func(t *testing.T) {
+		duplicates, err := mergeService.GetDuplicates(ctx, *did)
+		require.NoError(t, err)
+		require.Len(t, duplicates, 1)
+		assert.Equal(t, domain.ConnectionDuplicateEmail, duplicates[0].Reason)
+		assert.Equal(t, "jane.doe@example.com", duplicates[0].Value)
+		assert.Equal(t, []uuid.UUID{sourceConnID, targetConnID}, duplicates[0].ConnectionIDs)
+	})
+
+	t.Run("into itself", func(t *testing.T) {
+		_, err := mergeService.Merge(ctx, *did, targetConnID, targetConnID, verifiable.Iden3commRevocationStatusV1)
+		assert.ErrorIs(t, err, services.ErrConnectionMergeSameConnection)
+	})
+
+	t.Run("missing connection", func(t *testing.T) {
+		_, err := mergeService.Merge(ctx, *did, targetConnID, uuid.New(), verifiable.Iden3commRevocationStatusV1)
+		assert.ErrorIs(t, err, services.ErrConnectionDoesNotExist)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		merge, err := mergeService.Merge(ctx, *did, targetConnID, sourceConnID, verifiable.Iden3commRevocationStatusV1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, merge.Credentials)
+		assert.Equal(t, sourceUserDID.String(), merge.SourceUserDID)
+
+		revoked, err := claimsService.GetByID(ctx, did, sourceCredential.ID)
+		require.NoError(t, err)
+		assert.True(t, revoked.Revoked)
+
+		reissued, _, err := claimsService.GetAll(ctx, *did, &ports.ClaimsFilter{Subject: targetUserDID.String()})
+		require.NoError(t, err)
+		require.Len(t, reissued, 1)
+		assert.False(t, reissued[0].Revoked)
+
+		_, err = connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, sourceConnID, *did)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+		target, err := connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, targetConnID, *did)
+		require.NoError(t, err)
+		require.NotNil(t, target.Profile.DisplayName)
+		assert.Equal(t, "Jane Doe", *target.Profile.DisplayName)
+
+		merges, err := mergeService.GetMerges(ctx, *did, targetConnID)
+		require.NoError(t, err)
+		require.Len(t, merges, 1)
+		assert.Equal(t, merge.ID, merges[0].ID)
+
+		duplicates, err := mergeService.GetDuplicates(ctx, *did)
+		require.NoError(t, err)
+		assert.Empty(t, duplicates)
+	})
+}
@@ -0,0 +1,135 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestConnectionPruning(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	qrService := services.NewQrStoreService(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	pruningService := services.NewConnectionPruning(connectionsRepository, storage)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	connect := func(t *testing.T, userDID string, modifiedAt time.Time) uuid.UUID {
+		t.Helper()
+		parsed, err := w3c.ParseDID(userDID)
+		require.NoError(t, err)
+		id, err := connectionsRepository.Save(ctx, storage.Pgx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *did,
+			UserDID:    *parsed,
+			CreatedAt:  modifiedAt,
+			ModifiedAt: modifiedAt,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	longAgo := time.Now().AddDate(0, 0, -200)
+	deadConnID := connect(t, "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", longAgo)
+	withCredentialUserDID := "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	withCredentialConnID := connect(t, withCredentialUserDID, longAgo)
+	activeConnID := connect(t, "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", time.Now())
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	credentialSubject := map[string]any{
+		"id":           withCredentialUserDID,
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "index"
+	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	t.Run("dead connections", func(t *testing.T) {
+		dead, err := pruningService.GetDead(ctx, *did, 180, 50)
+		require.NoError(t, err)
+		require.Len(t, dead, 1)
+		assert.Equal(t, deadConnID, dead[0].ID)
+		assert.Equal(t, 0, dead[0].Credentials)
+
+		_, err = pruningService.GetDead(ctx, *did, 0, 50)
+		assert.ErrorIs(t, err, services.ErrConnectionPruningInactiveDays)
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, err := pruningService.Prune(ctx, *did, ports.ConnectionPruneRequest{InactiveDays: 180, Action: "remove", BatchSize: 10})
+		assert.ErrorIs(t, err, services.ErrConnectionPruningAction)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		ids, err := pruningService.Prune(ctx, *did, ports.ConnectionPruneRequest{InactiveDays: 180, Action: domain.ConnectionPruneArchive, BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{deadConnID}, ids)
+
+		_, err = connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, deadConnID, *did)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+		for _, id := range []uuid.UUID{withCredentialConnID, activeConnID} {
+			_, err = connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, id, *did)
+			assert.NoError(t, err)
+		}
+
+		var archived int
+		require.NoError(t, storage.Pgx.QueryRow(ctx, `SELECT COUNT(*) FROM archived_connections WHERE id = $1`, deadConnID).Scan(&archived))
+		assert.Equal(t, 1, archived)
+
+		ids, err = pruningService.Prune(ctx, *did, ports.ConnectionPruneRequest{InactiveDays: 180, Action: domain.ConnectionPruneDelete, BatchSize: 10})
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
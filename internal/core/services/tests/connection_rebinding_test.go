@@ -0,0 +1,155 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestConnectionRebinding(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	qrService := services.NewQrStoreService(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	rebindingService := services.NewConnectionRebinding(repositories.NewConnectionRebinding(), connectionsRepository, claimsRepo, claimsService, identityService, pubsub.NewMock(), storage)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	oldUserDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	newUserDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	connect := func(t *testing.T, userDID *w3c.DID) uuid.UUID {
+		t.Helper()
+		id, err := connectionsRepository.Save(ctx, storage.Pgx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *did,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		return id
+	}
+	oldConnID := connect(t, oldUserDID)
+	displayName := "Jane Doe"
+	require.NoError(t, connectionsRepository.UpdateProfile(ctx, storage.Pgx, oldConnID, *did, domain.ConnectionProfile{DisplayName: &displayName}))
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	credentialSubject := map[string]any{
+		"id":           oldUserDID.String(),
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "index"
+	oldCredential, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	t.Run("code for a missing connection", func(t *testing.T) {
+		_, err := rebindingService.CreateCode(ctx, *did, uuid.New())
+		assert.ErrorIs(t, err, services.ErrConnectionDoesNotExist)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := rebindingService.Redeem(ctx, *did, "AAAA-BBBB-CCCC-DDDD", "https://issuer.example.com")
+		assert.ErrorIs(t, err, services.ErrConnectionRebindingCodeInvalid)
+	})
+
+	t.Run("session without code", func(t *testing.T) {
+		rebinding, err := rebindingService.Complete(ctx, *did, uuid.New(), verifiable.Iden3commRevocationStatusV1)
+		require.NoError(t, err)
+		assert.Nil(t, rebinding)
+	})
+
+	t.Run("rebinding", func(t *testing.T) {
+		code, err := rebindingService.CreateCode(ctx, *did, oldConnID)
+		require.NoError(t, err)
+		assert.Len(t, code.Code, 19)
+
+		qr, err := rebindingService.Redeem(ctx, *did, code.Code, "https://issuer.example.com")
+		require.NoError(t, err)
+
+		// the holder answers the authentication request with the new DID
+		newConnID := connect(t, newUserDID)
+		require.NoError(t, connectionsRepository.SaveUserAuthentication(ctx, storage.Pgx, newConnID, qr.SessionID, time.Now()))
+
+		rebinding, err := rebindingService.Complete(ctx, *did, qr.SessionID, verifiable.Iden3commRevocationStatusV1)
+		require.NoError(t, err)
+		require.NotNil(t, rebinding)
+		assert.Equal(t, newConnID, *rebinding.NewConnectionID)
+
+		revoked, err := claimsService.GetByID(ctx, did, oldCredential.ID)
+		require.NoError(t, err)
+		assert.True(t, revoked.Revoked)
+
+		reissued, _, err := claimsService.GetAll(ctx, *did, &ports.ClaimsFilter{Subject: newUserDID.String()})
+		require.NoError(t, err)
+		require.Len(t, reissued, 1)
+		assert.False(t, reissued[0].Revoked)
+		assert.Equal(t, schemaURL, reissued[0].SchemaURL)
+
+		_, err = connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, oldConnID, *did)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+		newConn, err := connectionsRepository.GetByIDAndIssuerID(ctx, storage.Pgx, newConnID, *did)
+		require.NoError(t, err)
+		require.NotNil(t, newConn.Profile.DisplayName)
+		assert.Equal(t, displayName, *newConn.Profile.DisplayName)
+
+		_, err = rebindingService.Complete(ctx, *did, qr.SessionID, verifiable.Iden3commRevocationStatusV1)
+		assert.ErrorIs(t, err, services.ErrConnectionRebindingCodeInvalid)
+		_, err = rebindingService.Redeem(ctx, *did, code.Code, "https://issuer.example.com")
+		assert.ErrorIs(t, err, services.ErrConnectionRebindingCodeInvalid)
+	})
+}
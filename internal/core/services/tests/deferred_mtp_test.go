@@ -0,0 +1,123 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestDeferredMTPProof(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		IssuancePolicy:           config.IssuancePolicy{DeferredMTPProof: true},
+	})
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "index"
+	credential, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject,
+		common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition,
+		ports.ClaimRequestProofs{BJJSignatureProof2021: false, Iden3SparseMerkleTreeProof: true}, nil, false,
+		verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	t.Run("the credential is signed before the state is published", func(t *testing.T) {
+		credential, err := claimsService.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		assert.True(t, credential.MtProof)
+		assert.NotEqual(t, pgtype.Null, credential.SignatureProof.Status)
+		assert.NotEqual(t, pgtype.Present, credential.MTPProof.Status)
+	})
+
+	t.Run("the credential gets its merkle tree proof once the state is published", func(t *testing.T) {
+		state, err := identityService.UpdateState(ctx, *did)
+		require.NoError(t, err)
+		// the publisher confirms the state transition and attaches the merkle tree proofs of its credentials
+		state.Status = domain.StatusConfirmed
+		state.TxID = common.ToPointer("0x5f1c2e3d4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d")
+		state.BlockNumber = common.ToPointer(100)
+		state.BlockTimestamp = common.ToPointer(int(time.Now().Unix()))
+		require.NoError(t, claimsService.UpdateClaimsMTPAndState(ctx, state))
+
+		credential, err := claimsService.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, pgtype.Null, credential.SignatureProof.Status)
+		require.Equal(t, pgtype.Present, credential.MTPProof.Status)
+
+		var proof verifiable.Iden3SparseMerkleTreeProof
+		require.NoError(t, credential.MTPProof.AssignTo(&proof))
+		assert.Equal(t, state.State, proof.IssuerData.State.Value)
+		assert.Equal(t, state.TxID, proof.IssuerData.State.TxID)
+		coreClaim, err := proof.GetCoreClaim()
+		require.NoError(t, err)
+		hi, hv, err := coreClaim.HiHv()
+		require.NoError(t, err)
+		claimsTreeRoot, err := merkletree.NewHashFromHex(*state.ClaimsTreeRoot)
+		require.NoError(t, err)
+		assert.True(t, merkletree.VerifyProof(claimsTreeRoot, proof.MTP, hi, hv))
+
+		latest, err := identityStateRepo.GetLatestStateByIdentifier(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusConfirmed, latest.Status)
+	})
+}
@@ -3,6 +3,7 @@ package services_tests
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
 	"time"
 
@@ -39,7 +40,7 @@ func Test_link_issueClaim(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
 	sessionRepository := repositories.NewSessionCached(cachex)
 	schemaService := services.NewSchema(schemaRepository, docLoader)
 
@@ -51,7 +52,19 @@ func Test_link_issueClaim(t *testing.T) {
 		true,
 	)
 
-	claimsService := services.NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(), pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
 	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	assert.NoError(t, err)
 
@@ -84,15 +97,146 @@ func Test_link_issueClaim(t *testing.T) {
 
 	linkRepository := repositories.NewLink(*storage)
 	qrService := services.NewQrStoreService(cachex)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, docLoader, sessionRepository, pubsub.NewMock(), ipfsGateway)
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, docLoader, sessionRepository, pubsub.NewMock(), ipfsGateway, repositories.NewPresentationTemplate(), repositories.NewPayment(), nil, nil, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipient(), repositories.NewLinkHolder(), nil, nil, repositories.NewCredentialDependency())
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	nextWeek := time.Now().Add(7 * 24 * time.Hour)
 
-	link, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
 	assert.NoError(t, err)
 
-	link2, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, false, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link2, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:          common.ToPointer(100),
+		ValidUntil:           &tomorrow,
+		SchemaID:             schema.ID,
+		CredentialExpiration: &nextWeek,
+		CredentialMTPProof:   true,
+		CredentialAttributes: domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+	})
+	assert.NoError(t, err)
+
+	payment := &domain.LinkPayment{
+		Amount:    "1000000000000000",
+		ChainID:   80002,
+		Recipient: "0x2C1DDDc4C8b6BdAaE831eF04bF4FfDfA575d8bA7",
+		Tokens:    []domain.PaymentToken{{Currency: "POL"}},
+	}
+	paidLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Payment:                  payment,
+	})
+	assert.NoError(t, err)
+
+	_, err = linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Payment:                  &domain.LinkPayment{Amount: "-1", ChainID: 80002, Recipient: payment.Recipient, Tokens: payment.Tokens},
+	})
+	assert.ErrorIs(t, err, services.ErrLinkInvalidPayment)
+
+	_, err = linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Prerequisites:            []ports.LinkPrerequisiteRequest{{SchemaURL: "not an url", CredentialType: "KYCAgeCredential"}},
+	})
+	assert.ErrorIs(t, err, services.ErrLinkInvalidPrerequisite)
+
+	_, err = linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Restrictions:             &domain.LinkRestrictions{Countries: []string{"ES"}},
+	})
+	assert.ErrorIs(t, err, services.ErrLinkInvalidRestrictions)
+
+	restrictedLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Restrictions:             &domain.LinkRestrictions{IPRanges: []string{"203.0.113.0/24"}},
+	})
+	require.NoError(t, err)
+	_, err = linkService.CreateQRCode(ctx, *did, restrictedLink.ID, "https://issuer.example.com", "", net.ParseIP("198.51.100.7"))
+	assert.ErrorIs(t, err, services.ErrLinkRestricted)
+	_, err = linkService.CreateQRCode(ctx, *did, restrictedLink.ID, "https://issuer.example.com", "", net.ParseIP("203.0.113.7"))
+	assert.NoError(t, err)
+
+	_, err = linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Throttle:                 &domain.LinkThrottle{MaxClaimsPerHour: common.ToPointer(0)},
+	})
+	assert.ErrorIs(t, err, services.ErrLinkInvalidThrottle)
+
+	_, err = linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		ResultWebhook:            &domain.LinkResultWebhook{URL: "https://campaigns.example.com/issuances", Secret: "short"},
+	})
+	assert.ErrorIs(t, err, services.ErrLinkInvalidResultWebhook)
+
+	closedDay := (time.Now().UTC().Weekday() + 3) % 7
+	closedLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Throttle:                 &domain.LinkThrottle{MaxClaimsPerHour: common.ToPointer(10), Windows: []domain.LinkTimeWindow{{Days: []time.Weekday{closedDay}, Start: "00:00", End: "23:59"}}},
+	})
+	require.NoError(t, err)
+	_, err = linkService.CreateQRCode(ctx, *did, closedLink.ID, "https://issuer.example.com", "", nil)
+	require.ErrorIs(t, err, services.ErrLinkThrottled)
+	var throttled *services.LinkThrottledError
+	require.ErrorAs(t, err, &throttled)
+	assert.True(t, throttled.RetryAt.After(time.Now()))
+
+	throttledLink, err := linkService.Save(ctx, *did, ports.CreateLinkRequest{
+		MaxIssuance:              common.ToPointer(100),
+		ValidUntil:               &tomorrow,
+		SchemaID:                 schema.ID,
+		CredentialExpiration:     &nextWeek,
+		CredentialSignatureProof: true,
+		CredentialAttributes:     domain.CredentialSubject{"birthday": 19791109, "documentType": 12},
+		Throttle:                 &domain.LinkThrottle{MaxClaimsPerHour: common.ToPointer(10)},
+	})
+	require.NoError(t, err)
+	_, err = linkService.CreateQRCode(ctx, *did, throttledLink.ID, "https://issuer.example.com", "", nil)
 	assert.NoError(t, err)
 
 	type expected struct {
@@ -143,6 +287,17 @@ func Test_link_issueClaim(t *testing.T) {
 				issuedClaims: 1,
 			},
 		},
+		{
+			name:    "should return status pending payment for a paid link",
+			did:     *did,
+			userDID: *userDID1,
+			LinkID:  paidLink.ID,
+			expected: expected{
+				err:          nil,
+				status:       "pendingPayment",
+				issuedClaims: 0,
+			},
+		},
 		{
 			name:    "should return error wrong did",
 			did:     *did2,
@@ -164,7 +319,7 @@ func Test_link_issueClaim(t *testing.T) {
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			sessionID := uuid.New().String()
-			err := linkService.IssueClaim(ctx, sessionID, tc.did, tc.userDID, tc.LinkID, "host_url", verifiable.Iden3commRevocationStatusV1)
+			err := linkService.IssueClaim(ctx, sessionID, tc.did, tc.userDID, tc.LinkID, "host_url", verifiable.Iden3commRevocationStatusV1, nil)
 			if tc.expected.err != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expected.err, err)
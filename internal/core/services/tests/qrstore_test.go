@@ -3,6 +3,7 @@ package services_tests
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -119,3 +120,66 @@ func TestQRStore(t *testing.T) {
 		})
 	}
 }
+
+func TestQRStoreEmbeddedURL(t *testing.T) {
+	ctx := context.Background()
+	instance := miniredis.RunT(t)
+	client, err := redis.Open("redis://" + instance.Addr())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.Close()) }()
+	s := services.NewQrStoreService(cache.NewRedisCache(client))
+
+	body := []byte(`{"type":"https://iden3-communication.io/credentials/1.0/offer","body":{"credentials":[` +
+		strings.Repeat(`{"id":"3c5ff8f4-3f6a-4f2e-9a6f-1b1c1e5a5a1b","description":"KYCAgeCredential"},`, 20) + `{}]}}`)
+	id, err := s.Store(ctx, body, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("embedded", func(t *testing.T) {
+		link, err := s.ToEmbeddedURL(body, false)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(link, "iden3comm://?i_m="))
+		decoded, err := services.FromEmbeddedURL(link)
+		require.NoError(t, err)
+		assert.Equal(t, body, decoded)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		link, err := s.ToEmbeddedURL(body, true)
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(link, "&c=deflate"))
+		decoded, err := services.FromEmbeddedURL(link)
+		require.NoError(t, err)
+		assert.Equal(t, body, decoded)
+	})
+
+	t.Run("invalid embedded url", func(t *testing.T) {
+		_, err := services.FromEmbeddedURL("iden3comm://?request_uri=http://localhost/v1/qr-store?id=" + id.String())
+		assert.ErrorIs(t, err, services.ErrQRInvalidEmbeddedURL)
+		_, err = services.FromEmbeddedURL("iden3comm://?i_m=e30&c=gzip")
+		assert.ErrorIs(t, err, services.ErrQRInvalidEmbeddedURL)
+	})
+
+	embedded, err := s.ToEmbeddedURL(body, false)
+	require.NoError(t, err)
+	compressed, err := s.ToEmbeddedURL(body, true)
+	require.NoError(t, err)
+	require.Less(t, len(compressed), len(embedded))
+
+	for _, tc := range []struct {
+		name      string
+		maxLength int
+		compress  bool
+		expected  string
+	}{
+		{name: "fits embedded", maxLength: len(embedded), expected: embedded},
+		{name: "fits compressed", maxLength: len(compressed), compress: true, expected: compressed},
+		{name: "fits compressed but compression disabled", maxLength: len(compressed), expected: s.ToURL("http://localhost", id)},
+		{name: "too large", maxLength: len(compressed) - 1, compress: true, expected: s.ToURL("http://localhost", id)},
+	} {
+		t.Run("auto "+tc.name, func(t *testing.T) {
+			link, err := s.SelectURL("http://localhost", id, body, tc.maxLength, tc.compress)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, link)
+		})
+	}
+}
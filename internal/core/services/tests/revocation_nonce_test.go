@@ -0,0 +1,141 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestRevocationNonce(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	qrService := services.NewQrStoreService(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, sessionRepository, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	nonceService := services.NewRevocationNonce(repositories.NewRevocationNonce(), storage, config.RevocationNonces{
+		Mode:           config.RevocationNonceSequential,
+		CollisionScope: config.RevocationNonceCollisionsIssuer,
+		MaxRangeSize:   10,
+	})
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		NonceService:             nonceService,
+	})
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+	otherIdentity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	otherDID, err := w3c.ParseDID(otherIdentity.Identifier)
+	require.NoError(t, err)
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	merklizedRootPosition := "index"
+	newRequest := func(birthday int) *ports.CreateClaimRequest {
+		credentialSubject := map[string]any{
+			"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+			"birthday":     birthday,
+			"documentType": 2,
+		}
+		return ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil)
+	}
+
+	t.Run("sequential nonces", func(t *testing.T) {
+		first, err := claimsService.Save(ctx, newRequest(19960424))
+		require.NoError(t, err)
+		second, err := claimsService.Save(ctx, newRequest(19960425))
+		require.NoError(t, err)
+		assert.Equal(t, first.RevNonce+1, second.RevNonce)
+		assert.Less(t, uint64(second.RevNonce), domain.RevocationNonceRangesStart)
+	})
+
+	t.Run("collision in the issuer scope", func(t *testing.T) {
+		issued, err := claimsService.Save(ctx, newRequest(19960426))
+		require.NoError(t, err)
+		req := newRequest(19960427)
+		req.RevNonce = common.ToPointer(uint64(issued.RevNonce))
+		_, err = claimsService.Save(ctx, req)
+		assert.ErrorIs(t, err, services.ErrRevocationNonceCollision)
+		assert.NoError(t, nonceService.Check(ctx, *otherDID, uint64(issued.RevNonce)))
+	})
+
+	t.Run("reserved ranges", func(t *testing.T) {
+		_, err := nonceService.ReserveRange(ctx, *did, " ", 5)
+		assert.ErrorIs(t, err, services.ErrRevocationNonceIntegration)
+		_, err = nonceService.ReserveRange(ctx, *did, "importer", 11)
+		assert.ErrorIs(t, err, services.ErrRevocationNonceRangeSize)
+
+		first, err := nonceService.ReserveRange(ctx, *did, "importer", 2)
+		require.NoError(t, err)
+		second, err := nonceService.ReserveRange(ctx, *otherDID, "importer", 5)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, uint64(first.Start), domain.RevocationNonceRangesStart)
+		assert.Equal(t, first.Start+1, first.End)
+		assert.Greater(t, second.Start, first.End)
+
+		ranges, err := nonceService.GetRanges(ctx, *did)
+		require.NoError(t, err)
+		require.Len(t, ranges, 1)
+		assert.Equal(t, first.ID, ranges[0].ID)
+
+		req := newRequest(19960428)
+		req.RevNonceRangeID = &first.ID
+		issued, err := claimsService.Save(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, first.Start, issued.RevNonce)
+
+		nonce, err := nonceService.Next(ctx, *did, &first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(first.End), nonce)
+		_, err = nonceService.Next(ctx, *did, &first.ID)
+		assert.ErrorIs(t, err, services.ErrRevocationNonceRangeExhausted)
+		_, err = nonceService.Next(ctx, *did, &second.ID)
+		assert.ErrorIs(t, err, services.ErrRevocationNonceRangeNotFound)
+	})
+}
@@ -0,0 +1,163 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestRevocationRequest(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	schemaRepository := repositories.NewSchema(*storage)
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+	schemaService := services.NewSchema(schemaRepository, docLoader)
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		true,
+	)
+	claimsService := services.NewClaim(services.ClaimDependencies{
+		Repo:                     claimsRepo,
+		IdentityService:          identityService,
+		MtService:                mtService,
+		IdentityStateRepository:  identityStateRepo,
+		Loader:                   docLoader,
+		Storage:                  storage,
+		Host:                     cfg.CredentialStatus.Iden3CommAgentStatus.GetURL(),
+		Publisher:                pubsub.NewMock(),
+		IPFSGatewayURL:           ipfsGateway,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+	})
+	revocationRequestService := services.NewRevocationRequest(repositories.NewRevocationRequest(), claimsService, schemaRepository, storage)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	typeC := "KYCAgeCredential"
+	schema, err := schemaService.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(schemaURL, typeC, common.ToPointer("some title"), uuid.NewString(), common.ToPointer("some description")))
+	require.NoError(t, err)
+
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	otherUserDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	session := &domain.HolderSession{UserDID: *userDID, IssuerDID: *did}
+
+	issue := func(t *testing.T) *domain.Claim {
+		t.Helper()
+		credentialSubject := map[string]any{
+			"id":           userDID.String(),
+			"birthday":     19960424,
+			"documentType": 2,
+		}
+		merklizedRootPosition := "index"
+		claim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(time.Hour)), typeC, nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+		require.NoError(t, err)
+		return claim
+	}
+	revoked := func(t *testing.T, id uuid.UUID) bool {
+		t.Helper()
+		claim, err := claimsService.GetByID(ctx, did, id)
+		require.NoError(t, err)
+		return claim.Revoked
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		claim := issue(t)
+		_, err := revocationRequestService.Request(ctx, session, claim.ID, "lost my phone")
+		assert.ErrorIs(t, err, services.ErrSelfRevocationDisabled)
+	})
+
+	t.Run("approval", func(t *testing.T) {
+		_, err := schemaService.UpdateSelfRevocation(ctx, *did, schema.ID, domain.SchemaSelfRevocationApproval)
+		require.NoError(t, err)
+		claim := issue(t)
+
+		_, err = revocationRequestService.Request(ctx, &domain.HolderSession{UserDID: *otherUserDID, IssuerDID: *did}, claim.ID, "")
+		assert.ErrorIs(t, err, services.ErrClaimNotFound)
+
+		rejected, err := revocationRequestService.Request(ctx, session, claim.ID, "lost my phone")
+		require.NoError(t, err)
+		assert.Equal(t, domain.RevocationRequestStatusPending, rejected.Status)
+		_, err = revocationRequestService.Request(ctx, session, claim.ID, "lost my phone")
+		assert.ErrorIs(t, err, services.ErrRevocationRequestPending)
+
+		rejected, err = revocationRequestService.Reject(ctx, *did, rejected.ID, common.ToPointer("found it"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RevocationRequestStatusRejected, rejected.Status)
+		assert.False(t, revoked(t, claim.ID))
+		_, err = revocationRequestService.Approve(ctx, *did, rejected.ID, nil)
+		assert.ErrorIs(t, err, services.ErrRevocationRequestResolved)
+
+		approved, err := revocationRequestService.Request(ctx, session, claim.ID, "lost it again")
+		require.NoError(t, err)
+		approved, err = revocationRequestService.Approve(ctx, *did, approved.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, domain.RevocationRequestStatusApproved, approved.Status)
+		assert.False(t, approved.AutoApproved)
+		assert.True(t, revoked(t, claim.ID))
+
+		_, err = revocationRequestService.Request(ctx, session, claim.ID, "")
+		assert.ErrorIs(t, err, services.ErrCredentialRevokedAlready)
+
+		pending := domain.RevocationRequestStatusPending
+		requests, err := revocationRequestService.GetAll(ctx, *did, &pending)
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("auto", func(t *testing.T) {
+		_, err := schemaService.UpdateSelfRevocation(ctx, *did, schema.ID, domain.SchemaSelfRevocationAuto)
+		require.NoError(t, err)
+		claim := issue(t)
+
+		request, err := revocationRequestService.Request(ctx, session, claim.ID, "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.RevocationRequestStatusApproved, request.Status)
+		assert.True(t, request.AutoApproved)
+		assert.True(t, revoked(t, claim.ID))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := revocationRequestService.Approve(ctx, *did, uuid.New(), nil)
+		assert.ErrorIs(t, err, services.ErrRevocationRequestNotFound)
+		_, err = revocationRequestService.Reject(ctx, *did, uuid.New(), nil)
+		assert.ErrorIs(t, err, services.ErrRevocationRequestNotFound)
+		_, err = revocationRequestService.Request(ctx, session, uuid.New(), "")
+		assert.ErrorIs(t, err, services.ErrClaimNotFound)
+	})
+}
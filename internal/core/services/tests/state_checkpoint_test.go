@@ -0,0 +1,79 @@
+package services_tests
+
+import (
+	"context"
+	"testing"
+
+	commonEth "github.com/ethereum/go-ethereum/common"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+type fakeStateAnchor struct {
+	anchored map[string]int
+}
+
+func (a *fakeStateAnchor) Type() string {
+	return domain.StateAnchorOpenTimestamps
+}
+
+func (a *fakeStateAnchor) Anchor(_ context.Context, state *merkletree.Hash) (string, []byte, error) {
+	a.anchored[state.Hex()]++
+	return "https://calendar.test", []byte{0x00, 0x01}, nil
+}
+
+func TestStateCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	revocationRepository := repositories.NewRevocation()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.URL, nil, commonEth.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), cfg.CredentialStatus, rhsFactory, revocationStatusResolver, nil, nil)
+
+	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+	state, err := identityStateRepo.GetLatestStateByIdentifier(ctx, storage.Pgx, did)
+	require.NoError(t, err)
+
+	t.Run("disabled", func(t *testing.T) {
+		checkpointService := services.NewStateCheckpoint(repositories.NewStateCheckpoint(), identityService, identityStateRepo, nil, storage)
+		checkpointService.CheckpointAll(ctx)
+		checkpoints, err := checkpointService.GetAll(ctx, *did)
+		require.NoError(t, err)
+		assert.Empty(t, checkpoints)
+	})
+
+	t.Run("anchors the latest state once", func(t *testing.T) {
+		anchor := &fakeStateAnchor{anchored: map[string]int{}}
+		checkpointService := services.NewStateCheckpoint(repositories.NewStateCheckpoint(), identityService, identityStateRepo, anchor, storage)
+
+		checkpointService.CheckpointAll(ctx)
+		checkpointService.CheckpointAll(ctx)
+		assert.Equal(t, 1, anchor.anchored[*state.State])
+
+		checkpoints, err := checkpointService.GetAll(ctx, *did)
+		require.NoError(t, err)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, *state.State, checkpoints[0].State)
+		assert.Equal(t, domain.StateAnchorOpenTimestamps, checkpoints[0].AnchorType)
+		assert.Equal(t, "https://calendar.test", checkpoints[0].Reference)
+		assert.Equal(t, []byte{0x00, 0x01}, checkpoints[0].Receipt)
+	})
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"golang.org/x/text/language"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	ErrTranslationNotFound       = errors.New("translation not found")                                                  // ErrTranslationNotFound the issuer has no translations for the locale
+	ErrTranslationInvalidLocale  = errors.New("locale must be a BCP 47 language tag, e.g. es or pt-BR")                 // ErrTranslationInvalidLocale the locale cannot be parsed
+	ErrTranslationEmptyMessages  = errors.New("translations cannot be empty")                                           // ErrTranslationEmptyMessages there are no messages to save
+	ErrTranslationInvalidKey     = errors.New("unknown translation key")                                                // ErrTranslationInvalidKey the key is not a holder facing string
+	ErrTranslationMessageTooLong = fmt.Errorf("translations cannot be longer than %d characters", maxTranslationLength) // ErrTranslationMessageTooLong a message exceeds maxTranslationLength
+)
+
+// maxTranslationLength is the max length of a translated message
+const maxTranslationLength = 1024
+
+type translation struct {
+	repo    ports.TranslationRepository
+	storage *db.Storage
+}
+
+// NewTranslation returns a new translation service
+func NewTranslation(repo ports.TranslationRepository, storage *db.Storage) ports.TranslationService {
+	return &translation{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Save validates and stores the translations of an issuer to a locale, replacing the previous ones
+func (t *translation) Save(ctx context.Context, issuerDID w3c.DID, locale string, messages map[string]string) (*domain.TranslationBundle, error) {
+	locale, err := canonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, ErrTranslationEmptyMessages
+	}
+	for key, msg := range messages {
+		if !domain.IsValidTranslationKey(key) {
+			return nil, fmt.Errorf("%w: %s", ErrTranslationInvalidKey, key)
+		}
+		if len(msg) > maxTranslationLength {
+			return nil, ErrTranslationMessageTooLong
+		}
+	}
+
+	bundle := &domain.TranslationBundle{
+		IssuerDID:  issuerDID,
+		Locale:     locale,
+		Messages:   messages,
+		ModifiedAt: time.Now().UTC(),
+	}
+	if err := t.repo.Save(ctx, t.storage.Pgx, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// Get returns the translations of an issuer to a locale
+func (t *translation) Get(ctx context.Context, issuerDID w3c.DID, locale string) (*domain.TranslationBundle, error) {
+	locale, err := canonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := t.repo.Get(ctx, t.storage.Pgx, issuerDID, locale)
+	if errors.Is(err, repositories.ErrTranslationDoesNotExist) {
+		return nil, ErrTranslationNotFound
+	}
+	return bundle, err
+}
+
+// GetAll returns the translations of an issuer to every locale
+func (t *translation) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.TranslationBundle, error) {
+	return t.repo.GetAll(ctx, t.storage.Pgx, issuerDID)
+}
+
+// Delete removes the translations of an issuer to a locale
+func (t *translation) Delete(ctx context.Context, issuerDID w3c.DID, locale string) error {
+	locale, err := canonicalLocale(locale)
+	if err != nil {
+		return err
+	}
+	err = t.repo.Delete(ctx, t.storage.Pgx, issuerDID, locale)
+	if errors.Is(err, repositories.ErrTranslationDoesNotExist) {
+		return ErrTranslationNotFound
+	}
+	return err
+}
+
+// Localizer returns a localizer with the translations to the first of the given locales the issuer has translations
+// for. A locale also matches the translations to its base language, e.g. es-AR matches es. Invalid and empty locales
+// are skipped. If no locale matches, or the translations cannot be loaded, the localizer returns the default texts.
+func (t *translation) Localizer(ctx context.Context, issuerDID w3c.DID, locales ...string) *domain.Localizer {
+	bundles, err := t.repo.GetAll(ctx, t.storage.Pgx, issuerDID)
+	if err != nil {
+		log.Error(ctx, "loading the translations", "err", err)
+		return domain.NewLocalizer(nil)
+	}
+	return domain.NewLocalizer(matchTranslation(bundles, locales))
+}
+
+func matchTranslation(bundles []domain.TranslationBundle, locales []string) *domain.TranslationBundle {
+	byLocale := make(map[string]*domain.TranslationBundle, len(bundles))
+	for i := range bundles {
+		byLocale[bundles[i].Locale] = &bundles[i]
+	}
+	for _, locale := range locales {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			continue
+		}
+		if bundle, ok := byLocale[tag.String()]; ok {
+			return bundle
+		}
+		base, _ := tag.Base()
+		if bundle, ok := byLocale[base.String()]; ok {
+			return bundle
+		}
+	}
+	return nil
+}
+
+func canonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", ErrTranslationInvalidLocale
+	}
+	return tag.String(), nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/iden3/go-circuits/v2"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const trustRegistryKeyPrefix = "trust-registry-"
+
+var (
+	ErrUntrustedHolder = errors.New("the holder is not trusted")                                      // ErrUntrustedHolder the holder is not in the trust registry
+	ErrUntrustedIssuer = errors.New("the issuer of a credential proved by the holder is not trusted") // ErrUntrustedIssuer the issuer of a proof is not in the trust registry
+)
+
+type trustRegistry struct {
+	registry ports.TrustRegistry
+	cache    cache.Cache
+	cfg      config.TrustRegistry
+}
+
+// NewTrustRegistry returns a new trust registry service. The answers of the registry are cached and the allow and
+// deny lists of the configuration override them.
+func NewTrustRegistry(registry ports.TrustRegistry, c cache.Cache, cfg config.TrustRegistry) ports.TrustRegistryService {
+	return &trustRegistry{
+		registry: registry,
+		cache:    c,
+		cfg:      cfg,
+	}
+}
+
+// Check returns the trust status of the DID. DIDs are not trusted if the registry can not be consulted.
+func (t *trustRegistry) Check(ctx context.Context, did string) domain.TrustStatus {
+	status := domain.TrustStatus{DID: did, CheckedAt: time.Now()}
+	switch {
+	case containsString(t.cfg.DenyList, did):
+		status.Source = domain.TrustSourceDenyList
+		return status
+	case containsString(t.cfg.AllowList, did):
+		status.Trusted, status.Source = true, domain.TrustSourceAllowList
+		return status
+	case t.registry == nil:
+		status.Source = domain.TrustSourceDisabled
+		if len(t.cfg.AllowList) > 0 {
+			// the allow list is the registry
+			status.Source = domain.TrustSourceAllowList
+		}
+		return status
+	}
+
+	if t.cache.Get(ctx, trustRegistryKeyPrefix+did, &status) {
+		return status
+	}
+	entry, err := t.registry.Lookup(ctx, did)
+	if err != nil {
+		log.Warn(ctx, "consulting the trust registry", "err", err, "did", did)
+		status.Source = domain.TrustSourceUnavailable
+		return status
+	}
+	status.Source = domain.TrustSourceRegistry
+	if entry != nil {
+		status.Trusted, status.Name = true, entry.Name
+	}
+	if err := t.cache.Set(ctx, trustRegistryKeyPrefix+did, status, t.cfg.CacheTTL); err != nil {
+		log.Error(ctx, "caching the trust registry answer", "err", err, "did", did)
+	}
+	return status
+}
+
+// VerifyAuthorization checks that the holder of the authorization response and the issuers of the credentials it
+// proves are trusted, if the trust registry is enforced for them
+func (t *trustRegistry) VerifyAuthorization(ctx context.Context, arm *protocol.AuthorizationResponseMessage) error {
+	if t.cfg.EnforceHolders {
+		if status := t.Check(ctx, arm.From); !status.Trusted {
+			log.Warn(ctx, "authentication of an untrusted holder", "holder", arm.From, "source", status.Source)
+			return ErrUntrustedHolder
+		}
+	}
+	if t.cfg.EnforceIssuers {
+		for _, proof := range arm.Body.Scope {
+			issuer, err := proofIssuer(proof)
+			if err != nil {
+				log.Warn(ctx, "getting the issuer of the proof", "err", err, "circuit", proof.CircuitID)
+				return ErrUntrustedIssuer
+			}
+			if status := t.Check(ctx, issuer); !status.Trusted {
+				log.Warn(ctx, "authentication proving a credential of an untrusted issuer", "holder", arm.From, "issuer", issuer, "source", status.Source)
+				return ErrUntrustedIssuer
+			}
+		}
+	}
+	return nil
+}
+
+// proofIssuer returns the DID of the issuer in the public signals of the proof
+func proofIssuer(proof protocol.ZeroKnowledgeProofResponse) (string, error) {
+	pubSignals, err := json.Marshal(proof.PubSignals)
+	if err != nil {
+		return "", err
+	}
+	outputs, err := circuits.UnmarshalCircuitOutput(circuits.CircuitID(proof.CircuitID), pubSignals)
+	if err != nil {
+		return "", err
+	}
+	issuerID, ok := outputs["issuerID"].(*core.ID)
+	if !ok || issuerID == nil {
+		return "", errors.New("the proof has no issuer")
+	}
+	did, err := core.ParseDIDFromID(*issuerID)
+	if err != nil {
+		return "", err
+	}
+	return did.String(), nil
+}
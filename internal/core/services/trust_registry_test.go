@@ -0,0 +1,117 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+type trustRegistryStub struct {
+	entries map[string]string
+	err     error
+	lookups int
+}
+
+func (r *trustRegistryStub) Lookup(_ context.Context, did string) (*ports.TrustRegistryEntry, error) {
+	r.lookups++
+	if r.err != nil {
+		return nil, r.err
+	}
+	name, ok := r.entries[did]
+	if !ok {
+		return nil, nil
+	}
+	return &ports.TrustRegistryEntry{Name: name}, nil
+}
+
+func TestTrustRegistry_Check(t *testing.T) {
+	ctx := context.Background()
+	const trusted, untrusted, denied = "did:example:trusted", "did:example:untrusted", "did:example:denied"
+
+	t.Run("should answer with the registry and cache the answer", func(t *testing.T) {
+		registry := &trustRegistryStub{entries: map[string]string{trusted: "Acme"}}
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{CacheTTL: time.Minute})
+
+		status := trustRegistry.Check(ctx, trusted)
+		assert.True(t, status.Trusted)
+		assert.Equal(t, domain.TrustSourceRegistry, status.Source)
+		assert.Equal(t, "Acme", status.Name)
+
+		status = trustRegistry.Check(ctx, untrusted)
+		assert.False(t, status.Trusted)
+		assert.Equal(t, domain.TrustSourceRegistry, status.Source)
+
+		assert.True(t, trustRegistry.Check(ctx, trusted).Trusted)
+		assert.Equal(t, 2, registry.lookups)
+	})
+
+	t.Run("should override the registry with the allow and deny lists", func(t *testing.T) {
+		registry := &trustRegistryStub{entries: map[string]string{denied: "Denied"}}
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{
+			CacheTTL:  time.Minute,
+			AllowList: []string{untrusted},
+			DenyList:  []string{denied},
+		})
+
+		status := trustRegistry.Check(ctx, untrusted)
+		assert.True(t, status.Trusted)
+		assert.Equal(t, domain.TrustSourceAllowList, status.Source)
+
+		status = trustRegistry.Check(ctx, denied)
+		assert.False(t, status.Trusted)
+		assert.Equal(t, domain.TrustSourceDenyList, status.Source)
+		assert.Equal(t, 0, registry.lookups)
+	})
+
+	t.Run("should not trust nor cache when the registry is unavailable", func(t *testing.T) {
+		registry := &trustRegistryStub{err: errors.New("connection refused")}
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{CacheTTL: time.Minute})
+
+		status := trustRegistry.Check(ctx, trusted)
+		assert.False(t, status.Trusted)
+		assert.Equal(t, domain.TrustSourceUnavailable, status.Source)
+		trustRegistry.Check(ctx, trusted)
+		assert.Equal(t, 2, registry.lookups)
+	})
+
+	t.Run("should report disabled without registry", func(t *testing.T) {
+		trustRegistry := services.NewTrustRegistry(nil, cache.NewMemoryCache(), config.TrustRegistry{})
+		assert.Equal(t, domain.TrustSourceDisabled, trustRegistry.Check(ctx, trusted).Source)
+	})
+}
+
+func TestTrustRegistry_VerifyAuthorization(t *testing.T) {
+	ctx := context.Background()
+	registry := &trustRegistryStub{entries: map[string]string{"did:example:holder": "Holder"}}
+	arm := func(from string) *protocol.AuthorizationResponseMessage {
+		return &protocol.AuthorizationResponseMessage{From: from}
+	}
+
+	t.Run("should accept everyone when holders are not enforced", func(t *testing.T) {
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{CacheTTL: time.Minute})
+		assert.NoError(t, trustRegistry.VerifyAuthorization(ctx, arm("did:example:stranger")))
+	})
+
+	t.Run("should reject untrusted holders when enforced", func(t *testing.T) {
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{CacheTTL: time.Minute, EnforceHolders: true})
+		assert.NoError(t, trustRegistry.VerifyAuthorization(ctx, arm("did:example:holder")))
+		assert.ErrorIs(t, trustRegistry.VerifyAuthorization(ctx, arm("did:example:stranger")), services.ErrUntrustedHolder)
+	})
+
+	t.Run("should reject proofs without issuer when issuers are enforced", func(t *testing.T) {
+		trustRegistry := services.NewTrustRegistry(registry, cache.NewMemoryCache(), config.TrustRegistry{CacheTTL: time.Minute, EnforceIssuers: true})
+		msg := arm("did:example:holder")
+		msg.Body.Scope = []protocol.ZeroKnowledgeProofResponse{{ID: 1, CircuitID: "unknown"}}
+		assert.ErrorIs(t, trustRegistry.VerifyAuthorization(ctx, msg), services.ErrUntrustedIssuer)
+	})
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrUsageInvalidPeriod the end of the usage report period is not after its start
+var ErrUsageInvalidPeriod = errors.New("the end of the period must be after its start")
+
+type usage struct {
+	repo    ports.UsageRepository
+	storage *db.Storage
+}
+
+// NewUsage returns a new usage service
+func NewUsage(repo ports.UsageRepository, storage *db.Storage) ports.UsageService {
+	return &usage{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Get returns the usage report of the issuers matching the filter between from (included) and to (excluded)
+func (u *usage) Get(ctx context.Context, from, to time.Time, filter domain.UsageFilter) (*domain.UsageReport, error) {
+	from, to = from.UTC(), to.UTC()
+	if !to.After(from) {
+		return nil, ErrUsageInvalidPeriod
+	}
+
+	issuers, err := u.repo.Get(ctx, u.storage.Pgx, from, to, filter)
+	if err != nil {
+		log.Error(ctx, "getting usage", "err", err)
+		return nil, err
+	}
+	return &domain.UsageReport{From: from, To: to, Issuers: issuers}, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+// ErrCredentialNotPublished means the credential has no merkle tree proof against a published state yet
+var ErrCredentialNotPublished = errors.New("the credential has no merkle tree proof against a published state")
+
+type verificationBundle struct {
+	claimsService           ports.ClaimsService
+	identityStateRepository ports.IdentityStateRepository
+	loader                  loader.DocumentLoader
+	storage                 *db.Storage
+	stateContract           string
+}
+
+// NewVerificationBundle returns a new verification bundle service
+func NewVerificationBundle(claimsService ports.ClaimsService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, stateContract string) ports.VerificationBundleService {
+	return &verificationBundle{
+		claimsService:           claimsService,
+		identityStateRepository: identityStateRepository,
+		loader:                  ld,
+		storage:                 storage,
+		stateContract:           stateContract,
+	}
+}
+
+// Get builds the verification bundle of the credential. Only credentials with a merkle tree proof against a published
+// state can be exported. The non revocation proof is built against the latest published state of the issuer.
+func (v *verificationBundle) Get(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID) (*domain.VerificationBundle, error) {
+	claim, err := v.claimsService.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if !claim.MtProof || claim.IdentityState == nil || claim.MTPProof.Status != pgtype.Present {
+		return nil, ErrCredentialNotPublished
+	}
+
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert claim to w3cCredential: %w", err)
+	}
+
+	state, err := v.identityStateRepository.GetConfirmedState(ctx, v.storage.Pgx, issuerDID, *claim.IdentityState)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityStateDoesNotExist) {
+			return nil, ErrCredentialNotPublished
+		}
+		return nil, err
+	}
+
+	exportedAt := time.Now().UTC()
+	revocationState, revocationStatus, err := v.claimsService.GetRevocationStatusAtState(ctx, issuerDID, uint64(claim.RevNonce), ports.StateQuery{At: &exportedAt})
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := core.ChainIDfromDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := v.documents(vc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VerificationBundle{
+		Credential:       *vc,
+		State:            *state,
+		RevocationStatus: *revocationStatus,
+		RevocationState:  *revocationState,
+		ChainID:          chainID,
+		StateContract:    v.stateContract,
+		Documents:        documents,
+		ExportedAt:       exportedAt,
+	}, nil
+}
+
+// documents loads the JSON-LD contexts and the JSON schema of the credential
+func (v *verificationBundle) documents(vc *verifiable.W3CCredential) (map[string]any, error) {
+	urls := append([]string{}, vc.Context...)
+	if vc.CredentialSchema.ID != "" {
+		urls = append(urls, vc.CredentialSchema.ID)
+	}
+
+	documents := make(map[string]any, len(urls))
+	for _, url := range urls {
+		if _, ok := documents[url]; ok {
+			continue
+		}
+		doc, err := v.loader.LoadDocument(url)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load document %s: %w", url, err)
+		}
+		documents[url] = doc.Document
+	}
+	return documents, nil
+}
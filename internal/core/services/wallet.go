@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	maxWalletUserAgent = 256
+	maxWalletAccept    = 10
+)
+
+// ErrWalletProfileNotFound the holder has not sent any message to the issuer yet
+var ErrWalletProfileNotFound = errors.New("wallet profile not found")
+
+type wallet struct {
+	repo    ports.WalletProfileRepository
+	storage *db.Storage
+}
+
+// NewWallet returns a new wallet service
+func NewWallet(repo ports.WalletProfileRepository, storage *db.Storage) ports.WalletService {
+	return &wallet{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Observe records what a request of the wallet of the holder tells about it. Errors are only logged, so observing a
+// wallet never makes its request fail.
+func (s *wallet) Observe(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, observation domain.WalletObservation) {
+	if len(observation.UserAgent) > maxWalletUserAgent {
+		observation.UserAgent = observation.UserAgent[:maxWalletUserAgent]
+	}
+	if len(observation.Accept) > maxWalletAccept {
+		observation.Accept = observation.Accept[:maxWalletAccept]
+	}
+	if err := s.repo.Observe(ctx, s.storage.Pgx, issuerDID, userDID, observation); err != nil {
+		log.Error(ctx, "recording the wallet profile", "err", err, "issuer", issuerDID.String(), "user", userDID.String())
+	}
+}
+
+// Get returns the profile of the wallet of the holder
+func (s *wallet) Get(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (*domain.WalletProfile, error) {
+	profile, err := s.repo.Get(ctx, s.storage.Pgx, issuerDID, userDID)
+	if errors.Is(err, repositories.ErrWalletProfileDoesNotExist) {
+		return nil, ErrWalletProfileNotFound
+	}
+	return profile, err
+}
+
+// GetUsage returns the wallets used by the holders of the issuer grouped by user agent
+func (s *wallet) GetUsage(ctx context.Context, issuerDID w3c.DID) ([]domain.WalletUsage, error) {
+	return s.repo.GetUsage(ctx, s.storage.Pgx, issuerDID)
+}
+
+// QRFormat returns the QR code format the wallet of the holder supports. Unknown wallets get links, which every
+// wallet can read.
+func (s *wallet) QRFormat(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) domain.WalletQRFormat {
+	profile, err := s.Get(ctx, issuerDID, userDID)
+	if err != nil {
+		if !errors.Is(err, ErrWalletProfileNotFound) {
+			log.Error(ctx, "getting the wallet profile", "err", err, "issuer", issuerDID.String(), "user", userDID.String())
+		}
+		return domain.WalletQRFormatLink
+	}
+	return profile.QRFormat()
+}
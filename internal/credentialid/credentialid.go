@@ -0,0 +1,40 @@
+package credentialid
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/urn"
+)
+
+// Placeholder is replaced with the credential uuid when building the credential id
+const Placeholder = "{uuid}"
+
+// ErrIDDoesNotMatchTemplate the credential id was not built with the template
+var ErrIDDoesNotMatchTemplate = errors.New("credential id does not match the template")
+
+// Template is the credential id uri template, e.g. https://credentials.acme.com/{uuid}.
+// The empty template builds urn:uuid ids.
+type Template string
+
+// Build returns the credential id for the given uuid
+func (t Template) Build(id uuid.UUID) string {
+	if t == "" {
+		return string(urn.FromUUID(id))
+	}
+	return strings.Replace(string(t), Placeholder, id.String(), 1)
+}
+
+// UUID returns the uuid of a credential id built with the template
+func (t Template) UUID(credentialID string) (uuid.UUID, error) {
+	if t == "" {
+		return urn.UUIDFromURNString(credentialID)
+	}
+	prefix, suffix, found := strings.Cut(string(t), Placeholder)
+	if !found || !strings.HasPrefix(credentialID, prefix) || !strings.HasSuffix(credentialID, suffix) || len(credentialID) < len(prefix)+len(suffix) {
+		return uuid.Nil, ErrIDDoesNotMatchTemplate
+	}
+	return uuid.Parse(credentialID[len(prefix) : len(credentialID)-len(suffix)])
+}
@@ -0,0 +1,53 @@
+package credentialid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_Build(t *testing.T) {
+	id := uuid.New()
+	for _, ts := range []struct {
+		template Template
+		expected string
+	}{
+		{"", "urn:uuid:" + id.String()},
+		{"urn:uuid:{uuid}", "urn:uuid:" + id.String()},
+		{"https://credentials.acme.com/{uuid}", "https://credentials.acme.com/" + id.String()},
+		{"urn:acme:credential:{uuid}:v1", "urn:acme:credential:" + id.String() + ":v1"},
+	} {
+		t.Run(string(ts.template), func(t *testing.T) {
+			assert.Equal(t, ts.expected, ts.template.Build(id))
+		})
+	}
+}
+
+func TestTemplate_UUID(t *testing.T) {
+	id := uuid.New()
+	for _, ts := range []struct {
+		name         string
+		template     Template
+		credentialID string
+		err          bool
+	}{
+		{"default template", "", "urn:uuid:" + id.String(), false},
+		{"url template", "https://credentials.acme.com/{uuid}", "https://credentials.acme.com/" + id.String(), false},
+		{"template with suffix", "urn:acme:credential:{uuid}:v1", "urn:acme:credential:" + id.String() + ":v1", false},
+		{"other prefix", "https://credentials.acme.com/{uuid}", "https://other.com/" + id.String(), true},
+		{"not an uuid", "https://credentials.acme.com/{uuid}", "https://credentials.acme.com/1234", true},
+		{"overlapping prefix and suffix", "a{uuid}a", "a", true},
+	} {
+		t.Run(ts.name, func(t *testing.T) {
+			got, err := ts.template.UUID(ts.credentialID)
+			if ts.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, id, got)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connections
+    ADD COLUMN last_verified_at timestamptz NULL;
+UPDATE connections SET last_verified_at = modified_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE connections
+    DROP COLUMN last_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenants
+(
+    id           uuid        NOT NULL PRIMARY KEY,
+    name         text        NOT NULL,
+    api_key_hash text        NOT NULL,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT tenants_name_key UNIQUE (name)
+);
+
+ALTER TABLE identities
+    ADD COLUMN tenant_id uuid NULL REFERENCES tenants (id);
+CREATE INDEX identities_tenant_id_idx ON identities (tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE identities
+    DROP COLUMN tenant_id;
+DROP TABLE IF EXISTS tenants;
+-- +goose StatementEnd
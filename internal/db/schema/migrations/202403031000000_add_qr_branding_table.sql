@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE qr_branding
+(
+    issuer_id        text        NOT NULL PRIMARY KEY REFERENCES identities (identifier),
+    logo             bytea       NULL,
+    foreground_color text        NOT NULL,
+    background_color text        NOT NULL,
+    error_correction text        NOT NULL,
+    modified_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS qr_branding;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identity_states ADD COLUMN gas_used bigint NULL;
+ALTER TABLE identity_states ADD COLUMN tx_fee numeric NULL;
+ALTER TABLE revocation ADD COLUMN identity_state varchar(64) NULL;
+CREATE INDEX revocation_identity_state_idx ON revocation (identifier, identity_state);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS revocation_identity_state_idx;
+ALTER TABLE revocation DROP COLUMN IF EXISTS identity_state;
+ALTER TABLE identity_states DROP COLUMN IF EXISTS tx_fee;
+ALTER TABLE identity_states DROP COLUMN IF EXISTS gas_used;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys
+(
+    id           uuid        NOT NULL PRIMARY KEY,
+    name         text        NOT NULL,
+    key_hash     text        NOT NULL,
+    scopes       text[]      NOT NULL,
+    allowed_ips  text[]      NOT NULL DEFAULT '{}',
+    expires_at   timestamptz NULL,
+    revoked_at   timestamptz NULL,
+    last_used_at timestamptz NULL,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT api_keys_key_hash_key UNIQUE (key_hash)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connections
+    ADD COLUMN display_name text NULL,
+    ADD COLUMN external_id  text NULL,
+    ADD COLUMN email        text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE connections
+    DROP COLUMN IF EXISTS display_name,
+    DROP COLUMN IF EXISTS external_id,
+    DROP COLUMN IF EXISTS email;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims ADD COLUMN external_id text NULL;
+ALTER TABLE links ADD COLUMN external_id text NULL;
+CREATE INDEX claims_issuer_external_id_index ON claims (issuer, external_id) WHERE external_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_issuer_external_id_index;
+ALTER TABLE links DROP COLUMN IF EXISTS external_id;
+ALTER TABLE claims DROP COLUMN IF EXISTS external_id;
+-- +goose StatementEnd
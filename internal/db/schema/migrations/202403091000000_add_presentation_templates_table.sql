@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE presentation_templates
+(
+    id               uuid        NOT NULL PRIMARY KEY,
+    issuer_id        text        NOT NULL REFERENCES identities (identifier),
+    name             text        NOT NULL,
+    description      text        NULL,
+    schema_url       text        NOT NULL,
+    credential_type  text        NOT NULL,
+    circuit_id       text        NOT NULL,
+    allowed_issuers  text[]      NOT NULL,
+    conditions       jsonb       NOT NULL,
+    disclosed_fields text[]      NOT NULL DEFAULT '{}',
+    created_at       timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT presentation_templates_issuer_id_name_key UNIQUE (issuer_id, name)
+);
+
+ALTER TABLE links ADD COLUMN presentation_template text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS presentation_template;
+DROP TABLE IF EXISTS presentation_templates;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links ADD COLUMN payment jsonb NULL;
+
+CREATE TABLE payments
+(
+    id            uuid                     NOT NULL PRIMARY KEY,
+    issuer_id     text                     NOT NULL,
+    link_id       uuid                     NOT NULL,
+    session_id    uuid                     NOT NULL,
+    user_did      text                     NOT NULL,
+    chain_id      integer                  NOT NULL,
+    recipient     text                     NOT NULL,
+    amount        text                     NOT NULL,
+    currency      text                     NOT NULL,
+    token_address text                     NOT NULL DEFAULT '',
+    status        text                     NOT NULL,
+    tx_id         text                     NULL,
+    created_at    timestamp with time zone NOT NULL DEFAULT now(),
+    paid_at       timestamp with time zone NULL,
+    CONSTRAINT payments_links_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX payments_chain_tx_id_index ON payments (chain_id, lower(tx_id)) WHERE tx_id IS NOT NULL;
+CREATE INDEX payments_link_user_index ON payments (issuer_id, link_id, user_did);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payments;
+ALTER TABLE links DROP COLUMN IF EXISTS payment;
+-- +goose StatementEnd
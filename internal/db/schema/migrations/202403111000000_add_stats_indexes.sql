@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX claims_identifier_created_at_index ON claims (identifier, created_at);
+CREATE INDEX connections_issuer_created_at_index ON connections (issuer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_identifier_created_at_index;
+DROP INDEX IF EXISTS connections_issuer_created_at_index;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE translations
+(
+    issuer_id   text        NOT NULL REFERENCES identities (identifier),
+    locale      text        NOT NULL,
+    messages    jsonb       NOT NULL,
+    modified_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issuer_id, locale)
+);
+
+ALTER TABLE links ADD COLUMN locale text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS locale;
+DROP TABLE IF EXISTS translations;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links ADD COLUMN prerequisites jsonb NULL;
+
+CREATE TABLE link_prerequisite_proofs
+(
+    id              uuid                     NOT NULL PRIMARY KEY,
+    issuer_id       text                     NOT NULL,
+    link_id         uuid                     NOT NULL,
+    session_id      uuid                     NOT NULL,
+    user_did        text                     NOT NULL,
+    schema_url      text                     NOT NULL,
+    credential_type text                     NOT NULL,
+    proof           jsonb                    NOT NULL,
+    created_at      timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT link_prerequisite_proofs_links_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE CASCADE
+);
+CREATE INDEX link_prerequisite_proofs_link_index ON link_prerequisite_proofs (issuer_id, link_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_prerequisite_proofs;
+ALTER TABLE links DROP COLUMN IF EXISTS prerequisites;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE issuer_profiles
+(
+    id             uuid        NOT NULL PRIMARY KEY,
+    issuer_id      text        NOT NULL REFERENCES identities (identifier),
+    nonce          text        NOT NULL,
+    profile_did    text        NOT NULL,
+    name           text        NOT NULL,
+    description    text        NULL,
+    logo_url       text        NULL,
+    allowed_proofs text[]      NOT NULL,
+    created_at     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT issuer_profiles_issuer_id_name_key UNIQUE (issuer_id, name),
+    CONSTRAINT issuer_profiles_profile_did_key UNIQUE (profile_did)
+);
+
+ALTER TABLE claims ADD COLUMN profile_id uuid NULL REFERENCES issuer_profiles (id);
+CREATE INDEX claims_issuer_profile_id_index ON claims (issuer, profile_id) WHERE profile_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_issuer_profile_id_index;
+ALTER TABLE claims DROP COLUMN IF EXISTS profile_id;
+DROP TABLE IF EXISTS issuer_profiles;
+-- +goose StatementEnd
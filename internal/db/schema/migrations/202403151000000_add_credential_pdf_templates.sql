@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_pdf_templates
+(
+    issuer_id    text        NOT NULL REFERENCES identities (identifier),
+    schema_id    uuid        NOT NULL REFERENCES schemas (id) ON DELETE CASCADE,
+    title        text        NOT NULL,
+    header       text        NOT NULL,
+    footer       text        NOT NULL,
+    accent_color text        NOT NULL,
+    fields       jsonb       NOT NULL,
+    modified_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_pdf_templates_pkey PRIMARY KEY (issuer_id, schema_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS credential_pdf_templates;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE link_recipients
+(
+    id                 uuid        NOT NULL PRIMARY KEY,
+    link_id            uuid        NOT NULL REFERENCES links (id) ON DELETE CASCADE,
+    code               text        NOT NULL,
+    credential_subject jsonb       NOT NULL,
+    claim_id           uuid        NULL,
+    consumed_at        timestamptz NULL,
+    created_at         timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_recipients_link_code_key UNIQUE (link_id, code)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_recipients;
+-- +goose StatementEnd
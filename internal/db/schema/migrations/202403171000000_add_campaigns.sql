@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE campaigns
+(
+    id                  uuid        NOT NULL PRIMARY KEY,
+    issuer_id           text        NOT NULL REFERENCES identities (identifier),
+    name                text        NOT NULL,
+    description         text        NULL,
+    start_at            timestamptz NULL,
+    end_at              timestamptz NULL,
+    webhook_url         text        NULL,
+    milestones          integer[]   NOT NULL DEFAULT '{}',
+    notified_milestones integer[]   NOT NULL DEFAULT '{}',
+    created_at          timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX campaigns_issuer_id_idx ON campaigns (issuer_id);
+
+-- a link or a credential belongs to one campaign at most
+CREATE TABLE campaign_links
+(
+    link_id     uuid NOT NULL PRIMARY KEY REFERENCES links (id) ON DELETE CASCADE,
+    campaign_id uuid NOT NULL REFERENCES campaigns (id) ON DELETE CASCADE
+);
+
+CREATE INDEX campaign_links_campaign_id_idx ON campaign_links (campaign_id);
+
+CREATE TABLE campaign_credentials
+(
+    claim_id    uuid NOT NULL,
+    issuer_id   text NOT NULL,
+    campaign_id uuid NOT NULL REFERENCES campaigns (id) ON DELETE CASCADE,
+    CONSTRAINT campaign_credentials_pkey PRIMARY KEY (claim_id, issuer_id),
+    CONSTRAINT campaign_credentials_claims_fkey FOREIGN KEY (claim_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE
+);
+
+CREATE INDEX campaign_credentials_campaign_id_idx ON campaign_credentials (campaign_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS campaign_credentials;
+DROP TABLE IF EXISTS campaign_links;
+DROP TABLE IF EXISTS campaigns;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wallet_profiles
+(
+    issuer_id            text        NOT NULL,
+    user_id              text        NOT NULL,
+    user_agent           text        NULL,
+    media_types          text[]      NOT NULL DEFAULT '{}',
+    rejected_media_types text[]      NOT NULL DEFAULT '{}',
+    accept               text[]      NOT NULL DEFAULT '{}',
+    message_types        text[]      NOT NULL DEFAULT '{}',
+    first_seen_at        timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at         timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT wallet_profiles_pkey PRIMARY KEY (issuer_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS wallet_profiles;
+-- +goose StatementEnd
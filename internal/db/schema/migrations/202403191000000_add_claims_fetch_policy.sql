@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims ADD COLUMN fetch_policy text NOT NULL DEFAULT 'subject';
+ALTER TABLE claims ADD COLUMN fetch_auth_minutes int NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE claims DROP COLUMN IF EXISTS fetch_auth_minutes;
+ALTER TABLE claims DROP COLUMN IF EXISTS fetch_policy;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE link_holders
+(
+    link_id       uuid        NOT NULL REFERENCES links (id) ON DELETE CASCADE,
+    holder_digest text        NOT NULL,
+    user_id       text        NOT NULL,
+    claim_id      uuid        NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (link_id, holder_digest)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_holders;
+-- +goose StatementEnd
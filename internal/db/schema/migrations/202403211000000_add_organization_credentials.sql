@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organization_credentials
+(
+    id                uuid        NOT NULL PRIMARY KEY,
+    issuer_id         text        NOT NULL REFERENCES identities (identifier),
+    credential_id     text        NULL,
+    credential_issuer text        NOT NULL,
+    credential_type   text        NOT NULL,
+    credential        jsonb       NOT NULL,
+    public            boolean     NOT NULL DEFAULT false,
+    expires_at        timestamptz NULL,
+    created_at        timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT organization_credentials_credential_id_key UNIQUE (issuer_id, credential_id)
+);
+CREATE INDEX organization_credentials_issuer_type_idx ON organization_credentials (issuer_id, credential_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS organization_credentials;
+-- +goose StatementEnd
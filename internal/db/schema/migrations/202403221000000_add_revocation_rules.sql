@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE revocation_rules
+(
+    id              uuid        NOT NULL PRIMARY KEY,
+    issuer_id       text        NOT NULL REFERENCES identities (identifier),
+    name            text        NOT NULL,
+    schema_type     text        NOT NULL,
+    cron_expression text        NOT NULL,
+    webhook_url     text        NULL,
+    enabled         boolean     NOT NULL DEFAULT true,
+    next_run_at     timestamptz NULL,
+    last_run_at     timestamptz NULL,
+    last_revoked    integer     NOT NULL DEFAULT 0,
+    created_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX revocation_rules_issuer_id_idx ON revocation_rules (issuer_id);
+CREATE INDEX revocation_rules_next_run_at_idx ON revocation_rules (next_run_at) WHERE enabled;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS revocation_rules;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identity_states ADD COLUMN tx_fee_fiat numeric NULL;
+ALTER TABLE identity_states ADD COLUMN tx_fee_currency text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE identity_states DROP COLUMN IF EXISTS tx_fee_currency;
+ALTER TABLE identity_states DROP COLUMN IF EXISTS tx_fee_fiat;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN default_expiration_days integer NULL,
+    ADD COLUMN max_expiration_days integer NULL;
+CREATE INDEX schemas_issuer_id_url_type_idx ON schemas (issuer_id, url, type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS schemas_issuer_id_url_type_idx;
+ALTER TABLE schemas
+    DROP COLUMN default_expiration_days,
+    DROP COLUMN max_expiration_days;
+-- +goose StatementEnd
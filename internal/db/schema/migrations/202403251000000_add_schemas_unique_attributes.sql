@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN unique_attributes text[] NULL,
+    ADD COLUMN unique_strategy text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN unique_attributes,
+    DROP COLUMN unique_strategy;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links ADD COLUMN throttle jsonb NULL;
+CREATE INDEX claims_link_id_created_at_index ON claims (link_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_link_id_created_at_index;
+ALTER TABLE links DROP COLUMN IF EXISTS throttle;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE key_migrations
+(
+    id                    uuid        NOT NULL PRIMARY KEY,
+    issuer_id             text        NOT NULL REFERENCES identities (identifier),
+    step                  text        NOT NULL,
+    status                text        NOT NULL,
+    revoke_old_auth_claim boolean     NOT NULL DEFAULT false,
+    old_auth_claim_id     uuid        NOT NULL,
+    old_key_id            text        NOT NULL,
+    new_key_id            text        NULL,
+    new_auth_claim_id     uuid        NULL,
+    error                 text        NULL,
+    created_at            timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at           timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX key_migrations_issuer_id_in_progress_index ON key_migrations (issuer_id) WHERE status NOT IN ('completed', 'failed');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS key_migrations;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identities ADD COLUMN server_url text NULL;
+CREATE UNIQUE INDEX identities_server_url_index ON identities (server_url);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS identities_server_url_index;
+ALTER TABLE identities DROP COLUMN IF EXISTS server_url;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas ADD COLUMN self_revocation text NULL;
+
+CREATE TABLE revocation_requests
+(
+    id            uuid        NOT NULL PRIMARY KEY,
+    issuer_id     text        NOT NULL REFERENCES identities (identifier),
+    claim_id      uuid        NOT NULL REFERENCES claims (id) ON DELETE CASCADE,
+    user_did      text        NOT NULL,
+    reason        text        NOT NULL,
+    status        text        NOT NULL,
+    auto_approved boolean     NOT NULL DEFAULT false,
+    note          text        NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at   timestamptz NULL
+);
+CREATE INDEX revocation_requests_issuer_id_status_index ON revocation_requests (issuer_id, status);
+CREATE UNIQUE INDEX revocation_requests_claim_id_pending_index ON revocation_requests (claim_id) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS revocation_requests;
+ALTER TABLE schemas DROP COLUMN IF EXISTS self_revocation;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN tenant_id uuid NULL REFERENCES tenants (id);
+CREATE INDEX schemas_tenant_id_idx ON schemas (tenant_id);
+UPDATE schemas SET tenant_id = identities.tenant_id FROM identities WHERE identities.identifier = schemas.issuer_id;
+
+ALTER TABLE links
+    ADD COLUMN tenant_id uuid NULL REFERENCES tenants (id);
+CREATE INDEX links_tenant_id_idx ON links (tenant_id);
+UPDATE links SET tenant_id = identities.tenant_id FROM identities WHERE identities.identifier = links.issuer_id;
+
+ALTER TABLE connections
+    ADD COLUMN tenant_id uuid NULL REFERENCES tenants (id);
+CREATE INDEX connections_tenant_id_idx ON connections (tenant_id);
+UPDATE connections SET tenant_id = identities.tenant_id FROM identities WHERE identities.identifier = connections.issuer_id;
+
+ALTER TABLE api_keys
+    ADD COLUMN tenant_id uuid NULL REFERENCES tenants (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_keys
+    DROP COLUMN tenant_id;
+ALTER TABLE connections
+    DROP COLUMN tenant_id;
+ALTER TABLE links
+    DROP COLUMN tenant_id;
+ALTER TABLE schemas
+    DROP COLUMN tenant_id;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_rebindings
+(
+    id                uuid        NOT NULL PRIMARY KEY,
+    issuer_id         text        NOT NULL REFERENCES identities (identifier),
+    connection_id     uuid        NOT NULL,
+    code_hash         text        NOT NULL,
+    session_id        uuid        NULL,
+    new_connection_id uuid        NULL,
+    expires_at        timestamptz NOT NULL,
+    used_at           timestamptz NULL,
+    created_at        timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT connection_rebindings_code_hash_key UNIQUE (code_hash)
+);
+CREATE INDEX connection_rebindings_session_id_index ON connection_rebindings (session_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS connection_rebindings;
+-- +goose StatementEnd
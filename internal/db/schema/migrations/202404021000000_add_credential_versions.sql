@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_versions
+(
+    id                     uuid        NOT NULL PRIMARY KEY,
+    issuer_id              text        NOT NULL,
+    lineage_id             uuid        NOT NULL,
+    credential_id          uuid        NOT NULL,
+    previous_credential_id uuid        NOT NULL,
+    version                int         NOT NULL,
+    changes                jsonb       NOT NULL,
+    created_at             timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_versions_credential_id_key UNIQUE (credential_id),
+    CONSTRAINT credential_versions_lineage_version_key UNIQUE (lineage_id, version)
+);
+
+CREATE OR REPLACE FUNCTION reject_credential_versions_change()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'credential versions cannot be modified';
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER credential_versions_immutable
+    BEFORE UPDATE OR DELETE ON credential_versions FOR EACH ROW EXECUTE PROCEDURE reject_credential_versions_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS credential_versions_immutable ON credential_versions;
+DROP FUNCTION IF EXISTS reject_credential_versions_change;
+DROP TABLE IF EXISTS credential_versions;
+-- +goose StatementEnd
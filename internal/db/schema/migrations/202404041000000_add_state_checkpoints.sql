@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE state_checkpoints
+(
+    id          uuid        NOT NULL PRIMARY KEY,
+    issuer_id   text        NOT NULL REFERENCES identities (identifier),
+    state       text        NOT NULL,
+    anchor_type text        NOT NULL,
+    reference   text        NOT NULL,
+    receipt     bytea       NULL,
+    created_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT state_checkpoints_state_anchor_key UNIQUE (issuer_id, state, anchor_type)
+);
+CREATE INDEX state_checkpoints_issuer_id_created_at_index ON state_checkpoints (issuer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS state_checkpoints;
+-- +goose StatementEnd
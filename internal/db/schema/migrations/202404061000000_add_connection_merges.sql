@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_merges
+(
+    id                   uuid        NOT NULL PRIMARY KEY,
+    issuer_id            text        NOT NULL REFERENCES identities (identifier),
+    source_connection_id uuid        NOT NULL,
+    source_user_id       text        NOT NULL,
+    target_connection_id uuid        NOT NULL,
+    credentials          integer     NOT NULL,
+    created_at           timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX connection_merges_target_connection_id_index ON connection_merges (target_connection_id);
+CREATE INDEX connections_issuer_external_id_index ON connections (issuer_id, external_id) WHERE external_id IS NOT NULL;
+CREATE INDEX connections_issuer_email_index ON connections (issuer_id, lower(email)) WHERE email IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS connections_issuer_email_index;
+DROP INDEX IF EXISTS connections_issuer_external_id_index;
+DROP TABLE IF EXISTS connection_merges;
+-- +goose StatementEnd
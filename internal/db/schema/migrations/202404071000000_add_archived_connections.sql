@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE archived_connections
+(
+    id               uuid        NOT NULL PRIMARY KEY,
+    issuer_id        text        NOT NULL,
+    user_id          text        NOT NULL,
+    issuer_doc       jsonb       NULL,
+    user_doc         jsonb       NULL,
+    created_at       timestamptz NOT NULL,
+    modified_at      timestamptz NOT NULL,
+    last_verified_at timestamptz NULL,
+    display_name     text        NULL,
+    external_id      text        NULL,
+    email            text        NULL,
+    tenant_id        uuid        NULL,
+    archived_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX archived_connections_issuer_user_index ON archived_connections (issuer_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS archived_connections;
+-- +goose StatementEnd
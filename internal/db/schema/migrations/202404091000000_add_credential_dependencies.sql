@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_dependencies
+(
+    issuer_id       text        NOT NULL,
+    credential_id   uuid        NOT NULL,
+    prerequisite_id uuid        NOT NULL,
+    created_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_dependencies_pkey PRIMARY KEY (credential_id, prerequisite_id),
+    CONSTRAINT credential_dependencies_credential_fkey FOREIGN KEY (credential_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE,
+    CONSTRAINT credential_dependencies_prerequisite_fkey FOREIGN KEY (prerequisite_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE
+);
+CREATE INDEX credential_dependencies_prerequisite_index ON credential_dependencies (issuer_id, prerequisite_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS credential_dependencies;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas ADD COLUMN webhook_template jsonb NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas DROP COLUMN IF EXISTS webhook_template;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The archived claims keep the whole row of the claim and the rows of the tables that reference it, so they can be
+-- rehydrated as they were. The table can be moved to a cheaper tablespace with ALTER TABLE archived_claims SET TABLESPACE.
+CREATE TABLE archived_claims
+(
+    id               uuid        NOT NULL,
+    identifier       text        NOT NULL,
+    other_identifier text        NULL,
+    schema_type      text        NOT NULL,
+    rev_nonce        numeric     NULL,
+    revoked          bool        NOT NULL DEFAULT false,
+    created_at       timestamptz NOT NULL,
+    claim            jsonb       NOT NULL,
+    related          jsonb       NOT NULL DEFAULT '{}',
+    archived_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT archived_claims_pkey PRIMARY KEY (id, identifier)
+);
+CREATE INDEX archived_claims_identifier_rev_nonce_index ON archived_claims (identifier, rev_nonce);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS archived_claims;
+-- +goose StatementEnd
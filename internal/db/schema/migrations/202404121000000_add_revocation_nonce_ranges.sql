@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The ranges never overlap, even between the issuers of the node
+CREATE TABLE revocation_nonce_ranges
+(
+    id          uuid        NOT NULL PRIMARY KEY,
+    issuer_id   text        NOT NULL REFERENCES identities (identifier),
+    integration text        NOT NULL,
+    start_nonce numeric     NOT NULL,
+    end_nonce   numeric     NOT NULL,
+    next_nonce  numeric     NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT revocation_nonce_ranges_bounds_check CHECK (start_nonce <= end_nonce)
+);
+CREATE INDEX revocation_nonce_ranges_issuer_id_index ON revocation_nonce_ranges (issuer_id, start_nonce);
+
+CREATE TABLE revocation_nonce_sequences
+(
+    issuer_id  text    NOT NULL PRIMARY KEY REFERENCES identities (identifier),
+    next_nonce numeric NOT NULL
+);
+CREATE INDEX claims_rev_nonce_index ON claims (rev_nonce);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_rev_nonce_index;
+DROP TABLE IF EXISTS revocation_nonce_sequences;
+DROP TABLE IF EXISTS revocation_nonce_ranges;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The provenance of the claims imported from credentials built by another system. The claim id has no foreign key, so
+-- the provenance outlives the archival of the claim.
+CREATE TABLE claim_imports
+(
+    id               uuid        NOT NULL PRIMARY KEY,
+    issuer_id        text        NOT NULL REFERENCES identities (identifier),
+    claim_id         uuid        NOT NULL,
+    source           text        NOT NULL,
+    source_reference text        NULL,
+    original_issuer  text        NULL,
+    original_id      text        NULL,
+    document_hash    text        NOT NULL,
+    imported_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT claim_imports_document_hash_key UNIQUE (issuer_id, document_hash)
+);
+CREATE INDEX claim_imports_claim_id_index ON claim_imports (issuer_id, claim_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS claim_imports;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The versions keep the values of the credential subject, so they are deleted with the credentials they link. They can
+-- still not be modified.
+DROP TRIGGER IF EXISTS credential_versions_immutable ON credential_versions;
+
+DELETE FROM credential_versions
+WHERE NOT EXISTS (SELECT 1 FROM claims WHERE claims.id = credential_versions.credential_id AND claims.identifier = credential_versions.issuer_id)
+   OR NOT EXISTS (SELECT 1 FROM claims WHERE claims.id = credential_versions.previous_credential_id AND claims.identifier = credential_versions.issuer_id);
+
+ALTER TABLE credential_versions
+    ADD CONSTRAINT credential_versions_credential_fkey FOREIGN KEY (credential_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE,
+    ADD CONSTRAINT credential_versions_previous_credential_fkey FOREIGN KEY (previous_credential_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE;
+
+CREATE TRIGGER credential_versions_immutable
+    BEFORE UPDATE ON credential_versions FOR EACH ROW EXECUTE PROCEDURE reject_credential_versions_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS credential_versions_immutable ON credential_versions;
+ALTER TABLE credential_versions
+    DROP CONSTRAINT IF EXISTS credential_versions_credential_fkey,
+    DROP CONSTRAINT IF EXISTS credential_versions_previous_credential_fkey;
+CREATE TRIGGER credential_versions_immutable
+    BEFORE UPDATE OR DELETE ON credential_versions FOR EACH ROW EXECUTE PROCEDURE reject_credential_versions_change();
+-- +goose StatementEnd
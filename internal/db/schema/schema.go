@@ -4,20 +4,98 @@ import (
 	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
 
 	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+
+	_ "github.com/lib/pq" // postgres driver for goose
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
+// DefaultLockTimeout is the time Migrate waits for other processes running the migrations
+const DefaultLockTimeout = 5 * time.Minute
+
+// ErrLocked is returned when the migrations lock could not be acquired because other process is running them
+var ErrLocked = errors.New("migrations locked by other process")
+
+// Migration is a schema migration and its state in the database
+type Migration struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Duration  time.Duration // Only set for the migrations just applied
+}
+
 // Migrate runs migrations on the databaseURL
 func Migrate(databaseURL string) error {
-	var db *sql.DB
-	// setup database
+	if _, err := Up(context.Background(), databaseURL, DefaultLockTimeout); err != nil {
+		return fmt.Errorf("error trying to run migrations: %w", err)
+	}
+	return nil
+}
+
+// Status returns all the migrations, applied and pending, sorted by version
+func Status(ctx context.Context, databaseURL string) ([]Migration, error) {
+	var migrations []Migration
+	err := withProvider(databaseURL, nil, func(provider *goose.Provider) error {
+		status, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+		migrations = make([]Migration, len(status))
+		for i, s := range status {
+			migrations[i] = Migration{Version: s.Source.Version, Name: filepath.Base(s.Source.Path), Applied: s.State == goose.StateApplied}
+			if migrations[i].Applied {
+				appliedAt := s.AppliedAt
+				migrations[i].AppliedAt = &appliedAt
+			}
+		}
+		return nil
+	})
+	return migrations, err
+}
+
+// Up runs the pending migrations holding a postgres advisory lock, so only one process runs them at a time.
+// It waits up to lockTimeout, rounded up to seconds, for the lock, returning ErrLocked if it is not released.
+// It returns the migrations applied.
+func Up(ctx context.Context, databaseURL string, lockTimeout time.Duration) ([]Migration, error) {
+	seconds := uint64((lockTimeout + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	locker, err := lock.NewPostgresSessionLocker(lock.WithLockTimeout(1, seconds))
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	err = withProvider(databaseURL, &sessionLocker{locker}, func(provider *goose.Provider) error {
+		results, err := provider.Up(ctx)
+		for _, r := range results {
+			if r.Error == nil {
+				now := time.Now()
+				migrations = append(migrations, Migration{Version: r.Source.Version, Name: filepath.Base(r.Source.Path), Applied: true, AppliedAt: &now, Duration: r.Duration})
+			}
+		}
+		return err
+	})
+	for _, m := range migrations {
+		log.Info(ctx, "migration applied", "version", m.Version, "name", m.Name, "duration", m.Duration)
+	}
+	return migrations, err
+}
+
+func withProvider(databaseURL string, locker lock.SessionLocker, f func(provider *goose.Provider) error) error {
 	db, err := sql.Open("postgres", databaseURL)
 	if err != nil {
 		return fmt.Errorf("error open connection with database: %w", err)
@@ -28,14 +106,29 @@ func Migrate(databaseURL string) error {
 		}
 	}()
 
-	goose.SetBaseFS(embedMigrations)
-	if err := goose.SetDialect("postgres"); err != nil {
-		return fmt.Errorf("error setting dialect: %w", err)
+	migrations, err := fs.Sub(embedMigrations, "migrations")
+	if err != nil {
+		return err
 	}
-
-	if err := goose.Up(db, "migrations", goose.WithAllowMissing()); err != nil {
-		return fmt.Errorf("error trying to run migrations: %w", err)
+	opts := []goose.ProviderOption{goose.WithAllowOutofOrder(true)}
+	if locker != nil {
+		opts = append(opts, goose.WithSessionLocker(locker))
+	}
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations, opts...)
+	if err != nil {
+		return fmt.Errorf("error creating the migrations provider: %w", err)
 	}
+	return f(provider)
+}
 
+// sessionLocker tags the errors acquiring the lock with ErrLocked
+type sessionLocker struct {
+	lock.SessionLocker
+}
+
+func (l *sessionLocker) SessionLock(ctx context.Context, conn *sql.Conn) error {
+	if err := l.SessionLocker.SessionLock(ctx, conn); err != nil {
+		return fmt.Errorf("%w: %s", ErrLocked, err)
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -22,6 +23,29 @@ func NewStorage(connectionString string) (*Storage, error) {
 	}, nil
 }
 
+// NewStorageWithPassword creates a new Pgx storage connection whose new connections use the current value of password
+// instead of the password of the connection string, so it can be rotated without restarting. The open connections
+// are not affected by the rotation.
+func NewStorageWithPassword(connectionString string, password func() string) (*Storage, error) {
+	cfg, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, err
+	}
+	cfg.BeforeConnect = func(_ context.Context, cc *pgx.ConnConfig) error {
+		if p := password(); p != "" {
+			cc.Password = p
+		}
+		return nil
+	}
+	pgxConn, err := pgxpool.ConnectConfig(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{
+		Pgx: pgxConn,
+	}, nil
+}
+
 // Ping is a wrapper for Pgx Ping
 func (s *Storage) Ping(ctx context.Context) error {
 	return s.Pgx.Ping(ctx)
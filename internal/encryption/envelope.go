@@ -0,0 +1,154 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+)
+
+const (
+	keySize      = 32
+	algAES256GCM = "A256GCM"
+)
+
+// ErrInvalidKeyEncryptionKey is returned when the key encryption key has not the expected size
+var ErrInvalidKeyEncryptionKey = errors.New("invalid key encryption key")
+
+type envelopeData struct {
+	Alg        string `json:"alg"`
+	WrappedKey []byte `json:"wrappedKey"`
+	KeyNonce   []byte `json:"keyNonce"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type envelopeDocument struct {
+	Envelope *envelopeData `json:"envelope"`
+}
+
+// Envelope implements envelope encryption. Every payload is encrypted with a fresh data encryption key that is
+// stored, wrapped with the key encryption key, next to the ciphertext.
+// The output is a json document, so it can be stored in json columns.
+type Envelope struct {
+	kek cipher.AEAD
+}
+
+// NewEnvelope returns an envelope cipher that uses the given 32 bytes key encryption key
+func NewEnvelope(kek []byte) (*Envelope, error) {
+	if len(kek) != keySize {
+		return nil, ErrInvalidKeyEncryptionKey
+	}
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{kek: aead}, nil
+}
+
+// NewDataCipher returns the cipher used to protect the credentials at rest or nil if the encryption is disabled.
+// The key encryption key is kept in vault and it is created the first time.
+func NewDataCipher(ctx context.Context, cfg config.DataEncryption, vaultCli *vault.Client) (ports.DataCipher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	kek, err := providers.GetDataEncryptionKey(ctx, vaultCli)
+	if errors.Is(err, providers.DataEncryptionKeyNotFound) {
+		log.Info(ctx, "data encryption key not found in vault, creating a new one")
+		kek = make([]byte, keySize)
+		if _, err := rand.Read(kek); err != nil {
+			return nil, err
+		}
+		if err := providers.SaveDataEncryptionKey(ctx, vaultCli, kek); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return NewEnvelope(kek)
+}
+
+// Encrypt encrypts the plaintext and returns the json envelope
+func (e *Envelope) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+	dataCipher, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	keyNonce, err := randomNonce(e.kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomNonce(dataCipher)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(envelopeDocument{Envelope: &envelopeData{
+		Alg:        algAES256GCM,
+		WrappedKey: e.kek.Seal(nil, keyNonce, dek, nil),
+		KeyNonce:   keyNonce,
+		Nonce:      nonce,
+		Ciphertext: dataCipher.Seal(nil, nonce, plaintext, nil),
+	}})
+}
+
+// Decrypt opens the json envelope and returns the plaintext.
+// Data that is not an envelope is returned as is, so data stored before enabling the encryption can still be read.
+func (e *Envelope) Decrypt(_ context.Context, data []byte) ([]byte, error) {
+	var doc envelopeDocument
+	if err := json.Unmarshal(data, &doc); err != nil || doc.Envelope == nil {
+		return data, nil
+	}
+
+	if doc.Envelope.Alg != algAES256GCM {
+		return nil, fmt.Errorf("unsupported envelope algorithm: %s", doc.Envelope.Alg)
+	}
+
+	dek, err := e.kek.Open(nil, doc.Envelope.KeyNonce, doc.Envelope.WrappedKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unwrapping data encryption key: %w", err)
+	}
+	dataCipher, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := dataCipher.Open(nil, doc.Envelope.Nonce, doc.Envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func randomNonce(aead cipher.AEAD) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return nonce, nil
+}
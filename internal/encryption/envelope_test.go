@@ -0,0 +1,55 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+	ctx := context.Background()
+	kek := make([]byte, keySize)
+	_, err := rand.Read(kek)
+	require.NoError(t, err)
+
+	envelope, err := NewEnvelope(kek)
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"credentialSubject":{"id":"did:polygonid:polygon:mumbai:2qP8KN3KRwBi37jB2ENXrWxhTo3pefaU5u5BFPbjYo","birthday":19960424}}`)
+
+	t.Run("should encrypt and decrypt", func(t *testing.T) {
+		encrypted, err := envelope.Encrypt(ctx, plaintext)
+		require.NoError(t, err)
+		assert.True(t, json.Valid(encrypted))
+		assert.NotContains(t, string(encrypted), "birthday")
+
+		decrypted, err := envelope.Decrypt(ctx, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("should return data that is not an envelope as is", func(t *testing.T) {
+		decrypted, err := envelope.Decrypt(ctx, plaintext)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("should fail with a different key", func(t *testing.T) {
+		encrypted, err := envelope.Encrypt(ctx, plaintext)
+		require.NoError(t, err)
+
+		other, err := NewEnvelope(make([]byte, keySize))
+		require.NoError(t, err)
+		_, err = other.Decrypt(ctx, encrypted)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject a wrong key size", func(t *testing.T) {
+		_, err := NewEnvelope([]byte("short"))
+		assert.ErrorIs(t, err, ErrInvalidKeyEncryptionKey)
+	})
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "net/http"
+import (
+	"errors"
+	"net/http"
+)
 
 // AuthError is a special error type used to signal an authorization error
 type AuthError struct {
@@ -12,8 +15,23 @@ func (a AuthError) Error() string {
 	return a.Err.Error()
 }
 
+// ForbiddenError is a special error type used to signal that the authenticated user cannot perform the operation
+type ForbiddenError struct {
+	Err error
+}
+
+// Error satisfies error interface for ForbiddenError
+func (f ForbiddenError) Error() string {
+	return f.Err.Error()
+}
+
 // RequestErrorHandlerFunc is a Request Error Handler that can be injected in oapi-codegen to handler errors in requests
 func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
 	http.Error(w, err.Error(), http.StatusBadRequest)
 }
 
@@ -26,6 +44,9 @@ func ResponseErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error)
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
 		_, _ = w.Write([]byte("\"Unauthorized\""))
+	case ForbiddenError:
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("\"Forbidden\""))
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(err.Error()))
@@ -0,0 +1,214 @@
+// Package forwarded resolves the base url of each request from the reverse proxy headers, so the links of the
+// responses point to the domain the request was sent to instead of the configured server url.
+package forwarded
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+const (
+	headerHost   = "X-Forwarded-Host"
+	headerProto  = "X-Forwarded-Proto"
+	headerPrefix = "X-Forwarded-Prefix"
+	headerFor    = "X-Forwarded-For"
+	// headerBaseURL lets a client choose which of the allowed hosts the links of a request are built for
+	headerBaseURL = "X-Base-URL"
+)
+
+type (
+	baseURLCtxKey  struct{}
+	clientIPCtxKey struct{}
+)
+
+// Middleware returns a middleware that keeps in the request context the base url the request was sent to. It is taken
+// from the X-Base-URL header if its host is one of the allowed hosts, from the forwarded headers of the requests sent
+// by the trusted proxies, or from the Host header of the requests sent directly to one of the allowed hosts.
+// If the host is not allowed nothing is kept, and the server url is used.
+// The ip of the client is also kept, taken from the X-Forwarded-For header of the requests sent by the trusted proxies.
+// It does nothing if the forwarded headers are not configured.
+func Middleware(cfg config.ForwardedHeaders) func(http.Handler) http.Handler {
+	if !cfg.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	// The networks are validated when the configuration is sanitized
+	trusted, _ := cfg.TrustedNetworks()
+	allowed := make(map[string]struct{}, len(cfg.AllowedHosts))
+	for _, host := range cfg.AllowedHosts {
+		allowed[host] = struct{}{}
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if isTrusted(r, trusted) {
+				if ip := forwardedClientIP(r, trusted); ip != nil {
+					ctx = context.WithValue(ctx, clientIPCtxKey{}, ip)
+				}
+			}
+			if baseURL, ok := requestBaseURL(r, trusted, allowed); ok {
+				ctx = context.WithValue(ctx, baseURLCtxKey{}, baseURL)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// BaseURL returns the base url the request of the context was sent to, or the default one if it is unknown
+func BaseURL(ctx context.Context, defaultURL string) string {
+	baseURL, ok := ctx.Value(baseURLCtxKey{}).(string)
+	if !ok {
+		return defaultURL
+	}
+	return baseURL
+}
+
+// WithRequestBaseURL returns a copy of ctx that keeps the base url of the request, if any.
+// The strict handlers do not use the request context, so they need it to be copied.
+func WithRequestBaseURL(ctx context.Context, r *http.Request) context.Context {
+	baseURL, ok := r.Context().Value(baseURLCtxKey{}).(string)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, baseURLCtxKey{}, baseURL)
+}
+
+// ClientIP returns the ip of the client that sent the request. It is the one resolved from the X-Forwarded-For header
+// if the request was sent by a trusted proxy, or the remote address of the request otherwise.
+func ClientIP(r *http.Request) net.IP {
+	if ip, ok := r.Context().Value(clientIPCtxKey{}).(net.IP); ok {
+		return ip
+	}
+	return remoteIP(r)
+}
+
+// Origin returns the scheme and host the request was sent to, taking the forwarded headers into account only if
+// the base url of the request was resolved from them.
+func Origin(r *http.Request) string {
+	if baseURL, ok := r.Context().Value(baseURLCtxKey{}).(string); ok {
+		if u, err := url.Parse(baseURL); err == nil {
+			return u.Scheme + "://" + u.Host
+		}
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + strings.ToLower(r.Host)
+}
+
+func requestBaseURL(r *http.Request, trusted []*net.IPNet, allowed map[string]struct{}) (string, bool) {
+	if override := r.Header.Get(headerBaseURL); override != "" && len(allowed) > 0 {
+		if baseURL, ok := overrideBaseURL(override, allowed); ok {
+			return baseURL, true
+		}
+	}
+	scheme, host, prefix := "http", strings.ToLower(r.Host), ""
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if isTrusted(r, trusted) {
+		if forwardedHost := lastValue(r.Header.Values(headerHost)); forwardedHost != "" {
+			host = strings.ToLower(forwardedHost)
+		}
+		if forwardedProto := strings.ToLower(lastValue(r.Header.Values(headerProto))); forwardedProto == "http" || forwardedProto == "https" {
+			scheme = forwardedProto
+		}
+		prefix = strings.TrimSuffix(lastValue(r.Header.Values(headerPrefix)), "/")
+		if prefix != "" && !strings.HasPrefix(prefix, "/") {
+			prefix = "/" + prefix
+		}
+	} else if len(allowed) == 0 {
+		return "", false
+	}
+	if !validHost(host) {
+		return "", false
+	}
+	if len(allowed) > 0 {
+		if _, ok := allowed[host]; !ok {
+			return "", false
+		}
+	}
+	u := url.URL{Scheme: scheme, Host: host, Path: prefix}
+	return u.String(), true
+}
+
+// overrideBaseURL validates the base url asked for by the client. Only the allowed hosts can be asked for, so the
+// client can not make the server build links to a domain of its choice.
+func overrideBaseURL(override string, allowed map[string]struct{}) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(override))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", false
+	}
+	host := strings.ToLower(u.Host)
+	if !validHost(host) {
+		return "", false
+	}
+	if _, ok := allowed[host]; !ok {
+		return "", false
+	}
+	base := url.URL{Scheme: u.Scheme, Host: host, Path: strings.TrimSuffix(u.Path, "/")}
+	return base.String(), true
+}
+
+func validHost(host string) bool {
+	return host != "" && !strings.ContainsAny(host, "/\\@?#")
+}
+
+func isTrusted(r *http.Request, trusted []*net.IPNet) bool {
+	if len(trusted) == 0 {
+		return false
+	}
+	return trustedIP(remoteIP(r), trusted)
+}
+
+func trustedIP(ip net.IP, trusted []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedClientIP returns the rightmost address of the X-Forwarded-For header that is not a trusted proxy. The
+// addresses on its left could have been sent by the client.
+func forwardedClientIP(r *http.Request, trusted []*net.IPNet) net.IP {
+	addresses := strings.Split(strings.Join(r.Header.Values(headerFor), ","), ",")
+	for i := len(addresses) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(addresses[i]))
+		if ip == nil {
+			return nil
+		}
+		if !trustedIP(ip, trusted) {
+			return ip
+		}
+	}
+	return nil
+}
+
+func remoteIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// lastValue returns the value set by the proxy closest to the server when several proxies add their own, either to the
+// same header line or in header lines of their own. The values on the left were sent by the client or by the proxies
+// before the trusted one, so they can not be trusted.
+func lastValue(values []string) string {
+	header := strings.Join(values, ",")
+	if i := strings.LastIndex(header, ","); i >= 0 {
+		header = header[i+1:]
+	}
+	return strings.TrimSpace(header)
+}
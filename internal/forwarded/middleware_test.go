@@ -0,0 +1,164 @@
+package forwarded
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestMiddleware(t *testing.T) {
+	const serverURL = "https://issuer.example.com"
+	for _, tc := range []struct {
+		name       string
+		cfg        config.ForwardedHeaders
+		remoteAddr string
+		host       string
+		tls        bool
+		headers    map[string]string
+		expected   string
+	}{
+		{
+			name:       "not configured",
+			remoteAddr: "10.0.0.1:1234",
+			host:       "other.example.com",
+			headers:    map[string]string{headerHost: "evil.example.com", headerProto: "https"},
+			expected:   serverURL,
+		},
+		{
+			name:       "trusted proxy",
+			cfg:        config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.0/8"}},
+			remoteAddr: "10.0.0.1:1234",
+			host:       "issuer:3001",
+			headers:    map[string]string{headerHost: "evil.example.com, Acme.Example.com", headerProto: "http, https", headerPrefix: "issuer/"},
+			expected:   "https://acme.example.com/issuer",
+		},
+		{
+			name:       "untrusted proxy",
+			cfg:        config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.1"}},
+			remoteAddr: "10.0.0.2:1234",
+			host:       "issuer:3001",
+			headers:    map[string]string{headerHost: "evil.example.com", headerProto: "https"},
+			expected:   serverURL,
+		},
+		{
+			name:       "trusted proxy with a host not allowed",
+			cfg:        config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.1"}, AllowedHosts: []string{"acme.example.com"}},
+			remoteAddr: "10.0.0.1:1234",
+			host:       "issuer:3001",
+			headers:    map[string]string{headerHost: "evil.example.com", headerProto: "https"},
+			expected:   serverURL,
+		},
+		{
+			name:       "allowed host without proxy",
+			cfg:        config.ForwardedHeaders{AllowedHosts: []string{"acme.example.com"}},
+			remoteAddr: "192.168.1.1:1234",
+			host:       "acme.example.com",
+			tls:        true,
+			headers:    map[string]string{headerHost: "evil.example.com"},
+			expected:   "https://acme.example.com",
+		},
+		{
+			name:       "base url override",
+			cfg:        config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.1"}, AllowedHosts: []string{"acme.example.com", "other.example.com"}},
+			remoteAddr: "10.0.0.1:1234",
+			host:       "issuer:3001",
+			headers:    map[string]string{headerHost: "acme.example.com", headerProto: "https", headerBaseURL: "https://Other.Example.com/issuer/"},
+			expected:   "https://other.example.com/issuer",
+		},
+		{
+			name:       "base url override with a host not allowed",
+			cfg:        config.ForwardedHeaders{AllowedHosts: []string{"acme.example.com"}},
+			remoteAddr: "192.168.1.1:1234",
+			host:       "acme.example.com",
+			tls:        true,
+			headers:    map[string]string{headerBaseURL: "https://evil.example.com"},
+			expected:   "https://acme.example.com",
+		},
+		{
+			name:       "base url override without allowed hosts",
+			cfg:        config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.1"}},
+			remoteAddr: "10.0.0.2:1234",
+			host:       "issuer:3001",
+			headers:    map[string]string{headerBaseURL: "https://evil.example.com"},
+			expected:   serverURL,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got, origin string
+			handler := Middleware(tc.cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = BaseURL(r.Context(), serverURL)
+				origin = Origin(r)
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/v1/agent", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Host = tc.host
+			if tc.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tc.expected, got)
+			if got != serverURL {
+				assert.Contains(t, got, origin)
+			}
+		})
+	}
+
+	t.Run("trusted proxy with the headers in several lines", func(t *testing.T) {
+		var got string
+		handler := Middleware(config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.0/8"}})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = BaseURL(r.Context(), serverURL)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/v1/agent", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Host = "issuer:3001"
+		// the first lines were sent by the client, the proxy appended the last ones
+		req.Header.Add(headerHost, "evil.example.com")
+		req.Header.Add(headerHost, "Acme.Example.com")
+		req.Header.Add(headerProto, "http")
+		req.Header.Add(headerProto, "https")
+		req.Header.Add(headerPrefix, "/evil")
+		req.Header.Add(headerPrefix, "issuer")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, "https://acme.example.com/issuer", got)
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	cfg := config.ForwardedHeaders{TrustedProxies: []string{"10.0.0.0/8"}}
+	for _, tc := range []struct {
+		name         string
+		cfg          config.ForwardedHeaders
+		remoteAddr   string
+		forwardedFor string
+		expected     string
+	}{
+		{name: "not configured", remoteAddr: "10.0.0.1:1234", forwardedFor: "203.0.113.1", expected: "10.0.0.1"},
+		{name: "trusted proxy", cfg: cfg, remoteAddr: "10.0.0.1:1234", forwardedFor: "198.51.100.1, 203.0.113.1, 10.0.0.2", expected: "203.0.113.1"},
+		{name: "trusted proxy without header", cfg: cfg, remoteAddr: "10.0.0.1:1234", expected: "10.0.0.1"},
+		{name: "trusted proxy with an invalid header", cfg: cfg, remoteAddr: "10.0.0.1:1234", forwardedFor: "unknown", expected: "10.0.0.1"},
+		{name: "untrusted proxy", cfg: cfg, remoteAddr: "192.168.1.1:1234", forwardedFor: "203.0.113.1", expected: "192.168.1.1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got net.IP
+			handler := Middleware(tc.cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = ClientIP(r)
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/v1/agent", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.forwardedFor != "" {
+				req.Header.Set(headerFor, tc.forwardedFor)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tc.expected, got.String())
+		})
+	}
+}
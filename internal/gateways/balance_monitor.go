@@ -0,0 +1,242 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+var (
+	// ErrBalanceUnknown is returned by the health check until the balance has been read
+	ErrBalanceUnknown = errors.New("publishing account balance is unknown")
+	// ErrBalanceLow is returned by the health check when the balance is below the threshold
+	ErrBalanceLow = errors.New("publishing account balance is below the threshold")
+)
+
+// BalanceAlert is sent when the publishing account balance goes below the threshold or recovers
+type BalanceAlert struct {
+	Address   string    `json:"address"`
+	Balance   string    `json:"balance"`
+	Threshold string    `json:"threshold"`
+	Low       bool      `json:"low"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Message returns a human readable description of the alert
+func (a BalanceAlert) Message() string {
+	if a.Low {
+		return fmt.Sprintf("The balance of the state publishing account %s is %s wei, below the threshold of %s wei. State publishing will stall when it runs out of gas.", a.Address, a.Balance, a.Threshold)
+	}
+	return fmt.Sprintf("The balance of the state publishing account %s is %s wei, above the threshold of %s wei again.", a.Address, a.Balance, a.Threshold)
+}
+
+// BalanceAlerter sends a balance alert to an external system
+type BalanceAlerter interface {
+	Alert(ctx context.Context, alert BalanceAlert) error
+}
+
+// BalanceReader reads the native token balance of an account
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, addr common.Address) (*big.Int, error)
+}
+
+// BalanceMonitor checks periodically the balance of the publishing account
+type BalanceMonitor struct {
+	reader    BalanceReader
+	address   common.Address
+	threshold *big.Int
+	interval  time.Duration
+	alerters  []BalanceAlerter
+
+	mu        sync.RWMutex
+	balance   *big.Int
+	checkedAt time.Time
+	low       bool
+}
+
+// NewBalanceMonitor returns a monitor of the balance of the given address that alerts when it goes below the threshold
+func NewBalanceMonitor(reader BalanceReader, address common.Address, threshold *big.Int, interval time.Duration, alerters ...BalanceAlerter) *BalanceMonitor {
+	return &BalanceMonitor{
+		reader:    reader,
+		address:   address,
+		threshold: threshold,
+		interval:  interval,
+		alerters:  alerters,
+	}
+}
+
+// NewBalanceAlerters returns the alerters enabled in the configuration
+func NewBalanceAlerters(cfg config.BalanceMonitor) []BalanceAlerter {
+	var alerters []BalanceAlerter
+	if cfg.WebhookURL != "" {
+		alerters = append(alerters, NewWebhookBalanceAlerter(client.DefaultHTTPClientWithRetry, cfg.WebhookURL))
+	}
+	if cfg.Email.SMTPHost != "" && len(cfg.Email.To) > 0 {
+		alerters = append(alerters, NewEmailBalanceAlerter(cfg.Email))
+	}
+	return alerters
+}
+
+// Run checks the balance every interval until the context is cancelled
+func (m *BalanceMonitor) Run(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		m.check(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				m.check(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Ping is a health.Pinger that fails when the balance is below the threshold or could not be read.
+// It uses the last balance read, so it does not query the blockchain.
+func (m *BalanceMonitor) Ping(_ context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.balance == nil {
+		return ErrBalanceUnknown
+	}
+	if m.low {
+		return ErrBalanceLow
+	}
+	return nil
+}
+
+// Balance returns the last balance read and the time it was read. The balance is nil until the first check succeeds.
+func (m *BalanceMonitor) Balance() (*big.Int, time.Time) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.balance == nil {
+		return nil, time.Time{}
+	}
+	return new(big.Int).Set(m.balance), m.checkedAt
+}
+
+// MetricsHandler exposes the balance in the prometheus text format
+func (m *BalanceMonitor) MetricsHandler(w http.ResponseWriter, _ *http.Request) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	address := m.address.Hex()
+	_, _ = fmt.Fprintf(w, "# HELP issuer_publishing_account_balance_wei Native token balance of the state publishing account.\n")
+	_, _ = fmt.Fprintf(w, "# TYPE issuer_publishing_account_balance_wei gauge\n")
+	if m.balance != nil {
+		_, _ = fmt.Fprintf(w, "issuer_publishing_account_balance_wei{address=%q} %s\n", address, new(big.Float).SetInt(m.balance).Text('g', -1))
+	}
+	_, _ = fmt.Fprintf(w, "# HELP issuer_publishing_account_balance_threshold_wei Balance below which alerts are sent.\n")
+	_, _ = fmt.Fprintf(w, "# TYPE issuer_publishing_account_balance_threshold_wei gauge\n")
+	_, _ = fmt.Fprintf(w, "issuer_publishing_account_balance_threshold_wei{address=%q} %s\n", address, new(big.Float).SetInt(m.threshold).Text('g', -1))
+	_, _ = fmt.Fprintf(w, "# HELP issuer_publishing_account_balance_low Whether the balance is below the threshold.\n")
+	_, _ = fmt.Fprintf(w, "# TYPE issuer_publishing_account_balance_low gauge\n")
+	low := 0
+	if m.low {
+		low = 1
+	}
+	_, _ = fmt.Fprintf(w, "issuer_publishing_account_balance_low{address=%q} %d\n", address, low)
+}
+
+func (m *BalanceMonitor) check(ctx context.Context) {
+	balance, err := m.reader.BalanceAt(ctx, m.address)
+	if err != nil {
+		log.Error(ctx, "cannot read the publishing account balance", "address", m.address.Hex(), "err", err)
+		return
+	}
+
+	m.mu.Lock()
+	wasLow, known := m.low, m.balance != nil
+	m.balance = balance
+	m.checkedAt = time.Now()
+	m.low = balance.Cmp(m.threshold) < 0
+	low := m.low
+	m.mu.Unlock()
+
+	// alerts are only sent when the balance crosses the threshold, and the recovery only if a low alert was sent
+	if (known && low == wasLow) || (!known && !low) {
+		return
+	}
+
+	alert := BalanceAlert{
+		Address:   m.address.Hex(),
+		Balance:   balance.String(),
+		Threshold: m.threshold.String(),
+		Low:       low,
+		CheckedAt: m.checkedAt,
+	}
+	if low {
+		log.Warn(ctx, "publishing account balance is below the threshold", "address", alert.Address, "balance", alert.Balance, "threshold", alert.Threshold)
+	} else {
+		log.Info(ctx, "publishing account balance recovered", "address", alert.Address, "balance", alert.Balance)
+	}
+	for _, alerter := range m.alerters {
+		if err := alerter.Alert(ctx, alert); err != nil {
+			log.Error(ctx, "cannot send publishing account balance alert", "err", err)
+		}
+	}
+}
+
+type webhookBalanceAlerter struct {
+	client *client.Client
+	url    string
+}
+
+// NewWebhookBalanceAlerter returns an alerter that posts the alert as json to the given url
+func NewWebhookBalanceAlerter(cli *client.Client, url string) BalanceAlerter {
+	return &webhookBalanceAlerter{client: cli, url: url}
+}
+
+func (a *webhookBalanceAlerter) Alert(ctx context.Context, alert BalanceAlert) error {
+	body, err := json.Marshal(struct {
+		BalanceAlert
+		Message string `json:"message"`
+	}{BalanceAlert: alert, Message: alert.Message()})
+	if err != nil {
+		return err
+	}
+	_, err = a.client.Post(ctx, a.url, body)
+	return err
+}
+
+type emailBalanceAlerter struct {
+	cfg config.BalanceMonitorEmail
+}
+
+// NewEmailBalanceAlerter returns an alerter that sends the alert by email
+func NewEmailBalanceAlerter(cfg config.BalanceMonitorEmail) BalanceAlerter {
+	return &emailBalanceAlerter{cfg: cfg}
+}
+
+func (a *emailBalanceAlerter) Alert(_ context.Context, alert BalanceAlert) error {
+	subject := "Issuer node: publishing account balance is low"
+	if !alert.Low {
+		subject = "Issuer node: publishing account balance recovered"
+	}
+	msg := "From: " + a.cfg.From + "\r\n" +
+		"To: " + strings.Join(a.cfg.To, ", ") + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"\r\n" + alert.Message() + "\r\n"
+
+	var auth smtp.Auth
+	if a.cfg.SMTPUser != "" {
+		auth = smtp.PlainAuth("", a.cfg.SMTPUser, a.cfg.SMTPPassword, a.cfg.SMTPHost)
+	}
+	return smtp.SendMail(fmt.Sprintf("%s:%d", a.cfg.SMTPHost, a.cfg.SMTPPort), auth, a.cfg.From, a.cfg.To, []byte(msg))
+}
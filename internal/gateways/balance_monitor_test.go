@@ -0,0 +1,130 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+type balanceReaderMock struct {
+	balance *big.Int
+	err     error
+}
+
+func (m *balanceReaderMock) BalanceAt(_ context.Context, _ common.Address) (*big.Int, error) {
+	return m.balance, m.err
+}
+
+type balanceAlerterMock struct {
+	alerts []BalanceAlert
+}
+
+func (m *balanceAlerterMock) Alert(_ context.Context, alert BalanceAlert) error {
+	m.alerts = append(m.alerts, alert)
+	return nil
+}
+
+func TestBalanceMonitor_Check(t *testing.T) {
+	ctx := context.Background()
+	address := common.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4")
+	threshold := big.NewInt(1000)
+
+	t.Run("should alert when the balance crosses the threshold", func(t *testing.T) {
+		reader := &balanceReaderMock{err: errors.New("rpc unavailable")}
+		alerter := &balanceAlerterMock{}
+		monitor := NewBalanceMonitor(reader, address, threshold, 0, alerter)
+
+		monitor.check(ctx)
+		assert.ErrorIs(t, monitor.Ping(ctx), ErrBalanceUnknown)
+		balance, _ := monitor.Balance()
+		assert.Nil(t, balance)
+
+		reader.balance, reader.err = big.NewInt(5000), nil
+		monitor.check(ctx)
+		assert.NoError(t, monitor.Ping(ctx))
+		assert.Empty(t, alerter.alerts)
+
+		reader.balance = big.NewInt(999)
+		monitor.check(ctx)
+		assert.ErrorIs(t, monitor.Ping(ctx), ErrBalanceLow)
+		require.Len(t, alerter.alerts, 1)
+		assert.True(t, alerter.alerts[0].Low)
+		assert.Equal(t, "999", alerter.alerts[0].Balance)
+		assert.Equal(t, "1000", alerter.alerts[0].Threshold)
+		assert.Equal(t, address.Hex(), alerter.alerts[0].Address)
+
+		// still low, the alert is not repeated
+		reader.balance = big.NewInt(500)
+		monitor.check(ctx)
+		assert.Len(t, alerter.alerts, 1)
+
+		// a failed read keeps the last balance
+		reader.err = errors.New("rpc unavailable")
+		monitor.check(ctx)
+		assert.ErrorIs(t, monitor.Ping(ctx), ErrBalanceLow)
+		balance, _ = monitor.Balance()
+		assert.Equal(t, big.NewInt(500), balance)
+
+		reader.balance, reader.err = big.NewInt(1000), nil
+		monitor.check(ctx)
+		assert.NoError(t, monitor.Ping(ctx))
+		require.Len(t, alerter.alerts, 2)
+		assert.False(t, alerter.alerts[1].Low)
+	})
+
+	t.Run("should alert when the first balance read is low", func(t *testing.T) {
+		alerter := &balanceAlerterMock{}
+		monitor := NewBalanceMonitor(&balanceReaderMock{balance: big.NewInt(1)}, address, threshold, 0, alerter)
+		monitor.check(ctx)
+		assert.ErrorIs(t, monitor.Ping(ctx), ErrBalanceLow)
+		require.Len(t, alerter.alerts, 1)
+		assert.True(t, alerter.alerts[0].Low)
+	})
+}
+
+func TestBalanceMonitor_MetricsHandler(t *testing.T) {
+	address := common.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4")
+	monitor := NewBalanceMonitor(&balanceReaderMock{balance: big.NewInt(999)}, address, big.NewInt(1000), 0)
+
+	rr := httptest.NewRecorder()
+	monitor.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.NotContains(t, rr.Body.String(), "issuer_publishing_account_balance_wei{")
+
+	monitor.check(context.Background())
+	rr = httptest.NewRecorder()
+	monitor.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rr.Body.String()
+	assert.Contains(t, body, `issuer_publishing_account_balance_wei{address="`+address.Hex()+`"} 999`)
+	assert.Contains(t, body, `issuer_publishing_account_balance_threshold_wei{address="`+address.Hex()+`"} 1000`)
+	assert.Contains(t, body, `issuer_publishing_account_balance_low{address="`+address.Hex()+`"} 1`)
+}
+
+func TestWebhookBalanceAlerter_Alert(t *testing.T) {
+	var received map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &received))
+	}))
+	defer srv.Close()
+
+	alert := BalanceAlert{Address: "0x134B1BE34911E39A8397ec6289782989729807a4", Balance: "999", Threshold: "1000", Low: true}
+	alerter := NewWebhookBalanceAlerter(client.NewClient(http.Client{}), srv.URL)
+	require.NoError(t, alerter.Alert(context.Background(), alert))
+	assert.Equal(t, alert.Address, received["address"])
+	assert.Equal(t, "999", received["balance"])
+	assert.Equal(t, true, received["low"])
+	assert.Equal(t, alert.Message(), received["message"])
+}
@@ -0,0 +1,28 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+type campaignWebhook struct {
+	client *client.Client
+}
+
+// NewCampaignWebhook returns a campaign webhook that posts the milestones as json
+func NewCampaignWebhook(cli *client.Client) ports.CampaignWebhook {
+	return &campaignWebhook{client: cli}
+}
+
+func (w *campaignWebhook) Notify(ctx context.Context, url string, milestone domain.CampaignMilestone) error {
+	body, err := json.Marshal(milestone)
+	if err != nil {
+		return err
+	}
+	_, err = w.client.Post(ctx, url, body)
+	return err
+}
@@ -0,0 +1,127 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	droppedTxMisses      = 3                // consecutive checks that must miss a transaction to consider it dropped
+	droppedTxGracePeriod = 10 * time.Minute // minimum time between the first miss and considering the transaction dropped
+)
+
+type confirmationTracker struct {
+	client ETHClient
+	depth  int64
+	now    func() time.Time
+	mu     sync.Mutex
+	misses map[string]*txMisses
+}
+
+type txMisses struct {
+	count int
+	first time.Time
+}
+
+// NewConfirmationTracker returns a tracker that considers a transaction confirmed once it has depth blocks on top of it
+func NewConfirmationTracker(client ETHClient, depth int64) ports.ConfirmationTracker {
+	return &confirmationTracker{client: client, depth: depth, now: time.Now, misses: make(map[string]*txMisses)}
+}
+
+// Check returns the confirmation status of the transaction.
+// A receipt whose block is not part of the canonical chain anymore is considered pending, as the transaction
+// can be included again in a new block. When neither the receipt nor the transaction are found the transaction
+// has been dropped and has to be sent again. Nodes behind a failover or a relayer don't always see the mempool
+// of the node that received the transaction, so a transaction is only dropped after missing it in several
+// consecutive checks for a grace period.
+func (t *confirmationTracker) Check(ctx context.Context, txID string) (*ports.TxConfirmation, error) {
+	receipt, err := t.client.GetTransactionReceiptByID(ctx, txID)
+	if err != nil {
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+		return t.checkNotMined(ctx, txID)
+	}
+
+	t.forget(txID)
+
+	header, err := t.client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if header.Hash() != receipt.BlockHash {
+		log.Warn(ctx, "transaction block is not canonical anymore", "tx", txID, "block", receipt.BlockNumber, "blockHash", receipt.BlockHash.Hex())
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+
+	currentBlock, err := t.client.CurrentBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	confirmations := new(big.Int).Sub(currentBlock, receipt.BlockNumber).Int64()
+	resp := &ports.TxConfirmation{Status: ports.TxConfirmationPending, Receipt: receipt, Confirmations: confirmations}
+	switch {
+	case receipt.Status != types.ReceiptStatusSuccessful:
+		resp.Status = ports.TxConfirmationFailed
+	case confirmations >= t.depth:
+		resp.Status = ports.TxConfirmationConfirmed
+	}
+	return resp, nil
+}
+
+func (t *confirmationTracker) checkNotMined(ctx context.Context, txID string) (*ports.TxConfirmation, error) {
+	_, _, err := t.client.GetTransactionByID(ctx, txID)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+	if err == nil {
+		t.forget(txID)
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+
+	if !t.missed(txID) {
+		log.Info(ctx, "transaction not found. Waiting before considering it dropped", "tx", txID)
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+	// the transaction could have been mined since the receipt was checked
+	_, err = t.client.GetTransactionReceiptByID(ctx, txID)
+	if err == nil {
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	t.forget(txID)
+	log.Warn(ctx, "transaction not found. It was dropped", "tx", txID)
+	return &ports.TxConfirmation{Status: ports.TxConfirmationDropped}, nil
+}
+
+// missed records that the transaction was not found and returns true once it is missing long enough to be dropped
+func (t *confirmationTracker) missed(txID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	misses, ok := t.misses[txID]
+	if !ok {
+		misses = &txMisses{first: now}
+		t.misses[txID] = misses
+	}
+	misses.count++
+	return misses.count >= droppedTxMisses && now.Sub(misses.first) >= droppedTxGracePeriod
+}
+
+func (t *confirmationTracker) forget(txID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.misses, txID)
+}
@@ -0,0 +1,161 @@
+package gateways
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+type ethClientMock struct {
+	ETHClient
+	receipt      *types.Receipt
+	minedAtCall  int // the receipt is only returned from this call of GetTransactionReceiptByID on, when set
+	receiptCalls int
+	inMempool    bool
+	header       *types.Header
+	currentBlock int64
+}
+
+func (m *ethClientMock) GetTransactionReceiptByID(_ context.Context, _ string) (*types.Receipt, error) {
+	m.receiptCalls++
+	if m.receipt == nil || m.receiptCalls < m.minedAtCall {
+		return nil, ethereum.NotFound
+	}
+	return m.receipt, nil
+}
+
+func (m *ethClientMock) GetTransactionByID(_ context.Context, _ string) (*types.Transaction, bool, error) {
+	if !m.inMempool {
+		return nil, false, ethereum.NotFound
+	}
+	return &types.Transaction{}, true, nil
+}
+
+func (m *ethClientMock) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	return m.header, nil
+}
+
+func (m *ethClientMock) CurrentBlock(_ context.Context) (*big.Int, error) {
+	return big.NewInt(m.currentBlock), nil
+}
+
+func TestConfirmationTracker_Check(t *testing.T) {
+	ctx := context.Background()
+	const txID = "0x5f1c2e3d4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d"
+	header := &types.Header{Number: big.NewInt(100)}
+
+	for _, tc := range []struct {
+		name     string
+		client   *ethClientMock
+		expected ports.TxConfirmationStatus
+	}{
+		{
+			name:     "confirmed",
+			client:   &ethClientMock{receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(100), BlockHash: header.Hash()}, header: header, currentBlock: 110},
+			expected: ports.TxConfirmationConfirmed,
+		},
+		{
+			name:     "not enough confirmations",
+			client:   &ethClientMock{receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(100), BlockHash: header.Hash()}, header: header, currentBlock: 102},
+			expected: ports.TxConfirmationPending,
+		},
+		{
+			name:     "reverted",
+			client:   &ethClientMock{receipt: &types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(100), BlockHash: header.Hash()}, header: header, currentBlock: 102},
+			expected: ports.TxConfirmationFailed,
+		},
+		{
+			name:     "block reorganized",
+			client:   &ethClientMock{receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(100), BlockHash: ethCommon.HexToHash("0x01")}, header: header, currentBlock: 110},
+			expected: ports.TxConfirmationPending,
+		},
+		{
+			name:     "in the mempool",
+			client:   &ethClientMock{inMempool: true},
+			expected: ports.TxConfirmationPending,
+		},
+		{
+			name:     "not found once",
+			client:   &ethClientMock{},
+			expected: ports.TxConfirmationPending,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			confirmation, err := NewConfirmationTracker(tc.client, 5).Check(ctx, txID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, confirmation.Status)
+		})
+	}
+
+	t.Run("dropped after missing it during the grace period", func(t *testing.T) {
+		client := &ethClientMock{}
+		tracker := NewConfirmationTracker(client, 5).(*confirmationTracker)
+		now := time.Now()
+		tracker.now = func() time.Time { return now }
+
+		for i := 0; i < droppedTxMisses; i++ {
+			confirmation, err := tracker.Check(ctx, txID)
+			require.NoError(t, err)
+			assert.Equal(t, ports.TxConfirmationPending, confirmation.Status, "miss %d", i+1)
+		}
+
+		now = now.Add(droppedTxGracePeriod)
+		confirmation, err := tracker.Check(ctx, txID)
+		require.NoError(t, err)
+		assert.Equal(t, ports.TxConfirmationDropped, confirmation.Status)
+		assert.Empty(t, tracker.misses)
+	})
+
+	t.Run("not dropped if it was mined while checking it", func(t *testing.T) {
+		// the receipt shows up in the receipt check done before dropping the transaction
+		client := &ethClientMock{receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(100), BlockHash: header.Hash()}, minedAtCall: droppedTxMisses + 1, header: header, currentBlock: 110}
+		tracker := NewConfirmationTracker(client, 5).(*confirmationTracker)
+		now := time.Now()
+		tracker.now = func() time.Time { return now }
+
+		for i := 0; i < droppedTxMisses-1; i++ {
+			_, err := tracker.Check(ctx, txID)
+			require.NoError(t, err)
+		}
+		now = now.Add(droppedTxGracePeriod)
+		confirmation, err := tracker.Check(ctx, txID)
+		require.NoError(t, err)
+		assert.Equal(t, ports.TxConfirmationPending, confirmation.Status)
+
+		confirmation, err = tracker.Check(ctx, txID)
+		require.NoError(t, err)
+		assert.Equal(t, ports.TxConfirmationConfirmed, confirmation.Status)
+		assert.Empty(t, tracker.misses)
+	})
+
+	t.Run("the misses are reset when the transaction shows up", func(t *testing.T) {
+		client := &ethClientMock{}
+		tracker := NewConfirmationTracker(client, 5).(*confirmationTracker)
+		now := time.Now()
+		tracker.now = func() time.Time { return now }
+
+		for i := 0; i < droppedTxMisses; i++ {
+			_, err := tracker.Check(ctx, txID)
+			require.NoError(t, err)
+		}
+		client.inMempool = true
+		confirmation, err := tracker.Check(ctx, txID)
+		require.NoError(t, err)
+		assert.Equal(t, ports.TxConfirmationPending, confirmation.Status)
+
+		client.inMempool = false
+		now = now.Add(droppedTxGracePeriod)
+		confirmation, err = tracker.Check(ctx, txID)
+		require.NoError(t, err)
+		assert.Equal(t, ports.TxConfirmationPending, confirmation.Status)
+	})
+}
@@ -0,0 +1,79 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// maxConnectionWebhookResponse is the max size of the decision returned by the connection webhook
+const maxConnectionWebhookResponse = 64 << 10
+
+// NewConnectionWebhook returns the gateway of the configured connection webhook, or nil if there is none
+func NewConnectionWebhook(cfg config.ConnectionWebhook) ports.ConnectionWebhook {
+	if cfg.URL == "" {
+		return nil
+	}
+	return &connectionWebhook{url: cfg.URL, failOpen: cfg.FailOpen, client: &http.Client{Timeout: cfg.Timeout}}
+}
+
+// connectionWebhook posts the new connections as json. The webhook must answer 200 with {"allow": bool, "reason": "..."}
+type connectionWebhook struct {
+	url      string
+	failOpen bool
+	client   *http.Client
+}
+
+// Vet returns the decision of the webhook. When the webhook can not be consulted the connection is allowed only if
+// the webhook is configured to fail open.
+func (w *connectionWebhook) Vet(ctx context.Context, vetting domain.NewConnectionVetting) (*domain.NewConnectionDecision, error) {
+	decision, err := w.vet(ctx, vetting)
+	if err != nil {
+		log.Error(ctx, "consulting the connection webhook", "err", err, "userDID", vetting.UserDID)
+		if w.failOpen {
+			log.Warn(ctx, "audit: connection webhook unavailable, connection allowed", "issuer", vetting.Issuer, "userDID", vetting.UserDID)
+			return &domain.NewConnectionDecision{Allow: true}, nil
+		}
+		return nil, err
+	}
+	return decision, nil
+}
+
+func (w *connectionWebhook) vet(ctx context.Context, vetting domain.NewConnectionVetting) (*domain.NewConnectionDecision, error) {
+	b, err := json.Marshal(vetting)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxConnectionWebhookResponse))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("connection webhook answered with status %d: %s", resp.StatusCode, respBody)
+	}
+	var decision domain.NewConnectionDecision
+	if err := json.Unmarshal(respBody, &decision); err != nil {
+		return nil, fmt.Errorf("invalid connection webhook response: %w", err)
+	}
+	return &decision, nil
+}
@@ -0,0 +1,71 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
+)
+
+const (
+	displayMethodTimeout  = 5 * time.Second
+	displayMethodCacheTTL = 10 * time.Minute
+	maxDisplayMethodBytes = 64 * 1024
+)
+
+// DisplayMethodResolver fetches the Iden3BasicDisplayMethodV1 documents of the credentials over http(s). The
+// documents are cached, as the credentials of a schema usually share the same one.
+type DisplayMethodResolver struct {
+	client    *http.Client
+	documents *invalidation.Local[*domain.CredentialDisplay]
+}
+
+// NewDisplayMethodResolver returns a new display method resolver
+func NewDisplayMethodResolver() ports.DisplayMethodResolver {
+	return &DisplayMethodResolver{
+		client:    &http.Client{Timeout: displayMethodTimeout},
+		documents: invalidation.NewLocal[*domain.CredentialDisplay](displayMethodCacheTTL),
+	}
+}
+
+// Resolve returns the document of the display method
+func (r *DisplayMethodResolver) Resolve(ctx context.Context, displayMethod verifiable.DisplayMethod) (*domain.CredentialDisplay, error) {
+	if displayMethod.Type != verifiable.Iden3BasicDisplayMethodV1 {
+		return nil, fmt.Errorf("unsupported display method type: %s", displayMethod.Type)
+	}
+	if !strings.HasPrefix(displayMethod.ID, "https://") && !strings.HasPrefix(displayMethod.ID, "http://") {
+		return nil, fmt.Errorf("unsupported display method url: %s", displayMethod.ID)
+	}
+	if display, err := r.documents.Get(displayMethod.ID); err == nil {
+		return display, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, displayMethod.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("display method document returned status %d", resp.StatusCode)
+	}
+	var display domain.CredentialDisplay
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxDisplayMethodBytes)).Decode(&display); err != nil {
+		return nil, fmt.Errorf("invalid display method document: %w", err)
+	}
+	r.documents.Set(displayMethod.ID, &display)
+	return &display, nil
+}
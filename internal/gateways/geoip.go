@@ -0,0 +1,116 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// NewGeoIP returns the configured geoip provider, or nil if there is none
+func NewGeoIP(cfg config.GeoIP) ports.GeoIPProvider {
+	switch cfg.Type {
+	case config.GeoIPHTTP:
+		return &httpGeoIP{
+			url:       cfg.URL,
+			path:      strings.Split(cfg.Path, "."),
+			ttl:       cfg.CacheTTL,
+			client:    &http.Client{Timeout: cfg.Timeout},
+			countries: make(map[string]geoIPEntry),
+		}
+	default:
+		return nil
+	}
+}
+
+type geoIPEntry struct {
+	country   string
+	fetchedAt time.Time
+}
+
+// httpGeoIP reads the country of the ips from a json document and caches them for ttl
+type httpGeoIP struct {
+	url    string
+	path   []string
+	ttl    time.Duration
+	client *http.Client
+
+	mu        sync.Mutex
+	countries map[string]geoIPEntry
+}
+
+func (g *httpGeoIP) Country(ctx context.Context, ip net.IP) (string, error) {
+	if ip == nil {
+		return "", fmt.Errorf("unknown client ip")
+	}
+	key := ip.String()
+	g.mu.Lock()
+	entry, found := g.countries[key]
+	g.mu.Unlock()
+	if found && time.Since(entry.fetchedAt) < g.ttl {
+		return entry.country, nil
+	}
+
+	country, err := g.fetch(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// expired entries are dropped on writes, so the cache does not grow with the ips seen long ago
+	for cached, e := range g.countries {
+		if time.Since(e.fetchedAt) >= g.ttl {
+			delete(g.countries, cached)
+		}
+	}
+	g.countries[key] = geoIPEntry{country: country, fetchedAt: time.Now()}
+	return country, nil
+}
+
+func (g *httpGeoIP) fetch(ctx context.Context, ip string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(g.url, "{ip}", url.PathEscape(ip)), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("geoip provider answered with status %d: %s", resp.StatusCode, body)
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return "", fmt.Errorf("invalid geoip provider response: %w", err)
+	}
+	for _, key := range g.path {
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("country not found in the geoip provider response at %s", strings.Join(g.path, "."))
+		}
+		doc = obj[key]
+	}
+	country, ok := doc.(string)
+	if !ok || len(country) != 2 {
+		return "", fmt.Errorf("country not found in the geoip provider response at %s", strings.Join(g.path, "."))
+	}
+	return strings.ToUpper(country), nil
+}
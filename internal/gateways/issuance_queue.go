@@ -0,0 +1,91 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	// IssuanceQueueCommandField is the field of the stream entries that carries the issuance command as json
+	IssuanceQueueCommandField = "command"
+
+	// IssuanceQueueResultField is the field of the reply stream entries that carries the issuance result as json
+	IssuanceQueueResultField = "result"
+)
+
+// redisIssuanceQueue reads the issuance commands from a redis stream with a consumer group, so several issuer nodes
+// can share the commands, and writes the results to another stream
+type redisIssuanceQueue struct {
+	rdb     *redis.Client
+	cfg     config.IssuanceQueue
+	pending bool
+}
+
+// NewRedisIssuanceQueue returns the issuance queue of a redis stream. It creates the consumer group, and the stream,
+// if they do not exist.
+func NewRedisIssuanceQueue(ctx context.Context, rdb *redis.Client, cfg config.IssuanceQueue) (ports.IssuanceQueue, error) {
+	err := rdb.XGroupCreateMkStream(ctx, cfg.Topic, cfg.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("creating the consumer group of the issuance queue: %w", err)
+	}
+	return &redisIssuanceQueue{rdb: rdb, cfg: cfg, pending: true}, nil
+}
+
+// Receive returns the commands delivered to this consumer and never acknowledged, e.g. before a restart, and then waits
+// for new commands up to the block timeout
+func (q *redisIssuanceQueue) Receive(ctx context.Context) ([]domain.IssuanceMessage, error) {
+	from := ">"
+	if q.pending {
+		from = "0"
+	}
+	streams, err := q.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    q.cfg.Group,
+		Consumer: q.cfg.Consumer,
+		Streams:  []string{q.cfg.Topic, from},
+		Count:    int64(q.cfg.BatchSize),
+		Block:    q.cfg.BlockTimeout,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]domain.IssuanceMessage, 0, q.cfg.BatchSize)
+	for _, stream := range streams {
+		for _, entry := range stream.Messages {
+			command, _ := entry.Values[IssuanceQueueCommandField].(string)
+			messages = append(messages, domain.IssuanceMessage{ID: entry.ID, Body: []byte(command)})
+		}
+	}
+	if q.pending && len(messages) == 0 {
+		q.pending = false
+	}
+	return messages, nil
+}
+
+// Reply appends the result to the reply stream
+func (q *redisIssuanceQueue) Reply(ctx context.Context, result domain.IssuanceCommandResult) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return q.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.cfg.ReplyTopic,
+		Values: map[string]any{IssuanceQueueResultField: string(body)},
+	}).Err()
+}
+
+// Ack removes the command from the pending entries of the consumer group
+func (q *redisIssuanceQueue) Ack(ctx context.Context, messageID string) error {
+	return q.rdb.XAck(ctx, q.cfg.Topic, q.cfg.Group, messageID).Err()
+}
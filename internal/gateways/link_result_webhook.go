@@ -0,0 +1,53 @@
+package gateways
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+// LinkResultSignatureHeader is the header with the HMAC-SHA256 of the body posted to the link result webhooks
+const LinkResultSignatureHeader = "X-Issuer-Signature"
+
+type linkResultWebhook struct {
+	client *client.Client
+}
+
+// NewLinkResultWebhook returns a link result webhook that posts the results as json, reshaped by the webhook template
+// of the schema if it has one, and signed with the secret of the webhook
+func NewLinkResultWebhook(cli *client.Client) ports.LinkResultWebhook {
+	return &linkResultWebhook{client: cli}
+}
+
+func (w *linkResultWebhook) Notify(ctx context.Context, webhook domain.LinkResultWebhook, result domain.LinkIssuanceResult, template domain.WebhookTemplate) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if len(template) > 0 {
+		if body, err = renderWebhookTemplate(template, body); err != nil {
+			return err
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(webhook.Secret))
+	mac.Write(body)
+	_, err = w.client.PostWithHeaders(ctx, webhook.URL, body, map[string]string{
+		LinkResultSignatureHeader: "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+	})
+	return err
+}
+
+// renderWebhookTemplate returns the json body reshaped by the template
+func renderWebhookTemplate(template domain.WebhookTemplate, body []byte) ([]byte, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(template.Render(fields))
+}
@@ -0,0 +1,109 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+var (
+	ErrPaymentWrongChain     = errors.New("payment chain is not supported by the issuer node")                      // ErrPaymentWrongChain the payment was requested in a chain the node is not connected to
+	ErrPaymentTxNotFound     = errors.New("payment transaction not found")                                          // ErrPaymentTxNotFound the transaction is not mined yet
+	ErrPaymentTxFailed       = errors.New("payment transaction was reverted")                                       // ErrPaymentTxFailed the transaction was mined but reverted
+	ErrPaymentTxTooOld       = errors.New("payment transaction was sent before the payment request")                // ErrPaymentTxTooOld the transaction cannot pay a request created after it
+	ErrPaymentTxDoesNotMatch = errors.New("payment transaction does not pay the requested amount to the recipient") // ErrPaymentTxDoesNotMatch the transaction pays somebody else, less or with another token
+)
+
+// erc20TransferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event
+var erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// PaymentETHClient is the ethereum client used to verify the payments
+type PaymentETHClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	GetTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error)
+	GetTransactionByID(ctx context.Context, txID string) (*types.Transaction, bool, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type paymentVerifier struct {
+	client PaymentETHClient
+}
+
+// NewPaymentVerifier returns a verifier of the payments made in the chain the client is connected to
+func NewPaymentVerifier(client PaymentETHClient) ports.PaymentVerifier {
+	return &paymentVerifier{client: client}
+}
+
+// Verify checks that the transaction is mined, successful, sent after the payment request and that it transfers
+// at least the requested amount of the token to the recipient. Native currency payments must be plain transfers
+// to the recipient and ERC-20 payments are checked against the Transfer events of the token contract.
+func (v *paymentVerifier) Verify(ctx context.Context, payment *domain.Payment, txID string) error {
+	chainID, err := v.client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if chainID.Int64() != int64(payment.ChainID) {
+		return ErrPaymentWrongChain
+	}
+
+	receipt, err := v.client.GetTransactionReceiptByID(ctx, txID)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return ErrPaymentTxNotFound
+		}
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return ErrPaymentTxFailed
+	}
+
+	header, err := v.client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return err
+	}
+	if int64(header.Time) < payment.CreatedAt.Unix() {
+		return ErrPaymentTxTooOld
+	}
+
+	amount, ok := new(big.Int).SetString(payment.Amount, 10)
+	if !ok {
+		log.Error(ctx, "invalid payment amount", "payment", payment.ID, "amount", payment.Amount)
+		return ErrPaymentTxDoesNotMatch
+	}
+	recipient := common.HexToAddress(payment.Recipient)
+
+	if payment.Token.IsNative() {
+		tx, _, err := v.client.GetTransactionByID(ctx, txID)
+		if err != nil {
+			return err
+		}
+		if tx.To() == nil || *tx.To() != recipient || tx.Value().Cmp(amount) < 0 {
+			return ErrPaymentTxDoesNotMatch
+		}
+		return nil
+	}
+
+	token := common.HexToAddress(payment.Token.Address)
+	for _, l := range receipt.Logs {
+		if l.Address != token || len(l.Topics) != 3 || l.Topics[0] != erc20TransferTopic {
+			continue
+		}
+		if common.BytesToAddress(l.Topics[2].Bytes()) != recipient {
+			continue
+		}
+		if new(big.Int).SetBytes(l.Data).Cmp(amount) >= 0 {
+			return nil
+		}
+	}
+	log.Warn(ctx, "payment transaction does not transfer the token to the recipient", "payment", payment.ID, "tx", txID, "token", token.Hex())
+	return ErrPaymentTxDoesNotMatch
+}
@@ -0,0 +1,90 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// NewPolicyEngine returns the gateway of the configured policy engine, or nil if there is none
+func NewPolicyEngine(cfg config.PolicyEngine) ports.PolicyEngine {
+	switch cfg.Type {
+	case config.PolicyEngineOPA:
+		return &opaPolicyEngine{url: cfg.URL, client: &http.Client{Timeout: cfg.Timeout}}
+	default:
+		return nil
+	}
+}
+
+// opaPolicyEngine queries a decision of the open policy agent data api. The rego document must produce:
+// {"effect": "allow|deny|modify", "reason": "...", "attributes": {...}, "expiration": "RFC3339"}
+type opaPolicyEngine struct {
+	url    string
+	client *http.Client
+}
+
+type opaRequest struct {
+	Input domain.PolicyInput `json:"input"`
+}
+
+type opaResponse struct {
+	Result *struct {
+		Effect     domain.PolicyEffect `json:"effect"`
+		Reason     string              `json:"reason"`
+		Attributes map[string]any      `json:"attributes"`
+		Expiration *time.Time          `json:"expiration"`
+	} `json:"result"`
+}
+
+// Evaluate queries the decision. An undefined decision denies the credential.
+func (p *opaPolicyEngine) Evaluate(ctx context.Context, input domain.PolicyInput) (*domain.PolicyDecision, error) {
+	b, err := json.Marshal(opaRequest{Input: input})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("policy engine answered with status %d: %s", resp.StatusCode, respBody)
+	}
+	var decision opaResponse
+	if err := json.Unmarshal(respBody, &decision); err != nil {
+		return nil, fmt.Errorf("invalid policy engine response: %w", err)
+	}
+	if decision.Result == nil {
+		return &domain.PolicyDecision{Effect: domain.PolicyEffectDeny, Reason: "undefined policy decision"}, nil
+	}
+	switch decision.Result.Effect {
+	case domain.PolicyEffectAllow, domain.PolicyEffectDeny, domain.PolicyEffectModify:
+	default:
+		return nil, fmt.Errorf("invalid policy effect %q", decision.Result.Effect)
+	}
+	return &domain.PolicyDecision{
+		Effect:     decision.Result.Effect,
+		Reason:     decision.Result.Reason,
+		Attributes: decision.Result.Attributes,
+		Expiration: decision.Result.Expiration,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// NewPriceFeed returns the configured price feed of the native token, or nil if there is none
+func NewPriceFeed(cfg config.PriceFeed) ports.PriceFeed {
+	switch cfg.Type {
+	case config.PriceFeedStatic:
+		return &staticPriceFeed{price: cfg.Price, currency: cfg.Currency}
+	case config.PriceFeedHTTP:
+		return &httpPriceFeed{
+			url:      cfg.URL,
+			path:     strings.Split(cfg.Path, "."),
+			currency: cfg.Currency,
+			ttl:      cfg.CacheTTL,
+			client:   &http.Client{Timeout: cfg.Timeout},
+		}
+	default:
+		return nil
+	}
+}
+
+type staticPriceFeed struct {
+	price    float64
+	currency string
+}
+
+func (f *staticPriceFeed) Price(_ context.Context) (float64, error) {
+	return f.price, nil
+}
+
+func (f *staticPriceFeed) Currency() string {
+	return f.currency
+}
+
+// httpPriceFeed reads the price from a json document and caches it for ttl
+type httpPriceFeed struct {
+	url      string
+	path     []string
+	currency string
+	ttl      time.Duration
+	client   *http.Client
+
+	mu        sync.Mutex
+	price     float64
+	fetchedAt time.Time
+}
+
+func (f *httpPriceFeed) Currency() string {
+	return f.currency
+}
+
+func (f *httpPriceFeed) Price(ctx context.Context) (float64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.fetchedAt.IsZero() && time.Since(f.fetchedAt) < f.ttl {
+		return f.price, nil
+	}
+
+	price, err := f.fetch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	f.price, f.fetchedAt = price, time.Now()
+	return price, nil
+}
+
+func (f *httpPriceFeed) fetch(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("price feed answered with status %d: %s", resp.StatusCode, body)
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return 0, fmt.Errorf("invalid price feed response: %w", err)
+	}
+	for _, key := range f.path {
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return 0, fmt.Errorf("price not found in the price feed response at %s", strings.Join(f.path, "."))
+		}
+		doc = obj[key]
+	}
+
+	var price float64
+	switch v := doc.(type) {
+	case float64:
+		price = v
+	case string:
+		if price, err = strconv.ParseFloat(v, 64); err != nil {
+			return 0, fmt.Errorf("invalid price in the price feed response: %w", err)
+		}
+	default:
+		return 0, fmt.Errorf("price not found in the price feed response at %s", strings.Join(f.path, "."))
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("invalid price in the price feed response: %v", price)
+	}
+	return price, nil
+}
@@ -49,6 +49,13 @@ type PublisherGateway interface {
 	PublishState(ctx context.Context, identifier *w3c.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error)
 }
 
+// asyncPublisherGateway is implemented by the gateways whose transactions are not sent right away, like the ones
+// proposed to a multisig or sent by a relayer. The publisher does not wait for them and leaves them to the transaction
+// status checker.
+type asyncPublisherGateway interface {
+	Async() bool
+}
+
 type publisher struct {
 	storage               *db.Storage
 	identityService       ports.IdentityService
@@ -61,10 +68,12 @@ type publisher struct {
 	publisherGateway      PublisherGateway
 	pendingTransactions   *sync_ttl_map.TTLMap
 	notificationPublisher pubsub.Publisher
+	confirmationTracker   ports.ConfirmationTracker
+	priceFeed             ports.PriceFeed
 }
 
 // NewPublisher - Constructor
-func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimsService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, confirmationTimeout time.Duration, notificationPublisher pubsub.Publisher) *publisher {
+func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimsService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, confirmationTimeout time.Duration, notificationPublisher pubsub.Publisher, confirmationTracker ports.ConfirmationTracker, priceFeed ports.PriceFeed) *publisher {
 	pendingTransactions := sync_ttl_map.New(ttl)
 	pendingTransactions.CleaningBackground(transactionCleanup)
 
@@ -80,6 +89,8 @@ func NewPublisher(storage *db.Storage, identityService ports.IdentityService, cl
 		confirmationTimeout:   confirmationTimeout,
 		pendingTransactions:   pendingTransactions,
 		notificationPublisher: notificationPublisher,
+		confirmationTracker:   confirmationTracker,
+		priceFeed:             priceFeed,
 	}
 }
 
@@ -257,7 +268,7 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 		}
 
 		sigDigest := kms.BJJDigest(hashOldAndNewStates)
-		sigBytes, err := p.kms.Sign(ctx, claimKeyID, sigDigest)
+		sigBytes, err := p.kms.Sign(kms.WithSigningOperation(ctx, kms.SigningOperationState, ""), claimKeyID, sigDigest)
 		if err != nil {
 			return nil, err
 		}
@@ -313,6 +324,11 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 		return nil, err
 	}
 
+	if gw, ok := p.publisherGateway.(asyncPublisherGateway); ok && gw.Async() {
+		p.pendingTransactions.Delete(identifier.String())
+		return txID, nil
+	}
+
 	// add go routine that will listen for transaction status update
 
 	go func(ctx context.Context) {
@@ -412,6 +428,17 @@ func (p *publisher) updateTransactionStatus(ctx context.Context, state domain.Id
 		if !confirmed {
 			return fmt.Errorf("transaction receipt is found, but tx is not confirmed yet - %s", *state.TxID)
 		}
+
+		// a reorg could have dropped or moved the transaction while waiting for the confirmations
+		confirmation, rErr := p.confirmationTracker.Check(ctx, txID)
+		if rErr != nil {
+			return fmt.Errorf("transaction confirmation is not checked - %s: %w", *state.TxID, rErr)
+		}
+		// the state remains transacted, so the transaction status checker will take care of it
+		if confirmation.Status == ports.TxConfirmationDropped || confirmation.Status == ports.TxConfirmationPending {
+			return fmt.Errorf("transaction was reorganized while waiting for confirmations - %s", *state.TxID)
+		}
+		receipt = confirmation.Receipt
 	} else {
 		// do not wait for many confirmations, just save as failed
 		log.Info(ctx, "transaction failed", "tx", *state.TxID)
@@ -427,6 +454,22 @@ func (p *publisher) updateTransactionStatus(ctx context.Context, state domain.Id
 	return nil
 }
 
+// setTxFeeFiat converts the fee of the state transaction to fiat with the current price of the native token.
+// The fee is left without fiat value when there is no price feed or the price is not available.
+func (p *publisher) setTxFeeFiat(ctx context.Context, state *domain.IdentityState) {
+	if p.priceFeed == nil {
+		return
+	}
+	price, err := p.priceFeed.Price(ctx)
+	if err != nil {
+		log.Warn(ctx, "getting the native token price", "err", err, "state", state.State)
+		return
+	}
+	if err := state.SetTxFeeFiat(price, p.priceFeed.Currency()); err != nil {
+		log.Warn(ctx, "converting the transaction fee to fiat", "err", err, "state", state.State)
+	}
+}
+
 func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, state *domain.IdentityState, receipt *types.Receipt) error {
 	header, err := p.transactionService.GetHeaderByNumber(ctx, receipt.BlockNumber)
 	if err != nil {
@@ -440,6 +483,14 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, state *doma
 	blockTime := int(header.Time)
 	state.BlockTimestamp = &blockTime
 
+	gasUsed := int64(receipt.GasUsed)
+	state.GasUsed = &gasUsed
+	if receipt.EffectiveGasPrice != nil {
+		txFee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed)).String()
+		state.TxFee = &txFee
+		p.setTxFeeFiat(ctx, state)
+	}
+
 	if receipt.Status == types.ReceiptStatusSuccessful {
 		state.Status = domain.StatusConfirmed
 		err = p.claimService.UpdateClaimsMTPAndState(ctx, state)
@@ -524,26 +575,21 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context) {
 }
 
 func (p *publisher) checkStatus(ctx context.Context, state *domain.IdentityState) error {
-	// Get receipt and check status
-	receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, *state.TxID)
+	confirmation, err := p.confirmationTracker.Check(ctx, *state.TxID)
 	if err != nil {
-		log.Error(ctx, "error during receipt receiving:", "err", err, "state-id", *state.TxID)
-		return fmt.Errorf("error during receipt receiving::%s: %w", *state.TxID, err)
+		log.Error(ctx, "error during transaction confirmation check:", "err", err, "state-id", *state.TxID)
+		return fmt.Errorf("error during transaction confirmation check::%s: %w", *state.TxID, err)
 	}
 
-	// Check if transaction has enough confirmation blocks
-	confirmed, err := p.transactionService.CheckConfirmation(ctx, receipt)
-	if err != nil {
-		log.Error(ctx, fmt.Sprintf("transaction receipt is found, but confirmation is not checked - %s", *state.TxID), "err", err)
-		return fmt.Errorf("transaction receipt is found, but confirmation is not checked:%s - %w", *state.TxID, err)
-	}
-
-	if !confirmed {
-		log.Debug(ctx, "transaction receipt is found, but it is not confirmed yet", "TxID", *state.TxID)
+	switch confirmation.Status {
+	case ports.TxConfirmationPending:
+		log.Debug(ctx, "transaction is not confirmed yet", "TxID", *state.TxID, "confirmations", confirmation.Confirmations)
 		return ErrStateIsBeingProcessed
+	case ports.TxConfirmationDropped:
+		return p.requeueDroppedState(ctx, state)
 	}
 
-	err = p.updateIdentityStateTxStatus(ctx, state, receipt)
+	err = p.updateIdentityStateTxStatus(ctx, state, confirmation.Receipt)
 	if err != nil {
 		log.Error(ctx, "error during identity state update: ", "err", err)
 		return err
@@ -552,3 +598,28 @@ func (p *publisher) checkStatus(ctx context.Context, state *domain.IdentityState
 	log.Info(ctx, "transaction status updated", "tx", *state.TxID)
 	return nil
 }
+
+// requeueDroppedState marks the state as failed keeping track of the dropped transaction and publishes it again
+func (p *publisher) requeueDroppedState(ctx context.Context, state *domain.IdentityState) error {
+	if p.pendingTransactions.Load(state.Identifier) != nil {
+		return ErrStateIsBeingProcessed
+	}
+	did, err := w3c.ParseDID(state.Identifier)
+	if err != nil {
+		return err
+	}
+
+	log.Warn(ctx, "state transaction dropped. Publishing the state again", "tx", *state.TxID, "state", *state.State)
+	state.DroppedTxIDs = append(state.DroppedTxIDs, *state.TxID)
+	state.Status = domain.StatusFailed
+	if err := p.identityService.UpdateIdentityState(ctx, state); err != nil {
+		log.Error(ctx, "error saving the dropped state as failed", "err", err, "state", *state.State)
+		return err
+	}
+
+	if _, err := p.RetryPublishState(ctx, did); err != nil {
+		log.Error(ctx, "error publishing the dropped state again", "err", err, "state", *state.State)
+		return err
+	}
+	return nil
+}
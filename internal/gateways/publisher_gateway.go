@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/iden3/contracts-abi/state/go/abi"
@@ -114,7 +115,7 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 			return nil, err
 		}
 
-		a, b, c, err := pb.adaptProofToAbi(proof)
+		a, b, c, err := adaptProofToAbi(proof)
 		if err != nil {
 			return nil, err
 		}
@@ -132,7 +133,24 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 	return &txID, nil
 }
 
-func (pb *PublisherEthGateway) adaptProofToAbi(proof *rstypes.ProofData) (proofA [2]*big.Int, proofB [2][2]*big.Int, proofC [2]*big.Int, err error) {
+// transitStateCallData returns the call data of the state transition. Identities with ethereum keys use the generic
+// transition, that is authorized by the sender of the transaction instead of a zk proof.
+func transitStateCallData(stateABI *ethAbi.ABI, identity *domain.Identity, id core.ID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData) ([]byte, error) {
+	switch identity.KeyType {
+	case string(kms.KeyTypeEthereum):
+		return stateABI.Pack("transitStateGeneric", id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, big.NewInt(1), []byte{})
+	case string(kms.KeyTypeBabyJubJub):
+		a, b, c, err := adaptProofToAbi(proof)
+		if err != nil {
+			return nil, err
+		}
+		return stateABI.Pack("transitState", id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
+	default:
+		return nil, errors.New("unsupported key type for publishing")
+	}
+}
+
+func adaptProofToAbi(proof *rstypes.ProofData) (proofA [2]*big.Int, proofB [2][2]*big.Int, proofC [2]*big.Int, err error) {
 	a, err := common.ArrayStringToBigInt(proof.A)
 	if err != nil {
 		return
@@ -0,0 +1,243 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/iden3/contracts-abi/state/go/abi"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	rstypes "github.com/iden3/go-rapidsnark/types"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+// ErrRelayerUnsupportedKeyType is returned when the identity state can not be published through the relayer.
+// The state contract checks that ethereum based identities send their own transitions, so only
+// identities with baby jubjub keys, whose transitions are authorized by a zk proof, can be relayed.
+var ErrRelayerUnsupportedKeyType = errors.New("the relayer can only publish the state of identities with baby jubjub keys")
+
+// PublisherRelayerGateway publishes the state transitions through a transaction relayer, so the node does not need
+// a funded account. The relayer receives the transaction to sign and send and answers with its transaction id.
+// The relayer replaces the transactions that are not mined in time with a higher gas price, so the hash changes:
+// the transaction id is kept as the id of the state transition, and the tracker returned by
+// NewRelayerConfirmationTracker resolves the current hash before checking its confirmations.
+type PublisherRelayerGateway struct {
+	client   *http.Client
+	cfg      config.Relayer
+	contract ethCommon.Address
+	stateABI *ethAbi.ABI
+}
+
+type relayerRequest struct {
+	To       string `json:"to"`
+	Data     string `json:"data"`
+	Value    string `json:"value"`
+	GasLimit uint64 `json:"gasLimit"`
+	Speed    string `json:"speed,omitempty"`
+}
+
+type relayerTransaction struct {
+	Hash            string `json:"hash"`
+	TransactionHash string `json:"transactionHash"`
+	TransactionID   string `json:"transactionId"`
+	Status          string `json:"status"`
+}
+
+// hash returns the hash of the last transaction the relayer sent, if any
+func (t *relayerTransaction) hash() string {
+	if t.TransactionHash != "" {
+		return t.TransactionHash
+	}
+	return t.Hash
+}
+
+// relayerTxFailed is the status of the transactions the relayer gave up sending
+const relayerTxFailed = "failed"
+
+// relayerConfirmationTracker checks the transaction the relayer sent last for a relayer transaction id
+type relayerConfirmationTracker struct {
+	client *http.Client
+	cfg    config.Relayer
+	inner  ports.ConfirmationTracker
+}
+
+// NewPublisherRelayerGateway creates a new publisher gateway that sends the transactions to the relayer
+func NewPublisherRelayerGateway(cfg config.Relayer, contract ethCommon.Address) (*PublisherRelayerGateway, error) {
+	stateABI, err := abi.StateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &PublisherRelayerGateway{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		cfg:      cfg,
+		contract: contract,
+		stateABI: stateABI,
+	}, nil
+}
+
+// NewStatePublisherGateway returns the gateway that publishes the state transitions for the configured publishing mode:
+// the relayer, the safe, or the publishing account when none of them is enabled.
+func NewStatePublisherGateway(cfg *config.Configuration, client *eth.Client, keyStore *kms.KMS) (PublisherGateway, error) {
+	contract := ethCommon.HexToAddress(cfg.Ethereum.ContractAddress)
+	switch {
+	case cfg.Relayer.Enabled:
+		return NewPublisherRelayerGateway(cfg.Relayer, contract)
+	case cfg.Safe.Enabled:
+		return NewPublisherSafeGateway(cfg.Safe, client, contract, keyStore, cfg.PublishingKeyPath)
+	}
+	return NewPublisherEthGateway(client, contract, keyStore, cfg.PublishingKeyPath)
+}
+
+// PublishState sends the state transition to the relayer
+func (pr *PublisherRelayerGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error) {
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+	if identity.KeyType != string(kms.KeyTypeBabyJubJub) {
+		return nil, ErrRelayerUnsupportedKeyType
+	}
+
+	id, err := core.IDFromDID(*identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := transitStateCallData(pr.stateABI, identity, id, latestState, newState, isOldStateGenesis, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := pr.send(ctx, relayerRequest{
+		To:       pr.contract.Hex(),
+		Data:     hexutil.Encode(data),
+		Value:    "0",
+		GasLimit: pr.cfg.GasLimit,
+		Speed:    pr.cfg.Speed,
+	})
+	if err != nil {
+		log.Error(ctx, "failed to send the state transition to the relayer", "err", err)
+		return nil, err
+	}
+	return &txID, nil
+}
+
+// Async reports that the relayer sends the transactions, and may replace them, so the publisher does not wait for them.
+func (pr *PublisherRelayerGateway) Async() bool {
+	return true
+}
+
+// send sends the transaction to the relayer and returns the relayer transaction id. The relayers that don't track
+// their transactions answer only with the hash, which is returned instead.
+func (pr *PublisherRelayerGateway) send(ctx context.Context, relayerReq relayerRequest) (string, error) {
+	var tx relayerTransaction
+	if err := relayerDo(ctx, pr.client, pr.cfg, http.MethodPost, pr.cfg.URL, relayerReq, &tx); err != nil {
+		return "", err
+	}
+	if tx.TransactionID != "" {
+		log.Info(ctx, "state transition sent to the relayer", "relayerTransactionID", tx.TransactionID, "txID", tx.hash())
+		return tx.TransactionID, nil
+	}
+	if tx.hash() == "" {
+		return "", errors.New("relayer response does not include the transaction id or hash")
+	}
+	log.Info(ctx, "state transition sent to the relayer", "txID", tx.hash())
+	return tx.hash(), nil
+}
+
+// NewRelayerConfirmationTracker returns a tracker of the transactions sent through the relayer. The current hash of
+// the relayer transaction is checked by the inner tracker, while the relayer may still replace it the transaction is
+// pending, and it is dropped once the relayer gives up sending it. Transaction hashes are checked by the inner tracker,
+// so the states sent by relayers without transaction ids, or before enabling the relayer, are still followed.
+func NewRelayerConfirmationTracker(cfg config.Relayer, inner ports.ConfirmationTracker) ports.ConfirmationTracker {
+	return &relayerConfirmationTracker{client: &http.Client{Timeout: cfg.Timeout}, cfg: cfg, inner: inner}
+}
+
+func (t *relayerConfirmationTracker) Check(ctx context.Context, txID string) (*ports.TxConfirmation, error) {
+	if isTransactionHash(txID) {
+		return t.inner.Check(ctx, txID)
+	}
+	var tx relayerTransaction
+	statusURL := strings.ReplaceAll(t.cfg.StatusURL, "{id}", url.PathEscape(txID))
+	if err := relayerDo(ctx, t.client, t.cfg, http.MethodGet, statusURL, nil, &tx); err != nil {
+		return nil, err
+	}
+	if tx.Status == relayerTxFailed {
+		log.Warn(ctx, "the relayer could not send the transaction", "relayerTransactionID", txID, "tx", tx.hash())
+		return &ports.TxConfirmation{Status: ports.TxConfirmationDropped}, nil
+	}
+	if tx.hash() == "" {
+		log.Debug(ctx, "the relayer has not sent the transaction yet", "relayerTransactionID", txID, "status", tx.Status)
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+	confirmation, err := t.inner.Check(ctx, tx.hash())
+	if err != nil {
+		return nil, err
+	}
+	if confirmation.Status == ports.TxConfirmationDropped {
+		// the relayer replaces the transactions the node forgets about, the next check follows the new hash
+		log.Debug(ctx, "the relayer transaction was dropped, waiting for the relayer to replace it", "relayerTransactionID", txID, "tx", tx.hash())
+		confirmation.Status = ports.TxConfirmationPending
+	}
+	return confirmation, nil
+}
+
+// isTransactionHash returns true if the id is the hash of an ethereum transaction rather than a relayer transaction id
+func isTransactionHash(id string) bool {
+	b, err := hexutil.Decode(id)
+	return err == nil && len(b) == ethCommon.HashLength
+}
+
+func relayerDo(ctx context.Context, client *http.Client, cfg config.Relayer, method, endpoint string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("relayer answered with status %d: %s", resp.StatusCode, respBody)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("invalid relayer response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const testRelayerTxID = "5f6d1c2e-8a4b-4c3d-9e2f-1a0b3c4d5e6f"
+
+func TestPublisherRelayerGateway_Send(t *testing.T) {
+	ctx := context.Background()
+	request := relayerRequest{To: "0x134B1BE34911E39A8397ec6289782989729807a4", Data: "0x28f88a65", Value: "0", GasLimit: 1_000_000, Speed: "fast"}
+
+	for _, tc := range []struct {
+		name     string
+		status   int
+		response map[string]any
+		expected string
+		err      bool
+	}{
+		{
+			name:     "relayer transaction id",
+			status:   http.StatusOK,
+			response: map[string]any{"transactionId": testRelayerTxID, "hash": testExecTxHash, "status": "sent"},
+			expected: testRelayerTxID,
+		},
+		{
+			name:     "relayer without transaction ids",
+			status:   http.StatusOK,
+			response: map[string]any{"transactionHash": testExecTxHash},
+			expected: testExecTxHash,
+		},
+		{
+			name:     "neither transaction id nor hash",
+			status:   http.StatusOK,
+			response: map[string]any{"status": "pending"},
+			err:      true,
+		},
+		{
+			name:     "relayer error",
+			status:   http.StatusBadRequest,
+			response: map[string]any{"error": "insufficient funds"},
+			err:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/txs", r.URL.Path)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				var received relayerRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				assert.Equal(t, request, received)
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(tc.response)
+			}))
+			defer srv.Close()
+
+			gateway, err := NewPublisherRelayerGateway(config.Relayer{URL: srv.URL + "/txs", APIKey: "secret"}, ethCommon.HexToAddress(request.To))
+			require.NoError(t, err)
+			txID, err := gateway.send(ctx, request)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, txID)
+		})
+	}
+}
+
+func TestRelayerConfirmationTracker_Check(t *testing.T) {
+	ctx := context.Background()
+	mined := &ports.TxConfirmation{Status: ports.TxConfirmationConfirmed, Receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful}, Confirmations: 12}
+	dropped := &ports.TxConfirmation{Status: ports.TxConfirmationDropped}
+
+	type expected struct {
+		status  ports.TxConfirmationStatus
+		checked []string
+	}
+	for _, tc := range []struct {
+		name     string
+		txID     string
+		relayer  map[string]any
+		inner    *ports.TxConfirmation
+		expected expected
+	}{
+		{
+			name:     "sent",
+			txID:     testRelayerTxID,
+			relayer:  map[string]any{"transactionId": testRelayerTxID, "hash": testSafeTxHash, "status": "mined"},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationConfirmed, checked: []string{testSafeTxHash}},
+		},
+		{
+			name:     "replaced with a higher gas price",
+			txID:     testRelayerTxID,
+			relayer:  map[string]any{"transactionId": testRelayerTxID, "hash": testExecTxHash, "status": "mined"},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationConfirmed, checked: []string{testExecTxHash}},
+		},
+		{
+			name:     "not sent yet",
+			txID:     testRelayerTxID,
+			relayer:  map[string]any{"transactionId": testRelayerTxID, "status": "pending"},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationPending},
+		},
+		{
+			name:     "dropped while the relayer can replace it",
+			txID:     testRelayerTxID,
+			relayer:  map[string]any{"transactionId": testRelayerTxID, "hash": testSafeTxHash, "status": "submitted"},
+			inner:    dropped,
+			expected: expected{status: ports.TxConfirmationPending, checked: []string{testSafeTxHash}},
+		},
+		{
+			name:     "failed in the relayer",
+			txID:     testRelayerTxID,
+			relayer:  map[string]any{"transactionId": testRelayerTxID, "hash": testSafeTxHash, "status": "failed"},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationDropped},
+		},
+		{
+			name:     "transaction hash",
+			txID:     testExecTxHash,
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationConfirmed, checked: []string{testExecTxHash}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				if tc.relayer == nil || r.URL.Path != "/txs/"+tc.txID {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.relayer)
+			}))
+			defer srv.Close()
+
+			inner := &trackerMock{confirmation: tc.inner}
+			tracker := NewRelayerConfirmationTracker(config.Relayer{URL: srv.URL + "/txs", StatusURL: srv.URL + "/txs/{id}", APIKey: "secret"}, inner)
+			confirmation, err := tracker.Check(ctx, tc.txID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.status, confirmation.Status)
+			assert.Equal(t, tc.expected.checked, inner.checked)
+		})
+	}
+
+	t.Run("relayer error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		tracker := NewRelayerConfirmationTracker(config.Relayer{StatusURL: srv.URL + "/txs/{id}"}, &trackerMock{confirmation: mined})
+		_, err := tracker.Check(ctx, testRelayerTxID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,358 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/contracts-abi/state/go/abi"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	rstypes "github.com/iden3/go-rapidsnark/types"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+const (
+	safeOrigin         = "issuer-node"
+	safeRequestTimeout = 30 * time.Second
+)
+
+var (
+	safeDomainSeparatorTypeHash = crypto.Keccak256([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash              = crypto.Keccak256([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+
+	errSafeNotFound = errors.New("not found in the safe transaction service")
+)
+
+// PublisherSafeGateway proposes the state transitions to a Gnosis Safe through the Safe transaction service.
+// The transaction id returned is the safe transaction hash. The state remains transacted until the owners
+// of the safe execute the transaction, which is followed by the tracker returned by NewSafeConfirmationTracker.
+type PublisherSafeGateway struct {
+	rw              *sync.Mutex
+	api             *safeTransactionService
+	client          *eth.Client
+	kms             *kms.KMS
+	publishingKeyID kms.KeyID
+	safe            ethCommon.Address
+	contract        ethCommon.Address
+	stateABI        *ethAbi.ABI
+}
+
+// NewPublisherSafeGateway creates a new publisher gateway that proposes the transactions to the safe.
+// The publishing key must be an owner or a delegate of the safe to be allowed to propose transactions.
+func NewPublisherSafeGateway(cfg config.Safe, client *eth.Client, contract ethCommon.Address, keyStore *kms.KMS, publishingKeyPath string) (*PublisherSafeGateway, error) {
+	stateABI, err := abi.StateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &PublisherSafeGateway{
+		rw:              &sync.Mutex{},
+		api:             newSafeTransactionService(cfg),
+		client:          client,
+		kms:             keyStore,
+		publishingKeyID: kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath},
+		safe:            ethCommon.HexToAddress(cfg.Address),
+		contract:        contract,
+		stateABI:        stateABI,
+	}, nil
+}
+
+// PublishState proposes the state transition to the safe and returns the safe transaction hash
+func (ps *PublisherSafeGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error) {
+	ps.rw.Lock()
+	defer ps.rw.Unlock()
+
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+
+	id, err := core.IDFromDID(*identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := transitStateCallData(ps.stateABI, identity, id, latestState, newState, isOldStateGenesis, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := ps.client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := ps.api.nextNonce(ctx, ps.safe)
+	if err != nil {
+		log.Error(ctx, "failed to get the safe nonce", "err", err)
+		return nil, err
+	}
+	proposer, err := ps.client.Address(ps.publishingKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	safeTxHash := safeTransactionHash(chainID, ps.safe, ps.contract, data, nonce)
+	signature, err := ps.kms.Sign(kms.WithSigningOperation(ctx, kms.SigningOperationTransaction, ""), ps.publishingKeyID, safeTxHash)
+	if err != nil {
+		log.Error(ctx, "failed to sign the safe transaction", "err", err)
+		return nil, err
+	}
+	// the safe expects the recovery id of ethereum signatures, not the one returned by go-ethereum
+	signature[crypto.RecoveryIDOffset] += 27
+
+	txHash := hexutil.Encode(safeTxHash)
+	err = ps.api.propose(ctx, ps.safe, safeProposal{
+		To:                      ps.contract.Hex(),
+		Value:                   "0",
+		Data:                    hexutil.Encode(data),
+		Operation:               0,
+		SafeTxGas:               "0",
+		BaseGas:                 "0",
+		GasPrice:                "0",
+		GasToken:                ethCommon.Address{}.Hex(),
+		RefundReceiver:          ethCommon.Address{}.Hex(),
+		Nonce:                   nonce,
+		ContractTransactionHash: txHash,
+		Sender:                  proposer.Hex(),
+		Signature:               hexutil.Encode(signature),
+		Origin:                  safeOrigin,
+	})
+	if err != nil {
+		log.Error(ctx, "failed to propose the state transition to the safe", "err", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "state transition proposed to the safe", "safe", ps.safe.Hex(), "safeTxHash", txHash, "nonce", nonce)
+	return &txHash, nil
+}
+
+// Async reports that the transactions are not sent by the gateway, so the publisher does not wait for them.
+func (ps *PublisherSafeGateway) Async() bool {
+	return true
+}
+
+// safeTransactionHash returns the EIP-712 hash of a call from the safe with the default gas parameters
+func safeTransactionHash(chainID *big.Int, safe, to ethCommon.Address, data []byte, nonce uint64) []byte {
+	domainSeparator := crypto.Keccak256(
+		safeDomainSeparatorTypeHash,
+		ethCommon.LeftPadBytes(chainID.Bytes(), 32),
+		ethCommon.LeftPadBytes(safe.Bytes(), 32),
+	)
+	zero := make([]byte, 32)
+	structHash := crypto.Keccak256(
+		safeTxTypeHash,
+		ethCommon.LeftPadBytes(to.Bytes(), 32),
+		zero, // value
+		crypto.Keccak256(data),
+		zero, // operation: call
+		zero, // safeTxGas
+		zero, // baseGas
+		zero, // gasPrice
+		zero, // gasToken
+		zero, // refundReceiver
+		ethCommon.LeftPadBytes(new(big.Int).SetUint64(nonce).Bytes(), 32),
+	)
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+type safeConfirmationTracker struct {
+	api   *safeTransactionService
+	inner ports.ConfirmationTracker
+}
+
+// NewSafeConfirmationTracker returns a tracker of the transactions proposed to the safe. A safe transaction is pending
+// until the owners execute it. From then on the execution transaction is checked by the inner tracker.
+// Transaction ids unknown by the safe are checked by the inner tracker, so states sent before enabling the safe
+// are still followed.
+func NewSafeConfirmationTracker(cfg config.Safe, inner ports.ConfirmationTracker) ports.ConfirmationTracker {
+	return &safeConfirmationTracker{api: newSafeTransactionService(cfg), inner: inner}
+}
+
+// NewStateConfirmationTracker returns the tracker of the state transactions for the configured publishing mode
+func NewStateConfirmationTracker(cfg *config.Configuration, client ETHClient) ports.ConfirmationTracker {
+	tracker := NewConfirmationTracker(client, cfg.Ethereum.ConfirmationBlockCount)
+	switch {
+	case cfg.Relayer.Enabled:
+		return NewRelayerConfirmationTracker(cfg.Relayer, tracker)
+	case cfg.Safe.Enabled:
+		return NewSafeConfirmationTracker(cfg.Safe, tracker)
+	}
+	return tracker
+}
+
+func (t *safeConfirmationTracker) Check(ctx context.Context, txID string) (*ports.TxConfirmation, error) {
+	safeTx, err := t.api.transaction(ctx, txID)
+	if errors.Is(err, errSafeNotFound) {
+		return t.inner.Check(ctx, txID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !safeTx.IsExecuted || safeTx.TransactionHash == nil {
+		// another transaction with the same nonce was executed, e.g the owners rejected the proposal
+		nonce, err := t.api.nonce(ctx, ethCommon.HexToAddress(safeTx.Safe))
+		if err != nil {
+			return nil, err
+		}
+		if nonce > safeTx.Nonce {
+			log.Warn(ctx, "safe transaction was replaced by another transaction with the same nonce", "safeTxHash", txID, "nonce", safeTx.Nonce)
+			return &ports.TxConfirmation{Status: ports.TxConfirmationDropped}, nil
+		}
+		log.Debug(ctx, "safe transaction is not executed yet", "safeTxHash", txID, "confirmations", len(safeTx.Confirmations), "required", safeTx.ConfirmationsRequired)
+		return &ports.TxConfirmation{Status: ports.TxConfirmationPending}, nil
+	}
+	confirmation, err := t.inner.Check(ctx, *safeTx.TransactionHash)
+	if err != nil {
+		return nil, err
+	}
+	// the execution transaction succeeds even if the call of the safe reverts, so the result of the call is taken
+	// from the safe, once the execution is mined in the canonical chain
+	if safeTx.IsSuccessful != nil && !*safeTx.IsSuccessful && confirmation.Receipt != nil && confirmation.Status != ports.TxConfirmationDropped {
+		log.Warn(ctx, "safe transaction was executed but the state transition reverted", "safeTxHash", txID, "tx", *safeTx.TransactionHash)
+		confirmation.Status = ports.TxConfirmationFailed
+	}
+	return confirmation, nil
+}
+
+type safeTransactionService struct {
+	client *http.Client
+	url    string
+	auth   string
+}
+
+type safeProposal struct {
+	To                      string `json:"to"`
+	Value                   string `json:"value"`
+	Data                    string `json:"data"`
+	Operation               int    `json:"operation"`
+	SafeTxGas               string `json:"safeTxGas"`
+	BaseGas                 string `json:"baseGas"`
+	GasPrice                string `json:"gasPrice"`
+	GasToken                string `json:"gasToken"`
+	RefundReceiver          string `json:"refundReceiver"`
+	Nonce                   uint64 `json:"nonce"`
+	ContractTransactionHash string `json:"contractTransactionHash"`
+	Sender                  string `json:"sender"`
+	Signature               string `json:"signature"`
+	Origin                  string `json:"origin"`
+}
+
+type safeTransaction struct {
+	Safe                  string            `json:"safe"`
+	Nonce                 uint64            `json:"nonce"`
+	IsExecuted            bool              `json:"isExecuted"`
+	IsSuccessful          *bool             `json:"isSuccessful"`
+	TransactionHash       *string           `json:"transactionHash"`
+	ConfirmationsRequired int               `json:"confirmationsRequired"`
+	Confirmations         []json.RawMessage `json:"confirmations"`
+}
+
+func newSafeTransactionService(cfg config.Safe) *safeTransactionService {
+	return &safeTransactionService{
+		client: &http.Client{Timeout: safeRequestTimeout},
+		url:    strings.TrimSuffix(cfg.TransactionServiceURL, "/"),
+		auth:   cfg.TransactionServiceAuth,
+	}
+}
+
+// nextNonce returns the nonce for a new transaction, taking into account the transactions already queued in the safe
+func (s *safeTransactionService) nextNonce(ctx context.Context, safe ethCommon.Address) (uint64, error) {
+	nonce, err := s.nonce(ctx, safe)
+	if err != nil {
+		return 0, err
+	}
+
+	var queued struct {
+		Results []safeTransaction `json:"results"`
+	}
+	path := fmt.Sprintf("/api/v1/safes/%s/multisig-transactions/?executed=false&nonce__gte=%d&ordering=-nonce&limit=1", safe.Hex(), nonce)
+	if err := s.do(ctx, http.MethodGet, path, nil, &queued); err != nil {
+		return 0, err
+	}
+	if len(queued.Results) > 0 && queued.Results[0].Nonce >= nonce {
+		return queued.Results[0].Nonce + 1, nil
+	}
+	return nonce, nil
+}
+
+// nonce returns the nonce of the next transaction the safe will execute
+func (s *safeTransactionService) nonce(ctx context.Context, safe ethCommon.Address) (uint64, error) {
+	var info struct {
+		Nonce uint64 `json:"nonce"`
+	}
+	if err := s.do(ctx, http.MethodGet, fmt.Sprintf("/api/v1/safes/%s/", safe.Hex()), nil, &info); err != nil {
+		return 0, err
+	}
+	return info.Nonce, nil
+}
+
+func (s *safeTransactionService) propose(ctx context.Context, safe ethCommon.Address, proposal safeProposal) error {
+	return s.do(ctx, http.MethodPost, fmt.Sprintf("/api/v1/safes/%s/multisig-transactions/", safe.Hex()), proposal, nil)
+}
+
+func (s *safeTransactionService) transaction(ctx context.Context, safeTxHash string) (*safeTransaction, error) {
+	var tx safeTransaction
+	if err := s.do(ctx, http.MethodGet, fmt.Sprintf("/api/v1/multisig-transactions/%s/", safeTxHash), nil, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (s *safeTransactionService) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.auth != "" {
+		req.Header.Set("Authorization", "Bearer "+s.auth)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errSafeNotFound
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("safe transaction service answered with status %d: %s", resp.StatusCode, respBody)
+	}
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
@@ -0,0 +1,181 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	testSafeAddress = "0xFb1bffC9d739B8D520DaF37dF666da4C687191EA"
+	testSafeTxHash  = "0x3d2a8a1e5f2b8c0a0b36f4b8d71d2d1e6a0f5c7b9e2d4c6a8b0d2f4e6a8c0e2f"
+	testExecTxHash  = "0x9c2f5e3a1b7d4c6e8f0a2b4d6c8e0f1a3b5d7c9e1f3a5b7d9c1e3f5a7b9d1c3e"
+)
+
+func TestSafeTransactionHash(t *testing.T) {
+	// type hashes of the Safe contracts (DOMAIN_SEPARATOR_TYPEHASH and SAFE_TX_TYPEHASH)
+	assert.Equal(t, "0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218", hexutil.Encode(safeDomainSeparatorTypeHash))
+	assert.Equal(t, "0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8", hexutil.Encode(safeTxTypeHash))
+
+	hash := safeTransactionHash(
+		big.NewInt(80002),
+		ethCommon.HexToAddress(testSafeAddress),
+		ethCommon.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4"),
+		hexutil.MustDecode("0x28f88a65"),
+		7,
+	)
+	assert.Equal(t, "0xb46a3ac4fc89d23e8ae0f872f62918c2dc5f2db9375c947d2427a91b18f44db1", hexutil.Encode(hash))
+}
+
+func TestSafeTransactionService_NextNonce(t *testing.T) {
+	ctx := context.Background()
+	safe := ethCommon.HexToAddress(testSafeAddress)
+
+	for _, tc := range []struct {
+		name     string
+		queued   []safeTransaction
+		expected uint64
+	}{
+		{name: "no queued transactions", expected: 5},
+		{name: "queued transactions", queued: []safeTransaction{{Nonce: 6}}, expected: 7},
+		{name: "stale queued transaction", queued: []safeTransaction{{Nonce: 3}}, expected: 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				switch r.URL.Path {
+				case "/api/v1/safes/" + safe.Hex() + "/":
+					_ = json.NewEncoder(w).Encode(map[string]any{"nonce": 5})
+				case "/api/v1/safes/" + safe.Hex() + "/multisig-transactions/":
+					assert.Equal(t, "false", r.URL.Query().Get("executed"))
+					assert.Equal(t, "5", r.URL.Query().Get("nonce__gte"))
+					_ = json.NewEncoder(w).Encode(map[string]any{"results": tc.queued})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			api := newSafeTransactionService(config.Safe{TransactionServiceURL: srv.URL + "/", TransactionServiceAuth: "secret"})
+			nonce, err := api.nextNonce(ctx, safe)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, nonce)
+		})
+	}
+
+	t.Run("transaction service error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		_, err := newSafeTransactionService(config.Safe{TransactionServiceURL: srv.URL}).nextNonce(ctx, safe)
+		assert.Error(t, err)
+	})
+}
+
+type trackerMock struct {
+	checked      []string
+	confirmation *ports.TxConfirmation
+}
+
+func (m *trackerMock) Check(_ context.Context, txID string) (*ports.TxConfirmation, error) {
+	m.checked = append(m.checked, txID)
+	confirmation := *m.confirmation
+	return &confirmation, nil
+}
+
+func TestSafeConfirmationTracker_Check(t *testing.T) {
+	ctx := context.Background()
+	mined := &ports.TxConfirmation{Status: ports.TxConfirmationConfirmed, Receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful}, Confirmations: 12}
+	notCanonical := &ports.TxConfirmation{Status: ports.TxConfirmationPending}
+	successful, reverted := true, false
+	execTxHash := testExecTxHash
+
+	type expected struct {
+		status  ports.TxConfirmationStatus
+		checked []string
+	}
+	for _, tc := range []struct {
+		name      string
+		safeTx    *safeTransaction
+		safeNonce uint64
+		inner     *ports.TxConfirmation
+		expected  expected
+	}{
+		{
+			name:     "unknown by the safe",
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationConfirmed, checked: []string{testSafeTxHash}},
+		},
+		{
+			name:      "waiting for the owners",
+			safeTx:    &safeTransaction{Safe: testSafeAddress, Nonce: 4, ConfirmationsRequired: 2},
+			safeNonce: 4,
+			inner:     mined,
+			expected:  expected{status: ports.TxConfirmationPending},
+		},
+		{
+			name:      "replaced by another transaction",
+			safeTx:    &safeTransaction{Safe: testSafeAddress, Nonce: 4},
+			safeNonce: 5,
+			inner:     mined,
+			expected:  expected{status: ports.TxConfirmationDropped},
+		},
+		{
+			name:     "executed",
+			safeTx:   &safeTransaction{Safe: testSafeAddress, Nonce: 4, IsExecuted: true, IsSuccessful: &successful, TransactionHash: &execTxHash},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationConfirmed, checked: []string{testExecTxHash}},
+		},
+		{
+			name:     "executed but the state transition reverted",
+			safeTx:   &safeTransaction{Safe: testSafeAddress, Nonce: 4, IsExecuted: true, IsSuccessful: &reverted, TransactionHash: &execTxHash},
+			inner:    mined,
+			expected: expected{status: ports.TxConfirmationFailed, checked: []string{testExecTxHash}},
+		},
+		{
+			name:     "reverted execution not in the canonical chain",
+			safeTx:   &safeTransaction{Safe: testSafeAddress, Nonce: 4, IsExecuted: true, IsSuccessful: &reverted, TransactionHash: &execTxHash},
+			inner:    notCanonical,
+			expected: expected{status: ports.TxConfirmationPending, checked: []string{testExecTxHash}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/multisig-transactions/" + testSafeTxHash + "/":
+					if tc.safeTx == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tc.safeTx)
+				case "/api/v1/safes/" + ethCommon.HexToAddress(testSafeAddress).Hex() + "/":
+					_ = json.NewEncoder(w).Encode(map[string]any{"nonce": tc.safeNonce})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			inner := &trackerMock{confirmation: tc.inner}
+			tracker := NewSafeConfirmationTracker(config.Safe{TransactionServiceURL: srv.URL}, inner)
+			confirmation, err := tracker.Check(ctx, testSafeTxHash)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.status, confirmation.Status)
+			assert.Equal(t, tc.expected.checked, inner.checked)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+type revocationRuleWebhook struct {
+	client *client.Client
+}
+
+// NewRevocationRuleWebhook returns a revocation rule webhook that posts the runs as json
+func NewRevocationRuleWebhook(cli *client.Client) ports.RevocationRuleWebhook {
+	return &revocationRuleWebhook{client: cli}
+}
+
+func (w *revocationRuleWebhook) Notify(ctx context.Context, url string, run domain.RevocationRuleRun) error {
+	body, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	_, err = w.client.Post(ctx, url, body)
+	return err
+}
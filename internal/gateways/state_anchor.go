@@ -0,0 +1,101 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+// maxOpenTimestampsReceipt is the max size of the timestamp returned by an OpenTimestamps calendar
+const maxOpenTimestampsReceipt = 64 << 10
+
+type openTimestampsAnchor struct {
+	calendarURL string
+	client      *http.Client
+}
+
+// NewOpenTimestampsAnchor returns an anchor that submits the states to an OpenTimestamps calendar. The digest
+// submitted is the 32 bytes of the state.
+func NewOpenTimestampsAnchor(calendarURL string, timeout time.Duration) ports.StateAnchor {
+	return &openTimestampsAnchor{
+		calendarURL: strings.TrimSuffix(calendarURL, "/"),
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+func (a *openTimestampsAnchor) Type() string {
+	return domain.StateAnchorOpenTimestamps
+}
+
+func (a *openTimestampsAnchor) Anchor(ctx context.Context, state *merkletree.Hash) (string, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.calendarURL+"/digest", bytes.NewReader(state[:]))
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.opentimestamps.v1")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	receipt, err := io.ReadAll(io.LimitReader(resp.Body, maxOpenTimestampsReceipt))
+	if err != nil {
+		return "", nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("opentimestamps calendar answered with status %d: %s", resp.StatusCode, receipt)
+	}
+	return a.calendarURL, receipt, nil
+}
+
+type ethereumAnchor struct {
+	client *eth.Client
+	key    kms.KeyID
+}
+
+// NewEthereumAnchor returns an anchor that sends a transaction with the state as data from the account of the key to
+// itself, in the chain of the client
+func NewEthereumAnchor(client *eth.Client, key kms.KeyID) ports.StateAnchor {
+	return &ethereumAnchor{client: client, key: key}
+}
+
+func (a *ethereumAnchor) Type() string {
+	return domain.StateAnchorEthereum
+}
+
+func (a *ethereumAnchor) Anchor(ctx context.Context, state *merkletree.Hash) (string, []byte, error) {
+	opts, err := a.client.CreateTxOpts(ctx, a.key)
+	if err != nil {
+		return "", nil, err
+	}
+	tx, err := a.client.CreateRawTx(ctx, eth.TransactionParams{
+		FromAddress: opts.From,
+		ToAddress:   opts.From,
+		Payload:     state[:],
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	signed, err := opts.Signer(opts.From, tx)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := a.client.SendRawTx(ctx, signed); err != nil {
+		return "", nil, err
+	}
+	return signed.Hash().Hex(), nil, nil
+}
@@ -0,0 +1,115 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
+)
+
+type statusOracleRequest struct {
+	CredentialID     string `json:"credentialId"`
+	Issuer           string `json:"issuer"`
+	Subject          string `json:"subject"`
+	SchemaType       string `json:"schemaType"`
+	SchemaURL        string `json:"schemaUrl"`
+	RevocationNonce  uint64 `json:"revocationNonce"`
+	ExpirationStatus string `json:"expirationStatus"`
+}
+
+type statusOracleResponse struct {
+	Eligible *bool  `json:"eligible"`
+	Reason   string `json:"reason"`
+}
+
+// StatusOracle asks an external endpoint whether the subject of a credential is still eligible for it.
+// The endpoint receives a POST with the credential details and must answer {"eligible": bool, "reason": string}.
+// The answers are cached for each credential during the configured ttl.
+// The expiration status tells the endpoint whether the credential is valid, expired within the grace period or expired.
+type StatusOracle struct {
+	cfg                   config.StatusOracle
+	expirationGracePeriod time.Duration
+	client                *http.Client
+	answers               *invalidation.Local[*ports.StatusOracleResult]
+}
+
+// NewStatusOracle returns a status oracle client, or nil if no oracle is configured
+func NewStatusOracle(cfg config.StatusOracle, expirationGracePeriod time.Duration) ports.StatusOracle {
+	if !cfg.Enabled() {
+		return nil
+	}
+	return &StatusOracle{
+		cfg:                   cfg,
+		expirationGracePeriod: expirationGracePeriod,
+		client:                &http.Client{Timeout: cfg.Timeout},
+		answers:               invalidation.NewLocal[*ports.StatusOracleResult](cfg.CacheTTL),
+	}
+}
+
+// Check returns the eligibility of the credential subject
+func (o *StatusOracle) Check(ctx context.Context, credential *domain.Claim) (*ports.StatusOracleResult, error) {
+	key := credential.ID.String()
+	if result, err := o.answers.Get(key); err == nil {
+		return result, nil
+	}
+	result, err := o.check(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	o.answers.Set(key, result)
+	return result, nil
+}
+
+func (o *StatusOracle) check(ctx context.Context, credential *domain.Claim) (*ports.StatusOracleResult, error) {
+	body, err := json.Marshal(statusOracleRequest{
+		CredentialID:     credential.ID.String(),
+		Issuer:           credential.Issuer,
+		Subject:          credential.OtherIdentifier,
+		SchemaType:       credential.SchemaType,
+		SchemaURL:        credential.SchemaURL,
+		RevocationNonce:  uint64(credential.RevNonce),
+		ExpirationStatus: credential.ExpirationStatus(time.Now(), o.expirationGracePeriod),
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.cfg.APIKey)
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status oracle answered with status %d: %s", resp.StatusCode, respBody)
+	}
+
+	var oracleResp statusOracleResponse
+	if err := json.Unmarshal(respBody, &oracleResp); err != nil {
+		return nil, fmt.Errorf("invalid status oracle response: %w", err)
+	}
+	if oracleResp.Eligible == nil {
+		return nil, fmt.Errorf("status oracle response does not include the eligibility")
+	}
+	return &ports.StatusOracleResult{Eligible: *oracleResp.Eligible, Reason: oracleResp.Reason}, nil
+}
@@ -0,0 +1,133 @@
+package gateways
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	rstypes "github.com/iden3/go-rapidsnark/types"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// errSyntheticTxNotFound the transaction was not sent to this synthetic chain, i.e. before a restart
+var errSyntheticTxNotFound = errors.New("transaction not found in the synthetic chain")
+
+// SyntheticChain simulates the publishing of the states for the synthetic issuance mode. Every state transition is
+// mined right away in a new block, without proof, gas or network calls, so the publisher confirms the states and the
+// credentials get their MTP proofs as usual. The transactions are kept in memory.
+type SyntheticChain struct {
+	mu           sync.RWMutex
+	blocks       map[string]*big.Int  // block of each transaction
+	blockTimes   map[uint64]time.Time // time of each block
+	currentBlock *big.Int
+}
+
+// NewSyntheticChain returns an empty synthetic chain. It implements the publisher gateway, the transaction service,
+// the confirmation tracker and the zk proof generator used by the publisher.
+func NewSyntheticChain() *SyntheticChain {
+	return &SyntheticChain{
+		blocks:       make(map[string]*big.Int),
+		blockTimes:   make(map[uint64]time.Time),
+		currentBlock: big.NewInt(0),
+	}
+}
+
+// PublishState mines the state transition in a new block and returns its random transaction hash
+func (c *SyntheticChain) PublishState(_ context.Context, _ *w3c.DID, latestState, newState *merkletree.Hash, _ bool, _ *rstypes.ProofData, _ *domain.Identity) (*string, error) {
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+	var hash ethCommon.Hash
+	if _, err := rand.Read(hash[:]); err != nil {
+		return nil, err
+	}
+	txID := hash.Hex()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.currentBlock = new(big.Int).Add(c.currentBlock, big.NewInt(1))
+	c.blocks[txID] = c.currentBlock
+	c.blockTimes[c.currentBlock.Uint64()] = time.Now()
+	return &txID, nil
+}
+
+// Generate returns an empty proof, the synthetic chain does not verify them
+func (c *SyntheticChain) Generate(_ context.Context, _ json.RawMessage, _ string) (*rstypes.ZKProof, error) {
+	return &rstypes.ZKProof{Proof: &rstypes.ProofData{}, PubSignals: []string{}}, nil
+}
+
+// WaitForTransactionReceipt returns the receipt of the transaction, that is always mined
+func (c *SyntheticChain) WaitForTransactionReceipt(_ context.Context, txID string) (*types.Receipt, error) {
+	return c.receipt(txID)
+}
+
+// GetTransactionReceiptByID returns the receipt of the transaction
+func (c *SyntheticChain) GetTransactionReceiptByID(_ context.Context, txID string) (*types.Receipt, error) {
+	return c.receipt(txID)
+}
+
+// WaitForConfirmation returns true, the transactions are confirmed when they are mined
+func (c *SyntheticChain) WaitForConfirmation(_ context.Context, _ *types.Receipt) (bool, error) {
+	return true, nil
+}
+
+// CheckConfirmation returns true, the transactions are confirmed when they are mined
+func (c *SyntheticChain) CheckConfirmation(_ context.Context, _ *types.Receipt) (bool, error) {
+	return true, nil
+}
+
+// GetHeaderByNumber returns the header of the block, with the time it was mined
+func (c *SyntheticChain) GetHeaderByNumber(_ context.Context, blockNumber *big.Int) (*types.Header, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	minedAt, ok := c.blockTimes[blockNumber.Uint64()]
+	if !ok {
+		return nil, errors.New("block not found in the synthetic chain")
+	}
+	return &types.Header{Number: new(big.Int).Set(blockNumber), Time: uint64(minedAt.Unix())}, nil
+}
+
+// GetCurrentBlock returns the number of the last mined block
+func (c *SyntheticChain) GetCurrentBlock(_ context.Context) (*big.Int, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return new(big.Int).Set(c.currentBlock), nil
+}
+
+// Check returns the confirmation of the transaction. The ones this chain doesn't know are reported as dropped, so the
+// publisher sends them again.
+func (c *SyntheticChain) Check(_ context.Context, txID string) (*ports.TxConfirmation, error) {
+	receipt, err := c.receipt(txID)
+	if errors.Is(err, errSyntheticTxNotFound) {
+		return &ports.TxConfirmation{Status: ports.TxConfirmationDropped}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ports.TxConfirmation{Status: ports.TxConfirmationConfirmed, Receipt: receipt, Confirmations: 1}, nil
+}
+
+func (c *SyntheticChain) receipt(txID string) (*types.Receipt, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	block, ok := c.blocks[txID]
+	if !ok {
+		return nil, errSyntheticTxNotFound
+	}
+	return &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      ethCommon.HexToHash(txID),
+		BlockNumber: new(big.Int).Set(block),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 // ETHClient defines interface for ethereum client
 type ETHClient interface {
 	GetTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error)
+	GetTransactionByID(ctx context.Context, txID string) (*types.Transaction, bool, error)
 	WaitTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error)
 	CurrentBlock(ctx context.Context) (*big.Int, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
@@ -73,6 +74,11 @@ func (tr *transaction) GetTransactionReceiptByID(ctx context.Context, txID strin
 	return receipt, nil
 }
 
+// GetCurrentBlock returns the number of the latest block
+func (tr *transaction) GetCurrentBlock(ctx context.Context) (*big.Int, error) {
+	return tr.client.CurrentBlock(ctx)
+}
+
 // WaitForConfirmation wait until transaction will be confirmed
 func (tr *transaction) WaitForConfirmation(ctx context.Context, receipt *types.Receipt) (bool, error) {
 	confirmationBlock := big.NewInt(tr.confirmationBlockCount)
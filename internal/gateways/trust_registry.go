@@ -0,0 +1,151 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// NewTrustRegistry returns the gateway of the configured trust registry, or nil if there is none
+func NewTrustRegistry(cfg config.TrustRegistry) ports.TrustRegistry {
+	client := &http.Client{Timeout: cfg.Timeout}
+	switch cfg.Type {
+	case config.TrustRegistrySimple:
+		return &simpleTrustRegistry{url: cfg.URL, client: client}
+	case config.TrustRegistryEBSI:
+		return &ebsiTrustRegistry{url: strings.TrimSuffix(cfg.URL, "/"), client: client}
+	case config.TrustRegistryTRAIN:
+		return &trainTrustRegistry{url: cfg.URL, trustFrameworkPointer: cfg.TrustFrameworkPointer, client: client}
+	default:
+		return nil
+	}
+}
+
+type simpleTrustRegistryList struct {
+	Entries []struct {
+		DID  string `json:"did"`
+		Name string `json:"name"`
+	} `json:"entries"`
+}
+
+// simpleTrustRegistry is a json document published at an url with the trusted DIDs:
+// {"entries": [{"did": "did:...", "name": "..."}]}
+type simpleTrustRegistry struct {
+	url    string
+	client *http.Client
+}
+
+// Lookup looks for the DID in the list of the registry
+func (r *simpleTrustRegistry) Lookup(ctx context.Context, did string) (*ports.TrustRegistryEntry, error) {
+	var list simpleTrustRegistryList
+	if _, err := trustRegistryRequest(ctx, r.client, http.MethodGet, r.url, nil, &list); err != nil {
+		return nil, err
+	}
+	for _, entry := range list.Entries {
+		if entry.DID == did {
+			return &ports.TrustRegistryEntry{Name: entry.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// ebsiTrustRegistry is the trusted issuers registry api of EBSI, that answers 404 for the DIDs not registered
+type ebsiTrustRegistry struct {
+	url    string
+	client *http.Client
+}
+
+// Lookup gets the DID from the trusted issuers registry
+func (r *ebsiTrustRegistry) Lookup(ctx context.Context, did string) (*ports.TrustRegistryEntry, error) {
+	found, err := trustRegistryRequest(ctx, r.client, http.MethodGet, r.url+"/issuers/"+url.PathEscape(did), nil, nil)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &ports.TrustRegistryEntry{}, nil
+}
+
+type trainTrustRegistryRequest struct {
+	Issuer                string `json:"issuer"`
+	TrustFrameworkPointer string `json:"trustFrameworkPointer"`
+}
+
+type trainTrustRegistryResponse struct {
+	Trusted *bool  `json:"trusted"`
+	Name    string `json:"name"`
+}
+
+// trainTrustRegistry is a TRAIN trust validator. It receives a POST with the DID and the trust framework pointer and
+// must answer {"trusted": bool, "name": string}.
+type trainTrustRegistry struct {
+	url                   string
+	trustFrameworkPointer string
+	client                *http.Client
+}
+
+// Lookup asks the trust validator whether the DID is in the trust framework
+func (r *trainTrustRegistry) Lookup(ctx context.Context, did string) (*ports.TrustRegistryEntry, error) {
+	var resp trainTrustRegistryResponse
+	req := trainTrustRegistryRequest{Issuer: did, TrustFrameworkPointer: r.trustFrameworkPointer}
+	if _, err := trustRegistryRequest(ctx, r.client, http.MethodPost, r.url, req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Trusted == nil {
+		return nil, fmt.Errorf("trust validator response does not include the trust status")
+	}
+	if !*resp.Trusted {
+		return nil, nil
+	}
+	return &ports.TrustRegistryEntry{Name: resp.Name}, nil
+}
+
+// trustRegistryRequest sends the request to the registry and decodes the response in out, if not nil. It returns
+// false if the registry answered 404.
+func trustRegistryRequest(ctx context.Context, client *http.Client, method string, url string, in any, out any) (bool, error) {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("trust registry answered with status %d: %s", resp.StatusCode, respBody)
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return false, fmt.Errorf("invalid trust registry response: %w", err)
+		}
+	}
+	return true, nil
+}
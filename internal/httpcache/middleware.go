@@ -0,0 +1,147 @@
+// Package httpcache sets the cache headers of the public documents and statuses and answers their conditional requests.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+// Middleware applies the documents cache configuration to the documentRoutes and the statuses one to the
+// statusRoutes. Their successful responses get an ETag, and a Last-Modified header if the handler sets it, and the
+// conditional requests are answered with 304 Not Modified. The rest of the routes are not cacheable.
+// It must run before routing takes place, and after the /v2 paths have been rewritten.
+func Middleware(cfg config.HTTPCache, documentRoutes, statusRoutes []httpsecurity.Route) func(http.Handler) http.Handler {
+	isDocument := matcher(documentRoutes)
+	isStatus := matcher(statusRoutes)
+
+	return func(next http.Handler) http.Handler {
+		noCache := middleware.NoCache(next)
+		documents := cacheable(cfg.Documents, next)
+		statuses := cacheable(cfg.Statuses, next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method != http.MethodGet && r.Method != http.MethodHead:
+				noCache.ServeHTTP(w, r)
+			case isDocument(r):
+				documents.ServeHTTP(w, r)
+			case isStatus(r):
+				statuses.ServeHTTP(w, r)
+			default:
+				noCache.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+func cacheable(cfg config.EndpointCache, next http.Handler) http.Handler {
+	cacheControl := "public, max-age=" + strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	if cfg.MaxAge == 0 {
+		cacheControl += ", must-revalidate"
+	}
+	if cfg.SharedMaxAge > 0 {
+		cacheControl += ", s-maxage=" + strconv.Itoa(int(cfg.SharedMaxAge.Seconds()))
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &recorder{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.status != http.StatusOK {
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+
+		etag := w.Header().Get("ETag")
+		if etag == "" {
+			sum := sha256.Sum256(rec.body.Bytes())
+			etag = `"` + hex.EncodeToString(sum[:16]) + `"`
+			w.Header().Set("ETag", etag)
+		}
+		w.Header().Set("Cache-Control", cacheControl)
+
+		if notModified(r, etag, w.Header().Get("Last-Modified")) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(rec.body.Bytes())
+		}
+	})
+}
+
+// notModified evaluates the conditional headers of the request. If-None-Match takes precedence over
+// If-Modified-Since, as RFC 9110 requires.
+func notModified(r *http.Request, etag, lastModified string) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+	ims := r.Header.Get("If-Modified-Since")
+	if ims == "" || lastModified == "" {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// recorder buffers the response so its ETag can be computed before the headers are sent
+type recorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status, r.wroteHeader = status, true
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(b)
+}
+
+func matcher(routes []httpsecurity.Route) func(r *http.Request) bool {
+	router := chi.NewRouter()
+	for _, route := range routes {
+		router.MethodFunc(route.Method, route.Pattern, func(http.ResponseWriter, *http.Request) {})
+	}
+	return func(r *http.Request) bool {
+		return router.Match(chi.NewRouteContext(), http.MethodGet, r.URL.Path)
+	}
+}
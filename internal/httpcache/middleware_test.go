@@ -0,0 +1,100 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+func TestMiddleware(t *testing.T) {
+	cfg := config.HTTPCache{
+		Documents: config.EndpointCache{MaxAge: 10 * time.Minute, SharedMaxAge: time.Hour},
+	}
+	status := "active"
+	lastModified := time.Date(2024, 4, 6, 10, 0, 0, 0, time.UTC)
+
+	mux := chi.NewRouter()
+	mux.Use(Middleware(cfg,
+		[]httpsecurity.Route{{Method: http.MethodGet, Pattern: "/v1/qr-store"}},
+		[]httpsecurity.Route{{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}"}}))
+	mux.Get("/v1/qr-store", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") == "" {
+			http.Error(w, "id is required", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"body":"qr"}`))
+	})
+	mux.Get("/v1/credentials/revocation/status/{nonce}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		_, _ = w.Write([]byte(status))
+	})
+	mux.Get("/v1/credentials", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("[]"))
+	})
+
+	get := func(url string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("documents", func(t *testing.T) {
+		rr := get("/v1/qr-store?id=1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `{"body":"qr"}`, rr.Body.String())
+		assert.Equal(t, "public, max-age=600, s-maxage=3600", rr.Header().Get("Cache-Control"))
+		etag := rr.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		rr = get("/v1/qr-store?id=1", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.String())
+		assert.Equal(t, etag, rr.Header().Get("ETag"))
+
+		rr = get("/v1/qr-store?id=1", map[string]string{"If-None-Match": `"other"`})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		rr := get("/v1/qr-store", nil)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+		assert.Empty(t, rr.Header().Get("ETag"))
+	})
+
+	t.Run("statuses", func(t *testing.T) {
+		rr := get("/v1/credentials/revocation/status/1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "public, max-age=0, must-revalidate", rr.Header().Get("Cache-Control"))
+		etag := rr.Header().Get("ETag")
+
+		rr = get("/v1/credentials/revocation/status/1", map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)})
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+
+		status = "revoked"
+		rr = get("/v1/credentials/revocation/status/1", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "revoked", rr.Body.String())
+		assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+	})
+
+	t.Run("not cacheable", func(t *testing.T) {
+		rr := get("/v1/credentials", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Cache-Control"), "no-cache")
+		assert.Empty(t, rr.Header().Get("ETag"))
+	})
+}
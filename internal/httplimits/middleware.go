@@ -0,0 +1,78 @@
+// Package httplimits limits the size of the request bodies and the time to handle the requests, and logs the slow ones.
+package httplimits
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// Middleware applies the messages limits to the messageRoutes and the default ones to the rest. The streamingRoutes,
+// like the server sent events, keep the body size limit but have no timeout and are never logged as slow.
+// It must run before routing takes place, and after the /v2 paths have been rewritten.
+func Middleware(ctx context.Context, cfg config.HTTPLimits, messageRoutes, streamingRoutes []httpsecurity.Route) func(http.Handler) http.Handler {
+	isMessage := matcher(messageRoutes)
+	isStreaming := matcher(streamingRoutes)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limits := cfg.Default
+			if isMessage(r) {
+				limits = cfg.Messages
+			}
+			if limits.MaxBodySize > 0 {
+				if r.ContentLength > limits.MaxBodySize {
+					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+				r.Body = http.MaxBytesReader(w, r.Body, limits.MaxBodySize)
+			}
+			if isStreaming(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reqCtx := r.Context()
+			if limits.Timeout > 0 {
+				var cancel context.CancelFunc
+				reqCtx, cancel = context.WithTimeout(reqCtx, limits.Timeout)
+				defer cancel()
+			}
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+			next.ServeHTTP(ww, r.WithContext(reqCtx))
+			d := time.Since(start)
+
+			if errors.Is(reqCtx.Err(), context.DeadlineExceeded) && ww.Status() == 0 {
+				http.Error(ww, "request timeout", http.StatusGatewayTimeout)
+			}
+			//nolint:contextcheck
+			if cfg.SlowRequest > 0 && d >= cfg.SlowRequest {
+				log.Warn(ctx, "slow http request",
+					"req-id", middleware.GetReqID(r.Context()),
+					"method", r.Method,
+					"path", r.URL.Path,
+					"status", ww.Status(),
+					"d", d)
+			}
+		})
+	}
+}
+
+func matcher(routes []httpsecurity.Route) func(r *http.Request) bool {
+	router := chi.NewRouter()
+	for _, route := range routes {
+		router.MethodFunc(route.Method, route.Pattern, func(http.ResponseWriter, *http.Request) {})
+	}
+	return func(r *http.Request) bool {
+		return router.Match(chi.NewRouteContext(), r.Method, r.URL.Path)
+	}
+}
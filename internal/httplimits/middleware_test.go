@@ -0,0 +1,72 @@
+package httplimits
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+func TestMiddleware(t *testing.T) {
+	cfg := config.HTTPLimits{
+		Default:     config.EndpointLimits{MaxBodySize: 16, Timeout: 50 * time.Millisecond},
+		Messages:    config.EndpointLimits{MaxBodySize: 8, Timeout: time.Second},
+		SlowRequest: time.Second,
+	}
+	readBody := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		}
+	}
+	waitContext := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	mux := chi.NewRouter()
+	mux.Use(Middleware(context.Background(), cfg,
+		[]httpsecurity.Route{{Method: http.MethodPost, Pattern: "/v1/agent"}},
+		[]httpsecurity.Route{{Method: http.MethodGet, Pattern: "/v1/sessions/{id}/events"}}))
+	mux.Post("/v1/agent", readBody)
+	mux.Post("/v1/credentials", readBody)
+	mux.Get("/v1/credentials", waitContext)
+	mux.Get("/v1/sessions/{id}/events", waitContext)
+
+	for _, tc := range []struct {
+		name     string
+		method   string
+		url      string
+		body     string
+		chunked  bool
+		expected int
+	}{
+		{name: "default body within the limit", method: http.MethodPost, url: "/v1/credentials", body: "0123456789", expected: http.StatusOK},
+		{name: "message body over the message limit", method: http.MethodPost, url: "/v1/agent", body: "0123456789", expected: http.StatusRequestEntityTooLarge},
+		{name: "chunked message body over the limit", method: http.MethodPost, url: "/v1/agent", body: "0123456789", chunked: true, expected: http.StatusRequestEntityTooLarge},
+		{name: "default body over the limit", method: http.MethodPost, url: "/v1/credentials", body: strings.Repeat("0", 20), expected: http.StatusRequestEntityTooLarge},
+		{name: "handler timeout", method: http.MethodGet, url: "/v1/credentials", expected: http.StatusGatewayTimeout},
+		{name: "streaming endpoints have no timeout", method: http.MethodGet, url: "/v1/sessions/1/events", expected: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.url, strings.NewReader(tc.body))
+			if tc.chunked {
+				req.ContentLength = -1
+			}
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+			assert.Equal(t, tc.expected, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package httpsecurity applies the CORS policy and the security headers configured for each group of endpoints.
+package httpsecurity
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/cors"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+// Route identifies an endpoint by its method and chi path pattern
+type Route struct {
+	Method  string
+	Pattern string
+}
+
+var allowedMethods = []string{
+	http.MethodHead, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+}
+
+// Middleware applies the public configuration to the given routes and the admin configuration to the rest.
+// It must run before routing takes place, and after the /v2 paths have been rewritten.
+func Middleware(cfg config.HTTPSecurity, publicRoutes []Route) func(http.Handler) http.Handler {
+	public := chi.NewRouter()
+	for _, route := range publicRoutes {
+		public.MethodFunc(route.Method, route.Pattern, func(http.ResponseWriter, *http.Request) {})
+	}
+	isPublic := func(r *http.Request) bool {
+		method := r.Method
+		if preflight := r.Header.Get("Access-Control-Request-Method"); r.Method == http.MethodOptions && preflight != "" {
+			method = preflight
+		}
+		return public.Match(chi.NewRouteContext(), method, r.URL.Path)
+	}
+
+	return func(next http.Handler) http.Handler {
+		adminHandler := endpointHandler(cfg.Admin, next)
+		publicHandler := endpointHandler(cfg.Public, next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isPublic(r) {
+				publicHandler.ServeHTTP(w, r)
+				return
+			}
+			adminHandler.ServeHTTP(w, r)
+		})
+	}
+}
+
+func endpointHandler(cfg config.EndpointSecurity, next http.Handler) http.Handler {
+	withCORS := cors.Handler(cors.Options{
+		AllowedOrigins: cfg.AllowedOrigins,
+		AllowedHeaders: cfg.AllowedHeaders,
+		AllowedMethods: allowedMethods,
+	})(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cfg.ContentSecurityPolicy != "" {
+			w.Header().Set("Content-Security-Policy", cfg.ContentSecurityPolicy)
+		}
+		if cfg.HSTSMaxAge > 0 {
+			w.Header().Set("Strict-Transport-Security", "max-age="+strconv.Itoa(int(cfg.HSTSMaxAge.Seconds())))
+		}
+		withCORS.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,101 @@
+package httpsecurity
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestMiddleware(t *testing.T) {
+	cfg := config.HTTPSecurity{
+		Admin: config.EndpointSecurity{
+			AllowedOrigins:        []string{"https://admin.example.com"},
+			AllowedHeaders:        []string{"Authorization", "Content-Type"},
+			ContentSecurityPolicy: "frame-ancestors 'none'",
+			HSTSMaxAge:            365 * 24 * time.Hour,
+		},
+		Public: config.EndpointSecurity{
+			AllowedOrigins:        []string{"*"},
+			AllowedHeaders:        []string{"*"},
+			ContentSecurityPolicy: "frame-ancestors https://shop.example.com",
+		},
+	}
+	mux := chi.NewRouter()
+	mux.Use(Middleware(cfg, []Route{{Method: http.MethodGet, Pattern: "/v1/qr-store"}}))
+	mux.Get("/v1/qr-store", func(w http.ResponseWriter, r *http.Request) {})
+	mux.Get("/v1/credentials", func(w http.ResponseWriter, r *http.Request) {})
+
+	type expected struct {
+		allowOrigin string
+		csp         string
+		hsts        string
+	}
+	for _, tc := range []struct {
+		name      string
+		method    string
+		url       string
+		origin    string
+		preflight string
+		expected  expected
+	}{
+		{
+			name:     "public endpoint from any origin",
+			method:   http.MethodGet,
+			url:      "/v1/qr-store",
+			origin:   "https://shop.example.com",
+			expected: expected{allowOrigin: "*", csp: "frame-ancestors https://shop.example.com"},
+		},
+		{
+			name:      "public endpoint preflight",
+			method:    http.MethodOptions,
+			url:       "/v1/qr-store",
+			origin:    "https://shop.example.com",
+			preflight: http.MethodGet,
+			expected:  expected{allowOrigin: "*", csp: "frame-ancestors https://shop.example.com"},
+		},
+		{
+			name:     "admin endpoint from an allowed origin",
+			method:   http.MethodGet,
+			url:      "/v1/credentials",
+			origin:   "https://admin.example.com",
+			expected: expected{allowOrigin: "https://admin.example.com", csp: "frame-ancestors 'none'", hsts: "max-age=31536000"},
+		},
+		{
+			name:     "admin endpoint from another origin",
+			method:   http.MethodGet,
+			url:      "/v1/credentials",
+			origin:   "https://shop.example.com",
+			expected: expected{csp: "frame-ancestors 'none'", hsts: "max-age=31536000"},
+		},
+		{
+			name:      "admin endpoint preflight from another origin",
+			method:    http.MethodOptions,
+			url:       "/v1/credentials",
+			origin:    "https://shop.example.com",
+			preflight: http.MethodGet,
+			expected:  expected{csp: "frame-ancestors 'none'", hsts: "max-age=31536000"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.url, nil)
+			req.Header.Set("Origin", tc.origin)
+			if tc.preflight != "" {
+				req.Header.Set("Access-Control-Request-Method", tc.preflight)
+			}
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			require.Less(t, rr.Code, http.StatusBadRequest)
+			assert.Equal(t, tc.expected.allowOrigin, rr.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tc.expected.csp, rr.Header().Get("Content-Security-Policy"))
+			assert.Equal(t, tc.expected.hsts, rr.Header().Get("Strict-Transport-Security"))
+		})
+	}
+}
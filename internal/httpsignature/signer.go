@@ -0,0 +1,195 @@
+// Package httpsignature signs the responses of the public endpoints with a detached JWS, so the relying parties can
+// cache and redistribute them and still check they were served by the issuer node.
+package httpsignature
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+// Header is the response header with the detached JWS of the body, <protected header>..<signature>, as described in
+// RFC 7515 appendix F
+const Header = "X-JWS-Signature"
+
+// JWKSPath is the path of the JSON Web Key Set with the public key of the signatures
+const JWKSPath = "/.well-known/jwks.json"
+
+// Signer signs the responses with an ECDSA P-256 key (ES256)
+type Signer struct {
+	key *ecdsa.PrivateKey
+	kid string
+	now func() time.Time
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+}
+
+// NewSigner loads the PEM encoded P-256 private key of the file, in SEC 1 or PKCS #8 form. It returns nil if keyPath is
+// empty, and a nil signer doesn't sign anything.
+func NewSigner(keyPath string) (*Signer, error) {
+	if keyPath == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading the response signing key: %w", err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("the response signing key is not PEM encoded")
+	}
+	var key *ecdsa.PrivateKey
+	if key, err = x509.ParseECPrivateKey(block.Bytes); err != nil {
+		parsed, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if pkcs8Err != nil {
+			return nil, fmt.Errorf("parsing the response signing key: %w", err)
+		}
+		var ok bool
+		if key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+			return nil, errors.New("the response signing key is not an ECDSA key")
+		}
+	}
+	if key.Curve != elliptic.P256() {
+		return nil, errors.New("the response signing key is not a P-256 key")
+	}
+
+	s := &Signer{key: key, now: time.Now}
+	// RFC 7638 thumbprint of the public key
+	thumbprint, err := json.Marshal(s.publicJWK(false))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(thumbprint)
+	s.kid = base64.RawURLEncoding.EncodeToString(sum[:])
+	return s, nil
+}
+
+func (s *Signer) publicJWK(full bool) jwk {
+	size := (s.key.Curve.Params().BitSize + 7) / 8
+	k := jwk{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(s.key.X.FillBytes(make([]byte, size))),
+		Y:   base64.RawURLEncoding.EncodeToString(s.key.Y.FillBytes(make([]byte, size))),
+	}
+	if full {
+		k.Kid, k.Use, k.Alg = s.kid, "sig", "ES256"
+	}
+	return k
+}
+
+// Sign returns the detached JWS of the payload
+func (s *Signer) Sign(payload []byte, contentType string) (string, error) {
+	protected, err := json.Marshal(map[string]any{"alg": "ES256", "kid": s.kid, "cty": contentType, "iat": s.now().Unix()})
+	if err != nil {
+		return "", err
+	}
+	encodedProtected := base64.RawURLEncoding.EncodeToString(protected)
+	digest := sha256.Sum256([]byte(encodedProtected + "." + base64.RawURLEncoding.EncodeToString(payload)))
+	r, sig, err := ecdsa.Sign(rand.Reader, s.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	signature := append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+	return encodedProtected + ".." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the detached JWS of the payload was made with the key
+func Verify(publicKey *ecdsa.PublicKey, payload []byte, detached string) bool {
+	parts := bytes.Split([]byte(detached), []byte("."))
+	if len(parts) != 3 || len(parts[1]) != 0 {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(string(parts[2]))
+	if err != nil || len(signature) != 64 {
+		return false
+	}
+	digest := sha256.Sum256([]byte(string(parts[0]) + "." + base64.RawURLEncoding.EncodeToString(payload)))
+	return ecdsa.Verify(publicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:]))
+}
+
+// JWKSHandler publishes the public key of the signatures as a JSON Web Key Set
+func (s *Signer) JWKSHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	_ = json.NewEncoder(w).Encode(map[string][]jwk{"keys": {s.publicJWK(true)}})
+}
+
+// Middleware signs the successful responses of the routes, and of their /v2 version. A nil signer returns the handler
+// as it is. It must run before routing takes place, and before apiversion.Middleware, so the /v2 responses are signed
+// once they are wrapped in the envelope.
+func (s *Signer) Middleware(routes []httpsecurity.Route) func(http.Handler) http.Handler {
+	if s == nil {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	router := chi.NewRouter()
+	for _, route := range routes {
+		router.MethodFunc(route.Method, route.Pattern, func(http.ResponseWriter, *http.Request) {})
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !router.Match(chi.NewRouteContext(), r.Method, apiversion.V1Path(r.URL.Path)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rec := &recorder{header: w.Header(), status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if rec.status == http.StatusOK {
+				if detached, err := s.Sign(rec.body.Bytes(), w.Header().Get("Content-Type")); err == nil {
+					w.Header().Set(Header, detached)
+				}
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// recorder buffers the response so it can be signed before the headers are sent
+type recorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status, r.wroteHeader = status, true
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(b)
+}
@@ -0,0 +1,112 @@
+package httpsignature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
+	"github.com/polygonid/sh-id-platform/internal/httpsecurity"
+)
+
+func TestSigner(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "signing.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	signer, err := NewSigner(keyPath)
+	require.NoError(t, err)
+	none, err := NewSigner("")
+	require.NoError(t, err)
+	assert.Nil(t, none)
+
+	routes := []httpsecurity.Route{{Method: http.MethodGet, Pattern: "/v1/credentials/revocation/status/{nonce}"}}
+	status := func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "nonce") == "0" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issuer":{},"mtp":{"existence":false}}`))
+	}
+
+	mux := chi.NewRouter()
+	mux.Use(signer.Middleware(routes), apiversion.Middleware)
+	mux.Get("/v1/credentials/revocation/status/{nonce}", status)
+	mux.Get("/v1/credentials", status)
+	mux.Get(JWKSPath, signer.JWKSHandler)
+
+	get := func(url string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		return rr
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		rr := get("/v1/credentials/revocation/status/1")
+		require.Equal(t, http.StatusOK, rr.Code)
+		detached := rr.Header().Get(Header)
+		require.NotEmpty(t, detached)
+		assert.True(t, Verify(&key.PublicKey, rr.Body.Bytes(), detached))
+		assert.False(t, Verify(&key.PublicKey, []byte(`{"issuer":{},"mtp":{"existence":true}}`), detached))
+	})
+
+	t.Run("signed v2 envelope", func(t *testing.T) {
+		rr := get("/v2/credentials/revocation/status/1")
+		require.Equal(t, http.StatusOK, rr.Code)
+		detached := rr.Header().Get(Header)
+		require.NotEmpty(t, detached)
+		var envelope apiversion.Envelope
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		assert.Equal(t, "2", envelope.Meta.APIVersion)
+		assert.True(t, Verify(&key.PublicKey, rr.Body.Bytes(), detached))
+		assert.False(t, Verify(&key.PublicKey, []byte(`{"issuer":{},"mtp":{"existence":false}}`), detached))
+	})
+
+	t.Run("errors and other routes are not signed", func(t *testing.T) {
+		rr := get("/v1/credentials/revocation/status/0")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Empty(t, rr.Header().Get(Header))
+		rr = get("/v1/credentials")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get(Header))
+		rr = get("/v2/credentials/revocation/status/0")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Empty(t, rr.Header().Get(Header))
+	})
+
+	t.Run("published key", func(t *testing.T) {
+		rr := get(JWKSPath)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var jwks struct {
+			Keys []jwk `json:"keys"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &jwks))
+		require.Len(t, jwks.Keys, 1)
+		assert.Equal(t, "EC", jwks.Keys[0].Kty)
+		assert.Equal(t, "ES256", jwks.Keys[0].Alg)
+		assert.Equal(t, signer.kid, jwks.Keys[0].Kid)
+	})
+
+	t.Run("nil signer", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		none.Middleware(routes)(http.HandlerFunc(status)).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/credentials/revocation/status/1", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get(Header))
+	})
+}
@@ -0,0 +1,229 @@
+// Package invalidation propagates cache invalidations between the replicas of the issuer node.
+//
+// Every replica keeps some caches in memory (json-ld documents, revocation statuses). When one replica changes
+// the data behind those caches it sends a notification through a postgres channel and every replica, itself
+// included, drops the affected entries. Notify is meant to be called inside the transaction that changes the
+// data, so postgres delivers the notification only when, and if, that transaction commits.
+package invalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// Channel is the postgres channel used to send the invalidations
+	Channel = "issuer_cache_invalidation"
+
+	reconnectDelay = 5 * time.Second
+)
+
+// Cache is a local cache that can be invalidated remotely
+type Cache interface {
+	// Invalidate removes the given keys and all the keys starting with any of the prefixes
+	Invalidate(keys []string, prefixes []string)
+	// Purge removes all the entries
+	Purge()
+}
+
+// Message is the payload of an invalidation notification
+type Message struct {
+	Cache    string   `json:"cache"`
+	Keys     []string `json:"keys,omitempty"`
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// Notify sends the invalidation message to all the replicas. When conn is a transaction the message
+// is delivered after the commit and discarded on rollback.
+func Notify(ctx context.Context, conn db.Querier, msg Message) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, `SELECT pg_notify($1, $2)`, Channel, string(payload))
+	return err
+}
+
+// Listener receives the invalidation notifications and applies them to the registered caches
+type Listener struct {
+	storage *db.Storage
+	mu      sync.RWMutex
+	caches  map[string]Cache
+}
+
+// NewListener creates a Listener that uses a dedicated connection from the storage pool
+func NewListener(storage *db.Storage) *Listener {
+	return &Listener{storage: storage, caches: make(map[string]Cache)}
+}
+
+// Register adds a cache that will be invalidated by the messages sent with its name
+func (l *Listener) Register(name string, cache Cache) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.caches[name] = cache
+}
+
+// Dispatch applies a message to the registered cache. Messages for unknown caches are ignored.
+func (l *Listener) Dispatch(msg Message) {
+	l.mu.RLock()
+	cache, ok := l.caches[msg.Cache]
+	l.mu.RUnlock()
+	if ok {
+		cache.Invalidate(msg.Keys, msg.Prefixes)
+	}
+}
+
+// Purge empties all the registered caches
+func (l *Listener) Purge() {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, cache := range l.caches {
+		cache.Purge()
+	}
+}
+
+// Run listens for notifications until ctx is done. Notifications sent while the listener was disconnected
+// are lost, so all the caches are purged every time it (re)connects.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error(ctx, "listening cache invalidations. Reconnecting", "err", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := l.storage.Pgx.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return err
+	}
+	l.Purge()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var msg Message
+		if err := json.Unmarshal([]byte(notification.Payload), &msg); err != nil {
+			log.Warn(ctx, "invalid cache invalidation message", "err", err, "payload", notification.Payload)
+			continue
+		}
+		l.Dispatch(msg)
+	}
+}
+
+// Local is an in memory cache with expiration that can be invalidated remotely
+type Local[V any] struct {
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]localEntry[V]
+	sweptAt time.Time
+}
+
+type localEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ErrNotFound is returned by Local.Get when the key is not cached or it has expired
+var ErrNotFound = errors.New("not found in cache")
+
+// NewLocal creates a Local cache whose entries expire after ttl
+func NewLocal[V any](ttl time.Duration) *Local[V] {
+	return &Local[V]{ttl: ttl, entries: make(map[string]localEntry[V])}
+}
+
+// Get returns the value of the key
+func (c *Local[V]) Get(key string) (V, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, ErrNotFound
+	}
+	return entry.value, nil
+}
+
+// Set stores the value of the key
+func (c *Local[V]) Set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired()
+	c.entries[key] = localEntry[V]{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// SetIfAbsent stores the value of the key only if it is not cached or it has expired, and returns whether it was stored
+func (c *Local[V]) SetIfAbsent(key string, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired()
+	if entry, ok := c.entries[key]; ok && !time.Now().After(entry.expiresAt) {
+		return false
+	}
+	c.entries[key] = localEntry[V]{value: value, expiresAt: time.Now().Add(c.ttl)}
+	return true
+}
+
+// Invalidate removes the given keys and all the keys starting with any of the prefixes
+func (c *Local[V]) Invalidate(keys []string, prefixes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	if len(prefixes) == 0 {
+		return
+	}
+	for key := range c.entries {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// Purge removes all the entries
+func (c *Local[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]localEntry[V])
+}
+
+// removeExpired drops the expired entries, at most once per ttl. Must be called holding the lock.
+func (c *Local[V]) removeExpired() {
+	now := time.Now()
+	if now.Sub(c.sweptAt) < c.ttl {
+		return
+	}
+	c.sweptAt = now
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package invalidation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	cache := NewLocal[int](time.Minute)
+	cache.Set("did:a/1", 1)
+	cache.Set("did:a/2", 2)
+	cache.Set("did:b/1", 3)
+
+	v, err := cache.Get("did:a/1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	cache.Invalidate([]string{"did:b/1"}, nil)
+	_, err = cache.Get("did:b/1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	cache.Invalidate(nil, []string{"did:a/"})
+	_, err = cache.Get("did:a/1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = cache.Get("did:a/2")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	expiring := NewLocal[int](time.Millisecond)
+	expiring.Set("key", 1)
+	time.Sleep(5 * time.Millisecond)
+	_, err = expiring.Get("key")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.True(t, expiring.SetIfAbsent("key", 2))
+
+	assert.True(t, cache.SetIfAbsent("did:c/1", 4))
+	assert.False(t, cache.SetIfAbsent("did:c/1", 5))
+	v, err = cache.Get("did:c/1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, v)
+}
+
+func TestListenerDispatch(t *testing.T) {
+	statuses := NewLocal[string](time.Minute)
+	documents := NewLocal[string](time.Minute)
+	listener := NewListener(nil)
+	listener.Register("statuses", statuses)
+	listener.Register("documents", documents)
+
+	statuses.Set("did:a/1", "status")
+	documents.Set("https://schema", "document")
+
+	listener.Dispatch(Message{Cache: "unknown", Prefixes: []string{""}})
+	_, err := statuses.Get("did:a/1")
+	assert.NoError(t, err)
+
+	listener.Dispatch(Message{Cache: "statuses", Prefixes: []string{"did:a/"}})
+	_, err = statuses.Get("did:a/1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = documents.Get("https://schema")
+	assert.NoError(t, err)
+
+	listener.Purge()
+	_, err = documents.Get("https://schema")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,354 @@
+// Package issuernode wires the core services of the issuer node, the identities, the credentials, the links and the
+// state publisher, from the configuration. The servers and pkg/issuer build on it.
+package issuernode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-redis/redis/v8"
+	vault "github.com/hashicorp/vault/api"
+	auth "github.com/iden3/go-iden3-auth/v2"
+	authLoaders "github.com/iden3/go-iden3-auth/v2/loaders"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-auth/v2/state"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	iden3commProtocol "github.com/iden3/iden3comm/v2/protocol"
+	"github.com/piprate/json-gold/ld"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/credentialid"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
+	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/invalidation"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
+	issuerRedis "github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/secrets"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/revocation_status"
+	httpPkg "github.com/polygonid/sh-id-platform/pkg/http"
+	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
+	"github.com/polygonid/sh-id-platform/pkg/protocol"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+// Options configures the node
+type Options struct {
+	// ServerURL is the public url of the issuer, used in the credentials and in the QR codes.
+	ServerURL string
+	// SingleIssuer must be true when the node only manages the issuer DID of the configuration, like the UI server.
+	SingleIssuer bool
+	// Authentication enables the authentication of the holders, their connections and the sessions.
+	// The links and the connections are only available with it.
+	Authentication bool
+}
+
+// Repositories are the repositories shared by the node services, for the servers that add their own services
+type Repositories struct {
+	Claims                ports.ClaimsRepository
+	IdentityState         ports.IdentityStateRepository
+	Schemas               ports.SchemaRepository
+	Sessions              ports.SessionRepository
+	PresentationTemplates ports.PresentationTemplateRepository
+}
+
+// Node is an issuer node core. The services are safe for concurrent use.
+type Node struct {
+	Identities       ports.IdentityService
+	Credentials      ports.ClaimsService
+	Links            ports.LinkService // nil without Options.Authentication
+	Publisher        ports.Publisher
+	QrStore          ports.QrStoreService
+	MerkleTrees      ports.MtService
+	Connections      ports.ConnectionsService // nil without Options.Authentication
+	Translations     ports.TranslationService
+	RevocationNonces ports.RevocationNonceService
+	Transactions     ports.TransactionService
+	FetchThreads     ports.FetchThreadsService
+	TrustRegistry    ports.TrustRegistryService
+	PackageManager   *iden3comm.PackageManager
+
+	// Infrastructure used by the services
+	Storage        *db.Storage
+	Redis          *redis.Client
+	Cache          cache.Cache
+	PubSub         *pubsub.RedisClient
+	Vault          *vault.Client
+	KeyStore       *kms.KMS
+	SchemaLoader   ld.DocumentLoader
+	DocumentCache  *loader.DocumentCache
+	EthereumClient *eth.Client
+	EthConnect     *eth.Client
+	Repositories   Repositories
+
+	// Invalidations applies to the in memory caches of this node the invalidations sent by the other replicas
+	Invalidations     *invalidation.Listener
+	stopInvalidations context.CancelFunc
+
+	// Secrets are the secrets rotated without restarting the node, nil if they are not rotated. They are reloaded until
+	// the context of New is canceled
+	Secrets *secrets.Store
+}
+
+// New connects to the infrastructure of the configuration and creates the services. The configuration must be
+// sanitized. Some background tasks, like the renewal of the vault token or the reload of the rotated secrets, run until
+// the context is canceled.
+// The listener of the cache invalidations runs until the node is closed.
+func New(ctx context.Context, cfg *config.Configuration, opts Options) (_ *Node, err error) {
+	if err := services.RegisterCustomDIDMethods(ctx, cfg.CustomDIDMethods); err != nil {
+		return nil, fmt.Errorf("registering custom DID methods: %w", err)
+	}
+
+	n := &Node{}
+	defer func() {
+		if err != nil {
+			_ = n.Close()
+		}
+	}()
+
+	if cfg.Secrets.Dir != "" {
+		if n.Secrets, err = secrets.NewStore(ctx, cfg.Secrets.Dir); err != nil {
+			return nil, fmt.Errorf("loading the secrets: %w", err)
+		}
+		n.Storage, err = db.NewStorageWithPassword(cfg.Database.URL, n.Secrets.Secret(secrets.DatabasePassword))
+	} else {
+		n.Storage, err = db.NewStorage(cfg.Database.URL)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the database: %w", err)
+	}
+	if n.Secrets != nil {
+		n.Redis, err = issuerRedis.OpenWithPassword(cfg.Cache.RedisUrl, n.Secrets.Secret(secrets.CachePassword))
+	} else {
+		n.Redis, err = issuerRedis.Open(cfg.Cache.RedisUrl)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to redis: %w", err)
+	}
+	n.PubSub = pubsub.NewRedis(n.Redis)
+	n.PubSub.WithLogger(log.Error)
+	n.Cache = cache.NewRedisCache(n.Redis)
+	n.Invalidations = invalidation.NewListener(n.Storage)
+	n.DocumentCache = loader.NewDocumentCache()
+	n.Invalidations.Register(loader.DocumentCacheName, n.DocumentCache)
+	n.SchemaLoader = loader.NewDocumentLoaderWithCache(cfg.IPFS.GatewayURL, n.DocumentCache)
+
+	if cfg.SyntheticIssuance.Enabled {
+		// no vault: the keys are kept in memory and are lost on restart
+		if n.KeyStore, err = kms.OpenInMemory(); err != nil {
+			return nil, fmt.Errorf("initializing the in-memory kms: %w", err)
+		}
+	} else {
+		vaultCfg := providers.Config{
+			UserPassAuthEnabled: cfg.VaultUserPassAuthEnabled,
+			Address:             cfg.KeyStore.Address,
+			Token:               cfg.KeyStore.Token,
+			Pass:                cfg.VaultUserPassAuthPassword,
+		}
+		if token := n.Secrets.Secret(secrets.VaultToken)(); token != "" {
+			vaultCfg.Token = token
+		}
+		if n.Vault, err = providers.VaultClient(ctx, vaultCfg); err != nil {
+			return nil, fmt.Errorf("initializing the vault client: %w", err)
+		}
+		if n.Secrets != nil && !vaultCfg.UserPassAuthEnabled {
+			n.Secrets.OnChange(secrets.VaultToken, n.Vault.SetToken)
+		}
+		if vaultCfg.UserPassAuthEnabled {
+			go providers.RenewToken(ctx, n.Vault, vaultCfg)
+		}
+		if n.KeyStore, err = kms.Open(cfg.KeyStore.PluginIden3MountPath, n.Vault); err != nil {
+			return nil, fmt.Errorf("initializing the kms: %w", err)
+		}
+		if cfg.KeyStore.CustodyEnabled() {
+			if err = n.KeyStore.RegisterRemoteCustody(cfg.KeyStore.CustodyURL, cfg.KeyStore.CustodyToken, cfg.KeyStore.CustodyTimeout); err != nil {
+				return nil, fmt.Errorf("initializing the key custody: %w", err)
+			}
+		}
+	}
+	if cfg.KeyStore.Policies != "" {
+		policies, err := kms.ParseKeyPolicies(cfg.KeyStore.Policies)
+		if err != nil {
+			return nil, err
+		}
+		n.KeyStore.SetKeyPolicies(policies)
+	}
+
+	if n.EthereumClient, err = blockchain.Open(ctx, cfg, n.KeyStore); err != nil {
+		return nil, fmt.Errorf("dialing the ethereum client: %w", err)
+	}
+	stateContract, err := blockchain.InitEthClient(ctx, cfg.Ethereum)
+	if err != nil {
+		return nil, fmt.Errorf("initializing the state contract: %w", err)
+	}
+	if n.EthConnect, err = blockchain.InitEthConnect(ctx, cfg.Ethereum, n.KeyStore); err != nil {
+		return nil, fmt.Errorf("initializing the ethereum connection: %w", err)
+	}
+
+	dataCipher, err := encryption.NewDataCipher(ctx, cfg.DataEncryption, n.Vault)
+	if err != nil {
+		return nil, fmt.Errorf("initializing the data cipher: %w", err)
+	}
+
+	// repositories initialization
+	identityRepository := repositories.NewIdentity()
+	mtRepository := repositories.NewIdentityMerkleTreeRepository()
+	revocationRepository := repositories.NewRevocation()
+	linkRepository := repositories.NewLink(*n.Storage)
+	n.Repositories = Repositories{
+		Claims:                repositories.NewClaimsWithCipher(dataCipher),
+		IdentityState:         repositories.NewIdentityState(),
+		Schemas:               repositories.NewSchema(*n.Storage),
+		Sessions:              repositories.NewSessionCached(n.Cache),
+		PresentationTemplates: repositories.NewPresentationTemplate(),
+	}
+
+	var (
+		verifier              *auth.Verifier
+		connectionsRepository ports.ConnectionsRepository
+		sessionRepository     ports.SessionRepository
+	)
+	if opts.Authentication {
+		if verifier, err = newVerifier(cfg); err != nil {
+			return nil, fmt.Errorf("initializing the verifier: %w", err)
+		}
+		connectionsRepository = repositories.NewConnections()
+		sessionRepository = n.Repositories.Sessions
+	}
+
+	// services initialization
+	n.MerkleTrees = services.NewIdentityMerkleTrees(mtRepository)
+	n.QrStore = services.NewQrStoreService(n.Cache)
+	n.FetchThreads = services.NewFetchThreads(n.Cache, cfg.FetchBinding)
+	n.TrustRegistry = services.NewTrustRegistry(gateways.NewTrustRegistry(cfg.TrustRegistry), n.Cache, cfg.TrustRegistry)
+
+	cfg.CredentialStatus.SingleIssuer = opts.SingleIssuer
+
+	mediaTypeManager := services.NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
+			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
+		},
+		*cfg.MediaTypeManager.Enabled,
+	)
+
+	rhsFactory := reverse_hash.NewFactory(cfg.CredentialStatus.RHS.GetURL(), n.EthConnect, common.HexToAddress(cfg.CredentialStatus.OnchainTreeStore.SupportedTreeStoreContract), reverse_hash.DefaultRHSTimeOut)
+	revocationStatusResolver := revocation_status.NewRevocationStatusResolver(cfg.CredentialStatus)
+	n.Identities = services.NewIdentity(n.KeyStore, identityRepository, mtRepository, n.Repositories.IdentityState, n.MerkleTrees, n.QrStore, n.Repositories.Claims, revocationRepository, connectionsRepository, n.Storage, verifier, sessionRepository, n.PubSub, cfg.CredentialStatus, rhsFactory, revocationStatusResolver, n.TrustRegistry, gateways.NewConnectionWebhook(cfg.ConnectionWebhook))
+	n.RevocationNonces = services.NewRevocationNonce(repositories.NewRevocationNonce(), n.Storage, cfg.RevocationNonces)
+	n.Credentials = services.NewClaim(services.ClaimDependencies{
+		Repo:                     n.Repositories.Claims,
+		IdentityService:          n.Identities,
+		QrService:                n.QrStore,
+		MtService:                n.MerkleTrees,
+		IdentityStateRepository:  n.Repositories.IdentityState,
+		Loader:                   n.SchemaLoader,
+		Storage:                  n.Storage,
+		Host:                     opts.ServerURL,
+		Publisher:                n.PubSub,
+		IPFSGatewayURL:           cfg.IPFS.GatewayURL,
+		RevocationStatusResolver: revocationStatusResolver,
+		MediatypeManager:         mediaTypeManager,
+		IssuancePolicy:           cfg.IssuancePolicy,
+		SessionManager:           n.Repositories.Sessions,
+		StatusOracle:             gateways.NewStatusOracle(cfg.StatusOracle, cfg.CredentialStatus.ExpirationGracePeriod),
+		CredentialIDTemplate:     credentialid.Template(cfg.CredentialID.URITemplate),
+		ConnectionsRepository:    connectionsRepository,
+		FetchThreads:             n.FetchThreads,
+		PolicyEngine:             gateways.NewPolicyEngine(cfg.PolicyEngine),
+		PolicyEngineConfig:       cfg.PolicyEngine,
+		SchemaRepository:         n.Repositories.Schemas,
+		VersionRepository:        repositories.NewCredentialVersionWithCipher(dataCipher),
+		ArchiveRepository:        repositories.NewClaimArchive(),
+		NonceService:             n.RevocationNonces,
+		ExpirationGracePeriod:    cfg.CredentialStatus.ExpirationGracePeriod,
+	})
+	if cfg.Cache.RevocationStatusTTL > 0 {
+		cached := services.NewClaimsRevocationStatusCached(n.Credentials, cfg.Cache.RevocationStatusTTL)
+		n.Invalidations.Register(services.RevocationStatusCacheName, cached)
+		n.Credentials = cached
+	}
+	n.Translations = services.NewTranslation(repositories.NewTranslation(), n.Storage)
+	if opts.Authentication {
+		n.Connections = services.NewConnection(connectionsRepository, n.Repositories.Claims, n.Storage)
+		n.Links = services.NewLinkService(n.Storage, n.Credentials, n.QrStore, n.Repositories.Claims, linkRepository, n.Repositories.Schemas, n.SchemaLoader, n.Repositories.Sessions, n.PubSub, cfg.IPFS.GatewayURL, n.Repositories.PresentationTemplates, repositories.NewPayment(), gateways.NewPaymentVerifier(n.EthConnect), n.Translations, repositories.NewLinkPrerequisiteProof(), repositories.NewLinkRecipientWithCipher(dataCipher), repositories.NewLinkHolder(), gateways.NewGeoIP(cfg.GeoIP), gateways.NewLinkResultWebhook(httpPkg.DefaultHTTPClientWithRetry), repositories.NewCredentialDependency())
+	}
+
+	var publisherGateway gateways.PublisherGateway
+	var proofService ports.ZKGenerator
+	var confirmationTracker ports.ConfirmationTracker
+	if cfg.SyntheticIssuance.Enabled {
+		chain := gateways.NewSyntheticChain()
+		n.Transactions, publisherGateway, proofService, confirmationTracker = chain, chain, chain, chain
+	} else {
+		if n.Transactions, err = gateways.NewTransaction(n.EthereumClient, cfg.Ethereum.ConfirmationBlockCount); err != nil {
+			return nil, fmt.Errorf("creating the transaction service: %w", err)
+		}
+		if publisherGateway, err = gateways.NewStatePublisherGateway(cfg, n.EthereumClient, n.KeyStore); err != nil {
+			return nil, fmt.Errorf("creating the publish gateway: %w", err)
+		}
+		proofService = gateways.NewProver(ctx, cfg, circuitLoaders.NewCircuits(cfg.Circuit.Path))
+		confirmationTracker = gateways.NewStateConfirmationTracker(cfg, n.EthereumClient)
+	}
+	n.Publisher = gateways.NewPublisher(n.Storage, n.Identities, n.Credentials, n.MerkleTrees, n.KeyStore, n.Transactions, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, n.PubSub, confirmationTracker, gateways.NewPriceFeed(cfg.PriceFeed))
+
+	if n.PackageManager, err = protocol.InitPackageManager(stateContract, cfg.Circuit.Path); err != nil {
+		return nil, fmt.Errorf("initializing the package manager: %w", err)
+	}
+
+	listenerCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+	n.stopInvalidations = stop
+	go n.Invalidations.Run(listenerCtx)
+	if n.Secrets != nil {
+		go n.Secrets.Watch(ctx, cfg.Secrets.ReloadInterval)
+	}
+	return n, nil
+}
+
+// HealthMonitors returns the monitors of the infrastructure used by the node
+func (n *Node) HealthMonitors() health.Monitors {
+	return health.Monitors{
+		"postgres": n.Storage.Ping,
+		"redis":    func(ctx context.Context) error { return n.Redis.Ping(ctx).Err() },
+	}
+}
+
+// Close stops the invalidations listener and closes the connections to the database and redis
+func (n *Node) Close() error {
+	if n.stopInvalidations != nil {
+		n.stopInvalidations()
+	}
+	var err error
+	if n.Redis != nil {
+		err = errors.Join(err, n.Redis.Close())
+	}
+	if n.Storage != nil {
+		err = errors.Join(err, n.Storage.Close())
+	}
+	return err
+}
+
+func newVerifier(cfg *config.Configuration) (*auth.Verifier, error) {
+	verificationKeyLoader := &authLoaders.FSKeyLoader{Dir: cfg.Circuit.Path + "/authV2"}
+	resolvers := map[string]pubsignals.StateResolver{
+		cfg.Ethereum.ResolverPrefix: state.ETHResolver{
+			RPCUrl:          cfg.Ethereum.URL,
+			ContractAddress: common.HexToAddress(cfg.Ethereum.ContractAddress),
+		},
+	}
+	return auth.NewVerifier(verificationKeyLoader, resolvers)
+}
@@ -150,6 +150,44 @@ func (s *JSONSchema) SchemaHash(schemaType string) (core.SchemaHash, error) {
 	return common.CreateSchemaHash([]byte(id)), nil
 }
 
+// ErrSectionNotAllowed means the schema does not allow a section of the credential
+var ErrSectionNotAllowed = errors.New("the schema does not allow the section")
+
+// ValidateCredentialSection validates a top level section of the credential, like evidence or termsOfUse. The schema
+// allows the section when it describes it, and then the section must match the description, or when it does not
+// forbid additional properties.
+func (s *JSONSchema) ValidateCredentialSection(name string, value any) error {
+	props, _ := s.content["properties"].(map[string]any)
+	property, ok := props[name]
+	if !ok {
+		if additional, ok := s.content["additionalProperties"].(bool); ok && !additional {
+			return fmt.Errorf("%w: %s", ErrSectionNotAllowed, name)
+		}
+		return nil
+	}
+
+	sectionSchema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{name: property},
+		"required":   []string{name},
+	}
+	// keep the definitions the property may reference
+	for _, key := range []string{"$schema", "$defs", "definitions"} {
+		if v, ok := s.content[key]; ok {
+			sectionSchema[key] = v
+		}
+	}
+	schemaBytes, err := json.Marshal(sectionSchema)
+	if err != nil {
+		return err
+	}
+	dataBytes, err := json.Marshal(map[string]any{name: value})
+	if err != nil {
+		return err
+	}
+	return jsonSuite.Validator{}.ValidateData(dataBytes, schemaBytes)
+}
+
 // ValidateCredentialSubject validates that the given credential subject matches the given schema
 func ValidateCredentialSubject(ctx context.Context, loader loader.DocumentLoader, schemaURL string, schemaType string, cSubject map[string]interface{}) error {
 	schema, err := Load(ctx, schemaURL, loader)
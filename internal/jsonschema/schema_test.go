@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/loader"
 )
@@ -200,3 +201,27 @@ func TestValidateCredentialSubject(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONSchema_ValidateCredentialSection(t *testing.T) {
+	termsOfUse := []any{map[string]any{"type": "IssuerPolicy"}}
+
+	open := &JSONSchema{content: map[string]any{"properties": map[string]any{}}}
+	assert.NoError(t, open.ValidateCredentialSection("termsOfUse", termsOfUse))
+
+	closed := &JSONSchema{content: map[string]any{"properties": map[string]any{}, "additionalProperties": false}}
+	assert.ErrorIs(t, closed.ValidateCredentialSection("termsOfUse", termsOfUse), ErrSectionNotAllowed)
+
+	described := &JSONSchema{content: map[string]any{
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"termsOfUse": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "object", "required": []any{"type", "id"}},
+			},
+		},
+	}}
+	err := described.ValidateCredentialSection("termsOfUse", termsOfUse)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrSectionNotAllowed)
+	assert.NoError(t, described.ValidateCredentialSection("termsOfUse", []any{map[string]any{"type": "IssuerPolicy", "id": "https://issuer.example/policies/1"}}))
+}
@@ -0,0 +1,188 @@
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SigningOperation is the operation a signature is made for
+type SigningOperation string
+
+// List of the signing operations a key policy can allow
+const (
+	SigningOperationCredential    SigningOperation = "credential"    // signature proofs of the credentials
+	SigningOperationState         SigningOperation = "state"         // state transitions of the identities
+	SigningOperationTransaction   SigningOperation = "transaction"   // blockchain transactions
+	SigningOperationKeyRotation   SigningOperation = "keyRotation"   // proofs of possession of rotated keys
+	SigningOperationDomainLinkage SigningOperation = "domainLinkage" // domain linkage credentials of the DID configuration
+)
+
+// KeyPolicyAnyKey is the key of the policy applied to the keys without a more specific one
+const KeyPolicyAnyKey = "*"
+
+// ErrSigningOperationNotAllowed returns when the policy of the key doesn't allow the operation or the schema of the signature
+var ErrSigningOperationNotAllowed = stderr.New("signing operation not allowed by the key policy")
+
+// ErrSigningQuotaExceeded returns when the key has already made the maximum number of signatures of the day
+var ErrSigningQuotaExceeded = stderr.New("daily signing quota of the key exceeded")
+
+// KeyPolicy limits what a key can sign. Key is the ID of the key, its type (BJJ or ETH) or KeyPolicyAnyKey, and the most
+// specific policy applies. Empty AllowedOperations or AllowedSchemas, or a zero MaxSignaturesPerDay, don't limit anything.
+// AllowedSchemas only limits the credential signatures.
+type KeyPolicy struct {
+	Key                 string             `json:"key"`
+	MaxSignaturesPerDay uint64             `json:"maxSignaturesPerDay"`
+	AllowedOperations   []SigningOperation `json:"allowedOperations"`
+	AllowedSchemas      []string           `json:"allowedSchemas"`
+}
+
+// KeyPolicyMetrics are the counters of the signatures checked against the key policies
+type KeyPolicyMetrics struct {
+	Signed          uint64
+	OperationDenied uint64
+	SchemaDenied    uint64
+	QuotaExceeded   uint64
+	KeysOverQuota   uint64
+	Policies        uint64
+}
+
+// ParseKeyPolicies parses the JSON list of key policies of the configuration
+func ParseKeyPolicies(raw string) ([]KeyPolicy, error) {
+	var policies []KeyPolicy
+	if err := json.Unmarshal([]byte(raw), &policies); err != nil {
+		return nil, fmt.Errorf("parsing the key policies: %w", err)
+	}
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if policy.Key == "" {
+			return nil, errors.New("key policy without key")
+		}
+		if seen[policy.Key] {
+			return nil, fmt.Errorf("duplicated policy of key %s", policy.Key)
+		}
+		seen[policy.Key] = true
+		for _, op := range policy.AllowedOperations {
+			switch op {
+			case SigningOperationCredential, SigningOperationState, SigningOperationTransaction, SigningOperationKeyRotation, SigningOperationDomainLinkage:
+			default:
+				return nil, fmt.Errorf("unknown signing operation %q in the policy of key %s", op, policy.Key)
+			}
+		}
+	}
+	return policies, nil
+}
+
+type signingOperationCtxKey struct{}
+
+type signingOperation struct {
+	operation SigningOperation
+	schema    string
+}
+
+// WithSigningOperation returns a context that tells the KMS the operation, and the schema of the credential if any,
+// the signatures made with it are for. Signatures without operation are only allowed by policies that don't limit
+// the operations.
+func WithSigningOperation(ctx context.Context, operation SigningOperation, schema string) context.Context {
+	return context.WithValue(ctx, signingOperationCtxKey{}, signingOperation{operation: operation, schema: schema})
+}
+
+// keyPolicies enforces the key policies. The daily quotas are counted by every process, so with several replicas a
+// key can make up to the quota times the number of replicas signatures per day.
+type keyPolicies struct {
+	policies map[string]KeyPolicy
+	now      func() time.Time
+
+	mu     sync.Mutex
+	day    string
+	counts map[KeyID]uint64
+
+	signed          atomic.Uint64
+	operationDenied atomic.Uint64
+	schemaDenied    atomic.Uint64
+	quotaExceeded   atomic.Uint64
+}
+
+func newKeyPolicies(policies []KeyPolicy) *keyPolicies {
+	kp := &keyPolicies{policies: make(map[string]KeyPolicy, len(policies)), now: time.Now, counts: map[KeyID]uint64{}}
+	for _, policy := range policies {
+		kp.policies[policy.Key] = policy
+	}
+	return kp
+}
+
+// policy returns the most specific policy of the key
+func (kp *keyPolicies) policy(keyID KeyID) (KeyPolicy, bool) {
+	for _, key := range []string{keyID.ID, string(keyID.Type), KeyPolicyAnyKey} {
+		if policy, ok := kp.policies[key]; ok {
+			return policy, true
+		}
+	}
+	return KeyPolicy{}, false
+}
+
+// authorize checks the signature against the policy of the key and counts it in the daily quota
+func (kp *keyPolicies) authorize(ctx context.Context, keyID KeyID) error {
+	if kp == nil {
+		return nil
+	}
+	policy, ok := kp.policy(keyID)
+	if !ok {
+		kp.signed.Add(1)
+		return nil
+	}
+
+	op, _ := ctx.Value(signingOperationCtxKey{}).(signingOperation)
+	if len(policy.AllowedOperations) > 0 && !slices.Contains(policy.AllowedOperations, op.operation) {
+		kp.operationDenied.Add(1)
+		return fmt.Errorf("%w: %q", ErrSigningOperationNotAllowed, op.operation)
+	}
+	if op.operation == SigningOperationCredential && len(policy.AllowedSchemas) > 0 && !slices.Contains(policy.AllowedSchemas, op.schema) {
+		kp.schemaDenied.Add(1)
+		return fmt.Errorf("%w: schema %s", ErrSigningOperationNotAllowed, op.schema)
+	}
+
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if day := kp.now().UTC().Format(time.DateOnly); day != kp.day {
+		kp.day, kp.counts = day, map[KeyID]uint64{}
+	}
+	if policy.MaxSignaturesPerDay > 0 && kp.counts[keyID] >= policy.MaxSignaturesPerDay {
+		kp.quotaExceeded.Add(1)
+		return errors.WithStack(ErrSigningQuotaExceeded)
+	}
+	kp.counts[keyID]++
+	kp.signed.Add(1)
+	return nil
+}
+
+func (kp *keyPolicies) metrics() KeyPolicyMetrics {
+	if kp == nil {
+		return KeyPolicyMetrics{}
+	}
+	m := KeyPolicyMetrics{
+		Signed:          kp.signed.Load(),
+		OperationDenied: kp.operationDenied.Load(),
+		SchemaDenied:    kp.schemaDenied.Load(),
+		QuotaExceeded:   kp.quotaExceeded.Load(),
+		Policies:        uint64(len(kp.policies)),
+	}
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if kp.day != kp.now().UTC().Format(time.DateOnly) {
+		return m
+	}
+	for keyID, count := range kp.counts {
+		if policy, ok := kp.policy(keyID); ok && policy.MaxSignaturesPerDay > 0 && count >= policy.MaxSignaturesPerDay {
+			m.KeysOverQuota++
+		}
+	}
+	return m
+}
@@ -0,0 +1,102 @@
+package kms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoKeyProvider struct{}
+
+func (echoKeyProvider) New(_ *w3c.DID) (KeyID, error) { return KeyID{}, nil }
+
+func (echoKeyProvider) PublicKey(_ KeyID) ([]byte, error) { return nil, nil }
+
+func (echoKeyProvider) Sign(_ context.Context, _ KeyID, data []byte) ([]byte, error) {
+	return data, nil
+}
+
+func (echoKeyProvider) ListByIdentity(_ context.Context, _ w3c.DID) ([]KeyID, error) { return nil, nil }
+
+func (echoKeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, _ w3c.DID) (KeyID, error) {
+	return keyID, nil
+}
+
+func TestParseKeyPolicies(t *testing.T) {
+	policies, err := ParseKeyPolicies(`[{"key":"BJJ","maxSignaturesPerDay":10,"allowedOperations":["credential"],"allowedSchemas":["https://schema.test"]}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []KeyPolicy{{Key: "BJJ", MaxSignaturesPerDay: 10, AllowedOperations: []SigningOperation{SigningOperationCredential}, AllowedSchemas: []string{"https://schema.test"}}}, policies)
+
+	_, err = ParseKeyPolicies(`[{"key":"BJJ","allowedOperations":["anything"]}]`)
+	assert.Error(t, err)
+	_, err = ParseKeyPolicies(`[{"key":"*"},{"key":"*"}]`)
+	assert.Error(t, err)
+	_, err = ParseKeyPolicies(`[{"maxSignaturesPerDay":1}]`)
+	assert.Error(t, err)
+}
+
+func TestKMS_SignWithKeyPolicies(t *testing.T) {
+	ctx := context.Background()
+	k := NewKMS()
+	require.NoError(t, k.RegisterKeyProvider(KeyTypeBabyJubJub, echoKeyProvider{}))
+	require.NoError(t, k.RegisterKeyProvider(KeyTypeEthereum, echoKeyProvider{}))
+
+	bjjKey := KeyID{Type: KeyTypeBabyJubJub, ID: "bjj-1"}
+	limitedKey := KeyID{Type: KeyTypeBabyJubJub, ID: "bjj-2"}
+	ethKey := KeyID{Type: KeyTypeEthereum, ID: "eth-1"}
+
+	// without policies everything is signed
+	_, err := k.Sign(ctx, bjjKey, []byte{0x01})
+	require.NoError(t, err)
+
+	k.SetKeyPolicies([]KeyPolicy{
+		{Key: "BJJ", AllowedOperations: []SigningOperation{SigningOperationCredential, SigningOperationState}, AllowedSchemas: []string{"https://schema.test"}},
+		{Key: "bjj-2", MaxSignaturesPerDay: 2},
+	})
+	now := time.Date(2024, 4, 6, 23, 0, 0, 0, time.UTC)
+	k.policies.now = func() time.Time { return now }
+
+	t.Run("operations", func(t *testing.T) {
+		_, err := k.Sign(WithSigningOperation(ctx, SigningOperationState, ""), bjjKey, []byte{0x01})
+		assert.NoError(t, err)
+		_, err = k.Sign(WithSigningOperation(ctx, SigningOperationTransaction, ""), bjjKey, []byte{0x01})
+		assert.ErrorIs(t, err, ErrSigningOperationNotAllowed)
+		_, err = k.Sign(ctx, bjjKey, []byte{0x01})
+		assert.ErrorIs(t, err, ErrSigningOperationNotAllowed)
+		_, err = k.Sign(WithSigningOperation(ctx, SigningOperationTransaction, ""), ethKey, []byte{0x01})
+		assert.NoError(t, err)
+	})
+
+	t.Run("schemas", func(t *testing.T) {
+		_, err := k.Sign(WithSigningOperation(ctx, SigningOperationCredential, "https://schema.test"), bjjKey, []byte{0x01})
+		assert.NoError(t, err)
+		_, err = k.Sign(WithSigningOperation(ctx, SigningOperationCredential, "https://other.test"), bjjKey, []byte{0x01})
+		assert.ErrorIs(t, err, ErrSigningOperationNotAllowed)
+	})
+
+	t.Run("daily quota", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := k.Sign(ctx, limitedKey, []byte{0x01})
+			require.NoError(t, err)
+		}
+		_, err := k.Sign(ctx, limitedKey, []byte{0x01})
+		assert.ErrorIs(t, err, ErrSigningQuotaExceeded)
+		assert.Equal(t, uint64(1), k.KeyPolicyMetrics().KeysOverQuota)
+
+		now = now.Add(2 * time.Hour)
+		_, err = k.Sign(ctx, limitedKey, []byte{0x01})
+		assert.NoError(t, err)
+	})
+
+	metrics := k.KeyPolicyMetrics()
+	assert.Equal(t, uint64(6), metrics.Signed)
+	assert.Equal(t, uint64(2), metrics.OperationDenied)
+	assert.Equal(t, uint64(1), metrics.SchemaDenied)
+	assert.Equal(t, uint64(1), metrics.QuotaExceeded)
+	assert.Equal(t, uint64(0), metrics.KeysOverQuota)
+	assert.Equal(t, uint64(2), metrics.Policies)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	stderr "errors"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -20,6 +22,9 @@ type KMSType interface {
 	Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error)
 	KeysByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error)
 	LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error)
+	HasCustody(kt KeyType) bool
+	CreateCustodyKey(kt KeyType, identity *w3c.DID) (KeyID, error)
+	RetireKey(ctx context.Context, keyID KeyID) error
 }
 
 // KeyProvider describes the interface that key providers should match.
@@ -39,9 +44,17 @@ type KeyProvider interface {
 	LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error)
 }
 
+// KeyRetirer is implemented by the key providers that can retire keys. Retired keys are kept by the provider but they
+// are not listed for their identity anymore, so they can not be used to sign.
+type KeyRetirer interface {
+	Retire(ctx context.Context, keyID KeyID) error
+}
+
 // KMS stores keys and secrets
 type KMS struct {
 	registry map[KeyType]KeyProvider
+	custody  map[KeyType]KeyProvider
+	policies *keyPolicies
 }
 
 // KeyType describes the type of Key
@@ -66,6 +79,15 @@ var ErrKeyTypeConflict = stderr.New("key type already registered")
 // ErrPermissionDenied raises when we register new key provider with key type
 var ErrPermissionDenied = stderr.New("permission denied")
 
+// ErrNoCustodyKeyProvider returns when creating a custody key of a type without a custody key provider registered
+var ErrNoCustodyKeyProvider = stderr.New("no custody key provider registered")
+
+// ErrRetireNotSupported returns when the key provider of the key can't retire keys
+var ErrRetireNotSupported = stderr.New("the key provider can't retire keys")
+
+// CustodyKeyPrefix is the prefix of the IDs of the keys stored by the custody key provider, i.e. an HSM or a cloud KMS
+const CustodyKeyPrefix = "custody:"
+
 // KeyID is a key unique identifier
 type KeyID struct {
 	Type KeyType
@@ -74,7 +96,7 @@ type KeyID struct {
 
 // NewKMS create new KMS
 func NewKMS() *KMS {
-	k := &KMS{registry: make(map[KeyType]KeyProvider)}
+	k := &KMS{registry: make(map[KeyType]KeyProvider), custody: make(map[KeyType]KeyProvider)}
 	return k
 }
 
@@ -89,6 +111,51 @@ func (k *KMS) RegisterKeyProvider(kt KeyType, kp KeyProvider) error {
 	return nil
 }
 
+// RegisterCustodyKeyProvider registers the provider of the keys of the given type generated inside a hardware-backed
+// custody, i.e. an HSM or a cloud KMS. It handles the keys of that type whose ID starts with CustodyKeyPrefix. Like
+// RegisterKeyProvider, it is thread unsafe.
+func (k *KMS) RegisterCustodyKeyProvider(kt KeyType, kp KeyProvider) error {
+	if _, ok := k.custody[kt]; ok {
+		return errors.WithStack(ErrKeyTypeConflict)
+	}
+
+	k.custody[kt] = kp
+	return nil
+}
+
+// HasCustody returns true if a custody key provider is registered for the key type
+func (k *KMS) HasCustody(kt KeyType) bool {
+	_, ok := k.custody[kt]
+	return ok
+}
+
+// SetKeyPolicies sets the policies that limit what the keys can sign. Like RegisterKeyProvider, it is thread unsafe.
+func (k *KMS) SetKeyPolicies(policies []KeyPolicy) {
+	k.policies = newKeyPolicies(policies)
+}
+
+// KeyPolicyMetrics returns the counters of the signatures checked against the key policies
+func (k *KMS) KeyPolicyMetrics() KeyPolicyMetrics {
+	return k.policies.metrics()
+}
+
+// IsCustodyKey returns true if the key is stored by the custody key provider
+func IsCustodyKey(keyID KeyID) bool {
+	return strings.HasPrefix(keyID.ID, CustodyKeyPrefix)
+}
+
+// provider returns the key provider of the key
+func (k *KMS) provider(keyID KeyID) (KeyProvider, error) {
+	if kp, ok := k.custody[keyID.Type]; ok && IsCustodyKey(keyID) {
+		return kp, nil
+	}
+	kp, ok := k.registry[keyID.Type]
+	if !ok {
+		return nil, errors.WithStack(ErrUnknownKeyType)
+	}
+	return kp, nil
+}
+
 // CreateKey creates new random key of specified type.
 // If identity is not nil, store key for that identity. If nil, do not bind
 // key to identity.
@@ -101,20 +168,46 @@ func (k *KMS) CreateKey(kt KeyType, identity *w3c.DID) (KeyID, error) {
 	return kp.New(identity)
 }
 
+// CreateCustodyKey creates new random key of specified type inside the custody key provider.
+func (k *KMS) CreateCustodyKey(kt KeyType, identity *w3c.DID) (KeyID, error) {
+	kp, ok := k.custody[kt]
+	if !ok {
+		return KeyID{}, errors.WithStack(ErrNoCustodyKeyProvider)
+	}
+	return kp.New(identity)
+}
+
+// RetireKey retires the key, so it is not listed for its identity anymore. Not all key providers can support this
+// operation.
+func (k *KMS) RetireKey(ctx context.Context, keyID KeyID) error {
+	kp, err := k.provider(keyID)
+	if err != nil {
+		return err
+	}
+	retirer, ok := kp.(KeyRetirer)
+	if !ok {
+		return errors.WithStack(ErrRetireNotSupported)
+	}
+	return retirer.Retire(ctx, keyID)
+}
+
 // PublicKey returns bytes representation for public key for specified key ID
 func (k *KMS) PublicKey(keyID KeyID) ([]byte, error) {
-	kp, ok := k.registry[keyID.Type]
-	if !ok {
-		return nil, errors.WithStack(ErrUnknownKeyType)
+	kp, err := k.provider(keyID)
+	if err != nil {
+		return nil, err
 	}
 	return kp.PublicKey(keyID)
 }
 
-// Sign signs digest with private key
+// Sign signs digest with private key. The signature must be allowed by the policy of the key, if any.
 func (k *KMS) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
-	kp, ok := k.registry[keyID.Type]
-	if !ok {
-		return nil, errors.WithStack(ErrUnknownKeyType)
+	kp, err := k.provider(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.policies.authorize(ctx, keyID); err != nil {
+		return nil, err
 	}
 
 	return kp.Sign(ctx, keyID, data)
@@ -131,8 +224,16 @@ func (k *KMS) KeysByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, er
 		err  error
 	}
 
-	ch1 := make(chan resT)
+	providers := make([]KeyProvider, 0, len(k.registry)+len(k.custody))
 	for _, kp := range k.registry {
+		providers = append(providers, kp)
+	}
+	for _, kp := range k.custody {
+		providers = append(providers, kp)
+	}
+
+	ch1 := make(chan resT)
+	for _, kp := range providers {
 		wg.Add(1)
 		go func(kp KeyProvider) {
 			defer wg.Done()
@@ -169,14 +270,23 @@ func (k *KMS) KeysByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, er
 // Old key may be removed after vault. Not all key providers can support this
 // operation.
 func (k *KMS) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
-	kp, ok := k.registry[keyID.Type]
-	if !ok {
-		return keyID, errors.WithStack(ErrUnknownKeyType)
+	kp, err := k.provider(keyID)
+	if err != nil {
+		return keyID, err
 	}
 
 	return kp.LinkToIdentity(ctx, keyID, identity)
 }
 
+// RegisterRemoteCustody registers a remote signer as the custody key provider of the BabyJubJub keys
+func (k *KMS) RegisterRemoteCustody(signerURL, token string, timeout time.Duration) error {
+	kp, err := NewRemoteKeyProvider(signerURL, token, timeout, KeyTypeBabyJubJub)
+	if err != nil {
+		return fmt.Errorf("cannot create the custody key provider: %w", err)
+	}
+	return k.RegisterCustodyKeyProvider(KeyTypeBabyJubJub, kp)
+}
+
 // Open returns an initialized KMS
 func Open(pluginIden3MountPath string, vault *api.Client) (*KMS, error) {
 	bjjKeyProvider, err := NewVaultPluginIden3KeyProvider(vault, pluginIden3MountPath, KeyTypeBabyJubJub)
@@ -0,0 +1,163 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/utils"
+)
+
+// memoryKeyProvider keeps the keys in memory, with the same key IDs as the vault providers. The keys are lost when the
+// process stops, so it is only meant for the synthetic issuance mode and the tests.
+type memoryKeyProvider struct {
+	keyType KeyType
+	mu      sync.RWMutex
+	keys    map[string][]byte // private keys by key ID
+}
+
+// NewMemoryKeyProvider creates a new in-memory key provider of BabyJubJub or Ethereum keys
+func NewMemoryKeyProvider(keyType KeyType) (KeyProvider, error) {
+	if keyType != KeyTypeBabyJubJub && keyType != KeyTypeEthereum {
+		return nil, ErrUnknownKeyType
+	}
+	return &memoryKeyProvider{keyType: keyType, keys: make(map[string][]byte)}, nil
+}
+
+// OpenInMemory returns a KMS with in-memory BabyJubJub and Ethereum key providers
+func OpenInMemory() (*KMS, error) {
+	keyStore := NewKMS()
+	for _, kt := range []KeyType{KeyTypeBabyJubJub, KeyTypeEthereum} {
+		kp, err := NewMemoryKeyProvider(kt)
+		if err != nil {
+			return nil, err
+		}
+		if err := keyStore.RegisterKeyProvider(kt, kp); err != nil {
+			return nil, fmt.Errorf("cannot register %s key provider: %+v", kt, err)
+		}
+	}
+	return keyStore, nil
+}
+
+func (m *memoryKeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	var privKey []byte
+	var pubKeyHex string
+	switch m.keyType {
+	case KeyTypeBabyJubJub:
+		bjjPrivKey := babyjub.NewRandPrivKey()
+		privKey = bjjPrivKey[:]
+		pubKeyHex = bjjPrivKey.Public().String()
+	default:
+		if identity == nil {
+			return KeyID{Type: m.keyType}, errors.New("Ethereum keys can be created only for non-nil identities")
+		}
+		ethPrivKey, err := crypto.GenerateKey()
+		if err != nil {
+			return KeyID{Type: m.keyType}, err
+		}
+		privKey = crypto.FromECDSA(ethPrivKey)
+		pubKeyHex = hex.EncodeToString(crypto.CompressPubkey(&ethPrivKey.PublicKey))
+	}
+
+	keyID := KeyID{Type: m.keyType, ID: keyPath(identity, m.keyType, pubKeyHex)}
+	m.mu.Lock()
+	m.keys[keyID.ID] = privKey
+	m.mu.Unlock()
+	return keyID, nil
+}
+
+func (m *memoryKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != m.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	idx := strings.LastIndex(keyID.ID, string(m.keyType)+":")
+	if idx == -1 {
+		return nil, errors.New("unable to get public key from key ID")
+	}
+	return hex.DecodeString(keyID.ID[idx+len(m.keyType)+1:])
+}
+
+// Sign signs the data as the vault providers do: BabyJubJub keys sign the little-endian representation of a field
+// element with poseidon and Ethereum keys sign the hash.
+func (m *memoryKeyProvider) Sign(_ context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	privKey, err := m.privateKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if m.keyType == KeyTypeEthereum {
+		ethPrivKey, err := crypto.ToECDSA(privKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Sign(data, ethPrivKey)
+	}
+
+	if len(data) > defaultLength {
+		return nil, errors.New("data to sign is too large")
+	}
+	i := new(big.Int).SetBytes(utils.SwapEndianness(data))
+	if !utils.CheckBigIntInField(i) {
+		return nil, errors.New("data to sign is too large")
+	}
+	var bjjPrivKey babyjub.PrivateKey
+	copy(bjjPrivKey[:], privKey)
+	sig := bjjPrivKey.SignPoseidon(i).Compress()
+	return sig[:], nil
+}
+
+func (m *memoryKeyProvider) ListByIdentity(_ context.Context, identity w3c.DID) ([]KeyID, error) {
+	prefix := identityPath(&identity) + "/" + string(m.keyType) + ":"
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var result []KeyID //nolint:prealloc // result may be empty
+	for id := range m.keys {
+		if strings.HasPrefix(id, prefix) {
+			result = append(result, KeyID{Type: m.keyType, ID: id})
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryKeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != m.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+	if m.keyType == KeyTypeEthereum {
+		return keyID, errors.New("Ethereum keys does not support binding")
+	}
+	pubKeyPrefix := string(m.keyType) + ":"
+	if !strings.HasPrefix(keyID.ID, pubKeyPrefix) {
+		return keyID, errors.New("key ID does not looks like unbound")
+	}
+
+	newKeyID := KeyID{Type: m.keyType, ID: keyPath(&identity, m.keyType, strings.TrimPrefix(keyID.ID, pubKeyPrefix))}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	privKey, ok := m.keys[keyID.ID]
+	if !ok {
+		return keyID, errors.New("key not found")
+	}
+	m.keys[newKeyID.ID] = privKey
+	delete(m.keys, keyID.ID)
+	return newKeyID, nil
+}
+
+func (m *memoryKeyProvider) privateKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != m.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	privKey, ok := m.keys[keyID.ID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return privKey, nil
+}
@@ -0,0 +1,68 @@
+package kms
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe")
+	require.NoError(t, err)
+	keyStore, err := OpenInMemory()
+	require.NoError(t, err)
+
+	t.Run("BabyJubJub", func(t *testing.T) {
+		unbound, err := keyStore.CreateKey(KeyTypeBabyJubJub, nil)
+		require.NoError(t, err)
+		keyID, err := keyStore.LinkToIdentity(ctx, unbound, *did)
+		require.NoError(t, err)
+		keys, err := keyStore.KeysByIdentity(ctx, *did)
+		require.NoError(t, err)
+		assert.Equal(t, []KeyID{keyID}, keys)
+
+		pubKeyBytes, err := keyStore.PublicKey(keyID)
+		require.NoError(t, err)
+		pubKey, err := DecodeBJJPubKey(pubKeyBytes)
+		require.NoError(t, err)
+
+		data := big.NewInt(12345)
+		sigBytes, err := keyStore.Sign(ctx, keyID, utils.SwapEndianness(data.Bytes()))
+		require.NoError(t, err)
+		var sigComp babyjub.SignatureComp
+		copy(sigComp[:], sigBytes)
+		sig, err := sigComp.Decompress()
+		require.NoError(t, err)
+		assert.True(t, pubKey.VerifyPoseidon(data, sig))
+
+		_, err = keyStore.Sign(ctx, unbound, utils.SwapEndianness(data.Bytes()))
+		assert.Error(t, err)
+	})
+
+	t.Run("Ethereum", func(t *testing.T) {
+		_, err := keyStore.CreateKey(KeyTypeEthereum, nil)
+		assert.Error(t, err)
+		keyID, err := keyStore.CreateKey(KeyTypeEthereum, did)
+		require.NoError(t, err)
+
+		pubKeyBytes, err := keyStore.PublicKey(keyID)
+		require.NoError(t, err)
+		pubKey, err := DecodeETHPubKey(pubKeyBytes)
+		require.NoError(t, err)
+
+		hash := crypto.Keccak256([]byte("state transition"))
+		sig, err := keyStore.Sign(ctx, keyID, hash)
+		require.NoError(t, err)
+		recovered, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(*pubKey), crypto.PubkeyToAddress(*recovered))
+	})
+}
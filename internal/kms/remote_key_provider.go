@@ -0,0 +1,178 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const remoteKeyProviderTimeout = 10 * time.Second
+
+// remoteKeyProvider stores the keys in a remote signer in front of an HSM or a cloud KMS. The private keys never leave
+// the custody, the signer generates them and signs the data with them. The signer exposes:
+//
+//	POST /keys                  {"type": "BJJ", "identity": "did:..."} -> {"id": "...", "publicKey": "<hex>"}
+//	GET  /keys/{id}             -> {"id": "...", "type": "BJJ", "publicKey": "<hex>"}
+//	GET  /keys?identity=did:... -> {"keys": [{"id": "...", "type": "BJJ", "publicKey": "<hex>"}]}
+//	POST /keys/{id}/sign        {"data": "<hex>"} -> {"signature": "<hex>"}
+//	POST /keys/{id}/link        {"identity": "did:..."} -> {"id": "..."}
+//
+// The IDs of the keys are prefixed with CustodyKeyPrefix in the KMS.
+type remoteKeyProvider struct {
+	keyType KeyType
+	url     string
+	token   string
+	client  *http.Client
+}
+
+type remoteKey struct {
+	ID        string  `json:"id"`
+	Type      KeyType `json:"type"`
+	PublicKey string  `json:"publicKey"`
+}
+
+// NewRemoteKeyProvider creates new key provider for the keys of the given type stored in a remote signer. The token,
+// if any, is sent as a bearer token.
+func NewRemoteKeyProvider(signerURL, token string, timeout time.Duration, keyType KeyType) (KeyProvider, error) {
+	if keyType != KeyTypeBabyJubJub && keyType != KeyTypeEthereum {
+		return nil, errors.New("unsupported key type")
+	}
+	if _, err := url.ParseRequestURI(signerURL); err != nil {
+		return nil, fmt.Errorf("invalid remote signer url: %w", err)
+	}
+	if timeout <= 0 {
+		timeout = remoteKeyProviderTimeout
+	}
+	return &remoteKeyProvider{
+		keyType: keyType,
+		url:     strings.TrimSuffix(signerURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (r *remoteKeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	req := map[string]string{"type": string(r.keyType)}
+	if identity != nil {
+		req["identity"] = identity.String()
+	}
+	var key remoteKey
+	if err := r.do(context.Background(), http.MethodPost, "/keys", req, &key); err != nil {
+		return KeyID{}, err
+	}
+	return r.keyID(key.ID), nil
+}
+
+func (r *remoteKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	id, err := r.remoteID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	var key remoteKey
+	if err := r.do(context.Background(), http.MethodGet, "/keys/"+url.PathEscape(id), nil, &key); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(key.PublicKey)
+}
+
+func (r *remoteKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	id, err := r.remoteID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Signature string `json:"signature"`
+	}
+	req := map[string]string{"data": hex.EncodeToString(data)}
+	if err := r.do(ctx, http.MethodPost, "/keys/"+url.PathEscape(id)+"/sign", req, &resp); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(resp.Signature)
+}
+
+func (r *remoteKeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	var resp struct {
+		Keys []remoteKey `json:"keys"`
+	}
+	query := url.Values{"identity": {identity.String()}, "type": {string(r.keyType)}}
+	if err := r.do(ctx, http.MethodGet, "/keys?"+query.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+	result := make([]KeyID, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		if key.Type != r.keyType {
+			continue
+		}
+		result = append(result, r.keyID(key.ID))
+	}
+	return result, nil
+}
+
+func (r *remoteKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	id, err := r.remoteID(keyID)
+	if err != nil {
+		return keyID, err
+	}
+	var key remoteKey
+	req := map[string]string{"identity": identity.String()}
+	if err := r.do(ctx, http.MethodPost, "/keys/"+url.PathEscape(id)+"/link", req, &key); err != nil {
+		return KeyID{}, err
+	}
+	return r.keyID(key.ID), nil
+}
+
+func (r *remoteKeyProvider) keyID(id string) KeyID {
+	return KeyID{Type: r.keyType, ID: CustodyKeyPrefix + id}
+}
+
+func (r *remoteKeyProvider) remoteID(keyID KeyID) (string, error) {
+	if keyID.Type != r.keyType {
+		return "", ErrIncorrectKeyType
+	}
+	if !IsCustodyKey(keyID) {
+		return "", errors.New("the key is not stored in the custody")
+	}
+	return strings.TrimPrefix(keyID.ID, CustodyKeyPrefix), nil
+}
+
+func (r *remoteKeyProvider) do(ctx context.Context, method, path string, body, resp any) error {
+	var reqBody io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, r.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling the remote signer: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	switch {
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		return ErrPermissionDenied
+	case res.StatusCode >= http.StatusMultipleChoices:
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("remote signer returned %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
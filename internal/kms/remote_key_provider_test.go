@@ -0,0 +1,83 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeyProvider(t *testing.T) {
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+
+	keys := map[string]remoteKey{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/keys":
+			key := remoteKey{ID: "hsm-1", Type: KeyType(req["type"]), PublicKey: "0a0b"}
+			keys[req["identity"]] = key
+			_ = json.NewEncoder(w).Encode(key)
+		case r.Method == http.MethodGet && r.URL.Path == "/keys":
+			_ = json.NewEncoder(w).Encode(map[string][]remoteKey{"keys": {keys[r.URL.Query().Get("identity")]}})
+		case r.Method == http.MethodGet && r.URL.Path == "/keys/hsm-1":
+			_ = json.NewEncoder(w).Encode(keys[did.String()])
+		case r.Method == http.MethodPost && r.URL.Path == "/keys/hsm-1/sign":
+			_ = json.NewEncoder(w).Encode(map[string]string{"signature": strings.Repeat("ff", 2) + req["data"]})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewRemoteKeyProvider(srv.URL, "secret", time.Second, KeyTypeBabyJubJub)
+	require.NoError(t, err)
+	keyMS := NewKMS()
+	require.NoError(t, keyMS.RegisterCustodyKeyProvider(KeyTypeBabyJubJub, provider))
+	assert.True(t, keyMS.HasCustody(KeyTypeBabyJubJub))
+	assert.False(t, keyMS.HasCustody(KeyTypeEthereum))
+
+	_, err = keyMS.CreateCustodyKey(KeyTypeEthereum, did)
+	assert.ErrorIs(t, err, ErrNoCustodyKeyProvider)
+
+	keyID, err := keyMS.CreateCustodyKey(KeyTypeBabyJubJub, did)
+	require.NoError(t, err)
+	assert.Equal(t, KeyID{Type: KeyTypeBabyJubJub, ID: "custody:hsm-1"}, keyID)
+	assert.True(t, IsCustodyKey(keyID))
+
+	pubKey, err := keyMS.PublicKey(keyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x0a, 0x0b}, pubKey)
+
+	sig, err := keyMS.Sign(context.Background(), keyID, []byte{0x01})
+	require.NoError(t, err)
+	assert.Equal(t, "ffff01", hex.EncodeToString(sig))
+
+	keyIDs, err := keyMS.KeysByIdentity(context.Background(), *did)
+	require.NoError(t, err)
+	assert.Equal(t, []KeyID{keyID}, keyIDs)
+
+	assert.ErrorIs(t, keyMS.RetireKey(context.Background(), keyID), ErrRetireNotSupported)
+
+	_, err = keyMS.PublicKey(KeyID{Type: KeyTypeBabyJubJub, ID: "BJJ:0a0b"})
+	assert.ErrorIs(t, err, ErrUnknownKeyType)
+
+	unauthorized, err := NewRemoteKeyProvider(srv.URL, "", time.Second, KeyTypeBabyJubJub)
+	require.NoError(t, err)
+	_, err = unauthorized.New(did)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
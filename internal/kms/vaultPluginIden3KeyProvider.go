@@ -161,6 +161,20 @@ func (v *vaultPluginIden3KeyProvider) New(identity *w3c.DID) (KeyID, error) {
 	return keyID, nil
 }
 
+// Retire moves the key under the retired path of the keys, so it is not listed for its identity anymore
+func (v *vaultPluginIden3KeyProvider) Retire(_ context.Context, keyID KeyID) error {
+	if keyID.Type != v.keyType {
+		return ErrIncorrectKeyType
+	}
+
+	relPath := strings.TrimPrefix(strings.TrimPrefix(keyID.ID, v.keysPathPrefix), "/")
+	retiredPath := keyPathT{
+		keyID:     path.Join(v.keysPathPrefix, "retired", relPath),
+		mountPath: v.keysMountPath,
+	}
+	return moveKey(v.vaultCli, v.keyPathFromID(keyID), retiredPath)
+}
+
 func (v *vaultPluginIden3KeyProvider) randomKeyPath() (keyPathT, error) {
 	var rnd [16]byte
 	_, err := rand.Read(rnd[:])
@@ -0,0 +1,73 @@
+package loader
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/loaders"
+	"github.com/piprate/json-gold/ld"
+)
+
+// DocumentCacheName identifies the documents cache in the cache invalidation messages
+const DocumentCacheName = "documents"
+
+type cachedDocument struct {
+	doc        *ld.RemoteDocument
+	expireTime time.Time
+}
+
+// DocumentCache is the in memory cache of the json-ld document loader. Unlike the default one,
+// its entries can be invalidated, so replicas can drop a document changed in other replica.
+type DocumentCache struct {
+	mu   sync.RWMutex
+	docs map[string]cachedDocument
+}
+
+// NewDocumentCache returns an empty DocumentCache
+func NewDocumentCache() *DocumentCache {
+	return &DocumentCache{docs: make(map[string]cachedDocument)}
+}
+
+// Get returns the cached document for the url and its expiration time
+func (c *DocumentCache) Get(key string) (*ld.RemoteDocument, time.Time, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cd, ok := c.docs[key]
+	if !ok {
+		return nil, time.Time{}, loaders.ErrCacheMiss
+	}
+	return cd.doc, cd.expireTime, nil
+}
+
+// Set caches the document of the url until expireTime
+func (c *DocumentCache) Set(key string, doc *ld.RemoteDocument, expireTime time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.docs[key] = cachedDocument{doc: doc, expireTime: expireTime}
+	return nil
+}
+
+// Invalidate removes the given urls and all the urls starting with any of the prefixes
+func (c *DocumentCache) Invalidate(keys []string, prefixes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.docs, key)
+	}
+	for key := range c.docs {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				delete(c.docs, key)
+				break
+			}
+		}
+	}
+}
+
+// Purge removes all the cached documents
+func (c *DocumentCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.docs = make(map[string]cachedDocument)
+}
@@ -2,6 +2,7 @@ package loader
 
 import (
 	"github.com/iden3/go-schema-processor/processor"
+	"github.com/iden3/go-schema-processor/v2/loaders"
 	"github.com/piprate/json-gold/ld"
 )
 
@@ -20,3 +21,8 @@ type Factory func(url string) Loader
 func NewDocumentLoader(ipfsGateway string) ld.DocumentLoader {
 	return NewW3CDocumentLoader(nil, ipfsGateway)
 }
+
+// NewDocumentLoaderWithCache returns a new ld.DocumentLoader like NewDocumentLoader that keeps the documents in the given cache
+func NewDocumentLoaderWithCache(ipfsGateway string, cache *DocumentCache) ld.DocumentLoader {
+	return NewW3CDocumentLoader(nil, ipfsGateway, loaders.WithCacheEngine(cache))
+}
@@ -3,38 +3,57 @@ package log
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
 )
 
 // ChiMiddleware installs an http middleware that logs any http request.
-func ChiMiddleware(ctx context.Context) func(handler http.Handler) http.Handler {
+// Successful GET requests to paths starting with one of the quietPaths, like the polling endpoints,
+// are logged with debug level, so they can be sampled.
+func ChiMiddleware(ctx context.Context, quietPaths ...string) func(handler http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		return requestLogger(ctx)(next)
+		return requestLogger(ctx, quietPaths)(next)
 	}
 }
 
-func requestLogger(ctx context.Context) func(next http.Handler) http.Handler {
+func requestLogger(ctx context.Context, quietPaths []string) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			t1 := time.Now()
 			//nolint:contextcheck
 			defer func() {
-				ua := r.Header.Get("User-Agent")
-				Info(ctx,
-					"http req",
+				args := []any{
 					"req-id", middleware.GetReqID(r.Context()),
 					"method", r.Method,
 					"uri", r.RequestURI,
 					"status", ww.Status(),
 					"bytes", ww.BytesWritten(),
-					"ua", ua,
-					"d", time.Since(t1))
+					"ua", r.Header.Get("User-Agent"),
+					"d", time.Since(t1),
+				}
+				if isQuiet(r, ww.Status(), quietPaths) {
+					Debug(ctx, "http req", args...)
+					return
+				}
+				Info(ctx, "http req", args...)
 			}()
 			next.ServeHTTP(ww, r)
 		}
 		return http.HandlerFunc(fn)
 	}
 }
+
+func isQuiet(r *http.Request, status int, quietPaths []string) bool {
+	if r.Method != http.MethodGet || status >= http.StatusBadRequest {
+		return false
+	}
+	for _, path := range quietPaths {
+		if strings.HasPrefix(r.URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
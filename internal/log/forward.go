@@ -0,0 +1,219 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// lineSink receives every record formatted as a json line
+type lineSink func(level slog.Level, t time.Time, line string) error
+
+// lineHandler formats the records as json lines and hands them to a sink
+type lineHandler struct {
+	inner slog.Handler
+	out   *lineBuffer
+}
+
+type lineBuffer struct {
+	mu   sync.Mutex
+	buf  bytes.Buffer
+	sink lineSink
+}
+
+func newLineHandler(opts *slog.HandlerOptions, sink lineSink) *lineHandler {
+	out := &lineBuffer{sink: sink}
+	return &lineHandler{inner: slog.NewJSONHandler(&out.buf, opts), out: out}
+}
+
+func (h *lineHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *lineHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.out.mu.Lock()
+	h.out.buf.Reset()
+	if err := h.inner.Handle(ctx, r); err != nil {
+		h.out.mu.Unlock()
+		return err
+	}
+	line := string(bytes.TrimRight(h.out.buf.Bytes(), "\n"))
+	h.out.mu.Unlock()
+	return h.out.sink(r.Level, r.Time, line)
+}
+
+func (h *lineHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &lineHandler{inner: h.inner.WithAttrs(attrs), out: h.out}
+}
+
+func (h *lineHandler) WithGroup(name string) slog.Handler {
+	return &lineHandler{inner: h.inner.WithGroup(name), out: h.out}
+}
+
+// SyslogOptions configures the forwarding of the logs to syslog. With an empty Network and Address the
+// logs are sent to the local syslog server.
+type SyslogOptions struct {
+	Network string
+	Address string
+	Tag     string
+}
+
+// LokiOptions configures the forwarding of the logs to the Loki push api, e.g. http://loki:3100/loki/api/v1/push.
+// The lines are sent in batches of BatchSize lines or every FlushInterval, whatever happens first.
+type LokiOptions struct {
+	URL           string
+	Labels        map[string]string
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+const (
+	defaultLokiBatchSize     = 100
+	defaultLokiFlushInterval = 5 * time.Second
+	lokiPushTimeout          = 10 * time.Second
+)
+
+type lokiEntry struct {
+	level string
+	value [2]string
+}
+
+type lokiClient struct {
+	opts    LokiOptions
+	client  *http.Client
+	mu      sync.Mutex
+	entries []lokiEntry
+	full    chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func newLokiHandler(opts LokiOptions, handlerOpts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	if opts.URL == "" {
+		return nil, nil, errors.New("loki push url is empty")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultLokiBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = defaultLokiFlushInterval
+	}
+	c := &lokiClient{
+		opts:    opts,
+		client:  &http.Client{Timeout: lokiPushTimeout},
+		full:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go c.run()
+	return newLineHandler(handlerOpts, c.add), c, nil
+}
+
+func (c *lokiClient) add(level slog.Level, t time.Time, line string) error {
+	c.mu.Lock()
+	c.entries = append(c.entries, lokiEntry{level: level.String(), value: [2]string{strconv.FormatInt(t.UnixNano(), 10), line}})
+	full := len(c.entries) >= c.opts.BatchSize
+	c.mu.Unlock()
+	if full {
+		select {
+		case c.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (c *lokiClient) run() {
+	defer close(c.stopped)
+	ticker := time.NewTicker(c.opts.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.flush()
+		case <-c.full:
+			c.flush()
+		case <-c.done:
+			c.flush()
+			return
+		}
+	}
+}
+
+// flush pushes the pending lines. Failures can not be logged, so they are written to stderr and the lines dropped.
+func (c *lokiClient) flush() {
+	c.mu.Lock()
+	entries := c.entries
+	c.entries = nil
+	c.mu.Unlock()
+	if len(entries) == 0 {
+		return
+	}
+	if err := c.push(entries); err != nil {
+		fmt.Fprintf(os.Stderr, "pushing %d log lines to loki: %s\n", len(entries), err)
+	}
+}
+
+func (c *lokiClient) push(entries []lokiEntry) error {
+	type stream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+	streams := make(map[string]*stream)
+	order := make([]string, 0)
+	for _, entry := range entries {
+		s, ok := streams[entry.level]
+		if !ok {
+			labels := make(map[string]string, len(c.opts.Labels)+1)
+			for k, v := range c.opts.Labels {
+				labels[k] = v
+			}
+			labels["level"] = entry.level
+			s = &stream{Stream: labels}
+			streams[entry.level] = s
+			order = append(order, entry.level)
+		}
+		s.Values = append(s.Values, entry.value)
+	}
+	payload := struct {
+		Streams []*stream `json:"streams"`
+	}{Streams: make([]*stream, len(order))}
+	for i, level := range order {
+		payload.Streams[i] = streams[level]
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close pushes the pending lines and stops the client
+func (c *lokiClient) Close() error {
+	close(c.done)
+	<-c.stopped
+	return nil
+}
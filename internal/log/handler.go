@@ -0,0 +1,194 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rootHandler filters the records by the level of the module that logs them and samples the debug ones
+// before passing them to the output handlers.
+type rootHandler struct {
+	next    slog.Handler
+	levels  *moduleLevels
+	sampler *sampler
+}
+
+func (h *rootHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.levels.min()
+}
+
+func (h *rootHandler) Handle(ctx context.Context, r slog.Record) error {
+	module := moduleOf(r.PC)
+	if r.Level < h.levels.of(module) {
+		return nil
+	}
+	if h.sampler != nil && r.Level <= slog.LevelDebug && !h.sampler.allow(module, r.Message) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *rootHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &rootHandler{next: h.next.WithAttrs(attrs), levels: h.levels, sampler: h.sampler}
+}
+
+func (h *rootHandler) WithGroup(name string) slog.Handler {
+	return &rootHandler{next: h.next.WithGroup(name), levels: h.levels, sampler: h.sampler}
+}
+
+// moduleOf returns the name of the package of the function with the given program counter,
+// e.g. services for github.com/polygonid/sh-id-platform/internal/core/services.(*claim).Save
+func moduleOf(pc uintptr) string {
+	if pc == 0 {
+		return ""
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// moduleLevels are the minimum levels of the modules. They can be changed at runtime.
+type moduleLevels struct {
+	mu      sync.RWMutex
+	def     slog.Level
+	modules map[string]slog.Level
+	minimum slog.Level
+}
+
+func newModuleLevels(def slog.Level, modules map[string]int) *moduleLevels {
+	l := &moduleLevels{def: def, modules: make(map[string]slog.Level, len(modules))}
+	for module, level := range modules {
+		l.modules[module] = slog.Level(level)
+	}
+	l.updateMin()
+	return l
+}
+
+func (l *moduleLevels) of(module string) slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if level, ok := l.modules[module]; ok {
+		return level
+	}
+	return l.def
+}
+
+func (l *moduleLevels) min() slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.minimum
+}
+
+func (l *moduleLevels) setDefault(level slog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.def = level
+	l.updateMin()
+}
+
+func (l *moduleLevels) set(module string, level slog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.modules[module] = level
+	l.updateMin()
+}
+
+func (l *moduleLevels) updateMin() {
+	l.minimum = l.def
+	for _, level := range l.modules {
+		if level < l.minimum {
+			l.minimum = level
+		}
+	}
+}
+
+// SamplingOptions configures the sampling of the debug lines. In every Tick, the first Initial lines with the same
+// module and message are logged and then one of every Thereafter. With Thereafter 0 the rest are dropped.
+type SamplingOptions struct {
+	Initial    int
+	Thereafter int
+	Tick       time.Duration
+}
+
+type sampler struct {
+	opts        SamplingOptions
+	mu          sync.Mutex
+	windowStart time.Time
+	counts      map[string]int
+}
+
+func newSampler(opts SamplingOptions) *sampler {
+	if opts.Tick <= 0 {
+		opts.Tick = time.Second
+	}
+	return &sampler{opts: opts, counts: make(map[string]int)}
+}
+
+func (s *sampler) allow(module, msg string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if now.Sub(s.windowStart) >= s.opts.Tick {
+		s.windowStart = now
+		clear(s.counts)
+	}
+	key := module + "\x00" + msg
+	s.counts[key]++
+	n := s.counts[key]
+	if n <= s.opts.Initial {
+		return true
+	}
+	return s.opts.Thereafter > 0 && (n-s.opts.Initial)%s.opts.Thereafter == 0
+}
+
+// fanoutHandler writes the records to all the handlers
+type fanoutHandler []slog.Handler
+
+func (h fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	for _, handler := range h {
+		if handler.Enabled(ctx, r.Level) {
+			err = errors.Join(err, handler.Handle(ctx, r.Clone()))
+		}
+	}
+	return err
+}
+
+func (h fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (h fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return handlers
+}
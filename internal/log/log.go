@@ -3,8 +3,13 @@ package log
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log/slog"
+	"math"
+	"runtime"
+	"sync"
+	"time"
 )
 
 // Log configuration constants
@@ -18,22 +23,112 @@ const (
 	OutputText = 2 //  log output will be text format
 )
 
+// Options configures the default logger.
+//
+// ModuleLevels overrides Level for the packages with the given name, e.g. services or repositories.
+// Syslog and Loki forward the logs, in json format, besides writing them to Writer.
+// Sampling limits the debug lines with the same message logged by a module.
+type Options struct {
+	Level        int
+	Format       int
+	Writer       io.Writer
+	ModuleLevels map[string]int
+	Syslog       *SyslogOptions
+	Loki         *LokiOptions
+	Sampling     *SamplingOptions
+}
+
+var (
+	mu      sync.Mutex
+	levels  *moduleLevels
+	closers []io.Closer
+)
+
 // Config configures the default logger.
 func Config(level, format int, w io.Writer) {
-	var handler slog.Handler
+	_ = Setup(Options{Level: level, Format: format, Writer: w})
+}
+
+// Setup configures the default logger with the given options. The forwarders of a previous setup are closed.
+func Setup(opts Options) error {
+	// the filtering is done by the root handler, so the output handlers accept any level
+	handlerOpts := &slog.HandlerOptions{Level: slog.Level(math.MinInt)}
+
+	var handler slog.Handler = slog.NewTextHandler(opts.Writer, handlerOpts)
+	if opts.Format == OutputJSON {
+		handler = slog.NewJSONHandler(opts.Writer, handlerOpts)
+	}
+	handlers := []slog.Handler{handler}
+	var forwarders []io.Closer
+	if opts.Syslog != nil {
+		h, closer, err := newSyslogHandler(*opts.Syslog, handlerOpts)
+		if err != nil {
+			return err
+		}
+		handlers = append(handlers, h)
+		forwarders = append(forwarders, closer)
+	}
+	if opts.Loki != nil {
+		h, closer, err := newLokiHandler(*opts.Loki, handlerOpts)
+		if err != nil {
+			closeAll(forwarders)
+			return err
+		}
+		handlers = append(handlers, h)
+		forwarders = append(forwarders, closer)
+	}
+	if len(handlers) > 1 {
+		handler = fanoutHandler(handlers)
+	}
+
+	l := newModuleLevels(slog.Level(opts.Level), opts.ModuleLevels)
+	var s *sampler
+	if opts.Sampling != nil {
+		s = newSampler(*opts.Sampling)
+	}
 
-	l := slog.LevelVar{}
-	l.Set(slog.Level(level))
+	mu.Lock()
+	previous := closers
+	levels, closers = l, forwarders
+	slog.SetDefault(slog.New(&rootHandler{next: handler, levels: l, sampler: s}))
+	mu.Unlock()
 
-	opts := slog.HandlerOptions{
-		AddSource: false,
-		Level:     &l,
+	return closeAll(previous)
+}
+
+// SetLevel changes at runtime the minimum level of the modules without a level of their own.
+func SetLevel(level int) {
+	mu.Lock()
+	defer mu.Unlock()
+	if levels != nil {
+		levels.setDefault(slog.Level(level))
 	}
-	handler = slog.NewTextHandler(w, &opts)
-	if format == OutputJSON {
-		handler = slog.NewJSONHandler(w, &opts)
+}
+
+// SetModuleLevel changes at runtime the minimum level of a module.
+func SetModuleLevel(module string, level int) {
+	mu.Lock()
+	defer mu.Unlock()
+	if levels != nil {
+		levels.set(module, slog.Level(level))
 	}
-	slog.SetDefault(slog.New(handler))
+}
+
+// Close flushes and closes the log forwarders. It must be called before the process exits.
+func Close() error {
+	mu.Lock()
+	previous := closers
+	closers = nil
+	mu.Unlock()
+	return closeAll(previous)
+}
+
+func closeAll(cs []io.Closer) error {
+	var err error
+	for _, c := range cs {
+		err = errors.Join(err, c.Close())
+	}
+	return err
 }
 
 // With changes the default logger to include the extra attributes
@@ -44,20 +139,37 @@ func With(args ...any) {
 
 // Debug logs a debug message  using context logger
 func Debug(ctx context.Context, msg string, args ...any) {
-	slog.DebugContext(ctx, msg, args...)
+	logAt(ctx, slog.LevelDebug, msg, args...)
 }
 
 // Info logs an info using context logger
 func Info(ctx context.Context, msg string, args ...any) {
-	slog.InfoContext(ctx, msg, args...)
+	logAt(ctx, slog.LevelInfo, msg, args...)
 }
 
 // Warn logs a warning using context logger
 func Warn(ctx context.Context, msg string, args ...any) {
-	slog.WarnContext(ctx, msg, args...)
+	logAt(ctx, slog.LevelWarn, msg, args...)
 }
 
 // Error logs an error using context logger
 func Error(ctx context.Context, msg string, args ...any) {
-	slog.ErrorContext(ctx, msg, args...)
+	logAt(ctx, slog.LevelError, msg, args...)
+}
+
+// logAt logs the record with the program counter of the caller of the exported functions, so the module levels
+// apply to the module that logs and not to this package.
+func logAt(ctx context.Context, level slog.Level, msg string, args ...any) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger := slog.Default()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:]) // skip Callers, logAt and the exported function
+	r := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	r.Add(args...)
+	_ = logger.Handler().Handle(ctx, r)
 }
@@ -0,0 +1,86 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Setup(Options{Level: LevelWarn, Format: OutputJSON, Writer: &buf, ModuleLevels: map[string]int{"log": LevelDebug}}))
+	t.Cleanup(func() { Config(LevelDebug, OutputText, io.Discard) })
+
+	ctx := context.Background()
+	Debug(ctx, "logged, module log is at debug level")
+	assert.Contains(t, buf.String(), "logged, module log is at debug level")
+
+	buf.Reset()
+	SetModuleLevel("log", LevelErr)
+	Warn(ctx, "not logged")
+	assert.Empty(t, buf.String())
+
+	SetModuleLevel("other", LevelDebug)
+	Warn(ctx, "still not logged")
+	assert.Empty(t, buf.String())
+}
+
+func TestSampling(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Setup(Options{Level: LevelDebug, Format: OutputJSON, Writer: &buf, Sampling: &SamplingOptions{Initial: 2, Thereafter: 3, Tick: time.Hour}}))
+	t.Cleanup(func() { Config(LevelDebug, OutputText, io.Discard) })
+
+	ctx := context.Background()
+	for i := 0; i < 8; i++ {
+		Debug(ctx, "poll")
+		Info(ctx, "not sampled")
+	}
+	assert.Equal(t, 4, strings.Count(buf.String(), `"msg":"poll"`))
+	assert.Equal(t, 8, strings.Count(buf.String(), `"msg":"not sampled"`))
+}
+
+func TestLokiForwarding(t *testing.T) {
+	type push struct {
+		Streams []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"streams"`
+	}
+	pushes := make(chan push, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p push
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+		pushes <- p
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	loki := &LokiOptions{URL: srv.URL, Labels: map[string]string{"app": "issuer"}, BatchSize: 2, FlushInterval: time.Hour}
+	require.NoError(t, Setup(Options{Level: LevelInfo, Format: OutputJSON, Writer: io.Discard, Loki: loki}))
+	t.Cleanup(func() { Config(LevelDebug, OutputText, io.Discard) })
+
+	ctx := context.Background()
+	Info(ctx, "first")
+	Error(ctx, "second")
+
+	select {
+	case p := <-pushes:
+		require.Len(t, p.Streams, 2)
+		assert.Equal(t, map[string]string{"app": "issuer", "level": "INFO"}, p.Streams[0].Stream)
+		require.Len(t, p.Streams[0].Values, 1)
+		assert.Contains(t, p.Streams[0].Values[0][1], `"msg":"first"`)
+		assert.Equal(t, "ERROR", p.Streams[1].Stream["level"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("loki push not received")
+	}
+	require.NoError(t, Close())
+}
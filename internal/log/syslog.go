@@ -0,0 +1,30 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"io"
+	"log/slog"
+	"log/syslog"
+	"time"
+)
+
+func newSyslogHandler(opts SyslogOptions, handlerOpts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	w, err := syslog.Dial(opts.Network, opts.Address, syslog.LOG_INFO|syslog.LOG_DAEMON, opts.Tag)
+	if err != nil {
+		return nil, nil, err
+	}
+	sink := func(level slog.Level, _ time.Time, line string) error {
+		switch {
+		case level >= slog.LevelError:
+			return w.Err(line)
+		case level >= slog.LevelWarn:
+			return w.Warning(line)
+		case level >= slog.LevelInfo:
+			return w.Info(line)
+		default:
+			return w.Debug(line)
+		}
+	}
+	return newLineHandler(handlerOpts, sink), w, nil
+}
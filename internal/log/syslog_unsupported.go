@@ -0,0 +1,13 @@
+//go:build windows || plan9
+
+package log
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+)
+
+func newSyslogHandler(_ SyslogOptions, _ *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	return nil, nil, errors.New("syslog is not supported on this platform")
+}
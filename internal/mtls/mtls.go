@@ -0,0 +1,74 @@
+// Package mtls configures the https listener of the api servers and validates the client certificates
+// of the requests to the admin endpoints.
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+var (
+	// ErrClientCertRequired is returned when the request does not include a valid client certificate
+	ErrClientCertRequired = errors.New("a valid client certificate is required")
+	// ErrClientCertNotAllowed is returned when the client certificate subject is not in the allowlist
+	ErrClientCertNotAllowed = errors.New("client certificate subject is not allowed")
+)
+
+// ServerConfig returns the tls configuration of the servers. With mutual TLS, client certificates are validated
+// against the CA bundle when they are sent, but they are not required at the handshake, so public endpoints remain
+// reachable. Use Verify to require them in the admin endpoints.
+func ServerConfig(cfg config.TLS) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if !cfg.MutualTLSEnabled() {
+		return tlsCfg, nil
+	}
+
+	bundle, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("no certificates found in client CA bundle %s", cfg.ClientCAFile)
+	}
+	tlsCfg.ClientCAs = pool
+	tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
+	return tlsCfg, nil
+}
+
+// ListenAndServe starts the server on https if a certificate is configured and on http otherwise
+func ListenAndServe(server *http.Server, cfg config.TLS) error {
+	if !cfg.Enabled() {
+		return server.ListenAndServe()
+	}
+	tlsCfg, err := ServerConfig(cfg)
+	if err != nil {
+		return err
+	}
+	server.TLSConfig = tlsCfg
+	return server.ListenAndServeTLS(cfg.CertFile, cfg.KeyFile)
+}
+
+// Verify checks that the request was sent with a client certificate validated during the handshake and, if an
+// allowlist is given, that its common name or distinguished name is in it.
+func Verify(r *http.Request, allowedSubjects []string) error {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ErrClientCertRequired
+	}
+	if len(allowedSubjects) == 0 {
+		return nil
+	}
+	subject := r.TLS.VerifiedChains[0][0].Subject
+	for _, allowed := range allowedSubjects {
+		if allowed == subject.CommonName || allowed == subject.String() {
+			return nil
+		}
+	}
+	return ErrClientCertNotAllowed
+}
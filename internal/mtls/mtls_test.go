@@ -0,0 +1,86 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestServerConfig(t *testing.T) {
+	t.Run("without client CA", func(t *testing.T) {
+		tlsCfg, err := ServerConfig(config.TLS{CertFile: "cert.pem", KeyFile: "key.pem"})
+		require.NoError(t, err)
+		assert.Equal(t, tls.NoClientCert, tlsCfg.ClientAuth)
+		assert.Nil(t, tlsCfg.ClientCAs)
+	})
+
+	t.Run("with client CA", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caFile, selfSignedCertPEM(t, "issuer-ca"), 0o600))
+
+		tlsCfg, err := ServerConfig(config.TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: caFile})
+		require.NoError(t, err)
+		assert.Equal(t, tls.VerifyClientCertIfGiven, tlsCfg.ClientAuth)
+		assert.NotNil(t, tlsCfg.ClientCAs)
+	})
+
+	t.Run("with an invalid client CA", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+
+		_, err := ServerConfig(config.TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: caFile})
+		assert.Error(t, err)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	withCert := func(cn string, org string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/v1/identities", nil)
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn, Organization: []string{org}}}
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		return r
+	}
+
+	assert.ErrorIs(t, Verify(httptest.NewRequest(http.MethodGet, "/v1/identities", nil), nil), ErrClientCertRequired)
+	unverified := httptest.NewRequest(http.MethodGet, "/v1/identities", nil)
+	unverified.TLS = &tls.ConnectionState{}
+	assert.ErrorIs(t, Verify(unverified, nil), ErrClientCertRequired)
+
+	assert.NoError(t, Verify(withCert("admin", "Issuer"), nil))
+	assert.NoError(t, Verify(withCert("admin", "Issuer"), []string{"admin"}))
+	assert.NoError(t, Verify(withCert("admin", "Issuer"), []string{"CN=admin,O=Issuer"}))
+	assert.ErrorIs(t, Verify(withCert("operator", "Issuer"), []string{"admin"}), ErrClientCertNotAllowed)
+}
+
+func selfSignedCertPEM(t *testing.T, cn string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
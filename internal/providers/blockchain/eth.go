@@ -1,8 +1,10 @@
 package blockchain
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -13,13 +15,28 @@ import (
 	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
 )
 
+// Dial connects to the ethereum url of the configuration and its fallback urls
+func Dial(ctx context.Context, cfg config.Ethereum) (*ethclient.Client, error) {
+	urls := []string{cfg.URL}
+	for _, u := range cfg.FallbackURLs {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return eth.DialWithFailover(ctx, eth.FailoverConfig{
+		URLs:                urls,
+		Strategy:            eth.RPCStrategy(cfg.RPCStrategy),
+		HealthCheckInterval: cfg.RPCHealthCheckInterval,
+	})
+}
+
 // InitEthClient returns a State Contract Instance
-func InitEthClient(ethURL, contractAddress string) (*abi.State, error) {
-	ec, err := ethclient.Dial(ethURL)
+func InitEthClient(ctx context.Context, cfg config.Ethereum) (*abi.State, error) {
+	ec, err := Dial(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed connect to eth node %s: %s", ethURL, err.Error())
+		return nil, fmt.Errorf("failed connect to eth node %s: %s", cfg.URL, err.Error())
 	}
-	stateContractInstance, err := abi.NewState(common.HexToAddress(contractAddress), ec)
+	stateContractInstance, err := abi.NewState(common.HexToAddress(cfg.ContractAddress), ec)
 	if err != nil {
 		return nil, fmt.Errorf("error failed create state contract client: %s", err.Error())
 	}
@@ -28,8 +45,8 @@ func InitEthClient(ethURL, contractAddress string) (*abi.State, error) {
 }
 
 // InitEthConnect opens a new eth connection
-func InitEthConnect(cfg config.Ethereum, kms *kms.KMS) (*eth.Client, error) {
-	commonClient, err := ethclient.Dial(cfg.URL)
+func InitEthConnect(ctx context.Context, cfg config.Ethereum, kms *kms.KMS) (*eth.Client, error) {
+	commonClient, err := Dial(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -53,8 +70,8 @@ func InitEthConnect(cfg config.Ethereum, kms *kms.KMS) (*eth.Client, error) {
 }
 
 // Open returns an initialized eth Client with the given configuration
-func Open(cfg *config.Configuration, kms *kms.KMS) (*eth.Client, error) {
-	ethClient, err := ethclient.Dial(cfg.Ethereum.URL)
+func Open(ctx context.Context, cfg *config.Configuration, kms *kms.KMS) (*eth.Client, error) {
+	ethClient, err := Dial(ctx, cfg.Ethereum)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package providers
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
@@ -20,11 +21,15 @@ const (
 	user         = "issuernode"
 )
 
+const dataKeySecretPath = "data-encryption-key"
+
 var (
 	// DidNotFound error
 	DidNotFound = errors.New("did not found in vault")
 	// VaultConnErr error
 	VaultConnErr = errors.New("vault connection error")
+	// DataEncryptionKeyNotFound error
+	DataEncryptionKeyNotFound = errors.New("data encryption key not found in vault")
 )
 
 // HTTPClientTimeout http client timeout TODO: move to config
@@ -224,3 +229,37 @@ func SaveDID(ctx context.Context, vaultCli *vault.Client, did string) error {
 	log.Info(ctx, "did saved to vault")
 	return nil
 }
+
+// GetDataEncryptionKey gets the key used to encrypt data at rest from vault
+func GetDataEncryptionKey(ctx context.Context, vaultCli *vault.Client) ([]byte, error) {
+	secret, err := vaultCli.KVv2(didMountPath).Get(ctx, dataKeySecretPath)
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return nil, DataEncryptionKeyNotFound
+		}
+		log.Error(ctx, "error getting data encryption key from vault", "error", err)
+		return nil, errors.Join(err, VaultConnErr)
+	}
+
+	encoded, ok := secret.Data["key"].(string)
+	if !ok {
+		log.Info(ctx, "data encryption key not found in vault")
+		return nil, DataEncryptionKeyNotFound
+	}
+
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+// SaveDataEncryptionKey saves the key used to encrypt data at rest to vault
+func SaveDataEncryptionKey(ctx context.Context, vaultCli *vault.Client, key []byte) error {
+	_, err := vaultCli.KVv2(didMountPath).Put(ctx, dataKeySecretPath, map[string]interface{}{
+		"key": base64.StdEncoding.EncodeToString(key),
+	})
+	if err != nil {
+		log.Error(ctx, "error saving data encryption key to vault", "error", err)
+		return err
+	}
+
+	log.Info(ctx, "data encryption key saved to vault")
+	return nil
+}
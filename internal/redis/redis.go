@@ -19,6 +19,35 @@ func Open(url string) (*redis.Client, error) {
 	return rdb, nil
 }
 
+// OpenWithPassword opens a connection to redis whose new connections authenticate with the current value of password
+// instead of the password of the url, so it can be rotated without restarting
+func OpenWithPassword(url string, password func() string) (*redis.Client, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	username, urlPassword := opts.Username, opts.Password
+	opts.Username, opts.Password = "", ""
+	opts.OnConnect = func(ctx context.Context, cn *redis.Conn) error {
+		p := password()
+		if p == "" {
+			p = urlPassword
+		}
+		if p == "" {
+			return nil
+		}
+		if username != "" {
+			return cn.AuthACL(ctx, username, p).Err()
+		}
+		return cn.Auth(ctx, p).Err()
+	}
+	rdb := redis.NewClient(opts)
+	if err := Status(context.Background(), rdb); err != nil {
+		return nil, err
+	}
+	return rdb, nil
+}
+
 // Status returns nil of redis status is ok. Otherwise a redis status err
 func Status(ctx context.Context, rdb *redis.Client) error {
 	if pingCmd := rdb.Ping(ctx); pingCmd.Err() != nil {
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrAPIKeyDoesNotExist api key does not exist
+var ErrAPIKeyDoesNotExist = errors.New("api key does not exist")
+
+const apiKeyColumns = `id, name, key_hash, scopes, allowed_ips, expires_at, revoked_at, last_used_at, created_at, tenant_id, read_only`
+
+type apiKey struct{}
+
+// NewAPIKey returns a new api key repository
+func NewAPIKey() ports.APIKeyRepository {
+	return &apiKey{}
+}
+
+// Save stores a new api key
+func (a *apiKey) Save(ctx context.Context, conn db.Querier, key *domain.APIKey) error {
+	_, err := conn.Exec(ctx, `INSERT INTO api_keys (`+apiKeyColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		key.ID, key.Name, key.KeyHash, scopesToStrings(key.Scopes), allowedIPs(key.AllowedIPs), key.ExpiresAt, key.RevokedAt, key.LastUsedAt, key.CreatedAt, key.TenantID, key.ReadOnly)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationPGCode {
+		return ErrTenantDoesNotExist
+	}
+	return err
+}
+
+// GetByID returns the api key with the given id
+func (a *apiKey) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.APIKey, error) {
+	return scanAPIKey(conn.QueryRow(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, id))
+}
+
+// GetByHash returns the api key with the given hash
+func (a *apiKey) GetByHash(ctx context.Context, conn db.Querier, keyHash string) (*domain.APIKey, error) {
+	return scanAPIKey(conn.QueryRow(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, keyHash))
+}
+
+// GetAll returns all the api keys, including the revoked ones
+func (a *apiKey) GetAll(ctx context.Context, conn db.Querier) ([]domain.APIKey, error) {
+	rows, err := conn.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+	return keys, rows.Err()
+}
+
+// UpdateHash replaces the hash of a key that is not revoked
+func (a *apiKey) UpdateHash(ctx context.Context, conn db.Querier, id uuid.UUID, keyHash string) error {
+	res, err := conn.Exec(ctx, `UPDATE api_keys SET key_hash = $2 WHERE id = $1 AND revoked_at IS NULL`, id, keyHash)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrAPIKeyDoesNotExist
+	}
+	return nil
+}
+
+// Revoke marks a key as revoked. Revoked keys are kept to be listed in the audit.
+func (a *apiKey) Revoke(ctx context.Context, conn db.Querier, id uuid.UUID, revokedAt time.Time) error {
+	res, err := conn.Exec(ctx, `UPDATE api_keys SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`, id, revokedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrAPIKeyDoesNotExist
+	}
+	return nil
+}
+
+// UpdateLastUsed sets the last time the key was used
+func (a *apiKey) UpdateLastUsed(ctx context.Context, conn db.Querier, id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := conn.Exec(ctx, `UPDATE api_keys SET last_used_at = $2 WHERE id = $1`, id, lastUsedAt)
+	return err
+}
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var scopes []string
+	err := row.Scan(&key.ID, &key.Name, &key.KeyHash, &scopes, &key.AllowedIPs, &key.ExpiresAt, &key.RevokedAt, &key.LastUsedAt, &key.CreatedAt, &key.TenantID, &key.ReadOnly)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyDoesNotExist
+		}
+		return nil, err
+	}
+	key.Scopes = make([]domain.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		key.Scopes[i] = domain.APIKeyScope(scope)
+	}
+	return &key, nil
+}
+
+func scopesToStrings(scopes []domain.APIKeyScope) []string {
+	resp := make([]string, len(scopes))
+	for i, scope := range scopes {
+		resp[i] = string(scope)
+	}
+	return resp
+}
+
+func allowedIPs(ips []string) []string {
+	if ips == nil {
+		return []string{}
+	}
+	return ips
+}
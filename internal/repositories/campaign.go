@@ -0,0 +1,195 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrCampaignDoesNotExist           = errors.New("campaign does not exist")                       // ErrCampaignDoesNotExist campaign does not exist
+	ErrCampaignLinkDoesNotExist       = errors.New("link does not exist or is not in the campaign") // ErrCampaignLinkDoesNotExist the link is not of the issuer or not in the campaign
+	ErrCampaignCredentialDoesNotExist = errors.New("credential does not exist")                     // ErrCampaignCredentialDoesNotExist the credential is not of the issuer
+)
+
+// campaignSelect returns the campaigns with their links and their progress. The invited holders are the recipients
+// of the links that have them, the maximum issuance of the other links and the credentials added to the campaign.
+const campaignSelect = `
+SELECT c.id, c.issuer_id, c.name, c.description, c.start_at, c.end_at, c.webhook_url, c.milestones, c.notified_milestones, c.created_at,
+       ARRAY(SELECT cl.link_id::text FROM campaign_links cl WHERE cl.campaign_id = c.id ORDER BY cl.link_id),
+       (SELECT count(*) FROM campaign_links cl WHERE cl.campaign_id = c.id),
+       ((SELECT coalesce(sum(coalesce(nullif((SELECT count(*) FROM link_recipients r WHERE r.link_id = l.id), 0), l.max_issuance, 0)), 0)
+         FROM campaign_links cl JOIN links l ON l.id = cl.link_id WHERE cl.campaign_id = c.id) +
+        (SELECT count(*) FROM campaign_credentials cc WHERE cc.campaign_id = c.id))::bigint,
+       credentials.claimed,
+       credentials.revoked
+FROM campaigns c
+LEFT JOIN LATERAL (
+    SELECT count(*) AS claimed, count(*) FILTER (WHERE claims.revoked) AS revoked
+    FROM claims
+    WHERE claims.identifier = c.issuer_id
+      AND (claims.link_id IN (SELECT cl.link_id FROM campaign_links cl WHERE cl.campaign_id = c.id)
+        OR claims.id IN (SELECT cc.claim_id FROM campaign_credentials cc WHERE cc.campaign_id = c.id))
+) credentials ON true`
+
+type campaign struct{}
+
+// NewCampaign returns a new campaigns repository
+func NewCampaign() ports.CampaignRepository {
+	return &campaign{}
+}
+
+// Save stores a campaign or updates it, keeping the milestones already notified
+func (r *campaign) Save(ctx context.Context, conn db.Querier, campaign *domain.Campaign) error {
+	_, err := conn.Exec(ctx, `INSERT INTO campaigns (id, issuer_id, name, description, start_at, end_at, webhook_url, milestones, notified_milestones, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO
+		UPDATE SET name = $3, description = $4, start_at = $5, end_at = $6, webhook_url = $7, milestones = $8`,
+		campaign.ID, campaign.IssuerDID.String(), campaign.Name, campaign.Description, campaign.StartAt, campaign.EndAt, campaign.WebhookURL,
+		campaign.Milestones, campaign.NotifiedMilestones, campaign.CreatedAt)
+	return err
+}
+
+// GetByID returns the campaign of the issuer with its progress
+func (r *campaign) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.Campaign, error) {
+	return scanCampaign(conn.QueryRow(ctx, campaignSelect+` WHERE c.id = $1 AND c.issuer_id = $2`, id, issuerDID.String()))
+}
+
+// GetAll returns all the campaigns of the issuer with their progress, the newest first
+func (r *campaign) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.Campaign, error) {
+	return queryCampaigns(ctx, conn, campaignSelect+` WHERE c.issuer_id = $1 ORDER BY c.created_at DESC`, issuerDID.String())
+}
+
+// GetByCredentials returns the campaigns the credentials belong to, because they were issued by one of their links
+// or because they were added to them
+func (r *campaign) GetByCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialIDs []uuid.UUID) ([]domain.Campaign, error) {
+	return queryCampaigns(ctx, conn, campaignSelect+` WHERE c.issuer_id = $1 AND c.id IN (
+		SELECT cl.campaign_id FROM campaign_links cl JOIN claims ON claims.link_id = cl.link_id WHERE claims.identifier = $1 AND claims.id = ANY($2::uuid[])
+		UNION
+		SELECT cc.campaign_id FROM campaign_credentials cc WHERE cc.issuer_id = $1 AND cc.claim_id = ANY($2::uuid[]))`,
+		issuerDID.String(), uuidStrings(credentialIDs))
+}
+
+// Delete removes the campaign. Its links and credentials are not removed.
+func (r *campaign) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM campaigns WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrCampaignDoesNotExist
+	}
+	return nil
+}
+
+// AddLinks adds the links of the issuer to the campaign, moving them if they were in another campaign
+func (r *campaign) AddLinks(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, linkIDs []uuid.UUID) error {
+	ids := uuidStrings(linkIDs)
+	var count int
+	if err := conn.QueryRow(ctx, `SELECT count(*) FROM links WHERE issuer_id = $1 AND id = ANY($2::uuid[])`, issuerDID.String(), ids).Scan(&count); err != nil {
+		return err
+	}
+	if count != len(ids) {
+		return ErrCampaignLinkDoesNotExist
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO campaign_links (link_id, campaign_id) SELECT unnest($2::uuid[]), $1
+		ON CONFLICT (link_id) DO UPDATE SET campaign_id = $1`, id, ids)
+	return err
+}
+
+// RemoveLink removes a link from the campaign
+func (r *campaign) RemoveLink(ctx context.Context, conn db.Querier, id uuid.UUID, linkID uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM campaign_links WHERE campaign_id = $1 AND link_id = $2`, id, linkID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrCampaignLinkDoesNotExist
+	}
+	return nil
+}
+
+// AddCredentials adds the credentials of the issuer to the campaign, moving them if they were in another campaign
+func (r *campaign) AddCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, credentialIDs []uuid.UUID) error {
+	ids := uuidStrings(credentialIDs)
+	var count int
+	if err := conn.QueryRow(ctx, `SELECT count(*) FROM claims WHERE identifier = $1 AND id = ANY($2::uuid[])`, issuerDID.String(), ids).Scan(&count); err != nil {
+		return err
+	}
+	if count != len(ids) {
+		return ErrCampaignCredentialDoesNotExist
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO campaign_credentials (claim_id, issuer_id, campaign_id) SELECT unnest($3::uuid[]), $2, $1
+		ON CONFLICT (claim_id, issuer_id) DO UPDATE SET campaign_id = $1`, id, issuerDID.String(), ids)
+	return err
+}
+
+// MarkMilestoneNotified records that the milestone of the campaign was notified. It returns false if it was already
+// recorded, so concurrent updates notify every milestone once.
+func (r *campaign) MarkMilestoneNotified(ctx context.Context, conn db.Querier, id uuid.UUID, milestone int) (bool, error) {
+	res, err := conn.Exec(ctx, `UPDATE campaigns SET notified_milestones = array_append(notified_milestones, $2)
+		WHERE id = $1 AND NOT ($2 = ANY(notified_milestones))`, id, milestone)
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() == 1, nil
+}
+
+func queryCampaigns(ctx context.Context, conn db.Querier, sql string, args ...any) ([]domain.Campaign, error) {
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	campaigns := make([]domain.Campaign, 0)
+	for rows.Next() {
+		campaign, err := scanCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, *campaign)
+	}
+	return campaigns, rows.Err()
+}
+
+func scanCampaign(row pgx.Row) (*domain.Campaign, error) {
+	var campaign domain.Campaign
+	var issuerDID string
+	var linkIDs []string
+	err := row.Scan(&campaign.ID, &issuerDID, &campaign.Name, &campaign.Description, &campaign.StartAt, &campaign.EndAt, &campaign.WebhookURL,
+		&campaign.Milestones, &campaign.NotifiedMilestones, &campaign.CreatedAt, &linkIDs,
+		&campaign.Progress.Links, &campaign.Progress.Invited, &campaign.Progress.Claimed, &campaign.Progress.Revoked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCampaignDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	campaign.IssuerDID = *did
+	campaign.LinkIDs = make([]uuid.UUID, len(linkIDs))
+	for i, linkID := range linkIDs {
+		if campaign.LinkIDs[i], err = uuid.Parse(linkID); err != nil {
+			return nil, err
+		}
+	}
+	return &campaign, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return s
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrArchivedClaimDoesNotExist = errors.New("archived claim does not exist")                                   // ErrArchivedClaimDoesNotExist archived claim does not exist
+	ErrArchivedClaimConflict     = errors.New("a claim with the same id or index was issued after the archival") // ErrArchivedClaimConflict the archived claim cannot be rehydrated
+)
+
+type claimArchive struct{}
+
+// NewClaimArchive returns a new archived claims repository
+func NewClaimArchive() ports.ClaimArchiveRepository {
+	return &claimArchive{}
+}
+
+// Archive moves up to limit claims of the issuer created before createdBefore, the oldest first, to the
+// archived_claims table with the rows that reference them. The auth claims, the claims waiting for their merkle tree
+// proof, the claims with dependencies, the versioned claims and the claims with a pending revocation request stay in the
+// claims table.
+func (r *claimArchive) Archive(ctx context.Context, conn db.Querier, issuerDID w3c.DID, createdBefore time.Time, limit int) ([]uuid.UUID, error) {
+	rows, err := conn.Query(ctx, `
+		WITH candidates AS (
+			SELECT claims.id
+			FROM claims
+			WHERE claims.identifier = $1 AND claims.issuer = claims.identifier AND claims.created_at < $2
+				AND claims.schema_type <> $4
+				AND NOT (claims.mtp = true AND claims.identity_state IS NULL)
+				AND NOT EXISTS (SELECT 1 FROM credential_dependencies WHERE credential_dependencies.issuer_id = $1
+					AND (credential_dependencies.credential_id = claims.id OR credential_dependencies.prerequisite_id = claims.id))
+				AND NOT EXISTS (SELECT 1 FROM credential_versions WHERE credential_versions.issuer_id = $1
+					AND (credential_versions.credential_id = claims.id OR credential_versions.previous_credential_id = claims.id))
+				AND NOT EXISTS (SELECT 1 FROM revocation_requests WHERE revocation_requests.claim_id = claims.id
+					AND revocation_requests.status = 'pending')
+			ORDER BY claims.created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		), archived AS (
+			INSERT INTO archived_claims (id, identifier, other_identifier, schema_type, rev_nonce, revoked, created_at, claim, related)
+			SELECT claims.id, claims.identifier, claims.other_identifier, claims.schema_type, claims.rev_nonce,
+				COALESCE(claims.revoked, false), claims.created_at, to_jsonb(claims.*),
+				jsonb_build_object(
+					'campaign_credentials', COALESCE((SELECT jsonb_agg(to_jsonb(campaign_credentials.*)) FROM campaign_credentials
+						WHERE campaign_credentials.claim_id = claims.id AND campaign_credentials.issuer_id = claims.identifier), '[]'::jsonb),
+					'revocation_requests', COALESCE((SELECT jsonb_agg(to_jsonb(revocation_requests.*)) FROM revocation_requests
+						WHERE revocation_requests.claim_id = claims.id), '[]'::jsonb))
+			FROM claims JOIN candidates ON candidates.id = claims.id
+			WHERE claims.identifier = $1
+			RETURNING id
+		)
+		DELETE FROM claims USING archived
+		WHERE claims.id = archived.id AND claims.identifier = $1
+		RETURNING claims.id`,
+		issuerDID.String(), createdBefore, limit, domain.AuthBJJCredentialSchemaType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0, limit)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Rehydrate moves the archived claim back to the claims table with the rows that referenced it. The references to
+// the links, profiles and campaigns deleted in the meantime are dropped. It must run in a transaction.
+func (r *claimArchive) Rehydrate(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	var claim, related []byte
+	err := conn.QueryRow(ctx, `DELETE FROM archived_claims WHERE identifier = $1 AND id = $2 RETURNING claim, related`,
+		issuerDID.String(), id).Scan(&claim, &related)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrArchivedClaimDoesNotExist
+		}
+		return err
+	}
+
+	_, err = conn.Exec(ctx, `
+		INSERT INTO claims
+		SELECT (jsonb_populate_record(NULL::claims, $1::jsonb || jsonb_build_object(
+			'link_id', CASE WHEN EXISTS (SELECT 1 FROM links WHERE links.id::text = $1::jsonb->>'link_id') THEN $1::jsonb->'link_id' ELSE 'null'::jsonb END,
+			'profile_id', CASE WHEN EXISTS (SELECT 1 FROM issuer_profiles WHERE issuer_profiles.id::text = $1::jsonb->>'profile_id') THEN $1::jsonb->'profile_id' ELSE 'null'::jsonb END
+		))).*`, claim)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrArchivedClaimConflict
+		}
+		return err
+	}
+
+	_, err = conn.Exec(ctx, `
+		INSERT INTO campaign_credentials
+		SELECT related.* FROM jsonb_populate_recordset(NULL::campaign_credentials, $1::jsonb->'campaign_credentials') AS related
+		WHERE EXISTS (SELECT 1 FROM campaigns WHERE campaigns.id = related.campaign_id)
+		ON CONFLICT DO NOTHING`, related)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, `
+		INSERT INTO revocation_requests
+		SELECT related.* FROM jsonb_populate_recordset(NULL::revocation_requests, $1::jsonb->'revocation_requests') AS related
+		ON CONFLICT DO NOTHING`, related)
+	return err
+}
+
+// GetByID returns the archived claim of the issuer
+func (r *claimArchive) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ArchivedClaim, error) {
+	var archived domain.ArchivedClaim
+	err := conn.QueryRow(ctx, `
+		SELECT id, identifier, other_identifier, schema_type, rev_nonce, revoked, created_at, archived_at
+		FROM archived_claims
+		WHERE identifier = $1 AND id = $2`, issuerDID.String(), id).Scan(
+		&archived.ID,
+		&archived.IssuerDID,
+		&archived.OtherIdentifier,
+		&archived.SchemaType,
+		&archived.RevNonce,
+		&archived.Revoked,
+		&archived.CreatedAt,
+		&archived.ArchivedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrArchivedClaimDoesNotExist
+		}
+		return nil, err
+	}
+	return &archived, nil
+}
+
+// RevokeByNonce flags the archived claims of the nonce as revoked and returns how many there were
+func (r *claimArchive) RevokeByNonce(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64) (int64, error) {
+	cmd, err := conn.Exec(ctx, `
+		UPDATE archived_claims SET revoked = true, claim = jsonb_set(claim, '{revoked}', 'true'::jsonb)
+		WHERE identifier = $1 AND rev_nonce = $2`, issuerDID.String(), nonce)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
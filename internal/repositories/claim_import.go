@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrClaimImportDoesNotExist = errors.New("claim import does not exist")       // ErrClaimImportDoesNotExist claim import does not exist
+	ErrClaimImportDuplicated   = errors.New("the document was already imported") // ErrClaimImportDuplicated the issuer already imported the document
+)
+
+type claimImport struct{}
+
+// NewClaimImport returns a new claim imports repository
+func NewClaimImport() ports.ClaimImportRepository {
+	return &claimImport{}
+}
+
+// Save stores the provenance of an imported claim
+func (r *claimImport) Save(ctx context.Context, conn db.Querier, claimImport *domain.ClaimImport) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO claim_imports (id, issuer_id, claim_id, source, source_reference, original_issuer, original_id, document_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING imported_at`,
+		claimImport.ID, claimImport.IssuerDID, claimImport.ClaimID, claimImport.Source, claimImport.SourceReference,
+		claimImport.OriginalIssuer, claimImport.OriginalID, claimImport.DocumentHash).Scan(&claimImport.ImportedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrClaimImportDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByClaimID returns the provenance of the imported claim
+func (r *claimImport) GetByClaimID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, claimID uuid.UUID) (*domain.ClaimImport, error) {
+	return r.get(ctx, conn, `issuer_id = $1 AND claim_id = $2`, issuerDID.String(), claimID)
+}
+
+// GetByDocumentHash returns the provenance of the claim imported from the document with the hash
+func (r *claimImport) GetByDocumentHash(ctx context.Context, conn db.Querier, issuerDID w3c.DID, hash string) (*domain.ClaimImport, error) {
+	return r.get(ctx, conn, `issuer_id = $1 AND document_hash = $2`, issuerDID.String(), hash)
+}
+
+func (r *claimImport) get(ctx context.Context, conn db.Querier, where string, args ...any) (*domain.ClaimImport, error) {
+	var claimImport domain.ClaimImport
+	err := conn.QueryRow(ctx, `
+		SELECT id, issuer_id, claim_id, source, source_reference, original_issuer, original_id, document_hash, imported_at
+		FROM claim_imports
+		WHERE `+where, args...).Scan(
+		&claimImport.ID,
+		&claimImport.IssuerDID,
+		&claimImport.ClaimID,
+		&claimImport.Source,
+		&claimImport.SourceReference,
+		&claimImport.OriginalIssuer,
+		&claimImport.OriginalID,
+		&claimImport.DocumentHash,
+		&claimImport.ImportedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClaimImportDoesNotExist
+		}
+		return nil, err
+	}
+	return &claimImport, nil
+}
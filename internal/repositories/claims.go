@@ -3,8 +3,10 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-merkletree-sql/v2"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/labstack/gommon/log"
@@ -24,14 +27,24 @@ import (
 
 const duplicateViolationErrorCode = "23505"
 
+// maxQueryFieldClaims is the max number of claims decrypted in memory to match a query_field filter when the
+// credentials data is encrypted. The other filters must narrow the claims below it.
+const maxQueryFieldClaims = 1000
+
 // ErrClaimDuplication claim duplication error
 var (
 	ErrClaimDuplication = errors.New("claim duplication error")
 	// ErrClaimDoesNotExist claim does not exist
 	ErrClaimDoesNotExist = errors.New("claim does not exist")
+	// ErrQueryFieldEncrypted the claims are encrypted, so they can not be filtered by a field in pages
+	ErrQueryFieldEncrypted = errors.New("the credentials data is encrypted, filtering by a field is not supported with pagination")
+	// ErrQueryFieldTooManyClaims the claims are encrypted, and there are too many of them to filter them by a field in memory
+	ErrQueryFieldTooManyClaims = fmt.Errorf("the credentials data is encrypted, filtering by a field is supported for up to %d credentials, narrow the other filters", maxQueryFieldClaims)
 )
 
-type claims struct{}
+type claims struct {
+	cipher ports.DataCipher
+}
 
 type dbClaim struct {
 	ID               *uuid.UUID
@@ -64,6 +77,12 @@ func NewClaims() ports.ClaimsRepository {
 	return &claims{}
 }
 
+// NewClaimsWithCipher returns a new claim repository that encrypts the credentials data at rest with the given cipher.
+// A nil cipher stores the data in clear.
+func NewClaimsWithCipher(cipher ports.DataCipher) ports.ClaimsRepository {
+	return &claims{cipher: cipher}
+}
+
 // GetRevoked returns all the revoked claims from the given state
 func (c *claims) GetRevoked(ctx context.Context, conn db.Querier, currentState string) ([]*domain.Claim, error) {
 	query := `SELECT claims.id,
@@ -86,7 +105,9 @@ func (c *claims) GetRevoked(ctx context.Context, conn db.Querier, currentState s
 		core_claim,
 		revoked,
 		mtp,
-		claims.created_at
+		claims.created_at,
+		claims.external_id,
+		claims.profile_id
 	FROM claims
 	LEFT JOIN revocation ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
 	WHERE claims.identity_state = $1`
@@ -96,7 +117,7 @@ func (c *claims) GetRevoked(ctx context.Context, conn db.Querier, currentState s
 		return nil, err
 	}
 
-	claims, err := processClaims(rows)
+	claims, err := c.processClaims(ctx, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -105,20 +126,13 @@ func (c *claims) GetRevoked(ctx context.Context, conn db.Querier, currentState s
 }
 
 func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error) {
-	var err error
 	id := claim.ID
 
-	if claim.MTPProof.Status == pgtype.Undefined {
-		claim.MTPProof.Status = pgtype.Null
-	}
-	if claim.Data.Status == pgtype.Undefined {
-		claim.Data.Status = pgtype.Null
-	}
-	if claim.SignatureProof.Status == pgtype.Undefined {
-		claim.SignatureProof.Status = pgtype.Null
-	}
-	if claim.CredentialStatus.Status == pgtype.Undefined {
-		claim.CredentialStatus.Status = pgtype.Null
+	setUndefinedToNull(claim)
+
+	data, err := c.encryptData(ctx, claim.Data)
+	if err != nil {
+		return uuid.Nil, err
 	}
 
 	if id == uuid.Nil {
@@ -142,8 +156,12 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     index_hash,
 					mtp, 
 					link_id,
-                    created_at)
-		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+                    created_at,
+					external_id,
+					profile_id,
+					fetch_policy,
+					fetch_auth_minutes)
+		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)
 		RETURNING id`
 
 		err = conn.QueryRow(ctx, s,
@@ -156,7 +174,7 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.SignatureProof,
 			claim.Issuer,
 			claim.MTPProof,
-			claim.Data,
+			data,
 			claim.IdentityState,
 			claim.SchemaHash,
 			claim.SchemaURL,
@@ -167,7 +185,11 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.HIndex,
 			claim.MtProof,
 			claim.LinkID,
-			claim.CreatedAt).Scan(&id)
+			claim.CreatedAt,
+			claim.ExternalID,
+			claim.ProfileID,
+			fetchPolicyType(claim.FetchPolicy.Type),
+			claim.FetchPolicy.FreshAuthMinutes).Scan(&id)
 	} else {
 		s := `INSERT INTO claims (
 					id,
@@ -191,18 +213,22 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     index_hash,
 					mtp,
 					link_id,
-                    created_at
+                    created_at,
+					external_id,
+					profile_id,
+					fetch_policy,
+					fetch_auth_minutes
 		)
 		VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26
 		)
 		ON CONFLICT ON CONSTRAINT claims_pkey 
 		DO UPDATE SET 
 			( expiration, updatable, version, rev_nonce, signature_proof, mtp_proof, data, identity_state, 
-			other_identifier, schema_hash, schema_url, schema_type, issuer, credential_status, revoked, core_claim, mtp, link_id, created_at)
+			other_identifier, schema_hash, schema_url, schema_type, issuer, credential_status, revoked, core_claim, mtp, link_id, created_at, external_id, profile_id)
 			= (EXCLUDED.expiration, EXCLUDED.updatable, EXCLUDED.version, EXCLUDED.rev_nonce, EXCLUDED.signature_proof,
 		EXCLUDED.mtp_proof, EXCLUDED.data, EXCLUDED.identity_state, EXCLUDED.other_identifier, EXCLUDED.schema_hash, 
-		EXCLUDED.schema_url, EXCLUDED.schema_type, EXCLUDED.issuer, EXCLUDED.credential_status, EXCLUDED.revoked, EXCLUDED.core_claim, EXCLUDED.mtp, EXCLUDED.link_id, EXCLUDED.created_at)
+		EXCLUDED.schema_url, EXCLUDED.schema_type, EXCLUDED.issuer, EXCLUDED.credential_status, EXCLUDED.revoked, EXCLUDED.core_claim, EXCLUDED.mtp, EXCLUDED.link_id, EXCLUDED.created_at, EXCLUDED.external_id, EXCLUDED.profile_id)
 			RETURNING id`
 		err = conn.QueryRow(ctx, s,
 			claim.ID,
@@ -215,7 +241,7 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.SignatureProof,
 			claim.Issuer,
 			claim.MTPProof,
-			claim.Data,
+			data,
 			claim.IdentityState,
 			claim.SchemaHash,
 			claim.SchemaURL,
@@ -226,7 +252,11 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.HIndex,
 			claim.MtProof,
 			claim.LinkID,
-			claim.CreatedAt).Scan(&id)
+			claim.CreatedAt,
+			claim.ExternalID,
+			claim.ProfileID,
+			fetchPolicyType(claim.FetchPolicy.Type),
+			claim.FetchPolicy.FreshAuthMinutes).Scan(&id)
 	}
 
 	if err == nil {
@@ -240,10 +270,154 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 		}
 	}
 
-	log.Errorf("error saving the claim: %v", "err", err.Error())
+	log.Errorf("error saving the claim: %v", err.Error())
 	return uuid.Nil, fmt.Errorf("error saving the claim: %w", err)
 }
 
+// SaveBatch inserts new claims sending all the statements in a single round trip. Claims without ID get a new one.
+// It fails with ErrClaimDuplication if any of them already exists, so it should run inside a transaction to
+// insert all of them or none.
+func (c *claims) SaveBatch(ctx context.Context, conn db.Querier, claims []*domain.Claim) ([]uuid.UUID, error) {
+	const s = `INSERT INTO claims (
+					id,
+                    identifier,
+                    other_identifier,
+                    expiration,
+                    updatable,
+                    version,
+					rev_nonce,
+                    signature_proof,
+                    issuer,
+                    mtp_proof,
+                    data,
+                    identity_state,
+					schema_hash,
+                    schema_url,
+                    schema_type,
+                    credential_status,
+                    revoked,
+                    core_claim,
+                    index_hash,
+					mtp,
+					link_id,
+                    created_at,
+					external_id,
+					profile_id,
+					fetch_policy,
+					fetch_auth_minutes
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26
+		)`
+	if len(claims) == 0 {
+		return nil, nil
+	}
+
+	batch := &pgx.Batch{}
+	ids := make([]uuid.UUID, len(claims))
+	for i, claim := range claims {
+		if claim.ID == uuid.Nil {
+			claim.ID = uuid.New()
+		}
+		ids[i] = claim.ID
+		setUndefinedToNull(claim)
+		data, err := c.encryptData(ctx, claim.Data)
+		if err != nil {
+			return nil, err
+		}
+		batch.Queue(s,
+			claim.ID,
+			claim.Identifier,
+			claim.OtherIdentifier,
+			claim.Expiration,
+			claim.Updatable,
+			claim.Version,
+			claim.RevNonce,
+			claim.SignatureProof,
+			claim.Issuer,
+			claim.MTPProof,
+			data,
+			claim.IdentityState,
+			claim.SchemaHash,
+			claim.SchemaURL,
+			claim.SchemaType,
+			claim.CredentialStatus,
+			claim.Revoked,
+			claim.CoreClaim,
+			claim.HIndex,
+			claim.MtProof,
+			claim.LinkID,
+			claim.CreatedAt,
+			claim.ExternalID,
+			claim.ProfileID,
+			fetchPolicyType(claim.FetchPolicy.Type),
+			claim.FetchPolicy.FreshAuthMinutes)
+	}
+
+	results := conn.SendBatch(ctx, batch)
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.Errorf("error closing the claims batch: %v", err.Error())
+		}
+	}()
+	for range claims {
+		if _, err := results.Exec(); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+				return nil, ErrClaimDuplication
+			}
+			return nil, fmt.Errorf("error saving the claims: %w", err)
+		}
+	}
+
+	return ids, nil
+}
+
+// setUndefinedToNull stores as NULL the json columns of the claim that were not set
+func setUndefinedToNull(claim *domain.Claim) {
+	if claim.MTPProof.Status == pgtype.Undefined {
+		claim.MTPProof.Status = pgtype.Null
+	}
+	if claim.Data.Status == pgtype.Undefined {
+		claim.Data.Status = pgtype.Null
+	}
+	if claim.SignatureProof.Status == pgtype.Undefined {
+		claim.SignatureProof.Status = pgtype.Null
+	}
+	if claim.CredentialStatus.Status == pgtype.Undefined {
+		claim.CredentialStatus.Status = pgtype.Null
+	}
+}
+
+// encryptData returns the data to store in the data column. It is encrypted if the repository has a cipher.
+func (c *claims) encryptData(ctx context.Context, data pgtype.JSONB) (pgtype.JSONB, error) {
+	if c.cipher == nil || data.Status != pgtype.Present {
+		return data, nil
+	}
+
+	encrypted, err := c.cipher.Encrypt(ctx, data.Bytes)
+	if err != nil {
+		return pgtype.JSONB{}, fmt.Errorf("error encrypting the claim data: %w", err)
+	}
+
+	return pgtype.JSONB{Bytes: encrypted, Status: pgtype.Present}, nil
+}
+
+// decryptData decrypts in place the data of a claim read from the database
+func (c *claims) decryptData(ctx context.Context, claim *domain.Claim) error {
+	if c.cipher == nil || claim.Data.Status != pgtype.Present {
+		return nil
+	}
+
+	data, err := c.cipher.Decrypt(ctx, claim.Data.Bytes)
+	if err != nil {
+		return fmt.Errorf("error decrypting the claim data: %w", err)
+	}
+	claim.Data.Bytes = data
+
+	return nil
+}
+
 func (c *claims) Revoke(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error {
 	_, err := conn.Exec(ctx, `INSERT INTO revocation (identifier, nonce, version, status, description) VALUES($1, $2, $3, $4, $5)`,
 		revocation.Identifier,
@@ -258,6 +432,20 @@ func (c *claims) Revoke(ctx context.Context, conn db.Querier, revocation *domain
 	return nil
 }
 
+// RevokeBatch inserts the revocations with COPY
+func (c *claims) RevokeBatch(ctx context.Context, conn db.Querier, revocations []*domain.Revocation) error {
+	rows := make([][]interface{}, len(revocations))
+	for i, r := range revocations {
+		rows[i] = []interface{}{r.Identifier, strconv.FormatUint(uint64(r.Nonce), 10), int32(r.Version), int16(r.Status), r.Description}
+	}
+	_, err := conn.CopyFrom(ctx, pgx.Identifier{"revocation"}, []string{"identifier", "nonce", "version", "status", "description"}, pgx.CopyFromRows(rows))
+	if err != nil {
+		return fmt.Errorf("error revoking the claims: %w", err)
+	}
+
+	return nil
+}
+
 func (c *claims) Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error {
 	sql := `DELETE FROM claims WHERE id = $1`
 	cmd, err := conn.Exec(ctx, sql, id.String())
@@ -336,6 +524,9 @@ func (c *claims) GetByRevocationNonce(ctx context.Context, conn db.Querier, iden
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, &claim)
 	}
 
@@ -345,6 +536,8 @@ func (c *claims) GetByRevocationNonce(ctx context.Context, conn db.Querier, iden
 	return claims, nil
 }
 
+// FindOneClaimBySchemaHash returns a non revoked claim of the schema of the subject. When there are several, i.e. the
+// auth claims while the issuer key is migrated, the newest one with a MTP proof is returned.
 func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier, subject *w3c.DID, schemaHash string) (*domain.Claim, error) {
 	var claim domain.Claim
 
@@ -371,7 +564,9 @@ func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier,
 		WHERE claims.identifier=$1  
 				AND ( claims.other_identifier = $1 or claims.other_identifier = '') 
 				AND claims.schema_hash = $2 
-				AND claims.revoked = false`, subject.String(), schemaHash)
+				AND claims.revoked = false
+		ORDER BY claims.mtp_proof IS NULL, claims.created_at DESC
+		LIMIT 1`, subject.String(), schemaHash)
 
 	err := row.Scan(&claim.ID,
 		&claim.Issuer,
@@ -395,8 +590,11 @@ func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier,
 	if err == pgx.ErrNoRows {
 		return nil, ErrClaimDoesNotExist
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &claim, err
+	return &claim, c.decryptData(ctx, &claim)
 }
 
 func (c *claims) RevokeNonce(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error {
@@ -434,7 +632,11 @@ func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifi
        				core_claim,
 					mtp,
 					revoked,
-					link_id
+					link_id,
+					external_id,
+					profile_id,
+					fetch_policy,
+					fetch_auth_minutes
         FROM claims
         WHERE claims.identifier = $1 AND claims.id = $2`, identifier.String(), claimID).Scan(
 		&claim.ID,
@@ -456,17 +658,119 @@ func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifi
 		&claim.CoreClaim,
 		&claim.MtProof,
 		&claim.Revoked,
-		&claim.LinkID)
+		&claim.LinkID,
+		&claim.ExternalID,
+		&claim.ProfileID,
+		&claim.FetchPolicy.Type,
+		&claim.FetchPolicy.FreshAuthMinutes)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil, ErrClaimDoesNotExist
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &claim, c.decryptData(ctx, &claim)
+}
+
+// GetByID get claim by id, whatever the issuer is
+func (c *claims) GetByID(ctx context.Context, conn db.Querier, claimID uuid.UUID) (*domain.Claim, error) {
+	claim := domain.Claim{}
+	err := conn.QueryRow(ctx,
+		`SELECT id,
+       				issuer,
+       				schema_hash,
+       				schema_type,
+       				schema_url,
+       				other_identifier,
+       				expiration,
+       				updatable,
+       				version,
+        			rev_nonce,
+       				signature_proof,
+       				mtp_proof,
+       				data,
+       				claims.identifier,
+        			identity_state,
+       				credential_status,
+       				core_claim,
+					mtp,
+					revoked,
+					link_id,
+					external_id,
+					profile_id,
+					fetch_policy,
+					fetch_auth_minutes
+        FROM claims
+        WHERE claims.id = $1`, claimID).Scan(
+		&claim.ID,
+		&claim.Issuer,
+		&claim.SchemaHash,
+		&claim.SchemaType,
+		&claim.SchemaURL,
+		&claim.OtherIdentifier,
+		&claim.Expiration,
+		&claim.Updatable,
+		&claim.Version,
+		&claim.RevNonce,
+		&claim.SignatureProof,
+		&claim.MTPProof,
+		&claim.Data,
+		&claim.Identifier,
+		&claim.IdentityState,
+		&claim.CredentialStatus,
+		&claim.CoreClaim,
+		&claim.MtProof,
+		&claim.Revoked,
+		&claim.LinkID,
+		&claim.ExternalID,
+		&claim.ProfileID,
+		&claim.FetchPolicy.Type,
+		&claim.FetchPolicy.FreshAuthMinutes)
+
+	if err == pgx.ErrNoRows {
+		return nil, ErrClaimDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &claim, err
+	return &claim, c.decryptData(ctx, &claim)
 }
 
 // GetAllByIssuerID returns all the claims of the given issuer
 func (c *claims) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerID w3c.DID, filter *ports.ClaimsFilter) (claims []*domain.Claim, count uint, err error) {
+	// the encrypted data cannot be queried, so the field is matched after decrypting the claims, up to
+	// maxQueryFieldClaims of them
+	if c.cipher != nil && filter.QueryField != "" {
+		if filter.Page != nil {
+			return nil, 0, ErrQueryFieldEncrypted
+		}
+		dbFilter := *filter
+		dbFilter.QueryField, dbFilter.QueryFieldValue = "", ""
+		firstPage := uint(1)
+		dbFilter.Page, dbFilter.MaxResults = &firstPage, maxQueryFieldClaims+1
+		candidates, _, err := c.GetAllByIssuerID(ctx, conn, issuerID, &dbFilter)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(candidates) > maxQueryFieldClaims {
+			return nil, 0, ErrQueryFieldTooManyClaims
+		}
+		claims = make([]*domain.Claim, 0, len(candidates))
+		for _, claim := range candidates {
+			vc, err := claim.GetVerifiableCredential()
+			if err != nil {
+				return nil, 0, err
+			}
+			if matchesQueryField(vc.CredentialSubject, filter.QueryField, filter.QueryFieldValue) {
+				claims = append(claims, claim)
+			}
+		}
+		return claims, uint(len(claims)), nil
+	}
+
 	query, countQuery, args := buildGetAllQueryAndFilters(issuerID, filter)
 
 	// Let's count all results, only if we are paginating
@@ -486,7 +790,7 @@ func (c *claims) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerID
 		return nil, 0, err
 	}
 	defer rows.Close()
-	claims, err = processClaims(rows)
+	claims, err = c.processClaims(ctx, rows)
 
 	if filter.Page == nil {
 		count = uint(len(claims))
@@ -516,7 +820,9 @@ func (c *claims) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn
 				   core_claim,
 				   revoked,
 				   mtp,
-				   claims.created_at
+				   claims.created_at,
+				   claims.external_id,
+				   claims.profile_id
 			FROM claims
 			JOIN connections ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier
 			LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
@@ -531,7 +837,7 @@ func (c *claims) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn
 
 	defer rows.Close()
 
-	return processClaims(rows)
+	return c.processClaims(ctx, rows)
 }
 
 func (c *claims) GetAllByState(ctx context.Context, conn db.Querier, did *w3c.DID, state *merkletree.Hash) (claims []domain.Claim, err error) {
@@ -618,6 +924,9 @@ func (c *claims) GetAllByState(ctx context.Context, conn db.Querier, did *w3c.DI
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, claim)
 	}
 
@@ -707,6 +1016,9 @@ func (c *claims) GetAllByStateWithMTProof(ctx context.Context, conn db.Querier,
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, claim)
 	}
 
@@ -722,7 +1034,7 @@ func (c *claims) UpdateState(ctx context.Context, conn db.Querier, claim *domain
 	return res.RowsAffected(), nil
 }
 
-func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
+func (c *claims) processClaims(ctx context.Context, rows pgx.Rows) ([]*domain.Claim, error) {
 	claims := make([]*domain.Claim, 0)
 
 	for rows.Next() {
@@ -748,10 +1060,15 @@ func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
 			&claim.Revoked,
 			&claim.MtProof,
 			&claim.CreatedAt,
+			&claim.ExternalID,
+			&claim.ProfileID,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, &claim)
 	}
 
@@ -781,6 +1098,8 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		"revoked",
 		"mtp",
 		"claims.created_at",
+		"claims.external_id",
+		"claims.profile_id",
 	}
 	query = `SELECT ##QUERYFIELDS## FROM claims
 			LEFT JOIN identity_states ON claims.identity_state = identity_states.state 
@@ -809,6 +1128,14 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		filters = append(filters, fmt.Sprintf("%%%s%%", filter.SchemaType))
 		query = fmt.Sprintf("%s and schema_type like $%d", query, len(filters))
 	}
+	if filter.ExternalID != "" {
+		filters = append(filters, filter.ExternalID)
+		query = fmt.Sprintf("%s and claims.external_id = $%d", query, len(filters))
+	}
+	if filter.ProfileID != nil {
+		filters = append(filters, *filter.ProfileID)
+		query = fmt.Sprintf("%s and claims.profile_id = $%d", query, len(filters))
+	}
 	if filter.Revoked != nil {
 		filters = append(filters, *filter.Revoked)
 		query = fmt.Sprintf("%s and claims.revoked = $%d", query, len(filters))
@@ -895,14 +1222,17 @@ func (c *claims) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier
        	core_claim,
        	revoked,
 		mtp,
-		claims.created_at
+		claims.created_at,
+		claims.external_id,
+		claims.profile_id
 	FROM claims
 	LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
 	LEFT JOIN revocation  ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
 	WHERE claims.identifier = $1 
 			AND state != $2
 			AND claims.schema_hash = $3
-			AND revocation.nonce IS NULL `
+			AND revocation.nonce IS NULL
+	ORDER BY claims.created_at DESC`
 
 	rows, err := conn.Query(ctx, query, identifier.String(), publishingState, schemaHash)
 	if err != nil {
@@ -910,7 +1240,7 @@ func (c *claims) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier
 	}
 	defer rows.Close()
 
-	claims, err := processClaims(rows)
+	claims, err := c.processClaims(ctx, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -972,6 +1302,9 @@ func (c *claims) GetClaimsOfAConnection(ctx context.Context, conn db.Querier, id
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, &claim)
 	}
 
@@ -1033,6 +1366,9 @@ func (c *claims) GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, id
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, &claim)
 	}
 	return claims, nil
@@ -1093,8 +1429,225 @@ func (c *claims) GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier,
 		if err != nil {
 			return nil, err
 		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
 		claims = append(claims, &claim)
 	}
 
 	return claims, nil
 }
+
+// GetIssuanceStatsBySubjectAndSchema returns the number of non revoked credentials of the given schema that the issuer
+// has issued to the subject and the creation time of the last one, no matter if it has been revoked.
+func (c *claims) GetIssuanceStatsBySubjectAndSchema(ctx context.Context, conn db.Querier, issuerDID w3c.DID, subject string, schemaHash string) (uint, *time.Time, error) {
+	var count uint
+	var lastCreatedAt *time.Time
+	err := conn.QueryRow(ctx,
+		`SELECT COUNT(*) FILTER (WHERE NOT claims.revoked), MAX(claims.created_at)
+		FROM claims
+		WHERE claims.issuer = $1 AND claims.other_identifier = $2 AND claims.schema_hash = $3`,
+		issuerDID.String(), subject, schemaHash).Scan(&count, &lastCreatedAt)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count, lastCreatedAt, nil
+}
+
+// GetNonRevokedBySubjectAndSchemaType returns the non revoked and non expired credentials of the given schema type
+// issued by the issuer to the subject, newest first.
+func (c *claims) GetNonRevokedBySubjectAndSchemaType(ctx context.Context, conn db.Querier, issuerDID w3c.DID, subject string, schemaType string) ([]*domain.Claim, error) {
+	rows, err := conn.Query(ctx,
+		`SELECT claims.id,
+		   issuer,
+		   schema_hash,
+		   schema_type,
+		   schema_url,
+		   other_identifier,
+		   expiration,
+		   updatable,
+		   claims.version,
+		   rev_nonce,
+		   mtp_proof,
+		   signature_proof,
+		   data,
+		   claims.identifier,
+		   identity_state,
+		   credential_status,
+		   revoked,
+		   core_claim,
+		   mtp,
+		   link_id,
+		   created_at
+		FROM claims
+		WHERE claims.issuer = $1
+		AND claims.other_identifier = $2
+		AND claims.schema_type = $3
+		AND NOT claims.revoked
+		AND (claims.expiration = 0 OR claims.expiration > $4)
+		ORDER BY claims.created_at DESC`,
+		issuerDID.String(), subject, schemaType, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return c.scanClaims(ctx, rows)
+}
+
+// LockUniqueKey takes a transaction level advisory lock on the unique key of the schema, so the credentials with the
+// same key are issued one at a time. The lock is released when the transaction of conn ends.
+func (c *claims) LockUniqueKey(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string, key map[string]any) error {
+	// json.Marshal sorts the map keys, so the same key always locks the same value
+	keyJSON, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	lock := strings.Join([]string{issuerDID.String(), schemaURL, schemaType, string(keyJSON)}, "|")
+	_, err = conn.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", lock)
+	return err
+}
+
+// GetNonRevokedByUniqueKey returns the non revoked and non expired credentials of the given schema issued by the
+// issuer whose credentialSubject contains all the values of the unique key, newest first.
+func (c *claims) GetNonRevokedByUniqueKey(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string, key map[string]any) ([]*domain.Claim, error) {
+	query := `SELECT claims.id,
+		   issuer,
+		   schema_hash,
+		   schema_type,
+		   schema_url,
+		   other_identifier,
+		   expiration,
+		   updatable,
+		   claims.version,
+		   rev_nonce,
+		   mtp_proof,
+		   signature_proof,
+		   data,
+		   claims.identifier,
+		   identity_state,
+		   credential_status,
+		   revoked,
+		   core_claim,
+		   mtp,
+		   link_id,
+		   created_at
+		FROM claims
+		WHERE claims.issuer = $1
+		AND claims.schema_url = $2
+		AND claims.schema_type = $3
+		AND NOT claims.revoked
+		AND (claims.expiration = 0 OR claims.expiration > $4)`
+	args := []interface{}{issuerDID.String(), schemaURL, schemaType, time.Now().Unix()}
+	// the encrypted data cannot be queried, so the key is only matched in the database when it is in clear
+	if c.cipher == nil {
+		keyJSON, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		query += " AND claims.data -> 'credentialSubject' @> $5::jsonb"
+		args = append(args, string(keyJSON))
+	}
+	query += " ORDER BY claims.created_at DESC"
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates, err := c.scanClaims(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	claims := make([]*domain.Claim, 0, len(candidates))
+	for _, claim := range candidates {
+		vc, err := claim.GetVerifiableCredential()
+		if err != nil {
+			return nil, err
+		}
+		if containsUniqueKey(vc.CredentialSubject, key) {
+			claims = append(claims, claim)
+		}
+	}
+
+	return claims, nil
+}
+
+// matchesQueryField returns true if the attribute of the credential subject has the given value, compared as text
+// like the ->> operator of the query_field filter does
+func matchesQueryField(credentialSubject map[string]any, field string, value string) bool {
+	attr, ok := credentialSubject[field]
+	if !ok || attr == nil {
+		return false
+	}
+	if text, ok := attr.(string); ok {
+		return text == value
+	}
+	text, err := json.Marshal(attr)
+	return err == nil && string(text) == value
+}
+
+// containsUniqueKey returns true if the credential subject has the same values as the key, compared as JSON
+func containsUniqueKey(credentialSubject map[string]any, key map[string]any) bool {
+	for attr, value := range key {
+		subjectValue, ok := credentialSubject[attr]
+		if !ok {
+			return false
+		}
+		expected, err := json.Marshal(value)
+		if err != nil {
+			return false
+		}
+		actual, err := json.Marshal(subjectValue)
+		if err != nil || string(expected) != string(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *claims) scanClaims(ctx context.Context, rows pgx.Rows) ([]*domain.Claim, error) {
+	claims := make([]*domain.Claim, 0)
+	for rows.Next() {
+		var claim domain.Claim
+		if err := rows.Scan(&claim.ID,
+			&claim.Issuer,
+			&claim.SchemaHash,
+			&claim.SchemaType,
+			&claim.SchemaURL,
+			&claim.OtherIdentifier,
+			&claim.Expiration,
+			&claim.Updatable,
+			&claim.Version,
+			&claim.RevNonce,
+			&claim.MTPProof,
+			&claim.SignatureProof,
+			&claim.Data,
+			&claim.Identifier,
+			&claim.IdentityState,
+			&claim.CredentialStatus,
+			&claim.Revoked,
+			&claim.CoreClaim,
+			&claim.MtProof,
+			&claim.LinkID,
+			&claim.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := c.decryptData(ctx, &claim); err != nil {
+			return nil, err
+		}
+		claims = append(claims, &claim)
+	}
+
+	return claims, nil
+}
+
+// fetchPolicyType returns the type of the fetch policy to store, the subject one if it is not set
+func fetchPolicyType(policyType domain.CredentialFetchPolicyType) domain.CredentialFetchPolicyType {
+	if policyType == "" {
+		return domain.CredentialFetchPolicySubject
+	}
+	return policyType
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesQueryField(t *testing.T) {
+	var credentialSubject map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "Alice", "birthday": 19960424, "verified": true, "address": null}`), &credentialSubject))
+
+	for _, tc := range []struct {
+		field    string
+		value    string
+		expected bool
+	}{
+		{field: "name", value: "Alice", expected: true},
+		{field: "name", value: "alice", expected: false},
+		{field: "birthday", value: "19960424", expected: true},
+		{field: "birthday", value: "19960425", expected: false},
+		{field: "verified", value: "true", expected: true},
+		{field: "address", value: "", expected: false},
+		{field: "unknown", value: "Alice", expected: false},
+	} {
+		t.Run(tc.field+"="+tc.value, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchesQueryField(credentialSubject, tc.field, tc.value))
+		})
+	}
+}
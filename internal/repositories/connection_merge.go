@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type connectionMerge struct{}
+
+// NewConnectionMerge returns a new connection merges repository
+func NewConnectionMerge() ports.ConnectionMergeRepository {
+	return &connectionMerge{}
+}
+
+// Save stores a new connection merge
+func (r *connectionMerge) Save(ctx context.Context, conn db.Querier, merge *domain.ConnectionMerge) error {
+	_, err := conn.Exec(ctx, `INSERT INTO connection_merges (id, issuer_id, source_connection_id, source_user_id, target_connection_id, credentials, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		merge.ID, merge.IssuerDID.String(), merge.SourceConnectionID, merge.SourceUserDID, merge.TargetConnectionID, merge.Credentials, merge.CreatedAt)
+	return err
+}
+
+// GetByTargetConnectionID returns the merges into the connection, the newest first
+func (r *connectionMerge) GetByTargetConnectionID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connectionID uuid.UUID) ([]domain.ConnectionMerge, error) {
+	rows, err := conn.Query(ctx, `SELECT id, source_connection_id, source_user_id, target_connection_id, credentials, created_at
+		FROM connection_merges
+		WHERE issuer_id = $1 AND target_connection_id = $2
+		ORDER BY created_at DESC`, issuerDID.String(), connectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	merges := make([]domain.ConnectionMerge, 0)
+	for rows.Next() {
+		merge := domain.ConnectionMerge{IssuerDID: issuerDID}
+		if err := rows.Scan(&merge.ID, &merge.SourceConnectionID, &merge.SourceUserDID, &merge.TargetConnectionID, &merge.Credentials, &merge.CreatedAt); err != nil {
+			return nil, err
+		}
+		merges = append(merges, merge)
+	}
+	return merges, rows.Err()
+}
+
+// MoveToTarget moves the merges into a connection to another one, so the history of a merged connection is kept
+func (r *connectionMerge) MoveToTarget(ctx context.Context, conn db.Querier, fromConnectionID uuid.UUID, toConnectionID uuid.UUID) error {
+	_, err := conn.Exec(ctx, `UPDATE connection_merges SET target_connection_id = $2 WHERE target_connection_id = $1`, fromConnectionID, toConnectionID)
+	return err
+}
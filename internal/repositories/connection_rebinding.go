@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrConnectionRebindingDoesNotExist connection rebinding does not exist
+var ErrConnectionRebindingDoesNotExist = errors.New("connection rebinding does not exist")
+
+const connectionRebindingSelect = `SELECT id, issuer_id, connection_id, code_hash, session_id, new_connection_id, expires_at, used_at, created_at
+FROM connection_rebindings`
+
+type connectionRebinding struct{}
+
+// NewConnectionRebinding returns a new connection rebindings repository
+func NewConnectionRebinding() ports.ConnectionRebindingRepository {
+	return &connectionRebinding{}
+}
+
+// Save stores a new connection rebinding
+func (r *connectionRebinding) Save(ctx context.Context, conn db.Querier, rebinding *domain.ConnectionRebinding) error {
+	_, err := conn.Exec(ctx, `INSERT INTO connection_rebindings (id, issuer_id, connection_id, code_hash, session_id, new_connection_id, expires_at, used_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		rebinding.ID, rebinding.IssuerDID.String(), rebinding.ConnectionID, rebinding.CodeHash, rebinding.SessionID,
+		rebinding.NewConnectionID, rebinding.ExpiresAt, rebinding.UsedAt, rebinding.CreatedAt)
+	return err
+}
+
+// GetByCodeHash returns the rebinding of the code with the given hash
+func (r *connectionRebinding) GetByCodeHash(ctx context.Context, conn db.Querier, codeHash string) (*domain.ConnectionRebinding, error) {
+	return scanConnectionRebinding(conn.QueryRow(ctx, connectionRebindingSelect+` WHERE code_hash = $1`, codeHash))
+}
+
+// GetBySessionID returns the rebinding redeemed in the authentication session
+func (r *connectionRebinding) GetBySessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.ConnectionRebinding, error) {
+	return scanConnectionRebinding(conn.QueryRow(ctx, connectionRebindingSelect+` WHERE session_id = $1`, sessionID))
+}
+
+// GetBySessionIDForUpdate returns the rebinding redeemed in the authentication session and locks it until the
+// transaction ends, so it is completed once. conn must be a transaction.
+func (r *connectionRebinding) GetBySessionIDForUpdate(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.ConnectionRebinding, error) {
+	return scanConnectionRebinding(conn.QueryRow(ctx, connectionRebindingSelect+` WHERE session_id = $1 FOR UPDATE`, sessionID))
+}
+
+// SetSession stores the authentication session started with an active code
+func (r *connectionRebinding) SetSession(ctx context.Context, conn db.Querier, id uuid.UUID, sessionID uuid.UUID) error {
+	res, err := conn.Exec(ctx, `UPDATE connection_rebindings SET session_id = $2 WHERE id = $1 AND used_at IS NULL`, id, sessionID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrConnectionRebindingDoesNotExist
+	}
+	return nil
+}
+
+// MarkUsed marks the code as used by the rebinding to the new connection
+func (r *connectionRebinding) MarkUsed(ctx context.Context, conn db.Querier, id uuid.UUID, newConnectionID uuid.UUID, usedAt time.Time) error {
+	res, err := conn.Exec(ctx, `UPDATE connection_rebindings SET new_connection_id = $2, used_at = $3 WHERE id = $1 AND used_at IS NULL`, id, newConnectionID, usedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrConnectionRebindingDoesNotExist
+	}
+	return nil
+}
+
+func scanConnectionRebinding(row pgx.Row) (*domain.ConnectionRebinding, error) {
+	var rebinding domain.ConnectionRebinding
+	var issuerID string
+	err := row.Scan(&rebinding.ID, &issuerID, &rebinding.ConnectionID, &rebinding.CodeHash, &rebinding.SessionID,
+		&rebinding.NewConnectionID, &rebinding.ExpiresAt, &rebinding.UsedAt, &rebinding.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConnectionRebindingDoesNotExist
+		}
+		return nil, err
+	}
+	issuerDID, err := w3c.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	rebinding.IssuerDID = *issuerDID
+	return &rebinding, nil
+}
@@ -15,19 +15,24 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
 )
 
 // ErrConnectionDoesNotExist connection does not exist
 var ErrConnectionDoesNotExist = errors.New("connection does not exist")
 
 type dbConnection struct {
-	ID         uuid.UUID
-	IssuerDID  string
-	UserDID    string
-	IssuerDoc  pgtype.JSONB
-	UserDoc    pgtype.JSONB
-	CreatedAt  time.Time
-	ModifiedAt time.Time
+	ID             uuid.UUID
+	IssuerDID      string
+	UserDID        string
+	IssuerDoc      pgtype.JSONB
+	UserDoc        pgtype.JSONB
+	CreatedAt      time.Time
+	ModifiedAt     time.Time
+	LastVerifiedAt *time.Time
+	DisplayName    *string
+	ExternalID     *string
+	Email          *string
 }
 
 type dbConnectionWithCredentials struct {
@@ -45,11 +50,13 @@ func NewConnections() ports.ConnectionsRepository {
 // Save stores in the database the given connection and updates the modified at in case already exists
 func (c *connections) Save(ctx context.Context, conn db.Querier, connection *domain.Connection) (uuid.UUID, error) {
 	var id uuid.UUID
-	sql := `INSERT INTO connections (id,issuer_id, user_id, issuer_doc, user_doc,created_at,modified_at)
-			VALUES($1, $2, $3, $4,$5,$6,$7) ON CONFLICT ON CONSTRAINT connections_issuer_user_key DO
-			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7
+	sql := `INSERT INTO connections (id,issuer_id, user_id, issuer_doc, user_doc,created_at,modified_at,last_verified_at,display_name,external_id,email,tenant_id)
+			VALUES($1, $2, $3, $4,$5,$6,$7,$8,$9,$10,$11,` + tenantOf("$2") + `) ON CONFLICT ON CONSTRAINT connections_issuer_user_key DO
+			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7, last_verified_at = COALESCE($8, connections.last_verified_at),
+			display_name = COALESCE(NULLIF($9, ''), connections.display_name), external_id = COALESCE(NULLIF($10, ''), connections.external_id), email = COALESCE(NULLIF($11, ''), connections.email)
 			RETURNING id`
-	err := conn.QueryRow(ctx, sql, connection.ID, connection.IssuerDID.String(), connection.UserDID.String(), connection.IssuerDoc, connection.UserDoc, connection.CreatedAt, connection.ModifiedAt).Scan(&id)
+	err := conn.QueryRow(ctx, sql, connection.ID, connection.IssuerDID.String(), connection.UserDID.String(), connection.IssuerDoc, connection.UserDoc, connection.CreatedAt, connection.ModifiedAt, connection.LastVerifiedAt,
+		connection.Profile.DisplayName, connection.Profile.ExternalID, connection.Profile.Email).Scan(&id)
 
 	return id, err
 }
@@ -62,9 +69,165 @@ func (c *connections) SaveUserAuthentication(ctx context.Context, conn db.Querie
 	return err
 }
 
+// MoveUserAuthentications moves the authentications of a connection to another one. The authentications of a session
+// already in the target connection are kept.
+func (c *connections) MoveUserAuthentications(ctx context.Context, conn db.Querier, fromConnID uuid.UUID, toConnID uuid.UUID) error {
+	sql := `UPDATE user_authentications SET connection_id = $2 WHERE connection_id = $1
+			AND session_id NOT IN (SELECT session_id FROM user_authentications WHERE connection_id = $2)`
+	if _, err := conn.Exec(ctx, sql, fromConnID.String(), toConnID.String()); err != nil {
+		return err
+	}
+	_, err := conn.Exec(ctx, `DELETE FROM user_authentications WHERE connection_id = $1`, fromConnID.String())
+	return err
+}
+
+// UpdateProfile sets the profile fields that are not nil. Empty values remove the field.
+func (c *connections) UpdateProfile(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID, profile domain.ConnectionProfile) error {
+	sqlArgs := []interface{}{id.String(), issuerDID.String(), tenancy.FromContext(ctx)}
+	sets := []string{"modified_at = NOW()"}
+	for column, value := range map[string]*string{"display_name": profile.DisplayName, "external_id": profile.ExternalID, "email": profile.Email} {
+		if value != nil {
+			sqlArgs = append(sqlArgs, *value)
+			sets = append(sets, fmt.Sprintf("%s = NULLIF($%d, '')", column, len(sqlArgs)))
+		}
+	}
+	sql := fmt.Sprintf(`UPDATE connections SET %s WHERE id = $1 AND issuer_id = $2 AND %s`, strings.Join(sets, ", "), tenantScope("connections", "$3"))
+	cmd, err := conn.Exec(ctx, sql, sqlArgs...)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+	return nil
+}
+
+// MergeProfile fills the profile fields missing in a connection with the ones of another connection, and keeps the
+// oldest creation date and the latest verification of both
+func (c *connections) MergeProfile(ctx context.Context, conn db.Querier, fromConnID uuid.UUID, toConnID uuid.UUID) error {
+	sql := `UPDATE connections SET display_name = COALESCE(connections.display_name, source.display_name),
+			external_id = COALESCE(connections.external_id, source.external_id), email = COALESCE(connections.email, source.email),
+			created_at = LEAST(connections.created_at, source.created_at),
+			last_verified_at = GREATEST(connections.last_verified_at, source.last_verified_at), modified_at = NOW()
+			FROM connections AS source
+			WHERE connections.id = $2 AND source.id = $1 AND source.issuer_id = connections.issuer_id`
+	cmd, err := conn.Exec(ctx, sql, fromConnID.String(), toConnID.String())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+	return nil
+}
+
+// GetDuplicates returns the groups of connections of the issuer with the same external id or email, ignoring the
+// case of the email
+func (c *connections) GetDuplicates(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.ConnectionDuplicates, error) {
+	sql := `SELECT $3::text AS reason, external_id AS value, array_agg(id::text ORDER BY created_at), array_agg(user_id ORDER BY created_at)
+			FROM connections
+			WHERE issuer_id = $1 AND external_id IS NOT NULL AND ` + tenantScope("connections", "$2") + `
+			GROUP BY external_id HAVING COUNT(*) > 1
+			UNION ALL
+			SELECT $4::text AS reason, lower(email) AS value, array_agg(id::text ORDER BY created_at), array_agg(user_id ORDER BY created_at)
+			FROM connections
+			WHERE issuer_id = $1 AND email IS NOT NULL AND ` + tenantScope("connections", "$2") + `
+			GROUP BY lower(email) HAVING COUNT(*) > 1
+			ORDER BY reason, value`
+	rows, err := conn.Query(ctx, sql, issuerDID.String(), tenancy.FromContext(ctx), domain.ConnectionDuplicateExternalID, domain.ConnectionDuplicateEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	duplicates := make([]domain.ConnectionDuplicates, 0)
+	for rows.Next() {
+		var group domain.ConnectionDuplicates
+		var ids []string
+		if err := rows.Scan(&group.Reason, &group.Value, &ids, &group.UserDIDs); err != nil {
+			return nil, err
+		}
+		group.ConnectionIDs = make([]uuid.UUID, 0, len(ids))
+		for _, id := range ids {
+			connID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, err
+			}
+			group.ConnectionIDs = append(group.ConnectionIDs, connID)
+		}
+		duplicates = append(duplicates, group)
+	}
+	return duplicates, rows.Err()
+}
+
+// GetDead returns the connections of the issuer without credentials that are neither revoked nor expired, and without
+// activity since the given time, the least recently active first. The activity of a connection is the latest of its
+// modification, its verification, the authentications of the holder and the last message of their wallet.
+func (c *connections) GetDead(ctx context.Context, conn db.Querier, issuerDID w3c.DID, inactiveSince time.Time, limit int) ([]domain.DeadConnection, error) {
+	return c.getDead(ctx, conn, issuerDID, inactiveSince, limit, "")
+}
+
+// GetDeadForUpdate returns the dead connections like GetDead and locks them until the transaction ends, skipping the
+// ones locked by another transaction. conn must be a transaction.
+func (c *connections) GetDeadForUpdate(ctx context.Context, conn db.Querier, issuerDID w3c.DID, inactiveSince time.Time, limit int) ([]domain.DeadConnection, error) {
+	return c.getDead(ctx, conn, issuerDID, inactiveSince, limit, " FOR UPDATE OF connections SKIP LOCKED")
+}
+
+func (c *connections) getDead(ctx context.Context, conn db.Querier, issuerDID w3c.DID, inactiveSince time.Time, limit int, lock string) ([]domain.DeadConnection, error) {
+	sql := `SELECT connections.id, connections.user_id, activity.last_activity_at,
+			(SELECT COUNT(*) FROM claims WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id)
+			FROM connections
+			CROSS JOIN LATERAL (SELECT GREATEST(connections.modified_at, connections.last_verified_at,
+				(SELECT MAX(user_authentications.created_at) FROM user_authentications WHERE user_authentications.connection_id = connections.id),
+				(SELECT wallet_profiles.last_seen_at FROM wallet_profiles WHERE wallet_profiles.issuer_id = connections.issuer_id AND wallet_profiles.user_id = connections.user_id)
+			) AS last_activity_at) AS activity
+			WHERE connections.issuer_id = $1 AND ` + tenantScope("connections", "$2") + `
+			AND activity.last_activity_at < $3
+			AND NOT EXISTS (SELECT 1 FROM claims WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id
+				AND NOT claims.revoked AND (claims.expiration = 0 OR claims.expiration > $4))
+			ORDER BY activity.last_activity_at
+			LIMIT $5` + lock
+	rows, err := conn.Query(ctx, sql, issuerDID.String(), tenancy.FromContext(ctx), inactiveSince, time.Now().Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dead := make([]domain.DeadConnection, 0)
+	for rows.Next() {
+		var connection domain.DeadConnection
+		if err := rows.Scan(&connection.ID, &connection.UserDID, &connection.LastActivityAt, &connection.Credentials); err != nil {
+			return nil, err
+		}
+		dead = append(dead, connection)
+	}
+	return dead, rows.Err()
+}
+
+// Archive copies the connections to the archived_connections table and removes them with their authentications.
+// Their credentials are kept.
+func (c *connections) Archive(ctx context.Context, conn db.Querier, ids []uuid.UUID) error {
+	sql := `INSERT INTO archived_connections (id, issuer_id, user_id, issuer_doc, user_doc, created_at, modified_at, last_verified_at, display_name, external_id, email, tenant_id, archived_at)
+			SELECT id, issuer_id, user_id, issuer_doc, user_doc, created_at, modified_at, last_verified_at, display_name, external_id, email, tenant_id, NOW()
+			FROM connections WHERE id = ANY($1::uuid[])`
+	if _, err := conn.Exec(ctx, sql, uuidStrings(ids)); err != nil {
+		return err
+	}
+	return c.DeleteMany(ctx, conn, ids)
+}
+
+// DeleteMany removes the connections with their authentications. Their credentials are kept.
+func (c *connections) DeleteMany(ctx context.Context, conn db.Querier, ids []uuid.UUID) error {
+	if _, err := conn.Exec(ctx, `DELETE FROM user_authentications WHERE connection_id = ANY($1::uuid[])`, uuidStrings(ids)); err != nil {
+		return err
+	}
+	_, err := conn.Exec(ctx, `DELETE FROM connections WHERE id = ANY($1::uuid[])`, uuidStrings(ids))
+	return err
+}
+
 func (c *connections) Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) error {
-	sql := `DELETE FROM connections WHERE id = $1 AND issuer_id = $2`
-	cmd, err := conn.Exec(ctx, sql, id.String(), issuerDID.String())
+	sql := `DELETE FROM connections WHERE id = $1 AND issuer_id = $2 AND ` + tenantScope("connections", "$3")
+	cmd, err := conn.Exec(ctx, sql, id.String(), issuerDID.String(), tenancy.FromContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -77,8 +240,8 @@ func (c *connections) Delete(ctx context.Context, conn db.Querier, id uuid.UUID,
 }
 
 func (c *connections) DeleteCredentials(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID w3c.DID) error {
-	sql := `DELETE FROM claims USING connections WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id AND connections.id = $1 AND connections.issuer_id = $2`
-	_, err := conn.Exec(ctx, sql, id.String(), issuerID.String())
+	sql := `DELETE FROM claims USING connections WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id AND connections.id = $1 AND connections.issuer_id = $2 AND ` + tenantScope("connections", "$3")
+	_, err := conn.Exec(ctx, sql, id.String(), issuerID.String(), tenancy.FromContext(ctx))
 
 	return err
 }
@@ -86,9 +249,9 @@ func (c *connections) DeleteCredentials(ctx context.Context, conn db.Querier, id
 func (c *connections) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at,last_verified_at,display_name,external_id,email 
 				FROM connections 
-				WHERE connections.id = $1 AND connections.issuer_id = $2`, id.String(), issuerID.String()).Scan(
+				WHERE connections.id = $1 AND connections.issuer_id = $2 AND `+tenantScope("connections", "$3"), id.String(), issuerID.String(), tenancy.FromContext(ctx)).Scan(
 		&connection.ID,
 		&connection.IssuerDID,
 		&connection.UserDID,
@@ -96,6 +259,10 @@ func (c *connections) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, i
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.LastVerifiedAt,
+		&connection.DisplayName,
+		&connection.ExternalID,
+		&connection.Email,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -110,7 +277,7 @@ func (c *connections) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, i
 func (c *connections) GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at 
+		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at,connections.last_verified_at,connections.display_name,connections.external_id,connections.email 
 				FROM connections 
 				JOIN user_authentications ON connections.id = user_authentications.connection_id
 				WHERE user_authentications.session_id = $1`, sessionID.String()).Scan(
@@ -121,6 +288,10 @@ func (c *connections) GetByUserSessionID(ctx context.Context, conn db.Querier, s
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.LastVerifiedAt,
+		&connection.DisplayName,
+		&connection.ExternalID,
+		&connection.Email,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -135,9 +306,9 @@ func (c *connections) GetByUserSessionID(ctx context.Context, conn db.Querier, s
 func (c *connections) GetByUserID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at,last_verified_at,display_name,external_id,email 
 				FROM connections 
-				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2`, issuerDID.String(), userDID.String()).Scan(
+				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2 AND `+tenantScope("connections", "$3"), issuerDID.String(), userDID.String(), tenancy.FromContext(ctx)).Scan(
 		&connection.ID,
 		&connection.IssuerDID,
 		&connection.UserDID,
@@ -145,6 +316,10 @@ func (c *connections) GetByUserID(ctx context.Context, conn db.Querier, issuerDI
 		&connection.UserDoc,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
+		&connection.LastVerifiedAt,
+		&connection.DisplayName,
+		&connection.ExternalID,
+		&connection.Email,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -158,7 +333,7 @@ func (c *connections) GetByUserID(ctx context.Context, conn db.Querier, issuerDI
 
 func (c *connections) GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *ports.NewGetAllConnectionsRequest) ([]domain.Connection, uint, error) {
 	var count uint
-	sqlQuery, countQuery, filters := buildGetAllWithCredentialsQueryAndFilters(issuerDID, filter)
+	sqlQuery, countQuery, filters := buildGetAllWithCredentialsQueryAndFilters(issuerDID, tenancy.FromContext(ctx), filter)
 
 	if err := conn.QueryRow(ctx, countQuery, filters...).Scan(&count); err != nil {
 		return nil, 0, err
@@ -179,7 +354,7 @@ func (c *connections) GetAllWithCredentialsByIssuerID(ctx context.Context, conn
 	return conns, count, err
 }
 
-func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.NewGetAllConnectionsRequest) (string, string, []interface{}) {
+func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, tenantID *uuid.UUID, filter *ports.NewGetAllConnectionsRequest) (string, string, []interface{}) {
 	fields := []string{
 		"connections.id",
 		"connections.issuer_id",
@@ -188,18 +363,31 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 		"connections.user_doc",
 		"connections.created_at",
 		"connections.modified_at",
+		"connections.last_verified_at",
+		"connections.display_name",
+		"connections.external_id",
+		"connections.email",
 	}
 
 	sqlQuery := `SELECT ##QUERYFIELDS## FROM connections`
 
-	sqlArgs := []interface{}{issuerDID.String()}
-	sqlQuery = fmt.Sprintf("%s WHERE connections.issuer_id = $%d", sqlQuery, len(sqlArgs))
+	sqlArgs := []interface{}{issuerDID.String(), tenantID}
+	sqlQuery = fmt.Sprintf("%s WHERE connections.issuer_id = $1 AND %s", sqlQuery, tenantScope("connections", "$2"))
 
 	if filter.Query != "" {
 		terms := tokenizeQuery(filter.Query)
 		if len(terms) > 0 {
-			ftsConds := buildPartialQueryDidLikes("connections.user_id", terms, "OR")
-			sqlQuery += fmt.Sprintf(" AND (%s) ", ftsConds)
+			var ftsConds []string
+			if didConds := buildPartialQueryDidLikes("connections.user_id", terms, "OR"); didConds != "" {
+				ftsConds = append(ftsConds, didConds)
+			}
+			for _, field := range []string{"connections.display_name", "connections.external_id", "connections.email"} {
+				ftsConds = append(ftsConds, buildPartialQueryLikes(field, "OR", len(sqlArgs)+1, len(terms)))
+			}
+			for _, term := range terms {
+				sqlArgs = append(sqlArgs, term)
+			}
+			sqlQuery += fmt.Sprintf(" AND (%s) ", strings.Join(ftsConds, " OR "))
 		}
 	}
 
@@ -225,7 +413,11 @@ func toConnectionsWithCredentials(rows pgx.Rows) ([]domain.Connection, error) {
 			&dbConn.IssuerDoc,
 			&dbConn.UserDoc,
 			&dbConn.dbConnection.CreatedAt,
-			&dbConn.ModifiedAt)
+			&dbConn.ModifiedAt,
+			&dbConn.LastVerifiedAt,
+			&dbConn.DisplayName,
+			&dbConn.ExternalID,
+			&dbConn.Email)
 		if err != nil {
 			return nil, err
 		}
@@ -250,11 +442,17 @@ func toConnectionDomain(c *dbConnection) (*domain.Connection, error) {
 	}
 
 	conn := &domain.Connection{
-		ID:         c.ID,
-		IssuerDID:  *issID,
-		UserDID:    *usrDID,
-		CreatedAt:  c.CreatedAt,
-		ModifiedAt: c.ModifiedAt,
+		ID:             c.ID,
+		IssuerDID:      *issID,
+		UserDID:        *usrDID,
+		CreatedAt:      c.CreatedAt,
+		ModifiedAt:     c.ModifiedAt,
+		LastVerifiedAt: c.LastVerifiedAt,
+		Profile: domain.ConnectionProfile{
+			DisplayName: c.DisplayName,
+			ExternalID:  c.ExternalID,
+			Email:       c.Email,
+		},
 	}
 
 	if err := c.UserDoc.AssignTo(&conn.UserDoc); err != nil {
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// maxCredentialDependencyDepth bounds the walk of the dependencies, they cannot have cycles since a credential only
+// depends on older ones
+const maxCredentialDependencyDepth = 32
+
+type credentialDependency struct{}
+
+// NewCredentialDependency returns a new credential dependencies repository
+func NewCredentialDependency() ports.CredentialDependencyRepository {
+	return &credentialDependency{}
+}
+
+// SaveForPrerequisites stores the dependencies of a credential issued by a link on the active credentials of the
+// holder, issued by the same issuer, that match the schema and the type of the link prerequisites
+func (r *credentialDependency) SaveForPrerequisites(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID, userDID w3c.DID, prerequisites []domain.LinkPrerequisite) error {
+	for _, prerequisite := range prerequisites {
+		if !prerequisite.AcceptsIssuer(issuerDID) {
+			continue
+		}
+		_, err := conn.Exec(ctx, `INSERT INTO credential_dependencies (issuer_id, credential_id, prerequisite_id)
+			SELECT $1, $2, claims.id
+			FROM claims
+			WHERE claims.identifier = $1 AND claims.other_identifier = $3 AND claims.schema_url = $4 AND claims.schema_type = $5
+				AND claims.revoked = false AND claims.id <> $2
+			ON CONFLICT DO NOTHING`,
+			issuerDID.String(), credentialID, userDID.String(), prerequisite.SchemaURL, prerequisite.CredentialType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDependents returns the active credentials that depend on the credential, directly or through other dependents,
+// the closest first. The revoked dependents are walked through but not returned.
+func (r *credentialDependency) GetDependents(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialDependent, error) {
+	rows, err := conn.Query(ctx, `WITH RECURSIVE dependents AS (
+			SELECT credential_id, prerequisite_id, 1 AS depth
+			FROM credential_dependencies
+			WHERE issuer_id = $1 AND prerequisite_id = $2
+			UNION
+			SELECT d.credential_id, d.prerequisite_id, dependents.depth + 1
+			FROM credential_dependencies d
+			JOIN dependents ON d.prerequisite_id = dependents.credential_id
+			WHERE d.issuer_id = $1 AND dependents.depth < $3
+		)
+		SELECT id, prerequisite_id, schema_type, rev_nonce, depth FROM (
+			SELECT DISTINCT ON (claims.id) claims.id, dependents.prerequisite_id, claims.schema_type, claims.rev_nonce, dependents.depth
+			FROM dependents
+			JOIN claims ON claims.id = dependents.credential_id AND claims.identifier = $1
+			WHERE claims.revoked = false
+			ORDER BY claims.id, dependents.depth
+		) AS active
+		ORDER BY depth, id`, issuerDID.String(), credentialID, maxCredentialDependencyDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dependents := make([]domain.CredentialDependent, 0)
+	for rows.Next() {
+		var dependent domain.CredentialDependent
+		if err := rows.Scan(&dependent.ID, &dependent.PrerequisiteID, &dependent.SchemaType, &dependent.RevNonce, &dependent.Depth); err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, dependent)
+	}
+	return dependents, rows.Err()
+}
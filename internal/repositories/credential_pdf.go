@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrCredentialPDFTemplateDoesNotExist the schema has no credential pdf template
+var ErrCredentialPDFTemplateDoesNotExist = errors.New("credential pdf template does not exist")
+
+const credentialPDFTemplateColumns = `t.issuer_id, t.schema_id, t.title, t.header, t.footer, t.accent_color, t.fields, t.modified_at`
+
+type credentialPDFTemplate struct{}
+
+// NewCredentialPDFTemplate returns a new credential pdf template repository
+func NewCredentialPDFTemplate() ports.CredentialPDFTemplateRepository {
+	return &credentialPDFTemplate{}
+}
+
+// Save stores the template of a schema, replacing the previous one if it exists
+func (c *credentialPDFTemplate) Save(ctx context.Context, conn db.Querier, template *domain.CredentialPDFTemplate) error {
+	fields := pgtype.JSONB{}
+	if template.Fields == nil {
+		template.Fields = []domain.CredentialPDFField{}
+	}
+	if err := fields.Set(template.Fields); err != nil {
+		return err
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO credential_pdf_templates (issuer_id, schema_id, title, header, footer, accent_color, fields, modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (issuer_id, schema_id) DO
+		UPDATE SET title = $3, header = $4, footer = $5, accent_color = $6, fields = $7, modified_at = $8`,
+		template.IssuerDID.String(), template.SchemaID, template.Title, template.Header, template.Footer, template.AccentColor, fields, template.ModifiedAt)
+	return err
+}
+
+// GetBySchemaID returns the template of a schema
+func (c *credentialPDFTemplate) GetBySchemaID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID) (*domain.CredentialPDFTemplate, error) {
+	return scanCredentialPDFTemplate(conn.QueryRow(ctx, `SELECT `+credentialPDFTemplateColumns+` FROM credential_pdf_templates t
+		WHERE t.issuer_id = $1 AND t.schema_id = $2`, issuerDID.String(), schemaID))
+}
+
+// GetBySchema returns the template of the imported schema with the given url and type, the ones of the credentials
+func (c *credentialPDFTemplate) GetBySchema(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaURL string, schemaType string) (*domain.CredentialPDFTemplate, error) {
+	return scanCredentialPDFTemplate(conn.QueryRow(ctx, `SELECT `+credentialPDFTemplateColumns+` FROM credential_pdf_templates t
+		JOIN schemas s ON s.id = t.schema_id
+		WHERE t.issuer_id = $1 AND s.url = $2 AND s.type = $3`, issuerDID.String(), schemaURL, schemaType))
+}
+
+// Delete removes the template of a schema
+func (c *credentialPDFTemplate) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID) error {
+	cmd, err := conn.Exec(ctx, `DELETE FROM credential_pdf_templates WHERE issuer_id = $1 AND schema_id = $2`, issuerDID.String(), schemaID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrCredentialPDFTemplateDoesNotExist
+	}
+	return nil
+}
+
+func scanCredentialPDFTemplate(row pgx.Row) (*domain.CredentialPDFTemplate, error) {
+	var template domain.CredentialPDFTemplate
+	var issuerDID string
+	var fields pgtype.JSONB
+	err := row.Scan(&issuerDID, &template.SchemaID, &template.Title, &template.Header, &template.Footer, &template.AccentColor, &fields, &template.ModifiedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialPDFTemplateDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	template.IssuerDID = *did
+	if err := fields.AssignTo(&template.Fields); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type credentialVersion struct {
+	cipher ports.DataCipher
+}
+
+// NewCredentialVersion returns a new credential versions repository
+func NewCredentialVersion() ports.CredentialVersionRepository {
+	return &credentialVersion{}
+}
+
+// NewCredentialVersionWithCipher returns a new credential versions repository that encrypts the changes of the
+// credential subject at rest with the given cipher, the same as the claims data. A nil cipher stores them in clear.
+func NewCredentialVersionWithCipher(cipher ports.DataCipher) ports.CredentialVersionRepository {
+	return &credentialVersion{cipher: cipher}
+}
+
+// Save appends the version to the lineage of the previous credential and sets its lineage and number. The previous
+// credential starts a new lineage when it has no version. The lineage is locked until the transaction ends, so the
+// versions superseding credentials of the same lineage get consecutive numbers. conn must be a transaction.
+func (r *credentialVersion) Save(ctx context.Context, conn db.Querier, version *domain.CredentialVersion) error {
+	changes, err := json.Marshal(version.Changes)
+	if err != nil {
+		return err
+	}
+	if r.cipher != nil {
+		if changes, err = r.cipher.Encrypt(ctx, changes); err != nil {
+			return fmt.Errorf("error encrypting the credential version changes: %w", err)
+		}
+	}
+	err = conn.QueryRow(ctx, `SELECT COALESCE((SELECT lineage_id FROM credential_versions WHERE credential_id = $1), $1)`, version.PreviousCredentialID).
+		Scan(&version.LineageID)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", "credential-versions|"+version.LineageID.String()); err != nil {
+		return err
+	}
+	sql := `INSERT INTO credential_versions (id, issuer_id, lineage_id, credential_id, previous_credential_id, version, changes, created_at)
+		SELECT $1, $2, $3, $4, $5, COALESCE(MAX(version), 1) + 1, $6, $7 FROM credential_versions WHERE lineage_id = $3
+		RETURNING version`
+	return conn.QueryRow(ctx, sql, version.ID, version.IssuerDID.String(), version.LineageID, version.CredentialID, version.PreviousCredentialID, changes, version.CreatedAt).
+		Scan(&version.Version)
+}
+
+// GetLineage returns the versions of the lineage of the credential, the oldest first
+func (r *credentialVersion) GetLineage(ctx context.Context, conn db.Querier, issuerDID w3c.DID, credentialID uuid.UUID) ([]domain.CredentialVersion, error) {
+	sql := `SELECT id, lineage_id, credential_id, previous_credential_id, version, changes, created_at
+		FROM credential_versions
+		WHERE issuer_id = $1 AND lineage_id = COALESCE((SELECT lineage_id FROM credential_versions WHERE credential_id = $2), $2)
+		ORDER BY version`
+	rows, err := conn.Query(ctx, sql, issuerDID.String(), credentialID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := make([]domain.CredentialVersion, 0)
+	for rows.Next() {
+		version := domain.CredentialVersion{IssuerDID: issuerDID}
+		var changes []byte
+		if err := rows.Scan(&version.ID, &version.LineageID, &version.CredentialID, &version.PreviousCredentialID, &version.Version, &changes, &version.CreatedAt); err != nil {
+			return nil, err
+		}
+		if r.cipher != nil {
+			if changes, err = r.cipher.Decrypt(ctx, changes); err != nil {
+				return nil, fmt.Errorf("error decrypting the credential version changes: %w", err)
+			}
+		}
+		if err := json.Unmarshal(changes, &version.Changes); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, rows.Err()
+}
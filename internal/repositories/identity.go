@@ -2,15 +2,26 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
+const identityServerURLIndex = "identities_server_url_index"
+
+var (
+	ErrIdentityDoesNotExist   = errors.New("identity does not exist")                          // ErrIdentityDoesNotExist identity does not exist
+	ErrIdentityServerURLInUse = errors.New("the server url is used by other identity already") // ErrIdentityServerURLInUse other identity is reached at the server url
+)
+
 type identity struct{}
 
 // NewIdentity TODO
@@ -20,10 +31,45 @@ func NewIdentity() ports.IndentityRepository {
 
 // Save - Create new identity
 func (i *identity) Save(ctx context.Context, conn db.Querier, identity *domain.Identity) error {
-	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType) VALUES ($1, $2, $3)`, identity.Identifier, identity.Address, identity.KeyType)
+	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType, tenant_id, server_url) VALUES ($1, $2, $3, $4, $5)`, identity.Identifier, identity.Address, identity.KeyType, identity.TenantID, identity.ServerURL)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode && pgErr.ConstraintName == identityServerURLIndex {
+			return ErrIdentityServerURLInUse
+		}
+	}
 	return err
 }
 
+// UpdateServerURL sets the url the identity agent is reached at, or removes it if nil
+func (i *identity) UpdateServerURL(ctx context.Context, conn db.Querier, identifier w3c.DID, serverURL *string) error {
+	res, err := conn.Exec(ctx, `UPDATE identities SET server_url = $2 WHERE identifier = $1`, identifier.String(), serverURL)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrIdentityServerURLInUse
+		}
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrIdentityDoesNotExist
+	}
+	return nil
+}
+
+// GetByServerURL returns the identity whose agent is reached at the server url
+func (i *identity) GetByServerURL(ctx context.Context, conn db.Querier, serverURL string) (*w3c.DID, error) {
+	var identifier string
+	err := conn.QueryRow(ctx, `SELECT identifier FROM identities WHERE server_url = $1`, serverURL).Scan(&identifier)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrIdentityDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w3c.ParseDID(identifier)
+}
+
 func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.DID) (*domain.Identity, error) {
 	identity := domain.Identity{
 		State: domain.IdentityState{},
@@ -32,6 +78,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 		`SELECT  identities.identifier,
 						identities.keyType,
 						identities.address,
+						identities.server_url,
        					state_id,
    						state,           
     					root_of_roots,
@@ -55,6 +102,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 	err := row.Scan(&identity.Identifier,
 		&identity.KeyType,
 		&identity.Address,
+		&identity.ServerURL,
 		&identity.State.StateID,
 		&identity.State.State,
 		&identity.State.RootOfRoots,
@@ -91,6 +139,27 @@ func (i *identity) Get(ctx context.Context, conn db.Querier) (identities []strin
 	return identities, err
 }
 
+// GetByTenantID returns the identifiers of the identities that belong to the given tenant
+func (i *identity) GetByTenantID(ctx context.Context, conn db.Querier, tenantID uuid.UUID) (identities []string, err error) {
+	rows, err := conn.Query(ctx, `SELECT identifier FROM identities WHERE tenant_id = $1`, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var identifier string
+		err = rows.Scan(&identifier)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identifier)
+	}
+
+	return identities, err
+}
+
 func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context, conn db.Querier) (issuersIDs []*w3c.DID, err error) {
 	rows, err := conn.Query(ctx,
 		`WITH issuers_to_process AS
@@ -2,8 +2,11 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/jackc/pgx/v4"
 
@@ -12,6 +15,9 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
+// ErrIdentityStateDoesNotExist identity state does not exist
+var ErrIdentityStateDoesNotExist = errors.New("identity state does not exist")
+
 type identityState struct{}
 
 // NewIdentityState returns a new identity state repository
@@ -55,7 +61,7 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 // If 'confirmed' and non-genesis state are not found. Return genesis state.
 func (isr *identityState) GetLatestStateByIdentifier(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityState, error) {
 	row := conn.QueryRow(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, 
-       revocation_tree_root, block_timestamp, block_number, tx_id, previous_state, status, modified_at, created_at 
+       revocation_tree_root, block_timestamp, block_number, tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
 FROM identity_states
 WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, identifier.String())
 	state := domain.IdentityState{}
@@ -68,6 +74,11 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 		&state.BlockTimestamp,
 		&state.BlockNumber,
 		&state.TxID,
+		&state.GasUsed,
+		&state.TxFee,
+		&state.TxFeeFiat,
+		&state.TxFeeCurrency,
+		&state.DroppedTxIDs,
 		&state.PreviousState,
 		&state.Status,
 		&state.ModifiedAt,
@@ -78,10 +89,39 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 	return &state, nil
 }
 
+// GetConfirmedState returns the confirmed state of the identity with the given state hash
+func (isr *identityState) GetConfirmedState(ctx context.Context, conn db.Querier, identifier w3c.DID, state string) (*domain.IdentityState, error) {
+	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
+	FROM identity_states WHERE identifier = $1 AND state = $2 AND status = $3`, identifier.String(), state, domain.StatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return firstIdentityState(rows)
+}
+
+// GetConfirmedStateAt returns the state of the identity that was published at the given time, that is, the latest
+// state confirmed in a block not newer than at. The genesis state has no block, its creation time is used instead.
+func (isr *identityState) GetConfirmedStateAt(ctx context.Context, conn db.Querier, identifier w3c.DID, at time.Time) (*domain.IdentityState, error) {
+	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
+	FROM identity_states WHERE identifier = $1 AND status = $2 
+	AND coalesce(to_timestamp(block_timestamp), created_at) <= $3
+	ORDER BY state_id DESC LIMIT 1`, identifier.String(), domain.StatusConfirmed, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return firstIdentityState(rows)
+}
+
 // GetStatesByStatus returns states which are not transated
 func (isr *identityState) GetStatesByStatus(ctx context.Context, conn db.Querier, status domain.IdentityStatus) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
 	FROM identity_states WHERE status = $1 and previous_state IS NOT NULL`, status)
 	if err != nil {
 		return nil, err
@@ -94,7 +134,7 @@ func (isr *identityState) GetStatesByStatus(ctx context.Context, conn db.Querier
 // GetPublishedStates returns all the states
 func (isr *identityState) GetStates(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
 	FROM identity_states WHERE identifier = $1 and previous_state IS NOT NULL ORDER BY state_id ASC`, issuerDID.String())
 	if err != nil {
 		return nil, err
@@ -104,10 +144,46 @@ func (isr *identityState) GetStates(ctx context.Context, conn db.Querier, issuer
 	return toIdentityStatesDomain(rows)
 }
 
+// GetStateTransitionContents returns the credentials issued and revoked in each state transition of the issuer indexed by state
+func (isr *identityState) GetStateTransitionContents(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (map[string]*domain.StateTransitionContent, error) {
+	rows, err := conn.Query(ctx, `SELECT claims.identity_state, claims.id, false
+	FROM claims WHERE claims.issuer = $1 AND claims.identity_state IS NOT NULL
+	UNION ALL
+	SELECT revocation.identity_state, claims.id, true
+	FROM revocation JOIN claims ON claims.issuer = revocation.identifier AND claims.rev_nonce = revocation.nonce
+	WHERE revocation.identifier = $1 AND revocation.identity_state IS NOT NULL`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contents := make(map[string]*domain.StateTransitionContent)
+	for rows.Next() {
+		var state string
+		var claimID uuid.UUID
+		var revoked bool
+		if err := rows.Scan(&state, &claimID, &revoked); err != nil {
+			return nil, err
+		}
+		content, ok := contents[state]
+		if !ok {
+			content = &domain.StateTransitionContent{}
+			contents[state] = content
+		}
+		if revoked {
+			content.RevokedCredentialIDs = append(content.RevokedCredentialIDs, claimID)
+		} else {
+			content.CredentialIDs = append(content.CredentialIDs, claimID)
+		}
+	}
+
+	return contents, rows.Err()
+}
+
 func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, state *domain.IdentityState) (int64, error) {
 	tag, err := conn.Exec(ctx, `UPDATE identity_states 
-		SET block_timestamp=$1, block_number=$2, tx_id=$3, status=$4 WHERE state = $5 `,
-		state.BlockTimestamp, state.BlockNumber, state.TxID, state.Status, state.State)
+		SET block_timestamp=$1, block_number=$2, tx_id=$3, status=$4, gas_used=$5, tx_fee=$6, tx_fee_fiat=$7, tx_fee_currency=$8, dropped_tx_ids=$9 WHERE state = $10 `,
+		state.BlockTimestamp, state.BlockNumber, state.TxID, state.Status, state.GasUsed, state.TxFee, state.TxFeeFiat, state.TxFeeCurrency, droppedTxIDs(state), state.State)
 	if err != nil {
 		return 0, err
 	}
@@ -115,6 +191,25 @@ func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, stat
 	return tag.RowsAffected(), nil
 }
 
+// droppedTxIDs avoids storing NULL in the not nullable dropped_tx_ids column
+func droppedTxIDs(state *domain.IdentityState) []string {
+	if state.DroppedTxIDs == nil {
+		return []string{}
+	}
+	return state.DroppedTxIDs
+}
+
+func firstIdentityState(rows pgx.Rows) (*domain.IdentityState, error) {
+	states, err := toIdentityStatesDomain(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, ErrIdentityStateDoesNotExist
+	}
+	return &states[0], nil
+}
+
 func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 	states := []domain.IdentityState{}
 	for rows.Next() {
@@ -128,6 +223,11 @@ func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.GasUsed,
+			&state.TxFee,
+			&state.TxFeeFiat,
+			&state.TxFeeCurrency,
+			&state.DroppedTxIDs,
 			&state.PreviousState,
 			&state.Status,
 			&state.ModifiedAt,
@@ -147,7 +247,7 @@ func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 // GetStatesByStatus returns states which are not transated
 func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn db.Querier, status domain.IdentityStatus, issuerID w3c.DID) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
 	FROM identity_states WHERE identifier = $1 and status = $2 and previous_state IS NOT NULL
 	ORDER BY created_at DESC
 	`, issuerID.String(), status)
@@ -168,6 +268,11 @@ func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.GasUsed,
+			&state.TxFee,
+			&state.TxFeeFiat,
+			&state.TxFeeCurrency,
+			&state.DroppedTxIDs,
 			&state.PreviousState,
 			&state.Status,
 			&state.ModifiedAt,
@@ -186,7 +291,9 @@ func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn
 
 func (isr *identityState) GetGenesisState(ctx context.Context, conn db.Querier, identifier string) (*domain.IdentityState, error) {
 	state := domain.IdentityState{}
-	row := conn.QueryRow(ctx, "SELECT * FROM identity_states WHERE identifier=$1 AND previous_state IS NULL ", identifier)
+	row := conn.QueryRow(ctx, `SELECT state_id, identifier, state, root_of_roots, revocation_tree_root, claims_tree_root, block_timestamp, block_number, 
+       tx_id, gas_used, tx_fee::text, tx_fee_fiat::text, tx_fee_currency, dropped_tx_ids, previous_state, status, modified_at, created_at 
+	FROM identity_states WHERE identifier=$1 AND previous_state IS NULL`, identifier)
 	if err := row.Scan(&state.StateID,
 		&state.Identifier,
 		&state.State,
@@ -196,6 +303,11 @@ func (isr *identityState) GetGenesisState(ctx context.Context, conn db.Querier,
 		&state.BlockTimestamp,
 		&state.BlockNumber,
 		&state.TxID,
+		&state.GasUsed,
+		&state.TxFee,
+		&state.TxFeeFiat,
+		&state.TxFeeCurrency,
+		&state.DroppedTxIDs,
 		&state.PreviousState,
 		&state.Status,
 		&state.ModifiedAt,
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrIssuerProfileDoesNotExist = errors.New("issuer profile does not exist")                    // ErrIssuerProfileDoesNotExist issuer profile does not exist
+	ErrIssuerProfileDuplicated   = errors.New("issuer profile with the same name already exists") // ErrIssuerProfileDuplicated the issuer already has a profile with the same name
+	ErrIssuerProfileInUse        = errors.New("issuer profile has issued credentials")            // ErrIssuerProfileInUse the profile cannot be deleted once it has issued credentials
+)
+
+const issuerProfileColumns = `id, issuer_id, nonce, profile_did, name, description, logo_url, allowed_proofs, created_at`
+
+type issuerProfile struct{}
+
+// NewIssuerProfile returns a new issuer profile repository
+func NewIssuerProfile() ports.IssuerProfileRepository {
+	return &issuerProfile{}
+}
+
+// Save stores a new issuer profile
+func (p *issuerProfile) Save(ctx context.Context, conn db.Querier, profile *domain.IssuerProfile) error {
+	allowedProofs := make([]string, len(profile.AllowedProofs))
+	for i, proof := range profile.AllowedProofs {
+		allowedProofs[i] = string(proof)
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO issuer_profiles (`+issuerProfileColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		profile.ID, profile.IssuerDID.String(), profile.Nonce.String(), profile.DID.String(), profile.Name, profile.Description,
+		profile.LogoURL, allowedProofs, profile.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrIssuerProfileDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the issuer profile with the given id
+func (p *issuerProfile) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.IssuerProfile, error) {
+	return scanIssuerProfile(conn.QueryRow(ctx, `SELECT `+issuerProfileColumns+` FROM issuer_profiles WHERE issuer_id = $1 AND id = $2`, issuerDID.String(), id))
+}
+
+// GetAll returns all the profiles of the issuer
+func (p *issuerProfile) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.IssuerProfile, error) {
+	rows, err := conn.Query(ctx, `SELECT `+issuerProfileColumns+` FROM issuer_profiles WHERE issuer_id = $1 ORDER BY name`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profiles := make([]domain.IssuerProfile, 0)
+	for rows.Next() {
+		profile, err := scanIssuerProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, *profile)
+	}
+	return profiles, rows.Err()
+}
+
+// Delete removes the issuer profile with the given id. Profiles that issued credentials are not removed.
+func (p *issuerProfile) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	var inUse bool
+	if err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM claims WHERE issuer = $1 AND profile_id = $2)`, issuerDID.String(), id).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return ErrIssuerProfileInUse
+	}
+	res, err := conn.Exec(ctx, `DELETE FROM issuer_profiles WHERE issuer_id = $1 AND id = $2`, issuerDID.String(), id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrIssuerProfileDoesNotExist
+	}
+	return nil
+}
+
+func scanIssuerProfile(row pgx.Row) (*domain.IssuerProfile, error) {
+	var profile domain.IssuerProfile
+	var issuerDID, nonce, profileDID string
+	var allowedProofs []string
+	err := row.Scan(&profile.ID, &issuerDID, &nonce, &profileDID, &profile.Name, &profile.Description, &profile.LogoURL,
+		&allowedProofs, &profile.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIssuerProfileDoesNotExist
+		}
+		return nil, err
+	}
+	issuer, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(profileDID)
+	if err != nil {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(nonce, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid issuer profile nonce %s", nonce)
+	}
+	profile.IssuerDID = *issuer
+	profile.DID = *did
+	profile.Nonce = n
+	profile.AllowedProofs = make([]verifiable.ProofType, len(allowedProofs))
+	for i, proof := range allowedProofs {
+		profile.AllowedProofs[i] = verifiable.ProofType(proof)
+	}
+	return &profile, nil
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrKeyMigrationDoesNotExist = errors.New("key migration does not exist")                       // ErrKeyMigrationDoesNotExist key migration does not exist
+	ErrKeyMigrationInProgress   = errors.New("the issuer has a key migration in progress already") // ErrKeyMigrationInProgress other migration of the issuer is not completed or failed
+)
+
+const keyMigrationSelect = `SELECT id, issuer_id, step, status, revoke_old_auth_claim, old_auth_claim_id, old_key_id, new_key_id,
+       new_auth_claim_id, error, created_at, modified_at
+FROM key_migrations`
+
+type keyMigration struct{}
+
+// NewKeyMigration returns a new key migrations repository
+func NewKeyMigration() ports.KeyMigrationRepository {
+	return &keyMigration{}
+}
+
+// Save stores a key migration or updates its progress
+func (r *keyMigration) Save(ctx context.Context, conn db.Querier, m *domain.KeyMigration) error {
+	_, err := conn.Exec(ctx, `INSERT INTO key_migrations (id, issuer_id, step, status, revoke_old_auth_claim, old_auth_claim_id, old_key_id,
+			new_key_id, new_auth_claim_id, error, created_at, modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
+		UPDATE SET step = $3, status = $4, new_key_id = $8, new_auth_claim_id = $9, error = $10, modified_at = $12`,
+		m.ID, m.IssuerDID.String(), m.Step, m.Status, m.RevokeOldAuthClaim, m.OldAuthClaimID, m.OldKeyID,
+		m.NewKeyID, m.NewAuthClaimID, m.Error, m.CreatedAt, m.ModifiedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrKeyMigrationInProgress
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the key migration of the issuer
+func (r *keyMigration) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyMigration, error) {
+	return scanKeyMigration(conn.QueryRow(ctx, keyMigrationSelect+` WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()))
+}
+
+// GetAll returns the key migrations of the issuer, the newest first
+func (r *keyMigration) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.KeyMigration, error) {
+	rows, err := conn.Query(ctx, keyMigrationSelect+` WHERE issuer_id = $1 ORDER BY created_at DESC`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	migrations := make([]domain.KeyMigration, 0)
+	for rows.Next() {
+		m, err := scanKeyMigration(rows)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, *m)
+	}
+	return migrations, rows.Err()
+}
+
+func scanKeyMigration(row pgx.Row) (*domain.KeyMigration, error) {
+	var m domain.KeyMigration
+	var issuerID string
+	err := row.Scan(&m.ID, &issuerID, &m.Step, &m.Status, &m.RevokeOldAuthClaim, &m.OldAuthClaimID, &m.OldKeyID, &m.NewKeyID,
+		&m.NewAuthClaimID, &m.Error, &m.CreatedAt, &m.ModifiedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrKeyMigrationDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	m.IssuerDID = *did
+	return &m, nil
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrLinkHolderDoesNotExist = errors.New("link holder does not exist")                              // ErrLinkHolderDoesNotExist the identity has not claimed the link yet
+	ErrLinkHolderDuplicated   = errors.New("the identity of the holder has already claimed the link") // ErrLinkHolderDuplicated the identity already claimed the link with another profile
+)
+
+type linkHolder struct{}
+
+// NewLinkHolder returns a new link holders repository
+func NewLinkHolder() ports.LinkHolderRepository {
+	return &linkHolder{}
+}
+
+// Save stores the holder of the link. It fails if the identity of the holder already claimed the link.
+func (l *linkHolder) Save(ctx context.Context, conn db.Querier, holder domain.LinkHolder) error {
+	_, err := conn.Exec(ctx, `INSERT INTO link_holders (link_id, holder_digest, user_id, claim_id, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		holder.LinkID, holder.Digest, holder.UserDID, holder.ClaimID, holder.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrLinkHolderDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// Get returns the holder of the link with the digest of the genesis DID
+func (l *linkHolder) Get(ctx context.Context, conn db.Querier, linkID uuid.UUID, digest string) (*domain.LinkHolder, error) {
+	var holder domain.LinkHolder
+	err := conn.QueryRow(ctx, `SELECT link_id, holder_digest, user_id, claim_id, created_at FROM link_holders WHERE link_id = $1 AND holder_digest = $2`, linkID, digest).
+		Scan(&holder.LinkID, &holder.Digest, &holder.UserDID, &holder.ClaimID, &holder.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkHolderDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &holder, nil
+}
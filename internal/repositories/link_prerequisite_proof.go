@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const linkPrerequisiteProofColumns = `id, issuer_id, link_id, session_id, user_did, schema_url, credential_type, proof, created_at`
+
+type linkPrerequisiteProof struct{}
+
+// NewLinkPrerequisiteProof returns a new link prerequisite proofs repository
+func NewLinkPrerequisiteProof() ports.LinkPrerequisiteProofRepository {
+	return &linkPrerequisiteProof{}
+}
+
+// Save stores the proof a holder sent for a link prerequisite
+func (l *linkPrerequisiteProof) Save(ctx context.Context, conn db.Querier, proof *domain.LinkPrerequisiteProof) error {
+	raw := pgtype.JSONB{}
+	if err := raw.Set(proof.Proof); err != nil {
+		return err
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO link_prerequisite_proofs (`+linkPrerequisiteProofColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		proof.ID, proof.IssuerDID.String(), proof.LinkID, proof.SessionID, proof.UserDID.String(), proof.SchemaURL, proof.CredentialType, raw, proof.CreatedAt)
+	return err
+}
+
+// GetByLink returns the prerequisite proofs the holders sent for a link, newest first
+func (l *linkPrerequisiteProof) GetByLink(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID) ([]domain.LinkPrerequisiteProof, error) {
+	rows, err := conn.Query(ctx, `SELECT `+linkPrerequisiteProofColumns+` FROM link_prerequisite_proofs WHERE issuer_id = $1 AND link_id = $2 ORDER BY created_at DESC`,
+		issuerDID.String(), linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	proofs := make([]domain.LinkPrerequisiteProof, 0)
+	for rows.Next() {
+		proof, err := scanLinkPrerequisiteProof(rows)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, *proof)
+	}
+	return proofs, rows.Err()
+}
+
+func scanLinkPrerequisiteProof(row pgx.Row) (*domain.LinkPrerequisiteProof, error) {
+	var proof domain.LinkPrerequisiteProof
+	var issuerDID, userDID string
+	var raw pgtype.JSONB
+	if err := row.Scan(&proof.ID, &issuerDID, &proof.LinkID, &proof.SessionID, &userDID, &proof.SchemaURL, &proof.CredentialType, &raw, &proof.CreatedAt); err != nil {
+		return nil, err
+	}
+	issuer, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := w3c.ParseDID(userDID)
+	if err != nil {
+		return nil, err
+	}
+	proof.IssuerDID = *issuer
+	proof.UserDID = *user
+	if err := raw.AssignTo(&proof.Proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const linkRecipientColumns = `id, link_id, code, credential_subject, claim_id, consumed_at, created_at`
+
+var (
+	ErrLinkRecipientDoesNotExist   = errors.New("link recipient does not exist")            // ErrLinkRecipientDoesNotExist there is no recipient with the code
+	ErrLinkRecipientDuplicatedCode = errors.New("link recipient code already exists")       // ErrLinkRecipientDuplicatedCode the code is used by another recipient of the link
+	ErrLinkRecipientConsumed       = errors.New("link recipient code was already consumed") // ErrLinkRecipientConsumed the credential of the recipient was already issued
+)
+
+type linkRecipient struct {
+	cipher ports.DataCipher
+}
+
+// NewLinkRecipient returns a new link recipients repository
+func NewLinkRecipient() ports.LinkRecipientRepository {
+	return &linkRecipient{}
+}
+
+// NewLinkRecipientWithCipher returns a new link recipients repository that encrypts the credential subjects of the
+// recipients at rest with the given cipher, the same as the claims data. A nil cipher stores them in clear.
+func NewLinkRecipientWithCipher(cipher ports.DataCipher) ports.LinkRecipientRepository {
+	return &linkRecipient{cipher: cipher}
+}
+
+// Save stores the recipients with a single copy
+func (l *linkRecipient) Save(ctx context.Context, conn db.Querier, recipients []domain.LinkRecipient) error {
+	rows := make([][]any, len(recipients))
+	for i, recipient := range recipients {
+		subject, err := json.Marshal(recipient.CredentialSubject)
+		if err != nil {
+			return fmt.Errorf("cannot set credential subject values: %w", err)
+		}
+		if l.cipher != nil {
+			if subject, err = l.cipher.Encrypt(ctx, subject); err != nil {
+				return fmt.Errorf("error encrypting the credential subject: %w", err)
+			}
+		}
+		rows[i] = []any{recipient.ID, recipient.LinkID, recipient.Code, pgtype.JSONB{Bytes: subject, Status: pgtype.Present}, recipient.ClaimID, recipient.ConsumedAt, recipient.CreatedAt}
+	}
+	_, err := conn.CopyFrom(ctx, pgx.Identifier{"link_recipients"},
+		[]string{"id", "link_id", "code", "credential_subject", "claim_id", "consumed_at", "created_at"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrLinkRecipientDuplicatedCode
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByCode returns the recipient of the link with the code
+func (l *linkRecipient) GetByCode(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string) (*domain.LinkRecipient, error) {
+	recipient, err := l.scan(ctx, conn.QueryRow(ctx, `SELECT `+linkRecipientColumns+` FROM link_recipients WHERE link_id = $1 AND code = $2`, linkID, code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkRecipientDoesNotExist
+	}
+	return recipient, err
+}
+
+// GetByLink returns the recipients of the link in the order they were imported
+func (l *linkRecipient) GetByLink(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]domain.LinkRecipient, error) {
+	rows, err := conn.Query(ctx, `SELECT `+linkRecipientColumns+` FROM link_recipients WHERE link_id = $1 ORDER BY created_at, code`, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recipients := make([]domain.LinkRecipient, 0)
+	for rows.Next() {
+		recipient, err := l.scan(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, *recipient)
+	}
+	return recipients, rows.Err()
+}
+
+// Exists tells whether the link has recipients
+func (l *linkRecipient) Exists(ctx context.Context, conn db.Querier, linkID uuid.UUID) (bool, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM link_recipients WHERE link_id = $1)`, linkID).Scan(&exists)
+	return exists, err
+}
+
+// Consume marks the code of the recipient as used by the claim. It fails if the code was already consumed, so
+// concurrent callbacks with the same code issue one credential at most.
+func (l *linkRecipient) Consume(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error {
+	res, err := conn.Exec(ctx, `UPDATE link_recipients SET claim_id = $2, consumed_at = now() WHERE id = $1 AND consumed_at IS NULL`, id, claimID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrLinkRecipientConsumed
+	}
+	return nil
+}
+
+func (l *linkRecipient) scan(ctx context.Context, row pgx.Row) (*domain.LinkRecipient, error) {
+	var recipient domain.LinkRecipient
+	var subject []byte
+	if err := row.Scan(&recipient.ID, &recipient.LinkID, &recipient.Code, &subject, &recipient.ClaimID, &recipient.ConsumedAt, &recipient.CreatedAt); err != nil {
+		return nil, err
+	}
+	if l.cipher != nil {
+		var err error
+		if subject, err = l.cipher.Decrypt(ctx, subject); err != nil {
+			return nil, fmt.Errorf("error decrypting the credential subject: %w", err)
+		}
+	}
+	// numbers are kept as json.Number, as in the links, so big integers don't lose precision
+	d := json.NewDecoder(bytes.NewReader(subject))
+	d.UseNumber()
+	if err := d.Decode(&recipient.CredentialSubject); err != nil {
+		return nil, err
+	}
+	return &recipient, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
 )
 
 var (
@@ -44,12 +45,12 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 	}
 
 	var id uuid.UUID
-	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
+	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method, external_id, presentation_template, payment, locale, prerequisites, restrictions, throttle, credential_extensions, result_webhook, tenant_id)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, ` + tenantOf("$2") + `) ON CONFLICT (id) DO
 			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10 
 			RETURNING id`
 	err := conn.QueryRow(ctx, sql, link.ID, link.IssuerCoreDID().String(), link.MaxIssuance, link.ValidUntil, link.SchemaID, link.CredentialExpiration, link.CredentialSignatureProof,
-		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod).Scan(&id)
+		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod, link.ExternalID, link.PresentationTemplate, link.Payment, link.Locale, link.Prerequisites, link.Restrictions, link.Throttle, link.CredentialExtensions, link.ResultWebhook).Scan(&id)
 
 	if err != nil && strings.Contains(err.Error(), `table "links" violates foreign key constraint "links_schemas_id_key"`) {
 		return nil, errorShemaNotFound
@@ -58,7 +59,7 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 }
 
 func (l link) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Link, error) {
-	const sql = `
+	sql := `
 SELECT links.id, 
        links.issuer_id, 
        links.created_at, 
@@ -72,6 +73,15 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+       links.external_id,
+       links.presentation_template,
+       links.payment,
+       links.locale,
+       links.prerequisites,
+       links.restrictions,
+       links.throttle,
+       links.credential_extensions,
+       links.result_webhook,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
        schemas.issuer_id as schema_issuer_id,
@@ -79,17 +89,20 @@ SELECT links.id,
        schemas.type,
        schemas.hash,
        schemas.words, 
-       schemas.created_at
+       schemas.created_at,
+       schemas.version,
+       schemas.title,
+       schemas.description
 FROM links
 LEFT JOIN schemas ON schemas.id = links.schema_id AND schemas.issuer_id = links.issuer_id
 LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
-WHERE links.id = $1 AND links.issuer_id = $2
+WHERE links.id = $1 AND links.issuer_id = $2 AND ` + tenantScope("links", "$3") + `
 GROUP BY links.id, schemas.id 
 `
 	link := domain.Link{}
 	s := dbSchema{}
 	var credentialSubject pgtype.JSONB
-	err := l.conn.Pgx.QueryRow(ctx, sql, id, issuerDID.String()).Scan(
+	err := l.conn.Pgx.QueryRow(ctx, sql, id, issuerDID.String(), tenancy.FromContext(ctx)).Scan(
 		&link.ID,
 		&link.IssuerDID,
 		&link.CreatedAt,
@@ -103,6 +116,15 @@ GROUP BY links.id, schemas.id
 		&link.Active,
 		&link.RefreshService,
 		&link.DisplayMethod,
+		&link.ExternalID,
+		&link.PresentationTemplate,
+		&link.Payment,
+		&link.Locale,
+		&link.Prerequisites,
+		&link.Restrictions,
+		&link.Throttle,
+		&link.CredentialExtensions,
+		&link.ResultWebhook,
 		&link.IssuedClaims,
 		&s.ID,
 		&s.IssuerID,
@@ -111,6 +133,9 @@ GROUP BY links.id, schemas.id
 		&s.Hash,
 		&s.Words,
 		&s.CreatedAt,
+		&s.Version,
+		&s.Title,
+		&s.Description,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, ErrLinkDoesNotExist
@@ -146,6 +171,15 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+       links.external_id,
+       links.presentation_template,
+       links.payment,
+       links.locale,
+       links.prerequisites,
+       links.restrictions,
+       links.throttle,
+       links.credential_extensions,
+       links.result_webhook,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
        schemas.issuer_id as schema_issuer_id,
@@ -153,14 +187,17 @@ SELECT links.id,
        schemas.type,
        schemas.hash,
        schemas.words, 
-       schemas.created_at
+       schemas.created_at,
+       schemas.version,
+       schemas.title,
+       schemas.description
 FROM links
 LEFT JOIN schemas ON schemas.id = links.schema_id
 LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
-WHERE links.issuer_id = $1
+WHERE links.issuer_id = $1 AND ` + tenantScope("links", "$3") + `
 `
 	sqlArgs := make([]interface{}, 0)
-	sqlArgs = append(sqlArgs, issuerDID.String(), time.Now())
+	sqlArgs = append(sqlArgs, issuerDID.String(), time.Now(), tenancy.FromContext(ctx))
 
 	switch status {
 	case ports.LinkActive:
@@ -168,10 +205,10 @@ WHERE links.issuer_id = $1
 	case ports.LinkInactive:
 		sql += " AND NOT links.active"
 	case ports.LinkExceeded:
-		sql += " AND " +
+		sql += " AND (" +
 			"(links.valid_until IS NOT NULL AND links.valid_until<= $2) " +
 			"OR " +
-			"(links.max_issuance IS NOT NULL AND links.max_issuance <= (SELECT count(claims.id) FROM claims where claims.link_id = links.id))"
+			"(links.max_issuance IS NOT NULL AND links.max_issuance <= (SELECT count(claims.id) FROM claims where claims.link_id = links.id)))"
 	}
 	if query != nil && *query != "" {
 		terms := tokenizeQuery(*query)
@@ -196,6 +233,8 @@ WHERE links.issuer_id = $1
 	links := make([]domain.Link, 0)
 	var credentialAttributes pgtype.JSONB
 	for rows.Next() {
+		// the json columns are decoded into the existing values, so they must not be shared with the previous link
+		link = domain.Link{}
 		if err := rows.Scan(
 			&link.ID,
 			&link.IssuerDID,
@@ -209,6 +248,15 @@ WHERE links.issuer_id = $1
 			&link.Active,
 			&link.RefreshService,
 			&link.DisplayMethod,
+			&link.ExternalID,
+			&link.PresentationTemplate,
+			&link.Payment,
+			&link.Locale,
+			&link.Prerequisites,
+			&link.Restrictions,
+			&link.Throttle,
+			&link.CredentialExtensions,
+			&link.ResultWebhook,
 			&link.IssuedClaims,
 			&schema.ID,
 			&schema.IssuerID,
@@ -217,6 +265,9 @@ WHERE links.issuer_id = $1
 			&schema.Hash,
 			&schema.Words,
 			&schema.CreatedAt,
+			&schema.Version,
+			&schema.Title,
+			&schema.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -237,8 +288,8 @@ WHERE links.issuer_id = $1
 }
 
 func (l link) Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error {
-	const sql = `DELETE FROM links WHERE id = $1 AND issuer_id =$2`
-	cmd, err := l.conn.Pgx.Exec(ctx, sql, id.String(), issuerDID.String())
+	sql := `DELETE FROM links WHERE id = $1 AND issuer_id =$2 AND ` + tenantScope("links", "$3")
+	cmd, err := l.conn.Pgx.Exec(ctx, sql, id.String(), issuerDID.String(), tenancy.FromContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -248,3 +299,15 @@ func (l link) Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error
 	}
 	return nil
 }
+
+// ClaimsIssuedSince returns the number of credentials issued by the link after since and the creation time of the
+// oldest of them, nil if there are none.
+func (l link) ClaimsIssuedSince(ctx context.Context, id uuid.UUID, since time.Time) (int, *time.Time, error) {
+	const sql = `SELECT count(claims.id), min(claims.created_at) FROM claims WHERE claims.link_id = $1 AND claims.created_at > $2`
+	var count int
+	var oldest *time.Time
+	if err := l.conn.Pgx.QueryRow(ctx, sql, id, since).Scan(&count, &oldest); err != nil {
+		return 0, nil, err
+	}
+	return count, oldest, nil
+}
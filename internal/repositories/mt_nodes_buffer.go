@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	sql "github.com/iden3/go-merkletree-sql/db/pgx/v2"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// Same statements used by the merkle tree sql storage
+const (
+	upsertMTNodeStmt = `INSERT INTO mt_nodes (mt_id, key, type, child_l, child_r, entry) VALUES ($1, $2, $3, $4, $5, $6) ` +
+		`ON CONFLICT (mt_id, key) DO UPDATE SET type = $3, child_l = $4, child_r = $5, entry = $6`
+	upsertMTRootStmt = `INSERT INTO mt_roots (mt_id, key) VALUES ($1, $2) ` +
+		`ON CONFLICT (mt_id) DO UPDATE SET key = $2`
+)
+
+// MTNodesBuffer is a merkle tree storage that keeps in memory the nodes and the root written, and writes all of them
+// in a single round trip with Flush. Adding many entries to a tree writes a node per level for every entry, so
+// buffering them is much faster for bulk operations. Reads of the nodes not written go to the database.
+// It is not safe for concurrent use and must be flushed in the same transaction used to create it.
+type MTNodesBuffer struct {
+	conn    db.Querier
+	mtID    uint64
+	storage *sql.Storage
+	nodes   map[string]*merkletree.Node
+	dirty   []string
+	root    *merkletree.Hash
+	newRoot bool
+}
+
+// NewMTNodesBuffer returns a MTNodesBuffer for the tree mtID
+func NewMTNodesBuffer(conn db.Querier, mtID uint64) *MTNodesBuffer {
+	return &MTNodesBuffer{
+		conn:    conn,
+		mtID:    mtID,
+		storage: sql.NewSqlStorage(conn, mtID),
+		nodes:   make(map[string]*merkletree.Node),
+	}
+}
+
+// Get returns the node of the key
+func (b *MTNodesBuffer) Get(ctx context.Context, key []byte) (*merkletree.Node, error) {
+	if node, ok := b.nodes[string(key)]; ok {
+		return node, nil
+	}
+	return b.storage.Get(ctx, key)
+}
+
+// Put stores the node of the key in memory
+func (b *MTNodesBuffer) Put(_ context.Context, key []byte, node *merkletree.Node) error {
+	k := string(key)
+	if _, ok := b.nodes[k]; !ok {
+		b.dirty = append(b.dirty, k)
+	}
+	b.nodes[k] = node
+	return nil
+}
+
+// GetRoot returns the root of the tree
+func (b *MTNodesBuffer) GetRoot(ctx context.Context) (*merkletree.Hash, error) {
+	if b.root == nil {
+		return b.storage.GetRoot(ctx)
+	}
+	root := *b.root
+	return &root, nil
+}
+
+// SetRoot stores the root of the tree in memory
+func (b *MTNodesBuffer) SetRoot(_ context.Context, hash *merkletree.Hash) error {
+	root := *hash
+	b.root = &root
+	b.newRoot = true
+	return nil
+}
+
+// Flush writes the nodes and the root stored since the last Flush. They are kept in memory for the reads.
+func (b *MTNodesBuffer) Flush(ctx context.Context) error {
+	if len(b.dirty) == 0 && !b.newRoot {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, key := range b.dirty {
+		node := b.nodes[key]
+		var childL, childR, entry []byte
+		if node.ChildL != nil {
+			childL = append(childL, node.ChildL[:]...)
+		}
+		if node.ChildR != nil {
+			childR = append(childR, node.ChildR[:]...)
+		}
+		if node.Entry[0] != nil && node.Entry[1] != nil {
+			entry = append(append(entry, node.Entry[0][:]...), node.Entry[1][:]...)
+		}
+		batch.Queue(upsertMTNodeStmt, b.mtID, []byte(key), node.Type, childL, childR, entry)
+	}
+	if b.newRoot {
+		batch.Queue(upsertMTRootStmt, b.mtID, b.root[:])
+	}
+
+	results := b.conn.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			_ = results.Close()
+			return fmt.Errorf("error writing the merkle tree nodes: %w", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return err
+	}
+
+	b.dirty = b.dirty[:0]
+	b.newRoot = false
+	return nil
+}
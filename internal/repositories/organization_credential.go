@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrOrganizationCredentialDoesNotExist = errors.New("organization credential does not exist")                // ErrOrganizationCredentialDoesNotExist organization credential does not exist
+	ErrOrganizationCredentialDuplicated   = errors.New("the organization credential has already been received") // ErrOrganizationCredentialDuplicated a credential with the same id is already held by the issuer
+)
+
+const organizationCredentialColumns = `id, issuer_id, credential, public, created_at`
+
+type organizationCredential struct{}
+
+// NewOrganizationCredential returns a new organization credentials repository
+func NewOrganizationCredential() ports.OrganizationCredentialRepository {
+	return &organizationCredential{}
+}
+
+// Save stores a credential received by the issuer
+func (o *organizationCredential) Save(ctx context.Context, conn db.Querier, credential *domain.OrganizationCredential) error {
+	vc := pgtype.JSONB{}
+	if err := vc.Set([]byte(credential.Document)); err != nil {
+		return fmt.Errorf("cannot set the organization credential: %w", err)
+	}
+	var credentialID *string
+	if credential.Credential.ID != "" {
+		credentialID = &credential.Credential.ID
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO organization_credentials (id, issuer_id, credential_id, credential_issuer, credential_type, credential, public, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		credential.ID, credential.IssuerDID.String(), credentialID, credential.Credential.Issuer, credential.Type(), vc,
+		credential.Public, credential.Credential.Expiration, credential.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrOrganizationCredentialDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the organization credential with the given id
+func (o *organizationCredential) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.OrganizationCredential, error) {
+	return scanOrganizationCredential(conn.QueryRow(ctx, `SELECT `+organizationCredentialColumns+` FROM organization_credentials WHERE issuer_id = $1 AND id = $2`, issuerDID.String(), id))
+}
+
+// GetAll returns the organization credentials of the issuer, the last received first
+func (o *organizationCredential) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter ports.OrganizationCredentialsFilter) ([]domain.OrganizationCredential, error) {
+	conditions := []string{"issuer_id = $1"}
+	args := []any{issuerDID.String()}
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		conditions = append(conditions, fmt.Sprintf("credential_type = $%d", len(args)))
+	}
+	if filter.OnlyPublic {
+		conditions = append(conditions, "public")
+	}
+	rows, err := conn.Query(ctx, `SELECT `+organizationCredentialColumns+` FROM organization_credentials WHERE `+strings.Join(conditions, " AND ")+` ORDER BY created_at DESC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]domain.OrganizationCredential, 0)
+	for rows.Next() {
+		credential, err := scanOrganizationCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, *credential)
+	}
+	return credentials, rows.Err()
+}
+
+// SetPublic sets whether the organization credential is presented to the verifiers
+func (o *organizationCredential) SetPublic(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID, public bool) error {
+	res, err := conn.Exec(ctx, `UPDATE organization_credentials SET public = $3 WHERE issuer_id = $1 AND id = $2`, issuerDID.String(), id, public)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrOrganizationCredentialDoesNotExist
+	}
+	return nil
+}
+
+// Delete removes the organization credential with the given id
+func (o *organizationCredential) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM organization_credentials WHERE issuer_id = $1 AND id = $2`, issuerDID.String(), id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrOrganizationCredentialDoesNotExist
+	}
+	return nil
+}
+
+func scanOrganizationCredential(row pgx.Row) (*domain.OrganizationCredential, error) {
+	var credential domain.OrganizationCredential
+	var issuerDID string
+	var vc pgtype.JSONB
+	if err := row.Scan(&credential.ID, &issuerDID, &vc, &credential.Public, &credential.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrganizationCredentialDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	credential.IssuerDID = *did
+	credential.Document = vc.Bytes
+	if err := json.Unmarshal(vc.Bytes, &credential.Credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
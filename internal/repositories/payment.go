@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrPaymentDoesNotExist   = errors.New("payment does not exist")                       // ErrPaymentDoesNotExist payment does not exist
+	ErrPaymentTxAlreadyUsed  = errors.New("the transaction already paid another payment") // ErrPaymentTxAlreadyUsed a transaction can only pay one payment
+	ErrPaymentAlreadySettled = errors.New("payment is already paid")                      // ErrPaymentAlreadySettled the payment is not pending anymore
+)
+
+const paymentColumns = `id, issuer_id, link_id, session_id, user_did, chain_id, recipient, amount, currency, token_address, status, tx_id, created_at, paid_at`
+
+type payment struct{}
+
+// NewPayment returns a new payment repository
+func NewPayment() ports.PaymentRepository {
+	return &payment{}
+}
+
+// Save stores a new payment
+func (p *payment) Save(ctx context.Context, conn db.Querier, payment *domain.Payment) error {
+	_, err := conn.Exec(ctx, `INSERT INTO payments (`+paymentColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		payment.ID, payment.IssuerDID.String(), payment.LinkID, payment.SessionID, payment.UserDID.String(), payment.ChainID, payment.Recipient,
+		payment.Amount, payment.Token.Currency, payment.Token.Address, string(payment.Status), payment.TxID, payment.CreatedAt, payment.PaidAt)
+	return err
+}
+
+// GetByID returns a payment by id
+func (p *payment) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Payment, error) {
+	return scanPayment(conn.QueryRow(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id = $1`, id))
+}
+
+// GetPaid returns the payment the user made for the link, if any
+func (p *payment) GetPaid(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID, userDID w3c.DID) (*domain.Payment, error) {
+	return scanPayment(conn.QueryRow(ctx, `SELECT `+paymentColumns+` FROM payments WHERE issuer_id = $1 AND link_id = $2 AND user_did = $3 AND status = $4 LIMIT 1`,
+		issuerDID.String(), linkID, userDID.String(), string(domain.PaymentStatusPaid)))
+}
+
+// MarkPaid sets the transaction that paid a pending payment
+func (p *payment) MarkPaid(ctx context.Context, conn db.Querier, id uuid.UUID, txID string, paidAt time.Time) error {
+	res, err := conn.Exec(ctx, `UPDATE payments SET status = $2, tx_id = $3, paid_at = $4 WHERE id = $1 AND status = $5`,
+		id, string(domain.PaymentStatusPaid), txID, paidAt, string(domain.PaymentStatusPending))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrPaymentTxAlreadyUsed
+		}
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrPaymentAlreadySettled
+	}
+	return nil
+}
+
+func scanPayment(row pgx.Row) (*domain.Payment, error) {
+	var payment domain.Payment
+	var issuerDID, userDID, status string
+	err := row.Scan(&payment.ID, &issuerDID, &payment.LinkID, &payment.SessionID, &userDID, &payment.ChainID, &payment.Recipient,
+		&payment.Amount, &payment.Token.Currency, &payment.Token.Address, &status, &payment.TxID, &payment.CreatedAt, &payment.PaidAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPaymentDoesNotExist
+		}
+		return nil, err
+	}
+	issuer, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := w3c.ParseDID(userDID)
+	if err != nil {
+		return nil, err
+	}
+	payment.IssuerDID = *issuer
+	payment.UserDID = *user
+	payment.Status = domain.PaymentStatus(status)
+	return &payment, nil
+}
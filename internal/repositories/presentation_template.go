@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrPresentationTemplateDoesNotExist = errors.New("presentation template does not exist")                    // ErrPresentationTemplateDoesNotExist presentation template does not exist
+	ErrPresentationTemplateDuplicated   = errors.New("presentation template with the same name already exists") // ErrPresentationTemplateDuplicated the issuer already has a template with the same name
+	ErrPresentationTemplateInUse        = errors.New("presentation template is used by a link")                 // ErrPresentationTemplateInUse the template cannot be deleted while a link references it
+)
+
+const presentationTemplateColumns = `id, issuer_id, name, description, schema_url, credential_type, circuit_id, allowed_issuers, conditions, disclosed_fields, created_at`
+
+type presentationTemplate struct{}
+
+// NewPresentationTemplate returns a new presentation template repository
+func NewPresentationTemplate() ports.PresentationTemplateRepository {
+	return &presentationTemplate{}
+}
+
+// Save stores a new presentation template
+func (p *presentationTemplate) Save(ctx context.Context, conn db.Querier, template *domain.PresentationTemplate) error {
+	conditions := pgtype.JSONB{}
+	if err := conditions.Set(template.Conditions); err != nil {
+		return err
+	}
+	disclosedFields := template.DisclosedFields
+	if disclosedFields == nil {
+		disclosedFields = []string{}
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO presentation_templates (`+presentationTemplateColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		template.ID, template.IssuerDID.String(), template.Name, template.Description, template.SchemaURL, template.CredentialType,
+		string(template.CircuitID), template.AllowedIssuers, conditions, disclosedFields, template.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrPresentationTemplateDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByName returns the presentation template of the issuer with the given name
+func (p *presentationTemplate) GetByName(ctx context.Context, conn db.Querier, issuerDID w3c.DID, name string) (*domain.PresentationTemplate, error) {
+	return scanPresentationTemplate(conn.QueryRow(ctx, `SELECT `+presentationTemplateColumns+` FROM presentation_templates WHERE issuer_id = $1 AND name = $2`, issuerDID.String(), name))
+}
+
+// GetAll returns all the presentation templates of the issuer
+func (p *presentationTemplate) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.PresentationTemplate, error) {
+	rows, err := conn.Query(ctx, `SELECT `+presentationTemplateColumns+` FROM presentation_templates WHERE issuer_id = $1 ORDER BY name`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := make([]domain.PresentationTemplate, 0)
+	for rows.Next() {
+		template, err := scanPresentationTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *template)
+	}
+	return templates, rows.Err()
+}
+
+// Delete removes the presentation template of the issuer with the given name. Templates referenced by a link are not removed.
+func (p *presentationTemplate) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, name string) error {
+	var inUse bool
+	if err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM links WHERE issuer_id = $1 AND presentation_template = $2)`, issuerDID.String(), name).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return ErrPresentationTemplateInUse
+	}
+	res, err := conn.Exec(ctx, `DELETE FROM presentation_templates WHERE issuer_id = $1 AND name = $2`, issuerDID.String(), name)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrPresentationTemplateDoesNotExist
+	}
+	return nil
+}
+
+func scanPresentationTemplate(row pgx.Row) (*domain.PresentationTemplate, error) {
+	var template domain.PresentationTemplate
+	var issuerDID, circuitID string
+	var conditions pgtype.JSONB
+	err := row.Scan(&template.ID, &issuerDID, &template.Name, &template.Description, &template.SchemaURL, &template.CredentialType,
+		&circuitID, &template.AllowedIssuers, &conditions, &template.DisclosedFields, &template.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPresentationTemplateDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	template.IssuerDID = *did
+	template.CircuitID = circuits.CircuitID(circuitID)
+	if err := conditions.AssignTo(&template.Conditions); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrQRBrandingDoesNotExist the issuer has not configured a qr branding
+var ErrQRBrandingDoesNotExist = errors.New("qr branding does not exist")
+
+type qrBranding struct{}
+
+// NewQRBranding returns a new qr branding repository
+func NewQRBranding() ports.QRBrandingRepository {
+	return &qrBranding{}
+}
+
+// Save stores the qr branding of an issuer, replacing the previous one if it exists
+func (q *qrBranding) Save(ctx context.Context, conn db.Querier, branding *domain.QRBranding) error {
+	_, err := conn.Exec(ctx, `INSERT INTO qr_branding (issuer_id, logo, foreground_color, background_color, error_correction, modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (issuer_id) DO
+		UPDATE SET logo = $2, foreground_color = $3, background_color = $4, error_correction = $5, modified_at = $6`,
+		branding.IssuerDID.String(), branding.Logo, branding.ForegroundColor, branding.BackgroundColor, string(branding.ErrorCorrection), branding.ModifiedAt)
+	return err
+}
+
+// GetByIssuerID returns the qr branding of an issuer
+func (q *qrBranding) GetByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (*domain.QRBranding, error) {
+	branding := domain.QRBranding{IssuerDID: issuerDID}
+	var errorCorrection string
+	err := conn.QueryRow(ctx, `SELECT logo, foreground_color, background_color, error_correction, modified_at FROM qr_branding WHERE issuer_id = $1`, issuerDID.String()).
+		Scan(&branding.Logo, &branding.ForegroundColor, &branding.BackgroundColor, &errorCorrection, &branding.ModifiedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQRBrandingDoesNotExist
+		}
+		return nil, err
+	}
+	branding.ErrorCorrection = domain.QRErrorCorrection(errorCorrection)
+	return &branding, nil
+}
@@ -19,7 +19,7 @@ func NewRevocation() ports.RevocationRepository {
 
 func (r *revocation) UpdateStatus(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error) {
 	rows, err := conn.Query(ctx, `UPDATE revocation SET status = $2 WHERE identifier = $1 AND status = $3
-RETURNING identifier, nonce, version, status, description`,
+RETURNING id, identifier, nonce, version, status, description`,
 		did.String(), domain.RevPublished, domain.RevPending)
 	if err != nil {
 		return nil, err
@@ -29,7 +29,7 @@ RETURNING identifier, nonce, version, status, description`,
 	var revs []*domain.Revocation
 	for rows.Next() {
 		var revoke domain.Revocation
-		if err = rows.Scan(&revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Description); err != nil {
+		if err = rows.Scan(&revoke.ID, &revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Description); err != nil {
 			return nil, err
 		}
 		revs = append(revs, &revoke)
@@ -40,3 +40,37 @@ RETURNING identifier, nonce, version, status, description`,
 
 	return revs, nil
 }
+
+// GetPending returns the revocations of the issuer that have not been published yet
+func (r *revocation) GetPending(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error) {
+	rows, err := conn.Query(ctx, `SELECT id, identifier, nonce, version, status, description
+FROM revocation WHERE identifier = $1 AND status = $2 ORDER BY id`, did.String(), domain.RevPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revs := make([]*domain.Revocation, 0)
+	for rows.Next() {
+		var revoke domain.Revocation
+		if err = rows.Scan(&revoke.ID, &revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Description); err != nil {
+			return nil, err
+		}
+		revs = append(revs, &revoke)
+	}
+
+	return revs, rows.Err()
+}
+
+// UpdateIdentityState sets the state that published the given revocations
+func (r *revocation) UpdateIdentityState(ctx context.Context, conn db.Querier, revocations []*domain.Revocation, state string) error {
+	if len(revocations) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(revocations))
+	for i := range revocations {
+		ids[i] = revocations[i].ID
+	}
+	_, err := conn.Exec(ctx, `UPDATE revocation SET identity_state = $1 WHERE id = ANY($2)`, state, ids)
+	return err
+}
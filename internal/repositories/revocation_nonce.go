@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrRevocationNonceRangeDoesNotExist = errors.New("revocation nonce range does not exist") // ErrRevocationNonceRangeDoesNotExist revocation nonce range does not exist
+	ErrRevocationNonceRangeExhausted    = errors.New("revocation nonce range exhausted")      // ErrRevocationNonceRangeExhausted all the nonces of the range were handed out
+	ErrRevocationNonceSpaceExhausted    = errors.New("no revocation nonces left")             // ErrRevocationNonceSpaceExhausted there is no room for more nonces
+)
+
+type revocationNonce struct{}
+
+// NewRevocationNonce returns a new revocation nonces repository
+func NewRevocationNonce() ports.RevocationNonceRepository {
+	return &revocationNonce{}
+}
+
+// ReserveRange reserves size nonces for the integration right after the last range reserved in the node. It locks
+// the ranges table, so it must run in a transaction.
+func (r *revocationNonce) ReserveRange(ctx context.Context, conn db.Querier, issuerDID w3c.DID, integration string, size uint64) (*domain.RevocationNonceRange, error) {
+	if _, err := conn.Exec(ctx, `LOCK TABLE revocation_nonce_ranges IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return nil, err
+	}
+	nonceRange := domain.RevocationNonceRange{ID: uuid.New(), IssuerDID: issuerDID.String(), Integration: integration}
+	err := conn.QueryRow(ctx, `
+		INSERT INTO revocation_nonce_ranges (id, issuer_id, integration, start_nonce, end_nonce, next_nonce)
+		SELECT $1, $2, $3, bounds.start_nonce, bounds.start_nonce + $4 - 1, bounds.start_nonce
+		FROM (SELECT GREATEST($5::numeric, COALESCE(MAX(end_nonce) + 1, 0)) AS start_nonce FROM revocation_nonce_ranges) AS bounds
+		WHERE bounds.start_nonce + $4 - 1 <= $6::numeric
+		RETURNING start_nonce, end_nonce, next_nonce, created_at`,
+		nonceRange.ID, nonceRange.IssuerDID, integration, domain.RevNonceUint64(size),
+		domain.RevNonceUint64(domain.RevocationNonceRangesStart), domain.RevNonceUint64(math.MaxUint64)).Scan(
+		&nonceRange.Start,
+		&nonceRange.End,
+		&nonceRange.Next,
+		&nonceRange.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRevocationNonceSpaceExhausted
+		}
+		return nil, err
+	}
+	return &nonceRange, nil
+}
+
+// GetRanges returns the ranges reserved by the issuer, the oldest first
+func (r *revocationNonce) GetRanges(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.RevocationNonceRange, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, issuer_id, integration, start_nonce, end_nonce, next_nonce, created_at
+		FROM revocation_nonce_ranges
+		WHERE issuer_id = $1
+		ORDER BY start_nonce`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ranges := make([]domain.RevocationNonceRange, 0)
+	for rows.Next() {
+		var nonceRange domain.RevocationNonceRange
+		if err := rows.Scan(&nonceRange.ID, &nonceRange.IssuerDID, &nonceRange.Integration, &nonceRange.Start, &nonceRange.End, &nonceRange.Next, &nonceRange.CreatedAt); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, nonceRange)
+	}
+	return ranges, rows.Err()
+}
+
+// NextInRange hands out the next nonce of the range
+func (r *revocationNonce) NextInRange(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (uint64, error) {
+	var nonce domain.RevNonceUint64
+	err := conn.QueryRow(ctx, `
+		UPDATE revocation_nonce_ranges SET next_nonce = next_nonce + 1
+		WHERE id = $1 AND issuer_id = $2 AND next_nonce <= end_nonce
+		RETURNING next_nonce - 1`, id, issuerDID.String()).Scan(&nonce)
+	if err == nil {
+		return uint64(nonce), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
+	}
+
+	var exists bool
+	err = conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM revocation_nonce_ranges WHERE id = $1 AND issuer_id = $2)`, id, issuerDID.String()).Scan(&exists)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrRevocationNonceRangeDoesNotExist
+	}
+	return 0, ErrRevocationNonceRangeExhausted
+}
+
+// NextSequential hands out the next sequential nonce of the issuer, starting at 1 since 0 is the nonce of the auth claim
+func (r *revocationNonce) NextSequential(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (uint64, error) {
+	var nonce domain.RevNonceUint64
+	err := conn.QueryRow(ctx, `
+		INSERT INTO revocation_nonce_sequences (issuer_id, next_nonce) VALUES ($1, 2)
+		ON CONFLICT (issuer_id) DO UPDATE SET next_nonce = revocation_nonce_sequences.next_nonce + 1
+		RETURNING next_nonce - 1`, issuerDID.String()).Scan(&nonce)
+	if err != nil {
+		return 0, err
+	}
+	if uint64(nonce) >= domain.RevocationNonceRangesStart {
+		return 0, ErrRevocationNonceSpaceExhausted
+	}
+	return uint64(nonce), nil
+}
+
+// Used returns true if a credential, archived or not, or a revocation of the issuer has the nonce. A nil issuer checks
+// all the issuers of the node.
+func (r *revocationNonce) Used(ctx context.Context, conn db.Querier, issuerDID *w3c.DID, nonce uint64) (bool, error) {
+	issuer := ""
+	if issuerDID != nil {
+		issuer = issuerDID.String()
+	}
+	var used bool
+	err := conn.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM claims WHERE rev_nonce = $1 AND ($2 = '' OR identifier = $2))
+			OR EXISTS (SELECT 1 FROM archived_claims WHERE rev_nonce = $1 AND ($2 = '' OR identifier = $2))
+			OR EXISTS (SELECT 1 FROM revocation WHERE nonce = $1 AND ($2 = '' OR identifier = $2))`,
+		domain.RevNonceUint64(nonce), issuer).Scan(&used)
+	return used, err
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	ErrRevocationRequestDoesNotExist = errors.New("revocation request does not exist")                              // ErrRevocationRequestDoesNotExist revocation request does not exist
+	ErrRevocationRequestPending      = errors.New("the credential has a pending revocation request already")        // ErrRevocationRequestPending the credential has a pending revocation request
+	ErrRevocationRequestResolved     = errors.New("the revocation request is not pending, it was resolved already") // ErrRevocationRequestResolved the revocation request is not pending
+)
+
+const revocationRequestSelect = `SELECT id, issuer_id, claim_id, user_did, reason, status, auto_approved, note, created_at, resolved_at
+FROM revocation_requests`
+
+type revocationRequest struct{}
+
+// NewRevocationRequest returns a new revocation requests repository
+func NewRevocationRequest() ports.RevocationRequestRepository {
+	return &revocationRequest{}
+}
+
+// Save stores a new revocation request. It fails if the credential has a pending request already.
+func (r *revocationRequest) Save(ctx context.Context, conn db.Querier, request *domain.RevocationRequest) error {
+	_, err := conn.Exec(ctx, `INSERT INTO revocation_requests (id, issuer_id, claim_id, user_did, reason, status, auto_approved, note, created_at, resolved_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		request.ID, request.IssuerDID.String(), request.CredentialID, request.UserDID.String(), request.Reason, request.Status,
+		request.AutoApproved, request.Note, request.CreatedAt, request.ResolvedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrRevocationRequestPending
+		}
+	}
+	return err
+}
+
+// GetByID returns the revocation request of the issuer
+func (r *revocationRequest) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRequest, error) {
+	return scanRevocationRequest(conn.QueryRow(ctx, revocationRequestSelect+` WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()))
+}
+
+// GetAll returns the revocation requests of the issuer, the oldest first, optionally filtered by status
+func (r *revocationRequest) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.RevocationRequestStatus) ([]domain.RevocationRequest, error) {
+	sql := revocationRequestSelect + ` WHERE issuer_id = $1`
+	args := []any{issuerDID.String()}
+	if status != nil {
+		sql += ` AND status = $2`
+		args = append(args, *status)
+	}
+	rows, err := conn.Query(ctx, sql+` ORDER BY created_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := make([]domain.RevocationRequest, 0)
+	for rows.Next() {
+		request, err := scanRevocationRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+	return requests, rows.Err()
+}
+
+// Resolve stores the final status of a pending revocation request. It fails if the request was resolved already, so
+// it is resolved once even with concurrent operators.
+func (r *revocationRequest) Resolve(ctx context.Context, conn db.Querier, request *domain.RevocationRequest) error {
+	res, err := conn.Exec(ctx, `UPDATE revocation_requests SET status = $3, note = $4, resolved_at = $5
+		WHERE id = $1 AND issuer_id = $2 AND status = $6`,
+		request.ID, request.IssuerDID.String(), request.Status, request.Note, request.ResolvedAt, domain.RevocationRequestStatusPending)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrRevocationRequestResolved
+	}
+	return nil
+}
+
+func scanRevocationRequest(row pgx.Row) (*domain.RevocationRequest, error) {
+	var request domain.RevocationRequest
+	var issuerDID, userDID string
+	err := row.Scan(&request.ID, &issuerDID, &request.CredentialID, &userDID, &request.Reason, &request.Status,
+		&request.AutoApproved, &request.Note, &request.CreatedAt, &request.ResolvedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRevocationRequestDoesNotExist
+		}
+		return nil, err
+	}
+	issuer, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := w3c.ParseDID(userDID)
+	if err != nil {
+		return nil, err
+	}
+	request.IssuerDID, request.UserDID = *issuer, *user
+	return &request, nil
+}
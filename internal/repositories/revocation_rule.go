@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrRevocationRuleDoesNotExist revocation rule does not exist
+var ErrRevocationRuleDoesNotExist = errors.New("revocation rule does not exist")
+
+const revocationRuleSelect = `SELECT id, issuer_id, name, schema_type, cron_expression, webhook_url, enabled, next_run_at, last_run_at, last_revoked, created_at
+FROM revocation_rules`
+
+type revocationRule struct{}
+
+// NewRevocationRule returns a new revocation rules repository
+func NewRevocationRule() ports.RevocationRuleRepository {
+	return &revocationRule{}
+}
+
+// Save stores a revocation rule or updates it, keeping the result of its last run
+func (r *revocationRule) Save(ctx context.Context, conn db.Querier, rule *domain.RevocationRule) error {
+	_, err := conn.Exec(ctx, `INSERT INTO revocation_rules (id, issuer_id, name, schema_type, cron_expression, webhook_url, enabled, next_run_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (id) DO
+		UPDATE SET name = $3, schema_type = $4, cron_expression = $5, webhook_url = $6, enabled = $7, next_run_at = $8`,
+		rule.ID, rule.IssuerDID.String(), rule.Name, rule.SchemaType, rule.CronExpression, rule.WebhookURL, rule.Enabled, rule.NextRunAt, rule.CreatedAt)
+	return err
+}
+
+// GetByID returns the revocation rule of the issuer
+func (r *revocationRule) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.RevocationRule, error) {
+	return scanRevocationRule(conn.QueryRow(ctx, revocationRuleSelect+` WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()))
+}
+
+// GetAll returns all the revocation rules of the issuer, the newest first
+func (r *revocationRule) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.RevocationRule, error) {
+	return queryRevocationRules(ctx, conn, revocationRuleSelect+` WHERE issuer_id = $1 ORDER BY created_at DESC`, issuerDID.String())
+}
+
+// GetDue returns the enabled revocation rules of every issuer whose next run is due
+func (r *revocationRule) GetDue(ctx context.Context, conn db.Querier, now time.Time) ([]domain.RevocationRule, error) {
+	return queryRevocationRules(ctx, conn, revocationRuleSelect+` WHERE enabled AND next_run_at <= $1 ORDER BY next_run_at`, now)
+}
+
+// Delete removes the revocation rule. The credentials it revoked are not modified.
+func (r *revocationRule) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM revocation_rules WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrRevocationRuleDoesNotExist
+	}
+	return nil
+}
+
+// StartRun moves the next run of the rule scheduled at the given time. It returns false if the run was already
+// started, or the rule modified, so every run is executed once even with several replicas.
+func (r *revocationRule) StartRun(ctx context.Context, conn db.Querier, id uuid.UUID, scheduledAt time.Time, nextRunAt *time.Time) (bool, error) {
+	res, err := conn.Exec(ctx, `UPDATE revocation_rules SET next_run_at = $3, last_run_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND enabled AND next_run_at = $2`, id, scheduledAt, nextRunAt)
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() == 1, nil
+}
+
+// FinishRun records the number of credentials revoked by the last run of the rule
+func (r *revocationRule) FinishRun(ctx context.Context, conn db.Querier, id uuid.UUID, revoked int) error {
+	_, err := conn.Exec(ctx, `UPDATE revocation_rules SET last_revoked = $2 WHERE id = $1`, id, revoked)
+	return err
+}
+
+// GetCredentials returns the non revoked credentials of the issuer of the schema type created before the given time
+func (r *revocationRule) GetCredentials(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaType string, issuedBefore time.Time) ([]domain.RevocationRuleCredential, error) {
+	rows, err := conn.Query(ctx, `SELECT id, rev_nonce FROM claims
+		WHERE identifier = $1 AND schema_type = $2 AND NOT revoked AND created_at < $3
+		ORDER BY created_at`, issuerDID.String(), schemaType, issuedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]domain.RevocationRuleCredential, 0)
+	for rows.Next() {
+		var credential domain.RevocationRuleCredential
+		if err := rows.Scan(&credential.ID, &credential.RevNonce); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+	return credentials, rows.Err()
+}
+
+func queryRevocationRules(ctx context.Context, conn db.Querier, sql string, args ...any) ([]domain.RevocationRule, error) {
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.RevocationRule, 0)
+	for rows.Next() {
+		rule, err := scanRevocationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, rows.Err()
+}
+
+func scanRevocationRule(row pgx.Row) (*domain.RevocationRule, error) {
+	var rule domain.RevocationRule
+	var issuerDID string
+	err := row.Scan(&rule.ID, &issuerDID, &rule.Name, &rule.SchemaType, &rule.CronExpression, &rule.WebhookURL, &rule.Enabled,
+		&rule.NextRunAt, &rule.LastRunAt, &rule.LastRevoked, &rule.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRevocationRuleDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	rule.IssuerDID = *did
+	return &rule, nil
+}
@@ -40,3 +40,60 @@ func (s *schemaInMemory) GetAll(_ context.Context, _ w3c.DID, _ *string) ([]doma
 	}
 	return schemas, nil
 }
+
+// GetByURL returns the latest schema with the given url and type
+func (s *schemaInMemory) GetByURL(_ context.Context, _ w3c.DID, url string, sType string) (*domain.Schema, error) {
+	var latest *domain.Schema
+	for _, schema := range s.schemas {
+		if schema.URL == url && schema.Type == sType && (latest == nil || schema.CreatedAt.After(latest.CreatedAt)) {
+			schema := schema
+			latest = &schema
+		}
+	}
+	if latest == nil {
+		return nil, ErrSchemaDoesNotExist
+	}
+	return latest, nil
+}
+
+func (s *schemaInMemory) UpdateExpiration(_ context.Context, schema *domain.Schema) error {
+	stored, found := s.schemas[schema.ID]
+	if !found {
+		return ErrSchemaDoesNotExist
+	}
+	stored.DefaultExpirationDays = schema.DefaultExpirationDays
+	stored.MaxExpirationDays = schema.MaxExpirationDays
+	s.schemas[schema.ID] = stored
+	return nil
+}
+
+func (s *schemaInMemory) UpdateUniqueness(_ context.Context, schema *domain.Schema) error {
+	stored, found := s.schemas[schema.ID]
+	if !found {
+		return ErrSchemaDoesNotExist
+	}
+	stored.UniqueAttributes = schema.UniqueAttributes
+	stored.UniqueStrategy = schema.UniqueStrategy
+	s.schemas[schema.ID] = stored
+	return nil
+}
+
+func (s *schemaInMemory) UpdateSelfRevocation(_ context.Context, schema *domain.Schema) error {
+	stored, found := s.schemas[schema.ID]
+	if !found {
+		return ErrSchemaDoesNotExist
+	}
+	stored.SelfRevocation = schema.SelfRevocation
+	s.schemas[schema.ID] = stored
+	return nil
+}
+
+func (s *schemaInMemory) UpdateWebhookTemplate(_ context.Context, schema *domain.Schema) error {
+	stored, found := s.schemas[schema.ID]
+	if !found {
+		return ErrSchemaDoesNotExist
+	}
+	stored.WebhookTemplate = schema.WebhookTemplate
+	s.schemas[schema.ID] = stored
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
 )
 
 const duplicatedEntryPGCode = "23505"
@@ -35,6 +36,13 @@ type dbSchema struct {
 	Hash        string
 	Words       string
 	CreatedAt   time.Time
+
+	DefaultExpirationDays *int
+	MaxExpirationDays     *int
+	UniqueAttributes      []string
+	UniqueStrategy        *string
+	SelfRevocation        *string
+	WebhookTemplate       *domain.WebhookTemplate
 }
 
 type schema struct {
@@ -48,7 +56,7 @@ func NewSchema(conn db.Storage) *schema {
 
 // Save stores a new entry in schemas table
 func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
-	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type,  hash,  words, created_at,version,title,description) VALUES($1, $2::text, $3::text, $4::text, $5::text, $6::text, $7, $8::text,$9::text,$10::text);`
+	insertSchema := `INSERT INTO schemas (id, issuer_id, url, type,  hash,  words, created_at,version,title,description,default_expiration_days,max_expiration_days,unique_attributes,unique_strategy,self_revocation,webhook_template,tenant_id) VALUES($1, $2::text, $3::text, $4::text, $5::text, $6::text, $7, $8::text,$9::text,$10::text,$11,$12,$13,$14,$15,$16,` + tenantOf("$2") + `);`
 	hash, err := s.Hash.MarshalText()
 	if err != nil {
 		return err
@@ -65,7 +73,13 @@ func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
 		s.CreatedAt,
 		s.Version,
 		s.Title,
-		s.Description)
+		s.Description,
+		s.DefaultExpirationDays,
+		s.MaxExpirationDays,
+		s.UniqueAttributes,
+		uniqueStrategy(s),
+		selfRevocation(s),
+		webhookTemplate(s))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
@@ -91,10 +105,10 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 	var err error
 	var rows pgx.Rows
 	sqlArgs := make([]interface{}, 0)
-	sqlQuery := `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description
+	sqlQuery := `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description,default_expiration_days,max_expiration_days,unique_attributes,unique_strategy,self_revocation,webhook_template
 	FROM schemas
-	WHERE issuer_id=$1`
-	sqlArgs = append(sqlArgs, issuerDID.String())
+	WHERE issuer_id=$1 AND ` + tenantScope("schemas", "$2")
+	sqlArgs = append(sqlArgs, issuerDID.String(), tenancy.FromContext(ctx))
 	if query != nil && *query != "" {
 		terms := tokenizeQuery(*query)
 		sqlQuery += " AND (" + buildPartialQueryLikes("schemas.words", "OR", 1+len(sqlArgs), len(terms)) + ")"
@@ -112,7 +126,7 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 	schemaCol := make([]domain.Schema, 0)
 	s := dbSchema{}
 	for rows.Next() {
-		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description); err != nil {
+		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DefaultExpirationDays, &s.MaxExpirationDays, &s.UniqueAttributes, &s.UniqueStrategy, &s.SelfRevocation, &s.WebhookTemplate); err != nil {
 			return nil, err
 		}
 		item, err := toSchemaDomain(&s)
@@ -126,13 +140,103 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 
 // GetByID searches and returns an schema by id
 func (r *schema) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error) {
-	const byID = `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description
+	byID := `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description,default_expiration_days,max_expiration_days,unique_attributes,unique_strategy,self_revocation,webhook_template
 		FROM schemas 
-		WHERE issuer_id = $1 AND id=$2`
+		WHERE issuer_id = $1 AND id=$2 AND ` + tenantScope("schemas", "$3")
+
+	return r.getOne(ctx, byID, issuerDID.String(), id, tenancy.FromContext(ctx))
+}
+
+// GetByURL returns the latest schema imported by the issuer with the given url and type
+func (r *schema) GetByURL(ctx context.Context, issuerDID w3c.DID, url string, sType string) (*domain.Schema, error) {
+	byURL := `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description,default_expiration_days,max_expiration_days,unique_attributes,unique_strategy,self_revocation,webhook_template
+		FROM schemas 
+		WHERE issuer_id = $1 AND url = $2 AND type = $3 AND ` + tenantScope("schemas", "$4") + `
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	return r.getOne(ctx, byURL, issuerDID.String(), url, sType, tenancy.FromContext(ctx))
+}
+
+// UpdateExpiration stores the expiration settings of the schema
+func (r *schema) UpdateExpiration(ctx context.Context, s *domain.Schema) error {
+	const updateExpiration = `UPDATE schemas SET default_expiration_days = $3, max_expiration_days = $4 WHERE issuer_id = $1 AND id = $2`
+	tag, err := r.conn.Pgx.Exec(ctx, updateExpiration, s.IssuerDID.String(), s.ID, s.DefaultExpirationDays, s.MaxExpirationDays)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSchemaDoesNotExist
+	}
+	return nil
+}
+
+// UpdateUniqueness stores the unique attributes and strategy of the schema
+func (r *schema) UpdateUniqueness(ctx context.Context, s *domain.Schema) error {
+	const updateUniqueness = `UPDATE schemas SET unique_attributes = $3, unique_strategy = $4 WHERE issuer_id = $1 AND id = $2`
+	tag, err := r.conn.Pgx.Exec(ctx, updateUniqueness, s.IssuerDID.String(), s.ID, s.UniqueAttributes, uniqueStrategy(s))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSchemaDoesNotExist
+	}
+	return nil
+}
+
+// UpdateSelfRevocation stores the self revocation policy of the schema
+func (r *schema) UpdateSelfRevocation(ctx context.Context, s *domain.Schema) error {
+	const updateSelfRevocation = `UPDATE schemas SET self_revocation = $3 WHERE issuer_id = $1 AND id = $2`
+	tag, err := r.conn.Pgx.Exec(ctx, updateSelfRevocation, s.IssuerDID.String(), s.ID, selfRevocation(s))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSchemaDoesNotExist
+	}
+	return nil
+}
+
+// UpdateWebhookTemplate stores the webhook template of the schema
+func (r *schema) UpdateWebhookTemplate(ctx context.Context, s *domain.Schema) error {
+	const updateWebhookTemplate = `UPDATE schemas SET webhook_template = $3 WHERE issuer_id = $1 AND id = $2`
+	tag, err := r.conn.Pgx.Exec(ctx, updateWebhookTemplate, s.IssuerDID.String(), s.ID, webhookTemplate(s))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSchemaDoesNotExist
+	}
+	return nil
+}
+
+func webhookTemplate(s *domain.Schema) *domain.WebhookTemplate {
+	if len(s.WebhookTemplate) == 0 {
+		return nil
+	}
+	return &s.WebhookTemplate
+}
 
+func selfRevocation(s *domain.Schema) *string {
+	if s.SelfRevocation == "" {
+		return nil
+	}
+	policy := string(s.SelfRevocation)
+	return &policy
+}
+
+func uniqueStrategy(s *domain.Schema) *string {
+	if s.UniqueStrategy == "" {
+		return nil
+	}
+	strategy := string(s.UniqueStrategy)
+	return &strategy
+}
+
+func (r *schema) getOne(ctx context.Context, query string, args ...interface{}) (*domain.Schema, error) {
 	s := dbSchema{}
-	row := r.conn.Pgx.QueryRow(ctx, byID, issuerDID.String(), id)
-	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description)
+	row := r.conn.Pgx.QueryRow(ctx, query, args...)
+	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DefaultExpirationDays, &s.MaxExpirationDays, &s.UniqueAttributes, &s.UniqueStrategy, &s.SelfRevocation, &s.WebhookTemplate)
 	if err == pgx.ErrNoRows {
 		return nil, ErrSchemaDoesNotExist
 	}
@@ -151,7 +255,7 @@ func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 	if err != nil {
 		return nil, fmt.Errorf("parsing hash from schema: %w", err)
 	}
-	return &domain.Schema{
+	schema := &domain.Schema{
 		ID:          s.ID,
 		IssuerDID:   *issuerDID,
 		URL:         s.URL,
@@ -162,5 +266,21 @@ func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 		Version:     s.Version,
 		Title:       s.Title,
 		Description: s.Description,
-	}, nil
+
+		DefaultExpirationDays: s.DefaultExpirationDays,
+		MaxExpirationDays:     s.MaxExpirationDays,
+	}
+	if len(s.UniqueAttributes) > 0 {
+		schema.UniqueAttributes = s.UniqueAttributes
+	}
+	if s.UniqueStrategy != nil {
+		schema.UniqueStrategy = domain.SchemaUniqueStrategy(*s.UniqueStrategy)
+	}
+	if s.SelfRevocation != nil {
+		schema.SelfRevocation = domain.SchemaSelfRevocation(*s.SelfRevocation)
+	}
+	if s.WebhookTemplate != nil {
+		schema.WebhookTemplate = *s.WebhookTemplate
+	}
+	return schema, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/iden3/iden3comm/v2/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
@@ -54,3 +55,38 @@ func (c *cached) GetLink(ctx context.Context, key string) (link_state.State, err
 	}
 	return message, nil
 }
+
+// SetLinkHolder stores the profile the holder proved in the session of a link
+func (c *cached) SetLinkHolder(ctx context.Context, key string, value domain.LinkHolder) error {
+	return c.cache.Set(ctx, key, value, defaultTTL)
+}
+
+// GetLinkHolder returns the profile the holder proved in the session of a link
+func (c *cached) GetLinkHolder(ctx context.Context, key string) (domain.LinkHolder, error) {
+	var holder domain.LinkHolder
+	found := c.cache.Get(ctx, key, &holder)
+	if !found {
+		return holder, fmt.Errorf("link holder not found")
+	}
+	return holder, nil
+}
+
+// SetHolderSession stores the given holder session
+func (c *cached) SetHolderSession(ctx context.Context, key string, value domain.HolderSession, ttl time.Duration) error {
+	return c.cache.Set(ctx, key, value, ttl)
+}
+
+// GetHolderSession returns the cached holder session
+func (c *cached) GetHolderSession(ctx context.Context, key string) (domain.HolderSession, error) {
+	var session domain.HolderSession
+	found := c.cache.Get(ctx, key, &session)
+	if !found {
+		return session, fmt.Errorf("holder session not found")
+	}
+	return session, nil
+}
+
+// Delete removes the given session
+func (c *cached) Delete(ctx context.Context, key string) error {
+	return c.cache.Delete(ctx, key)
+}
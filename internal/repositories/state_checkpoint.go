@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrStateCheckpointDoesNotExist state checkpoint does not exist
+var ErrStateCheckpointDoesNotExist = errors.New("state checkpoint does not exist")
+
+const stateCheckpointSelect = `SELECT id, issuer_id, state, anchor_type, reference, receipt, created_at FROM state_checkpoints`
+
+type stateCheckpoint struct{}
+
+// NewStateCheckpoint returns a new state checkpoints repository
+func NewStateCheckpoint() ports.StateCheckpointRepository {
+	return &stateCheckpoint{}
+}
+
+// Save stores the checkpoint. A state already anchored with the same type is not stored again.
+func (r *stateCheckpoint) Save(ctx context.Context, conn db.Querier, checkpoint *domain.StateCheckpoint) error {
+	_, err := conn.Exec(ctx, `INSERT INTO state_checkpoints (id, issuer_id, state, anchor_type, reference, receipt, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT ON CONSTRAINT state_checkpoints_state_anchor_key DO NOTHING`,
+		checkpoint.ID, checkpoint.IssuerDID.String(), checkpoint.State, checkpoint.AnchorType, checkpoint.Reference, checkpoint.Receipt, checkpoint.CreatedAt)
+	return err
+}
+
+// GetLatest returns the newest checkpoint of the issuer with the anchor type
+func (r *stateCheckpoint) GetLatest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, anchorType string) (*domain.StateCheckpoint, error) {
+	return scanStateCheckpoint(conn.QueryRow(ctx, stateCheckpointSelect+` WHERE issuer_id = $1 AND anchor_type = $2 ORDER BY created_at DESC LIMIT 1`,
+		issuerDID.String(), anchorType))
+}
+
+// GetAll returns all the checkpoints of the issuer, the newest first
+func (r *stateCheckpoint) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.StateCheckpoint, error) {
+	rows, err := conn.Query(ctx, stateCheckpointSelect+` WHERE issuer_id = $1 ORDER BY created_at DESC`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checkpoints := make([]domain.StateCheckpoint, 0)
+	for rows.Next() {
+		checkpoint, err := scanStateCheckpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, *checkpoint)
+	}
+	return checkpoints, rows.Err()
+}
+
+// TryLock takes the lock of the checkpoints of the issuer until the end of the transaction. It returns false if
+// another replica holds it, so every state is anchored once.
+func (r *stateCheckpoint) TryLock(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (bool, error) {
+	var locked bool
+	err := conn.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtextextended($1, 0))`, "state-checkpoints|"+issuerDID.String()).Scan(&locked)
+	return locked, err
+}
+
+func scanStateCheckpoint(row pgx.Row) (*domain.StateCheckpoint, error) {
+	var checkpoint domain.StateCheckpoint
+	var issuerDID string
+	err := row.Scan(&checkpoint.ID, &issuerDID, &checkpoint.State, &checkpoint.AnchorType, &checkpoint.Reference, &checkpoint.Receipt, &checkpoint.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrStateCheckpointDoesNotExist
+		}
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint.IssuerDID = *did
+	return &checkpoint, nil
+}
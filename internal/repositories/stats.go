@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// statsCountersQuery counts the issuer credentials, links, connections and the changes waiting to be published.
+// The pending conditions are the same used to build the next state transition.
+const statsCountersQuery = `
+SELECT
+    (SELECT count(*) FROM claims WHERE identifier = $1 AND issuer = $1 AND schema_type <> $3),
+    (SELECT count(*) FROM claims WHERE identifier = $1 AND issuer = $1 AND schema_type <> $3 AND revoked),
+    (SELECT count(*) FROM links WHERE issuer_id = $1 AND active AND coalesce(valid_until > $2, true)
+        AND coalesce(max_issuance > (SELECT count(claims.id) FROM claims WHERE claims.link_id = links.id), true)),
+    (SELECT count(*) FROM connections WHERE issuer_id = $1),
+    (SELECT count(*) FROM claims WHERE identifier = $1 AND issuer = $1 AND identity_state IS NULL AND mtp = true),
+    (SELECT count(*) FROM revocation WHERE identifier = $1 AND status = $4),
+    (SELECT count(*) FROM identity_states WHERE identifier = $1 AND status IN ($5, $6) AND previous_state IS NOT NULL)`
+
+// statsCredentialsPerDayQuery and statsConnectionsPerDayQuery join every day of the series with the rows created
+// that day, so days without rows are returned with a zero count. The ranges use the (identifier|issuer_id, created_at)
+// indexes.
+const (
+	statsCredentialsPerDayQuery = `
+SELECT day, count(claims.id)
+FROM generate_series($2::timestamptz, $2::timestamptz + ($3::int - 1) * interval '1 day', interval '1 day') AS day
+LEFT JOIN claims ON claims.identifier = $1 AND claims.issuer = $1 AND claims.schema_type <> $4
+    AND claims.created_at >= day AND claims.created_at < day + interval '1 day'
+GROUP BY day
+ORDER BY day`
+
+	statsConnectionsPerDayQuery = `
+SELECT day, count(connections.id)
+FROM generate_series($2::timestamptz, $2::timestamptz + ($3::int - 1) * interval '1 day', interval '1 day') AS day
+LEFT JOIN connections ON connections.issuer_id = $1
+    AND connections.created_at >= day AND connections.created_at < day + interval '1 day'
+GROUP BY day
+ORDER BY day`
+)
+
+// statsGasSpendPerMonthQuery adds up the state transitions confirmed each month, by block time, with the same
+// series approach of the daily queries. The fiat fees are only added up when they are in the currency $4.
+const statsGasSpendPerMonthQuery = `
+SELECT month, count(identity_states.state_id), coalesce(sum(identity_states.gas_used), 0),
+    coalesce(sum(identity_states.tx_fee), 0)::text,
+    coalesce(sum(identity_states.tx_fee_fiat) FILTER (WHERE identity_states.tx_fee_currency = $4), 0)::text
+FROM generate_series($2::timestamptz, $2::timestamptz + ($3::int - 1) * interval '1 month', interval '1 month') AS month
+LEFT JOIN identity_states ON identity_states.identifier = $1 AND identity_states.status = $5
+    AND identity_states.previous_state IS NOT NULL
+    AND coalesce(to_timestamp(identity_states.block_timestamp), identity_states.modified_at) >= month
+    AND coalesce(to_timestamp(identity_states.block_timestamp), identity_states.modified_at) < month + interval '1 month'
+GROUP BY month
+ORDER BY month`
+
+type stats struct{}
+
+// NewStats returns a new stats repository
+func NewStats() ports.StatsRepository {
+	return &stats{}
+}
+
+// Get returns the issuer aggregates and the daily series of the given days starting at from
+func (s *stats) Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, from time.Time, days int) (*domain.Stats, error) {
+	var res domain.Stats
+	err := conn.QueryRow(ctx, statsCountersQuery, issuerDID.String(), time.Now(), domain.AuthBJJCredentialSchemaType,
+		domain.RevPending, domain.StatusCreated, domain.StatusTransacted).Scan(
+		&res.CredentialsIssued,
+		&res.CredentialsRevoked,
+		&res.ActiveLinks,
+		&res.Connections,
+		&res.PendingCredentials,
+		&res.PendingRevocations,
+		&res.PendingStates,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.CredentialsIssuedPerDay, err = dailyCounts(ctx, conn, statsCredentialsPerDayQuery, issuerDID.String(), from, days, domain.AuthBJJCredentialSchemaType); err != nil {
+		return nil, err
+	}
+	if res.ConnectionsPerDay, err = dailyCounts(ctx, conn, statsConnectionsPerDayQuery, issuerDID.String(), from, days); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func dailyCounts(ctx context.Context, conn db.Querier, query string, args ...interface{}) ([]domain.DailyCount, error) {
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]domain.DailyCount, 0)
+	for rows.Next() {
+		var count domain.DailyCount
+		if err := rows.Scan(&count.Day, &count.Count); err != nil {
+			return nil, err
+		}
+		count.Day = count.Day.UTC()
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+// GetGasSpend returns the spend of the state transitions of the issuer in the given months starting at from
+func (s *stats) GetGasSpend(ctx context.Context, conn db.Querier, issuerDID w3c.DID, from time.Time, months int, currency string) ([]domain.MonthlyGasSpend, error) {
+	rows, err := conn.Query(ctx, statsGasSpendPerMonthQuery, issuerDID.String(), from, months, currency, domain.StatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	spends := make([]domain.MonthlyGasSpend, 0, months)
+	for rows.Next() {
+		var spend domain.MonthlyGasSpend
+		if err := rows.Scan(&spend.Month, &spend.StateTransitions, &spend.GasUsed, &spend.TxFee, &spend.TxFeeFiat); err != nil {
+			return nil, err
+		}
+		spend.Month = spend.Month.UTC()
+		spends = append(spends, spend)
+	}
+	return spends, rows.Err()
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const foreignKeyViolationPGCode = "23503"
+
+var (
+	ErrTenantDoesNotExist  = errors.New("tenant does not exist") // ErrTenantDoesNotExist tenant does not exist
+	ErrTenantDuplicated    = errors.New("tenant already exists") // ErrTenantDuplicated there is another tenant with the same name
+	ErrTenantHasIdentities = errors.New("tenant has identities") // ErrTenantHasIdentities the tenant cannot be deleted because it owns identities
+)
+
+type tenant struct{}
+
+// NewTenant returns a new tenant repository
+func NewTenant() ports.TenantRepository {
+	return &tenant{}
+}
+
+// Save stores a new tenant
+func (t *tenant) Save(ctx context.Context, conn db.Querier, tenant *domain.Tenant) error {
+	_, err := conn.Exec(ctx, `INSERT INTO tenants (id, name, api_key_hash, created_at) VALUES ($1, $2, $3, $4)`,
+		tenant.ID, tenant.Name, tenant.APIKeyHash, tenant.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicatedEntryPGCode {
+			return ErrTenantDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the tenant with the given id
+func (t *tenant) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error) {
+	var tenant domain.Tenant
+	err := conn.QueryRow(ctx, `SELECT id, name, api_key_hash, created_at FROM tenants WHERE id = $1`, id).
+		Scan(&tenant.ID, &tenant.Name, &tenant.APIKeyHash, &tenant.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTenantDoesNotExist
+		}
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// GetAll returns all the tenants
+func (t *tenant) GetAll(ctx context.Context, conn db.Querier) ([]domain.Tenant, error) {
+	rows, err := conn.Query(ctx, `SELECT id, name, api_key_hash, created_at FROM tenants ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenants := make([]domain.Tenant, 0)
+	for rows.Next() {
+		var tenant domain.Tenant
+		if err := rows.Scan(&tenant.ID, &tenant.Name, &tenant.APIKeyHash, &tenant.CreatedAt); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, rows.Err()
+}
+
+// Delete removes a tenant. Tenants that own identities cannot be removed.
+func (t *tenant) Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error {
+	res, err := conn.Exec(ctx, `DELETE FROM tenants WHERE id = $1`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationPGCode {
+			return ErrTenantHasIdentities
+		}
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrTenantDoesNotExist
+	}
+	return nil
+}
+
+// OwnsIdentity returns true if the identity belongs to the tenant
+func (t *tenant) OwnsIdentity(ctx context.Context, conn db.Querier, id uuid.UUID, identifier w3c.DID) (bool, error) {
+	var owns bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM identities WHERE identifier = $1 AND tenant_id = $2)`, identifier.String(), id).Scan(&owns)
+	return owns, err
+}
+
+// tenantOf is the sql expression that resolves the tenant of the issuer identity in the parameter
+func tenantOf(param string) string {
+	return `(SELECT identities.tenant_id FROM identities WHERE identities.identifier = ` + param + `)`
+}
+
+// tenantScope is the sql condition that restricts the rows of table to the tenant in the parameter. A null tenant
+// means that the request is not scoped to a tenant and matches all the rows.
+func tenantScope(table string, param string) string {
+	return `(` + param + `::uuid IS NULL OR ` + table + `.tenant_id = ` + param + `)`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/rand"
@@ -291,6 +292,7 @@ func TestGetAllByIssuerID(t *testing.T) {
 		SignatureProof:  *jsonB,
 		OtherIdentifier: userDID.String(),
 		HIndex:          fmt.Sprintf("%d", rand.Int()),
+		ExternalID:      common.ToPointer("EMP-1234"),
 	}
 	require.NoError(t, c.Data.Set(vc))
 
@@ -339,6 +341,16 @@ func TestGetAllByIssuerID(t *testing.T) {
 			filter:   ports.ClaimsFilter{Subject: userDID.String(), Proofs: []verifiable.ProofType{domain.AnyProofType}},
 			expected: 1,
 		},
+		{
+			name:     "filter.ExternalID should return one entry",
+			filter:   ports.ClaimsFilter{ExternalID: "EMP-1234"},
+			expected: 1,
+		},
+		{
+			name:     "filter.ExternalID not found",
+			filter:   ports.ClaimsFilter{ExternalID: "EMP-0000"},
+			expected: 0,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			claims, total, err := claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &tc.filter)
@@ -664,3 +676,242 @@ func TestGetClaimsIssuedForUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIssuanceStatsBySubjectAndSchema(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	didStr := "did:polygonid:polygon:mumbai:2qJp131YoXVu8iLNGfL3TkQAWEr3pqimh2iaPgH3BJ"
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	schemaHash := "ca938857241db9451ea329256b9c06e6"
+	for _, revoked := range []bool{false, true} {
+		fixture.CreateClaim(t, &domain.Claim{
+			ID:              uuid.New(),
+			Identifier:      common.ToPointer(did.String()),
+			Issuer:          did.String(),
+			SchemaHash:      schemaHash,
+			SchemaURL:       "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/auth.json-ld",
+			SchemaType:      "AuthBJJCredential",
+			OtherIdentifier: userDID.String(),
+			RevNonce:        domain.RevNonceUint64(rand.Int63()),
+			CoreClaim:       domain.CoreClaim{},
+			HIndex:          uuid.New().String(),
+			Revoked:         revoked,
+		})
+	}
+
+	claimsRepo := repositories.NewClaims()
+
+	t.Run("should count only the non revoked credentials", func(t *testing.T) {
+		issued, lastIssuedAt, err := claimsRepo.GetIssuanceStatsBySubjectAndSchema(ctx, storage.Pgx, *did, userDID.String(), schemaHash)
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), issued)
+		assert.NotNil(t, lastIssuedAt)
+	})
+
+	t.Run("should return 0 for a different schema", func(t *testing.T) {
+		issued, lastIssuedAt, err := claimsRepo.GetIssuanceStatsBySubjectAndSchema(ctx, storage.Pgx, *did, userDID.String(), "00000000000000000000000000000000")
+		require.NoError(t, err)
+		assert.Equal(t, uint(0), issued)
+		assert.Nil(t, lastIssuedAt)
+	})
+}
+
+func TestGetNonRevokedBySubjectAndSchemaType(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	didStr := "did:polygonid:polygon:mumbai:2qLduMv2z7hnuhzkcTWesCUuJKpRVDEThztM4tsJUj"
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+
+	userDID := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
+	expired := time.Now().Add(-time.Hour).Unix()
+	for _, tc := range []struct {
+		revoked    bool
+		expiration int64
+	}{
+		{revoked: false, expiration: 0},
+		{revoked: true, expiration: 0},
+		{revoked: false, expiration: expired},
+	} {
+		fixture.CreateClaim(t, &domain.Claim{
+			ID:              uuid.New(),
+			Identifier:      common.ToPointer(did.String()),
+			Issuer:          did.String(),
+			SchemaHash:      "ca938857241db9451ea329256b9c06e7",
+			SchemaURL:       "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			SchemaType:      "KYCAgeCredential",
+			OtherIdentifier: userDID,
+			Expiration:      tc.expiration,
+			RevNonce:        domain.RevNonceUint64(rand.Int63()),
+			CoreClaim:       domain.CoreClaim{},
+			HIndex:          uuid.New().String(),
+			Revoked:         tc.revoked,
+		})
+	}
+
+	claimsRepo := repositories.NewClaims()
+
+	t.Run("should return only the non revoked and non expired credentials", func(t *testing.T) {
+		claims, err := claimsRepo.GetNonRevokedBySubjectAndSchemaType(ctx, storage.Pgx, *did, userDID, "KYCAgeCredential")
+		require.NoError(t, err)
+		assert.Len(t, claims, 1)
+	})
+
+	t.Run("should return nothing for another schema type", func(t *testing.T) {
+		claims, err := claimsRepo.GetNonRevokedBySubjectAndSchemaType(ctx, storage.Pgx, *did, userDID, "KYCCountryOfResidenceCredential")
+		require.NoError(t, err)
+		assert.Len(t, claims, 0)
+	})
+}
+
+func TestGetNonRevokedByUniqueKey(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	didStr := "did:polygonid:polygon:mumbai:2qNevtQ3kDbgMuV4mLGnHM7nmeHRtACJaq8etV1mC1"
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+
+	const schemaURL = "https://example.com/schemas/EmployeeCredential.json"
+	for _, tc := range []struct {
+		employeeID string
+		revoked    bool
+	}{
+		{employeeID: "E-1", revoked: false},
+		{employeeID: "E-1", revoked: true},
+		{employeeID: "E-2", revoked: false},
+	} {
+		claim := &domain.Claim{
+			ID:         uuid.New(),
+			Identifier: common.ToPointer(did.String()),
+			Issuer:     did.String(),
+			SchemaHash: "ca938857241db9451ea329256b9c06e7",
+			SchemaURL:  schemaURL,
+			SchemaType: "EmployeeCredential",
+			RevNonce:   domain.RevNonceUint64(rand.Int63()),
+			CoreClaim:  domain.CoreClaim{},
+			HIndex:     uuid.New().String(),
+			Revoked:    tc.revoked,
+		}
+		require.NoError(t, claim.Data.Set(verifiable.W3CCredential{
+			CredentialSubject: map[string]any{"id": "did:iden3:user", "employeeId": tc.employeeID, "company": "ACME"},
+		}))
+		fixture.CreateClaim(t, claim)
+	}
+
+	claimsRepo := repositories.NewClaims()
+
+	t.Run("should return the active credentials with the unique key", func(t *testing.T) {
+		claims, err := claimsRepo.GetNonRevokedByUniqueKey(ctx, storage.Pgx, *did, schemaURL, "EmployeeCredential", map[string]any{"employeeId": "E-1", "company": "ACME"})
+		require.NoError(t, err)
+		assert.Len(t, claims, 1)
+	})
+
+	t.Run("should return nothing for another unique key", func(t *testing.T) {
+		claims, err := claimsRepo.GetNonRevokedByUniqueKey(ctx, storage.Pgx, *did, schemaURL, "EmployeeCredential", map[string]any{"employeeId": "E-3"})
+		require.NoError(t, err)
+		assert.Len(t, claims, 0)
+	})
+}
+
+func TestLockUniqueKey(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qNevtQ3kDbgMuV4mLGnHM7nmeHRtACJaq8etV1mC1")
+	require.NoError(t, err)
+	claimsRepo := repositories.NewClaims()
+	const schemaURL = "https://example.com/schemas/EmployeeCredential.json"
+
+	tx, err := storage.Pgx.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+	require.NoError(t, claimsRepo.LockUniqueKey(ctx, tx, *did, schemaURL, "EmployeeCredential", map[string]any{"employeeId": "E-1", "company": "ACME"}))
+
+	t.Run("should wait for the transaction holding the same key", func(t *testing.T) {
+		other, err := storage.Pgx.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = other.Rollback(ctx) }()
+		ctxTimeout, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		assert.Error(t, claimsRepo.LockUniqueKey(ctxTimeout, other, *did, schemaURL, "EmployeeCredential", map[string]any{"company": "ACME", "employeeId": "E-1"}))
+	})
+
+	t.Run("should not wait for other keys", func(t *testing.T) {
+		other, err := storage.Pgx.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = other.Rollback(ctx) }()
+		assert.NoError(t, claimsRepo.LockUniqueKey(ctx, other, *did, schemaURL, "EmployeeCredential", map[string]any{"employeeId": "E-2", "company": "ACME"}))
+	})
+}
+
+func TestSaveBatchAndRevokeBatch(t *testing.T) {
+	ctx := context.Background()
+	idStr := "did:polygonid:polygon:mumbai:2qDDDKmo436EZGCBAvkqZjADYoNRJszkG7UymZeCHQ"
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := w3c.ParseDID(idStr)
+	require.NoError(t, err)
+	claimsRepo := repositories.NewClaims()
+
+	claims := make([]*domain.Claim, 3)
+	for i := range claims {
+		claims[i] = &domain.Claim{
+			Identifier: &idStr,
+			Issuer:     idStr,
+			SchemaHash: "ca938857241db9451ea329256b9c06e5",
+			SchemaURL:  "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/auth.json-ld",
+			SchemaType: "AuthBJJCredential",
+			RevNonce:   domain.RevNonceUint64(1000 + i),
+			CoreClaim:  domain.CoreClaim{},
+			HIndex:     fmt.Sprintf("batch-%d", i),
+			CreatedAt:  time.Now(),
+		}
+	}
+
+	t.Run("should save all the claims", func(t *testing.T) {
+		ids, err := claimsRepo.SaveBatch(ctx, storage.Pgx, claims)
+		require.NoError(t, err)
+		require.Len(t, ids, len(claims))
+		for i, id := range ids {
+			assert.Equal(t, claims[i].ID, id)
+			claim, err := claimsRepo.GetByIdAndIssuer(ctx, storage.Pgx, did, id)
+			require.NoError(t, err)
+			assert.Equal(t, claims[i].RevNonce, claim.RevNonce)
+		}
+	})
+
+	t.Run("should save none of the claims if one is duplicated", func(t *testing.T) {
+		duplicated := *claims[0]
+		batch := []*domain.Claim{{Identifier: &idStr, Issuer: idStr, SchemaType: "AuthBJJCredential", CoreClaim: domain.CoreClaim{}, HIndex: "batch-new"}, &duplicated}
+		err := storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			_, err := claimsRepo.SaveBatch(ctx, tx, batch)
+			return err
+		})
+		assert.ErrorIs(t, err, repositories.ErrClaimDuplication)
+		_, err = claimsRepo.GetByIdAndIssuer(ctx, storage.Pgx, did, batch[0].ID)
+		assert.ErrorIs(t, err, repositories.ErrClaimDoesNotExist)
+	})
+
+	t.Run("should revoke all the nonces", func(t *testing.T) {
+		revocations := make([]*domain.Revocation, len(claims))
+		for i := range claims {
+			revocations[i] = &domain.Revocation{Identifier: idStr, Nonce: claims[i].RevNonce, Version: 0, Status: domain.RevPending, Description: "batch"}
+		}
+		require.NoError(t, claimsRepo.RevokeBatch(ctx, storage.Pgx, revocations))
+		pending, err := repositories.NewRevocation().GetPending(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		assert.Len(t, pending, len(revocations))
+	})
+}
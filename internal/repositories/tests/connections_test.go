@@ -408,3 +408,58 @@ func TestGetByUserID(t *testing.T) {
 		assert.NotNil(t, conn)
 	})
 }
+
+func TestUpdateProfile(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := repositories.NewConnections()
+	fixture := tests.NewFixture(storage)
+
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qCp9Tx4x5hzchym1dZXtBpwRQsH7HXe7GcbvskoRn")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+		Profile:    domain.ConnectionProfile{DisplayName: common.ToPointer("Jane Doe")},
+	})
+
+	t.Run("should update the given fields", func(t *testing.T) {
+		err := connectionsRepo.UpdateProfile(ctx, storage.Pgx, connID, *issuerDID, domain.ConnectionProfile{
+			ExternalID: common.ToPointer("EMP-1234"),
+			Email:      common.ToPointer("jane.doe@example.com"),
+		})
+		require.NoError(t, err)
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", *conn.Profile.DisplayName)
+		assert.Equal(t, "EMP-1234", *conn.Profile.ExternalID)
+		assert.Equal(t, "jane.doe@example.com", *conn.Profile.Email)
+	})
+
+	t.Run("should find the connection by its profile", func(t *testing.T) {
+		for _, query := range []string{"jane", "EMP-1234", "doe@example"} {
+			conns, _, err := connectionsRepo.GetAllWithCredentialsByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.NewGetAllConnectionsRequest{Query: query})
+			require.NoError(t, err)
+			require.Len(t, conns, 1, query)
+			assert.Equal(t, connID, conns[0].ID)
+		}
+	})
+
+	t.Run("should remove the fields set to an empty string", func(t *testing.T) {
+		err := connectionsRepo.UpdateProfile(ctx, storage.Pgx, connID, *issuerDID, domain.ConnectionProfile{DisplayName: common.ToPointer("")})
+		require.NoError(t, err)
+		conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *issuerDID)
+		require.NoError(t, err)
+		assert.Nil(t, conn.Profile.DisplayName)
+		assert.NotNil(t, conn.Profile.Email)
+	})
+
+	t.Run("should fail for an unknown connection", func(t *testing.T) {
+		err := connectionsRepo.UpdateProfile(ctx, storage.Pgx, uuid.New(), *issuerDID, domain.ConnectionProfile{DisplayName: common.ToPointer("John")})
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+	})
+}
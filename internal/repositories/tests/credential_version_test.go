@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestCredentialVersions(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	repo := repositories.NewCredentialVersion()
+
+	idStr := "did:polygonid:polygon:mumbai:2qKdCHmS1xBG8DQuLCNMnhSRn7fnGkHpaBqGmf6VFt"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := w3c.ParseDID(idStr)
+	require.NoError(t, err)
+
+	newCredential := func(t *testing.T) uuid.UUID {
+		t.Helper()
+		claim := fixture.NewClaim(t, idStr)
+		claim.HIndex = uuid.NewString()
+		return fixture.CreateClaim(t, claim)
+	}
+	first, second, third := newCredential(t), newCredential(t), newCredential(t)
+	save := func(t *testing.T, repo ports.CredentialVersionRepository, credentialID, previousID uuid.UUID) *domain.CredentialVersion {
+		t.Helper()
+		version := &domain.CredentialVersion{
+			ID:                   uuid.New(),
+			IssuerDID:            *did,
+			CredentialID:         credentialID,
+			PreviousCredentialID: previousID,
+			Changes:              []domain.CredentialSubjectChange{{Attribute: "documentType", Before: float64(2), After: float64(3)}},
+			CreatedAt:            time.Now().UTC(),
+		}
+		tx, err := storage.Pgx.Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, tx, version))
+		require.NoError(t, tx.Commit(ctx))
+		return version
+	}
+
+	t.Run("should chain the versions of a lineage", func(t *testing.T) {
+		v2 := save(t, repo, second, first)
+		assert.Equal(t, first, v2.LineageID)
+		assert.Equal(t, 2, v2.Version)
+		v3 := save(t, repo, third, second)
+		assert.Equal(t, first, v3.LineageID)
+		assert.Equal(t, 3, v3.Version)
+
+		for _, id := range []uuid.UUID{first, second, third} {
+			versions, err := repo.GetLineage(ctx, storage.Pgx, *did, id)
+			require.NoError(t, err)
+			require.Len(t, versions, 2)
+			assert.Equal(t, second, versions[0].CredentialID)
+			assert.Equal(t, third, versions[1].CredentialID)
+			assert.Equal(t, v2.Changes, versions[0].Changes)
+		}
+	})
+
+	t.Run("should be empty without versions", func(t *testing.T) {
+		versions, err := repo.GetLineage(ctx, storage.Pgx, *did, uuid.New())
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("should reject changes", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, `UPDATE credential_versions SET version = 10 WHERE credential_id = $1`, second)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject versions of unknown credentials", func(t *testing.T) {
+		version := &domain.CredentialVersion{ID: uuid.New(), IssuerDID: *did, CredentialID: uuid.New(), PreviousCredentialID: first, CreatedAt: time.Now().UTC()}
+		tx, err := storage.Pgx.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+		assert.Error(t, repo.Save(ctx, tx, version))
+	})
+
+	t.Run("should encrypt the changes", func(t *testing.T) {
+		envelope, err := encryption.NewEnvelope(make([]byte, 32))
+		require.NoError(t, err)
+		encrypted := repositories.NewCredentialVersionWithCipher(envelope)
+		previous, current := newCredential(t), newCredential(t)
+		version := save(t, encrypted, current, previous)
+
+		var stored []byte
+		require.NoError(t, storage.Pgx.QueryRow(ctx, `SELECT changes FROM credential_versions WHERE id = $1`, version.ID).Scan(&stored))
+		assert.NotContains(t, string(stored), "documentType")
+
+		versions, err := encrypted.GetLineage(ctx, storage.Pgx, *did, current)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, version.Changes, versions[0].Changes)
+	})
+
+	t.Run("should be deleted with the credentials", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, `DELETE FROM claims WHERE id = $1`, second)
+		require.NoError(t, err)
+		versions, err := repo.GetLineage(ctx, storage.Pgx, *did, first)
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+}
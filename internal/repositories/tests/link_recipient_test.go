@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/encryption"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestLinkRecipients(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:polygonid:polygon:mumbai:2qFXWZVHPy8R8AWaaCfm5PLF9uSFJgqqhmaTcwaEdQ"
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+
+	schemaID := insertSchemaForLink(ctx, didStr, repositories.NewSchema(*storage), t)
+	linkID, err := repositories.NewLink(*storage).Save(ctx, storage.Pgx, domain.NewLink(*did, common.ToPointer[int](10), nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil))
+	require.NoError(t, err)
+
+	envelope, err := encryption.NewEnvelope(make([]byte, 32))
+	require.NoError(t, err)
+	repo := repositories.NewLinkRecipientWithCipher(envelope)
+
+	subject := domain.CredentialSubject{"birthday": json.Number("19960424"), "documentType": json.Number("2")}
+	recipient := domain.NewLinkRecipient(*linkID, "code-1", subject)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, []domain.LinkRecipient{*recipient}))
+
+	t.Run("should encrypt the credential subject", func(t *testing.T) {
+		var stored []byte
+		require.NoError(t, storage.Pgx.QueryRow(ctx, `SELECT credential_subject FROM link_recipients WHERE id = $1`, recipient.ID).Scan(&stored))
+		assert.NotContains(t, string(stored), "birthday")
+	})
+
+	t.Run("should decrypt the credential subject", func(t *testing.T) {
+		got, err := repo.GetByCode(ctx, storage.Pgx, *linkID, "code-1")
+		require.NoError(t, err)
+		assert.Equal(t, subject, got.CredentialSubject)
+
+		all, err := repo.GetByLink(ctx, storage.Pgx, *linkID)
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		assert.Equal(t, subject, all[0].CredentialSubject)
+	})
+}
@@ -2,10 +2,14 @@ package tests
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	sql "github.com/iden3/go-merkletree-sql/db/pgx/v2"
+	"github.com/iden3/go-merkletree-sql/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
@@ -77,3 +81,36 @@ func TestMtGetById(t *testing.T) {
 		assert.Nil(t, mts)
 	})
 }
+
+func TestMTNodesBuffer(t *testing.T) {
+	ctx := context.Background()
+	idStr := "did:polygonid:polygon:amoy:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	mt, err := repositories.NewIdentityMerkleTreeRepository().Save(ctx, storage.Pgx, idStr, 0)
+	require.NoError(t, err)
+
+	tx, err := storage.Pgx.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	buffer := repositories.NewMTNodesBuffer(tx, mt.ID)
+	buffered, err := merkletree.NewMerkleTree(ctx, buffer, 40)
+	require.NoError(t, err)
+	for i := int64(1); i <= 20; i++ {
+		require.NoError(t, buffered.Add(ctx, big.NewInt(i), big.NewInt(i*10)))
+	}
+
+	t.Run("should not write before flushing", func(t *testing.T) {
+		_, err := sql.NewSqlStorage(tx, mt.ID).GetRoot(ctx)
+		assert.ErrorIs(t, err, merkletree.ErrNotFound)
+	})
+
+	t.Run("should write the tree when flushed", func(t *testing.T) {
+		require.NoError(t, buffer.Flush(ctx))
+		tree, err := merkletree.NewMerkleTree(ctx, sql.NewSqlStorage(tx, mt.ID), 40)
+		require.NoError(t, err)
+		assert.Equal(t, buffered.Root(), tree.Root())
+		_, value, _, err := tree.Get(ctx, big.NewInt(7))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(70), value)
+	})
+}
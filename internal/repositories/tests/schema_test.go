@@ -61,6 +61,86 @@ func TestGetSchema(t *testing.T) {
 	assert.Equal(t, schema1.Version, schema2.Version)
 }
 
+func TestSchemaExpiration(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did, err := w3c.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+
+	schema := &domain.Schema{
+		ID:                    uuid.New(),
+		IssuerDID:             *did,
+		URL:                   "https://an.url.org/" + uuid.NewString() + ".json",
+		Type:                  "schemaType",
+		Hash:                  core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Words:                 domain.SchemaWords{"field1"},
+		CreatedAt:             time.Now(),
+		Version:               "1.0.0",
+		DefaultExpirationDays: common.ToPointer(365),
+	}
+	require.NoError(t, store.Save(ctx, schema))
+
+	t.Run("should get the schema by url with its expiration", func(t *testing.T) {
+		got, err := store.GetByURL(ctx, *did, schema.URL, schema.Type)
+		require.NoError(t, err)
+		assert.Equal(t, schema.ID, got.ID)
+		assert.Equal(t, common.ToPointer(365), got.DefaultExpirationDays)
+		assert.Nil(t, got.MaxExpirationDays)
+	})
+
+	t.Run("should update the expiration", func(t *testing.T) {
+		schema.DefaultExpirationDays = nil
+		schema.MaxExpirationDays = common.ToPointer(30)
+		require.NoError(t, store.UpdateExpiration(ctx, schema))
+		got, err := store.GetByID(ctx, *did, schema.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.DefaultExpirationDays)
+		assert.Equal(t, common.ToPointer(30), got.MaxExpirationDays)
+	})
+
+	t.Run("should not get the schema of another type", func(t *testing.T) {
+		_, err := store.GetByURL(ctx, *did, schema.URL, "otherType")
+		assert.ErrorIs(t, err, repositories.ErrSchemaDoesNotExist)
+	})
+}
+
+func TestSchemaUniqueness(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did, err := w3c.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+
+	schema := &domain.Schema{
+		ID:               uuid.New(),
+		IssuerDID:        *did,
+		URL:              "https://an.url.org/" + uuid.NewString() + ".json",
+		Type:             "schemaType",
+		Hash:             core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Words:            domain.SchemaWords{"employeeId"},
+		CreatedAt:        time.Now(),
+		Version:          "1.0.0",
+		UniqueAttributes: []string{"employeeId"},
+		UniqueStrategy:   domain.SchemaUniqueStrategyRevoke,
+	}
+	require.NoError(t, store.Save(ctx, schema))
+
+	t.Run("should get the schema with its unique attributes", func(t *testing.T) {
+		got, err := store.GetByID(ctx, *did, schema.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"employeeId"}, got.UniqueAttributes)
+		assert.Equal(t, domain.SchemaUniqueStrategyRevoke, got.UniqueStrategy)
+	})
+
+	t.Run("should remove the unique attributes", func(t *testing.T) {
+		schema.UniqueAttributes, schema.UniqueStrategy = nil, ""
+		require.NoError(t, store.UpdateUniqueness(ctx, schema))
+		got, err := store.GetByURL(ctx, *did, schema.URL, schema.Type)
+		require.NoError(t, err)
+		assert.Empty(t, got.UniqueAttributes)
+		assert.Empty(t, got.UniqueStrategy)
+	})
+}
+
 func TestCreateSchema(t *testing.T) {
 	rand.NewSource(time.Now().Unix())
 	ctx := context.Background()
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestGetStats(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := repositories.NewConnections()
+	statsRepo := repositories.NewStats()
+
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1ZT16aqEWhh9mX9aqM2pe2ZwV995dTkReeKwCaQ")
+	require.NoError(t, err)
+	userDID1, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qD6cqGpLX2dibdFuKfrPxGiybi3wKa8RbR4onw49H")
+	require.NoError(t, err)
+	userDID2, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qHgCmGW1wDH5ShTH94SssR4eN8XW4xyHLfop2Qoqm")
+	require.NoError(t, err)
+
+	for _, userDID := range []*w3c.DID{userDID1, userDID2} {
+		_, err := connectionsRepo.Save(ctx, storage.Pgx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -29)
+
+	t.Run("should get the stats of the issuer", func(t *testing.T) {
+		stats, err := statsRepo.Get(ctx, storage.Pgx, *issuerDID, from, 30)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.Connections)
+		assert.Equal(t, 0, stats.CredentialsIssued)
+		assert.Equal(t, 0, stats.CredentialsRevoked)
+		require.Len(t, stats.CredentialsIssuedPerDay, 30)
+		require.Len(t, stats.ConnectionsPerDay, 30)
+		assert.Equal(t, 2, stats.ConnectionsPerDay[29].Count)
+	})
+
+	t.Run("should get the monthly gas spend of the issuer", func(t *testing.T) {
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -11, 0)
+		spends, err := statsRepo.GetGasSpend(ctx, storage.Pgx, *issuerDID, month, 12, "USD")
+		require.NoError(t, err)
+		require.Len(t, spends, 12)
+		assert.Equal(t, month, spends[0].Month)
+		assert.Equal(t, 0, spends[11].StateTransitions)
+		assert.Equal(t, "0", spends[11].TxFee)
+		assert.Equal(t, "0", spends[11].TxFeeFiat)
+	})
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/tenancy"
+)
+
+func TestTenantOwnsIdentity(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	tenantRepo := repositories.NewTenant()
+	identityRepo := repositories.NewIdentity()
+
+	tenant := &domain.Tenant{
+		ID:         uuid.New(),
+		Name:       "tenant-" + uuid.NewString(),
+		APIKeyHash: "hash",
+		CreatedAt:  time.Now().UTC(),
+	}
+	require.NoError(t, tenantRepo.Save(ctx, storage.Pgx, tenant))
+
+	idStr := "did:polygonid:polygon:mumbai:2qPtvSaMx5FVdpXfVxWDsDj6mzt2W3cabGUVyyCmPp"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr, TenantID: &tenant.ID})
+	did, err := w3c.ParseDID(idStr)
+	require.NoError(t, err)
+
+	t.Run("should own the identity", func(t *testing.T) {
+		owns, err := tenantRepo.OwnsIdentity(ctx, storage.Pgx, tenant.ID, *did)
+		assert.NoError(t, err)
+		assert.True(t, owns)
+	})
+
+	t.Run("should not own the identity", func(t *testing.T) {
+		owns, err := tenantRepo.OwnsIdentity(ctx, storage.Pgx, uuid.New(), *did)
+		assert.NoError(t, err)
+		assert.False(t, owns)
+	})
+
+	t.Run("should get the tenant identities", func(t *testing.T) {
+		identities, err := identityRepo.GetByTenantID(ctx, storage.Pgx, tenant.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{idStr}, identities)
+	})
+
+	t.Run("should not delete a tenant with identities", func(t *testing.T) {
+		assert.ErrorIs(t, tenantRepo.Delete(ctx, storage.Pgx, tenant.ID), repositories.ErrTenantHasIdentities)
+	})
+}
+
+func TestTenantScope(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	tenantRepo := repositories.NewTenant()
+	schemaRepo := repositories.NewSchema(*storage)
+	connectionsRepo := repositories.NewConnections()
+
+	tenant := &domain.Tenant{
+		ID:         uuid.New(),
+		Name:       "tenant-" + uuid.NewString(),
+		APIKeyHash: "hash",
+		CreatedAt:  time.Now().UTC(),
+	}
+	require.NoError(t, tenantRepo.Save(ctx, storage.Pgx, tenant))
+
+	idStr := "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr, TenantID: &tenant.ID})
+	did, err := w3c.ParseDID(idStr)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	schema := &domain.Schema{
+		ID:        uuid.New(),
+		IssuerDID: *did,
+		URL:       "https://an.url.org/tenant.json",
+		Type:      "TenantCredential",
+		Hash:      core.NewSchemaHashFromInt(big.NewInt(1)),
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, schemaRepo.Save(ctx, schema))
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *did,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	tenantCtx := tenancy.WithTenant(ctx, tenant.ID)
+	otherTenantCtx := tenancy.WithTenant(ctx, uuid.New())
+
+	t.Run("should be visible without tenant", func(t *testing.T) {
+		_, err := schemaRepo.GetByID(ctx, *did, schema.ID)
+		assert.NoError(t, err)
+		_, err = connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *did)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should be visible for the tenant", func(t *testing.T) {
+		_, err := schemaRepo.GetByID(tenantCtx, *did, schema.ID)
+		assert.NoError(t, err)
+		schemas, err := schemaRepo.GetAll(tenantCtx, *did, nil)
+		assert.NoError(t, err)
+		assert.Len(t, schemas, 1)
+		_, err = connectionsRepo.GetByIDAndIssuerID(tenantCtx, storage.Pgx, connID, *did)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not be visible for other tenants", func(t *testing.T) {
+		_, err := schemaRepo.GetByID(otherTenantCtx, *did, schema.ID)
+		assert.ErrorIs(t, err, repositories.ErrSchemaDoesNotExist)
+		schemas, err := schemaRepo.GetAll(otherTenantCtx, *did, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, schemas)
+		_, err = connectionsRepo.GetByIDAndIssuerID(otherTenantCtx, storage.Pgx, connID, *did)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+		assert.ErrorIs(t, connectionsRepo.Delete(otherTenantCtx, storage.Pgx, connID, *did), repositories.ErrConnectionDoesNotExist)
+	})
+}
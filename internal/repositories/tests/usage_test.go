@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestGetUsage(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	usageRepo := repositories.NewUsage()
+
+	tenant := &domain.Tenant{
+		ID:         uuid.New(),
+		Name:       "tenant-" + uuid.NewString(),
+		APIKeyHash: "hash",
+		CreatedAt:  time.Now().UTC(),
+	}
+	require.NoError(t, repositories.NewTenant().Save(ctx, storage.Pgx, tenant))
+
+	issuer := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UWxHUPw55g15QgEVGnj6Wkq8Vk"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuer, TenantID: &tenant.ID})
+	issuerDID, err := w3c.ParseDID(issuer)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFVUasb8QZ1XAmD71b3NA8bzQhGs92VQEPgELYnpk")
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	})
+	fixture.CreateUserAuthentication(t, connID, uuid.New(), now)
+	fixture.CreateUserAuthentication(t, connID, uuid.New(), now)
+	fixture.CreateUserAuthentication(t, connID, uuid.New(), now.AddDate(0, -2, 0))
+
+	filter := domain.UsageFilter{TenantID: &tenant.ID}
+
+	t.Run("should get the usage of the tenant issuers in the period", func(t *testing.T) {
+		usages, err := usageRepo.Get(ctx, storage.Pgx, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), filter)
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		assert.Equal(t, issuer, usages[0].IssuerDID)
+		assert.Equal(t, tenant.Name, *usages[0].TenantName)
+		assert.Equal(t, 2, usages[0].Verifications)
+		assert.Equal(t, 0, usages[0].StateTransitions)
+		assert.Equal(t, "0", usages[0].TxFee)
+	})
+
+	t.Run("should not get the usage of other tenants", func(t *testing.T) {
+		other := uuid.New()
+		usages, err := usageRepo.Get(ctx, storage.Pgx, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), domain.UsageFilter{TenantID: &other})
+		require.NoError(t, err)
+		assert.Empty(t, usages)
+	})
+}
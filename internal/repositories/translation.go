@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrTranslationDoesNotExist the issuer has no translations for the locale
+var ErrTranslationDoesNotExist = errors.New("translation does not exist")
+
+type translation struct{}
+
+// NewTranslation returns a new translation repository
+func NewTranslation() ports.TranslationRepository {
+	return &translation{}
+}
+
+// Save stores the translations of an issuer to a locale, replacing the previous ones
+func (t *translation) Save(ctx context.Context, conn db.Querier, bundle *domain.TranslationBundle) error {
+	messages := pgtype.JSONB{}
+	if err := messages.Set(bundle.Messages); err != nil {
+		return err
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO translations (issuer_id, locale, messages, modified_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (issuer_id, locale) DO UPDATE SET messages = $3, modified_at = $4`,
+		bundle.IssuerDID.String(), bundle.Locale, messages, bundle.ModifiedAt)
+	return err
+}
+
+// Get returns the translations of an issuer to a locale
+func (t *translation) Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, locale string) (*domain.TranslationBundle, error) {
+	row := conn.QueryRow(ctx, `SELECT locale, messages, modified_at FROM translations WHERE issuer_id = $1 AND locale = $2`, issuerDID.String(), locale)
+	bundle, err := toTranslationBundle(row, issuerDID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTranslationDoesNotExist
+		}
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// GetAll returns the translations of an issuer to every locale
+func (t *translation) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.TranslationBundle, error) {
+	rows, err := conn.Query(ctx, `SELECT locale, messages, modified_at FROM translations WHERE issuer_id = $1 ORDER BY locale`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bundles := make([]domain.TranslationBundle, 0)
+	for rows.Next() {
+		bundle, err := toTranslationBundle(rows, issuerDID)
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, *bundle)
+	}
+	return bundles, rows.Err()
+}
+
+// Delete removes the translations of an issuer to a locale
+func (t *translation) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, locale string) error {
+	tag, err := conn.Exec(ctx, `DELETE FROM translations WHERE issuer_id = $1 AND locale = $2`, issuerDID.String(), locale)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTranslationDoesNotExist
+	}
+	return nil
+}
+
+func toTranslationBundle(row pgx.Row, issuerDID w3c.DID) (*domain.TranslationBundle, error) {
+	bundle := domain.TranslationBundle{IssuerDID: issuerDID}
+	var messages pgtype.JSONB
+	if err := row.Scan(&bundle.Locale, &messages, &bundle.ModifiedAt); err != nil {
+		return nil, err
+	}
+	if err := messages.AssignTo(&bundle.Messages); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
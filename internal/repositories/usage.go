@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// usageQuery aggregates the billable events of every identity in [$1, $2). Verifications are the holder
+// authentications of the issuer connections and state transitions are counted when they are confirmed on chain,
+// genesis states excluded.
+const usageQuery = `
+SELECT identities.identifier, identities.tenant_id, tenants.name,
+    (SELECT count(*) FROM claims WHERE claims.identifier = identities.identifier AND claims.issuer = identities.identifier
+        AND claims.schema_type <> $3 AND claims.created_at >= $1 AND claims.created_at < $2),
+    (SELECT count(*) FROM user_authentications
+        JOIN connections ON connections.id = user_authentications.connection_id
+        WHERE connections.issuer_id = identities.identifier
+        AND user_authentications.created_at >= $1 AND user_authentications.created_at < $2),
+    states.transitions, states.gas_used, states.tx_fee
+FROM identities
+LEFT JOIN tenants ON tenants.id = identities.tenant_id
+CROSS JOIN LATERAL (
+    SELECT count(*) AS transitions, coalesce(sum(gas_used), 0) AS gas_used, coalesce(sum(tx_fee), 0)::text AS tx_fee
+    FROM identity_states
+    WHERE identity_states.identifier = identities.identifier AND identity_states.status = $4
+        AND identity_states.previous_state IS NOT NULL
+        AND identity_states.modified_at >= $1 AND identity_states.modified_at < $2
+) AS states
+WHERE ($5::uuid IS NULL OR identities.tenant_id = $5) AND ($6::text IS NULL OR identities.identifier = $6)
+ORDER BY tenants.name NULLS FIRST, identities.identifier`
+
+type usage struct{}
+
+// NewUsage returns a new usage repository
+func NewUsage() ports.UsageRepository {
+	return &usage{}
+}
+
+// Get returns the usage of the identities matching the filter between from (included) and to (excluded)
+func (u *usage) Get(ctx context.Context, conn db.Querier, from, to time.Time, filter domain.UsageFilter) ([]domain.IssuerUsage, error) {
+	rows, err := conn.Query(ctx, usageQuery, from, to, domain.AuthBJJCredentialSchemaType, domain.StatusConfirmed,
+		filter.TenantID, filter.IssuerDID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usages := make([]domain.IssuerUsage, 0)
+	for rows.Next() {
+		var usage domain.IssuerUsage
+		if err := rows.Scan(
+			&usage.IssuerDID,
+			&usage.TenantID,
+			&usage.TenantName,
+			&usage.CredentialsIssued,
+			&usage.Verifications,
+			&usage.StateTransitions,
+			&usage.GasUsed,
+			&usage.TxFee,
+		); err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, rows.Err()
+}
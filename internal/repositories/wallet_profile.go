@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrWalletProfileDoesNotExist the holder has not sent any message to the issuer yet
+var ErrWalletProfileDoesNotExist = errors.New("wallet profile does not exist")
+
+type walletProfile struct{}
+
+// NewWalletProfile returns a new wallet profiles repository
+func NewWalletProfile() ports.WalletProfileRepository {
+	return &walletProfile{}
+}
+
+// Observe adds the observation to the profile of the wallet of the holder. The media types and message types are
+// accumulated, while the user agent and the accepted media types are replaced by the last ones sent.
+func (w *walletProfile) Observe(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID, observation domain.WalletObservation) error {
+	var userAgent *string
+	if observation.UserAgent != "" {
+		userAgent = &observation.UserAgent
+	}
+	mediaTypes, rejected := []string{}, []string{}
+	if observation.Rejected {
+		rejected = append(rejected, string(observation.MediaType))
+	} else {
+		mediaTypes = append(mediaTypes, string(observation.MediaType))
+	}
+	accept := observation.Accept
+	if accept == nil {
+		accept = []string{}
+	}
+	now := time.Now().UTC()
+	_, err := conn.Exec(ctx, `INSERT INTO wallet_profiles (issuer_id, user_id, user_agent, media_types, rejected_media_types, accept, message_types, first_seen_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8) ON CONFLICT (issuer_id, user_id) DO
+		UPDATE SET user_agent = coalesce(EXCLUDED.user_agent, wallet_profiles.user_agent),
+		           media_types = ARRAY(SELECT DISTINCT m FROM unnest(wallet_profiles.media_types || EXCLUDED.media_types) AS m ORDER BY m),
+		           rejected_media_types = ARRAY(SELECT DISTINCT m FROM unnest(wallet_profiles.rejected_media_types || EXCLUDED.rejected_media_types) AS m ORDER BY m),
+		           accept = CASE WHEN cardinality(EXCLUDED.accept) > 0 THEN EXCLUDED.accept ELSE wallet_profiles.accept END,
+		           message_types = ARRAY(SELECT DISTINCT m FROM unnest(wallet_profiles.message_types || EXCLUDED.message_types) AS m ORDER BY m),
+		           last_seen_at = EXCLUDED.last_seen_at`,
+		issuerDID.String(), userDID.String(), userAgent, mediaTypes, rejected, accept, []string{string(observation.MessageType)}, now)
+	return err
+}
+
+// Get returns the profile of the wallet of the holder
+func (w *walletProfile) Get(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.WalletProfile, error) {
+	profile := domain.WalletProfile{IssuerDID: issuerDID, UserDID: userDID}
+	err := conn.QueryRow(ctx, `SELECT user_agent, media_types, rejected_media_types, accept, message_types, first_seen_at, last_seen_at
+		FROM wallet_profiles WHERE issuer_id = $1 AND user_id = $2`, issuerDID.String(), userDID.String()).Scan(
+		&profile.UserAgent, &profile.MediaTypes, &profile.RejectedMediaTypes, &profile.Accept, &profile.MessageTypes, &profile.FirstSeenAt, &profile.LastSeenAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWalletProfileDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// GetUsage returns the wallets of the holders of the issuer grouped by user agent, the most used first
+func (w *walletProfile) GetUsage(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]domain.WalletUsage, error) {
+	rows, err := conn.Query(ctx, `
+SELECT w.user_agent,
+       count(*),
+       ARRAY(SELECT DISTINCT m FROM wallet_profiles p, unnest(p.media_types) AS m
+             WHERE p.issuer_id = $1 AND p.user_agent IS NOT DISTINCT FROM w.user_agent ORDER BY m),
+       ARRAY(SELECT DISTINCT m FROM wallet_profiles p, unnest(p.rejected_media_types) AS m
+             WHERE p.issuer_id = $1 AND p.user_agent IS NOT DISTINCT FROM w.user_agent ORDER BY m),
+       ARRAY(SELECT DISTINCT m FROM wallet_profiles p, unnest(p.accept) AS m
+             WHERE p.issuer_id = $1 AND p.user_agent IS NOT DISTINCT FROM w.user_agent ORDER BY m),
+       max(w.last_seen_at)
+FROM wallet_profiles w
+WHERE w.issuer_id = $1
+GROUP BY w.user_agent
+ORDER BY count(*) DESC, w.user_agent`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usage := make([]domain.WalletUsage, 0)
+	for rows.Next() {
+		var u domain.WalletUsage
+		if err := rows.Scan(&u.UserAgent, &u.Connections, &u.MediaTypes, &u.RejectedMediaTypes, &u.Accept, &u.LastSeenAt); err != nil {
+			return nil, err
+		}
+		usage = append(usage, u)
+	}
+	return usage, rows.Err()
+}
@@ -0,0 +1,136 @@
+// Package secrets keeps the current value of the secrets that can be rotated without restarting the issuer node.
+package secrets
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// Names of the files of the secrets that can be rotated
+const (
+	DatabasePassword = "database-password"
+	VaultToken       = "vault-token"
+	CachePassword    = "cache-password"
+)
+
+// Store keeps the secrets of a directory, one file per secret like the secrets mounted by kubernetes. The secrets are
+// reloaded when the files change and on SIGHUP, and the listeners of the changed secrets are called.
+type Store struct {
+	dir string
+
+	mu        sync.RWMutex
+	values    map[string]string
+	listeners map[string][]func(value string)
+}
+
+// NewStore loads the secrets of the directory
+func NewStore(ctx context.Context, dir string) (*Store, error) {
+	s := &Store{dir: dir, values: map[string]string{}, listeners: map[string][]func(string){}}
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get returns the current value of the secret, or false if the directory doesn't have it
+func (s *Store) Get(name string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[name]
+	return value, ok
+}
+
+// Secret returns a function with the current value of the secret, empty if the store is nil or the directory doesn't
+// have it, so the connections opened with it use the password of their url instead
+func (s *Store) Secret(name string) func() string {
+	return func() string {
+		if s == nil {
+			return ""
+		}
+		value, _ := s.Get(name)
+		return value
+	}
+}
+
+// OnChange registers a function called with the new value of the secret every time it changes
+func (s *Store) OnChange(name string, fn func(value string)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners[name] = append(s.listeners[name], fn)
+}
+
+// Reload reads the secrets of the directory again and notifies the changes. A removed file keeps the last value of
+// its secret, because the files are replaced, not removed, when the secrets are rotated.
+func (s *Store) Reload(ctx context.Context) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	type change struct {
+		name  string
+		value string
+	}
+	var changes []change
+	s.mu.Lock()
+	for _, entry := range entries {
+		// kubernetes mounts the secrets as symlinks to a hidden directory that is swapped on every update
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			log.Error(ctx, "reading secret", "err", err, "secret", entry.Name())
+			continue
+		}
+		value := strings.TrimSpace(string(content))
+		if current, ok := s.values[entry.Name()]; ok && current == value {
+			continue
+		}
+		s.values[entry.Name()] = value
+		changes = append(changes, change{name: entry.Name(), value: value})
+	}
+	listeners := make(map[string][]func(string), len(s.listeners))
+	for name, fns := range s.listeners {
+		listeners[name] = fns
+	}
+	s.mu.Unlock()
+
+	for _, c := range changes {
+		log.Info(ctx, "audit: secret rotated", "secret", c.name)
+		for _, fn := range listeners[c.name] {
+			fn(c.value)
+		}
+	}
+	return nil
+}
+
+// Watch reloads the secrets every interval and on SIGHUP until the context is canceled
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-hup:
+			log.Info(ctx, "SIGHUP received, reloading the secrets")
+		}
+		if err := s.Reload(ctx); err != nil {
+			log.Error(ctx, "reloading the secrets", "err", err, "dir", s.dir)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, DatabasePassword), []byte("first\n"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0o700))
+
+	store, err := NewStore(ctx, dir)
+	require.NoError(t, err)
+	value, ok := store.Get(DatabasePassword)
+	assert.True(t, ok)
+	assert.Equal(t, "first", value)
+	_, ok = store.Get(VaultToken)
+	assert.False(t, ok)
+
+	var rotated []string
+	store.OnChange(DatabasePassword, func(value string) { rotated = append(rotated, value) })
+
+	require.NoError(t, store.Reload(ctx))
+	assert.Empty(t, rotated)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, DatabasePassword), []byte("second"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, VaultToken), []byte("token"), 0o600))
+	require.NoError(t, store.Reload(ctx))
+	assert.Equal(t, []string{"second"}, rotated)
+	value, _ = store.Get(VaultToken)
+	assert.Equal(t, "token", value)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, DatabasePassword)))
+	require.NoError(t, store.Reload(ctx))
+	value, _ = store.Get(DatabasePassword)
+	assert.Equal(t, "second", value)
+	assert.Equal(t, "second", store.Secret(DatabasePassword)())
+	assert.Empty(t, store.Secret(CachePassword)())
+
+	var none *Store
+	assert.Empty(t, none.Secret(DatabasePassword)())
+}
@@ -0,0 +1,26 @@
+// Package tenancy carries the tenant that performs a request through the context so the repositories can
+// scope their queries to it.
+package tenancy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type tenantCtxKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the tenant
+func WithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantCtxKey{}, tenantID)
+}
+
+// FromContext returns the tenant that is performing the request, if any.
+// A nil value means that the request is not scoped to a tenant, e.g. it was authenticated with the admin credentials.
+func FromContext(ctx context.Context) *uuid.UUID {
+	tenantID, ok := ctx.Value(tenantCtxKey{}).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &tenantID
+}
@@ -0,0 +1,513 @@
+package tsclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/polygonid/sh-id-platform/internal/apiversion"
+)
+
+// digestLength is the number of hex characters of the spec digest that version the client
+const digestLength = 12
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Client is a generated TypeScript client
+type Client struct {
+	Version string // Version of the spec the client was generated from
+	Digest  string // Digest of the spec the client was generated from, it changes with any change of the contract
+	Source  []byte
+}
+
+// Generate returns a dependency free TypeScript client, based on fetch, with the types of the schemas and a method
+// per operation of the given openapi spec
+func Generate(spec []byte) (*Client, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("loading the spec: %w", err)
+	}
+	sum := sha256.Sum256(spec)
+	g := &generator{
+		version: doc.Info.Version,
+		digest:  hex.EncodeToString(sum[:])[:digestLength],
+	}
+	g.header(doc)
+	g.schemas(doc)
+	g.runtime()
+	if err := g.operations(doc); err != nil {
+		return nil, err
+	}
+	return &Client{Version: g.version, Digest: g.digest, Source: g.buf.Bytes()}, nil
+}
+
+type generator struct {
+	buf     bytes.Buffer
+	version string
+	digest  string
+}
+
+func (g *generator) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) header(doc *openapi3.T) {
+	g.printf("// Code generated by the issuer node from %s %s (spec %s). DO NOT EDIT.\n\n", doc.Info.Title, g.version, g.digest)
+	g.printf("export const API_VERSION = %q;\n", g.version)
+	g.printf("export const SPEC_DIGEST = %q;\n\n", g.digest)
+}
+
+func (g *generator) schemas(doc *openapi3.T) {
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := doc.Components.Schemas[name].Value
+		g.comment("", s.Description)
+		if len(s.Properties) > 0 && len(s.AllOf) == 0 && len(s.OneOf) == 0 && len(s.AnyOf) == 0 {
+			g.printf("export interface %s %s\n\n", typeName(name), objectType(s, ""))
+			continue
+		}
+		g.printf("export type %s = %s;\n\n", typeName(name), tsType(doc.Components.Schemas[name], true))
+	}
+}
+
+func (g *generator) comment(indent string, description string) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return
+	}
+	lines := strings.Split(strings.ReplaceAll(description, "*/", "*\\/"), "\n")
+	g.printf("%s/**\n", indent)
+	for _, line := range lines {
+		g.printf("%s * %s\n", indent, strings.TrimRight(line, " "))
+	}
+	g.printf("%s */\n", indent)
+}
+
+// tsType returns the TypeScript type of a schema. The component schemas are referenced by name unless inline is set.
+func tsType(ref *openapi3.SchemaRef, inline bool) string {
+	if ref == nil || ref.Value == nil {
+		return "unknown"
+	}
+	if ref.Ref != "" && !inline {
+		return typeName(ref.Ref[strings.LastIndex(ref.Ref, "/")+1:])
+	}
+	s := ref.Value
+	t := baseType(s)
+	if s.Nullable {
+		t += " | null"
+	}
+	return t
+}
+
+func baseType(s *openapi3.Schema) string {
+	switch {
+	case len(s.AllOf) > 0:
+		return joinTypes(s.AllOf, " & ")
+	case len(s.OneOf) > 0:
+		return joinTypes(s.OneOf, " | ")
+	case len(s.AnyOf) > 0:
+		return joinTypes(s.AnyOf, " | ")
+	case len(s.Enum) > 0:
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = literal(v)
+		}
+		return strings.Join(values, " | ")
+	}
+	switch {
+	case s.Type.Is(openapi3.TypeString):
+		if s.Format == "binary" {
+			return "Blob"
+		}
+		return "string"
+	case s.Type.Is(openapi3.TypeInteger), s.Type.Is(openapi3.TypeNumber):
+		return "number"
+	case s.Type.Is(openapi3.TypeBoolean):
+		return "boolean"
+	case s.Type.Is(openapi3.TypeArray):
+		return "Array<" + tsType(s.Items, false) + ">"
+	case len(s.Properties) > 0:
+		return objectType(s, "")
+	case s.AdditionalProperties.Schema != nil:
+		return "Record<string, " + tsType(s.AdditionalProperties.Schema, false) + ">"
+	case s.Type.Is(openapi3.TypeObject):
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+func objectType(s *openapi3.Schema, indent string) string {
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range names {
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		fmt.Fprintf(&b, "%s  %s%s: %s;\n", indent, propertyName(name), optional, tsType(s.Properties[name], false))
+	}
+	if s.AdditionalProperties.Schema != nil {
+		fmt.Fprintf(&b, "%s  [key: string]: unknown;\n", indent)
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+func joinTypes(refs openapi3.SchemaRefs, sep string) string {
+	types := make([]string, len(refs))
+	for i, ref := range refs {
+		types[i] = tsType(ref, false)
+		if strings.Contains(types[i], " ") && !strings.HasPrefix(types[i], "{") {
+			types[i] = "(" + types[i] + ")"
+		}
+	}
+	return strings.Join(types, sep)
+}
+
+func literal(v any) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func propertyName(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+func typeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || r == '$' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') {
+			return r
+		}
+		return -1
+	}, name)
+	if name == "" || ('0' <= name[0] && name[0] <= '9') {
+		name = "T" + name
+	}
+	return name
+}
+
+func methodName(operationID string) string {
+	name := typeName(operationID)
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func (g *generator) runtime() {
+	g.buf.WriteString(`export class ApiError extends Error {
+  readonly status: number;
+  readonly body: unknown;
+
+  constructor(status: number, body: unknown) {
+    super("request failed with status " + status);
+    this.status = status;
+    this.body = body;
+  }
+}
+
+export interface ClientOptions {
+  /** Base url of the issuer node api, i.e: https://issuer.example.com */
+  baseUrl: string;
+  /** Headers sent with every request, like the Authorization one */
+  headers?: Record<string, string>;
+  fetch?: typeof fetch;
+}
+
+type ResponseType = "json" | "text" | "blob" | "none";
+
+export class Client {
+  private readonly options: ClientOptions;
+
+  constructor(options: ClientOptions) {
+    this.options = options;
+  }
+
+  private async request(method: string, path: string, query: Record<string, unknown> | undefined, body: unknown, contentType: string | undefined, responseType: ResponseType): Promise<any> {
+    const url = new URL(this.options.baseUrl.replace(/\/+$/, "") + path);
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value === undefined || value === null) {
+        continue;
+      }
+      for (const item of Array.isArray(value) ? value : [value]) {
+        url.searchParams.append(key, String(item));
+      }
+    }
+    const headers: Record<string, string> = { ...this.options.headers };
+    let payload: BodyInit | undefined;
+    if (body !== undefined) {
+      if (contentType === "application/json") {
+        payload = JSON.stringify(body);
+      } else {
+        payload = body as BodyInit;
+      }
+      if (contentType && contentType !== "multipart/form-data") {
+        headers["Content-Type"] = contentType;
+      }
+    }
+    const response = await (this.options.fetch ?? fetch)(url.toString(), { method, headers, body: payload });
+    if (!response.ok) {
+      const text = await response.text();
+      let errorBody: unknown = text;
+      try {
+        errorBody = JSON.parse(text);
+      } catch {
+        // not a json error
+      }
+      throw new ApiError(response.status, errorBody);
+    }
+    switch (responseType) {
+      case "json":
+        return response.status === 204 ? undefined : response.json();
+      case "text":
+        return response.text();
+      case "blob":
+        return response.blob();
+      default:
+        return undefined;
+    }
+  }
+`)
+}
+
+func (g *generator) operations(doc *openapi3.T) error {
+	paths := doc.Paths.Map()
+	names := make([]string, 0, len(paths))
+	for path := range paths {
+		names = append(names, path)
+	}
+	sort.Strings(names)
+
+	seen := make(map[string]bool)
+	for _, path := range names {
+		item := paths[path]
+		methods := make([]string, 0)
+		for method := range item.Operations() {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			op := item.Operations()[method]
+			if op.OperationID == "" {
+				return fmt.Errorf("%s %s: the operation has no id", method, path)
+			}
+			name := methodName(op.OperationID)
+			if seen[name] {
+				return fmt.Errorf("%s %s: duplicated operation id %s", method, path, op.OperationID)
+			}
+			seen[name] = true
+			g.operation(path, method, name, append(item.Parameters, op.Parameters...), op)
+		}
+	}
+	g.printf("}\n")
+	return nil
+}
+
+func (g *generator) operation(path string, method string, name string, parameters openapi3.Parameters, op *openapi3.Operation) {
+	var pathParams, queryParams []*openapi3.Parameter
+	for _, p := range parameters {
+		if p.Value == nil {
+			continue
+		}
+		switch p.Value.In {
+		case openapi3.ParameterInPath:
+			pathParams = append(pathParams, p.Value)
+		case openapi3.ParameterInQuery:
+			queryParams = append(queryParams, p.Value)
+		}
+	}
+
+	var args []string
+	if len(pathParams)+len(queryParams) > 0 {
+		fields := make([]string, 0, len(pathParams)+len(queryParams))
+		optional := "?"
+		for _, p := range append(pathParams, queryParams...) {
+			o := "?"
+			if p.Required {
+				o, optional = "", ""
+			}
+			fields = append(fields, fmt.Sprintf("%s%s: %s", propertyName(p.Name), o, tsType(p.Schema, false)))
+		}
+		args = append(args, fmt.Sprintf("params%s: { %s }", optional, strings.Join(fields, "; ")))
+	}
+
+	bodyArg, contentType := "undefined", ""
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		var bodyType string
+		contentType, bodyType = requestContent(op.RequestBody.Value.Content)
+		o := "?"
+		if op.RequestBody.Value.Required {
+			o = ""
+		}
+		args = append(args, fmt.Sprintf("body%s: %s", o, bodyType))
+		bodyArg = "body"
+	}
+	responseType, resultType := responseContent(op)
+
+	pathExpr := "`" + path + "`"
+	for _, p := range pathParams {
+		pathExpr = strings.ReplaceAll(pathExpr, "{"+p.Name+"}", "${encodeURIComponent(String(params."+p.Name+"))}")
+	}
+	queryArg := "undefined"
+	if len(queryParams) > 0 {
+		fields := make([]string, len(queryParams))
+		for i, p := range queryParams {
+			fields[i] = fmt.Sprintf("%s: params?.%s", propertyName(p.Name), p.Name)
+			if !identifier.MatchString(p.Name) {
+				fields[i] = fmt.Sprintf("%q: params?.[%q]", p.Name, p.Name)
+			}
+		}
+		queryArg = "{ " + strings.Join(fields, ", ") + " }"
+	}
+	contentTypeArg := "undefined"
+	if contentType != "" {
+		contentTypeArg = fmt.Sprintf("%q", contentType)
+	}
+
+	g.buf.WriteString("\n")
+	summary := op.Summary
+	if op.Deprecated {
+		summary = strings.TrimSpace(summary + "\n@deprecated")
+	}
+	g.comment("  ", summary)
+	g.printf("  %s(%s): Promise<%s> {\n", name, strings.Join(args, ", "), resultType)
+	g.printf("    return this.request(%q, %s, %s, %s, %s, %q);\n", strings.ToUpper(method), pathExpr, queryArg, bodyArg, contentTypeArg, responseType)
+	g.printf("  }\n")
+}
+
+// requestContent returns the content type and the TypeScript type of a request body
+func requestContent(content openapi3.Content) (string, string) {
+	if mt, ok := content["application/json"]; ok {
+		return "application/json", tsType(mt.Schema, false)
+	}
+	if _, ok := content["multipart/form-data"]; ok {
+		return "multipart/form-data", "FormData"
+	}
+	types := make([]string, 0, len(content))
+	for t := range content {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	if len(types) == 0 {
+		return "", "unknown"
+	}
+	if strings.HasPrefix(types[0], "text/") {
+		return types[0], "string"
+	}
+	return types[0], "Blob"
+}
+
+// responseContent returns how to read the success response of an operation and its TypeScript type
+func responseContent(op *openapi3.Operation) (string, string) {
+	codes := make([]string, 0)
+	for code := range op.Responses.Map() {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		resp := op.Responses.Value(code)
+		if resp == nil || resp.Value == nil || len(resp.Value.Content) == 0 {
+			continue
+		}
+		if mt, ok := resp.Value.Content["application/json"]; ok {
+			return "json", tsType(mt.Schema, false)
+		}
+		for t := range resp.Value.Content {
+			if strings.HasPrefix(t, "text/") {
+				return "text", "string"
+			}
+		}
+		return "blob", "Blob"
+	}
+	return "none", "void"
+}
+
+// Handler serves the TypeScript client of the spec at specPath. The spec digest is the ETag of the client, so it can
+// be cached until the node is deployed with a new contract.
+func Handler(specPath string) http.HandlerFunc {
+	return handler(func() ([]byte, error) {
+		return os.ReadFile(specPath)
+	})
+}
+
+// V2Handler serves the TypeScript client of the v2 version of the spec at specPath
+func V2Handler(specPath string) http.HandlerFunc {
+	return handler(func() ([]byte, error) {
+		v1, err := os.ReadFile(specPath)
+		if err != nil {
+			return nil, err
+		}
+		return apiversion.V2Spec(v1)
+	})
+}
+
+// handler serves the client of the loaded spec. The client is only generated again when the spec changes.
+func handler(load func() ([]byte, error)) http.HandlerFunc {
+	var (
+		mu     sync.Mutex
+		cached *Client
+		sum    [sha256.Size]byte
+	)
+	return func(w http.ResponseWriter, r *http.Request) {
+		spec, err := load()
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("not found"))
+			return
+		}
+		specSum := sha256.Sum256(spec)
+		mu.Lock()
+		client := cached
+		if client == nil || specSum != sum {
+			if client, err = Generate(spec); err == nil {
+				cached, sum = client, specSum
+			}
+		}
+		mu.Unlock()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		etag := `"` + client.Digest + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("X-Api-Version", client.Version)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/typescript; charset=UTF-8")
+		_, _ = w.Write(client.Source)
+	}
+}
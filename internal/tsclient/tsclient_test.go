@@ -0,0 +1,129 @@
+package tsclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, path := range []string{"../../api/api.yaml", "../../api_ui/api.yaml"} {
+		t.Run(path, func(t *testing.T) {
+			spec, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			client, err := Generate(spec)
+			require.NoError(t, err)
+			assert.Len(t, client.Digest, digestLength)
+			assert.Contains(t, string(client.Source), `export const SPEC_DIGEST = "`+client.Digest+`";`)
+			assert.Contains(t, string(client.Source), "export class Client {")
+		})
+	}
+}
+
+func TestGenerateOperations(t *testing.T) {
+	spec := []byte(`
+openapi: 3.0.0
+info:
+  title: test
+  version: "1"
+paths:
+  /v1/links/{id}:
+    get:
+      operationId: GetLink
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: status
+          in: query
+          schema:
+            type: string
+            enum: [ active, inactive ]
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Link'
+  /v1/links/{id}/qrcode/html:
+    post:
+      operationId: CreateLinkQrCodeHTML
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string
+      responses:
+        '200':
+          description: ok
+          content:
+            text/html:
+              schema:
+                type: string
+components:
+  schemas:
+    Link:
+      type: object
+      description: A link
+      required: [ id ]
+      properties:
+        id:
+          type: string
+        "@context":
+          type: array
+          items:
+            type: string
+        maxIssuance:
+          type: integer
+          nullable: true
+`)
+	client, err := Generate(spec)
+	require.NoError(t, err)
+	source := string(client.Source)
+	assert.Equal(t, "1", client.Version)
+	assert.Contains(t, source, "/**\n * A link\n */\nexport interface Link {\n  \"@context\"?: Array<string>;\n  id: string;\n  maxIssuance?: number | null;\n}")
+	assert.Contains(t, source, `  getLink(params: { id: string; status?: "active" | "inactive" }): Promise<Link> {
+    return this.request("GET", `+"`/v1/links/${encodeURIComponent(String(params.id))}`"+`, { status: params?.status }, undefined, undefined, "json");`)
+	assert.Contains(t, source, `  createLinkQrCodeHTML(params: { id: string }, body?: string): Promise<string> {`)
+}
+
+func TestHandler(t *testing.T) {
+	handler := Handler("../../api_ui/api.yaml")
+
+	rr := httptest.NewRecorder()
+	handler(rr, httptest.NewRequest(http.MethodGet, "/static/clients/api_ui/client.ts", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.NotEmpty(t, rr.Header().Get("X-Api-Version"))
+
+	req := httptest.NewRequest(http.MethodGet, "/static/clients/api_ui/client.ts", nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	handler(rr, req)
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+
+	rr = httptest.NewRecorder()
+	V2Handler("../../api_ui/api.yaml")(rr, httptest.NewRequest(http.MethodGet, "/static/clients/api_ui/client.v2.ts", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "2", rr.Header().Get("X-Api-Version"))
+	assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+
+	rr = httptest.NewRecorder()
+	Handler("missing.yaml")(rr, httptest.NewRequest(http.MethodGet, "/static/clients/api_ui/client.ts", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -490,6 +490,11 @@ func (c *Client) getGasPrice(ctx context.Context) (*big.Int, error) {
 	return gasPrice, err
 }
 
+// Address returns the ethereum address of the given key
+func (c *Client) Address(k kms.KeyID) (common.Address, error) {
+	return c.getAddress(k)
+}
+
 // getAddress - get address by keyID
 func (c *Client) getAddress(k kms.KeyID) (common.Address, error) {
 	if c.kms == nil {
@@ -527,7 +532,7 @@ func (c *Client) signerFnFactory(ctx context.Context, signingKeyID kms.KeyID) fu
 		signer := types.LatestSignerForChainID(ch)
 		h := signer.Hash(tx)
 
-		sig, err := c.kms.Sign(ctx, signingKeyID, h[:])
+		sig, err := c.kms.Sign(kms.WithSigningOperation(ctx, kms.SigningOperationTransaction, ""), signingKeyID, h[:])
 		if err != nil {
 			return nil, err
 		}
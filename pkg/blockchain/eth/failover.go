@@ -0,0 +1,216 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// RPCStrategy defines how requests are spread among several rpc endpoints
+type RPCStrategy string
+
+const (
+	// RPCStrategyFailover sends every request to the first healthy endpoint in the configured order
+	RPCStrategyFailover RPCStrategy = "failover"
+	// RPCStrategyRoundRobin rotates the requests among the healthy endpoints
+	RPCStrategyRoundRobin RPCStrategy = "round-robin"
+
+	// DefaultRPCHealthCheckInterval is the time between health checks of the rpc endpoints
+	DefaultRPCHealthCheckInterval = 30 * time.Second
+
+	rpcHealthCheckTimeout = 5 * time.Second
+	rpcHealthCheckBody    = `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+)
+
+// ErrNoRPCEndpoints is returned when no rpc endpoint is configured
+var ErrNoRPCEndpoints = errors.New("no rpc endpoints configured")
+
+// FailoverConfig configures the rpc endpoints used by DialWithFailover
+type FailoverConfig struct {
+	URLs                []string
+	Strategy            RPCStrategy
+	HealthCheckInterval time.Duration
+}
+
+// DialWithFailover returns an ethereum client that sends the requests to several http rpc endpoints.
+// Requests that fail with a network error or a 5xx/429 response are retried in the next endpoint
+// and the failing endpoint is not used again until a health check succeeds.
+// With a single url it behaves as ethclient.Dial.
+func DialWithFailover(ctx context.Context, cfg FailoverConfig) (*ethclient.Client, error) {
+	switch len(cfg.URLs) {
+	case 0:
+		return nil, ErrNoRPCEndpoints
+	case 1:
+		return ethclient.DialContext(ctx, cfg.URLs[0])
+	}
+
+	transport, err := newFailoverTransport(cfg.URLs, cfg.Strategy, http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+	interval := cfg.HealthCheckInterval
+	if interval == 0 {
+		interval = DefaultRPCHealthCheckInterval
+	}
+	go transport.healthCheck(ctx, interval)
+
+	rpcClient, err := rpc.DialOptions(ctx, cfg.URLs[0], rpc.WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+type rpcEndpoint struct {
+	url     *url.URL
+	healthy atomic.Bool
+}
+
+type failoverTransport struct {
+	endpoints []*rpcEndpoint
+	strategy  RPCStrategy
+	next      atomic.Uint64
+	base      http.RoundTripper
+}
+
+func newFailoverTransport(urls []string, strategy RPCStrategy, base http.RoundTripper) (*failoverTransport, error) {
+	if strategy == "" {
+		strategy = RPCStrategyFailover
+	}
+	if strategy != RPCStrategyFailover && strategy != RPCStrategyRoundRobin {
+		return nil, fmt.Errorf("unknown rpc strategy %q", strategy)
+	}
+
+	t := &failoverTransport{strategy: strategy, base: base}
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rpc url %q: %w", rawURL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("rpc failover only supports http endpoints: %q", rawURL)
+		}
+		endpoint := &rpcEndpoint{url: u}
+		endpoint.healthy.Store(true)
+		t.endpoints = append(t.endpoints, endpoint)
+	}
+	return t, nil
+}
+
+// RoundTrip sends the request to the endpoints in order until one of them answers.
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	var lastErr error
+	for _, endpoint := range t.order() {
+		resp, err := t.base.RoundTrip(endpointRequest(req, endpoint.url, body))
+		if err == nil && !isRPCServerError(resp.StatusCode) {
+			return resp, nil
+		}
+		if err == nil {
+			_ = resp.Body.Close()
+			err = fmt.Errorf("rpc endpoint answered with status %d", resp.StatusCode)
+		}
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+		log.Warn(req.Context(), "rpc endpoint failed. Trying next one", "endpoint", endpoint.url.Host, "err", err)
+		endpoint.healthy.Store(false)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// order returns the endpoints in the order they have to be tried. Unhealthy endpoints go last,
+// so they are still tried when all the endpoints are down.
+func (t *failoverTransport) order() []*rpcEndpoint {
+	start := 0
+	if t.strategy == RPCStrategyRoundRobin {
+		start = int(t.next.Add(1) % uint64(len(t.endpoints)))
+	}
+
+	healthy := make([]*rpcEndpoint, 0, len(t.endpoints))
+	var unhealthy []*rpcEndpoint
+	for i := range t.endpoints {
+		endpoint := t.endpoints[(start+i)%len(t.endpoints)]
+		if endpoint.healthy.Load() {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (t *failoverTransport) healthCheck(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, endpoint := range t.endpoints {
+				healthy := t.check(ctx, endpoint)
+				if healthy != endpoint.healthy.Load() {
+					log.Info(ctx, "rpc endpoint health changed", "endpoint", endpoint.url.Host, "healthy", healthy)
+				}
+				endpoint.healthy.Store(healthy)
+			}
+		}
+	}
+}
+
+func (t *failoverTransport) check(ctx context.Context, endpoint *rpcEndpoint) bool {
+	ctx, cancel := context.WithTimeout(ctx, rpcHealthCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.url.String(), nil)
+	if err != nil {
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.base.RoundTrip(endpointRequest(req, endpoint.url, []byte(rpcHealthCheckBody)))
+	if err != nil {
+		return false
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+func endpointRequest(req *http.Request, endpoint *url.URL, body []byte) *http.Request {
+	r := req.Clone(req.Context())
+	r.URL = &url.URL{Scheme: endpoint.Scheme, Host: endpoint.Host, Path: endpoint.Path, RawQuery: endpoint.RawQuery}
+	r.Host = endpoint.Host
+	if endpoint.User != nil {
+		password, _ := endpoint.User.Password()
+		r.SetBasicAuth(endpoint.User.Username(), password)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return r
+}
+
+func isRPCServerError(status int) bool {
+	return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+}
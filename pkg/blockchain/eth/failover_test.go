@@ -0,0 +1,42 @@
+package eth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialWithFailover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+	defer up.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, strategy := range []RPCStrategy{RPCStrategyFailover, RPCStrategyRoundRobin} {
+		t.Run(string(strategy), func(t *testing.T) {
+			client, err := DialWithFailover(ctx, FailoverConfig{URLs: []string{down.URL, up.URL}, Strategy: strategy})
+			require.NoError(t, err)
+
+			for i := 0; i < 3; i++ {
+				block, err := client.BlockNumber(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, uint64(16), block)
+			}
+		})
+	}
+
+	_, err := DialWithFailover(ctx, FailoverConfig{URLs: []string{up.URL, "ws://localhost:8546"}})
+	assert.Error(t, err)
+}
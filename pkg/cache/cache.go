@@ -20,4 +20,9 @@ type Cache interface {
 	Exists(ctx context.Context, key string) bool
 	// Delete removes an entry from the cache.
 	Delete(ctx context.Context, key string) error
+	// Increment atomically adds one to the counter of the key and returns its new value. The counter expires ttl after
+	// its last increment. Counters must only be read with Counter.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// Counter returns the value of the counter of the key, or 0 if it does not exist
+	Counter(ctx context.Context, key string) int64
 }
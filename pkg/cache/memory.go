@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -15,6 +16,8 @@ const (
 
 type memory struct {
 	c *cache.Cache
+	// counters serializes the increments of the counters
+	counters sync.Mutex
 }
 
 // NewMemoryCache returns a basic in memory cache
@@ -53,3 +56,21 @@ func (m *memory) Delete(_ context.Context, key string) error {
 	m.c.Delete(key)
 	return nil
 }
+
+// Increment increments the counter of the key and refreshes its ttl
+func (m *memory) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	m.counters.Lock()
+	defer m.counters.Unlock()
+	value, _ := m.c.Get(key)
+	counter, _ := value.(int64)
+	counter++
+	m.c.Set(key, counter, ttl)
+	return counter, nil
+}
+
+// Counter returns the value of the counter of the key
+func (m *memory) Counter(_ context.Context, key string) int64 {
+	value, _ := m.c.Get(key)
+	counter, _ := value.(int64)
+	return counter
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryIncrement(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Increment(ctx, "counter", time.Minute)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(50), c.Counter(ctx, "counter"))
+
+	value, err := c.Increment(ctx, "counter", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(51), value)
+
+	require.NoError(t, c.Delete(ctx, "counter"))
+	assert.Equal(t, int64(0), c.Counter(ctx, "counter"))
+
+	_, err = c.Increment(ctx, "expiring", time.Millisecond)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, int64(0), c.Counter(ctx, "expiring"))
+}
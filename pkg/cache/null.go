@@ -27,3 +27,13 @@ func (n *NullCache) Exists(_ context.Context, _ string) bool {
 func (n *NullCache) Delete(_ context.Context, _ string) error {
 	return nil
 }
+
+// Increment does nothing
+func (n *NullCache) Increment(_ context.Context, _ string, _ time.Duration) (int64, error) {
+	return 0, nil
+}
+
+// Counter returns 0
+func (n *NullCache) Counter(_ context.Context, _ string) int64 {
+	return 0
+}
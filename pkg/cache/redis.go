@@ -9,13 +9,14 @@ import (
 )
 
 type redisCache struct {
-	redis *cache.Cache
+	redis  *cache.Cache
+	client *redis.Client
 }
 
 // NewRedisCache returns a new cache based on Redis
 func NewRedisCache(client *redis.Client) Cache {
 	myc := cache.New(&cache.Options{Redis: client})
-	return &redisCache{redis: myc}
+	return &redisCache{redis: myc, client: client}
 }
 
 // Set sets a new entry in redis cache
@@ -49,3 +50,25 @@ func (c *redisCache) Exists(ctx context.Context, key string) bool {
 func (c *redisCache) Delete(ctx context.Context, key string) error {
 	return c.redis.Delete(ctx, key)
 }
+
+// Increment increments the counter of the key and refreshes its ttl in a single transaction
+func (c *redisCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	pipe := c.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	if ttl > 0 {
+		pipe.Expire(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Counter returns the value of the counter of the key
+func (c *redisCache) Counter(ctx context.Context, key string) int64 {
+	value, err := c.client.Get(ctx, key).Int64()
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,42 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	from := time.Date(2024, time.March, 20, 10, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{name: "every minute", expr: "* * * * *", expected: time.Date(2024, time.March, 20, 10, 31, 0, 0, time.UTC)},
+		{name: "every 15 minutes", expr: "*/15 * * * *", expected: time.Date(2024, time.March, 20, 10, 45, 0, 0, time.UTC)},
+		{name: "august 31st", expr: "0 0 31 8 *", expected: time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "month names", expr: "0 0 31 AUG *", expected: time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "yearly", expr: "@yearly", expected: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "week days", expr: "0 9 * * MON-FRI", expected: time.Date(2024, time.March, 21, 9, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "0 0 * * 7", expected: time.Date(2024, time.March, 24, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or week", expr: "0 0 1 * FRI", expected: time.Date(2024, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{name: "list", expr: "0 8,20 * * *", expected: time.Date(2024, time.March, 20, 20, 0, 0, 0, time.UTC)},
+		{name: "never", expr: "0 0 30 2 *", expected: time.Time{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * FOO *", "@every 5m"} {
+		_, err := Parse(expr)
+		assert.ErrorIs(t, err, ErrInvalidExpression, expr)
+	}
+}
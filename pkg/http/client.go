@@ -46,6 +46,21 @@ func (c *Client) Post(ctx context.Context, url string, req []byte) ([]byte, erro
 	return executeRequest(ctx, c, request)
 }
 
+// PostWithHeaders send request to url with requestID headers and the given ones
+func (c *Client) PostWithHeaders(ctx context.Context, url string, req []byte, headers map[string]string) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(req))
+	if err != nil {
+		return nil, err
+	}
+
+	addRequestIDToHeader(ctx, request)
+	for k, v := range headers {
+		request.Header.Set(k, v)
+	}
+
+	return executeRequest(ctx, c, request)
+}
+
 // Get send request to url with requestID headers
 func (c *Client) Get(ctx context.Context, url string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url,
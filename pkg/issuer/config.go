@@ -0,0 +1,111 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+// Config is the configuration of the node. The settings that are not fields of Config, like the credential status or
+// the publishing mode, are read by LoadConfig from the same file or ISSUER_* environment variables the servers use.
+type Config struct {
+	DatabaseURL          string
+	RedisURL             string
+	VaultAddress         string
+	VaultToken           string
+	EthereumURL          string
+	StateContractAddress string
+	// CircuitsPath is the directory of the circuits used to generate the proofs of the state transitions
+	CircuitsPath   string
+	IPFSGatewayURL string
+	// SyntheticIssuance keeps the keys in memory and publishes the states to a simulated chain, so no vault or
+	// ethereum node is needed. It is meant for load and integration tests.
+	SyntheticIssuance bool
+
+	loaded *config.Configuration
+}
+
+// LoadConfig loads the configuration from the file, or the environment variables when fileName is empty.
+// The fields of the returned Config can be changed before calling New.
+func LoadConfig(_ context.Context, fileName string) (*Config, error) {
+	cfg, err := config.Load(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		DatabaseURL:          cfg.Database.URL,
+		RedisURL:             cfg.Cache.RedisUrl,
+		VaultAddress:         cfg.KeyStore.Address,
+		VaultToken:           cfg.KeyStore.Token,
+		EthereumURL:          cfg.Ethereum.URL,
+		StateContractAddress: cfg.Ethereum.ContractAddress,
+		CircuitsPath:         cfg.Circuit.Path,
+		IPFSGatewayURL:       cfg.IPFS.GatewayURL,
+		SyntheticIssuance:    cfg.SyntheticIssuance.Enabled,
+		loaded:               cfg,
+	}, nil
+}
+
+// configuration returns a sanitized copy of the loaded configuration with the values of the fields. A Config that
+// was not loaded with LoadConfig takes the rest of the settings from the environment variables. Empty fields keep
+// the loaded values.
+func (cfg *Config) configuration(ctx context.Context, opts Options) (*config.Configuration, error) {
+	loaded := cfg.loaded
+	if loaded == nil {
+		var err error
+		if loaded, err = config.Load(""); err != nil {
+			return nil, err
+		}
+	}
+	c := *loaded
+	for field, value := range map[*string]string{
+		&c.Database.URL:             cfg.DatabaseURL,
+		&c.Cache.RedisUrl:           cfg.RedisURL,
+		&c.KeyStore.Address:         cfg.VaultAddress,
+		&c.KeyStore.Token:           cfg.VaultToken,
+		&c.Ethereum.URL:             cfg.EthereumURL,
+		&c.Ethereum.ContractAddress: cfg.StateContractAddress,
+		&c.Circuit.Path:             cfg.CircuitsPath,
+		&c.IPFS.GatewayURL:          cfg.IPFSGatewayURL,
+		&c.ServerUrl:                opts.ServerURL,
+		&c.APIUI.ServerURL:          opts.ServerURL,
+	} {
+		if value != "" {
+			*field = value
+		}
+	}
+	c.SyntheticIssuance.Enabled = cfg.SyntheticIssuance
+
+	if !opts.SingleIssuer {
+		if err := c.Sanitize(ctx); err != nil {
+			return nil, fmt.Errorf("invalid configuration: %w", err)
+		}
+		return &c, nil
+	}
+	// the single issuer nodes are sanitized as the UI server, that checks its port. The embedded node doesn't
+	// listen, so it gets the one of the server url
+	if c.APIUI.ServerPort == 0 {
+		c.APIUI.ServerPort = serverPort(c.APIUI.ServerURL)
+	}
+	if err := c.SanitizeAPIUI(ctx); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return &c, nil
+}
+
+func serverPort(serverURL string) int {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return 0
+	}
+	if port, err := strconv.Atoi(u.Port()); err == nil {
+		return port
+	}
+	if u.Scheme == "http" {
+		return 80
+	}
+	return 443
+}
@@ -0,0 +1,67 @@
+package issuer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// Connection is a holder that authenticated with an issuer identity
+type Connection struct {
+	ID             uuid.UUID
+	IssuerDID      w3c.DID
+	UserDID        w3c.DID
+	CreatedAt      time.Time
+	ModifiedAt     time.Time
+	LastVerifiedAt *time.Time
+}
+
+// ConnectionService manages the connections of the issuer identities
+type ConnectionService interface {
+	Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Connection, error)
+	GetByUser(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (*Connection, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, deleteCredentials bool) error
+}
+
+type connections struct {
+	service ports.ConnectionsService
+}
+
+// Get returns the connection of the issuer
+func (c *connections) Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Connection, error) {
+	conn, err := c.service.GetByIDAndIssuerID(ctx, id, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	return toConnection(conn), nil
+}
+
+// GetByUser returns the connection of the issuer with the holder
+func (c *connections) GetByUser(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (*Connection, error) {
+	conn, err := c.service.GetByUserID(ctx, issuerDID, userDID)
+	if err != nil {
+		return nil, err
+	}
+	return toConnection(conn), nil
+}
+
+// Delete deletes the connection, and the credentials issued to the holder with deleteCredentials
+func (c *connections) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, deleteCredentials bool) error {
+	return c.service.Delete(ctx, id, deleteCredentials, issuerDID)
+}
+
+func toConnection(conn *domain.Connection) *Connection {
+	return &Connection{
+		ID:             conn.ID,
+		IssuerDID:      conn.IssuerDID,
+		UserDID:        conn.UserDID,
+		CreatedAt:      conn.CreatedAt,
+		ModifiedAt:     conn.ModifiedAt,
+		LastVerifiedAt: conn.LastVerifiedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package issuer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+// CredentialRequest is a credential to issue
+type CredentialRequest struct {
+	IssuerDID         w3c.DID
+	SchemaURL         string
+	Type              string
+	CredentialSubject map[string]any
+	Expiration        *time.Time
+	SignatureProof    bool
+	MTProof           bool
+	// CredentialStatusType of the credential. The credential status type of the configuration if empty.
+	CredentialStatusType verifiable.CredentialStatusType
+	// RevNonce of the credential. It is generated if nil.
+	RevNonce   *uint64
+	ExternalID *string
+}
+
+// Credential is a credential issued by the node
+type Credential struct {
+	ID         uuid.UUID
+	IssuerDID  string
+	SchemaURL  string
+	SchemaType string
+	RevNonce   uint64
+	Revoked    bool
+	ExternalID *string
+	CreatedAt  time.Time
+	// MTProof tells whether the credential gets a merkle tree proof when the state is published
+	MTProof bool
+	// W3C is the credential with its proofs. The merkle tree proof is added once the state is published.
+	W3C *verifiable.W3CCredential
+}
+
+// CredentialService issues and revokes the credentials of the issuer identities
+type CredentialService interface {
+	Issue(ctx context.Context, req CredentialRequest) (*Credential, error)
+	Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Credential, error)
+	Revoke(ctx context.Context, issuerDID w3c.DID, nonce uint64, reason string) error
+	RevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error)
+}
+
+type credentials struct {
+	service              ports.ClaimsService
+	singleIssuer         bool
+	credentialStatusType verifiable.CredentialStatusType
+}
+
+// Issue issues the credential of the request
+func (c *credentials) Issue(ctx context.Context, req CredentialRequest) (*Credential, error) {
+	credentialStatusType := req.CredentialStatusType
+	if credentialStatusType == "" {
+		credentialStatusType = c.credentialStatusType
+	}
+	proofs := ports.ClaimRequestProofs{BJJSignatureProof2021: req.SignatureProof, Iden3SparseMerkleTreeProof: req.MTProof}
+	claimReq := ports.NewCreateClaimRequest(&req.IssuerDID, req.SchemaURL, req.CredentialSubject, req.Expiration, req.Type, nil, nil, nil, proofs, nil, c.singleIssuer, credentialStatusType, nil, req.RevNonce, nil)
+	claimReq.ExternalID = req.ExternalID
+	claim, err := c.service.Save(ctx, claimReq)
+	if err != nil {
+		return nil, err
+	}
+	return toCredential(claim)
+}
+
+// Get returns the credential of the issuer
+func (c *credentials) Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Credential, error) {
+	claim, err := c.service.GetByID(ctx, &issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toCredential(claim)
+}
+
+// Revoke revokes the credential with the revocation nonce. The revocation is published with the next state.
+func (c *credentials) Revoke(ctx context.Context, issuerDID w3c.DID, nonce uint64, reason string) error {
+	return c.service.Revoke(ctx, issuerDID, nonce, reason)
+}
+
+// RevocationStatus returns the revocation status of the nonce in the last published state
+func (c *credentials) RevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error) {
+	return c.service.GetRevocationStatus(ctx, issuerDID, nonce)
+}
+
+func toCredential(claim *domain.Claim) (*Credential, error) {
+	w3cCredential, err := schema.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return nil, err
+	}
+	return &Credential{
+		ID:         claim.ID,
+		IssuerDID:  claim.Issuer,
+		SchemaURL:  claim.SchemaURL,
+		SchemaType: claim.SchemaType,
+		RevNonce:   uint64(claim.RevNonce),
+		Revoked:    claim.Revoked,
+		ExternalID: claim.ExternalID,
+		CreatedAt:  claim.CreatedAt,
+		MTProof:    claim.MtProof,
+		W3C:        w3cCredential,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package issuer
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// Key types of the identities
+const (
+	KeyTypeBabyJubJub = string(kms.KeyTypeBabyJubJub)
+	KeyTypeEthereum   = string(kms.KeyTypeEthereum)
+)
+
+// StateStatus is the publishing status of a state
+type StateStatus string
+
+// Publishing statuses of the states
+const (
+	StateCreated    = StateStatus(domain.StatusCreated)    // StateCreated the state is not published yet
+	StateTransacted = StateStatus(domain.StatusTransacted) // StateTransacted the state transition was sent
+	StateConfirmed  = StateStatus(domain.StatusConfirmed)  // StateConfirmed the state transition was confirmed
+	StateFailed     = StateStatus(domain.StatusFailed)     // StateFailed the state transition failed
+)
+
+// IdentityOptions are the DID method, blockchain and network, and the key type of a new identity
+type IdentityOptions struct {
+	Method     core.DIDMethod
+	Blockchain core.Blockchain
+	Network    core.NetworkID
+	KeyType    string // KeyTypeBabyJubJub or KeyTypeEthereum
+	// CredentialStatusType of the auth credential. The credential status type of the configuration if empty.
+	CredentialStatusType verifiable.CredentialStatusType
+}
+
+// Identity is an identity of the issuer
+type Identity struct {
+	DID   w3c.DID
+	State State
+}
+
+// State is a state of an identity and the roots of its trees
+type State struct {
+	State              *string
+	ClaimsTreeRoot     *string
+	RevocationTreeRoot *string
+	RootOfRoots        *string
+	TxID               *string // the state transition, once it is published
+	Status             StateStatus
+}
+
+// IdentityService manages the identities of the issuer
+type IdentityService interface {
+	Create(ctx context.Context, opts IdentityOptions) (*Identity, error)
+	List(ctx context.Context) ([]w3c.DID, error)
+	LatestState(ctx context.Context, did w3c.DID) (*State, error)
+}
+
+type identities struct {
+	service              ports.IdentityService
+	serverURL            string
+	credentialStatusType verifiable.CredentialStatusType
+}
+
+// Create creates an identity with the options
+func (i *identities) Create(ctx context.Context, opts IdentityOptions) (*Identity, error) {
+	credentialStatusType := opts.CredentialStatusType
+	if credentialStatusType == "" {
+		credentialStatusType = i.credentialStatusType
+	}
+	identity, err := i.service.Create(ctx, i.serverURL, &ports.DIDCreationOptions{
+		Method:                  opts.Method,
+		Blockchain:              opts.Blockchain,
+		Network:                 opts.Network,
+		KeyType:                 kms.KeyType(opts.KeyType),
+		AuthBJJCredentialStatus: credentialStatusType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(identity.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{DID: *did, State: toState(&identity.State)}, nil
+}
+
+// List returns the identities of the issuer
+func (i *identities) List(ctx context.Context) ([]w3c.DID, error) {
+	identifiers, err := i.service.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dids := make([]w3c.DID, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		did, err := w3c.ParseDID(identifier)
+		if err != nil {
+			return nil, err
+		}
+		dids = append(dids, *did)
+	}
+	return dids, nil
+}
+
+// LatestState returns the last state of the identity, published or not
+func (i *identities) LatestState(ctx context.Context, did w3c.DID) (*State, error) {
+	state, err := i.service.GetLatestStateByID(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	s := toState(state)
+	return &s, nil
+}
+
+func toState(state *domain.IdentityState) State {
+	return State{
+		State:              state.State,
+		ClaimsTreeRoot:     state.ClaimsTreeRoot,
+		RevocationTreeRoot: state.RevocationTreeRoot,
+		RootOfRoots:        state.RootOfRoots,
+		TxID:               state.TxID,
+		Status:             StateStatus(state.Status),
+	}
+}
@@ -0,0 +1,92 @@
+// Package issuer embeds the core of the issuer node, the identities, the credentials, the links and the state
+// publisher, in other Go applications. It runs the same services the issuer node servers use, without any http server:
+//
+//	cfg, err := issuer.LoadConfig(ctx, "")
+//	if err != nil {
+//		return err
+//	}
+//	node, err := issuer.New(ctx, cfg, issuer.Options{ServerURL: "https://issuer.example.com"})
+//	if err != nil {
+//		return err
+//	}
+//	defer func() { _ = node.Close() }()
+//
+//	credential, err := node.Credentials.Issue(ctx, issuer.CredentialRequest{...})
+//
+// The node needs the same infrastructure as the servers: postgres, redis, vault and an ethereum node. The published
+// states are confirmed calling node.Publisher.CheckTransactionStatus periodically, as the pending_publisher does.
+package issuer
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/issuernode"
+)
+
+// Options configures the node
+type Options struct {
+	// ServerURL is the public url of the issuer, used in the credentials and in the QR codes.
+	ServerURL string
+	// SingleIssuer must be true when the node only manages one issuer DID, like the UI server.
+	SingleIssuer bool
+	// Authentication enables the authentication of the holders, their connections and the sessions.
+	// The links and the connections are only available with it.
+	Authentication bool
+}
+
+// Node is an issuer node core. The services are safe for concurrent use.
+type Node struct {
+	Identities  IdentityService
+	Credentials CredentialService
+	Links       LinkService       // nil without Options.Authentication
+	Connections ConnectionService // nil without Options.Authentication
+	Publisher   Publisher
+
+	node *issuernode.Node
+}
+
+// New connects to the infrastructure of the configuration and creates the services. Some background tasks, like the
+// renewal of the vault token or the reload of the rotated secrets, run until the context is canceled.
+// The listener of the cache invalidations runs until the node is closed.
+func New(ctx context.Context, cfg *Config, opts Options) (*Node, error) {
+	c, err := cfg.configuration(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	n, err := issuernode.New(ctx, c, issuernode.Options{ServerURL: opts.ServerURL, SingleIssuer: opts.SingleIssuer, Authentication: opts.Authentication})
+	if err != nil {
+		return nil, err
+	}
+	return newNode(n, c, opts), nil
+}
+
+func newNode(n *issuernode.Node, cfg *config.Configuration, opts Options) *Node {
+	node := &Node{
+		Identities:  &identities{service: n.Identities, serverURL: opts.ServerURL, credentialStatusType: cfg.CredentialStatus.CredentialStatusType},
+		Credentials: &credentials{service: n.Credentials, singleIssuer: opts.SingleIssuer, credentialStatusType: cfg.CredentialStatus.CredentialStatusType},
+		Publisher:   &publisher{service: n.Publisher},
+		node:        n,
+	}
+	if n.Links != nil {
+		node.Links = &links{service: n.Links}
+	}
+	if n.Connections != nil {
+		node.Connections = &connections{service: n.Connections}
+	}
+	return node
+}
+
+// HealthMonitors returns the checks of the infrastructure used by the node, by name
+func (n *Node) HealthMonitors() map[string]func(context.Context) error {
+	monitors := make(map[string]func(context.Context) error)
+	for name, ping := range n.node.HealthMonitors() {
+		monitors[name] = ping
+	}
+	return monitors
+}
+
+// Close stops the invalidations listener and closes the connections to the database and redis
+func (n *Node) Close() error {
+	return n.node.Close()
+}
@@ -0,0 +1,123 @@
+package issuer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// LinkRequest is a link that issues credentials of an imported schema to the holders that scan its QR code
+type LinkRequest struct {
+	SchemaID             uuid.UUID
+	MaxIssuance          *int
+	ValidUntil           *time.Time
+	CredentialExpiration *time.Time
+	SignatureProof       bool
+	MTProof              bool
+	CredentialSubject    map[string]any
+	ExternalID           *string
+}
+
+// Link is a credential link of the issuer
+type Link struct {
+	ID                   uuid.UUID
+	IssuerDID            w3c.DID
+	SchemaID             uuid.UUID
+	Active               bool
+	MaxIssuance          *int
+	IssuedCredentials    int
+	ValidUntil           *time.Time
+	CredentialExpiration *time.Time
+	SignatureProof       bool
+	MTProof              bool
+	CredentialSubject    map[string]any
+	ExternalID           *string
+	CreatedAt            time.Time
+}
+
+// LinkService manages the credential links of the issuer identities. The schemas of the links are imported with the
+// api of the UI server.
+type LinkService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, req LinkRequest) (*Link, error)
+	Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Link, error)
+	List(ctx context.Context, issuerDID w3c.DID) ([]Link, error)
+	Activate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, active bool) error
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+}
+
+type links struct {
+	service ports.LinkService
+}
+
+// Create creates the link of the request
+func (l *links) Create(ctx context.Context, issuerDID w3c.DID, req LinkRequest) (*Link, error) {
+	link, err := l.service.Save(ctx, issuerDID, ports.CreateLinkRequest{
+		MaxIssuance:              req.MaxIssuance,
+		ValidUntil:               req.ValidUntil,
+		SchemaID:                 req.SchemaID,
+		CredentialExpiration:     req.CredentialExpiration,
+		CredentialSignatureProof: req.SignatureProof,
+		CredentialMTPProof:       req.MTProof,
+		CredentialAttributes:     req.CredentialSubject,
+		ExternalID:               req.ExternalID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toLink(link), nil
+}
+
+// Get returns the link of the issuer
+func (l *links) Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*Link, error) {
+	link, err := l.service.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toLink(link), nil
+}
+
+// List returns the links of the issuer
+func (l *links) List(ctx context.Context, issuerDID w3c.DID) ([]Link, error) {
+	all, err := l.service.GetAll(ctx, issuerDID, ports.LinkAll, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Link, len(all))
+	for i := range all {
+		res[i] = *toLink(&all[i])
+	}
+	return res, nil
+}
+
+// Activate activates or deactivates the link
+func (l *links) Activate(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, active bool) error {
+	return l.service.Activate(ctx, issuerDID, id, active)
+}
+
+// Delete deletes the link
+func (l *links) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return l.service.Delete(ctx, id, issuerDID)
+}
+
+func toLink(link *domain.Link) *Link {
+	return &Link{
+		ID:                   link.ID,
+		IssuerDID:            w3c.DID(link.IssuerDID),
+		SchemaID:             link.SchemaID,
+		Active:               link.Active,
+		MaxIssuance:          link.MaxIssuance,
+		IssuedCredentials:    link.IssuedClaims,
+		ValidUntil:           link.ValidUntil,
+		CredentialExpiration: link.CredentialExpiration,
+		SignatureProof:       link.CredentialSignatureProof,
+		MTProof:              link.CredentialMTPProof,
+		CredentialSubject:    link.CredentialSubject,
+		ExternalID:           link.ExternalID,
+		CreatedAt:            link.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package issuer
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// PublishedState is a state sent to the state contract, not confirmed yet
+type PublishedState struct {
+	TxID               *string
+	State              *string
+	ClaimsTreeRoot     *string
+	RevocationTreeRoot *string
+	RootOfRoots        *string
+}
+
+// Publisher publishes the states of the issuer identities
+type Publisher interface {
+	// PublishState publishes a new state with the credentials and revocations since the last one
+	PublishState(ctx context.Context, issuerDID w3c.DID) (*PublishedState, error)
+	// CheckTransactionStatus confirms the published states whose transactions were mined
+	CheckTransactionStatus(ctx context.Context)
+}
+
+type publisher struct {
+	service ports.Publisher
+}
+
+// PublishState publishes a new state of the identity
+func (p *publisher) PublishState(ctx context.Context, issuerDID w3c.DID) (*PublishedState, error) {
+	published, err := p.service.PublishState(ctx, &issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	return &PublishedState{
+		TxID:               published.TxID,
+		State:              published.State,
+		ClaimsTreeRoot:     published.ClaimsTreeRoot,
+		RevocationTreeRoot: published.RevocationTreeRoot,
+		RootOfRoots:        published.RootOfRoots,
+	}, nil
+}
+
+// CheckTransactionStatus confirms the published states
+func (p *publisher) CheckTransactionStatus(ctx context.Context) {
+	p.service.CheckTransactionStatus(ctx)
+}
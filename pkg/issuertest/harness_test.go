@@ -0,0 +1,33 @@
+package issuertest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/pkg/issuertest"
+)
+
+func TestHarness(t *testing.T) {
+	const schemaURL = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	h := issuertest.New(t)
+
+	credential := h.IssueCredential(t, issuertest.CredentialRequest(h.IssuerDID, schemaURL, "KYCAgeCredential", map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}))
+	assert.Equal(t, h.IssuerDID.String(), credential.Issuer)
+
+	published := h.PublishState(t)
+	require.NotNil(t, published.TxID)
+	h.VerifyCredential(t, credential.ID)
+
+	require.NoError(t, h.Node.Credentials.Revoke(context.Background(), h.IssuerDID, credential.RevNonce, "test"))
+	h.PublishState(t)
+	status, err := h.Node.Credentials.RevocationStatus(context.Background(), h.IssuerDID, credential.RevNonce)
+	require.NoError(t, err)
+	assert.True(t, status.MTP.Existence)
+}
@@ -14,6 +14,8 @@ const (
 	StatusError = "error"
 	// StatusDone - status done
 	StatusDone = "done"
+	// StatusPendingPayment - the holder has to pay the payment request in the qr code before the credential is issued
+	StatusPendingPayment = "pendingPayment"
 )
 
 // CredentialOfferMessageType - TODO
@@ -64,6 +66,16 @@ func CredentialStateCacheKey(linkID, sessionID string) string {
 	return fmt.Sprintf("credential_link_%s_%s", linkID, sessionID)
 }
 
+// CredentialFetchCacheKey returns the cache key of the fetch status of a credential
+func CredentialFetchCacheKey(credentialID string) string {
+	return fmt.Sprintf("credential_fetch_%s", credentialID)
+}
+
+// HolderProfileCacheKey returns the cache key of the profile the holder proved in a session of the link
+func HolderProfileCacheKey(linkID, sessionID string) string {
+	return fmt.Sprintf("credential_link_holder_%s_%s", linkID, sessionID)
+}
+
 // NewStateError - NewStateError
 func NewStateError(err error) *State {
 	return &State{
@@ -88,3 +100,11 @@ func NewStatePendingPublish() *State {
 	}
 	return state
 }
+
+// NewStatePendingPayment returns the state of a link waiting for the holder to pay the payment request in the qr code
+func NewStatePendingPayment(qrCodeLink string) *State {
+	return &State{
+		Status: StatusPendingPayment,
+		QRCode: &qrCodeLink,
+	}
+}
@@ -16,19 +16,18 @@ const (
 	schemaParts = 2
 )
 
-// NewOfferMsg returns an offer message
-func NewOfferMsg(fetchURL string, credentials ...*domain.Claim) ([]byte, error) {
+// NewOfferMsg returns an offer message in the given thread
+func NewOfferMsg(fetchURL string, threadID string, credentials ...*domain.Claim) ([]byte, error) {
 	if len(credentials) == 0 {
 		return nil, errors.New("no claims provided")
 	}
 
 	credentialOffers := toProtocolCredentialOffer(credentials)
-	msgID := uuid.NewString()
 	credOffer := &protocol.CredentialsOfferMessage{
-		ID:       msgID,
+		ID:       threadID,
 		Typ:      packers.MediaTypePlainMessage,
 		Type:     protocol.CredentialOfferMessageType,
-		ThreadID: msgID,
+		ThreadID: threadID,
 		Body: protocol.CredentialsOfferMessageBody{
 			URL:         fetchURL,
 			Credentials: credentialOffers,
@@ -0,0 +1,262 @@
+// Package pdf writes simple PDF documents: text with the standard Helvetica fonts, filled rectangles and images.
+// It is enough to render printable documents without external dependencies. Coordinates are in points, with
+// the origin in the top left corner of the page.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+)
+
+// Page sizes in points
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Font is one of the standard fonts, available in every PDF reader without embedding them
+type Font int
+
+// Standard fonts supported
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+var fontNames = [...]string{Helvetica: "Helvetica", HelveticaBold: "Helvetica-Bold"}
+
+// Document is a PDF document being written
+type Document struct {
+	width, height float64
+	title         string
+	pages         []*bytes.Buffer
+	images        []image.Image
+}
+
+// New returns a document with an empty page of the given size
+func New(width, height float64) *Document {
+	d := &Document{width: width, height: height}
+	d.AddPage()
+	return d
+}
+
+// SetTitle sets the title in the document information
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+// AddPage adds a new page. The following drawing operations are done on it.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount returns the number of pages
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// Rect draws a rectangle filled with the color
+func (d *Document) Rect(x, y, w, h float64, c color.Color) {
+	fmt.Fprintf(d.page(), "q %s rg %s %s %s %s re f Q\n", rgb(c), num(x), num(d.height-y-h), num(w), num(h))
+}
+
+// Text draws the text with its baseline at y. Characters that are not in the Windows-1252 charset are replaced by ?.
+func (d *Document) Text(x, y float64, font Font, size float64, c color.Color, text string) {
+	fmt.Fprintf(d.page(), "BT %s rg /F%d %s Tf %s %s Td (%s) Tj ET\n", rgb(c), int(font)+1, num(size), num(x), num(d.height-y), escape(text))
+}
+
+// Image draws the image scaled to the rectangle. Transparent pixels are composed over white.
+func (d *Document) Image(x, y, w, h float64, img image.Image) {
+	d.images = append(d.images, img)
+	fmt.Fprintf(d.page(), "q %s 0 0 %s %s %s cm /Im%d Do Q\n", num(w), num(h), num(x), num(d.height-y-h), len(d.images))
+}
+
+// Bytes returns the encoded document
+func (d *Document) Bytes() ([]byte, error) {
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Fixed objects: 1 catalog, 2 pages, 3 info, then the fonts, the images and, for every page, the page and its content
+	fontsID := 4
+	imagesID := fontsID + len(fontNames)
+	pagesID := imagesID + len(d.images)
+
+	w.object(1, "<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", pagesID+2*i)
+	}
+	w.object(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	w.object(3, fmt.Sprintf("<< /Title (%s) /Producer (Issuer Node) >>", escape(d.title)))
+
+	var fonts, xObjects strings.Builder
+	for i, name := range fontNames {
+		w.object(fontsID+i, fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+		fmt.Fprintf(&fonts, "/F%d %d 0 R ", i+1, fontsID+i)
+	}
+	for i, img := range d.images {
+		data, width, height, err := encodeImage(img)
+		if err != nil {
+			return nil, err
+		}
+		w.stream(imagesID+i, fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode", width, height), data)
+		fmt.Fprintf(&xObjects, "/Im%d %d 0 R ", i+1, imagesID+i)
+	}
+	resources := fmt.Sprintf("<< /Font << %s>> /XObject << %s>> >>", fonts.String(), xObjects.String())
+
+	for i, content := range d.pages {
+		pageID := pagesID + 2*i
+		w.object(pageID, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			num(d.width), num(d.height), resources, pageID+1))
+		compressed, err := deflate(content.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		w.stream(pageID+1, "/Filter /FlateDecode", compressed)
+	}
+
+	return w.finish(1, 3), nil
+}
+
+// TextWidth returns the width of the text in points
+func TextWidth(font Font, size float64, text string) float64 {
+	widths := &helveticaWidths
+	if font == HelveticaBold {
+		widths = &helveticaBoldWidths
+	}
+	var units int
+	for _, r := range text {
+		if r >= ' ' && r <= '~' {
+			units += widths[r-' ']
+		} else {
+			units += defaultWidth
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// writer keeps the offsets of the objects for the cross reference table
+type writer struct {
+	buf     bytes.Buffer
+	offsets map[int]int
+}
+
+func (w *writer) object(id int, body string) {
+	w.begin(id)
+	fmt.Fprintf(&w.buf, "%s\nendobj\n", body)
+}
+
+func (w *writer) stream(id int, dict string, data []byte) {
+	w.begin(id)
+	fmt.Fprintf(&w.buf, "<< %s /Length %d >>\nstream\n", dict, len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func (w *writer) begin(id int) {
+	if w.offsets == nil {
+		w.offsets = make(map[int]int)
+	}
+	w.offsets[id] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n", id)
+}
+
+func (w *writer) finish(rootID, infoID int) []byte {
+	size := len(w.offsets) + 1
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", size)
+	for id := 1; id < size; id++ {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", w.offsets[id])
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, rootID, infoID, xref)
+	return w.buf.Bytes()
+}
+
+func encodeImage(img image.Image) ([]byte, int, int, error) {
+	b := img.Bounds()
+	raw := make([]byte, 0, b.Dx()*b.Dy()*3)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := img.At(x, y).RGBA()
+			// compose over white: c + (1 - alpha) * white, with premultiplied colors
+			white := 0xffff - a
+			raw = append(raw, byte((r+white)>>8), byte((g+white)>>8), byte((bl+white)>>8))
+		}
+	}
+	data, err := deflate(raw)
+	return data, b.Dx(), b.Dy(), err
+}
+
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func rgb(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("%s %s %s", num(float64(r)/0xffff), num(float64(g)/0xffff), num(float64(b)/0xffff))
+}
+
+func num(f float64) string {
+	s := fmt.Sprintf("%.3f", f)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "" || s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// escape encodes the text as a PDF literal string in Windows-1252
+func escape(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			sb.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			sb.WriteByte('?')
+		}
+	}
+	return sb.String()
+}
+
+const defaultWidth = 556
+
+// Widths of the printable ascii characters, from space to ~, in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,55 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	doc := New(A4Width, A4Height)
+	doc.SetTitle("Credential (KYC)")
+	doc.Rect(0, 0, A4Width, 80, color.RGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff})
+	doc.Text(40, 50, HelveticaBold, 20, color.White, "Título (v1)")
+	doc.Image(40, 100, 100, 100, image.NewGray(image.Rect(0, 0, 10, 10)))
+	doc.AddPage()
+	doc.Text(40, 50, Helvetica, 10, color.Black, "second page")
+	assert.Equal(t, 2, doc.PageCount())
+
+	out, err := doc.Bytes()
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), `/Title (Credential \(KYC\))`)
+
+	// every entry of the cross reference table points to its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.Len(t, startxref, 2)
+	offset, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[offset:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		objOffset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[objOffset:], []byte(fmt.Sprintf("%d 0 obj", i+1))), "object %d", i+1)
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 5.56, TextWidth(Helvetica, 10, "a"), 0.001)
+	assert.InDelta(t, 6.11, TextWidth(HelveticaBold, 10, "b"), 0.001)
+	assert.InDelta(t, 2*5.56, TextWidth(Helvetica, 10, "ñ0"), 0.001)
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\(b\)\\ \361 ?`, escape("a(b)\\ ñ €"))
+}
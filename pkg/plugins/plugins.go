@@ -0,0 +1,167 @@
+// Package plugins lets deployments extend the http servers of the issuer node without modifying them. A plugin is a
+// package compiled in the server binaries that registers itself in its init function:
+//
+//	func init() {
+//		plugins.Register(&ssoHeaders{})
+//	}
+//
+// and is imported for its side effects in cmd/platform/plugins.go or cmd/platform_ui/plugins.go.
+package plugins
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// Server identifies the http server a plugin is mounted in
+type Server string
+
+const (
+	ServerAPI Server = "api" // ServerAPI is the issuer api server (cmd/platform)
+	ServerUI  Server = "ui"  // ServerUI is the ui api server (cmd/platform_ui)
+)
+
+// Plugin is an extension of the http servers. Plugins must implement MiddlewarePlugin, InterceptorPlugin or both.
+type Plugin interface {
+	Name() string
+}
+
+// MiddlewarePlugin adds chi middlewares to the router of the server. They run after the built-in middlewares and
+// before the authentication of the operations.
+type MiddlewarePlugin interface {
+	Plugin
+	Middlewares(server Server) []func(http.Handler) http.Handler
+}
+
+// InterceptorPlugin is called before and after every request of the servers.
+// InterceptRequest can replace the request, i.e: to add headers or values to the context, or reject it returning an
+// error. The request is rejected with 403 unless the error is an *Error with a different status.
+// InterceptResponse is called once the response has been written, i.e: to send the request to an audit sink.
+type InterceptorPlugin interface {
+	Plugin
+	InterceptRequest(server Server, r *http.Request) (*http.Request, error)
+	InterceptResponse(server Server, r *http.Request, response Response)
+}
+
+// Response is the outcome of a request intercepted by an InterceptorPlugin
+type Response struct {
+	Status   int
+	Bytes    int
+	Duration time.Duration
+}
+
+// Error rejects a request intercepted by an InterceptorPlugin with the given status
+type Error struct {
+	Status  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var (
+	mu      sync.RWMutex
+	plugins = map[string]Plugin{}
+)
+
+// Register adds a plugin to the servers. It panics if the plugin is nil, implements none of the extension points or
+// if a plugin with the same name is already registered.
+func Register(p Plugin) {
+	mu.Lock()
+	defer mu.Unlock()
+	if p == nil {
+		panic("plugins: Register plugin is nil")
+	}
+	_, isMiddleware := p.(MiddlewarePlugin)
+	_, isInterceptor := p.(InterceptorPlugin)
+	if !isMiddleware && !isInterceptor {
+		panic(fmt.Sprintf("plugins: plugin %s implements no extension point", p.Name()))
+	}
+	if _, dup := plugins[p.Name()]; dup {
+		panic(fmt.Sprintf("plugins: Register called twice for plugin %s", p.Name()))
+	}
+	plugins[p.Name()] = p
+}
+
+// Registered returns the registered plugins sorted by name
+func Registered() []Plugin {
+	mu.RLock()
+	defer mu.RUnlock()
+	registered := make([]Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		registered = append(registered, p)
+	}
+	sort.Slice(registered, func(i, j int) bool { return registered[i].Name() < registered[j].Name() })
+	return registered
+}
+
+// Middleware chains the middlewares and interceptors of the registered plugins for the server. Plugins run sorted
+// by name.
+func Middleware(server Server) func(http.Handler) http.Handler {
+	registered := Registered()
+	return func(next http.Handler) http.Handler {
+		handler := next
+		for i := len(registered) - 1; i >= 0; i-- {
+			if p, ok := registered[i].(MiddlewarePlugin); ok {
+				mws := p.Middlewares(server)
+				for j := len(mws) - 1; j >= 0; j-- {
+					handler = mws[j](handler)
+				}
+			}
+		}
+
+		var interceptors []InterceptorPlugin
+		for _, p := range registered {
+			if interceptor, ok := p.(InterceptorPlugin); ok {
+				interceptors = append(interceptors, interceptor)
+			}
+		}
+		if len(interceptors) == 0 {
+			return handler
+		}
+		return intercept(server, interceptors, handler)
+	}
+}
+
+func intercept(server Server, interceptors []InterceptorPlugin, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		defer func() {
+			response := Response{Status: ww.Status(), Bytes: ww.BytesWritten(), Duration: time.Since(start)}
+			if response.Status == 0 {
+				response.Status = http.StatusOK
+			}
+			for _, interceptor := range interceptors {
+				interceptor.InterceptResponse(server, r, response)
+			}
+		}()
+
+		for _, interceptor := range interceptors {
+			req, err := interceptor.InterceptRequest(server, r)
+			if err != nil {
+				status := http.StatusForbidden
+				var pluginErr *Error
+				if errors.As(err, &pluginErr) && pluginErr.Status != 0 {
+					status = pluginErr.Status
+				}
+				ww.Header().Set("Content-Type", "application/json")
+				ww.WriteHeader(status)
+				_ = json.NewEncoder(ww).Encode(map[string]string{"message": err.Error()})
+				return
+			}
+			if req != nil {
+				r = req
+			}
+		}
+		next.ServeHTTP(ww, r)
+	})
+}
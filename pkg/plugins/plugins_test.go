@@ -0,0 +1,85 @@
+package plugins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type headerPlugin struct {
+	name string
+}
+
+func (p *headerPlugin) Name() string { return p.name }
+
+func (p *headerPlugin) Middlewares(server Server) []func(http.Handler) http.Handler {
+	return []func(http.Handler) http.Handler{
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("X-Plugin", p.name+"-"+string(server))
+				next.ServeHTTP(w, r)
+			})
+		},
+	}
+}
+
+type auditPlugin struct {
+	responses []Response
+}
+
+func (p *auditPlugin) Name() string { return "audit" }
+
+func (p *auditPlugin) InterceptRequest(_ Server, r *http.Request) (*http.Request, error) {
+	if r.Header.Get("X-SSO-User") == "" {
+		return nil, &Error{Status: http.StatusUnauthorized, Message: "missing sso user"}
+	}
+	return r, nil
+}
+
+func (p *auditPlugin) InterceptResponse(_ Server, _ *http.Request, response Response) {
+	p.responses = append(p.responses, response)
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Cleanup(func() { plugins = map[string]Plugin{} })
+	audit := &auditPlugin{}
+	Register(&headerPlugin{name: "b"})
+	Register(&headerPlugin{name: "a"})
+	Register(audit)
+
+	handler := Middleware(ServerUI)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	t.Run("should run the middlewares sorted by name and intercept the response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/credentials", nil)
+		req.Header.Set("X-SSO-User", "jane")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, []string{"a-ui", "b-ui"}, rr.Header().Values("X-Plugin"))
+		require.Len(t, audit.responses, 1)
+		assert.Equal(t, http.StatusCreated, audit.responses[0].Status)
+	})
+
+	t.Run("should reject the requests refused by an interceptor", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/credentials", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.JSONEq(t, `{"message":"missing sso user"}`, rr.Body.String())
+		require.Len(t, audit.responses, 2)
+		assert.Equal(t, http.StatusUnauthorized, audit.responses[1].Status)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(func() { plugins = map[string]Plugin{} })
+	Register(&headerPlugin{name: "sso"})
+	assert.Panics(t, func() { Register(&headerPlugin{name: "sso"}) })
+	assert.Panics(t, func() { Register(nil) })
+}
@@ -122,13 +122,13 @@ func Process(ctx context.Context, loader loader.DocumentLoader, schemaURL string
 	err = pr.ValidateData(jsonCredential, schema)
 	if err != nil {
 		log.Error(ctx, "error validating claim data", "err", err)
-		return nil, ErrValidateData
+		return nil, fmt.Errorf("%w: %s", ErrValidateData, err)
 	}
 
 	claim, err := pr.ParseClaim(ctx, credential, options)
 	if err != nil {
 		log.Error(ctx, "error parsing claim", "err", err)
-		return nil, ErrParseClaim
+		return nil, fmt.Errorf("%w: %s", ErrParseClaim, err)
 	}
 	return claim, nil
 }